@@ -48,6 +48,7 @@ func testAisMsgMarshal(t *testing.T, tc aismsgTestConf) {
 			beforeMsg.BMDVersion = 12
 			beforeMsg.RMDVersion = 34
 			beforeMsg.UUID = "outer-uuid"
+			beforeMsg.ReqID = "test-reqid"
 		}
 		b, err := jsoniter.Marshal(beforeMsg)
 		if err != nil {
@@ -5,6 +5,7 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -458,27 +459,46 @@ func (m *AISBackendProvider) ListBuckets(qbck cmn.QueryBcks) (bcks cmn.Bcks, err
 		return
 	}
 
-	// all attached
+	// all attached, in parallel; buckets are namespaced by alias (when there's one) or UUID;
+	// returning partial results along with the error (that names all failed clusters)
 	m.mu.RLock()
-	uuids := make([]string, 0, len(m.remote))
+	names := make(cos.StrKVs, len(m.remote)) // UUID => alias or UUID
 	for u := range m.remote {
-		uuids = append(uuids, u)
+		names[u] = u
+	}
+	for a, u := range m.alias {
+		names[u] = a
 	}
 	m.mu.RUnlock()
-	if len(uuids) == 0 {
+	if len(names) == 0 {
 		return
 	}
-	for _, uuid := range uuids {
-		remoteBcks, errV := m.blist(uuid, qbck)
-		bcks = append(bcks, remoteBcks...)
-		if errV != nil && err == nil {
-			err = errV
-		}
-	}
-	if len(uuids) == 1 {
-		errCode, err = extractErrCode(err, uuids[0])
-	} else {
-		errCode, err = extractErrCode(err, "")
+	var (
+		errs []error
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+	)
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			remoteBcks, errV := m.blist(name, qbck)
+			mu.Lock()
+			bcks = append(bcks, remoteBcks...)
+			if errV != nil {
+				errs = append(errs, fmt.Errorf("%c%s: %w", apc.NsUUIDPrefix, name, errV))
+			}
+			mu.Unlock()
+			wg.Done()
+		}(name)
+	}
+	wg.Wait()
+	switch len(errs) {
+	case 0:
+	case 1:
+		errCode, err = extractErrCode(errs[0], "")
+	default:
+		err = fmt.Errorf("failed to list buckets at %d (out of %d) remote clusters: %v", len(errs), len(names), errors.Join(errs...))
+		errCode = http.StatusBadGateway
 	}
 	return
 }
@@ -557,7 +577,7 @@ func (m *AISBackendProvider) GetObj(_ ctx, lom *core.LOM, owt cmn.OWT) (errCode
 	return extractErrCode(err, remAis.uuid)
 }
 
-func (m *AISBackendProvider) GetObjReader(_ ctx, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+func (m *AISBackendProvider) GetObjReader(ctx ctx, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
 	var (
 		remAis    *remAis
 		op        *cmn.ObjectProps
@@ -580,10 +600,12 @@ func (m *AISBackendProvider) GetObjReader(_ ctx, lom *core.LOM, offset, length i
 	lom.SetCksum(nil)
 
 	// reader
+	args = &api.GetArgs{Header: make(http.Header, 2)}
 	if length > 0 {
 		rng := cmn.MakeRangeHdr(offset, length)
-		args = &api.GetArgs{Header: http.Header{cos.HdrRange: []string{rng}}}
+		args.Header.Set(cos.HdrRange, rng)
 	}
+	setReqID(ctx, args.Header) // same request ID in the remote cluster
 	res.R, res.Err = api.GetObjectReader(remAis.bp, remoteBck, lom.ObjName, args)
 	res.ErrCode, res.Err = extractErrCode(res.Err, remAis.uuid)
 	return
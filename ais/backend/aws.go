@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -130,6 +131,11 @@ func (*awsProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCo
 		return
 	}
 	bckProps[apc.HdrBucketVerEnabled] = strconv.FormatBool(versioned)
+	if cmn.Rom.Features().IsSet(feat.S3ImportBucketACL) {
+		if access, ok := getBucketAccess(svc, cloudBck); ok {
+			bckProps[apc.HdrBucketAccess] = access.String()
+		}
+	}
 	return
 }
 
@@ -159,6 +165,9 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 	if msg.ContinuationToken != "" {
 		params.ContinuationToken = aws.String(msg.ContinuationToken)
 	}
+	if msg.IsFlagSet(apc.LsNoRecursion) {
+		params.Delimiter = aws.String("/") // virtual directories => CommonPrefixes
+	}
 
 	versioning = bck.Props != nil && bck.Props.Versioning.Enabled && msg.WantProp(apc.GetPropsVersion)
 	msg.PageSize = calcPageSize(msg.PageSize, awsp.MaxPageSize())
@@ -201,6 +210,13 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 		}
 	}
 	lst.Entries = lst.Entries[:l]
+	if len(resp.CommonPrefixes) > 0 {
+		for _, cp := range resp.CommonPrefixes {
+			dir := strings.TrimSuffix(*cp.Prefix, "/")
+			lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: dir, Flags: apc.EntryIsDir})
+		}
+		cmn.SortLso(lst.Entries)
+	}
 
 	if *resp.IsTruncated {
 		lst.ContinuationToken = *resp.NextContinuationToken
@@ -221,6 +237,9 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 		num       int
 	)
 	for _, entry := range lst.Entries {
+		if entry.IsDir() {
+			continue
+		}
 		verParams.Prefix = aws.String(entry.Name)
 		verResp, err := svc.ListObjectVersions(verParams)
 		if err != nil {
@@ -0,0 +1,213 @@
+//go:build aws
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"path"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// Import S3 bucket ACL and bucket policy and map them to AIS access attributes
+// (feature flag feat.S3ImportBucketACL):
+// - only the data path is subject to mapping: list, get/head, put/append, and delete;
+//   all other (bucket and cluster level) permissions remain intact;
+// - bucket ACL: grants to the bucket owner and to the AllUsers and AuthenticatedUsers groups;
+//   READ => list objects; WRITE => put, append, and delete; FULL_CONTROL => all of the above
+//   (note that S3 bucket ACL does not control reading objects - object ACLs do);
+// - bucket policy: unconditional "Deny" statements that apply to all principals ("*");
+//   the denied actions (including wildcards, e.g. "s3:Put*") are then subtracted;
+// - allowing statements are ignored: AIS buckets are fully accessible by default;
+// - failure to read either one (e.g., AccessDenied) is not an error - the respective part is skipped.
+
+const (
+	s3GroupAllUsers  = "http://acs.amazonaws.com/groups/global/AllUsers"
+	s3GroupAuthUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+
+	errCodeNoSuchBucketPolicy = "NoSuchBucketPolicy"
+)
+
+const s3DataAccess = apc.AceObjLIST | apc.AceGET | apc.AceObjHEAD | apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE
+
+// S3 actions => AIS permissions
+var s3Actions = map[string]apc.AccessAttrs{
+	"s3:listbucket":   apc.AceObjLIST,
+	"s3:getobject":    apc.AceGET | apc.AceObjHEAD,
+	"s3:putobject":    apc.AcePUT | apc.AceAPPEND,
+	"s3:deleteobject": apc.AceObjDELETE,
+}
+
+type (
+	s3Policy struct {
+		Statement s3Stmts `json:"Statement"`
+	}
+	s3Stmt struct {
+		Effect    string         `json:"Effect"`
+		Principal any            `json:"Principal"`
+		Action    s3StrOrList    `json:"Action"`
+		Condition map[string]any `json:"Condition,omitempty"`
+	}
+	s3Stmts     []s3Stmt
+	s3StrOrList []string
+)
+
+// returns false when neither ACL nor policy could be read
+func getBucketAccess(svc *s3.S3, bck *cmn.Bck) (access apc.AccessAttrs, ok bool) {
+	access = apc.AccessAll
+	acl, err := svc.GetBucketAcl(&s3.GetBucketAclInput{Bucket: aws.String(bck.Name)})
+	if err == nil {
+		access = aclToAccess(acl, access)
+		ok = true
+	} else {
+		nlog.Warningln("failed to get", bck.Cname(""), "ACL:", _awsErr(err))
+	}
+	policy, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String(bck.Name)})
+	switch {
+	case err == nil:
+		access = policyToAccess(aws.StringValue(policy.Policy), access, bck)
+		ok = true
+	case _isAwsCode(err, errCodeNoSuchBucketPolicy):
+		ok = true
+	default:
+		nlog.Warningln("failed to get", bck.Cname(""), "policy:", _awsErr(err))
+	}
+	return access, ok
+}
+
+func aclToAccess(acl *s3.GetBucketAclOutput, access apc.AccessAttrs) apc.AccessAttrs {
+	var (
+		granted apc.AccessAttrs
+		owner   string
+	)
+	if acl.Owner != nil {
+		owner = aws.StringValue(acl.Owner.ID)
+	}
+	for _, grant := range acl.Grants {
+		if grant.Grantee == nil {
+			continue
+		}
+		var (
+			id  = aws.StringValue(grant.Grantee.ID)
+			uri = aws.StringValue(grant.Grantee.URI)
+		)
+		if (id == "" || id != owner) && uri != s3GroupAllUsers && uri != s3GroupAuthUsers {
+			continue
+		}
+		switch aws.StringValue(grant.Permission) {
+		case s3.PermissionFullControl:
+			granted |= s3DataAccess
+		case s3.PermissionRead:
+			granted |= apc.AceObjLIST
+		case s3.PermissionWrite:
+			granted |= apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE
+		}
+	}
+	// object reads are governed by object ACLs
+	granted |= apc.AceGET | apc.AceObjHEAD
+	return access&^s3DataAccess | granted&access
+}
+
+func policyToAccess(policy string, access apc.AccessAttrs, bck *cmn.Bck) apc.AccessAttrs {
+	var p s3Policy
+	if err := cos.JSON.UnmarshalFromString(policy, &p); err != nil {
+		nlog.Warningln("failed to parse", bck.Cname(""), "policy:", err)
+		return access
+	}
+	for i := range p.Statement {
+		stmt := &p.Statement[i]
+		if !strings.EqualFold(stmt.Effect, "Deny") || !_anyPrincipal(stmt.Principal) {
+			continue
+		}
+		if len(stmt.Condition) > 0 {
+			if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+				nlog.Infoln(bck.Cname(""), "policy: skipping conditional deny", stmt.Action)
+			}
+			continue
+		}
+		for _, pattern := range stmt.Action {
+			pattern = strings.ToLower(pattern)
+			for action, perms := range s3Actions {
+				if matched, _ := path.Match(pattern, action); matched {
+					access &^= perms
+				}
+			}
+		}
+	}
+	return access
+}
+
+// "*" or {"AWS": "*"} or {"AWS": ["*", ...]}
+func _anyPrincipal(principal any) bool {
+	switch v := principal.(type) {
+	case string:
+		return v == "*"
+	case map[string]any:
+		switch p := v["AWS"].(type) {
+		case string:
+			return p == "*"
+		case []any:
+			for _, a := range p {
+				if s, ok := a.(string); ok && s == "*" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func _isAwsCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
+
+/////////////////////////////////////////
+// s3Stmts and s3StrOrList (unmarshal) //
+/////////////////////////////////////////
+
+// policy "Statement" can be a single object or a list
+func (stmts *s3Stmts) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '{' {
+		var stmt s3Stmt
+		if err := cos.JSON.Unmarshal(b, &stmt); err != nil {
+			return err
+		}
+		*stmts = s3Stmts{stmt}
+		return nil
+	}
+	var list []s3Stmt
+	if err := cos.JSON.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*stmts = list
+	return nil
+}
+
+// "Action" can be a single string or a list
+func (sl *s3StrOrList) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := cos.JSON.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*sl = s3StrOrList{s}
+		return nil
+	}
+	var list []string
+	if err := cos.JSON.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*sl = list
+	return nil
+}
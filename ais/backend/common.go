@@ -6,9 +6,11 @@ package backend
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/fs"
 )
@@ -17,6 +19,13 @@ type ctx = context.Context // used when omitted for shortness sake
 
 func fmtTime(t time.Time) string { return t.Format(time.RFC3339) }
 
+// forward client request ID (cos.HdrReqID), if provided by the caller via context
+func setReqID(ctx context.Context, hdr http.Header) {
+	if id, ok := ctx.Value(cos.CtxReqID).(string); ok && id != "" {
+		hdr.Set(cos.HdrReqID, id)
+	}
+}
+
 func calcPageSize(pageSize, maxPageSize uint) uint {
 	if pageSize == 0 {
 		return maxPageSize
@@ -64,7 +64,12 @@ func (hp *httpProvider) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps
 	}
 
 	// Contact the original URL - as long as we can make connection we assume it's good.
-	resp, err := hp.client(origURL).Head(origURL)
+	req, err := http.NewRequest(http.MethodHead, origURL, http.NoBody)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	setReqID(ctx, req.Header)
+	resp, err := hp.client(origURL).Do(req)
 	if err != nil {
 		return nil, http.StatusBadRequest, err
 	}
@@ -184,6 +189,7 @@ func (hp *httpProvider) GetObjReader(ctx context.Context, lom *core.LOM, offset,
 		rng := cmn.MakeRangeHdr(offset, length)
 		req.Header = http.Header{cos.HdrRange: []string{rng}}
 	}
+	setReqID(ctx, req.Header)
 	resp, res.Err = hp.client(origURL).Do(req) //nolint:bodyclose // is closed by the caller
 	if res.Err != nil {
 		return res
@@ -0,0 +1,16 @@
+//go:build !oci
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core"
+)
+
+func NewOCI(_ core.TargetPut) (core.BackendProvider, error) {
+	return nil, newErrInitBackend(apc.OCI)
+}
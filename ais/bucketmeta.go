@@ -82,9 +82,10 @@ type (
 		txnID string // transaction UUID
 		bcks  []*meta.Bck
 
-		propsToUpdate *cmn.BpropsToSet // update existing props
-		revertProps   *cmn.BpropsToSet // props to revert
-		setProps      *cmn.Bprops      // new props to set
+		propsToUpdate *cmn.BpropsToSet  // update existing props
+		revertProps   *cmn.BpropsToSet  // props to revert
+		setProps      *cmn.Bprops       // new props to set
+		accUpdate     *apc.AccessUpdate // allow/deny permissions (apc.ActUpdateAccess)
 
 		wait         bool
 		needReMirror bool
@@ -533,6 +534,35 @@ func defaultBckProps(args bckPropsArgs) (props *cmn.Bprops) {
 	return
 }
 
+// automatic redundancy (feature flag feat.AutoRedundancy) for ais buckets created without
+// explicitly specified props - unless cluster-wide defaults already enable mirroring or EC:
+// - fewer than 4 targets: 2-way mirror;
+// - 4 and 5 targets: EC 2+1;
+// - 6 and more targets: EC 3+2;
+// the selection is recorded in props.AutoRedundancy
+const (
+	autoRedundancyMinEC = 4
+	autoRedundancyBigEC = 6
+)
+
+func autoRedundancy(props *cmn.Bprops, nt int) {
+	if props.Mirror.Enabled || props.EC.Enabled || nt == 0 {
+		return
+	}
+	switch {
+	case nt < autoRedundancyMinEC:
+		props.Mirror.Enabled, props.Mirror.Copies = true, 2
+		props.AutoRedundancy = fmt.Sprintf("mirror %d copies (%d targets)", props.Mirror.Copies, nt)
+	default:
+		props.EC.Enabled, props.EC.DataSlices, props.EC.ParitySlices = true, 2, 1
+		if nt >= autoRedundancyBigEC {
+			props.EC.DataSlices, props.EC.ParitySlices = 3, 2
+		}
+		props.AutoRedundancy = fmt.Sprintf("ec %d+%d (%d targets)", props.EC.DataSlices, props.EC.ParitySlices, nt)
+	}
+	debug.AssertNoErr(props.Validate(nt))
+}
+
 func mergeRemoteBckProps(props *cmn.Bprops, header http.Header) *cmn.Bprops {
 	debug.Assert(len(header) > 0)
 	switch props.Provider {
@@ -549,6 +579,11 @@ func mergeRemoteBckProps(props *cmn.Bprops, header http.Header) *cmn.Bprops {
 		debug.AssertNoErr(err)
 		props.Versioning.Enabled = versioning
 	}
+	if accStr := header.Get(apc.HdrBucketAccess); accStr != "" {
+		access, err := strconv.ParseUint(accStr, 10, 64)
+		debug.AssertNoErr(err)
+		props.Access = apc.AccessAttrs(access)
+	}
 	return props
 }
 
@@ -138,3 +138,49 @@ var _ = Describe("BMD marshal and unmarshal", func() {
 		})
 	}
 })
+
+var _ = Describe("Automatic redundancy", func() {
+	var props *cmn.Bprops
+
+	BeforeEach(func() {
+		props = defaultBckProps(bckPropsArgs{bck: meta.NewBck("auto", apc.AIS, cmn.NsGlobal)})
+		props.Mirror.Enabled, props.EC.Enabled = false, false
+	})
+
+	It("should select 2-way mirror when there are fewer than 4 targets", func() {
+		autoRedundancy(props, 3)
+		Expect(props.Mirror.Enabled).To(BeTrue())
+		Expect(props.Mirror.Copies).To(Equal(int64(2)))
+		Expect(props.EC.Enabled).To(BeFalse())
+		Expect(props.AutoRedundancy).NotTo(BeEmpty())
+	})
+
+	It("should select EC based on the number of targets", func() {
+		autoRedundancy(props, 5)
+		Expect(props.EC.Enabled).To(BeTrue())
+		Expect(props.EC.DataSlices).To(Equal(2))
+		Expect(props.EC.ParitySlices).To(Equal(1))
+
+		props.EC.Enabled = false
+		autoRedundancy(props, 6)
+		Expect(props.Mirror.Enabled).To(BeFalse())
+		Expect(props.EC.DataSlices).To(Equal(3))
+		Expect(props.EC.ParitySlices).To(Equal(2))
+		Expect(props.Validate(6)).NotTo(HaveOccurred())
+	})
+
+	It("should not override cluster defaults and should reset when changed", func() {
+		props.Mirror.Enabled, props.Mirror.Copies = true, 3
+		autoRedundancy(props, 8)
+		Expect(props.EC.Enabled).To(BeFalse())
+		Expect(props.Mirror.Copies).To(Equal(int64(3)))
+		Expect(props.AutoRedundancy).To(BeEmpty())
+
+		props.Mirror.Enabled = false
+		autoRedundancy(props, 2)
+		Expect(props.AutoRedundancy).NotTo(BeEmpty())
+		disable := false
+		props.Apply(&cmn.BpropsToSet{Mirror: &cmn.MirrorConfToSet{Enabled: &disable}})
+		Expect(props.AutoRedundancy).To(BeEmpty())
+	})
+})
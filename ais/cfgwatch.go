@@ -0,0 +1,145 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/OneOfOne/xxhash"
+	"github.com/fsnotify/fsnotify"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Config hot-reload: watch (inotify) the node's plain-text override file <confdir>/ais_override.json
+// and apply its content - same way as `ais config node NODE_ID inherited ...` would, i.e.:
+//   - only run-time reconfigurable (non-cluster-only) knobs can be set;
+//   - invalid content gets rejected in its entirety (and logged);
+//   - applied changes get persisted and logged, e.g.:
+//     "config reload ais_override.json: log.level: 3 => 4, timeout.max_keepalive: 4s => 5s";
+//   - removing a key from the file does _not_ revert the corresponding setting.
+// The file gets applied at startup (if exists) and then each time its content changes.
+
+const cfgwatchDelay = time.Second // to accommodate multi-step writes (truncate, write, close)
+
+type cfgwatch struct {
+	co      *configOwner
+	watcher *fsnotify.Watcher
+	stopCh  *cos.StopCh
+	path    string
+	digest  uint64 // of the last applied content
+}
+
+// strict: unknown keys (typos) must fail to parse
+var cfgwatchJSON = jsoniter.Config{DisallowUnknownFields: true}.Froze()
+
+func newCfgwatch(co *configOwner, config *cmn.Config) *cfgwatch {
+	return &cfgwatch{
+		co:     co,
+		stopCh: cos.NewStopCh(),
+		path:   filepath.Join(config.ConfigDir, fname.PlainOverrideConfig),
+	}
+}
+
+func (*cfgwatch) Name() string { return "cfgwatch" }
+
+func (cw *cfgwatch) Run() (err error) {
+	nlog.Infoln("Starting", cw.Name(), cw.path)
+	cw.reload()
+
+	// watching the directory (rather than the file) to also handle create, delete, and rename-over
+	if cw.watcher, err = fsnotify.NewWatcher(); err == nil {
+		err = cw.watcher.Add(filepath.Dir(cw.path))
+	}
+	if err != nil {
+		nlog.Errorln(cw.Name()+": failed to watch", cw.path, "err:", err, "- config hot-reload disabled")
+		<-cw.stopCh.Listen()
+		return nil
+	}
+	var (
+		timer = time.NewTimer(cfgwatchDelay)
+		base  = filepath.Base(cw.path)
+	)
+	timer.Stop()
+	defer cw.watcher.Close()
+	for {
+		select {
+		case ev, ok := <-cw.watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Base(ev.Name) == base && ev.Has(fsnotify.Write|fsnotify.Create|fsnotify.Rename) {
+				timer.Reset(cfgwatchDelay)
+			}
+		case err, ok := <-cw.watcher.Errors:
+			if !ok {
+				return nil
+			}
+			nlog.Warningln(cw.Name()+":", err)
+		case <-timer.C:
+			cw.reload()
+		case <-cw.stopCh.Listen():
+			timer.Stop()
+			return nil
+		}
+	}
+}
+
+func (cw *cfgwatch) Stop(err error) {
+	nlog.Infof("Stopping %s: %v", cw.Name(), err)
+	cw.stopCh.Close()
+}
+
+func (cw *cfgwatch) reload() {
+	b, err := os.ReadFile(cw.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(cw.Name()+": failed to read", cw.path, "err:", err)
+		}
+		return
+	}
+	digest := xxhash.Checksum64S(b, cos.MLCG32)
+	if digest == cw.digest {
+		return
+	}
+	changes, err := cw.apply(b)
+	if err != nil {
+		nlog.Errorf("config reload %s: %v - not applied", cw.path, err)
+		return
+	}
+	cw.digest = digest
+	if len(changes) == 0 {
+		nlog.Infof("config reload %s: no changes", cw.path)
+		return
+	}
+	s := make([]string, 0, len(changes))
+	for _, c := range changes {
+		s = append(s, c.Name+": "+c.From+" => "+c.To)
+	}
+	nlog.Infof("config reload %s: %s", cw.path, strings.Join(s, ", "))
+}
+
+func (cw *cfgwatch) apply(b []byte) ([]cmn.ConfigChange, error) {
+	toUpdate := &cmn.ConfigToSet{}
+	if err := cfgwatchJSON.Unmarshal(b, toUpdate); err != nil {
+		return nil, fmt.Errorf("failed to parse: %v", err)
+	}
+	if toUpdate.FSP != nil {
+		return nil, errors.New("mountpaths cannot be changed via config reload (use 'ais storage mountpath')")
+	}
+	before := cmn.GCO.Get()
+	if err := cw.co.setDaemonConfig(toUpdate, false /*transient*/); err != nil {
+		return nil, err
+	}
+	return cmn.DiffClusterConfig(&before.ClusterConfig, &cmn.GCO.Get().ClusterConfig), nil
+}
@@ -63,6 +63,11 @@ type (
 			// use loopback devices
 			useLoopbackDevs bool
 		}
+		standalone struct {
+			dir     string // configs, metadata, logs, and the (single) mountpath
+			port    int    // proxy's public port (target: port+1)
+			enabled bool   // run one-node cluster (see standalone.go)
+		}
 		usage bool // show usage and exit
 	}
 	runRet struct {
@@ -102,7 +107,16 @@ func initFlags(flset *flag.FlagSet) {
 	// primary-only:
 	flset.IntVar(&daemon.cli.primary.ntargets, "ntargets", 0, "number of storage targets expected to be joining at startup (optional, primary-only)")
 	flset.BoolVar(&daemon.cli.primary.skipStartup, "skip_startup", false,
-		"whether primary, when starting up, should skip waiting for target joins (used only in tests)")
+		"whether primary, when starting up, should skip waiting for target joins (used in tests and standalone mode)")
+
+	// standalone (one-node cluster)
+	flset.BoolVar(&daemon.cli.standalone.enabled, "standalone", false,
+		"run one-node cluster (proxy and target with a single mountpath) for development and CI;\n"+
+			"runs and supervises two aisnode processes; generates configuration unless already present (see also: standalone_dir, standalone_port)")
+	flset.StringVar(&daemon.cli.standalone.dir, "standalone_dir", "",
+		"standalone mode: directory to store configuration, metadata, logs, and data (default: $HOME/.ais-standalone)")
+	flset.IntVar(&daemon.cli.standalone.port, "standalone_port", 8080,
+		"standalone mode: cluster endpoint port (the target uses the next one)")
 
 	nlog.InitFlags(flset)
 }
@@ -130,6 +144,9 @@ func initDaemon(version, buildTime string) cos.Runner {
 		fmt.Fprintf(os.Stderr, "version %s (build: %s)\n", version, buildTime)
 		os.Exit(0)
 	}
+	if daemon.cli.standalone.enabled {
+		os.Exit(runStandalone(version, buildTime))
+	}
 	os.Args = []string{os.Args[0]}
 	flag.Parse() // so that imported packages don't complain
 
@@ -194,6 +211,7 @@ func initDaemon(version, buildTime string) cos.Runner {
 	}
 	nlog.Infoln(loghdr) // redundant (see below), prior to start/init
 	sys.SetMaxProcs()
+	sys.SetMemLimit()
 
 	daemon.rg = &rungroup{rs: make(map[string]cos.Runner, 6)}
 	hk.Init(&daemon.stopping)
@@ -210,11 +228,15 @@ func initDaemon(version, buildTime string) cos.Runner {
 		xs.Xreg(true /* x-ele only */)
 		p := newProxy(co)
 		p.init(config)
+		if err := p.selfTest(config); err != nil {
+			cos.ExitLog(err)
+		}
 		title := "Node " + p.si.Name() + ", " + loghdr + "\n"
 		nlog.Infoln(title)
 
 		// aux plumbing
 		nlog.SetTitle(title)
+		nlog.SetNode(p.si.Name())
 		cmn.InitErrs(p.si.Name(), nil)
 		return p
 	}
@@ -225,11 +247,15 @@ func initDaemon(version, buildTime string) cos.Runner {
 
 	t := newTarget(co)
 	t.init(config)
+	if err := t.selfTest(config); err != nil {
+		cos.ExitLog(err)
+	}
 	title := "Node " + t.si.Name() + ", " + loghdr + "\n"
 	nlog.Infoln(title)
 
 	// aux plumbing
 	nlog.SetTitle(title)
+	nlog.SetNode(t.si.Name())
 	cmn.InitErrs(t.si.Name(), fs.CleanPathErr)
 
 	return t
@@ -21,19 +21,27 @@ type dpq struct {
 	uuid                string // xaction
 	skipVC              string // (skip loading existing object's metadata)
 	archpath, archmime  string // archive
+	archglob            string // archive: wildcard selection
 	isGFN               string // ditto
 	origURL             string // ht://url->
 	appendTy, appendHdl string // APPEND { apc.AppendOp, ... }
+	mptID, mptPart      string // PUT(part) of a multipart upload
 	owt                 string // object write transaction { OwtPut, ... }
 	fltPresence         string // QparamFltPresence
+	remAisAll           string // QparamRemAisAll
 	dontHeadRemote      string // QparamDontHeadRemote
 	dontAddRemote       string // QparamDontAddRemote
 	bsummRemote         string // QparamBsummRemote
 	etlName             string // QparamETLName
 	silent              string // QparamSilent
 	latestVer           string // QparamLatestVer
+	deltaSig, delta     string // QparamDeltaSig, QparamDelta
+	composite           string // QparamComposite
+	objVer              string // QparamObjVersion
 	// special use: s3 only
 	isS3 string
+	// (not a query parameter) do not apply the bucket's default ETL - see target.getObject
+	noDfltETL bool
 }
 
 var (
@@ -82,6 +90,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.skipVC = value
 		case apc.QparamProxyID:
 			dpq.pid = value
+		case apc.QparamReqID:
+			// (see setReqID)
 		case apc.QparamUnixTime:
 			dpq.ptime = value
 		case apc.QparamUUID:
@@ -94,6 +104,10 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			if dpq.archmime, err = url.QueryUnescape(value); err != nil {
 				return
 			}
+		case apc.QparamArchglob:
+			if dpq.archglob, err = url.QueryUnescape(value); err != nil {
+				return
+			}
 		case apc.QparamIsGFNRequest:
 			dpq.isGFN = value
 		case apc.QparamOrigURL:
@@ -106,11 +120,17 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			if dpq.appendHdl, err = url.QueryUnescape(value); err != nil {
 				return
 			}
+		case apc.QparamMptUploadID:
+			dpq.mptID = value
+		case apc.QparamMptPartNum:
+			dpq.mptPart = value
 		case apc.QparamOWT:
 			dpq.owt = value
 
 		case apc.QparamFltPresence:
 			dpq.fltPresence = value
+		case apc.QparamRemAisAll:
+			dpq.remAisAll = value
 		case apc.QparamDontHeadRemote:
 			dpq.dontHeadRemote = value
 		case apc.QparamDontAddRemote:
@@ -124,6 +144,14 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.silent = value
 		case apc.QparamLatestVer:
 			dpq.latestVer = value
+		case apc.QparamDeltaSig:
+			dpq.deltaSig = value
+		case apc.QparamDelta:
+			dpq.delta = value
+		case apc.QparamComposite:
+			dpq.composite = value
+		case apc.QparamObjVersion:
+			dpq.objVer = value
 
 		case s3.QparamMptUploadID, s3.QparamMptUploads, s3.QparamMptPartNo:
 			// TODO: ignore for now
@@ -44,6 +44,9 @@ type (
 		msg       *apc.ActMsg
 		query     url.Values
 		hdr       http.Header
+		prev      []byte // (cluster config prior to the update - to roll back)
+		failedCnt int    // number of nodes that failed to receive the update
+		rejectCnt int    // (of which) number of nodes that explicitly rejected it
 		wait      bool
 	}
 )
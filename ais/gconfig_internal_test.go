@@ -0,0 +1,41 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestCluCfgRollback(t *testing.T) {
+	clone := &globalConfig{}
+	clone.LRU.Enabled = true
+	clone.LRU.DontEvictTime = cos.Duration(100)
+	clone.Version = 7
+
+	ctx := &configModifier{
+		toUpdate: &cmn.ConfigToSet{LRU: &cmn.LRUConfToSet{Enabled: apc.Bool(false)}},
+	}
+	if updated, err := _setConfPre(ctx, clone); err != nil || !updated {
+		t.Fatalf("failed to apply: (%t, %v)", updated, err)
+	}
+	if clone.LRU.Enabled {
+		t.Fatal("expected the update to be applied")
+	}
+	clone.Version++ // (runPre)
+
+	if updated, err := _rollbackConfPre(&configModifier{prev: ctx.prev}, clone); err != nil || !updated {
+		t.Fatalf("failed to roll back: (%t, %v)", updated, err)
+	}
+	if !clone.LRU.Enabled || clone.LRU.DontEvictTime != 100 {
+		t.Fatalf("expected prior values, got %+v", clone.LRU)
+	}
+	if clone.Version != 8 {
+		t.Fatalf("rollback must not revert config version: expected 8, got %d", clone.Version)
+	}
+}
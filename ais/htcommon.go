@@ -107,6 +107,7 @@ type (
 		UUID       string `json:"uuid"` // cluster-wide ID of this action (operation, transaction)
 		BMDVersion int64  `json:"bmdversion,string"`
 		RMDVersion int64  `json:"rmdversion,string"`
+		ReqID      string `json:"reqid,omitempty"` // originating client request (cos.HdrReqID), if any
 	}
 
 	cleanmark struct {
@@ -125,6 +126,7 @@ type (
 
 	archiveQuery struct {
 		filename string // pathname in archive
+		glob     string // or, wildcard to select multiple files (apc.QparamArchglob)
 		mime     string // https://developer.mozilla.org/en-US/docs/Web/HTTP/Basics_of_HTTP/MIME_types/Common_types
 	}
 
@@ -213,6 +215,7 @@ type (
 		sync.Mutex
 		s             *http.Server
 		muxers        httpMuxers
+		connlim       *connLimiter // proxy's public endpoint only
 		sndRcvBufSize int
 	}
 
@@ -428,11 +431,15 @@ type (
 	cresEI struct{} // -> etl.InfoList
 	cresEL struct{} // -> etl.Logs
 	cresEM struct{} // -> etl.CPUMemUsed
+	cresES struct{} // -> etl.Stats
 	cresIC struct{} // -> icBundle
 	cresBM struct{} // -> bucketMD
 
 	cresLso   struct{} // -> cmn.LsoResult
 	cresBsumm struct{} // -> cmn.AllBsummResults
+	cresHeat  struct{} // -> apc.Heatmap
+	cresSmpl  struct{} // -> apc.Sample
+	cresBatch struct{} // -> apc.BatchOpsResult
 )
 
 var (
@@ -444,9 +451,13 @@ var (
 	_ cresv = cresEI{}
 	_ cresv = cresEL{}
 	_ cresv = cresEM{}
+	_ cresv = cresES{}
 	_ cresv = cresIC{}
 	_ cresv = cresBM{}
 	_ cresv = cresBsumm{}
+	_ cresv = cresHeat{}
+	_ cresv = cresSmpl{}
+	_ cresv = cresBatch{}
 )
 
 func (res *callResult) read(body io.Reader)  { res.bytes, res.err = io.ReadAll(body) }
@@ -484,6 +495,9 @@ func (c cresEL) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresEM) newV() any                              { return &etl.CPUMemUsed{} }
 func (c cresEM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresES) newV() any                              { return &etl.Stats{} }
+func (c cresES) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 func (cresIC) newV() any                              { return &icBundle{} }
 func (c cresIC) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
@@ -493,6 +507,15 @@ func (c cresBM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresBsumm) newV() any                              { return &cmn.AllBsummResults{} }
 func (c cresBsumm) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresHeat) newV() any                              { return &apc.Heatmap{} }
+func (c cresHeat) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresSmpl) newV() any                              { return &apc.Sample{} }
+func (c cresSmpl) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresBatch) newV() any                              { return &apc.BatchOpsResult{} }
+func (c cresBatch) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 ////////////////
 // nlogWriter //
 ////////////////
@@ -582,7 +605,12 @@ func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Co
 	if timeout, isSet := cmn.ParseReadHeaderTimeout(); isSet { // optional env var
 		server.s.ReadHeaderTimeout = timeout
 	}
-	if server.sndRcvBufSize > 0 && !config.Net.HTTP.UseHTTPS {
+	switch {
+	case server.connlim != nil:
+		debug.Assert(server.sndRcvBufSize == 0) // proxy
+		server.s.ConnContext = server.connlim.connContext
+		server.s.ConnState = server.connlim.connState
+	case server.sndRcvBufSize > 0 && !config.Net.HTTP.UseHTTPS:
 		server.s.ConnState = server.connStateListener // setsockopt; see also cmn.NewTransport
 	}
 	server.s.TLSConfig = tlsConf
@@ -669,12 +697,33 @@ func newMuxers() httpMuxers {
 // pattern most closely matches the request URL.
 func (m httpMuxers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if sm, ok := m[r.Method]; ok {
+		setReqID(w, r)
 		sm.ServeHTTP(w, r)
 		return
 	}
 	w.WriteHeader(http.StatusBadRequest)
 }
 
+// accept or generate request ID (cos.HdrReqID) and return it in the response;
+// the ID may also arrive via redirect query (see redirectURL) -
+// intra-cluster requests are not assigned new IDs
+func setReqID(w http.ResponseWriter, r *http.Request) {
+	id := r.Header.Get(cos.HdrReqID)
+	if id == "" {
+		if r.Header.Get(apc.HdrCallerID) != "" {
+			return
+		}
+		if strings.Contains(r.URL.RawQuery, apc.QparamReqID+"=") {
+			id = r.URL.Query().Get(apc.QparamReqID)
+		}
+		if id == "" {
+			id = cos.CryptoRandS(cos.LenShortID)
+		}
+		r.Header.Set(cos.HdrReqID, id)
+	}
+	w.Header().Set(cos.HdrReqID, id)
+}
+
 /////////////////
 // clusterInfo //
 /////////////////
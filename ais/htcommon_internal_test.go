@@ -0,0 +1,53 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestSetReqID(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		hdr      http.Header
+		expected string // "" - expecting generated; "-" - expecting none
+	}{
+		{"client-provided", "/v1/objects/b/o", http.Header{cos.HdrReqID: []string{"abc"}}, "abc"},
+		{"generated", "/v1/objects/b/o", http.Header{}, ""},
+		{"redirected", "/v1/objects/b/o?pid=p1&" + apc.QparamReqID + "=xyz", http.Header{}, "xyz"},
+		{"intra-cluster", "/v1/health", http.Header{apc.HdrCallerID: []string{"t1"}}, "-"},
+		{"intra-cluster propagated", "/v1/txn", http.Header{apc.HdrCallerID: []string{"p1"}, cos.HdrReqID: []string{"abc"}}, "abc"},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, test.url, http.NoBody)
+		for k, v := range test.hdr {
+			r.Header.Set(k, v[0])
+		}
+		w := httptest.NewRecorder()
+		setReqID(w, r)
+
+		id, resp := r.Header.Get(cos.HdrReqID), w.Header().Get(cos.HdrReqID)
+		switch test.expected {
+		case "-":
+			if id != "" || resp != "" {
+				t.Errorf("%s: expected no request ID, got %q (response %q)", test.name, id, resp)
+			}
+		case "":
+			if id == "" || resp != id {
+				t.Errorf("%s: expected generated request ID, got %q (response %q)", test.name, id, resp)
+			}
+		default:
+			if id != test.expected || resp != test.expected {
+				t.Errorf("%s: expected %q, got %q (response %q)", test.name, test.expected, id, resp)
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// runtime profiling in release builds: GET /v1/daemon?what=profile&profile=<kind>[&seconds=N]
+// - response body is the profile itself in pprof format (go tool pprof);
+// - access: apc.AceAdmin (enforced by the gateway - see p.reverseHandler and p.httpdaeget);
+// - one profile at a time (per node)
+// (compare with debug.Handlers - debug builds only)
+
+const (
+	profDfltSecs = 30
+	profMaxSecs  = 300
+
+	profMutexFraction = 5     // runtime.SetMutexProfileFraction
+	profBlockRate     = 10000 // runtime.SetBlockProfileRate (nanoseconds)
+)
+
+var profiling atomic.Bool
+
+func (h *htrun) sendProfile(w http.ResponseWriter, r *http.Request, query url.Values) {
+	kind := query.Get(apc.QparamProfile)
+	if !cos.StringInSlice(kind, apc.SupportedProfiles) {
+		h.writeErrf(w, r, "invalid profile %q (expecting one of: %v)", kind, apc.SupportedProfiles)
+		return
+	}
+	secs := profDfltSecs
+	if s := query.Get(apc.QparamProfileSecs); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 || n > profMaxSecs {
+			h.writeErrf(w, r, "invalid %s profile duration %q (expecting 1 to %d seconds)", kind, s, profMaxSecs)
+			return
+		}
+		secs = n
+	}
+	if !profiling.CAS(false, true) {
+		h.writeErr(w, r, fmt.Errorf("%s: busy collecting another profile - try again later", h), http.StatusConflict)
+		return
+	}
+	defer profiling.Store(false)
+
+	var err error
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	switch kind {
+	case apc.ProfileCPU:
+		if err = pprof.StartCPUProfile(w); err != nil {
+			w.Header().Del(cos.HdrContentType)
+			h.writeErr(w, r, err, http.StatusConflict) // e.g., "cpu profiling already in use"
+			return
+		}
+		profSleep(r, secs)
+		pprof.StopCPUProfile()
+	case apc.ProfileMutex:
+		prev := runtime.SetMutexProfileFraction(profMutexFraction)
+		profSleep(r, secs)
+		err = pprof.Lookup(kind).WriteTo(w, 0)
+		runtime.SetMutexProfileFraction(prev)
+	case apc.ProfileBlock:
+		runtime.SetBlockProfileRate(profBlockRate)
+		profSleep(r, secs)
+		err = pprof.Lookup(kind).WriteTo(w, 0)
+		runtime.SetBlockProfileRate(0)
+	default:
+		err = pprof.Lookup(kind).WriteTo(w, 0)
+	}
+	if err != nil {
+		nlog.Errorln(h.String(), "failed to send", kind, "profile:", err)
+		return
+	}
+	nlog.Infoln(h.String(), "sent", kind, "profile")
+}
+
+// sample for the requested duration unless the requester goes away
+func profSleep(r *http.Request, secs int) {
+	timer := time.NewTimer(time.Duration(secs) * time.Second)
+	select {
+	case <-timer.C:
+	case <-r.Context().Done():
+		timer.Stop()
+	}
+}
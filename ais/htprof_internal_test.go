@@ -0,0 +1,66 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func testProfile(kind, secs string) *httptest.ResponseRecorder {
+	q := url.Values{apc.QparamWhat: []string{apc.WhatProfile}, apc.QparamProfile: []string{kind}}
+	if secs != "" {
+		q.Set(apc.QparamProfileSecs, secs)
+	}
+	r := httptest.NewRequest(http.MethodGet, "/v1/daemon?"+q.Encode(), http.NoBody)
+	w := httptest.NewRecorder()
+	t.sendProfile(w, r, r.URL.Query())
+	return w
+}
+
+func TestProfile(tt *testing.T) {
+	for _, test := range []struct{ kind, secs string }{
+		{apc.ProfileHeap, ""},
+		{apc.ProfileGoroutine, ""},
+		{apc.ProfileCPU, "1"},
+		{apc.ProfileMutex, "1"},
+		{apc.ProfileBlock, "1"},
+	} {
+		w := testProfile(test.kind, test.secs)
+		if w.Code != http.StatusOK {
+			tt.Fatalf("%s: expected %d, got %d (%s)", test.kind, http.StatusOK, w.Code, w.Body.String())
+		}
+		// pprof format: gzip-compressed protobuf
+		if b := w.Body.Bytes(); len(b) < 2 || b[0] != 0x1f || b[1] != 0x8b {
+			tt.Fatalf("%s: expected gzip-compressed profile, got %d bytes", test.kind, len(b))
+		}
+	}
+}
+
+func TestProfileErrors(tt *testing.T) {
+	for _, test := range []struct{ kind, secs string }{
+		{"", ""},
+		{"threadcreate", ""},
+		{apc.ProfileCPU, "0"},
+		{apc.ProfileCPU, "301"},
+		{apc.ProfileMutex, "abc"},
+	} {
+		if w := testProfile(test.kind, test.secs); w.Code != http.StatusBadRequest {
+			tt.Errorf("%q (%q): expected %d, got %d", test.kind, test.secs, http.StatusBadRequest, w.Code)
+		}
+	}
+
+	// one at a time
+	profiling.Store(true)
+	w := testProfile(apc.ProfileHeap, "")
+	profiling.Store(false)
+	if w.Code != http.StatusConflict {
+		tt.Fatalf("expected %d, got %d", http.StatusConflict, w.Code)
+	}
+}
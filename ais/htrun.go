@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
@@ -36,6 +37,8 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -64,8 +67,9 @@ type htrun struct {
 		cluster atomic.Int64 // mono.NanoTime() since cluster startup, zero prior to that
 		node    atomic.Int64 // ditto - for the node
 	}
-	gmm *memsys.MMSA // system pagesize-based memory manager and slab allocator
-	smm *memsys.MMSA // system MMSA for small-size allocations
+	gmm  *memsys.MMSA // system pagesize-based memory manager and slab allocator
+	smm  *memsys.MMSA // system MMSA for small-size allocations
+	wdog watchdog     // goroutine, open file, and socket counts (see cmn.WatchdogConf)
 }
 
 ///////////
@@ -416,6 +420,10 @@ func (h *htrun) setDaemonConfigMsg(w http.ResponseWriter, r *http.Request, msg *
 		h.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, h, msg.Action, msg.Value, err)
 		return
 	}
+	if cos.IsParseBool(query.Get(apc.QparamStage)) {
+		h.stageConfig(w, r, toUpdate, query)
+		return
+	}
 	if err := h.owner.config.setDaemonConfig(toUpdate, transient); err != nil {
 		h.writeErr(w, r, err)
 	}
@@ -431,12 +439,50 @@ func (h *htrun) setDaemonConfigQuery(w http.ResponseWriter, r *http.Request) {
 		h.writeErr(w, r, err)
 		return
 	}
+	if cos.IsParseBool(query.Get(apc.QparamStage)) {
+		h.stageConfig(w, r, toUpdate, query)
+		return
+	}
 	err := h.owner.config.setDaemonConfig(toUpdate, transient)
 	if err != nil {
 		h.writeErr(w, r, err)
 	}
 }
 
+// validate the update against this node's config and respond with the resulting changes - without applying
+// (apc.QparamApplyTo == apc.Cluster: staging cluster-wide update on behalf of the primary)
+func (h *htrun) stageConfig(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToSet, query url.Values) {
+	changes, err := stageConfig(toUpdate, query.Get(apc.QparamApplyTo) == apc.Cluster)
+	if err != nil {
+		h.writeErr(w, r, cmn.NewErrFailedTo(h, "validate", "config update", err))
+		return
+	}
+	h.writeJSON(w, r, changes, "stage-config")
+}
+
+func stageConfig(toUpdate *cmn.ConfigToSet, cluster bool) ([]cmn.ConfigChange, error) {
+	var (
+		config = cmn.GCO.Get()
+		clone  = cmn.GCO.Clone()
+	)
+	if !cluster {
+		if err := clone.UpdateClusterConfig(toUpdate, apc.Daemon); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := clone.UpdateClusterConfig(toUpdate, apc.Cluster); err != nil {
+			return nil, err
+		}
+		// node-local overrides (if any) take precedence
+		if override := cmn.GCO.GetOverride(); override != nil {
+			if err := clone.UpdateClusterConfig(override, apc.Daemon); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return cmn.DiffClusterConfig(&config.ClusterConfig, &clone.ClusterConfig), nil
+}
+
 func (h *htrun) run(config *cmn.Config) error {
 	var (
 		tlsConf *tls.Config
@@ -466,7 +512,8 @@ func (h *htrun) run(config *cmn.Config) error {
 	} else if len(h.si.PubExtra) > 0 {
 		pubAddr2 := h.si.PubExtra[0]
 		debug.Assert(pubAddr2.Port == h.si.PubNet.Port)
-		g.netServ.pub2 = &netServer{muxers: g.netServ.pub.muxers, sndRcvBufSize: g.netServ.pub.sndRcvBufSize}
+		g.netServ.pub2 = &netServer{muxers: g.netServ.pub.muxers, connlim: g.netServ.pub.connlim,
+			sndRcvBufSize: g.netServ.pub.sndRcvBufSize}
 		go func() {
 			_ = g.netServ.pub2.listen(pubAddr2.TCPEndpoint(), logger, tlsConf, config)
 		}()
@@ -706,6 +753,9 @@ func (h *htrun) _nfy(n core.Notif, err error, upon string, aborted bool) {
 	}
 	path := apc.URLPathNotifs.Join(upon)
 	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: path, Body: cos.MustMarshal(&msg)}
+	if msg.ReqID != "" {
+		args.req.Header = http.Header{cos.HdrReqID: []string{msg.ReqID}}
+	}
 	args.network = cmn.NetIntraControl
 	args.timeout = cmn.Rom.MaxKeepalive()
 	args.selected = nodes
@@ -1006,6 +1056,14 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		c = *config
 		c.Auth.Secret = "**********"
 		body = &c
+	case apc.WhatNodeOverride:
+		names := []string{}
+		if override := cmn.GCO.GetOverride(); override != nil {
+			names = override.Names()
+		}
+		body = names
+	case apc.WhatNodeComputed:
+		body = h.computed()
 	case apc.WhatSmap:
 		body = h.owner.smap.get()
 	case apc.WhatBMD:
@@ -1029,12 +1087,17 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 			h.sendOneLog(w, r, query)
 		}
 		return
+	case apc.WhatProfile:
+		h.sendProfile(w, r, query)
+		return
 	case apc.WhatNodeStats:
 		statsNode := h.statsT.GetStats()
 		statsNode.Snode = h.si
 		body = statsNode
 	case apc.WhatMetricNames:
 		body = h.statsT.GetMetricNames()
+	case apc.WhatSLO:
+		body = h.statsT.GetSLO()
 	default:
 		h.writeErrf(w, r, "invalid GET /daemon request: unrecognized what=%s", what)
 		return
@@ -1042,6 +1105,34 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 	h.writeJSON(w, r, body, "httpdaeget-"+what)
 }
 
+// values computed (auto-tuned) at startup, in part based on cgroup limits, if any
+// (see sys.NumCPU, sys.SetMaxProcs, sys.SetMemLimit, and memsys.Init)
+func (h *htrun) computed() cos.StrKVs {
+	var (
+		config   = cmn.GCO.Get()
+		memLimit = "none"
+		goLimit  = "none"
+		kvs      = make(cos.StrKVs, 10)
+	)
+	if l := sys.MemLimit(); l > 0 {
+		memLimit = cos.ToSizeIEC(int64(l), 2)
+	}
+	if l := sys.GoMemLimit(); l < math.MaxInt64 {
+		goLimit = cos.ToSizeIEC(l, 2)
+	}
+	kvs["containerized"] = strconv.FormatBool(sys.Containerized())
+	kvs["cpus"] = strconv.Itoa(sys.NumCPU())
+	kvs["gomaxprocs"] = strconv.Itoa(runtime.GOMAXPROCS(0))
+	kvs["max_parallelism"] = strconv.Itoa(cmn.MaxParallelism())
+	kvs["mem_limit"] = memLimit
+	kvs["gomemlimit"] = goLimit
+	kvs["memsys.min_free"] = cos.ToSizeIEC(int64(h.gmm.MinFree), 2)
+	if h.si.IsTarget() {
+		kvs["transport.burst_buffer"] = strconv.Itoa(transport.Burst(config))
+	}
+	return kvs
+}
+
 func (h *htrun) sendAllLogs(w http.ResponseWriter, r *http.Request, query url.Values) string {
 	sev := query.Get(apc.QparamLogSev)
 	tempdir, archname, err := h.targzLogs(sev)
@@ -2088,7 +2179,7 @@ func (h *htrun) newAmsgActVal(act string, val any) *aisMsg {
 }
 
 func (h *htrun) newAmsg(actionMsg *apc.ActMsg, bmd *bucketMD, uuid ...string) *aisMsg {
-	msg := &aisMsg{ActMsg: *actionMsg}
+	msg := &aisMsg{ActMsg: *actionMsg, ReqID: actionMsg.ReqID}
 	if bmd != nil {
 		msg.BMDVersion = bmd.Version
 	} else {
@@ -2104,6 +2195,7 @@ func (h *htrun) newAmsg(actionMsg *apc.ActMsg, bmd *bucketMD, uuid ...string) *a
 func (*htrun) readActionMsg(w http.ResponseWriter, r *http.Request) (msg *apc.ActMsg, err error) {
 	msg = &apc.ActMsg{}
 	err = cmn.ReadJSON(w, r, msg)
+	msg.ReqID = r.Header.Get(cos.HdrReqID)
 	return
 }
 
@@ -21,6 +21,11 @@ type global struct {
 	client struct {
 		control *http.Client // http client for intra-cluster comm
 		data    *http.Client // http client to execute target <=> target GET & PUT (object)
+		// connection pool stats (exported via the respective gauges - see watchdog)
+		cstats struct {
+			control cmn.ConnStats
+			data    cmn.ConnStats
+		}
 	}
 }
 
@@ -62,7 +67,9 @@ func initCtrlClient(config *cmn.Config) {
 		Timeout:         config.Client.Timeout.D(),
 		WriteBufferSize: defaultControlWriteBufferSize,
 		ReadBufferSize:  defaultControlReadBufferSize,
+		ConnStats:       &g.client.cstats.control,
 	}
+	intraConnArgs(&cargs, &config.Net.HTTP)
 	if config.Net.HTTP.UseHTTPS {
 		g.client.control = cmn.NewIntraClientTLS(cargs, config)
 	} else {
@@ -83,7 +90,9 @@ func initDataClient(config *cmn.Config) {
 		Timeout:         config.Client.TimeoutLong.D(),
 		WriteBufferSize: wbuf,
 		ReadBufferSize:  rbuf,
+		ConnStats:       &g.client.cstats.data,
 	}
+	intraConnArgs(&cargs, &config.Net.HTTP)
 	if config.Net.HTTP.UseHTTPS {
 		g.client.data = cmn.NewIntraClientTLS(cargs, config)
 	} else {
@@ -91,6 +100,15 @@ func initDataClient(config *cmn.Config) {
 	}
 }
 
+// connection pooling and HTTP/2 (zero values: defaults - see cmn.NewTransport)
+func intraConnArgs(cargs *cmn.TransportArgs, c *cmn.HTTPConf) {
+	cargs.IdleConnsPerHost = c.IdleConnsPerHost
+	cargs.MaxIdleConns = c.MaxIdleConns
+	cargs.MaxConnsPerHost = c.MaxConnsPerHost
+	cargs.IdleConnTimeout = c.IdleConnTimeout.D()
+	cargs.ForceHTTP2 = c.HTTP2 && c.UseHTTPS
+}
+
 func shuthttp() {
 	config := cmn.GCO.Get()
 	g.netServ.pub.shutdown(config)
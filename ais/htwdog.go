@@ -0,0 +1,147 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/sys"
+)
+
+// leak watchdog (see cmn.WatchdogConf):
+// - periodically sample goroutines, open file descriptors, and sockets;
+// - update the respective stats gauges (stats.GoroutinesGauge, et al.);
+// - update intra-cluster connection pool stats (stats.CtrlConnsGauge, et al.) - always, even when disabled;
+// - warn when exceeding configured thresholds;
+// - warn when steadily growing: never decreasing over the last `wdogWindow` samples
+//   while growing by at least `wdogGrowthPct` percent
+// Warnings are logged as space-separated key=value pairs, e.g.:
+// "watchdog: metric=goroutines value=12000 limit=10000 window=10m0s growth=3000"
+
+const (
+	wdogWindow    = 10 // samples
+	wdogGrowthPct = 50
+)
+
+const (
+	wdogGoroutines = iota
+	wdogFDs
+	wdogSockets
+	wdogNum
+)
+
+var wdogNames = [wdogNum]string{"goroutines", "fds", "sockets"}
+
+type (
+	wdogSample [wdogNum]int
+	watchdog   struct {
+		h       *htrun
+		samples []wdogSample // ring of the most recent samples, up to `wdogWindow`
+		next    int
+		dials   struct{ ctrl, data int64 } // (to add the deltas)
+	}
+)
+
+func (wd *watchdog) init(h *htrun) {
+	wd.h = h
+	wd.samples = make([]wdogSample, 0, wdogWindow)
+	hk.Reg("watchdog"+hk.NameSuffix, wd.housekeep, cmn.GCO.Get().Watchdog.Interval.D())
+}
+
+func (wd *watchdog) housekeep() time.Duration {
+	conf := &cmn.GCO.Get().Watchdog
+	var (
+		cs       = &g.client.cstats
+		ctrl     = cs.control.Dials.Load()
+		data     = cs.data.Dials.Load()
+		ctrlPrev = wd.dials.ctrl
+		dataPrev = wd.dials.data
+	)
+	wd.dials.ctrl, wd.dials.data = ctrl, data
+	wd.h.statsT.AddMany(
+		cos.NamedVal64{Name: stats.CtrlConnsGauge, Value: cs.control.Open.Load()},
+		cos.NamedVal64{Name: stats.CtrlDialsCount, Value: ctrl - ctrlPrev},
+		cos.NamedVal64{Name: stats.DataConnsGauge, Value: cs.data.Open.Load()},
+		cos.NamedVal64{Name: stats.DataDialsCount, Value: data - dataPrev},
+	)
+	if !conf.Enabled {
+		wd.samples = wd.samples[:0]
+		return conf.Interval.D()
+	}
+	var (
+		s      wdogSample
+		fdsLim uint64
+	)
+	s[wdogGoroutines] = runtime.NumGoroutine()
+	if fds, err := sys.ProcessFDs(os.Getpid()); err == nil {
+		s[wdogFDs], s[wdogSockets], fdsLim = fds.Open, fds.Sockets, fds.Limit
+	}
+	wd.h.statsT.AddMany(
+		cos.NamedVal64{Name: stats.GoroutinesGauge, Value: int64(s[wdogGoroutines])},
+		cos.NamedVal64{Name: stats.OpenFDsGauge, Value: int64(s[wdogFDs])},
+		cos.NamedVal64{Name: stats.SocketsGauge, Value: int64(s[wdogSockets])},
+	)
+	for _, w := range wd.check(conf, s, fdsLim) {
+		nlog.Warningln(w)
+	}
+	return conf.Interval.D()
+}
+
+// add the sample and return warnings, if any
+func (wd *watchdog) check(conf *cmn.WatchdogConf, s wdogSample, fdsLim uint64) (warns []string) {
+	var oldest *wdogSample
+	if len(wd.samples) < wdogWindow {
+		wd.samples = append(wd.samples, s)
+	} else {
+		wd.samples[wd.next] = s
+		wd.next = (wd.next + 1) % wdogWindow
+		oldest = &wd.samples[wd.next]
+	}
+
+	limits := [wdogNum]int{conf.MaxGoroutines, 0, conf.MaxSockets}
+	if fdsLim > 0 {
+		limits[wdogFDs] = int(fdsLim * uint64(conf.MaxFDsPct) / 100)
+	}
+	window := time.Duration(len(wd.samples)-1) * conf.Interval.D()
+	for i := 0; i < wdogNum; i++ {
+		var (
+			val    = s[i]
+			growth int
+		)
+		if oldest != nil {
+			growth = val - oldest[i]
+		}
+		switch {
+		case limits[i] > 0 && val > limits[i]:
+			warns = append(warns, fmt.Sprintf("watchdog: node=%s metric=%s value=%d limit=%d window=%v growth=%d",
+				wd.h.si, wdogNames[i], val, limits[i], window, growth))
+		case oldest != nil && growth > 0 && wd.growing(i) && growth*100 >= oldest[i]*wdogGrowthPct:
+			warns = append(warns, fmt.Sprintf("watchdog: node=%s metric=%s value=%d steady-growth window=%v growth=%d (possible leak)",
+				wd.h.si, wdogNames[i], val, window, growth))
+		}
+	}
+	return warns
+}
+
+// never decreasing over the (full) window
+func (wd *watchdog) growing(i int) bool {
+	prev := wd.samples[wd.next][i]
+	for j := 1; j < wdogWindow; j++ {
+		cur := wd.samples[(wd.next+j)%wdogWindow][i]
+		if cur < prev {
+			return false
+		}
+		prev = cur
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func testWdog() (*watchdog, *cmn.WatchdogConf) {
+	wd := &watchdog{h: &t.htrun, samples: make([]wdogSample, 0, wdogWindow)}
+	conf := &cmn.WatchdogConf{Interval: cos.Duration(time.Minute), MaxFDsPct: 80, Enabled: true}
+	return wd, conf
+}
+
+func TestWatchdogLimits(tt *testing.T) {
+	wd, conf := testWdog()
+	conf.MaxGoroutines, conf.MaxSockets = 100, 10
+
+	if warns := wd.check(conf, wdogSample{100, 50, 10}, 1000); len(warns) != 0 {
+		tt.Fatalf("expected no warnings at the limits, got %v", warns)
+	}
+	warns := wd.check(conf, wdogSample{101, 801, 11}, 1000)
+	if len(warns) != wdogNum {
+		tt.Fatalf("expected %d warnings, got %v", wdogNum, warns)
+	}
+	for i, w := range warns {
+		if !strings.Contains(w, "metric="+wdogNames[i]) || !strings.Contains(w, "limit=") {
+			tt.Errorf("unexpected warning %q", w)
+		}
+	}
+
+	// no limits (and unknown RLIMIT_NOFILE)
+	conf.MaxGoroutines, conf.MaxSockets = 0, 0
+	if warns := wd.check(conf, wdogSample{10000, 10000, 10000}, 0); len(warns) != 0 {
+		tt.Fatalf("expected no warnings, got %v", warns)
+	}
+}
+
+func TestWatchdogGrowth(tt *testing.T) {
+	wd, conf := testWdog()
+
+	// steady growth - reported only when the window is full
+	for i := 0; i < wdogWindow; i++ {
+		if warns := wd.check(conf, wdogSample{100 + 10*i, 20, 0}, 0); len(warns) != 0 {
+			tt.Fatalf("sample %d: expected no warnings, got %v", i, warns)
+		}
+	}
+	warns := wd.check(conf, wdogSample{200, 20, 0}, 0)
+	if len(warns) != 1 || !strings.Contains(warns[0], "metric=goroutines") || !strings.Contains(warns[0], "possible leak") {
+		tt.Fatalf("expected goroutine growth warning, got %v", warns)
+	}
+
+	// a single drop within the window: not a leak
+	wd, _ = testWdog()
+	for i := 0; i <= wdogWindow; i++ {
+		n := 100 + 10*i
+		if i == wdogWindow/2 {
+			n = 50
+		}
+		if warns := wd.check(conf, wdogSample{n, 20, 0}, 0); len(warns) != 0 {
+			tt.Fatalf("sample %d: expected no warnings, got %v", i, warns)
+		}
+	}
+
+	// growing, but not by much
+	wd, _ = testWdog()
+	for i := 0; i <= 2*wdogWindow; i++ {
+		if warns := wd.check(conf, wdogSample{1000 + i, 20, 0}, 0); len(warns) != 0 {
+			tt.Fatalf("sample %d: expected no warnings, got %v", i, warns)
+		}
+	}
+}
@@ -110,6 +110,7 @@ type (
 	revsReq struct {
 		wg        *sync.WaitGroup
 		failedCnt *atomic.Int32
+		rejectCnt *atomic.Int32 // (subset of failedCnt: nodes that responded with an error)
 		pairs     []revsPair
 		reqType   int // enum: reqSync, etc.
 	}
@@ -178,11 +179,14 @@ func (y *metasyncer) Run() error {
 				y.timerStopped = true
 				break
 			}
-			failedCnt := y.do(revsReq.pairs, revsReq.reqType)
+			failedCnt, rejectCnt := y.do(revsReq.pairs, revsReq.reqType)
 			if revsReq.wg != nil {
 				if revsReq.failedCnt != nil {
 					revsReq.failedCnt.Store(int32(failedCnt))
 				}
+				if revsReq.rejectCnt != nil {
+					revsReq.rejectCnt.Store(int32(rejectCnt))
+				}
 				revsReq.wg.Done()
 			}
 			if y.timerStopped && failedCnt > 0 {
@@ -252,6 +256,28 @@ func (y *metasyncer) sync(pairs ...revsPair) *sync.WaitGroup {
 	return req.wg
 }
 
+// same as sync but also waits and returns the number of nodes that failed to sync
+// (failures include nodes that remain unreachable after the retries - see `do`)
+// and, separately, the number of those that explicitly rejected the update
+func (y *metasyncer) syncCnt(pairs ...revsPair) (failedCnt, rejectCnt int) {
+	debug.Assert(len(pairs) > 0)
+	if err := y.isPrimary(); err != nil {
+		nlog.Errorln(err)
+		return
+	}
+	req := revsReq{
+		pairs:     pairs,
+		wg:        &sync.WaitGroup{},
+		failedCnt: atomic.NewInt32(0),
+		rejectCnt: atomic.NewInt32(0),
+		reqType:   reqSync,
+	}
+	req.wg.Add(1)
+	y.workCh <- req
+	req.wg.Wait()
+	return int(req.failedCnt.Load()), int(req.rejectCnt.Load())
+}
+
 // become non-primary (to serialize cleanup of the internal state and stop the timer)
 func (y *metasyncer) becomeNonPrimary() {
 drain:
@@ -270,7 +296,8 @@ drain:
 }
 
 // main method; see top of the file; returns number of "sync" failures
-func (y *metasyncer) do(pairs []revsPair, reqT int) (failedCnt int) {
+// and (the subset of) those that came back as error responses
+func (y *metasyncer) do(pairs []revsPair, reqT int) (failedCnt, rejectCnt int) {
 	var (
 		refused meta.NodeMap
 		newTIDs []string
@@ -364,6 +391,9 @@ func (y *metasyncer) do(pairs []revsPair, reqT int) (failedCnt int) {
 		} else {
 			nlog.Warningf("%s: %s %s: %v(%d)", y.p, failsync, sname, err, res.status)
 			failedCnt++
+			if res.status >= http.StatusBadRequest {
+				rejectCnt++
+			}
 		}
 	}
 	freeBcastRes(results)
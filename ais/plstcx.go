@@ -42,6 +42,7 @@ type (
 		lsmsg   apc.LsoMsg
 		altmsg  apc.ActMsg
 		tcomsg  cmn.TCObjsMsg
+		pt      *cos.ParsedTemplate // tcomsg.TCBMsg.Template, if any
 		stopped atomic.Bool
 	}
 )
@@ -90,9 +91,20 @@ func (a *lstca) abort(xargs *xact.ArgsMsg) {
 
 func (c *lstcx) do() (string, error) {
 	// 1. lsmsg
+	prefix := c.tcomsg.TCBMsg.Prefix
+	if tmpl := c.tcomsg.TCBMsg.Template; tmpl != "" {
+		pt, err := cos.NewParsedTemplate(tmpl)
+		if err != nil {
+			return "", err
+		}
+		c.pt = &pt
+		if len(pt.Prefix) > len(prefix) && strings.HasPrefix(pt.Prefix, prefix) {
+			prefix = pt.Prefix
+		}
+	}
 	c.lsmsg = apc.LsoMsg{
 		UUID:     cos.GenUUID(),
-		Prefix:   c.tcomsg.TCBMsg.Prefix,
+		Prefix:   prefix,
 		Props:    apc.GetPropsName,
 		PageSize: 0, // i.e., backend.MaxPageSize()
 	}
@@ -105,13 +117,20 @@ func (c *lstcx) do() (string, error) {
 	c.tsi = tsi
 	c.lsmsg.SID = tsi.ID()
 
-	// 2. ls 1st page
+	// 2. ls 1st page (that has matching names, if filtering by template)
 	var lst *cmn.LsoResult
-	lst, err = c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.smap, tsi /*designated target*/, c.config, true)
-	if err != nil {
-		return "", err
+	for {
+		lst, err = c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.smap, tsi /*designated target*/, c.config, true)
+		if err != nil {
+			return "", err
+		}
+		c.setNames(lst)
+		if len(c.tcomsg.ListRange.ObjNames) > 0 || lst.ContinuationToken == "" {
+			break
+		}
+		c.lsmsg.ContinuationToken = lst.ContinuationToken
 	}
-	if len(lst.Entries) == 0 {
+	if len(c.tcomsg.ListRange.ObjNames) == 0 {
 		// TODO: return http status to indicate exactly that (#6393)
 		nlog.Infoln(c.amsg.Action, c.bckFrom.Cname(""), " to ", c.bckTo.Cname("")+": lso counts zero - nothing to do")
 		return c.lsmsg.UUID, nil
@@ -119,11 +138,7 @@ func (c *lstcx) do() (string, error) {
 
 	// 3. tcomsg
 	c.tcomsg.ToBck = c.bckTo.Clone()
-	lr, cnt := &c.tcomsg.ListRange, len(lst.Entries)
-	lr.ObjNames = make([]string, 0, cnt)
-	for _, e := range lst.Entries {
-		lr.ObjNames = append(lr.ObjNames, e.Name)
-	}
+	cnt := len(c.tcomsg.ListRange.ObjNames)
 
 	// 4. multi-obj action: transform/copy 1st page
 	c.altmsg.Value = &c.tcomsg
@@ -179,17 +194,33 @@ func (c *lstcx) _page() (int, error) {
 		return 0, nil
 	}
 
+	c.setNames(lst)
 	lr := &c.tcomsg.ListRange
-	clear(lr.ObjNames)
-	lr.ObjNames = lr.ObjNames[:0]
-	for _, e := range lst.Entries {
-		lr.ObjNames = append(lr.ObjNames, e.Name)
+	if len(lr.ObjNames) == 0 {
+		return 0, nil // none matching
 	}
 	c.altmsg.Value = &c.tcomsg
 	err = c.bcast()
 	return len(lr.ObjNames), err
 }
 
+// names from the listed page (filtered by template, if specified)
+func (c *lstcx) setNames(lst *cmn.LsoResult) {
+	lr := &c.tcomsg.ListRange
+	if lr.ObjNames == nil {
+		lr.ObjNames = make([]string, 0, len(lst.Entries))
+	} else {
+		clear(lr.ObjNames)
+		lr.ObjNames = lr.ObjNames[:0]
+	}
+	for _, e := range lst.Entries {
+		if c.pt != nil && !c.pt.Match(e.Name) {
+			continue
+		}
+		lr.ObjNames = append(lr.ObjNames, e.Name)
+	}
+}
+
 // calls t.httpxpost (TODO: slice of names is the only "delta" - optimize)
 func (c *lstcx) bcast() (err error) {
 	body := cos.MustMarshal(apc.ActMsg{Name: c.xid, Value: &c.tcomsg})
@@ -37,6 +37,7 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
@@ -71,6 +72,7 @@ type (
 			mu  sync.RWMutex
 			in  atomic.Bool
 		}
+		connlim           connLimiter // client connection limits (see cmn.ProxyConf.MaxConns)
 		settingNewPrimary atomic.Bool // primary executing "set new primary" request (state)
 		readyToFastKalive atomic.Bool // primary can accept fast keepalives
 	}
@@ -118,6 +120,8 @@ func (p *proxy) init(config *cmn.Config) {
 	m := newMetasyncer(p)
 	daemon.rg.add(m)
 	p.metasyncer = m
+
+	daemon.rg.add(newCfgwatch(p.owner.config, config))
 }
 
 func initPID(config *cmn.Config) (pid string) {
@@ -189,6 +193,8 @@ func (p *proxy) Run() error {
 	p.notifs.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	p.wdog.init(&p.htrun)
+	p.connlim.init(p)
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -214,9 +220,15 @@ func (p *proxy) Run() error {
 
 		{r: apc.Notifs, h: p.notifs.handler, net: accessNetIntraControl},
 
+		{r: apc.OpenAPI, h: p.openapiHandler, net: accessNetPublic},
+		{r: apc.Xactions, h: p.xactHandler, net: accessNetPublic}, // WebSocket: xaction watch
+
 		// S3 compatibility
 		{r: "/" + apc.S3, h: p.s3Handler, net: accessNetPublic},
 
+		// built-in web dashboard (config.Proxy.WebUI)
+		{r: "/" + apc.WebUI, h: p.webuiHandler, net: accessNetPublic},
+
 		// "easy URL"
 		{r: "/" + apc.GSScheme, h: p.easyURLHandler, net: accessNetPublic},
 		{r: "/" + apc.AZScheme, h: p.easyURLHandler, net: accessNetPublic},
@@ -225,6 +237,12 @@ func (p *proxy) Run() error {
 		// ht:// _or_ S3 compatibility, depending on feature flag
 		{r: "/", h: p.rootHandler, net: accessNetPublic},
 	}
+	// client connection limits and read-only proxy: gate client requests (see connGate and roGate)
+	for i := range networkHandlers {
+		if networkHandlers[i].net.isSet(accessNetPublic) {
+			networkHandlers[i].h = p.connGate(p.roGate(networkHandlers[i].h))
+		}
+	}
 	p.regNetHandlers(networkHandlers)
 
 	nlog.Infof("%s: [%s net] listening on: %s", p, cmn.NetPublic, p.si.PubNet.URL)
@@ -277,8 +295,14 @@ func (p *proxy) recvCluMeta(cm *cluMeta, action, caller string) error {
 		errs []error
 	)
 	if cm.PrimeTime != 0 {
+		now := time.Now().UnixNano()
+		if action == apc.ActSelfJoinProxy && !p.NodeStarted() {
+			if err := checkClockSkew(p.String(), cm.PrimeTime, now, cmn.GCO.Get()); err != nil {
+				return err
+			}
+		}
 		xreg.PrimeTime.Store(cm.PrimeTime)
-		xreg.MyTime.Store(time.Now().UnixNano())
+		xreg.MyTime.Store(now)
 	}
 	// Config
 	debug.Assert(cm.Config != nil)
@@ -544,6 +568,10 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := summMsg.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		if qbck.IsBucket() {
 			bck := (*meta.Bck)(qbck)
 			bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceBckHEAD, bck: bck, dpq: dpq}
@@ -557,6 +585,50 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
+	// (I') per-object access statistics
+	if msg.Action == apc.ActHeatmapBck {
+		var hmsg apc.HeatmapMsg
+		if err := cos.MorphMarshal(msg.Value, &hmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if !qbck.IsBucket() {
+			p.writeErrf(w, r, "bad heatmap request: %q is not a bucket", qbck)
+			return
+		}
+		bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceBckHEAD, bck: (*meta.Bck)(qbck), dpq: dpq}
+		bckArgs.createAIS = false
+		bckArgs.dontAddRemote = true
+		if _, err := bckArgs.initAndTry(); err != nil {
+			return
+		}
+		hmsg.Validate()
+		p.heatmap(w, r, qbck, &hmsg)
+		return
+	}
+
+	// (I'') random sample of object names
+	if msg.Action == apc.ActSampleBck {
+		var smsg apc.SampleMsg
+		if err := cos.MorphMarshal(msg.Value, &smsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if !qbck.IsBucket() {
+			p.writeErrf(w, r, "bad sample request: %q is not a bucket", qbck)
+			return
+		}
+		bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceObjLIST, bck: (*meta.Bck)(qbck), dpq: dpq}
+		bckArgs.createAIS = false
+		bckArgs.dontAddRemote = true
+		if _, err := bckArgs.initAndTry(); err != nil {
+			return
+		}
+		smsg.Validate()
+		p.sample(w, r, qbck, &smsg)
+		return
+	}
+
 	// (II) invalid action
 	if msg.Action != apc.ActList {
 		p.writeErrAct(w, r, msg.Action)
@@ -691,6 +763,13 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 			nodeID = items[0] // nodeID; compare w/ apndOI.parse
 		}
 	}
+	if apireq.dpq.mptID != "" { // apc.QparamMptUploadID
+		var err error
+		if nodeID, err = mptTargetID(apireq.dpq.mptID); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
 
 	// 2. bucket
 	bckArgs := allocBctx()
@@ -1113,6 +1192,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 	const (
 		warnDstNotExist = "%s: destination %s doesn't exist and will be created with the %s (source bucket) props"
 		errPrependSync  = "prepend option (%q) is incompatible with the request to synchronize buckets"
+		errRenameSync   = "rename template (%q) is incompatible with the request to synchronize buckets"
 	)
 	var (
 		query    = r.URL.Query()
@@ -1209,11 +1289,19 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 				return
 			}
+			if err := tcbmsg.Validate(false); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
 		}
 		if tcbmsg.Sync && tcbmsg.Prepend != "" {
 			p.writeErrf(w, r, errPrependSync, tcbmsg.Prepend)
 			return
 		}
+		if tcbmsg.Sync && tcbmsg.Rename != "" {
+			p.writeErrf(w, r, errRenameSync, tcbmsg.Rename)
+			return
+		}
 		bckTo, err = newBckFromQuname(query, true /*required*/)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1279,6 +1367,14 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, errPrependSync, tcomsg.Prepend)
 			return
 		}
+		if tcomsg.Sync && tcomsg.Rename != "" {
+			p.writeErrf(w, r, errRenameSync, tcomsg.Rename)
+			return
+		}
+		if err := tcomsg.TCBMsg.Validate(msg.Action == apc.ActETLObjects); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		bckTo = meta.CloneBck(&tcomsg.ToBck)
 
 		if bck.Equal(bckTo, true, true) {
@@ -1328,6 +1424,114 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActInitShard:
+		ishmsg := &apc.InitShardMsg{}
+		if err := cos.MorphMarshal(msg.Value, ishmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := ishmsg.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if _, err := archive.Mime("", ishmsg.Template); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		perms := apc.AcePUT
+		if ishmsg.DeleteSrc {
+			perms |= apc.AceObjDELETE
+		}
+		if err := p.checkAccess(w, r, bck, perms); err != nil {
+			return
+		}
+		msg.Value = ishmsg // (with defaults)
+		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActCheckOOB:
+		oobmsg := &apc.CheckOOBMsg{}
+		if err := cos.MorphMarshal(msg.Value, oobmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := oobmsg.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := cmn.ValidateRemoteBck(apc.ActCheckOOB, bck.Bucket()); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		perms := apc.AceObjLIST | apc.AceObjHEAD
+		switch oobmsg.Action {
+		case apc.OOBMark:
+			perms |= apc.AceObjUpdate | apc.AceObjDELETE // (remotely deleted get evicted)
+		case apc.OOBEvict:
+			perms |= apc.AceObjDELETE
+		}
+		if err := p.checkAccess(w, r, bck, perms); err != nil {
+			return
+		}
+		msg.Value = oobmsg // (with defaults)
+		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActRenamePrefix:
+		rnpmsg := &apc.RenamePrefixMsg{}
+		if err := cos.MorphMarshal(msg.Value, rnpmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := rnpmsg.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		// (same limitations as apc.ActRenameObject)
+		if !bck.IsAIS() {
+			p.writeErrActf(w, r, msg.Action, "not supported for remote buckets (%s)", bck)
+			return
+		}
+		if bck.Props.EC.Enabled {
+			p.writeErrActf(w, r, msg.Action, "not supported for erasure-coded buckets (%s)", bck)
+			return
+		}
+		if err := cmn.ValidatePrefix(rnpmsg.NewPrefix); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := p.checkAccess(w, r, bck, apc.AceObjMOVE); err != nil {
+			return
+		}
+		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActBatchObjOps:
+		bmsg := &apc.BatchOpsMsg{}
+		if err := cos.MorphMarshal(msg.Value, bmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := bmsg.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		var perms apc.AccessAttrs
+		for i := range bmsg.Ops {
+			if bmsg.Ops[i].Op == apc.BatchDelete {
+				perms |= apc.AceObjDELETE
+			} else {
+				perms |= apc.AceObjUpdate
+			}
+		}
+		if err := p.checkAccess(w, r, bck, perms); err != nil {
+			return
+		}
+		p.batchObjOps(w, r, bck, msg, bmsg)
+		return
 	case apc.ActInvalListCache:
 		p.qm.c.invalidate(bck.Bucket())
 		return
@@ -1398,6 +1602,17 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 		return
 	}
 
+	// bucket profile (if specified) goes first, explicitly specified props override
+	var profile *cmn.BpropsToSet
+	if name := query.Get(apc.QparamBprofile); name != "" {
+		config := cmn.GCO.Get()
+		if profile = config.BucketProfiles.Get(name); profile == nil {
+			p.writeErrf(w, r, "cannot create %s: bucket profile %q does not exist (see 'bucket_profiles' in the cluster config)",
+				bck, name)
+			return
+		}
+	}
+
 	if bck.IsRemote() {
 		// (feature) add Cloud bucket to BMD, to further set its `Props.Extra`
 		// with alternative access profile and/or endpoint
@@ -1417,6 +1632,9 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 			config := cmn.GCO.Get()
 			bprops := bck.Bucket().DefaultProps(&config.ClusterConfig)
 			bprops.SetProvider(bck.Provider)
+			if profile != nil {
+				bprops.Apply(profile)
+			}
 
 			if err := p._createBucketWithProps(msg, bck, bprops); err != nil {
 				p.writeErr(w, r, err, crerrStatus(err))
@@ -1440,11 +1658,16 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 		msg.Action = apc.ActAddRemoteBck // ditto
 	}
 	// props-to-update at creation time
-	if msg.Value != nil {
+	if msg.Value != nil || profile != nil {
 		propsToUpdate := cmn.BpropsToSet{}
-		if err := cos.MorphMarshal(msg.Value, &propsToUpdate); err != nil {
-			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
-			return
+		if profile != nil {
+			propsToUpdate = *profile
+		}
+		if msg.Value != nil {
+			if err := cos.MorphMarshal(msg.Value, &propsToUpdate); err != nil {
+				p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+				return
+			}
 		}
 		// Make and validate new bucket props.
 		bck.Props = defaultBckProps(bckPropsArgs{bck: bck})
@@ -1510,6 +1733,19 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 		}
 	}
 
+	if lsmsg.IsFlagSet(apc.LsHead) && lsmsg.IsFlagSet(apc.LsNoRecursion) {
+		p.writeErrMsg(w, r, "flag 'LsHead' is incompatible with 'LsNoRecursion'")
+		return
+	}
+
+	// filtering by custom metadata: objects present in the cluster
+	if _, err := lsmsg.MDFilter(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	} else if lsmsg.CustomMD != "" {
+		lsmsg.SetFlag(apc.LsObjCached)
+	}
+
 	// default props & flags => user-provided message
 	switch {
 	case lsmsg.Props == "":
@@ -1576,6 +1812,24 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, smap
 	if err != nil {
 		return nil, err
 	}
+	if !listRemote && lsmsg.PageSize == 0 {
+		lsmsg.PageSize = apc.DefaultPageSizeAIS
+	}
+	if lsmsg.PageSize > 0 { // (0 when listing remote: the backend's max)
+		lsmsg.PageSize = p.lsoPageSize(lsmsg.PageSize, smap)
+	}
+	if lsmsg.IsFlagSet(apc.LsHead) {
+		if !listRemote {
+			return p.lsHead(bck, lsmsg, smap)
+		}
+		// remote: the first page from the backend (below) - already best-effort and early-exit
+		lsmsg.ClearFlag(apc.LsHead)
+		lst, err = p.lsObjsR(bck, lsmsg, smap, tsi, cmn.GCO.Get(), wantOnlyRemote)
+		if lst != nil {
+			lst.ContinuationToken = ""
+		}
+		return lst, err
+	}
 	if newls {
 		if wantOnlyRemote {
 			nl = xact.NewXactNL(lsmsg.UUID, apc.ActList, &smap.Smap, meta.NodeMap{tsi.ID(): tsi}, bck.Bucket())
@@ -1620,6 +1874,41 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, smap
 	return lst, err
 }
 
+// conservative estimate of the memory footprint of a single list-objects entry (name, props, and overhead)
+const lsoEntrySize = 512
+
+// Cut down the requested page size to fit the configured limit (cmn.LsoConf) that depends on
+// the current memory pressure. In addition, the proxy merges up to page-size entries from each
+// target - all of which must fit into a fraction (1/4) of the free memory.
+// In both cases, the result is a smaller page with continuation token.
+func (p *proxy) lsoPageSize(pageSize uint, smap *smapX) uint {
+	var (
+		mem    sys.MemStat
+		config = cmn.GCO.Get()
+		limit  = config.Lso.MaxPageSize
+	)
+	_ = mem.Get()
+	switch p.gmm.Pressure(&mem) {
+	case memsys.PressureHigh:
+		limit = config.Lso.MaxPageSizeHigh
+	case memsys.PressureExtreme, memsys.OOM:
+		limit = config.Lso.MaxPageSizeExtreme
+	}
+	if mem.ActualFree > 0 {
+		nt := uint64(max(smap.CountActiveTs(), 1))
+		if n := mem.ActualFree / 4 / (nt * lsoEntrySize); n < uint64(limit) {
+			limit = max(uint(n), config.Lso.MaxPageSizeExtreme)
+		}
+	}
+	if pageSize <= limit {
+		return pageSize
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(lsotag, "page size", pageSize, "=>", limit, "["+p.gmm.Str(&mem)+"]")
+	}
+	return limit
+}
+
 // list-objects flow control helper
 func (p *proxy) _lsofc(bck *meta.Bck, lsmsg *apc.LsoMsg, smap *smapX) (tsi *meta.Snode, listRemote, wantOnlyRemote bool, err error) {
 	listRemote = bck.IsRemote() && !lsmsg.IsFlagSet(apc.LsObjCached)
@@ -1672,8 +1961,16 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
-	if msg.Action == apc.ActRenameObject {
+	switch msg.Action {
+	case apc.ActRenameObject:
 		apireq.after = 2
+	case apc.ActMptStart, apc.ActMptStatus, apc.ActMptComplete, apc.ActMptAbort:
+		apireq.after = 2
+		if err := p.parseReq(w, r, apireq); err != nil {
+			return
+		}
+		p.redirectMpt(w, r, apireq, msg)
+		return
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
 		return
@@ -1725,6 +2022,10 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := args.ValidatePatterns(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		var tsi *meta.Snode
 		if args.DaemonID != "" {
 			smap := p.owner.smap.get()
@@ -1872,6 +2173,7 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 		propsToUpdate cmn.BpropsToSet
 		xid           string
 		nprops        *cmn.Bprops // complete instance of bucket props with propsToUpdate changes
+		accUpdate     *apc.AccessUpdate
 	)
 	if err = p.parseReq(w, r, apireq); err != nil {
 		return
@@ -1879,7 +2181,18 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 	if msg, err = p.readActionMsg(w, r); err != nil {
 		return
 	}
-	if err := cos.MorphMarshal(msg.Value, &propsToUpdate); err != nil {
+	if msg.Action == apc.ActUpdateAccess {
+		accUpdate = &apc.AccessUpdate{}
+		if err := cos.MorphMarshal(msg.Value, accUpdate); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := accUpdate.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		msg.Value = accUpdate
+	} else if err := cos.MorphMarshal(msg.Value, &propsToUpdate); err != nil {
 		p.writeErrMsg(w, r, "invalid props-to-update value in apireq: "+msg.String())
 		return
 	}
@@ -1888,7 +2201,7 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 	perms := apc.AcePATCH
-	if propsToUpdate.Access != nil {
+	if propsToUpdate.Access != nil || accUpdate != nil {
 		perms |= apc.AceBckSetACL
 	}
 	bckArgs := bctx{p: p, w: w, r: r, bck: bck, msg: msg, skipBackend: true,
@@ -1897,10 +2210,15 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 	if bck, err = bckArgs.initAndTry(); err != nil {
 		return
 	}
-	if err = _checkAction(msg, apc.ActSetBprops, apc.ActResetBprops); err != nil {
+	if err = _checkAction(msg, apc.ActSetBprops, apc.ActResetBprops, apc.ActUpdateAccess); err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
+	if accUpdate != nil {
+		// (to validate; the primary recomputes it from the current value when updating BMD)
+		access := accUpdate.Apply(bck.Props.Access)
+		propsToUpdate.Access = &access
+	}
 	// make and validate new props
 	if nprops, err = p.makeNewBckProps(bck, &propsToUpdate); err != nil {
 		p.writeErr(w, r, err)
@@ -1922,6 +2240,26 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if id := nprops.ETL.ID; id != "" && id != bck.Props.ETL.ID {
+		// default ETL must be initialized (and remain so - see _deleteETLPre)
+		if p.owner.etl.get().get(id) == nil {
+			p.writeErrf(w, r, "%s: cannot set default ETL (etl.id): %v", bck, cos.NewErrNotFound(p, "etl job "+id))
+			return
+		}
+	}
+	if nprops.Replication.Enabled {
+		// replication destination (remote ais bucket) must exist - add it to BMD if need be
+		dstBck, err := nprops.Replication.DstBck()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		args := bctx{p: p, w: w, r: r, bck: meta.CloneBck(&dstBck), msg: msg, dpq: apireq.dpq, query: apireq.query}
+		args.createAIS = false
+		if _, err = args.initAndTry(); err != nil {
+			return
+		}
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -1991,8 +2329,10 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 	var (
 		bmd     = p.owner.bmd.get()
 		present bool
+		// plus all attached remote ais clusters - always via target (see apc.QparamRemAisAll)
+		remAisAll = cos.IsParseBool(dpq.remAisAll) && qbck.Ns.IsGlobal() && (qbck.Provider == "" || qbck.Provider == apc.AIS)
 	)
-	if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsHDFS() {
+	if (qbck.IsAIS() || qbck.IsHTTP() || qbck.IsHDFS()) && !remAisAll {
 		bcks := bmd.Select(qbck)
 		p.writeJSON(w, r, bcks, "list-buckets")
 		return
@@ -2004,7 +2344,7 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 			present = apc.IsFltPresent(v)
 		}
 	}
-	if present {
+	if present && !remAisAll {
 		bcks := bmd.Select(qbck)
 		p.writeJSON(w, r, bcks, "list-buckets")
 		return
@@ -2042,6 +2382,9 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 	hdr := w.Header()
 	hdr.Set(cos.HdrContentType, res.header.Get(cos.HdrContentType))
 	hdr.Set(cos.HdrContentLength, strconv.Itoa(len(res.bytes)))
+	if s := res.header.Get(apc.HdrRemAisErrs); s != "" {
+		hdr.Set(apc.HdrRemAisErrs, s)
+	}
 	_, err = w.Write(res.bytes)
 	debug.AssertNoErr(err)
 }
@@ -2071,7 +2414,7 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIn
 			nodeURL = si.URL(netPub)
 		}
 	}
-	redirect = nodeURL + r.URL.Path + "?"
+	redirect = nodeURL + r.URL.EscapedPath() + "?"
 	if r.URL.RawQuery != "" {
 		redirect += r.URL.RawQuery + "&"
 	}
@@ -2080,6 +2423,9 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIn
 		apc.QparamProxyID:  []string{p.SID()},
 		apc.QparamUnixTime: []string{cos.UnixNano2S(ts.UnixNano())},
 	}
+	if id := r.Header.Get(cos.HdrReqID); id != "" {
+		query.Set(apc.QparamReqID, id) // in case the client does not resend (or did not send) the header
+	}
 	redirect += query.Encode()
 	return
 }
@@ -2188,6 +2534,41 @@ end:
 	return allEntries, nil
 }
 
+// "head of bucket" (apc.LsHead): each target returns up to page-size objects it happens to find
+// first; concatenate (unordered) and trim to page size - no list-objects xaction, no merge-sort
+func (p *proxy) lsHead(bck *meta.Bck, lsmsg *apc.LsoMsg, smap *smapX) (*cmn.LsoResult, error) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(p.newAmsgActVal(apc.ActList, &lsmsg)),
+	}
+	args.timeout = apc.LongTimeout
+	args.smap = smap
+	args.cresv = cresLso{} // -> cmn.LsoResult
+
+	var (
+		results = p.bcastGroup(args)
+		lst     = &cmn.LsoResult{UUID: lsmsg.UUID}
+		n       = int(lsmsg.PageSize)
+	)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return nil, err
+		}
+		if l := len(lst.Entries); l < n {
+			entries := res.v.(*cmn.LsoResult).Entries
+			lst.Entries = append(lst.Entries, entries[:min(len(entries), n-l)]...)
+		}
+	}
+	freeBcastRes(results)
+	return lst, nil
+}
+
 func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, smap *smapX, tsi *meta.Snode, config *cmn.Config,
 	wantOnlyRemote bool) (*cmn.LsoResult, error) {
 	aisMsg := p.newAmsgActVal(apc.ActList, &lsmsg)
@@ -2272,6 +2653,49 @@ func (p *proxy) redirectObjAction(w http.ResponseWriter, r *http.Request, bck *m
 	p.statsT.Inc(stats.RenameCount)
 }
 
+// multipart upload: start at the HRW target; thereafter, the upload ID determines the target
+func (p *proxy) redirectMpt(w http.ResponseWriter, r *http.Request, apireq *apiRequest, msg *apc.ActMsg) {
+	bckArgs := allocBctx()
+	{
+		bckArgs.p = p
+		bckArgs.w = w
+		bckArgs.r = r
+		bckArgs.perms = apc.AcePUT
+		bckArgs.createAIS = false
+	}
+	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
+	bck, err := bckArgs.initAndTry()
+	freeBctx(bckArgs)
+	if err != nil {
+		return
+	}
+	var (
+		tsi     *meta.Snode
+		smap    = p.owner.smap.get()
+		objName = apireq.items[1]
+		started = time.Now()
+	)
+	if msg.Action == apc.ActMptStart {
+		tsi, err = smap.HrwName2T(bck.MakeUname(objName))
+	} else {
+		var tid string
+		if tid, err = mptTargetID(msg.Name); err == nil {
+			if tsi = smap.GetTarget(tid); tsi == nil {
+				err = &errNodeNotFound{msg.Action + " failure:", tid, p.si, smap}
+			}
+		}
+	}
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infof("%q %s => %s", msg.Action, bck.Cname(objName), tsi.StringEx())
+	}
+	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraControl)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
 func (p *proxy) listrange(method, bucket string, msg *apc.ActMsg, query url.Values) (xid string, err error) {
 	var (
 		smap   = p.owner.smap.get()
@@ -2345,7 +2769,11 @@ func (p *proxy) reverseHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
 		// must be consistent with httpdaeget, httpcluget
-		err = p.checkAccess(w, r, nil, apc.AceShowCluster)
+		if r.URL.Query().Get(apc.QparamWhat) == apc.WhatProfile {
+			err = p.checkAccess(w, r, nil, apc.AceAdmin)
+		} else {
+			err = p.checkAccess(w, r, nil, apc.AceShowCluster)
+		}
 	case http.MethodPost:
 		// (ditto) httpdaepost, httpclupost
 		err = p.checkAccess(w, r, nil, apc.AceAdmin)
@@ -2444,8 +2872,13 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			p.handlePendingRenamedLB(renamedBucket)
 		}
 		fallthrough // fallthrough
-	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog,
-		apc.WhatNodeStats, apc.WhatMetricNames:
+	case apc.WhatNodeConfig, apc.WhatNodeOverride, apc.WhatNodeComputed, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog,
+		apc.WhatNodeStats, apc.WhatMetricNames, apc.WhatSLO:
+		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
+	case apc.WhatProfile:
+		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+			return
+		}
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 	case apc.WhatSysInfo:
 		p.writeJSON(w, r, apc.GetMemCPU(), what)
@@ -5,17 +5,19 @@
 package ais
 
 import (
+	"crypto/ecdh"
+	"crypto/rand"
 	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
-	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
@@ -67,13 +69,12 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 		Version: a.version,
 	}
 	var (
-		now    = time.Now()
-		secret = cmn.GCO.Get().Auth.Secret
+		now  = time.Now()
+		auth = &cmn.GCO.Get().Auth
 	)
 	for token := range a.revokedTokens {
-		tk, err := tok.DecryptToken(token, secret)
-		debug.AssertNoErr(err)
-		if tk.Expires.Before(now) {
+		tk, err := tok.DecryptToken(token, auth.Secret, auth.PrevSecret)
+		if err != nil || tk.Expires.Before(now) { // (signed with a no longer valid secret or expired)
 			delete(a.revokedTokens, token)
 		} else {
 			allRevoked.Tokens = append(allRevoked.Tokens, token)
@@ -124,10 +125,10 @@ func (a *authManager) validateAddRm(token string, now time.Time) (*tok.Token, er
 	tk, ok := a.tkList[token]
 	if !ok || tk == nil {
 		var (
-			err    error
-			secret = cmn.GCO.Get().Auth.Secret
+			err  error
+			auth = &cmn.GCO.Get().Auth
 		)
-		if tk, err = tok.DecryptToken(token, secret); err != nil {
+		if tk, err = tok.DecryptToken(token, auth.Secret, auth.PrevSecret); err != nil {
 			nlog.Errorln(err)
 			return nil, tok.ErrInvalidToken
 		}
@@ -163,10 +164,12 @@ func (p *proxy) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodPost:
 		p.validateSecret(w, r)
+	case http.MethodPut:
+		p.httpTokenPut(w, r)
 	case http.MethodDelete:
 		p.httpTokenDelete(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost, http.MethodPut)
 	}
 }
 
@@ -187,6 +190,30 @@ func (p *proxy) validateSecret(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// AuthN rotated its secret: the new one is sealed with the (current) old one
+func (p *proxy) httpTokenPut(w http.ResponseWriter, r *http.Request) {
+	if _, err := p.parseURL(w, r, apc.URLPathTokens.L, 0, false); err != nil {
+		return
+	}
+	if p.forwardCP(w, r, nil, "rotate secret") {
+		return
+	}
+	sealed := &authn.SealedSecret{}
+	if err := cmn.ReadJSON(w, r, sealed); err != nil {
+		return
+	}
+	prev := cmn.GCO.Get().Auth.Secret
+	secret, err := sealed.OpenSym(prev)
+	if err != nil {
+		p.writeErrf(w, r, "%s: failed to open rotated AuthN secret (re-enrollment required?): %v", p, err)
+		return
+	}
+	msg := &apc.ActMsg{Action: apc.ActSetConfig, Name: "auth.secret"}
+	if err := p.setAuthSecret(secret, prev, msg); err != nil {
+		p.writeErr(w, r, err)
+	}
+}
+
 func (p *proxy) httpTokenDelete(w http.ResponseWriter, r *http.Request) {
 	if _, err := p.parseURL(w, r, apc.URLPathTokens.L, 0, false); err != nil {
 		return
@@ -205,6 +232,81 @@ func (p *proxy) httpTokenDelete(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// register with AuthN (see api/authn/enroll.go)
+func (p *proxy) enrollAuthN(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var args apc.ActValEnrollAuthN
+	if err := cos.MorphMarshal(msg.Value, &args); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if args.URL == "" || args.Token == "" {
+		p.writeErrf(w, r, "%s: AuthN URL and enrollment token must be specified", p)
+		return
+	}
+	priv, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	var (
+		config = cmn.GCO.Get()
+		smap   = p.owner.smap.get()
+		emsg   = &authn.EnrollMsg{
+			Token:     args.Token,
+			ClusterID: smap.UUID,
+			Alias:     args.Alias,
+			URLs:      make([]string, 0, smap.CountActivePs()),
+			PubKey:    priv.PublicKey().Bytes(),
+		}
+		cargs  = cmn.TransportArgs{Timeout: config.Client.Timeout.D()}
+		client *http.Client
+	)
+	emsg.URLs = append(emsg.URLs, p.si.URL(cmn.NetPublic))
+	for _, psi := range smap.Pmap {
+		if psi.ID() != p.SID() && !psi.InMaintOrDecomm() {
+			emsg.URLs = append(emsg.URLs, psi.URL(cmn.NetPublic))
+		}
+	}
+	if cos.IsHTTPS(args.URL) {
+		client = cmn.NewClientTLS(cargs, config.Net.HTTP.ToTLS())
+	} else {
+		client = cmn.NewClient(cargs)
+	}
+	bp := api.BaseParams{Client: client, URL: args.URL, UA: ua}
+	sealed, err := authn.Enroll(bp, emsg)
+	if err != nil {
+		p.writeErrf(w, r, "%s: failed to enroll with AuthN at %s: %v", p, args.URL, err)
+		return
+	}
+	secret, err := sealed.Open(priv)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(p.String(), "enrolled with AuthN at", args.URL)
+
+	msg.Value = nil // (do not metasync the token)
+	prev := config.Auth.Secret
+	if prev == secret {
+		prev = config.Auth.PrevSecret
+	}
+	if err := p.setAuthSecret(secret, prev, msg); err != nil {
+		p.writeErr(w, r, err)
+	}
+}
+
+func (p *proxy) setAuthSecret(secret, prev string, msg *apc.ActMsg) error {
+	ctx := &configModifier{
+		pre:      _setConfPre,
+		final:    p._syncConfFinal,
+		msg:      msg,
+		toUpdate: &cmn.ConfigToSet{Auth: &cmn.AuthConfToSet{Secret: &secret, PrevSecret: &prev}},
+		wait:     true,
+	}
+	_, err := p.owner.config.modify(ctx)
+	return err
+}
+
 // Validates a token from the request header
 func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 	token, err := tok.ExtractToken(hdr)
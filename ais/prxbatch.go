@@ -0,0 +1,71 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// broadcast apc.ActBatchObjOps and merge per-target results back into the request order
+// (atomicity is per target - see tgtbatch.go)
+func (p *proxy) batchObjOps(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg, bmsg *apc.BatchOpsMsg) {
+	var (
+		q    = make(url.Values, 4)
+		args = allocBcArgs()
+	)
+	msg.Value = bmsg
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPost,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Body:   cos.MustMarshal(p.newAmsg(msg, nil)),
+	}
+	args.smap = p.owner.smap.get()
+	bck.AddToQuery(q)
+	args.req.Query = q
+	args.cresv = cresBatch{} // -> apc.BatchOpsResult
+
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var (
+		out  = &apc.BatchOpsResult{Items: make([]apc.BatchOpResult, len(bmsg.Ops))}
+		done = make([]bool, len(bmsg.Ops))
+		terr error
+	)
+	for _, res := range results {
+		if res.err != nil {
+			terr = res.toErr()
+			continue
+		}
+		for _, item := range res.v.(*apc.BatchOpsResult).Items {
+			if item.Idx < 0 || item.Idx >= len(out.Items) {
+				continue
+			}
+			out.Items[item.Idx], done[item.Idx] = item, true
+		}
+	}
+	freeBcastRes(results)
+
+	// items owned by the targets that failed to respond (or changed ownership mid-flight)
+	for i := range out.Items {
+		if done[i] {
+			continue
+		}
+		item := &out.Items[i]
+		item.ObjName, item.Idx, item.Status = bmsg.Ops[i].ObjName, i, http.StatusServiceUnavailable
+		if terr != nil {
+			item.Err = terr.Error()
+		} else {
+			item.Err = "not processed by any target"
+		}
+	}
+	p.writeJSON(w, r, out, apc.ActBatchObjOps)
+}
@@ -5,25 +5,38 @@
 package ais
 
 import (
+	"fmt"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
 )
 
 // in this source:
 // - bsummact  <= api.GetBucketSummary(query-bcks, ActMsg)
+// - bsummStream <= api.GetBucketSummaryStream(query-bcks, ActMsg)
 // - bsummhead <= api.GetBucketInfo(bck, QparamBsummRemote)
+// - heatmap <= api.GetBucketHeatmap(bck, HeatmapMsg)
+// - sample <= api.SampleObjects(bck, SampleMsg)
 
 func (p *proxy) bsummact(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) {
 	news := msg.UUID == ""
 	debug.Assert(msg.UUID == "" || cos.IsValidUUID(msg.UUID), msg.UUID)
 
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamBsummStream)) {
+		p.bsummStream(w, r, qbck, msg)
+		return
+	}
+
 	// start new
 	if news {
 		err := p.bsummNew(qbck, msg)
@@ -76,32 +89,9 @@ func (p *proxy) bsummNew(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (err error)
 }
 
 func (p *proxy) bsummCollect(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (_ cmn.AllBsummResults, status int, _ error) {
-	var (
-		q      = make(url.Values, 4)
-		aisMsg = p.newAmsgActVal(apc.ActSummaryBck, msg)
-		args   = allocBcArgs()
-	)
-	args.req = cmn.HreqArgs{
-		Method: http.MethodGet,
-		Path:   apc.URLPathBuckets.Join(qbck.Name, apc.ActQuery),
-		Body:   cos.MustMarshal(aisMsg),
-	}
-	args.smap = p.owner.smap.get()
-	if cnt := args.smap.CountActiveTs(); cnt < 1 {
-		return nil, 0, cmn.NewErrNoNodes(apc.Target, args.smap.CountTargets())
-	}
-	qbck.AddToQuery(q)
-	q.Set(apc.QparamSilent, "true")
-	args.req.Query = q
-	args.cresv = cresBsumm{} // -> cmn.AllBsummResults
-
-	results := p.bcastGroup(args)
-	freeBcArgs(args)
-	for _, res := range results {
-		if res.err != nil {
-			freeBcastRes(results)
-			return nil, 0, res.toErr()
-		}
+	results, err := p.bsummQuery(qbck, msg)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	var (
@@ -138,6 +128,139 @@ func (p *proxy) bsummCollect(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (_ cmn.
 	return summaries, status, nil
 }
 
+// query all targets for (partial or final) results of the bucket-summary job `msg.UUID`
+// (caller must free the results)
+func (p *proxy) bsummQuery(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (sliceResults, error) {
+	var (
+		q      = make(url.Values, 4)
+		aisMsg = p.newAmsgActVal(apc.ActSummaryBck, msg)
+		args   = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(qbck.Name, apc.ActQuery),
+		Body:   cos.MustMarshal(aisMsg),
+	}
+	args.smap = p.owner.smap.get()
+	if cnt := args.smap.CountActiveTs(); cnt < 1 {
+		freeBcArgs(args)
+		return nil, cmn.NewErrNoNodes(apc.Target, args.smap.CountTargets())
+	}
+	qbck.AddToQuery(q)
+	q.Set(apc.QparamSilent, "true")
+	args.req.Query = q
+	args.cresv = cresBsumm{} // -> cmn.AllBsummResults
+
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// Stream per-target results as they arrive, one JSON-encoded `cmn.BsummStreamResult` per line,
+// until all targets are done (or the client goes away, or 'client.client_long_timeout' expires).
+// Errors that occur after the response status has already been sent are reported in-band
+// (see `cmn.BsummStreamResult.Err`).
+func (p *proxy) bsummStream(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) {
+	if msg.UUID == "" {
+		if err := p.bsummNew(qbck, msg); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Header().Set(apc.HdrXactionID, msg.UUID)
+	w.WriteHeader(http.StatusOK)
+
+	var (
+		rc       = http.NewResponseController(w)
+		latest   = make(map[string]cmn.AllBsummResults, 8) // per target
+		done     = make(map[string]bool, 8)
+		sleep    = xact.MinPollTime / 2
+		timeout  = cmn.GCO.Get().Client.TimeoutLong.D()
+		deadline = mono.NanoTime() + int64(timeout)
+	)
+	for {
+		time.Sleep(sleep)
+		results, err := p.bsummQuery(qbck, msg)
+		if err != nil {
+			p.bsummWrite(w, &cmn.BsummStreamResult{Err: err.Error()})
+			return
+		}
+		var numDone int
+		for _, res := range results {
+			tid := res.si.ID()
+			if done[tid] {
+				numDone++
+				continue
+			}
+			if res.status != http.StatusOK && res.status != http.StatusPartialContent {
+				continue // accepted (nothing yet)
+			}
+			tres := &cmn.BsummStreamResult{TID: tid, Summaries: *res.v.(*cmn.AllBsummResults)}
+			if res.status == http.StatusOK {
+				tres.Done = true
+				done[tid] = true
+				numDone++
+			}
+			latest[tid] = tres.Summaries
+			tres.Total = bsummTotal(latest)
+			if err := p.bsummWrite(w, tres); err != nil {
+				freeBcastRes(results)
+				return // client gone
+			}
+		}
+		last := numDone == len(results)
+		freeBcastRes(results)
+		if last {
+			return
+		}
+		if mono.NanoTime() > deadline {
+			// (the job itself keeps running - see bsummCollect)
+			err := fmt.Errorf("%s[%s]: timed out after %v - query the results by job ID", apc.ActSummaryBck, msg.UUID, timeout)
+			p.bsummWrite(w, &cmn.BsummStreamResult{Err: err.Error()})
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		default:
+		}
+	}
+}
+
+func (*proxy) bsummWrite(w http.ResponseWriter, tres *cmn.BsummStreamResult) error {
+	b := cos.MustMarshal(tres)
+	_, err := w.Write(append(b, '\n'))
+	return err
+}
+
+// aggregate (copies of) the most recent per-target results
+func bsummTotal(latest map[string]cmn.AllBsummResults) cmn.AllBsummResults {
+	var (
+		total = make(cmn.AllBsummResults, 0, 8)
+		dsize = make(map[string]uint64, len(latest))
+	)
+	for tid, summaries := range latest {
+		for _, summ := range summaries {
+			clone := *summ
+			dsize[tid] = summ.TotalSize.Disks
+			total = total.Aggregate(&clone)
+		}
+	}
+	total.Finalize(dsize, cmn.Rom.TestingEnv())
+	return total
+}
+
 // fully reuse bsummact impl.
 func (p *proxy) bsummhead(bck *meta.Bck, msg *apc.BsummCtrlMsg) (info *cmn.BsummResult, status int, err error) {
 	var (
@@ -156,3 +279,101 @@ func (p *proxy) bsummhead(bck *meta.Bck, msg *apc.BsummCtrlMsg) (info *cmn.Bsumm
 	}
 	return
 }
+
+// aggregate per-target access statistics (see feat.TrackObjectAccess)
+func (p *proxy) heatmap(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.HeatmapMsg) {
+	var (
+		q    = make(url.Values, 4)
+		args = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(qbck.Name),
+		Body:   cos.MustMarshal(p.newAmsgActVal(apc.ActHeatmapBck, msg)),
+	}
+	args.smap = p.owner.smap.get()
+	qbck.AddToQuery(q)
+	args.req.Query = q
+	args.cresv = cresHeat{} // -> apc.Heatmap
+
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	heatmap := &apc.Heatmap{}
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		heatmap.Merge(res.v.(*apc.Heatmap), msg.TopN)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, heatmap, apc.ActHeatmapBck)
+}
+
+// merge per-target samples into a single uniform sample of the requested size
+func (p *proxy) sample(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.SampleMsg) {
+	var (
+		q    = make(url.Values, 4)
+		args = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(qbck.Name),
+		Body:   cos.MustMarshal(p.newAmsgActVal(apc.ActSampleBck, msg)),
+	}
+	args.smap = p.owner.smap.get()
+	qbck.AddToQuery(q)
+	args.req.Query = q
+	args.cresv = cresSmpl{} // -> apc.Sample
+
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	samples := make([]*apc.Sample, 0, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		samples = append(samples, res.v.(*apc.Sample))
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, mergeSamples(samples, msg.Size), apc.ActSampleBck)
+}
+
+// Each target returns a uniform random sample (in random order) of its own objects,
+// along with the total number of those. To draw from the union without replacement,
+// pick the next target with probability proportional to its remaining (not yet drawn)
+// objects and take its next sampled name.
+func mergeSamples(samples []*apc.Sample, size int) *apc.Sample {
+	var (
+		out    = &apc.Sample{}
+		remain = make([]int64, len(samples))
+		next   = make([]int, len(samples))
+	)
+	for i, s := range samples {
+		remain[i] = s.Total
+		out.Total += s.Total
+	}
+	size = int(min(int64(size), out.Total))
+	out.Names = make([]string, 0, size)
+	for left := out.Total; left > 0 && len(out.Names) < size; left-- {
+		x := rand.Int63n(left)
+		for i := range samples {
+			if x >= remain[i] {
+				x -= remain[i]
+				continue
+			}
+			remain[i]--
+			if next[i] < len(samples[i].Names) { // (always true when the target sampled min(size, total))
+				out.Names = append(out.Names, samples[i].Names[next[i]])
+				next[i]++
+			}
+			break
+		}
+	}
+	return out
+}
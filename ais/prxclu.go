@@ -956,16 +956,13 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
-		query := r.URL.Query()
-		if transient := cos.IsParseBool(query.Get(apc.ActTransient)); transient {
-			p.setCluCfgTransient(w, r, toUpdate, msg)
-		} else {
-			p.setCluCfgPersistent(w, r, toUpdate, msg)
-		}
+		p.setCluCfg(w, r, toUpdate, msg, r.URL.Query())
 	case apc.ActResetConfig:
 		p.resetCluCfgPersistent(w, r, msg)
 	case apc.ActRotateLogs:
 		p.rotateLogs(w, r, msg)
+	case apc.ActEnrollAuthN:
+		p.enrollAuthN(w, r, msg)
 
 	case apc.ActShutdownCluster:
 		args := allocBcArgs()
@@ -1015,10 +1012,162 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// set-config: first, validate the update on all affected nodes; then, unless staging (apc.QparamStage),
+// apply it cluster-wide or - when scoped (apc.QparamApplyTo) - as node-local overrides
+func (p *proxy) setCluCfg(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToSet, msg *apc.ActMsg, query url.Values) {
+	var (
+		applyTo   = query.Get(apc.QparamApplyTo)
+		scoped    = applyTo != "" && applyTo != apc.Cluster
+		transient = cos.IsParseBool(query.Get(apc.ActTransient))
+		smap      = p.owner.smap.get()
+	)
+	nodes, self, err := p.cfgNodes(smap, applyTo)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	staged, err := p.stageCfg(toUpdate, nodes, self, scoped, smap)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cos.IsParseBool(query.Get(apc.QparamStage)) {
+		p.writeJSON(w, r, staged, "stage-config")
+		return
+	}
+	switch {
+	case scoped:
+		p.setScopedCfg(w, r, toUpdate, nodes, self, transient, smap)
+	case transient:
+		p.setCluCfgTransient(w, r, toUpdate, msg)
+	default:
+		p.setCluCfgPersistent(w, r, toUpdate, msg)
+	}
+}
+
+// active nodes to update (other than self), and whether self is one of them
+func (p *proxy) cfgNodes(smap *smapX, applyTo string) (nodes meta.Nodes, self bool, err error) {
+	add := func(nm meta.NodeMap) {
+		for _, si := range nm {
+			switch {
+			case si.InMaintOrDecomm():
+			case si.ID() == p.SID():
+				self = true
+			default:
+				nodes = append(nodes, si)
+			}
+		}
+	}
+	switch applyTo {
+	case "", apc.Cluster:
+		add(smap.Pmap)
+		add(smap.Tmap)
+	case apc.ApplyToProxies:
+		add(smap.Pmap)
+	case apc.ApplyToTargets:
+		add(smap.Tmap)
+	default:
+		si := smap.GetActiveNode(applyTo)
+		if si == nil {
+			return nil, false, &errNodeNotFound{"set-config:", applyTo, p.si, smap}
+		}
+		add(meta.NodeMap{si.ID(): si})
+	}
+	return nodes, self, nil
+}
+
+// validate the update on each of the specified nodes and collect the resulting changes
+func (p *proxy) stageCfg(toUpdate *cmn.ConfigToSet, nodes meta.Nodes, self, scoped bool, smap *smapX) (cmn.StagedConfig, error) {
+	staged := make(cmn.StagedConfig, len(nodes)+1)
+	if self {
+		changes, err := stageConfig(toUpdate, !scoped)
+		if err != nil {
+			return nil, cmn.NewErrFailedTo(p, "validate", "config update", err)
+		}
+		if len(changes) > 0 {
+			staged[p.SID()] = changes
+		}
+	}
+	if len(nodes) == 0 {
+		return staged, nil
+	}
+	query := url.Values{apc.QparamStage: []string{"true"}}
+	if !scoped {
+		query.Set(apc.QparamApplyTo, apc.Cluster)
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPut,
+		Path:   apc.URLPathDae.S,
+		Body:   cos.MustMarshal(&apc.ActMsg{Action: apc.ActSetConfig, Value: toUpdate}),
+		Query:  query,
+	}
+	args.selected = nodes
+	args.nodeCount = len(nodes)
+	args.smap = smap
+	results := p.bcastSelected(args)
+	freeBcArgs(args)
+
+	var err error
+	for _, res := range results {
+		if res.err != nil {
+			err = res.toErr()
+			break
+		}
+		var changes []cmn.ConfigChange
+		if err = jsoniter.Unmarshal(res.bytes, &changes); err != nil {
+			break
+		}
+		if len(changes) > 0 {
+			staged[res.si.ID()] = changes
+		}
+	}
+	freeBcastRes(results)
+	return staged, err
+}
+
+// scoped update: node-local overrides (compare with `ais config node`)
+func (p *proxy) setScopedCfg(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToSet, nodes meta.Nodes, self, transient bool,
+	smap *smapX) {
+	if self {
+		if err := p.owner.config.setDaemonConfig(toUpdate, transient); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	if len(nodes) == 0 {
+		return
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPut,
+		Path:   apc.URLPathDae.S,
+		Body:   cos.MustMarshal(&apc.ActMsg{Action: apc.ActSetConfig, Value: toUpdate}),
+	}
+	if transient {
+		args.req.Query = url.Values{apc.ActTransient: []string{"true"}}
+	}
+	args.selected = nodes
+	args.nodeCount = len(nodes)
+	args.smap = smap
+	results := p.bcastSelected(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			break
+		}
+	}
+	freeBcastRes(results)
+}
+
+// all-or-nothing: when any of the (active) nodes rejects the update the primary rolls back
+// (metasyncs) the prior cluster config and fails the request; nodes that are unreachable
+// (transport failures) do not trigger rollback - metasync keeps retrying them instead
 func (p *proxy) setCluCfgPersistent(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToSet, msg *apc.ActMsg) {
 	ctx := &configModifier{
 		pre:      _setConfPre,
-		final:    p._syncConfFinal,
+		final:    p._syncConfCnt,
 		msg:      msg,
 		toUpdate: toUpdate,
 		wait:     true,
@@ -1047,10 +1196,63 @@ func (p *proxy) setCluCfgPersistent(w http.ResponseWriter, r *http.Request, toUp
 		whingeToUpdate("config.auth", string(from), string(to))
 	}
 
+	replace := placementChanged(toUpdate.Rebalance, &cmn.GCO.Get().Rebalance)
+
 	// do
 	if _, err := p.owner.config.modify(ctx); err != nil {
 		p.writeErr(w, r, err)
+		return
+	}
+	if ctx.rejectCnt > 0 {
+		err := fmt.Errorf("%d node%s rejected the update", ctx.rejectCnt, cos.Plural(ctx.rejectCnt))
+		if errR := p.rollbackCluCfg(ctx); errR != nil {
+			err = fmt.Errorf("%v; failed to roll back: %v", err, errR)
+		} else {
+			err = fmt.Errorf("%v - rolled back", err)
+		}
+		nlog.Errorln(p.String()+":", err)
+		p.writeErr(w, r, cmn.NewErrFailedTo(p, "update", "cluster config", err), http.StatusServiceUnavailable)
+		return
+	}
+	if n := ctx.failedCnt; n > 0 {
+		nlog.Warningln(p.String()+":", n, "node"+cos.Plural(n), "unreachable - cluster config update pending (will retry)")
+	}
+	if replace {
+		p.rebalancePlacement()
+	}
+}
+
+// capacity weights and/or partitions
+func placementChanged(toUpdate *cmn.RebalanceConfToSet, config *cmn.RebalanceConf) bool {
+	if toUpdate == nil {
+		return false
+	}
+	return (toUpdate.CapWeights != nil && *toUpdate.CapWeights != config.CapWeights) ||
+		(toUpdate.Partitions != nil && *toUpdate.Partitions != config.Partitions)
+}
+
+// updating capacity weights or partitions changes the placement of (existing) objects - rebalance
+// to re-place them (see meta.Smap.HrwHash2T)
+func (p *proxy) rebalancePlacement() {
+	if err := p.canRebalance(); err != nil {
+		nlog.Warningln(p.String()+": object placement updated but cannot rebalance:", err, "- run rebalance manually")
+		return
+	}
+	smap := p.owner.smap.get()
+	if smap.CountActiveTs() < 2 {
+		return
 	}
+	rmdCtx := &rmdModifier{
+		pre:     rmdInc,
+		final:   rmdSync,
+		p:       p,
+		smapCtx: &smapModifier{smap: smap, msg: &apc.ActMsg{Action: apc.ActRebalance}},
+	}
+	if _, err := p.owner.rmd.modify(rmdCtx); err != nil {
+		nlog.Errorln(p.String()+": failed to rebalance upon updating object placement:", err)
+		return
+	}
+	nlog.Infoln(p.String()+": object placement updated - started rebalance", rmdCtx.rebID)
 }
 
 // switch http => https, or vice versa
@@ -1119,6 +1321,7 @@ func (p *proxy) setCluCfgTransient(w http.ResponseWriter, r *http.Request, toUpd
 }
 
 func _setConfPre(ctx *configModifier, clone *globalConfig) (updated bool, err error) {
+	ctx.prev = cos.MustMarshal(&clone.ClusterConfig)
 	if err = clone.Apply(ctx.toUpdate, apc.Cluster); err != nil {
 		return
 	}
@@ -1126,6 +1329,37 @@ func _setConfPre(ctx *configModifier, clone *globalConfig) (updated bool, err er
 	return
 }
 
+// restore prior values as a new (ie., higher) config version, so that
+// the nodes that did receive the update (and those still pending) converge
+func (p *proxy) rollbackCluCfg(ctx *configModifier) error {
+	rctx := &configModifier{
+		pre:   _rollbackConfPre,
+		final: p._syncConfFinal,
+		msg:   &apc.ActMsg{Action: apc.ActSetConfig, Name: "rollback"},
+		prev:  ctx.prev,
+		wait:  true,
+	}
+	_, err := p.owner.config.modify(rctx)
+	return err
+}
+
+func _rollbackConfPre(ctx *configModifier, clone *globalConfig) (bool, error) {
+	var (
+		prev    cmn.ClusterConfig
+		version = clone.Version
+	)
+	if err := jsoniter.Unmarshal(ctx.prev, &prev); err != nil {
+		return false, err
+	}
+	clone.ClusterConfig = prev
+	clone.Version = version // (runPre increments)
+	return true, nil
+}
+
+func (p *proxy) _syncConfCnt(ctx *configModifier, clone *globalConfig) {
+	ctx.failedCnt, ctx.rejectCnt = p.metasyncer.syncCnt(revsPair{clone, p.newAmsg(ctx.msg, nil)})
+}
+
 func (p *proxy) _syncConfFinal(ctx *configModifier, clone *globalConfig) {
 	wg := p.metasyncer.sync(revsPair{clone, p.newAmsg(ctx.msg, nil)})
 	if ctx.wait {
@@ -1150,6 +1384,9 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S}
+	if msg.ReqID != "" {
+		args.req.Header = http.Header{cos.HdrReqID: []string{msg.ReqID}}
+	}
 
 	switch {
 	case xargs.Kind == apc.ActBlobDl:
@@ -1620,11 +1857,7 @@ func (p *proxy) cluputQuery(w http.ResponseWriter, r *http.Request, action strin
 			p.writeErrf(w, r, err.Error())
 			return
 		}
-		if transient := cos.IsParseBool(query.Get(apc.ActTransient)); transient {
-			p.setCluCfgTransient(w, r, toUpdate, msg)
-		} else {
-			p.setCluCfgPersistent(w, r, toUpdate, msg)
-		}
+		p.setCluCfg(w, r, toUpdate, msg, query)
 	case apc.ActAttachRemAis, apc.ActDetachRemAis:
 		p.attachDetachRemAis(w, r, action, r.URL.Query())
 	}
@@ -0,0 +1,195 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// per-proxy client connection limits (see cmn.ProxyConf.MaxConns and MaxClientConns):
+// - track connections to the proxy's public endpoint: total and per client (IP address);
+// - a connection is classified upon its first request: intra-cluster connections
+//   (see fromNode) are neither counted nor limited;
+// - a client connection that would exceed either limit is rejected: the request fails
+//   with http.StatusServiceUnavailable and Retry-After, and the connection gets closed;
+// - when the limits are lowered at runtime, excess connections are drained: the current
+//   request is served as usual, and then the connection gets closed;
+// - stats: stats.ClientConnsGauge and the respective rejected and drained counters.
+// Note that all clients behind a NAT or load balancer share the same (per client) limit.
+
+const connlimRetryAfter = time.Second
+
+// connection state
+const (
+	connNew      = iota // no requests yet
+	connAdmitted        // counted
+	connExempt          // intra-cluster
+	connRejected
+	connDrained
+)
+
+type (
+	connInfo struct {
+		err   *errConnLimit // when rejected
+		ip    string
+		state int
+	}
+	connLimiter struct {
+		statsT stats.Tracker
+		conns  map[net.Conn]*connInfo
+		byIP   map[string]int // admitted, by client IP
+		total  int            // ditto, all clients
+		mu     sync.Mutex
+	}
+	errConnLimit struct {
+		what       string
+		ip         string
+		limit      int
+		retryAfter time.Duration
+	}
+
+	ctxConnInfo struct{} // context key
+)
+
+func (cl *connLimiter) init(p *proxy) {
+	cl.statsT = p.statsT
+	cl.conns = make(map[net.Conn]*connInfo, 64)
+	cl.byIP = make(map[string]int, 64)
+	g.netServ.pub.connlim = cl
+}
+
+// (http.Server.ConnContext)
+func (cl *connLimiter) connContext(ctx context.Context, c net.Conn) context.Context {
+	ci := &connInfo{}
+	if host, _, err := net.SplitHostPort(c.RemoteAddr().String()); err == nil {
+		ci.ip = host
+	}
+	cl.mu.Lock()
+	cl.conns[c] = ci
+	cl.mu.Unlock()
+	return context.WithValue(ctx, ctxConnInfo{}, ci)
+}
+
+// (http.Server.ConnState)
+func (cl *connLimiter) connState(c net.Conn, cs http.ConnState) {
+	if cs != http.StateClosed && cs != http.StateHijacked {
+		return
+	}
+	cl.mu.Lock()
+	if ci, ok := cl.conns[c]; ok {
+		delete(cl.conns, c)
+		if ci.state == connAdmitted {
+			cl._dec(ci.ip)
+		}
+	}
+	cl.mu.Unlock()
+}
+
+// returns nil when it's ok to proceed, with `drain` indicating that the connection
+// must be closed once the request is served; otherwise, returns errConnLimit
+func (cl *connLimiter) admit(ci *connInfo, intra bool, conf *cmn.ProxyConf) (drain bool, err *errConnLimit) {
+	cl.mu.Lock()
+	switch ci.state {
+	case connNew:
+		if intra {
+			ci.state = connExempt
+			break
+		}
+		switch {
+		case conf.MaxConns > 0 && cl.total >= conf.MaxConns:
+			ci.err = &errConnLimit{what: "max_conns", limit: conf.MaxConns, retryAfter: connlimRetryAfter}
+		case conf.MaxClientConns > 0 && cl.byIP[ci.ip] >= conf.MaxClientConns:
+			ci.err = &errConnLimit{what: "max_client_conns", ip: ci.ip, limit: conf.MaxClientConns,
+				retryAfter: connlimRetryAfter}
+		}
+		if ci.err != nil {
+			ci.state = connRejected
+			err = ci.err
+			break
+		}
+		ci.state = connAdmitted
+		cl.total++
+		cl.byIP[ci.ip]++
+		cl.statsT.Add(stats.ClientConnsGauge, int64(cl.total))
+	case connAdmitted:
+		if (conf.MaxConns > 0 && cl.total > conf.MaxConns) ||
+			(conf.MaxClientConns > 0 && cl.byIP[ci.ip] > conf.MaxClientConns) {
+			ci.state = connDrained
+			cl._dec(ci.ip)
+			cl.statsT.Inc(stats.ClientConnsDrained)
+			drain = true
+		}
+	case connRejected: // (HTTP/2: concurrent streams)
+		err = ci.err
+	case connDrained:
+		drain = true
+	}
+	cl.mu.Unlock()
+	if err != nil {
+		cl.statsT.Inc(stats.ClientConnsRejected)
+	}
+	return drain, err
+}
+
+// is called under lock
+func (cl *connLimiter) _dec(ip string) {
+	cl.total--
+	if n := cl.byIP[ip]; n > 1 {
+		cl.byIP[ip] = n - 1
+	} else {
+		delete(cl.byIP, ip)
+	}
+	cl.statsT.Add(stats.ClientConnsGauge, int64(cl.total))
+}
+
+func (p *proxy) connGate(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ci, ok := r.Context().Value(ctxConnInfo{}).(*connInfo)
+		if !ok { // not via public endpoint
+			h(w, r)
+			return
+		}
+		conf := &cmn.GCO.Get().Proxy
+		drain, err := p.connlim.admit(ci, p.fromNode(r), conf)
+		if err != nil {
+			w.Header().Set(cos.HdrConnection, "close")
+			p.writeErr(w, r, err, err.hdr(w), Silent)
+			return
+		}
+		if drain {
+			w.Header().Set(cos.HdrConnection, "close")
+		}
+		h(w, r)
+	}
+}
+
+//////////////////
+// errConnLimit //
+//////////////////
+
+func (e *errConnLimit) Error() string {
+	if e.ip != "" {
+		return fmt.Sprintf("too many connections from %s (%s=%d), please retry after %v",
+			e.ip, e.what, e.limit, e.retryAfter)
+	}
+	return fmt.Sprintf("too many client connections (%s=%d), please retry after %v", e.what, e.limit, e.retryAfter)
+}
+
+// set Retry-After (seconds) and return http status (compare with errShed)
+func (e *errConnLimit) hdr(w http.ResponseWriter) int {
+	secs := max(int64((e.retryAfter+time.Second-1)/time.Second), 1)
+	w.Header().Set(cos.HdrRetryAfter, strconv.FormatInt(secs, 10))
+	return http.StatusServiceUnavailable
+}
@@ -0,0 +1,186 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+)
+
+type tconn struct {
+	net.Conn
+	addr string
+}
+
+func (c *tconn) RemoteAddr() net.Addr {
+	addr, _ := net.ResolveTCPAddr("tcp", c.addr)
+	return addr
+}
+
+func newConnLimiter() *connLimiter { return (&connLimiter{}).tinit() }
+
+func (cl *connLimiter) tinit() *connLimiter {
+	cl.statsT = mock.NewStatsTracker()
+	cl.conns, cl.byIP = map[net.Conn]*connInfo{}, map[string]int{}
+	return cl
+}
+
+func (cl *connLimiter) tconnect(addr string) (net.Conn, *connInfo) {
+	c := &tconn{addr: addr}
+	ctx := cl.connContext(context.Background(), c)
+	return c, ctx.Value(ctxConnInfo{}).(*connInfo)
+}
+
+func TestConnLimAdmit(tt *testing.T) {
+	var (
+		cl   = newConnLimiter()
+		conf = &cmn.ProxyConf{MaxConns: 3, MaxClientConns: 2}
+	)
+	c1, ci1 := cl.tconnect("10.0.0.1:50001")
+	_, ci2 := cl.tconnect("10.0.0.1:50002")
+	_, ci3 := cl.tconnect("10.0.0.1:50003")
+	for i, ci := range []*connInfo{ci1, ci2} {
+		if _, err := cl.admit(ci, false, conf); err != nil {
+			tt.Fatalf("conn #%d: expected to be admitted, got %v", i, err)
+		}
+	}
+	// per client
+	if _, err := cl.admit(ci3, false, conf); err == nil || err.what != "max_client_conns" {
+		tt.Fatalf("expected max_client_conns, got %v", err)
+	}
+	if _, err := cl.admit(ci3, false, conf); err == nil { // ditto, subsequent requests
+		tt.Fatal("expected rejection")
+	}
+	// intra-cluster: not counted
+	_, ci4 := cl.tconnect("10.0.0.1:50004")
+	if _, err := cl.admit(ci4, true, conf); err != nil || cl.total != 2 {
+		tt.Fatalf("intra-cluster: expected admitted and not counted, got %v (total %d)", err, cl.total)
+	}
+	// total
+	_, ci5 := cl.tconnect("10.0.0.2:50005")
+	_, ci6 := cl.tconnect("10.0.0.3:50006")
+	if _, err := cl.admit(ci5, false, conf); err != nil {
+		tt.Fatal(err)
+	}
+	if _, err := cl.admit(ci6, false, conf); err == nil || err.what != "max_conns" {
+		tt.Fatalf("expected max_conns, got %v", err)
+	}
+	// close => admit
+	cl.connState(c1, http.StateClosed)
+	if cl.total != 2 || cl.byIP["10.0.0.1"] != 1 {
+		tt.Fatalf("expected total 2 (one from 10.0.0.1), got %d (%d)", cl.total, cl.byIP["10.0.0.1"])
+	}
+	_, ci7 := cl.tconnect("10.0.0.3:50007")
+	if _, err := cl.admit(ci7, false, conf); err != nil {
+		tt.Fatal(err)
+	}
+}
+
+func TestConnLimDrain(tt *testing.T) {
+	var (
+		cl    = newConnLimiter()
+		conf  = &cmn.ProxyConf{}
+		conns = make([]*connInfo, 0, 4)
+	)
+	for i := 0; i < 4; i++ {
+		_, ci := cl.tconnect("10.0.0.1:" + strconv.Itoa(50000+i))
+		if drain, err := cl.admit(ci, false, conf); drain || err != nil {
+			tt.Fatalf("unlimited: expected to be admitted, got %t, %v", drain, err)
+		}
+		conns = append(conns, ci)
+	}
+	// lower the limit at runtime: drain the excess (and only the excess)
+	conf.MaxConns = 2
+	var drained int
+	for _, ci := range conns {
+		drain, err := cl.admit(ci, false, conf)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		if drain {
+			drained++
+		}
+	}
+	if drained != 2 || cl.total != 2 {
+		tt.Fatalf("expected 2 drained and 2 remaining connections, got %d and %d", drained, cl.total)
+	}
+	if drain, _ := cl.admit(conns[0], false, conf); !drain {
+		tt.Fatal("expected drained connection to keep draining")
+	}
+}
+
+func TestConnGate(tt *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Proxy.MaxConns = 1
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Proxy.MaxConns = 0
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	var (
+		p       = newROProxy()
+		handled bool
+	)
+	p.connlim.tinit()
+	h := p.connGate(func(http.ResponseWriter, *http.Request) { handled = true })
+
+	tests := []struct {
+		addr     string
+		callerID string // intra-cluster headers
+		allowed  bool
+	}{
+		{"10.0.0.1:50001", "", true},
+		{"10.0.0.2:50002", "t1", true},          // intra-cluster: not counted
+		{"10.0.0.3:50003", "spoofed-id", false}, // not in the Smap: counted and limited
+		{"10.0.0.4:50004", "", false},
+	}
+	for _, test := range tests {
+		handled = false
+		_, ci := p.connlim.tconnect(test.addr)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/v1/objects/abc/obj", http.NoBody)
+		r = r.WithContext(context.WithValue(r.Context(), ctxConnInfo{}, ci))
+		if test.callerID != "" {
+			r.Header.Set(apc.HdrCallerID, test.callerID)
+			r.Header.Set(apc.HdrCallerName, "t["+test.callerID+"]")
+		}
+		h(w, r)
+		if handled != test.allowed {
+			tt.Errorf("%s (caller %q): expected allowed=%t", test.addr, test.callerID, test.allowed)
+		}
+		if test.allowed {
+			continue
+		}
+		if w.Code != http.StatusServiceUnavailable || w.Header().Get(cos.HdrRetryAfter) == "" {
+			tt.Errorf("%s (caller %q): expected %d with Retry-After, got %d", test.addr, test.callerID,
+				http.StatusServiceUnavailable, w.Code)
+		}
+	}
+	if p.connlim.total != 1 {
+		tt.Errorf("expected one (client) connection, got %d", p.connlim.total)
+	}
+}
+
+func TestConnLimErr(tt *testing.T) {
+	w := httptest.NewRecorder()
+	err := &errConnLimit{what: "max_conns", limit: 10, retryAfter: connlimRetryAfter}
+	if code := err.hdr(w); code != http.StatusServiceUnavailable {
+		tt.Fatalf("expected %d, got %d", http.StatusServiceUnavailable, code)
+	}
+	if ra := w.Header().Get(cos.HdrRetryAfter); ra != "1" {
+		tt.Fatalf("expected Retry-After 1, got %q", ra)
+	}
+}
@@ -285,7 +285,26 @@ func (p *proxy) validateDownload(w http.ResponseWriter, r *http.Request, body []
 	bck := meta.CloneBck(&dlBase.Bck)
 	args := bctx{p: p, w: w, r: r, reqBody: body, bck: bck, perms: apc.AccessRW}
 	args.createAIS = true
-	if _, err := args.initAndTry(); err == nil {
+	if _, err := args.initAndTry(); err != nil {
+		return
+	}
+	if dlb.Type != dload.TypeManifest {
+		return dlb, dlBase, true
+	}
+
+	// manifest: must be readable
+	mbody := &dload.ManifestBody{}
+	if err := jsoniter.Unmarshal(dlb.RawMessage, mbody); err != nil {
+		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "download manifest", cos.BHead(dlb.RawMessage), err)
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := mbody.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	margs := bctx{p: p, w: w, r: r, reqBody: body, bck: meta.CloneBck(&mbody.ManifestBck), perms: apc.AceGET}
+	if _, err := margs.initAndTry(); err == nil {
 		ok = true
 	}
 	return
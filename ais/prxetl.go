@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -18,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/k8s"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/etl"
 )
 
@@ -79,6 +81,9 @@ func (p *proxy) handleETLGet(w http.ResponseWriter, r *http.Request) {
 	case apc.ETLMetrics:
 		// /v1/etl/<etl-name>/metrics
 		p.metricsETL(w, r)
+	case apc.ETLStats:
+		// /v1/etl/<etl-name>/stats
+		p.statsETL(w, r)
 	default:
 		p.writeErrURL(w, r)
 	}
@@ -124,6 +129,25 @@ func (p *proxy) handleETLPut(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// create cache bucket if need be
+	if cbck := initMsg.CacheBck(); !cbck.IsEmpty() {
+		bck := meta.CloneBck(cbck)
+		if err := bck.Init(p.owner.bmd); err != nil {
+			if !cmn.IsErrBckNotFound(err) {
+				p.writeErr(w, r, err)
+				return
+			}
+			if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+				return
+			}
+			if err := p.createBucket(&apc.ActMsg{Action: apc.ActCreateBck}, bck, nil); err != nil {
+				p.writeErr(w, r, err, crerrStatus(err))
+				return
+			}
+			nlog.Infoln(p.String(), "etl", initMsg.Name(), "created cache bucket", bck.Cname(""))
+		}
+	}
+
 	// add to cluster MD and start running
 	if err := p.startETL(w, initMsg, true /*add to etlMD*/); err != nil {
 		p.writeErr(w, r, err)
@@ -192,6 +216,16 @@ func (p *proxy) handleETLDelete(w http.ResponseWriter, r *http.Request) {
 
 func (p *proxy) _deleteETLPre(ctx *etlMDModifier, clone *etlMD) (err error) {
 	debug.AssertNoErr(k8s.ValidateEtlName(ctx.etlName))
+	// cannot delete bucket's default ETL (see cmn.BckETLConf)
+	p.owner.bmd.get().Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.Props.ETL.ID == ctx.etlName {
+			err = fmt.Errorf("cannot delete etl job %q: it is the default ETL of %s (etl.id)", ctx.etlName, bck)
+		}
+		return err != nil
+	})
+	if err != nil {
+		return
+	}
 	if exists := clone.del(ctx.etlName); !exists {
 		err = cos.NewErrNotFound(p, "etl job "+ctx.etlName)
 	}
@@ -422,6 +456,28 @@ func (p *proxy) metricsETL(w http.ResponseWriter, r *http.Request) {
 	p.writeJSON(w, r, metrics, "metrics-etl")
 }
 
+// GET /v1/etl/<etl-name>/stats
+func (p *proxy) statsETL(w http.ResponseWriter, r *http.Request) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path}
+	args.timeout = apc.DefaultTimeout
+	args.cresv = cresES{} // -> etl.StatsByTarget
+	results := p.bcastGroup(args)
+	defer freeBcastRes(results)
+	freeBcArgs(args)
+
+	stats := make(etl.StatsByTarget, 0, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr(), res.status)
+			return
+		}
+		stats = append(stats, res.v.(*etl.Stats))
+	}
+	sort.SliceStable(stats, func(i, j int) bool { return stats[i].TargetID < stats[j].TargetID })
+	p.writeJSON(w, r, stats, "stats-etl")
+}
+
 // POST /v1/etl/<etl-name>/stop
 func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request) {
 	args := allocBcArgs()
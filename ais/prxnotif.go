@@ -6,7 +6,6 @@ package ais
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -163,7 +162,7 @@ func (n *notifs) handler(w http.ResponseWriter, r *http.Request) {
 
 func (*notifs) _progress(nl nl.Listener, tsi *meta.Snode, msg *core.NotifMsg) {
 	if msg.ErrMsg != "" {
-		nl.AddErr(errors.New(msg.ErrMsg))
+		nl.AddErr(msg.Err())
 	}
 	// when defined, `data must be valid encoded stats
 	if msg.Data != nil {
@@ -192,7 +191,7 @@ func (n *notifs) _finished(nl nl.Listener, tsi *meta.Snode, msg *core.NotifMsg)
 		aborted = aborted || abortedSnap
 	}
 	if msg.ErrMsg != "" {
-		srcErr = errors.New(msg.ErrMsg)
+		srcErr = msg.Err()
 	}
 	done = n.markFinished(nl, tsi, srcErr, aborted)
 	nl.Unlock()
@@ -0,0 +1,43 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/openapi"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// OpenAPI 3 document describing the public REST API (generated once, on demand)
+var oas struct {
+	err  error
+	b    []byte
+	once sync.Once
+}
+
+// GET /v1/openapi
+func (p *proxy) openapiHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		cmn.WriteErr405(w, r, http.MethodGet)
+		return
+	}
+	oas.once.Do(func() {
+		var doc *openapi.Document
+		if doc, oas.err = openapi.Generate(cmn.VersionAIStore); oas.err == nil {
+			oas.b = cos.MustMarshal(doc)
+		}
+	})
+	if oas.err != nil {
+		p.writeErr(w, r, oas.err, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(oas.b)))
+	w.Write(oas.b)
+}
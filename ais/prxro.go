@@ -0,0 +1,64 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Read-only proxy (gateway) - see cmn.ProxyConf.ReadOnly:
+// - serves GET and HEAD requests: read and list objects, list buckets, query cluster and nodes, etc.;
+// - rejects (with 405) client requests that may modify cluster, buckets, or objects -
+//   that is, all PUT, POST, DELETE, and PATCH requests on the public network;
+// - intra-cluster requests are not affected (see fromNode);
+// - the setting is per proxy (to configure, override the inherited `proxy.read_only`)
+//   and is ignored by the primary (a read-only proxy must be non-electable).
+
+func (p *proxy) roGate(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if cmn.GCO.Get().Proxy.ReadOnly && p.roReject(r) {
+			w.Header().Set("Allow", http.MethodGet+", "+http.MethodHead)
+			p.writeErrStatusf(w, r, http.StatusMethodNotAllowed,
+				"%s is read-only: %s %s is not permitted (use read-write endpoint to modify cluster, buckets, or objects)",
+				p, r.Method, r.URL.Path)
+			return
+		}
+		h(w, r)
+	}
+}
+
+func (p *proxy) roReject(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	if p.fromNode(r) {
+		return false
+	}
+	return !p.owner.smap.get().isPrimary(p.si)
+}
+
+// intra-cluster request: received via intra-cluster (control or data) network or else
+// (public network) from a node that is present in the current Smap
+// (unlike isIntraCall, never assume a newly joined node - the headers can be set by anyone)
+func (p *proxy) fromNode(r *http.Request) bool {
+	if srv, ok := r.Context().Value(http.ServerContextKey).(*http.Server); ok && !isPubServer(srv) {
+		return true
+	}
+	callerID := r.Header.Get(apc.HdrCallerID)
+	return callerID != "" && p.owner.smap.get().GetNode(callerID) != nil
+}
+
+func isPubServer(srv *http.Server) bool {
+	for _, server := range []*netServer{g.netServ.pub, g.netServ.pub2} {
+		if server != nil && server.s == srv {
+			return true
+		}
+	}
+	return false
+}
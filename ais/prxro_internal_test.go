@@ -0,0 +1,84 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// non-primary proxy "p1" with primary "p0" and target "t1"
+func newROProxy() *proxy {
+	var (
+		p    = &proxy{}
+		smap = newSmap()
+	)
+	p.owner.smap = newSmapOwner(cmn.GCO.Get())
+	p.si = newSnode("p1", apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	primary := newSnode("p0", apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	smap.addProxy(primary)
+	smap.addProxy(p.si)
+	smap.addTarget(newSnode("t1", apc.Target, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{}))
+	smap.Primary = primary
+	p.owner.smap.put(smap)
+	return p
+}
+
+func TestReadOnlyGate(tt *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Proxy.ReadOnly, config.Proxy.NonElectable = true, true
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Proxy.ReadOnly, config.Proxy.NonElectable = false, false
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	var (
+		p       = newROProxy()
+		handled bool
+		h       = p.roGate(func(http.ResponseWriter, *http.Request) { handled = true })
+	)
+	tests := []struct {
+		method   string
+		callerID string // intra-cluster headers
+		allowed  bool
+	}{
+		{http.MethodGet, "", true},
+		{http.MethodHead, "", true},
+		{http.MethodPut, "", false},
+		{http.MethodDelete, "", false},
+		{http.MethodPost, "", false},
+		{http.MethodPost, "t1", true},         // intra-cluster
+		{http.MethodPut, "spoofed-id", false}, // not in the Smap
+	}
+	for _, test := range tests {
+		handled = false
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(test.method, "/v1/objects/abc/obj", http.NoBody)
+		if test.callerID != "" {
+			r.Header.Set(apc.HdrCallerID, test.callerID)
+			r.Header.Set(apc.HdrCallerName, "t["+test.callerID+"]")
+		}
+		h(w, r)
+		if handled != test.allowed {
+			tt.Errorf("%s (caller %q): expected allowed=%t", test.method, test.callerID, test.allowed)
+		}
+		if test.allowed {
+			continue
+		}
+		if w.Code != http.StatusMethodNotAllowed {
+			tt.Errorf("%s (caller %q): expected %d, got %d", test.method, test.callerID, http.StatusMethodNotAllowed, w.Code)
+		}
+		if allow := w.Header().Get("Allow"); allow != "GET, HEAD" {
+			tt.Errorf("%s (caller %q): expected Allow header, got %q", test.method, test.callerID, allow)
+		}
+	}
+}
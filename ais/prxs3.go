@@ -321,6 +321,10 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
+	if err := s3.ValidateEncodingType(q); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
 	amsg := &apc.ActMsg{Action: apc.ActList}
 
 	// currently, always forwarding
@@ -341,9 +345,9 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// - "start-after"
 	// - "delimiter" (TODO: limited support: no recursion)
 	// - "continuation-token" (NOTE: base64 encoded, as in: base64.StdEncoding.DecodeString(token)
+	// - "encoding-type" (see ListObjectResult.FromQuery)
 	// TODO:
 	// - "fetch-owner"
-	// - "encoding-type"
 	s3.FillLsoMsg(q, lsmsg)
 
 	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg)
@@ -358,6 +362,7 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	resp := s3.NewListObjectResult(bucket)
 	resp.ContinuationToken = lsmsg.ContinuationToken
 	resp.FromLsoResult(lst, lsmsg)
+	resp.FromQuery(q)
 	sgl := p.gmm.NewSGL(0)
 	resp.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -67,6 +68,9 @@ func (c *txnCln) init(msg *apc.ActMsg, bck *meta.Bck, config *cmn.Config, waitms
 	c.msg = c.p.newAmsg(msg, nil, c.uuid)
 	body := cos.MustMarshal(c.msg)
 	c.req = cmn.HreqArgs{Method: http.MethodPost, Query: query, Body: body}
+	if c.msg.ReqID != "" {
+		c.req.Header = http.Header{cos.HdrReqID: []string{c.msg.ReqID}}
+	}
 	return c
 }
 
@@ -227,6 +231,9 @@ func (p *proxy) _createBucketWithProps(msg *apc.ActMsg, bck *meta.Bck, bprops *c
 	)
 	if bprops == nil { // inherit (all) cluster defaults
 		bprops = defaultBckProps(bckPropsArgs{bck: bck})
+		if bck.IsAIS() && cmn.Rom.Features().IsSet(feat.AutoRedundancy) {
+			autoRedundancy(bprops, p.owner.smap.get().CountActiveTs())
+		}
 	}
 
 	// 1. try add
@@ -378,7 +385,7 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 
 	// 2. begin
 	switch msg.Action {
-	case apc.ActSetBprops:
+	case apc.ActSetBprops, apc.ActUpdateAccess:
 		// do nothing here (caller's responsible for validation)
 	case apc.ActResetBprops:
 		bargs := bckPropsArgs{bck: bck}
@@ -396,11 +403,14 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 		}
 		nprops = defaultBckProps(bargs)
 	default:
-		return "", fmt.Errorf(fmtErrInvaldAction, msg.Action, []string{apc.ActSetBprops, apc.ActResetBprops})
+		return "", fmt.Errorf(fmtErrInvaldAction, msg.Action, []string{apc.ActSetBprops, apc.ActResetBprops, apc.ActUpdateAccess})
 	}
 	// msg{propsToUpdate} => nmsg{nprops} and prep context(nmsg)
 	nmsg := *msg
 	nmsg.Value = nprops
+	if msg.Action == apc.ActUpdateAccess {
+		nmsg.Action = apc.ActSetBprops // (targets-wise, same as any other update)
+	}
 	var (
 		waitmsync = true
 		c         = p.prepTxnClient(&nmsg, bck, waitmsync)
@@ -419,6 +429,9 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 		setProps: nprops,
 		bcks:     []*meta.Bck{bck},
 	}
+	if msg.Action == apc.ActUpdateAccess {
+		ctx.accUpdate = msg.Value.(*apc.AccessUpdate)
+	}
 	bmd, err := p.owner.bmd.modify(ctx)
 	if err != nil {
 		c.bcastAbort(bck, err)
@@ -454,9 +467,15 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 		bprops, present = clone.Get(bck)
 	)
 	debug.Assert(present)
-	if ctx.msg.Action == apc.ActSetBprops {
+	if ctx.msg.Action == apc.ActSetBprops || ctx.msg.Action == apc.ActUpdateAccess {
 		bck.Props = bprops
 	}
+	if ctx.accUpdate != nil {
+		// allow/deny relative to the current value (under lock)
+		nprops := bprops.Clone()
+		nprops.Access = ctx.accUpdate.Apply(bprops.Access)
+		ctx.setProps = nprops
+	}
 	ctx.needReMirror = _reMirror(bprops, ctx.setProps)
 	targetCnt, ctx.needReEC = _reEC(bprops, ctx.setProps, bck, p.owner.smap.get())
 	debug.Assert(!ctx.needReEC || ctx.setProps.Validate(targetCnt) == nil)
@@ -0,0 +1,179 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/net/websocket"
+)
+
+// xaction watch: stream stats snapshots of the selected xactions over WebSocket
+// at a given interval - until all of them finish or the client goes away
+// (compare with polling via apc.WhatQueryXactStats)
+
+// GET /v1/xactions/watch?uuid=<id>[,<id>...]&interval=<duration>
+func (p *proxy) xactHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		cmn.WriteErr405(w, r, http.MethodGet)
+		return
+	}
+	apiItems, err := p.parseURL(w, r, apc.URLPathXactions.L, 1, false)
+	if err != nil {
+		return
+	}
+	if apiItems[0] != apc.Watch {
+		p.writeErrURL(w, r)
+		return
+	}
+	ids, ival, err := parseWatch(r.URL.Query())
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	s := websocket.Server{Handler: func(ws *websocket.Conn) { p.xwatch(ws, ids, ival) }}
+	s.ServeHTTP(w, r)
+}
+
+func parseWatch(query url.Values) (ids []string, ival time.Duration, err error) {
+	for _, xid := range strings.Split(query.Get(apc.QparamUUID), ",") {
+		if xid = strings.TrimSpace(xid); xid == "" {
+			continue
+		}
+		if !xact.IsValidUUID(xid) {
+			return nil, 0, fmt.Errorf("invalid xaction ID %q", xid)
+		}
+		ids = append(ids, xid)
+	}
+	switch {
+	case len(ids) == 0:
+		return nil, 0, fmt.Errorf("missing xaction ID(s) (query parameter %q)", apc.QparamUUID)
+	case len(ids) > xact.MaxWatchIDs:
+		return nil, 0, fmt.Errorf("number of xactions to watch (%d) exceeds the maximum (%d)", len(ids), xact.MaxWatchIDs)
+	}
+	ival = xact.DfltWatchIval
+	if s := query.Get(apc.QparamIval); s != "" {
+		if ival, err = time.ParseDuration(s); err != nil {
+			return nil, 0, fmt.Errorf("invalid watch interval %q: %v", s, err)
+		}
+		if ival < xact.MinWatchIval || ival > xact.MaxWatchIval {
+			return nil, 0, fmt.Errorf("watch interval %v is out of range [%v, %v]", ival, xact.MinWatchIval, xact.MaxWatchIval)
+		}
+	}
+	return ids, ival, nil
+}
+
+func (p *proxy) xwatch(ws *websocket.Conn, ids []string, ival time.Duration) {
+	// the client is not expected to send anything - read to detect it going away
+	gone := make(chan struct{})
+	go func() {
+		var b [64]byte
+		for {
+			if _, err := ws.Read(b[:]); err != nil {
+				close(gone)
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(ival)
+	defer func() {
+		ticker.Stop()
+		ws.Close()
+	}()
+	for {
+		msg := p.watchSnaps(ids)
+		if err := websocket.JSON.Send(ws, msg); err != nil {
+			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+				nlog.Infoln(p.String()+": xaction watch", ids, "terminated:", err)
+			}
+			return
+		}
+		if msg.Fin || msg.Err != "" {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-gone:
+			return
+		}
+	}
+}
+
+func (p *proxy) watchSnaps(ids []string) *xact.WatchMsg {
+	var (
+		msg = &xact.WatchMsg{Snaps: make(xact.MultiSnap, 8)}
+		fin = true
+	)
+	for _, xid := range ids {
+		xs, err := p.xsnaps(&xact.QueryMsg{ID: xid})
+		if err != nil {
+			msg.Err = err.Error()
+			break
+		}
+		var found bool
+		for tid, snaps := range xs {
+			msg.Snaps[tid] = append(msg.Snaps[tid], snaps...)
+			for _, snap := range snaps {
+				found = true
+				if !snap.Finished() && !snap.IsAborted() {
+					fin = false
+				}
+			}
+		}
+		if !found {
+			fin = false // not started yet (or unknown)
+		}
+	}
+	msg.Time = time.Now().UnixNano()
+	msg.Fin = fin && msg.Err == ""
+	return msg
+}
+
+// query all targets (compare with `xquery`)
+func (p *proxy) xsnaps(xactMsg *xact.QueryMsg) (xs xact.MultiSnap, err error) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathXactions.S,
+		Body:   cos.MustMarshal(xactMsg),
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatQueryXactStats}},
+	}
+	args.to = core.Targets
+	args.timeout = cmn.Rom.CplaneOperation()
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	xs = make(xact.MultiSnap, len(results))
+	for _, res := range results {
+		if res.status == http.StatusNotFound {
+			continue
+		}
+		if res.err != nil {
+			err = res.toErr()
+			break
+		}
+		var snaps []*core.Snap
+		if err = jsoniter.Unmarshal(res.bytes, &snaps); err != nil {
+			break
+		}
+		if len(snaps) > 0 {
+			xs[res.si.ID()] = snaps
+		}
+	}
+	freeBcastRes(results)
+	return xs, err
+}
@@ -0,0 +1,127 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"embed"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Built-in web dashboard (read-only): browse buckets and objects, view cluster
+// health and capacity, and monitor xactions.
+// - enabled via (cluster) config: `proxy.webui`;
+// - static assets (see ais/webui) are compiled into the binary and served at /webui/;
+// - the dashboard itself calls the regular REST API; the only exception is /webui/api/
+//   that translates browser-friendly (bodiless) GET requests into the corresponding
+//   API calls - with the same access control (see checkAccess)
+
+const (
+	webuiRoot = "/" + apc.WebUI
+	webuiAPI  = webuiRoot + "/api/"
+
+	webuiPageSize = 1000
+)
+
+// (web UI only) query parameters
+const (
+	webuiQparamPrefix = "prefix"
+	webuiQparamToken  = "token"
+	webuiQparamKind   = "kind"
+)
+
+//go:embed webui
+var webuiAssets embed.FS
+
+var webuiFS http.Handler
+
+func init() {
+	sub, err := fs.Sub(webuiAssets, apc.WebUI)
+	debug.AssertNoErr(err)
+	webuiFS = http.StripPrefix(webuiRoot, http.FileServer(http.FS(sub)))
+}
+
+// GET /webui/...
+func (p *proxy) webuiHandler(w http.ResponseWriter, r *http.Request) {
+	if !cmn.GCO.Get().Proxy.WebUI {
+		p.writeErrStatusf(w, r, http.StatusNotFound, "%s: web UI is disabled (to enable, set config 'proxy.webui=true')", p)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		cmn.WriteErr405(w, r, http.MethodGet, http.MethodHead)
+		return
+	}
+	switch {
+	case r.URL.Path == webuiRoot:
+		http.Redirect(w, r, webuiRoot+"/", http.StatusMovedPermanently)
+	case strings.HasPrefix(r.URL.Path, webuiAPI):
+		p.webuiAPI(w, r, strings.TrimPrefix(r.URL.Path, webuiAPI))
+	default:
+		webuiFS.ServeHTTP(w, r)
+	}
+}
+
+// GET /webui/api/buckets
+// GET /webui/api/objects/<bucket-name>?provider=...&prefix=...&uuid=...&token=...
+// GET /webui/api/xactions[?kind=...]
+func (p *proxy) webuiAPI(w http.ResponseWriter, r *http.Request, what string) {
+	var (
+		path    string
+		msg     any
+		handler func(http.ResponseWriter, *http.Request)
+		query   = r.URL.Query()
+	)
+	switch {
+	case what == apc.Buckets:
+		path, handler = apc.URLPathBuckets.S, p.bucketHandler
+		msg = apc.ActMsg{Action: apc.ActList}
+	case strings.HasPrefix(what, apc.Objects+"/"):
+		bckName := strings.TrimPrefix(what, apc.Objects+"/")
+		if bckName == "" || strings.IndexByte(bckName, '/') >= 0 {
+			p.writeErrURL(w, r)
+			return
+		}
+		lsmsg := &apc.LsoMsg{
+			Props:             apc.GetPropsNameSize + apc.LsPropsSepa + apc.GetPropsAtime,
+			Prefix:            query.Get(webuiQparamPrefix),
+			UUID:              query.Get(apc.QparamUUID),
+			ContinuationToken: query.Get(webuiQparamToken),
+			PageSize:          webuiPageSize,
+		}
+		query.Del(webuiQparamPrefix)
+		query.Del(apc.QparamUUID)
+		query.Del(webuiQparamToken)
+		path, handler = apc.URLPathBuckets.Join(bckName), p.bucketHandler
+		msg = apc.ActMsg{Action: apc.ActList, Value: lsmsg}
+	case what == apc.Xactions:
+		path, handler = apc.URLPathClu.S, p.clusterHandler
+		msg = xact.QueryMsg{Kind: query.Get(webuiQparamKind)}
+		query = url.Values{apc.QparamWhat: {apc.WhatQueryXactStats}}
+	default:
+		p.writeErrURL(w, r)
+		return
+	}
+
+	body := cos.MustMarshal(msg)
+	req := r.Clone(r.Context())
+	req.Method = http.MethodGet
+	req.URL.Path = path
+	req.URL.RawQuery = query.Encode()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	req.Header.Del(cos.HdrAccept) // always JSON
+	handler(w, req)
+}
@@ -17,6 +17,10 @@ const (
 	QparamContinuationToken = "continuation-token"
 	QparamStartAfter        = "start-after"
 	QparamDelimiter         = "delimiter"
+	QparamEncodingType      = "encoding-type"
+
+	// the only supported encoding type (ListObjectsV2)
+	EncodingTypeURL = "url"
 
 	// multipart
 	QparamMptUploads        = "uploads"
@@ -6,6 +6,7 @@ package s3
 
 import (
 	"encoding/xml"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
@@ -32,6 +33,9 @@ type (
 		Name                  string          `xml:"Name"`
 		Ns                    string          `xml:"xmlns,attr"`
 		Prefix                string          `xml:"Prefix"`
+		Delimiter             string          `xml:"Delimiter,omitempty"`
+		StartAfter            string          `xml:"StartAfter,omitempty"`
+		EncodingType          string          `xml:"EncodingType,omitempty"`   // "url" when requested (QparamEncodingType)
 		KeyCount              int             `xml:"KeyCount"`                 // number of object names in the response
 		MaxKeys               int             `xml:"MaxKeys"`                  // "The maximum number of keys returned ..." (s3)
 		IsTruncated           bool            `xml:"IsTruncated"`              // true if there are more pages to read
@@ -189,6 +193,37 @@ func (r *ListObjectResult) FromLsoResult(lst *cmn.LsoResult, lsmsg *apc.LsoMsg)
 	}
 }
 
+// echo request parameters and, if requested, URL-encode all names in the response
+// (e.g., boto3 requests "encoding-type=url" by default - and always decodes)
+func (r *ListObjectResult) FromQuery(query url.Values) {
+	r.Prefix = query.Get(QparamPrefix)
+	r.Delimiter = query.Get(QparamDelimiter)
+	r.StartAfter = query.Get(QparamStartAfter)
+	if query.Get(QparamEncodingType) != EncodingTypeURL {
+		return
+	}
+	r.EncodingType = EncodingTypeURL
+	r.Prefix, r.Delimiter, r.StartAfter = encodeURL(r.Prefix), encodeURL(r.Delimiter), encodeURL(r.StartAfter)
+	for _, obj := range r.Contents {
+		obj.Key = encodeURL(obj.Key)
+	}
+	for _, cp := range r.CommonPrefixes {
+		cp.Prefix = encodeURL(cp.Prefix)
+	}
+}
+
+// same as S3: form-encoding (space => '+') with no escaping of '/'
+func encodeURL(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "%2F", "/")
+}
+
+func ValidateEncodingType(query url.Values) error {
+	if et := query.Get(QparamEncodingType); et != "" && et != EncodingTypeURL {
+		return fmt.Errorf("invalid %s=%q (expecting %q)", QparamEncodingType, et, EncodingTypeURL)
+	}
+	return nil
+}
+
 func SetEtag(hdr http.Header, lom *core.LOM) {
 	if hdr.Get(cos.S3CksumHeader) != "" {
 		return
@@ -0,0 +1,52 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListEncodingType(t *testing.T) {
+	newResult := func() *ListObjectResult {
+		r := NewListObjectResult("bck")
+		r.Contents = append(r.Contents, &ObjInfo{Key: "dir/a b+c%d.txt"})
+		r.CommonPrefixes = append(r.CommonPrefixes, &CommonPrefix{Prefix: "dir/sub dir/"})
+		return r
+	}
+
+	// no encoding
+	q := url.Values{QparamPrefix: []string{"dir/"}, QparamDelimiter: []string{"/"}}
+	r := newResult()
+	r.FromQuery(q)
+	if r.EncodingType != "" || r.Contents[0].Key != "dir/a b+c%d.txt" || r.Prefix != "dir/" || r.Delimiter != "/" {
+		t.Fatalf("unexpected %+v, %+v", r, r.Contents[0])
+	}
+
+	// encoding-type=url
+	q.Set(QparamEncodingType, EncodingTypeURL)
+	if err := ValidateEncodingType(q); err != nil {
+		t.Fatal(err)
+	}
+	r = newResult()
+	r.FromQuery(q)
+	if r.EncodingType != EncodingTypeURL {
+		t.Fatalf("expected encoding type %q, got %q", EncodingTypeURL, r.EncodingType)
+	}
+	if key := r.Contents[0].Key; key != "dir/a+b%2Bc%25d.txt" {
+		t.Fatalf("unexpected encoded key %q", key)
+	}
+	if prefix := r.CommonPrefixes[0].Prefix; prefix != "dir/sub+dir/" {
+		t.Fatalf("unexpected encoded common prefix %q", prefix)
+	}
+	if decoded, err := url.QueryUnescape(r.Contents[0].Key); err != nil || decoded != "dir/a b+c%d.txt" {
+		t.Fatalf("failed to decode %q: %q, %v", r.Contents[0].Key, decoded, err)
+	}
+
+	q.Set(QparamEncodingType, "base64")
+	if err := ValidateEncodingType(q); err == nil {
+		t.Fatal("expected error: unsupported encoding type")
+	}
+}
@@ -0,0 +1,175 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// startup self-test: fail fast (and tell the admin what to fix) rather than
+// degrade later in ways that are hard to diagnose:
+// - cross-section config sanity (each section validates itself - see cmn.Config.Validate);
+// - all listening endpoints (public, intra-control, intra-data) can be bound;
+// - (target) every available mountpath passes a write/sync/read/remove test;
+// - clock skew vs primary, when self-joining (see checkClockSkew)
+
+const (
+	maxClockSkew = 2 * time.Second // see checkClockSkew
+
+	selfTestDir = "selftest"
+)
+
+func (h *htrun) selfTest(config *cmn.Config) (errs []error) {
+	if err := selfTestConfig(config); err != nil {
+		errs = append(errs, err)
+	}
+	for _, ep := range h.listenEndpoints(config) {
+		if err := selfTestListen(ep.addr, ep.net); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (t *target) selfTest(config *cmn.Config) error {
+	errs := t.htrun.selfTest(config)
+	avail, _ := fs.Get()
+	for _, mi := range avail {
+		if err := selfTestMpath(mi); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return _selfTestErr(t.String(), errs)
+}
+
+func (p *proxy) selfTest(config *cmn.Config) error {
+	return _selfTestErr(p.String(), p.htrun.selfTest(config))
+}
+
+func _selfTestErr(sname string, errs []error) error {
+	if len(errs) == 0 {
+		nlog.Infoln(sname, "startup self-test: ok")
+		return nil
+	}
+	for _, err := range errs {
+		nlog.Errorln(sname, "startup self-test:", err)
+	}
+	return fmt.Errorf("%s: startup self-test failed (%d error%s): %w", sname, len(errs), cos.Plural(len(errs)),
+		errors.Join(errs...))
+}
+
+// cross-section checks
+func selfTestConfig(config *cmn.Config) error {
+	if config.Client.Timeout < config.Timeout.CplaneOperation {
+		return fmt.Errorf("client.client_timeout=%s is smaller than timeout.cplane_operation=%s - increase the former",
+			config.Client.Timeout, config.Timeout.CplaneOperation)
+	}
+	for _, kt := range []*cmn.KeepaliveTrackerConf{&config.Keepalive.Proxy, &config.Keepalive.Target} {
+		if kt.Interval < config.Timeout.MaxKeepalive {
+			return fmt.Errorf("keepalivetracker interval=%s is smaller than timeout.max_keepalive=%s - increase the former",
+				kt.Interval, config.Timeout.MaxKeepalive)
+		}
+	}
+	if u := config.Proxy.PrimaryURL; u != "" {
+		if _, valid := cos.ParseURL(u); !valid {
+			return fmt.Errorf("invalid proxy.primary_url %q - expecting [http|https]://host:port", u)
+		}
+	}
+	return nil
+}
+
+type listenEP struct {
+	net  string
+	addr string
+}
+
+// compare with htrun.run
+func (h *htrun) listenEndpoints(config *cmn.Config) (eps []listenEP) {
+	pub := listenEP{cmn.NetPublic, h.si.PubNet.TCPEndpoint()}
+	if h.pubAddrAny(config) {
+		pub.addr = ":" + h.si.PubNet.Port
+	}
+	eps = append(eps, pub)
+	if config.HostNet.UseIntraControl {
+		eps = append(eps, listenEP{cmn.NetIntraControl, h.si.ControlNet.TCPEndpoint()})
+	}
+	if config.HostNet.UseIntraData {
+		eps = append(eps, listenEP{cmn.NetIntraData, h.si.DataNet.TCPEndpoint()})
+	}
+	return eps
+}
+
+func selfTestListen(addr, netName string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("cannot listen on %s (%s network): %v - make sure the port is not in use "+
+			"and the address belongs to one of the local interfaces", addr, netName, err)
+	}
+	return ln.Close()
+}
+
+func selfTestMpath(mi *fs.Mountpath) error {
+	const tag = "mountpath write test"
+	var (
+		dir  = mi.TempDir(selfTestDir)
+		fqn  = filepath.Join(dir, cos.GenTie())
+		data = []byte(fqn)
+	)
+	if err := cos.CreateDir(dir); err != nil {
+		return fmt.Errorf("%s %s: %v - check permissions and whether the filesystem is mounted read-only", tag, mi, err)
+	}
+	defer os.RemoveAll(dir)
+
+	file, err := cos.CreateFile(fqn)
+	if err != nil {
+		return fmt.Errorf("%s %s: %v - check permissions and whether the filesystem is mounted read-only", tag, mi, err)
+	}
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	cos.Close(file)
+	if err != nil {
+		return fmt.Errorf("%s %s: %v - check available space and the underlying disk(s)", tag, mi, err)
+	}
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return fmt.Errorf("%s %s: %v - check the underlying disk(s)", tag, mi, err)
+	}
+	if !bytes.Equal(b, data) {
+		return fmt.Errorf("%s %s: read back %q, expected %q - check the underlying disk(s)", tag, mi, b, data)
+	}
+	return nil
+}
+
+// primeTime: primary's wall clock at the time of sending the (join) response that has just been received;
+// with no skew, primeTime must be within [now - cplane_operation, now]
+func checkClockSkew(sname string, primeTime, now int64, config *cmn.Config) error {
+	var (
+		tol  = int64(maxClockSkew)
+		skew time.Duration
+	)
+	switch {
+	case primeTime > now+tol:
+		skew = time.Duration(primeTime - now)
+	case primeTime < now-int64(config.Timeout.CplaneOperation)-tol:
+		skew = time.Duration(now - int64(config.Timeout.CplaneOperation) - primeTime)
+	default:
+		return nil
+	}
+	return fmt.Errorf("%s: clock skew vs primary is at least %v (max allowed %v) - synchronize the clocks (NTP) of all nodes",
+		sname, skew.Round(time.Millisecond), maxClockSkew)
+}
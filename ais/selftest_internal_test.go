@@ -0,0 +1,116 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+func TestSelfTestConfig(tt *testing.T) {
+	valid := func() *cmn.Config {
+		config := &cmn.Config{}
+		config.Timeout.CplaneOperation = cos.Duration(2 * time.Second)
+		config.Timeout.MaxKeepalive = cos.Duration(4 * time.Second)
+		config.Client.Timeout = cos.Duration(10 * time.Second)
+		config.Keepalive.Proxy.Interval = cos.Duration(10 * time.Second)
+		config.Keepalive.Target.Interval = cos.Duration(10 * time.Second)
+		config.Proxy.PrimaryURL = "http://localhost:8080"
+		return config
+	}
+	tests := []struct {
+		name   string
+		modify func(*cmn.Config)
+		ok     bool
+	}{
+		{"valid", func(*cmn.Config) {}, true},
+		{"no-primary-url", func(c *cmn.Config) { c.Proxy.PrimaryURL = "" }, true},
+		{"client-timeout", func(c *cmn.Config) { c.Client.Timeout = cos.Duration(time.Second) }, false},
+		{"proxy-keepalive", func(c *cmn.Config) { c.Keepalive.Proxy.Interval = cos.Duration(time.Second) }, false},
+		{"target-keepalive", func(c *cmn.Config) { c.Keepalive.Target.Interval = cos.Duration(time.Second) }, false},
+		{"primary-url", func(c *cmn.Config) { c.Proxy.PrimaryURL = "localhost:8080" }, false},
+	}
+	for _, test := range tests {
+		config := valid()
+		test.modify(config)
+		if err := selfTestConfig(config); (err == nil) != test.ok {
+			tt.Errorf("%s: expected ok=%t, got %v", test.name, test.ok, err)
+		}
+	}
+}
+
+func TestSelfTestListen(tt *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tt.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	if err := selfTestListen(addr, cmn.NetPublic); err == nil {
+		tt.Fatalf("expected %s to be in use", addr)
+	}
+	ln.Close()
+	if err := selfTestListen(addr, cmn.NetPublic); err != nil {
+		tt.Fatal(err)
+	}
+	// not a local address (TEST-NET-1)
+	if err := selfTestListen("192.0.2.1:0", cmn.NetIntraData); err == nil {
+		tt.Fatal("expected error binding non-local address")
+	}
+}
+
+func TestSelfTestMpath(tt *testing.T) {
+	avail, _ := fs.Get()
+	if len(avail) == 0 {
+		tt.Fatal("no mountpaths")
+	}
+	for _, mi := range avail {
+		if err := selfTestMpath(mi); err != nil {
+			tt.Fatal(err)
+		}
+		if _, err := os.Stat(mi.TempDir(selfTestDir)); !os.IsNotExist(err) {
+			tt.Fatalf("%s: expected self-test leftovers to be removed, got %v", mi, err)
+		}
+	}
+	if os.Geteuid() == 0 {
+		return // (root ignores permissions)
+	}
+	mi := &fs.Mountpath{Path: tt.TempDir()}
+	if err := os.Chmod(mi.Path, 0o500); err != nil {
+		tt.Fatal(err)
+	}
+	if err := selfTestMpath(mi); err == nil {
+		tt.Fatalf("%s: expected write test to fail", mi)
+	}
+}
+
+func TestCheckClockSkew(tt *testing.T) {
+	config := &cmn.Config{}
+	config.Timeout.CplaneOperation = cos.Duration(2 * time.Second)
+	now := time.Now().UnixNano()
+	tests := []struct {
+		delta time.Duration // primary vs self
+		ok    bool
+	}{
+		{0, true},
+		{-time.Second, true},
+		{-3 * time.Second, true}, // (delivery time)
+		{time.Second, true},
+		{maxClockSkew + time.Second, false},
+		{-(2*time.Second + maxClockSkew + time.Second), false},
+		{time.Hour, false},
+	}
+	for _, test := range tests {
+		err := checkClockSkew("t[x]", now+int64(test.delta), now, config)
+		if (err == nil) != test.ok {
+			tt.Errorf("%v: expected ok=%t, got %v", test.delta, test.ok, err)
+		}
+	}
+}
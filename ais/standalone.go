@@ -0,0 +1,247 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+)
+
+// Standalone mode (`aisnode -standalone`): one-node cluster for laptops and CI.
+//
+// Scope: a single command (and a single binary), but not a single process. Given the current
+// process-wide state - global config, node context, mountpaths, and more - proxy and target
+// cannot share one process. Instead, standalone aisnode generates configuration under the
+// standalone directory (or reuses it on restart), and then runs itself twice, as the primary
+// proxy and the target, supervising both:
+//   - <dir>/ais.json                 - cluster config (cmn.DefaultClusterConfig);
+//   - <dir>/<role>/ais_local.json    - local config; <dir>/<role> is also the node's confdir;
+//   - <dir>/<role>/log               - logs;
+//   - <dir>/mp                       - the target's one and only mountpath.
+// The supervisor forwards termination signals to both nodes and exits when either one does.
+
+const (
+	saClusterConf = "ais.json"
+	saLocalConf   = "ais_local.json"
+	saMpath       = "mp"
+	saDirDflt     = ".ais-standalone"
+
+	saReadyTimeout = time.Minute
+)
+
+type saNode struct {
+	cmd  *exec.Cmd
+	role string
+	args []string
+}
+
+func runStandalone(version, buildTime string) int {
+	dir, err := saDir()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	var (
+		port       = daemon.cli.standalone.port
+		primaryURL = "http://localhost:" + strconv.Itoa(port)
+		started    = time.Now()
+	)
+	nodes, err := saConfigure(dir, primaryURL, port)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "standalone:", err)
+		return 1
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "standalone:", err)
+		return 1
+	}
+
+	// run proxy and target
+	var (
+		sigCh  = make(chan os.Signal, 1)
+		exitCh = make(chan *saNode, len(nodes))
+	)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	for _, n := range nodes {
+		n.cmd = exec.Command(exe, n.args...)
+		n.cmd.Stdout, n.cmd.Stderr = os.Stdout, os.Stderr
+		n.cmd.Env = os.Environ()
+		if n.role == apc.Proxy {
+			n.cmd.Env = append(n.cmd.Env, env.AIS.IsPrimary+"=true")
+		}
+		if err := n.cmd.Start(); err != nil {
+			fmt.Fprintf(os.Stderr, "standalone: failed to start %s: %v\n", n.role, err)
+			saStop(nodes, syscall.SIGTERM)
+			return 1
+		}
+		go func(n *saNode) {
+			n.cmd.Wait()
+			exitCh <- n
+		}(n)
+	}
+	fmt.Fprintf(os.Stderr, "AIS %s (build %s) standalone: %s (data and logs: %s)\n", version, buildTime, primaryURL, dir)
+	go saReady(primaryURL, started)
+
+	// wait for a signal or either node to exit; stop the other one and wait for it as well
+	var ecode int
+	select {
+	case sig := <-sigCh:
+		saStop(nodes, sig)
+	case n := <-exitCh:
+		ecode = n.cmd.ProcessState.ExitCode()
+		fmt.Fprintf(os.Stderr, "standalone: %s exited (code %d), stopping...\n", n.role, ecode)
+		saStop(nodes, syscall.SIGTERM)
+		<-exitCh
+		return max(ecode, 1)
+	}
+	for range nodes {
+		<-exitCh
+	}
+	return ecode
+}
+
+func saDir() (string, error) {
+	dir := daemon.cli.standalone.dir
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("standalone: failed to determine home directory (use '-standalone_dir'): %v", err)
+		}
+		dir = filepath.Join(home, saDirDflt)
+	}
+	return filepath.Abs(cos.ExpandPath(dir))
+}
+
+// generate cluster and node configs unless already present (in which case reuse them);
+// see also: `ais cluster init`
+func saConfigure(dir, primaryURL string, port int) ([]*saNode, error) {
+	if _, err := cmn.ValidatePort(port); err != nil {
+		return nil, err
+	}
+	if _, err := cmn.ValidatePort(port + 1); err != nil {
+		return nil, err
+	}
+	if err := cos.CreateDir(dir); err != nil {
+		return nil, err
+	}
+	confPath := filepath.Join(dir, saClusterConf)
+	cc, err := saClusterConfig(confPath, primaryURL)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*saNode, 0, 2)
+	for i, role := range []string{apc.Proxy, apc.Target} {
+		var (
+			localPath = filepath.Join(dir, role, saLocalConf)
+			lc        = &cmn.LocalConfig{}
+			args      = []string{"-role=" + role, "-config=" + confPath, "-local_config=" + localPath}
+			exists    bool
+		)
+		if _, err := jsp.Load(localPath, lc, jsp.Plain()); err == nil {
+			if lc.HostNet.Port != port+i {
+				return nil, fmt.Errorf("%s: %s port %d does not match the requested %d (use '-standalone_port' or remove %q)",
+					localPath, role, lc.HostNet.Port, port+i, dir)
+			}
+			exists = true
+		} else if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to load %s config: %v", role, err)
+		} else {
+			lc.ConfigDir = filepath.Join(dir, role)
+			lc.HostNet = cmn.LocalNetConfig{Port: port + i}
+			lc.LogDir = filepath.Join(lc.ConfigDir, "log")
+			if role == apc.Target {
+				lc.FSP.Paths = cos.NewStrSet(filepath.Join(dir, saMpath))
+			}
+		}
+		if role == apc.Proxy {
+			args = append(args, "-skip_startup")
+		} else {
+			for mpath := range lc.FSP.Paths {
+				if err := cos.CreateDir(mpath); err != nil {
+					return nil, err
+				}
+			}
+			args = append(args, "-allow_shared_no_disks")
+		}
+		config := &cmn.Config{ClusterConfig: *cc, LocalConfig: *lc}
+		config.SetRole(role)
+		if err := config.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s config: %v", role, err)
+		}
+		if err := cos.CreateDir(lc.LogDir); err != nil {
+			return nil, err
+		}
+		if !exists {
+			if err := jsp.Save(localPath, lc, jsp.Plain(), nil); err != nil {
+				return nil, err
+			}
+		}
+		nodes = append(nodes, &saNode{role: role, args: args})
+	}
+	return nodes, nil
+}
+
+// load existing cluster config or, if missing, generate and save the default one
+func saClusterConfig(confPath, primaryURL string) (*cmn.ClusterConfig, error) {
+	cc := &cmn.ClusterConfig{}
+	_, err := jsp.Load(confPath, cc, jsp.Plain())
+	if err == nil {
+		if cc.Proxy.PrimaryURL != primaryURL {
+			return nil, fmt.Errorf("%s: primary URL %q does not match the requested %q (use '-standalone_port' or remove %q)",
+				confPath, cc.Proxy.PrimaryURL, primaryURL, filepath.Dir(confPath))
+		}
+		return cc, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to load cluster config: %v", err)
+	}
+	if cc, err = cmn.DefaultClusterConfig(primaryURL); err != nil {
+		return nil, err
+	}
+	if err := jsp.Save(confPath, cc, jsp.Plain(), nil); err != nil {
+		return nil, err
+	}
+	return cc, nil
+}
+
+func saReady(primaryURL string, started time.Time) {
+	bp := api.BaseParams{Client: &http.Client{Timeout: time.Second}, URL: primaryURL, Method: http.MethodGet}
+	for time.Since(started) < saReadyTimeout {
+		smap, err := api.GetClusterMap(bp)
+		if err == nil && smap.UUID != "" && smap.CountActiveTs() > 0 {
+			fmt.Fprintf(os.Stderr, "AIS standalone: ready in %v\n", time.Since(started).Round(time.Millisecond))
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	fmt.Fprintln(os.Stderr, "standalone: timed out waiting for the cluster to become ready")
+}
+
+func saStop(nodes []*saNode, sig os.Signal) {
+	for _, n := range nodes {
+		if n.cmd == nil || n.cmd.Process == nil {
+			continue
+		}
+		if err := n.cmd.Process.Signal(sig); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			fmt.Fprintf(os.Stderr, "standalone: failed to signal %s: %v\n", n.role, err)
+		}
+	}
+}
@@ -6,12 +6,14 @@ package ais
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -71,6 +73,10 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		coldq        coldq
+		shed         shedder
+		ratelim      ratelim
+		mpt          mptUploads
 	}
 )
 
@@ -139,6 +145,8 @@ func (t *target) _initBuiltin() error {
 			add, err = backend.NewGCP(t)
 		case apc.Azure:
 			add, err = backend.NewAzure(t)
+		case apc.OCI:
+			add, err = backend.NewOCI(t)
 		case apc.HDFS:
 			add, err = backend.NewHDFS(t)
 		case apc.AIS, apc.HTTP:
@@ -218,9 +226,14 @@ func (t *target) init(config *cmn.Config) {
 	daemon.rg.add(fshc)
 	t.fshc = fshc
 
+	daemon.rg.add(newCfgwatch(t.owner.config, config))
+
 	if err := ts.InitCDF(); err != nil {
 		cos.ExitLog(err)
 	}
+	// total capacity => HRW weight (see meta.Snode.Capacity)
+	cs := fs.Cap()
+	t.si.Capacity = (cs.TotalUsed + cs.TotalAvail) / cos.MiB
 	fs.Clblk()
 
 	s3.Init() // s3 multipart
@@ -319,9 +332,12 @@ func (t *target) Run() error {
 		nlog.Errorln("")
 	}
 
-	// register object type and workfile type
+	// register object type, workfile type, and dedup chunks
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.DedupType, &fs.DedupContentResolver{})
+	fs.CSM.Reg(fs.PackType, &fs.PackContentResolver{})
+	fs.CSM.Reg(fs.VersionType, &fs.VersionContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -364,6 +380,10 @@ func (t *target) Run() error {
 	}
 
 	t.transactions.init(t)
+	t.shed.init(t)
+	t.ratelim.init(t)
+	t.mpt.init(t)
+	t.wdog.init(&t.htrun)
 
 	t.reb = reb.New(config)
 	t.res = res.New()
@@ -674,19 +694,57 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 			return lom
 		}
 	}
+	if err := t.ratelim.admit(lom, false /*put*/); err != nil {
+		t._erris(w, r, dpq.silent, err, err.(*errRateLimited).hdr(w))
+		return lom
+	}
+
+	// bucket's default ETL (cmn.BckETLConf) applies to client GETs - those that were redirected by a proxy;
+	// in particular, not to ETL containers reading the original (source) objects
+	if dpq.etlName == "" && dpq.ptime != "" && !dpq.noDfltETL {
+		dpq.etlName = lom.Bprops().ETL.ID
+	}
 
 	debug.Assert(dpq.uuid == "", dpq.uuid+" vs "+dpq.etlName) // expecting etlName or none of the above
 	if dpq.etlName != "" {
-		t.doETL(w, r, dpq.etlName, bck, lom.ObjName)
+		t.doETL(w, r, dpq, bck, lom.ObjName)
 		return lom
 	}
 
+	if dpq.deltaSig != "" { // apc.QparamDeltaSig
+		t.getDeltaSig(w, r, lom, dpq)
+		return lom
+	}
+
+	if dpq.objVer != "" { // apc.QparamObjVersion
+		if t.getPrevVersion(w, r, lom, dpq) {
+			return lom
+		}
+	}
+
+	if dpq.archglob != "" { // apc.QparamArchglob
+		if dpq.archpath != "" {
+			t.writeErrf(w, r, "%s: %q and %q are mutually exclusive", t, apc.QparamArchpath, apc.QparamArchglob)
+			return lom
+		}
+		if _, err := path.Match(dpq.archglob, ""); err != nil {
+			t.writeErrf(w, r, "%s: invalid %s=%q: %v", t, apc.QparamArchglob, dpq.archglob, err)
+			return lom
+		}
+	}
 	filename := dpq.archpath // apc.QparamArchpath
 	if strings.HasPrefix(filename, lom.ObjName) {
 		if rel, err := filepath.Rel(lom.ObjName, filename); err == nil {
 			filename = rel
 		}
 	}
+	ctx, cancel, err := reqCtx(r, cmn.GCO.Get().Timeout.ObjGet.D())
+	if err != nil {
+		t.writeErr(w, r, err)
+		return lom
+	}
+	defer cancel()
+
 	// GET context
 	goi := allocGOI()
 	{
@@ -700,23 +758,42 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.t = t
 		goi.lom = lom
 		goi.w = w
-		goi.ctx = context.Background()
+		goi.ctx = ctx
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
 		goi.archive = archiveQuery{
 			filename: filename,
+			glob:     dpq.archglob, // apc.QparamArchglob
 			mime:     dpq.archmime, // apc.QparamArchmime
 		}
 		goi.isGFN = cos.IsParseBool(dpq.isGFN)                 // query.Get(apc.QparamIsGFNRequest)
 		goi.latestVer = goi.lom.ValidateWarmGet(dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
+		if goi.latestVer && dpq.latestVer == "" {
+			goi.vival = goi.lom.VersionConf().ValidateWarmGetIval.D()
+			goi.verconf = true
+		}
 		goi.isS3 = dpq.isS3 != ""
+		goi.manifest = dpq.composite != "" && !cos.IsParseBool(dpq.composite) // apc.QparamComposite
 	}
 	if bck.IsHTTP() {
 		originalURL := dpq.origURL // query.Get(apc.QparamOrigURL)
 		goi.ctx = context.WithValue(goi.ctx, cos.CtxOriginalURL, originalURL)
 	}
+	if bck.IsRemote() {
+		goi.ctx = reqIDCtx(goi.ctx, r)
+	}
 	if errCode, err := goi.getObject(); err != nil {
 		t.statsT.IncErr(stats.GetCount)
 		if err != errSendingResp {
+			switch e := err.(type) {
+			case *errColdGetBusy:
+				errCode = e.hdr(w)
+			case *errShed:
+				errCode = e.hdr(w)
+			}
+			if ctx.Err() == context.DeadlineExceeded {
+				errCode = http.StatusGatewayTimeout
+				err = cmn.NewErrFailedTo(t, "GET (timed out)", lom.Cname(), err, errCode)
+			}
 			t._erris(w, r, dpq.silent, err, errCode)
 		}
 	}
@@ -725,6 +802,40 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	return lom
 }
 
+// request context: canceled when the client goes away, or upon timeout -
+// the one specified by the client (apc.HdrTimeout), if any, or else the configured default
+func reqCtx(r *http.Request, dflt time.Duration) (context.Context, context.CancelFunc, error) {
+	timeout, err := reqTimeout(r, dflt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if timeout == 0 {
+		return r.Context(), func() {}, nil
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	return ctx, cancel, nil
+}
+
+// remote backends to forward client request ID, if any (see cos.CtxReqID)
+func reqIDCtx(ctx context.Context, r *http.Request) context.Context {
+	if id := r.Header.Get(cos.HdrReqID); id != "" {
+		return context.WithValue(ctx, cos.CtxReqID, id)
+	}
+	return ctx
+}
+
+func reqTimeout(r *http.Request, dflt time.Duration) (time.Duration, error) {
+	s := r.Header.Get(apc.HdrTimeout)
+	if s == "" {
+		return dflt, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid %s=%q (expecting non-negative duration, e.g. 30s)", apc.HdrTimeout, s)
+	}
+	return d, nil
+}
+
 // err in silence
 func (t *target) _erris(w http.ResponseWriter, r *http.Request, silent string /*apc.QparamSilent*/, err error, code int) {
 	if cos.IsParseBool(silent) {
@@ -749,8 +860,19 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		t.writeErrf(w, r, "%s: %s(obj) is expected to be redirected or replicated", t.si, r.Method)
 		return
 	}
+	timeout, errT := reqTimeout(r, config.Timeout.ObjPut.D())
+	if errT != nil {
+		t.writeErr(w, r, errT)
+		return
+	}
+	if timeout > 0 {
+		// abandoned or timed-out PUT: fail reading the request body (and, therefore, writing remote and local)
+		if err := http.NewResponseController(w).SetReadDeadline(time.Now().Add(timeout)); err != nil {
+			nlog.Warningln(t.String()+": failed to set PUT deadline:", err)
+		}
+	}
 	cs := fs.Cap()
-	if errCap := cs.Err(); errCap != nil || cs.PctMax > int32(config.Space.CleanupWM) {
+	if errCap := cs.Err(); errCap != nil || cs.PctUsedMax() > int32(config.Space.CleanupWM) {
 		cs = t.OOS(nil)
 		if cs.IsOOS() {
 			// fail this write
@@ -770,6 +892,12 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	if !t2tput {
+		if err := t.ratelim.admit(lom, true /*put*/); err != nil {
+			t.writeErr(w, r, err, err.(*errRateLimited).hdr(w))
+			return
+		}
+	}
 
 	// load (maybe)
 	skipVC := cmn.Rom.Features().IsSet(feat.SkipVC) || cos.IsParseBool(apireq.dpq.skipVC)
@@ -812,6 +940,8 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 		t.statsT.IncErr(stats.AppendCount)
+	case apireq.dpq.mptID != "": // apc.QparamMptUploadID
+		errCode, err = t.mptPutPart(w.Header(), r, lom, apireq.dpq)
 	default:
 		poi := allocPOI()
 		{
@@ -832,7 +962,12 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		freePOI(poi)
 	}
 	if err != nil {
-		t.fsErr(err, lom.FQN)
+		if timeout > 0 && errors.Is(err, os.ErrDeadlineExceeded) {
+			errCode = http.StatusGatewayTimeout
+			err = cmn.NewErrFailedTo(t, "PUT (timed out)", lom.Cname(), err, errCode)
+		} else {
+			t.fsErr(err, lom.FQN)
+		}
 		t.writeErr(w, r, err, errCode)
 	}
 }
@@ -900,7 +1035,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		if err = lom.InitBck(apireq.bck.Bucket()); err != nil {
 			break
 		}
-		if err = t.objMv(lom, msg); err == nil {
+		if err = t.objMv(lom, msg.Name); err == nil {
 			t.statsT.Inc(stats.RenameCount)
 			core.FreeLOM(lom)
 			lom = nil
@@ -926,6 +1061,9 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 			w.Write([]byte(xid))
 			// lom is eventually freed by x-blob
 		}
+	case apc.ActMptStart, apc.ActMptStatus, apc.ActMptComplete, apc.ActMptAbort:
+		t.mptAction(w, r, apireq, msg)
+		return
 	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
@@ -950,15 +1088,27 @@ func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	ctx, cancel, err := reqCtx(r, cmn.GCO.Get().Timeout.ObjHead.D())
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if bck.IsRemote() {
+		ctx = reqIDCtx(ctx, r)
+	}
 	lom := core.AllocLOM(objName)
-	errCode, err := t.objHead(w.Header(), query, bck, lom)
+	errCode, err := t.objHead(ctx, w.Header(), query, bck, lom)
 	core.FreeLOM(lom)
 	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			errCode = http.StatusGatewayTimeout
+		}
 		t._erris(w, r, query.Get(apc.QparamSilent), err, errCode)
 	}
+	cancel()
 }
 
-func (t *target) objHead(hdr http.Header, query url.Values, bck *meta.Bck, lom *core.LOM) (errCode int, err error) {
+func (t *target) objHead(ctx context.Context, hdr http.Header, query url.Values, bck *meta.Bck, lom *core.LOM) (errCode int, err error) {
 	var (
 		fltPresence int
 		exists      = true
@@ -1035,7 +1185,7 @@ func (t *target) objHead(hdr http.Header, query url.Values, bck *meta.Bck, lom *
 	} else {
 		// cold HEAD
 		var oa *cmn.ObjAttrs
-		oa, errCode, err = t.Backend(lom.Bck()).HeadObj(context.Background(), lom)
+		oa, errCode, err = t.Backend(lom.Bck()).HeadObj(ctx, lom)
 		if err != nil {
 			if errCode != http.StatusNotFound {
 				err = cmn.NewErrFailedTo(t, "HEAD", lom, err)
@@ -1097,11 +1247,6 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 	if err != nil {
 		return
 	}
-	custom := cos.StrKVs{}
-	if err := cos.MorphMarshal(msg.Value, &custom); err != nil {
-		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "set-custom", msg.Value, err)
-		return
-	}
 	lom := core.AllocLOM(apireq.items[1] /*objName*/)
 	defer core.FreeLOM(lom)
 	if !t.isValidObjname(w, r, lom.ObjName) {
@@ -1111,6 +1256,24 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		t.writeErr(w, r, err)
 		return
 	}
+	delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
+	if msg.Action == apc.ActSetObjProps {
+		props := &apc.ObjPropsToSet{}
+		if err := cos.MorphMarshal(msg.Value, props); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		if errCode, err := t.setObjProps(lom, props, delOldSetNew); err != nil {
+			t.writeErr(w, r, err, errCode)
+		}
+		return
+	}
+
+	custom := cos.StrKVs{}
+	if err := cos.MorphMarshal(msg.Value, &custom); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "set-custom", msg.Value, err)
+		return
+	}
 	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
 		if cos.IsNotExist(err, 0) {
 			t.writeErr(w, r, err, http.StatusNotFound)
@@ -1119,7 +1282,6 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		}
 		return
 	}
-	delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
 	if delOldSetNew {
 		lom.SetCustomMD(custom)
 	} else {
@@ -1130,6 +1292,66 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 	lom.Persist()
 }
 
+// (apc.ActSetObjProps)
+func (t *target) setObjProps(lom *core.LOM, props *apc.ObjPropsToSet, delOldSetNew bool) (int, error) {
+	if err := props.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	if errCode, err := validateObjProps(lom, props); err != nil {
+		return errCode, err
+	}
+	applyObjProps(lom, props, delOldSetNew)
+	if err := lom.Persist(); err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+// validate custom checksum (if any) against the content; expecting locked and loaded lom
+func validateObjProps(lom *core.LOM, props *apc.ObjPropsToSet) (int, error) {
+	if props.CksumType == "" {
+		return 0, nil
+	}
+	cksum := cos.NewCksum(props.CksumType, props.CksumVal)
+	computed, err := lom.ComputeCksum(props.CksumType)
+	if err != nil {
+		return 0, err
+	}
+	if !computed.Equal(cksum) {
+		return http.StatusBadRequest, cos.NewErrDataCksum(cksum, &computed.Cksum, lom.Cname())
+	}
+	return 0, nil
+}
+
+// (in memory - the caller persists)
+func applyObjProps(lom *core.LOM, props *apc.ObjPropsToSet, delOldSetNew bool) {
+	if delOldSetNew && len(props.Custom) > 0 {
+		lom.SetCustomMD(props.Custom)
+	} else {
+		for key, val := range props.Custom {
+			lom.SetCustomKey(key, val)
+		}
+	}
+	if props.Pinned != nil {
+		if *props.Pinned {
+			lom.SetCustomKey(cmn.PinnedObjMD, "true")
+		} else {
+			lom.DelCustomKeys(cmn.PinnedObjMD)
+		}
+	}
+	if props.CksumType != "" {
+		lom.SetCksum(cos.NewCksum(props.CksumType, props.CksumVal))
+	}
+}
+
 //
 // httpec* handlers
 //
@@ -1278,6 +1500,9 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	}
 	if err == nil {
 		t.statsT.Inc(stats.DeleteCount)
+		if !evict {
+			t.replicate(lom, true /*del*/)
+		}
 	} else {
 		t.statsT.IncErr(stats.DeleteCount) // TODO: count GET/PUT/DELETE remote errors separately..
 	}
@@ -1334,14 +1559,14 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 }
 
 // rename obj
-func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
+func (t *target) objMv(lom *core.LOM, objNameTo string) (err error) {
 	if lom.Bck().IsRemote() {
 		return fmt.Errorf("%s: cannot rename object %s from remote bucket", t.si, lom)
 	}
 	if lom.Bck().Props.EC.Enabled {
 		return fmt.Errorf("%s: cannot rename erasure-coded object %s", t.si, lom)
 	}
-	if msg.Name == lom.ObjName {
+	if objNameTo == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
 
@@ -1349,7 +1574,7 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 	coiParams := core.AllocCOI()
 	{
 		coiParams.BckTo = lom.Bck()
-		coiParams.ObjnameTo = msg.Name /* new object name */
+		coiParams.ObjnameTo = objNameTo
 		coiParams.Buf = buf
 		coiParams.Config = cmn.GCO.Get()
 		coiParams.OWT = cmn.OwtCopy
@@ -1366,7 +1591,7 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 	// TODO: combine copy+delete under a single write lock
 	lom.Lock(true)
 	if err := lom.Remove(); err != nil {
-		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
+		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, objNameTo, err)
 	}
 	lom.Unlock(true)
 	return nil
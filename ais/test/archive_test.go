@@ -6,7 +6,9 @@ package integration_test
 
 import (
 	"archive/tar"
+	"bytes"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/url"
 	"os"
@@ -190,6 +192,64 @@ func TestGetFromArch(t *testing.T) {
 	})
 }
 
+// GET all archived files that match a wildcard (apc.QparamArchglob) - as a new TAR
+func TestGetFromArchGlob(t *testing.T) {
+	const tmpDir = "/tmp"
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		names      = []string{"a/1.txt", "a/2.txt", "a/3.jpg", "b/4.txt", "5.txt"}
+		globs      = map[string]int{"a/*.txt": 2, "*/*": 4, "*.txt": 1, "c/*": 0}
+		errCh      = make(chan error, 1)
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	for _, ext := range []string{archive.ExtTar, archive.ExtTarGz, archive.ExtZip, archive.ExtTarLz4, archive.ExtTarZst} {
+		t.Run(ext, func(t *testing.T) {
+			archName := tmpDir + "/" + cos.GenTie() + ext
+			err := tarch.CreateArchRandomFiles(archName, tar.FormatUnknown, ext, len(names), cos.KiB,
+				false /*dup*/, nil /*record extensions*/, names)
+			tassert.CheckFatal(t, err)
+			defer os.Remove(archName)
+
+			reader, err := readers.NewExistingFile(archName, cos.ChecksumNone)
+			tassert.CheckFatal(t, err)
+			objName := filepath.Base(archName)
+			tools.Put(proxyURL, bck, objName, reader, errCh)
+			tassert.SelectErr(t, errCh, "put", true)
+
+			for glob, expected := range globs {
+				var (
+					buf     bytes.Buffer
+					getArgs = api.GetArgs{Writer: &buf, Query: url.Values{apc.QparamArchglob: []string{glob}}}
+				)
+				_, err := api.GetObject(baseParams, bck, objName, &getArgs)
+				if expected == 0 {
+					tassert.Errorf(t, cmn.IsStatusNotFound(err), "%s?%s=%s: expected not-found, got %v",
+						bck.Cname(objName), apc.QparamArchglob, glob, err)
+					continue
+				}
+				tassert.CheckFatal(t, err)
+
+				var cnt int
+				tr := tar.NewReader(&buf)
+				for {
+					hdr, err := tr.Next()
+					if err == io.EOF {
+						break
+					}
+					tassert.CheckFatal(t, err)
+					matched, _ := path.Match(glob, hdr.Name)
+					tassert.Errorf(t, matched, "%s?%s=%s: unexpected %q", bck.Cname(objName), apc.QparamArchglob, glob, hdr.Name)
+					cnt++
+				}
+				tassert.Errorf(t, cnt == expected, "%s?%s=%s: expected %d files, got %d",
+					bck.Cname(objName), apc.QparamArchglob, glob, expected, cnt)
+			}
+		})
+	}
+}
+
 // archive multple obj-s with an option to append if exists
 func TestArchMultiObj(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
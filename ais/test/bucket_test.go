@@ -2792,6 +2792,40 @@ func testCopyBucketPrefix(t *testing.T, srcBck cmn.Bck, m *ioContext, expected i
 	}
 }
 
+func TestCopyBucketTemplateRename(t *testing.T) {
+	var (
+		srcBck = cmn.Bck{Name: "cpybck_src" + cos.GenTie(), Provider: apc.AIS}
+		dstBck = cmn.Bck{Name: "cpybck_dst" + cos.GenTie(), Provider: apc.AIS}
+	)
+	tools.CreateBucket(t, proxyURL, srcBck, nil, true /*cleanup*/)
+	for i := 0; i < 10; i++ {
+		tassert.CheckFatal(t, tools.PutObjRR(baseParams, srcBck, fmt.Sprintf("train/shard-%04d.tar", i), cos.KiB, cos.ChecksumNone))
+	}
+	tassert.CheckFatal(t, tools.PutObjRR(baseParams, srcBck, "other/shard-0000.tar", cos.KiB, cos.ChecksumNone))
+
+	// invalid (would-be same destination name for all)
+	_, err := api.CopyBucket(baseParams, srcBck, dstBck, &apc.CopyBckMsg{Rename: "abc"})
+	tassert.Fatalf(t, err != nil, "expected invalid rename template to fail")
+
+	msg := &apc.CopyBckMsg{Prefix: "train/", Template: "train/shard-{0000..0009..3}.tar", Rename: "v2/{stem}-copy{ext}"}
+	xid, err := api.CopyBucket(baseParams, srcBck, dstBck, msg)
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() {
+		tools.DestroyBucket(t, proxyURL, dstBck)
+	})
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck, Timeout: time.Minute}
+	_, err = api.WaitForXactionIC(baseParams, &args)
+	tassert.CheckFatal(t, err)
+
+	list, err := api.ListObjects(baseParams, dstBck, nil, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	expected := []string{"v2/shard-0000-copy.tar", "v2/shard-0003-copy.tar", "v2/shard-0006-copy.tar", "v2/shard-0009-copy.tar"}
+	tassert.Fatalf(t, len(list.Entries) == len(expected), "expected %d to be copied, got %d", len(expected), len(list.Entries))
+	for i, e := range list.Entries {
+		tassert.Errorf(t, e.Name == expected[i], "expected %q, got %q", expected[i], e.Name)
+	}
+}
+
 func testCopyBucketAbort(t *testing.T, srcBck cmn.Bck, m *ioContext, sleep time.Duration) {
 	dstBck := cmn.Bck{Name: testBucketName + cos.GenTie(), Provider: apc.AIS}
 
@@ -6,6 +6,7 @@ package integration_test
 
 import (
 	"bytes"
+	cryptorand "crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -297,6 +298,212 @@ func TestAppendObject(t *testing.T) {
 	}
 }
 
+func TestPutObjectMultipart(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "multipart/obj"
+		partSize   = int64(cos.MiB)
+		content    = make([]byte, 5*partSize+1234) // 6 parts
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	_, err := cryptorand.Read(content)
+	tassert.CheckFatal(t, err)
+
+	// simulate an interrupted upload: parts 1 and 3 only
+	uploadID, err := api.StartMultipart(baseParams, bck, objName)
+	tassert.CheckFatal(t, err)
+	for _, num := range []int{1, 3} {
+		part := content[int64(num-1)*partSize : int64(num)*partSize]
+		err = api.PutPart(baseParams, bck, objName, uploadID, num, cos.NewByteHandle(part), partSize)
+		tassert.CheckFatal(t, err)
+	}
+	status, err := api.MultipartStatus(baseParams, bck, objName, uploadID)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(status.Parts) == 2 && status.Parts[0].Num == 1 && status.Parts[1].Num == 3,
+		"unexpected status %+v", status)
+
+	// incomplete
+	_, err = api.CompleteMultipart(baseParams, bck, objName, uploadID, &apc.MptCompleteMsg{NumParts: 6})
+	tassert.Fatalf(t, err != nil, "expected completion to fail (missing parts)")
+
+	// resume
+	args := &api.PutMptArgs{
+		BaseParams: baseParams,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     bytes.NewReader(content),
+		Size:       int64(len(content)),
+		PartSize:   partSize,
+		UploadID:   uploadID,
+	}
+	_, err = api.PutObjectMultipart(args)
+	tassert.CheckFatal(t, err)
+
+	writer := bytes.NewBuffer(nil)
+	_, err = api.GetObject(baseParams, bck, objName, &api.GetArgs{Writer: writer})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(writer.Bytes(), content), "multipart object content differs")
+
+	// the upload is gone
+	_, err = api.MultipartStatus(baseParams, bck, objName, uploadID)
+	tools.CheckErrIsNotFound(t, err)
+
+	// abort
+	uploadID, err = api.StartMultipart(baseParams, bck, objName)
+	tassert.CheckFatal(t, err)
+	err = api.PutPart(baseParams, bck, objName, uploadID, 1, cos.NewByteHandle(content[:partSize]), partSize)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, api.AbortMultipart(baseParams, bck, objName, uploadID))
+	err = api.PutPart(baseParams, bck, objName, uploadID, 2, cos.NewByteHandle(content[:partSize]), partSize)
+	tools.CheckErrIsNotFound(t, err)
+}
+
+func TestPutObjectDelta(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "checkpoint.bin"
+		blockSize  = int64(8 * cos.KiB)
+		v1         = make([]byte, 4*cos.MiB)
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	_, _ = cryptorand.Read(v1)
+
+	// first time: nothing to diff against - regular PUT
+	args := &api.PutDeltaArgs{
+		BlockSize: blockSize,
+		PutArgs: api.PutArgs{
+			BaseParams: baseParams,
+			Bck:        bck,
+			ObjName:    objName,
+			Reader:     cos.NewByteHandle(v1),
+		},
+	}
+	_, stats, err := api.PutObjectDelta(args)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, stats == nil, "expected regular PUT, got delta stats %+v", stats)
+
+	// update a few regions
+	v2 := append([]byte(nil), v1...)
+	copy(v2[100*cos.KiB:], []byte("updated-region-1"))
+	copy(v2[3*cos.MiB:], make([]byte, 20*cos.KiB))
+	v2 = append(v2, []byte("appended")...)
+
+	args.Reader = cos.NewByteHandle(v2)
+	args.Cksum = cos.NewCksum(cos.ChecksumXXHash, "")
+	_, stats, err = api.PutObjectDelta(args)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, stats != nil, "expected delta PUT")
+	tassert.Errorf(t, stats.Literal < 8*blockSize, "expected mostly copied content, got %+v", stats)
+
+	writer := bytes.NewBuffer(nil)
+	_, err = api.GetObjectWithValidation(baseParams, bck, objName, &api.GetArgs{Writer: writer})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(writer.Bytes(), v2), "invalid object content after delta PUT")
+}
+
+type slowReader struct {
+	b []byte
+	r *bytes.Reader
+}
+
+func (sr *slowReader) Read(p []byte) (int, error) {
+	time.Sleep(50 * time.Millisecond)
+	return sr.r.Read(p[:min(len(p), 16*cos.KiB)])
+}
+
+func (sr *slowReader) Open() (cos.ReadOpenCloser, error) {
+	return &slowReader{b: sr.b, r: bytes.NewReader(sr.b)}, nil
+}
+
+func (*slowReader) Close() error { return nil }
+
+// per-request timeouts (apc.HdrTimeout)
+func TestObjectTimeout(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "timeout.bin"
+		b          = make([]byte, cos.MiB)
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	_, _ = cryptorand.Read(b)
+
+	putArgs := api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objName, Reader: cos.NewByteHandle(b), Timeout: time.Minute}
+	_, err := api.PutObject(&putArgs)
+	tassert.CheckFatal(t, err)
+
+	// timed out prior to transmitting
+	_, err = api.GetObject(baseParams, bck, objName, &api.GetArgs{Timeout: time.Nanosecond})
+	tassert.Errorf(t, api.HTTPStatus(err) == http.StatusGatewayTimeout, "expected status %d, got %v",
+		http.StatusGatewayTimeout, err)
+
+	oah, err := api.GetObject(baseParams, bck, objName, &api.GetArgs{Timeout: time.Minute})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, oah.Size() == cos.MiB, "expected size %d, got %d", cos.MiB, oah.Size())
+
+	// invalid timeout
+	_, err = api.GetObject(baseParams, bck, objName, &api.GetArgs{Header: http.Header{apc.HdrTimeout: []string{"abc"}}})
+	tassert.Errorf(t, api.HTTPStatus(err) == http.StatusBadRequest, "expected status %d, got %v",
+		http.StatusBadRequest, err)
+
+	// slow PUT (~3s) that times out
+	putArgs.ObjName = "slow.bin"
+	putArgs.Reader = &slowReader{b: b, r: bytes.NewReader(b)}
+	putArgs.Timeout = 500 * time.Millisecond
+	_, err = api.PutObject(&putArgs)
+	tassert.Fatalf(t, err != nil, "expected PUT to time out")
+	if status := api.HTTPStatus(err); status > 0 {
+		tassert.Errorf(t, status == http.StatusGatewayTimeout, "expected status %d, got %v", http.StatusGatewayTimeout, err)
+	}
+	_, err = api.HeadObject(baseParams, bck, putArgs.ObjName, apc.FltPresent, true /*silent*/)
+	tassert.Errorf(t, cmn.IsStatusNotFound(err), "expected %s not to exist, got %v", bck.Cname(putArgs.ObjName), err)
+}
+
+// previous object versions (versioning.retain)
+func TestObjectPrevVersions(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "versioned/obj"
+		retain     = 2
+	)
+	props := &cmn.BpropsToSet{Versioning: &cmn.VersionConfToSet{Enabled: apc.Bool(true), Retain: apc.Int(retain)}}
+	tools.CreateBucket(t, proxyURL, bck, props, true /*cleanup*/)
+
+	for i := 1; i <= 4; i++ {
+		putArgs := api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objName,
+			Reader: cos.NewByteHandle([]byte("content-" + strconv.Itoa(i)))}
+		_, err := api.PutObject(&putArgs)
+		tassert.CheckFatal(t, err)
+	}
+
+	// current and retained
+	for _, ver := range []int{4, 3, 2} {
+		writer := bytes.NewBuffer(nil)
+		oah, err := api.GetObjectVersion(baseParams, bck, objName, strconv.Itoa(ver), &api.GetArgs{Writer: writer})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, writer.String() == "content-"+strconv.Itoa(ver), "version %d: unexpected content %q",
+			ver, writer.String())
+		tassert.Errorf(t, oah.RespHeader().Get(apc.HdrObjVersion) == strconv.Itoa(ver), "expected version %d, got %q",
+			ver, oah.RespHeader().Get(apc.HdrObjVersion))
+	}
+
+	// pruned
+	_, err := api.GetObjectVersion(baseParams, bck, objName, "1", nil)
+	tassert.Errorf(t, cmn.IsStatusNotFound(err), "expected version 1 to be pruned, got %v", err)
+
+	// removed together with the object
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, objName))
+	_, err = api.GetObjectVersion(baseParams, bck, objName, "3", nil)
+	tassert.Errorf(t, cmn.IsStatusNotFound(err), "expected version 3 to be removed, got %v", err)
+}
+
 func TestSameBucketName(t *testing.T) {
 	var (
 		proxyURL   = tools.RandomProxyURL(t)
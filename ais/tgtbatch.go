@@ -0,0 +1,226 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"sort"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// batch object operations (apc.ActBatchObjOps): the proxy broadcasts the entire batch;
+// each target executes the items it owns (per HRW) - all or nothing:
+// 1. lock all (in the name order) and load;
+// 2. validate all;
+// 3. apply metadata updates (rolling back those already applied if any fails);
+// 4. delete - upon failure, roll back all metadata updates and skip the remaining
+//    deletions (the ones already executed are, of course, irreversible).
+
+var errBatchNotApplied = errors.New("not applied: another operation in the batch failed")
+
+type (
+	batchItem struct {
+		lom   *core.LOM
+		op    *apc.BatchOp
+		prev  cos.StrKVs // custom metadata prior to BatchSetProps (rollback)
+		cksum *cos.Cksum // ditto
+		res   apc.BatchOpResult
+	}
+	batchCtx struct {
+		t     *target
+		items []*batchItem
+	}
+)
+
+// POST /v1/buckets/bucket-name { apc.ActBatchObjOps }
+func (t *target) batchObjOps(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg) {
+	bmsg := &apc.BatchOpsMsg{}
+	if err := cos.MorphMarshal(msg.Value, bmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := bmsg.Validate(); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var (
+		smap = t.owner.smap.get()
+		ctx  = &batchCtx{t: t}
+	)
+	for i := range bmsg.Ops {
+		op := &bmsg.Ops[i]
+		lom := core.AllocLOM(op.ObjName)
+		if err := lom.InitBck(bck.Bucket()); err != nil {
+			core.FreeLOM(lom)
+			ctx.free()
+			t.writeErr(w, r, err)
+			return
+		}
+		_, local, err := lom.HrwTarget(&smap.Smap)
+		if err != nil || !local {
+			core.FreeLOM(lom)
+			continue
+		}
+		item := &batchItem{lom: lom, op: op}
+		item.res.ObjName, item.res.Idx = op.ObjName, i
+		ctx.items = append(ctx.items, item)
+	}
+
+	ctx.run()
+
+	res := &apc.BatchOpsResult{Items: make([]apc.BatchOpResult, len(ctx.items))}
+	for i, item := range ctx.items {
+		res.Items[i] = item.res
+	}
+	ctx.free()
+	t.writeJSON(w, r, res, apc.ActBatchObjOps)
+}
+
+func (ctx *batchCtx) free() {
+	for _, item := range ctx.items {
+		core.FreeLOM(item.lom)
+	}
+	ctx.items = nil
+}
+
+func (ctx *batchCtx) run() {
+	if len(ctx.items) == 0 {
+		return
+	}
+	// (in the name order to avoid deadlocks with concurrent batches)
+	sort.Slice(ctx.items, func(i, j int) bool { return ctx.items[i].lom.ObjName < ctx.items[j].lom.ObjName })
+	for _, item := range ctx.items {
+		item.lom.Lock(true)
+	}
+	defer func() {
+		for _, item := range ctx.items {
+			item.lom.Unlock(true)
+		}
+		// back to the request order
+		sort.Slice(ctx.items, func(i, j int) bool { return ctx.items[i].res.Idx < ctx.items[j].res.Idx })
+	}()
+
+	// load and validate
+	for _, item := range ctx.items {
+		if errCode, err := item.validate(); err != nil {
+			ctx.fail(item, errCode, err)
+			return
+		}
+	}
+
+	// set props
+	for i, item := range ctx.items {
+		if item.op.Op != apc.BatchSetProps {
+			continue
+		}
+		item.prev = maps.Clone(item.lom.GetCustomMD())
+		if cksum := item.lom.Checksum(); cksum != nil {
+			item.cksum = cksum.Clone()
+		}
+		applyObjProps(item.lom, item.op.Props, false /*delOldSetNew*/)
+		if err := item.lom.Persist(); err != nil {
+			ctx.rollback(i + 1) // including this one (in-memory props already applied)
+			ctx.fail(item, 0, err)
+			return
+		}
+	}
+
+	// delete (last, as the only non-revertible part)
+	for i, item := range ctx.items {
+		if item.op.Op != apc.BatchDelete {
+			continue
+		}
+		errCode, err, _ := ctx.t.delobj(item.lom, false /*evict*/)
+		if err != nil {
+			ctx.t.statsT.IncErr(stats.DeleteCount)
+			ctx.rollback(len(ctx.items))
+			ctx.failDel(i, errCode, err)
+			return
+		}
+		ctx.t.statsT.Inc(stats.DeleteCount)
+		ctx.t.replicate(item.lom, true /*del*/)
+	}
+}
+
+func (item *batchItem) validate() (int, error) {
+	lom := item.lom
+	err := lom.Load(true /*cache it*/, true /*locked*/)
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err, 0):
+		// deleting remote object that is not present in-cluster
+		if item.op.Op == apc.BatchDelete && lom.Bck().IsRemote() {
+			return 0, nil
+		}
+		return http.StatusNotFound, err
+	default:
+		return 0, err
+	}
+	if item.op.Op == apc.BatchSetProps {
+		return validateObjProps(lom, item.op.Props)
+	}
+	return 0, nil
+}
+
+// revert metadata updates [0, upto)
+func (ctx *batchCtx) rollback(upto int) {
+	for _, item := range ctx.items[:upto] {
+		if item.op.Op != apc.BatchSetProps {
+			continue
+		}
+		item.lom.SetCustomMD(item.prev)
+		item.lom.SetCksum(item.cksum)
+		if err := item.lom.Persist(); err != nil {
+			nlog.Errorln(ctx.t.String(), "batch: failed to roll back", item.lom.Cname(), "err:", err)
+		}
+	}
+}
+
+func (ctx *batchCtx) fail(failed *batchItem, errCode int, err error) {
+	for _, item := range ctx.items {
+		if item == failed {
+			item.res.Err, item.res.Status = err.Error(), _status(errCode, err)
+		} else {
+			item.res.Err = fmt.Sprintf("%v (%s)", errBatchNotApplied, failed.op.ObjName)
+			item.res.Status = http.StatusFailedDependency
+		}
+	}
+}
+
+// deletion of the item #idx failed: deletions that precede it stand, everything else is not applied
+func (ctx *batchCtx) failDel(idx, errCode int, err error) {
+	failed := ctx.items[idx]
+	for i, item := range ctx.items {
+		switch {
+		case i == idx:
+			item.res.Err, item.res.Status = err.Error(), _status(errCode, err)
+		case i < idx && item.op.Op == apc.BatchDelete:
+		default:
+			item.res.Err = fmt.Sprintf("%v (%s)", errBatchNotApplied, failed.op.ObjName)
+			item.res.Status = http.StatusFailedDependency
+		}
+	}
+}
+
+func _status(errCode int, err error) int {
+	switch {
+	case errCode != 0:
+		return errCode
+	case cos.IsNotExist(err, 0):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,189 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/readers"
+)
+
+func testBatchPut(tt *testing.T, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	r, _ := readers.NewRand(cos.KiB, cos.ChecksumNone)
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       r,
+		workFQN: path.Join(testMountpath, objName+".work"),
+		config:  cmn.GCO.Get(),
+	}
+	if _, err := poi.putObject(); err != nil {
+		tt.Fatal(err)
+	}
+}
+
+// custom metadata as persisted on disk (bypassing LOM cache)
+func testBatchCustomMD(tt *testing.T, objName string) cos.StrKVs {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	if err := lom.LoadMetaFromFS(); err != nil {
+		tt.Fatal(err)
+	}
+	return lom.GetCustomMD()
+}
+
+func testBatchRun(tt *testing.T, ops []apc.BatchOp) *batchCtx {
+	ctx := &batchCtx{t: t}
+	for i := range ops {
+		lom := core.AllocLOM(ops[i].ObjName)
+		if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+			tt.Fatal(err)
+		}
+		item := &batchItem{lom: lom, op: &ops[i]}
+		item.res.ObjName, item.res.Idx = ops[i].ObjName, i
+		ctx.items = append(ctx.items, item)
+	}
+	ctx.run()
+	return ctx
+}
+
+func TestBatchObjOps(tt *testing.T) {
+	const (
+		o1, o2, o3 = "batch/o1", "batch/o2", "batch/o3"
+		missing    = "batch/missing"
+	)
+	for _, name := range []string{o1, o2, o3} {
+		testBatchPut(tt, name)
+	}
+	tt.Cleanup(func() { os.RemoveAll(path.Join(testMountpath, "batch")) })
+
+	setProps := func(name, k, v string) apc.BatchOp {
+		return apc.BatchOp{Op: apc.BatchSetProps, ObjName: name, Props: &apc.ObjPropsToSet{Custom: cos.StrKVs{k: v}}}
+	}
+
+	tt.Run("validation-failure", func(tt *testing.T) {
+		ctx := testBatchRun(tt, []apc.BatchOp{
+			setProps(o1, "k", "v1"),
+			{Op: apc.BatchDelete, ObjName: missing},
+			{Op: apc.BatchDelete, ObjName: o2},
+		})
+		defer ctx.free()
+		if status := ctx.items[1].res.Status; status != http.StatusNotFound {
+			tt.Fatalf("expected %d for the missing object, got %d", http.StatusNotFound, status)
+		}
+		for _, i := range []int{0, 2} {
+			if status := ctx.items[i].res.Status; status != http.StatusFailedDependency {
+				tt.Fatalf("%s: expected %d, got %d", ctx.items[i].res.ObjName, http.StatusFailedDependency, status)
+			}
+		}
+		if _, ok := testBatchCustomMD(tt, o1)["k"]; ok {
+			tt.Fatalf("%s: custom metadata must not be applied", o1)
+		}
+		if _, err := os.Stat(ctx.items[2].lom.FQN); err != nil {
+			tt.Fatalf("%s must not be deleted: %v", o2, err)
+		}
+	})
+
+	tt.Run("bad-checksum", func(tt *testing.T) {
+		bad := apc.BatchOp{Op: apc.BatchSetProps, ObjName: o3,
+			Props: &apc.ObjPropsToSet{CksumType: cos.ChecksumXXHash, CksumVal: "0123456789abcdef"}}
+		ctx := testBatchRun(tt, []apc.BatchOp{setProps(o1, "k", "v1"), bad})
+		defer ctx.free()
+		if status := ctx.items[1].res.Status; status != http.StatusBadRequest {
+			tt.Fatalf("expected %d, got %d (%s)", http.StatusBadRequest, status, ctx.items[1].res.Err)
+		}
+		if ctx.items[0].res.Err == "" {
+			tt.Fatalf("%s: expected 'not applied' error", o1)
+		}
+		if _, ok := testBatchCustomMD(tt, o1)["k"]; ok {
+			tt.Fatalf("%s: custom metadata must not be applied", o1)
+		}
+	})
+
+	tt.Run("rollback", func(tt *testing.T) {
+		ctx := testBatchRun(tt, []apc.BatchOp{setProps(o1, "k", "v1"), setProps(o3, "k", "v3")})
+		for _, item := range ctx.items {
+			if item.res.Err != "" {
+				tt.Fatalf("%s: unexpected error %q", item.res.ObjName, item.res.Err)
+			}
+		}
+		if v := testBatchCustomMD(tt, o3)["k"]; v != "v3" {
+			tt.Fatalf("%s: expected custom value %q, got %q", o3, "v3", v)
+		}
+		// revert both (as in: delete failure)
+		for _, item := range ctx.items {
+			item.lom.Lock(true)
+		}
+		ctx.rollback(len(ctx.items))
+		for _, item := range ctx.items {
+			item.lom.Unlock(true)
+		}
+		ctx.free()
+		for _, name := range []string{o1, o3} {
+			if _, ok := testBatchCustomMD(tt, name)["k"]; ok {
+				tt.Fatalf("%s: custom metadata must be rolled back", name)
+			}
+		}
+	})
+
+	tt.Run("success", func(tt *testing.T) {
+		ctx := testBatchRun(tt, []apc.BatchOp{{Op: apc.BatchDelete, ObjName: o2}, setProps(o1, "k", "v1")})
+		defer ctx.free()
+		for _, item := range ctx.items {
+			if item.res.Err != "" {
+				tt.Fatalf("%s: unexpected error %q", item.res.ObjName, item.res.Err)
+			}
+		}
+		// request order restored
+		if ctx.items[0].res.ObjName != o2 || ctx.items[0].res.Idx != 0 {
+			tt.Fatalf("expected results in the request order, got %+v", ctx.items[0].res)
+		}
+		if v := testBatchCustomMD(tt, o1)["k"]; v != "v1" {
+			tt.Fatalf("%s: expected custom value %q, got %q", o1, "v1", v)
+		}
+		if _, err := os.Stat(ctx.items[0].lom.FQN); !os.IsNotExist(err) {
+			tt.Fatalf("%s: expected deleted, got %v", o2, err)
+		}
+	})
+}
+
+func TestBatchOpsMsgValidate(tt *testing.T) {
+	props := &apc.ObjPropsToSet{Custom: cos.StrKVs{"k": "v"}}
+	tests := []struct {
+		name string
+		ops  []apc.BatchOp
+		ok   bool
+	}{
+		{"empty", nil, false},
+		{"valid", []apc.BatchOp{{Op: apc.BatchSetProps, ObjName: "a", Props: props}, {Op: apc.BatchDelete, ObjName: "b"}}, true},
+		{"duplicate", []apc.BatchOp{{Op: apc.BatchDelete, ObjName: "a"}, {Op: apc.BatchSetProps, ObjName: "a", Props: props}}, false},
+		{"no-name", []apc.BatchOp{{Op: apc.BatchDelete}}, false},
+		{"no-props", []apc.BatchOp{{Op: apc.BatchSetProps, ObjName: "a"}}, false},
+		{"bad-op", []apc.BatchOp{{Op: "rename", ObjName: "a"}}, false},
+	}
+	for _, test := range tests {
+		msg := &apc.BatchOpsMsg{Ops: test.ops}
+		if err := msg.Validate(); (err == nil) != test.ok {
+			tt.Errorf("%s: expected ok=%t, got %v", test.name, test.ok, err)
+		}
+	}
+}
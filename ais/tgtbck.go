@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -68,7 +69,7 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		// (see api.ListBuckets and the line below)
 		if !qbck.IsBucket() {
 			qbck.Name = msg.Name
-			t.listBuckets(w, r, qbck)
+			t.listBuckets(w, r, qbck, dpq)
 			return
 		}
 		bck := meta.CloneBck((*cmn.Bck)(qbck))
@@ -102,6 +103,11 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			t.writeErrf(w, r, "list-objects: invalid UUID %q", lsmsg.UUID)
 			return
 		}
+		if err := t.shed.admit("list-objects page", stats.ShedListCount); err != nil {
+			t.statsT.IncErr(stats.ListCount)
+			t.writeErr(w, r, err, err.(*errShed).hdr(w))
+			return
+		}
 		if ok := t.listObjects(w, r, bck, lsmsg); !ok {
 			t.statsT.IncErr(stats.ListCount)
 			return
@@ -158,6 +164,54 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			}
 		}
 		t.bsumm(w, r, phase, bck, &bsumMsg, dpq)
+	case apc.ActHeatmapBck:
+		if len(apiItems) == 0 {
+			t.writeErrURL(w, r)
+			return
+		}
+		var hmsg apc.HeatmapMsg
+		if err := cos.MorphMarshal(msg.Value, &hmsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		hmsg.Validate()
+		qbck, err := newQbckFromQ(apiItems[0], nil, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		heatmap := core.GetHeatmap((*cmn.Bck)(qbck), hmsg.TopN)
+		if heatmap == nil {
+			heatmap = &apc.Heatmap{}
+		}
+		t.writeJSON(w, r, heatmap, apc.ActHeatmapBck)
+	case apc.ActSampleBck:
+		if len(apiItems) == 0 {
+			t.writeErrURL(w, r)
+			return
+		}
+		var smsg apc.SampleMsg
+		if err := cos.MorphMarshal(msg.Value, &smsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		smsg.Validate()
+		qbck, err := newQbckFromQ(apiItems[0], nil, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		bck := (*meta.Bck)(qbck)
+		if err := bck.Init(t.owner.bmd); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		sample, err := core.SampleBck(bck, &smsg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.writeJSON(w, r, sample, apc.ActSampleBck)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -166,13 +220,14 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 // there's a difference between looking for all (any) provider vs a specific one -
 // in the former case the fact that (the corresponding backend is not configured)
 // is not an error
-func (t *target) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks) {
+func (t *target) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, dpq *dpq) {
 	var (
-		bcks   cmn.Bcks
-		config = cmn.GCO.Get()
-		bmd    = t.owner.bmd.get()
-		err    error
-		code   int
+		bcks      cmn.Bcks
+		config    = cmn.GCO.Get()
+		bmd       = t.owner.bmd.get()
+		err       error
+		code      int
+		remAisAll = cos.IsParseBool(dpq.remAisAll) && qbck.Ns.IsGlobal() && (qbck.Provider == "" || qbck.Provider == apc.AIS)
 	)
 	if qbck.Provider != "" {
 		if qbck.IsAIS() || qbck.IsHTTP() { // built-in providers
@@ -205,6 +260,9 @@ func (t *target) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Q
 			bcks = append(bcks, buckets...)
 		}
 	}
+	if remAisAll {
+		bcks = append(bcks, t.blistRemAis(w, dpq, config, bmd)...)
+	}
 
 	sort.Sort(bcks)
 	t.writeJSON(w, r, bcks, "list-buckets")
@@ -251,6 +309,20 @@ func (t *target) blist(qbck *cmn.QueryBcks, config *cmn.Config, bmd *bucketMD) (
 	return
 }
 
+// all attached remote ais clusters (apc.QparamRemAisAll);
+// partial failure (when some of the clusters fail to respond) is reported via response header
+func (t *target) blistRemAis(w http.ResponseWriter, dpq *dpq, config *cmn.Config, bmd *bucketMD) cmn.Bcks {
+	qrais := &cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.NsAnyRemote}
+	if v, err := strconv.Atoi(dpq.fltPresence); err == nil && apc.IsFltPresent(v) {
+		return bmd.Select(qrais)
+	}
+	bcks, _, err := t.blist(qrais, config, bmd)
+	if err != nil {
+		w.Header().Set(apc.HdrRemAisErrs, strings.ReplaceAll(err.Error(), "\n", "; "))
+	}
+	return bcks
+}
+
 // returns `cmn.LsoResult` containing object names and (requested) props
 // control/scope - via `apc.LsoMsg`
 func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lsmsg *apc.LsoMsg) (ok bool) {
@@ -264,7 +336,17 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.B
 			lsmsg.PageSize = maxRemotePageSize
 		}
 	}
-	debug.Assert(lsmsg.PageSize > 0 && lsmsg.PageSize < 100000)
+	debug.Assert(lsmsg.PageSize > 0 && lsmsg.PageSize <= cmn.MaxLsoPageSize)
+
+	// "head of bucket": synchronous, no xaction
+	if lsmsg.IsFlagSet(apc.LsHead) {
+		lst, err := xs.LsoHead(bck, lsmsg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return false
+		}
+		return t.writeMsgPack(w, lst, "list_objects")
+	}
 
 	// (advanced) user-selected target to execute remote ls
 	if lsmsg.SID != "" {
@@ -424,6 +506,9 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			Xact: xctn,
 		}
 		xctn.AddNotif(notif)
+		if msg.ReqID != "" {
+			xctn.SetReqID(msg.ReqID)
+		}
 		xact.GoRunW(xctn)
 	default:
 		t.writeErrAct(w, r, msg.Action)
@@ -436,7 +521,9 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActPrefetchObjects {
+	switch msg.Action {
+	case apc.ActPrefetchObjects, apc.ActInitShard, apc.ActCheckOOB, apc.ActRenamePrefix, apc.ActBatchObjOps:
+	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -450,6 +537,44 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 
+	if msg.Action == apc.ActInitShard {
+		ishmsg := &apc.InitShardMsg{}
+		if err := cos.MorphMarshal(msg.Value, ishmsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		if errCode, err := t.runInitShard(msg.UUID, apireq.bck, ishmsg); err != nil {
+			t.writeErr(w, r, err, errCode)
+		}
+		return
+	}
+	if msg.Action == apc.ActBatchObjOps {
+		t.batchObjOps(w, r, apireq.bck, &msg.ActMsg)
+		return
+	}
+	if msg.Action == apc.ActCheckOOB {
+		oobmsg := &apc.CheckOOBMsg{}
+		if err := cos.MorphMarshal(msg.Value, oobmsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		if errCode, err := t.runCheckOOB(msg.UUID, apireq.bck, oobmsg); err != nil {
+			t.writeErr(w, r, err, errCode)
+		}
+		return
+	}
+	if msg.Action == apc.ActRenamePrefix {
+		rnpmsg := &apc.RenamePrefixMsg{}
+		if err := cos.MorphMarshal(msg.Value, rnpmsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		if errCode, err := t.runRenamePrefix(msg.UUID, apireq.bck, rnpmsg); err != nil {
+			t.writeErr(w, r, err, errCode)
+		}
+		return
+	}
+
 	prfMsg := &apc.PrefetchMsg{}
 	if err := cos.MorphMarshal(msg.Value, prfMsg); err != nil {
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
@@ -457,6 +582,8 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	}
 	if errCode, err := t.runPrefetch(msg.UUID, apireq.bck, prfMsg); err != nil {
 		t.writeErr(w, r, err, errCode)
+	} else if msg.ReqID != "" {
+		setXactReqID(msg.UUID, msg.ReqID)
 	}
 }
 
@@ -482,6 +609,77 @@ func (t *target) runPrefetch(xactID string, bck *meta.Bck, prfMsg *apc.PrefetchM
 	return 0, nil
 }
 
+// handle apc.ActInitShard <-- via api.InitShard
+func (t *target) runInitShard(xactID string, bck *meta.Bck, msg *apc.InitShardMsg) (int, error) {
+	cs := fs.Cap()
+	if err := cs.Err(); err != nil {
+		return http.StatusInsufficientStorage, err
+	}
+	if err := msg.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+	rns := xreg.RenewInitShard(xactID, bck, msg)
+	if rns.Err != nil {
+		return http.StatusBadRequest, rns.Err
+	}
+
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+
+	xact.GoRunW(xctn)
+	return 0, nil
+}
+
+// handle apc.ActCheckOOB <-- via api.CheckOOB
+func (t *target) runCheckOOB(xactID string, bck *meta.Bck, msg *apc.CheckOOBMsg) (int, error) {
+	if err := msg.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if err := t.shed.admit("out-of-band scrub", stats.ShedScrubCount); err != nil {
+		return http.StatusServiceUnavailable, err
+	}
+	rns := xreg.RenewCheckOOB(xactID, bck, msg)
+	if rns.Err != nil {
+		return http.StatusBadRequest, rns.Err
+	}
+
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+
+	xact.GoRunW(xctn)
+	return 0, nil
+}
+
+// handle apc.ActRenamePrefix <-- via api.RenamePrefix
+func (t *target) runRenamePrefix(xactID string, bck *meta.Bck, msg *apc.RenamePrefixMsg) (int, error) {
+	cs := fs.Cap()
+	if err := cs.Err(); err != nil {
+		return http.StatusInsufficientStorage, err
+	}
+	rns := xreg.RenewRenamePrefix(xactID, bck, msg)
+	if rns.Err != nil {
+		return http.StatusBadRequest, rns.Err
+	}
+
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+
+	xact.GoRunW(xctn)
+	return 0, nil
+}
+
 // HEAD /v1/buckets/bucket-name
 func (t *target) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	var (
@@ -543,6 +741,13 @@ func (t *target) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *api
 				nlog.Errorf("%s: %s versioning got out of sync: %s != %s", t, apireq.bck, v, curr)
 			}
 		}
+		if k == apc.HdrBucketAccess && apireq.bck.Props != nil {
+			if curr := apireq.bck.Props.Access.String(); curr != v {
+				// e.g., S3 bucket policy changed after the bucket was added (see feat.S3ImportBucketACL)
+				nlog.Warningf("%s: %s access attributes differ from the remote: %s != %s (tip: evict the bucket to re-import)",
+					t, apireq.bck, v, curr)
+			}
+		}
 		hdr.Set(k, v)
 	}
 }
@@ -0,0 +1,111 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// cold GET admission control (see cmn.ColdGetConf):
+// - at most `max_active` concurrent backend reads (cold GETs) per target;
+// - up to `max_queued` additional cold GETs wait for their turn (but no longer than `queue_timeout`);
+//   max_queued = -1 (cmn.ColdGetNoQueue) disables queueing;
+// - all the rest get rejected with http.StatusServiceUnavailable and Retry-After
+
+type (
+	coldq struct {
+		sema   chan struct{} // (re)created when max_active changes
+		queued atomic.Int32
+		mu     sync.Mutex
+	}
+	errColdGetBusy struct {
+		retryAfter time.Duration
+		active     int
+		queued     int
+		timedout   bool
+	}
+)
+
+func (q *coldq) _sema(maxActive int) (sema chan struct{}) {
+	q.mu.Lock()
+	if q.sema == nil || cap(q.sema) != maxActive {
+		q.sema = make(chan struct{}, maxActive)
+	}
+	sema = q.sema
+	q.mu.Unlock()
+	return sema
+}
+
+// non-blocking: returns nil when there are max_active cold GETs
+func (q *coldq) try() chan struct{} {
+	sema := q._sema(cmn.GCO.Get().ColdGet.MaxActive)
+	select {
+	case sema <- struct{}{}:
+		return sema
+	default:
+		return nil
+	}
+}
+
+// returns semaphore to release when done (see getOI.getObject);
+// may block for up to `queue_timeout` - the caller must not be holding object locks
+func (q *coldq) acquire(t *target) (chan struct{}, error) {
+	var (
+		conf = &cmn.GCO.Get().ColdGet
+		sema = q._sema(conf.MaxActive)
+	)
+	select {
+	case sema <- struct{}{}:
+		return sema, nil
+	default:
+	}
+
+	// queue
+	n := int(q.queued.Inc())
+	defer q.queued.Dec()
+	if n > max(conf.MaxQueued, 0) {
+		t.statsT.IncErr(stats.ErrGetColdRejectedCount)
+		return nil, &errColdGetBusy{retryAfter: conf.QueueTimeout.D(), active: len(sema), queued: n - 1}
+	}
+	t.statsT.Inc(stats.GetColdQueuedCount)
+	timer := time.NewTimer(conf.QueueTimeout.D())
+	select {
+	case sema <- struct{}{}:
+		timer.Stop()
+		return sema, nil
+	case <-timer.C:
+		t.statsT.IncErr(stats.ErrGetColdRejectedCount)
+		return nil, &errColdGetBusy{retryAfter: conf.QueueTimeout.D(), active: len(sema), queued: n, timedout: true}
+	}
+}
+
+////////////////////
+// errColdGetBusy //
+////////////////////
+
+func (e *errColdGetBusy) Error() string {
+	s := "queue is full"
+	if e.timedout {
+		s = "timed out waiting in the queue"
+	}
+	return fmt.Sprintf("too many concurrent cold GETs (active %d, queued %d): %s, please retry after %v",
+		e.active, e.queued, s, e.retryAfter)
+}
+
+// set Retry-After (seconds) and return http status
+func (e *errColdGetBusy) hdr(w http.ResponseWriter) int {
+	secs := max(int64((e.retryAfter+time.Second-1)/time.Second), 1)
+	w.Header().Set(cos.HdrRetryAfter, strconv.FormatInt(secs, 10))
+	return http.StatusServiceUnavailable
+}
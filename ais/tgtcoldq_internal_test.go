@@ -0,0 +1,190 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func testColdConf(tt *testing.T, maxActive, maxQueued int, timeout time.Duration) {
+	prev := cmn.GCO.Get()
+	config := cmn.GCO.BeginUpdate()
+	config.ColdGet = cmn.ColdGetConf{MaxActive: maxActive, MaxQueued: maxQueued, QueueTimeout: cos.Duration(timeout)}
+	cmn.GCO.CommitUpdate(config)
+	tt.Cleanup(func() {
+		cmn.GCO.BeginUpdate()
+		cmn.GCO.CommitUpdate(prev)
+	})
+}
+
+func testColdBusy(tt *testing.T, err error, timedout bool) *errColdGetBusy {
+	var busy *errColdGetBusy
+	if !errors.As(err, &busy) {
+		tt.Fatalf("expected errColdGetBusy, got %v", err)
+	}
+	if busy.timedout != timedout {
+		tt.Fatalf("expected timedout=%t, got %v", timedout, busy)
+	}
+	return busy
+}
+
+func TestColdqQueueFull(tt *testing.T) {
+	const timeout = 10 * time.Second
+	testColdConf(tt, 1, 1, timeout)
+	q := &coldq{}
+
+	sema, err := q.acquire(t)
+	if err != nil {
+		tt.Fatal(err)
+	}
+
+	// the one and only waiter
+	ch := make(chan error, 1)
+	go func() {
+		_, err := q.acquire(t)
+		ch <- err
+	}()
+	for q.queued.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// no room in the queue: rejected right away
+	started := time.Now()
+	_, err = q.acquire(t)
+	busy := testColdBusy(tt, err, false)
+	if elapsed := time.Since(started); elapsed > timeout/2 {
+		tt.Fatalf("expected immediate rejection, took %v", elapsed)
+	}
+	if busy.active != 1 || busy.queued != 1 || busy.retryAfter != timeout {
+		tt.Fatalf("unexpected %+v", busy)
+	}
+
+	// release: the waiter gets admitted
+	<-sema
+	if err := <-ch; err != nil {
+		tt.Fatalf("expected the queued cold GET to proceed, got %v", err)
+	}
+	if n := q.queued.Load(); n != 0 {
+		tt.Fatalf("expected empty queue, got %d", n)
+	}
+}
+
+func TestColdqQueueTimeout(tt *testing.T) {
+	const timeout = 50 * time.Millisecond
+	testColdConf(tt, 1, 4, timeout)
+	q := &coldq{}
+
+	if _, err := q.acquire(t); err != nil {
+		tt.Fatal(err)
+	}
+	started := time.Now()
+	_, err := q.acquire(t)
+	busy := testColdBusy(tt, err, true)
+	if elapsed := time.Since(started); elapsed < timeout {
+		tt.Fatalf("expected to wait at least %v, waited %v", timeout, elapsed)
+	}
+	if busy.active != 1 || busy.queued != 1 {
+		tt.Fatalf("unexpected %+v", busy)
+	}
+	if n := q.queued.Load(); n != 0 {
+		tt.Fatalf("expected empty queue, got %d", n)
+	}
+}
+
+func TestColdqNoQueue(tt *testing.T) {
+	testColdConf(tt, 1, cmn.ColdGetNoQueue, time.Second)
+	q := &coldq{}
+
+	if _, err := q.acquire(t); err != nil {
+		tt.Fatal(err)
+	}
+	_, err := q.acquire(t)
+	if busy := testColdBusy(tt, err, false); busy.active != 1 || busy.queued != 0 {
+		tt.Fatalf("unexpected %+v", busy)
+	}
+}
+
+func TestColdqRetryAfter(tt *testing.T) {
+	tests := []struct {
+		retryAfter time.Duration
+		expected   string
+	}{
+		{0, "1"},
+		{100 * time.Millisecond, "1"},
+		{time.Second, "1"},
+		{1500 * time.Millisecond, "2"},
+		{3 * time.Second, "3"},
+	}
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		busy := &errColdGetBusy{retryAfter: test.retryAfter}
+		if status := busy.hdr(rec); status != http.StatusServiceUnavailable {
+			tt.Fatalf("expected status %d, got %d", http.StatusServiceUnavailable, status)
+		}
+		if v := rec.Header().Get(cos.HdrRetryAfter); v != test.expected {
+			tt.Errorf("%v: expected Retry-After %q, got %q", test.retryAfter, test.expected, v)
+		}
+	}
+}
+
+// changing max_active replaces the semaphore; active holders keep (and release) the old one
+func TestColdqResize(tt *testing.T) {
+	testColdConf(tt, 2, 0, time.Second)
+	q := &coldq{}
+
+	var holders []chan struct{}
+	for i := 0; i < 2; i++ {
+		sema, err := q.acquire(t)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		holders = append(holders, sema)
+	}
+	_, err := q.acquire(t)
+	testColdBusy(tt, err, false)
+
+	testColdConf(tt, 3, 0, time.Second)
+	var resized []chan struct{}
+	for i := 0; i < 3; i++ {
+		sema, err := q.acquire(t)
+		if err != nil {
+			tt.Fatalf("acquire #%d after resize: %v", i, err)
+		}
+		if sema == holders[0] || cap(sema) != 3 {
+			tt.Fatalf("expected new semaphore of capacity 3, got %d", cap(sema))
+		}
+		resized = append(resized, sema)
+	}
+	_, err = q.acquire(t)
+	testColdBusy(tt, err, false)
+
+	// releasing the old semaphore must neither block nor free up new slots
+	done := make(chan struct{})
+	go func() {
+		for _, sema := range holders {
+			<-sema
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		tt.Fatal("timed out releasing the old semaphore")
+	}
+	_, err = q.acquire(t)
+	testColdBusy(tt, err, false)
+
+	<-resized[0]
+	if _, err := q.acquire(t); err != nil {
+		tt.Fatalf("expected a free slot, got %v", err)
+	}
+}
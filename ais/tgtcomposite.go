@@ -0,0 +1,342 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// composite objects (see apc.CompositeManifest and core/composite.go):
+// - PUT(object) with apc.QparamComposite: validate the members, resolve their sizes, and
+//   store the resulting manifest as the object's content;
+// - GET(object): stream the members - local and remote - concatenated, in order;
+//   the members are expected to remain unchanged (a size mismatch fails the GET).
+// Composite objects do not nest.
+
+func errNestedComposite(name string) error {
+	return fmt.Errorf("member %q is itself a composite object (nesting not supported)", name)
+}
+
+func errMemberChanged(name string, expected, actual int64) error {
+	return fmt.Errorf("member %q has changed: size %d, expected %d", name, actual, expected)
+}
+
+//
+// PUT
+//
+
+// replace request body with the resolved manifest
+func (poi *putOI) composite(body io.Reader) (int, error) {
+	lom := poi.lom
+	if !lom.Bck().IsAIS() {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("create composite object in", lom.Bck().Cname(""))
+	}
+	b, err := io.ReadAll(io.LimitReader(body, apc.MaxCompositeManifestSize+1))
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if len(b) > apc.MaxCompositeManifestSize {
+		return http.StatusRequestEntityTooLarge, fmt.Errorf("%s: composite manifest exceeds %s",
+			lom, cos.ToSizeIEC(apc.MaxCompositeManifestSize, 0))
+	}
+	manifest := &apc.CompositeManifest{}
+	if err := jsoniter.Unmarshal(b, manifest); err != nil {
+		return http.StatusBadRequest, fmt.Errorf("%s: invalid composite manifest: %v", lom, err)
+	}
+	if err := manifest.Validate(lom.ObjName); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if errCode, err := poi.t.resolveMembers(lom.Bck(), manifest); err != nil {
+		return errCode, err
+	}
+	b = cos.MustMarshal(manifest)
+	if len(b) > apc.MaxCompositeManifestSize {
+		return http.StatusRequestEntityTooLarge, fmt.Errorf("%s: composite manifest exceeds %s",
+			lom, cos.ToSizeIEC(apc.MaxCompositeManifestSize, 0))
+	}
+	poi.r = io.NopCloser(bytes.NewReader(b)) // (request body gets closed by net/http)
+	poi.size = int64(len(b))
+	poi.cksumToUse = nil
+	lom.SetCustomKey(cmn.CompositeObjMD, core.CompositeDigest(b))
+	return 0, nil
+}
+
+// fill-in member sizes and the total
+func (t *target) resolveMembers(bck *meta.Bck, manifest *apc.CompositeManifest) (int, error) {
+	var (
+		smap  = t.owner.smap.get()
+		sizes = make(map[string]int64, len(manifest.Members))
+	)
+	manifest.Size = 0
+	for i := range manifest.Members {
+		m := &manifest.Members[i]
+		size, ok := sizes[m.Name]
+		if !ok {
+			var (
+				errCode int
+				err     error
+			)
+			if size, errCode, err = t.headMember(bck, m.Name, smap); err != nil {
+				return errCode, err
+			}
+			sizes[m.Name] = size
+		}
+		m.Size = size
+		manifest.Size += size
+	}
+	return 0, nil
+}
+
+func (t *target) headMember(bck *meta.Bck, objName string, smap *smapX) (int64, int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return 0, 0, err
+	}
+	tsi, local, err := lom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return 0, 0, err
+	}
+	if local {
+		if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+			if cos.IsNotExist(err, 0) {
+				return 0, http.StatusNotFound, err
+			}
+			return 0, 0, err
+		}
+		if lom.IsComposite() {
+			return 0, http.StatusBadRequest, errNestedComposite(objName)
+		}
+		return lom.SizeBytes(), 0, nil
+	}
+
+	// HEAD(member) from its owner
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodHead,
+			Header: http.Header{
+				apc.HdrCallerID:   []string{t.SID()},
+				apc.HdrCallerName: []string{t.callerName()},
+			},
+			Base:  tsi.URL(cmn.NetIntraControl),
+			Path:  apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+			Query: lom.Bck().NewQuery(),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		errCode, err := res.status, res.err
+		freeCR(res)
+		return 0, errCode, err
+	}
+	var oa cmn.ObjAttrs
+	oa.FromHeader(res.header)
+	freeCR(res)
+	if _, ok := oa.GetCustomKey(cmn.CompositeObjMD); ok {
+		return 0, http.StatusBadRequest, errNestedComposite(objName)
+	}
+	return oa.Size, 0, nil
+}
+
+//
+// GET
+//
+
+// (under rlock)
+func (goi *getOI) getComposite(manifest *apc.CompositeManifest) (int, error) {
+	var (
+		lom    = goi.lom
+		hdr    = goi.w.Header()
+		off    int64
+		length = manifest.Size
+	)
+	if goi.archive.filename != "" {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("read archived file from composite object", lom.Cname())
+	}
+	if goi.ranges.Range != "" {
+		hrng, errCode, err := goi.parseRange(hdr, manifest.Size)
+		if err != nil {
+			return errCode, err
+		}
+		if hrng != nil {
+			off, length = hrng.Start, hrng.Length
+		}
+	}
+
+	var (
+		smap    = goi.t.owner.smap.get()
+		total   = length
+		written int64
+	)
+	buf, slab := goi.t.gmm.AllocSize(min(length, 64*cos.KiB))
+	defer slab.Free(buf)
+
+	for i := range manifest.Members {
+		if length <= 0 {
+			break
+		}
+		m := &manifest.Members[i]
+		if off >= m.Size {
+			off -= m.Size
+			continue
+		}
+		n := min(m.Size-off, length)
+		r, errCode, err := goi.t.openMember(lom.Bck(), m, off, n, smap)
+		if err != nil {
+			if written == 0 {
+				hdr.Del(cos.HdrContentRange)
+				return errCode, cmn.NewErrFailedTo(goi.t, "GET composite", lom.Cname(), err, errCode)
+			}
+			nlog.Errorln(goi.t.String()+":", "GET composite", lom.Cname(), "failed:", err)
+			return 0, errSendingResp
+		}
+		if written == 0 {
+			compositeHdr(hdr, lom, total)
+		}
+		nw, err := cos.CopyBuffer(goi.w, r, buf)
+		cos.Close(r)
+		written += nw
+		if err == nil && nw != n {
+			err = errMemberChanged(m.Name, n, nw)
+		}
+		if err != nil {
+			nlog.Errorln(goi.t.String()+":", "GET composite", lom.Cname(), "failed:", err)
+			return 0, errSendingResp
+		}
+		off = 0
+		length -= n
+	}
+	if written == 0 { // (empty members or range)
+		compositeHdr(hdr, lom, 0)
+	}
+	goi.stats(written)
+	return 0, nil
+}
+
+// (the checksum is the manifest's - not the content's)
+func compositeHdr(hdr http.Header, lom *core.LOM, size int64) {
+	cmn.ToHeader(lom.ObjAttrs(), hdr)
+	hdr.Del(apc.HdrObjCksumVal)
+	hdr.Del(apc.HdrObjCksumType)
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	hdr.Set(cos.HdrContentType, cos.ContentBinary)
+}
+
+// open member's [off, off+n) byte range for reading
+func (t *target) openMember(bck *meta.Bck, m *apc.CompositeMember, off, n int64, smap *smapX) (io.ReadCloser, int, error) {
+	lom := core.AllocLOM(m.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return nil, 0, err
+	}
+	tsi, local, err := lom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !local {
+		return t.getMemberT2T(lom, tsi, m, off, n)
+	}
+
+	var fh cos.LomReader
+	lom.Lock(false)
+	err = lom.Load(true /*cache it*/, true /*locked*/)
+	switch {
+	case err != nil:
+	case lom.IsComposite():
+		err = errNestedComposite(m.Name)
+	case lom.SizeBytes() != m.Size:
+		err = errMemberChanged(m.Name, m.Size, lom.SizeBytes())
+	default:
+		// (the handle remains valid even if the member gets overwritten in the meantime)
+		fh, err = lom.NewHandle()
+	}
+	lom.Unlock(false)
+	if err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusConflict, err
+	}
+	if off > 0 {
+		if _, err := fh.Seek(off, io.SeekStart); err != nil {
+			cos.Close(fh)
+			return nil, 0, err
+		}
+	}
+	return &memberReader{Reader: io.LimitReader(fh, n), c: fh}, 0, nil
+}
+
+func (t *target) getMemberT2T(lom *core.LOM, tsi *meta.Snode, m *apc.CompositeMember, off, n int64) (io.ReadCloser, int, error) {
+	query := lom.Bck().NewQuery()
+	query.Set(apc.QparamComposite, "false") // do not nest
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		}
+		if off > 0 || n < m.Size {
+			reqArgs.Header.Set(cos.HdrRange, fmt.Sprintf("%s%d-%d", cos.HdrRangeValPrefix, off, off+n-1))
+		}
+		reqArgs.Path = apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName)
+		reqArgs.Query = query
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, cos.KiB))
+		resp.Body.Close()
+		if herr := cmn.Str2HTTPErr(string(b)); herr != nil {
+			return nil, resp.StatusCode, herr
+		}
+		return nil, resp.StatusCode, fmt.Errorf("%s: failed to GET member %q from %s: %s",
+			t, m.Name, tsi.StringEx(), http.StatusText(resp.StatusCode))
+	}
+	var oa cmn.ObjAttrs
+	oa.FromHeader(resp.Header)
+	if _, ok := oa.GetCustomKey(cmn.CompositeObjMD); ok {
+		err = errNestedComposite(m.Name)
+	} else if resp.ContentLength != n {
+		err = errMemberChanged(m.Name, n, resp.ContentLength)
+	}
+	if err != nil {
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+		return nil, http.StatusConflict, err
+	}
+	return resp.Body, 0, nil
+}
+
+type memberReader struct {
+	io.Reader
+	c io.Closer
+}
+
+func (r *memberReader) Close() error { return r.c.Close() }
@@ -0,0 +1,339 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/trand"
+)
+
+// the other target (in a two-target cluster) that stores "remote" members
+type compositePeer struct {
+	srv    *httptest.Server
+	objs   map[string]string // object name => content
+	nested cos.StrSet        // composite objects
+	mu     sync.Mutex
+}
+
+func (peer *compositePeer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	objName := strings.TrimPrefix(r.URL.Path, apc.URLPathObjects.Join(testBucket)+"/")
+	peer.mu.Lock()
+	content, ok := peer.objs[objName]
+	nested := peer.nested.Contains(objName)
+	peer.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if r.Method == http.MethodGet && r.URL.Query().Get(apc.QparamComposite) != "false" {
+		w.WriteHeader(http.StatusBadRequest) // (members are read as is)
+		return
+	}
+	if nested {
+		w.Header().Set(apc.HdrObjCustomMD, cmn.CompositeObjMD+"="+core.CompositeDigest([]byte(content)))
+	}
+	http.ServeContent(&rangeRW{w}, r, objName, time.Time{}, strings.NewReader(content))
+}
+
+// (range read with status 200, as in goi.parseRange)
+type rangeRW struct {
+	http.ResponseWriter
+}
+
+func (rw *rangeRW) WriteHeader(status int) {
+	if status == http.StatusPartialContent {
+		status = http.StatusOK
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (peer *compositePeer) put(objName, content string) {
+	peer.mu.Lock()
+	peer.objs[objName] = content
+	peer.mu.Unlock()
+}
+
+func testCompositeInit(tt *testing.T) *compositePeer {
+	if g.client.data == nil {
+		config := cmn.GCO.Get()
+		initCtrlClient(config)
+		initDataClient(config)
+	}
+	if t.keepalive == nil {
+		t.keepalive = newTalive(t, t.statsT, atomic.NewBool(true))
+	}
+	peer := &compositePeer{objs: make(map[string]string), nested: cos.NewStrSet()}
+	peer.srv = httptest.NewServer(peer)
+
+	other := &meta.Snode{
+		PubNet:     meta.NetInfo{URL: peer.srv.URL},
+		ControlNet: meta.NetInfo{URL: peer.srv.URL},
+		DataNet:    meta.NetInfo{URL: peer.srv.URL},
+	}
+	other.Init("composite-peer", apc.Target)
+	prev := t.owner.smap.get()
+	smap := newSmap()
+	smap.addTarget(t.si)
+	smap.addTarget(other)
+	t.owner.smap.put(smap)
+
+	tt.Cleanup(func() {
+		peer.srv.Close()
+		if prev != nil {
+			t.owner.smap.put(prev)
+		} else {
+			t.owner.smap.smap.Store(nil)
+		}
+	})
+	return peer
+}
+
+// object names that this target (local) or the peer owns
+func testCompositeName(tt *testing.T, prefix string, local bool) string {
+	smap := t.owner.smap.get()
+	for {
+		objName := prefix + trand.String(8)
+		lom := core.AllocLOM(objName)
+		if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+			tt.Fatal(err)
+		}
+		_, isLocal, err := lom.HrwTarget(&smap.Smap)
+		core.FreeLOM(lom)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		if isLocal == local {
+			return objName
+		}
+	}
+}
+
+func testCompositePut(tt *testing.T, objName string, content []byte, composite bool) (int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	tt.Cleanup(func() { os.Remove(lom.FQN) })
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       io.NopCloser(bytes.NewReader(content)),
+		size:    int64(len(content)),
+		workFQN: lom.FQN + ".work",
+		config:  cmn.GCO.Get(),
+	}
+	if composite {
+		if errCode, err := poi.composite(poi.r); err != nil {
+			return errCode, err
+		}
+	}
+	return poi.putObject()
+}
+
+func testCompositeManifest(names ...string) []byte {
+	manifest := &apc.CompositeManifest{}
+	for _, name := range names {
+		manifest.Members = append(manifest.Members, apc.CompositeMember{Name: name})
+	}
+	return cos.MustMarshal(manifest)
+}
+
+func testCompositeGet(tt *testing.T, objName, rng string) (*httptest.ResponseRecorder, int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(true, true); err != nil {
+		tt.Fatal(err)
+	}
+	manifest, err := lom.LoadComposite()
+	if err != nil || manifest == nil {
+		tt.Fatalf("%s: expected composite object, got %v", objName, err)
+	}
+	rec := httptest.NewRecorder()
+	goi := &getOI{w: rec, t: t, lom: lom, ltime: mono.NanoTime()}
+	goi.ranges.Range = rng
+	errCode, err := goi.getComposite(manifest)
+	return rec, errCode, err
+}
+
+func TestCompositeObject(tt *testing.T) {
+	peer := testCompositeInit(tt)
+	var (
+		local1  = testCompositeName(tt, "composite/", true)
+		local2  = testCompositeName(tt, "composite/", true)
+		remote1 = testCompositeName(tt, "composite/", false)
+		content = map[string]string{local1: "0123456789", local2: "abc", remote1: "ABCDEFGHIJKLMNOPQRST"}
+		members = []string{local1, remote1, local2, local1} // (duplicates are fine)
+		objName = testCompositeName(tt, "composite/obj-", true)
+	)
+	for _, name := range []string{local1, local2} {
+		if _, err := testCompositePut(tt, name, []byte(content[name]), false); err != nil {
+			tt.Fatal(err)
+		}
+	}
+	peer.put(remote1, content[remote1])
+
+	if _, err := testCompositePut(tt, objName, testCompositeManifest(members...), true); err != nil {
+		tt.Fatal(err)
+	}
+	var expected string
+	for _, name := range members {
+		expected += content[name]
+	}
+
+	// resolved manifest
+	lom := core.AllocLOM(objName)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	if err := lom.Load(false, false); err != nil {
+		tt.Fatal(err)
+	}
+	manifest, err := lom.LoadComposite()
+	core.FreeLOM(lom)
+	if err != nil || manifest == nil {
+		tt.Fatalf("expected composite object, got %v", err)
+	}
+	if manifest.Size != int64(len(expected)) {
+		tt.Fatalf("expected total size %d, got %d", len(expected), manifest.Size)
+	}
+	for i, m := range manifest.Members {
+		if m.Size != int64(len(content[m.Name])) {
+			tt.Fatalf("member #%d %q: expected size %d, got %d", i, m.Name, len(content[m.Name]), m.Size)
+		}
+	}
+
+	tests := []struct {
+		rng        string
+		start, end int // expected[start:end]
+	}{
+		{"", 0, len(expected)},
+		{"bytes=0-4", 0, 5},              // first member only
+		{"bytes=5-14", 5, 15},            // local => remote
+		{"bytes=12-34", 12, 35},          // inside remote => local2 => local1
+		{"bytes=30-", 30, len(expected)}, // tail
+		{fmt.Sprintf("bytes=-%d", 4), len(expected) - 4, len(expected)}, // suffix
+	}
+	for _, test := range tests {
+		rec, errCode, err := testCompositeGet(tt, objName, test.rng)
+		if err != nil {
+			tt.Fatalf("range %q: %v(%d)", test.rng, err, errCode)
+		}
+		if got, want := rec.Body.String(), expected[test.start:test.end]; got != want {
+			tt.Fatalf("range %q: expected %q, got %q", test.rng, want, got)
+		}
+		if cl := rec.Header().Get(cos.HdrContentLength); cl != fmt.Sprint(test.end-test.start) {
+			tt.Fatalf("range %q: expected Content-Length %d, got %s", test.rng, test.end-test.start, cl)
+		}
+	}
+}
+
+func TestCompositePutErrors(tt *testing.T) {
+	peer := testCompositeInit(tt)
+	var (
+		local   = testCompositeName(tt, "composite-err/", true)
+		remote  = testCompositeName(tt, "composite-err/", false)
+		nestedL = testCompositeName(tt, "composite-err/", true)
+		nestedR = testCompositeName(tt, "composite-err/", false)
+		missing = []string{testCompositeName(tt, "composite-err/", true), testCompositeName(tt, "composite-err/", false)}
+		objName = testCompositeName(tt, "composite-err/obj-", true)
+	)
+	if _, err := testCompositePut(tt, local, []byte("local"), false); err != nil {
+		tt.Fatal(err)
+	}
+	if _, err := testCompositePut(tt, nestedL, testCompositeManifest(local), true); err != nil {
+		tt.Fatal(err)
+	}
+	peer.put(remote, "remote")
+	peer.put(nestedR, string(testCompositeManifest(remote)))
+	peer.nested.Add(nestedR)
+
+	tests := []struct {
+		name    string
+		body    []byte
+		errCode int
+	}{
+		{"missing-local", testCompositeManifest(local, missing[0]), http.StatusNotFound},
+		{"missing-remote", testCompositeManifest(remote, missing[1]), http.StatusNotFound},
+		{"nested-local", testCompositeManifest(local, nestedL), http.StatusBadRequest},
+		{"nested-remote", testCompositeManifest(remote, nestedR), http.StatusBadRequest},
+		{"self", testCompositeManifest(local, objName), http.StatusBadRequest},
+		{"empty", testCompositeManifest(), http.StatusBadRequest},
+		{"invalid-json", []byte("{\"members\": ["), http.StatusBadRequest},
+		{"too-large", bytes.Repeat([]byte{' '}, apc.MaxCompositeManifestSize+1), http.StatusRequestEntityTooLarge},
+	}
+	for _, test := range tests {
+		errCode, err := testCompositePut(tt, objName, test.body, true)
+		if err == nil || errCode != test.errCode {
+			tt.Errorf("%s: expected error %d, got %v(%d)", test.name, test.errCode, err, errCode)
+		}
+	}
+}
+
+// members must remain unchanged: fail the GET before sending anything, if possible
+func TestCompositeMemberChanged(tt *testing.T) {
+	peer := testCompositeInit(tt)
+	var (
+		local  = testCompositeName(tt, "composite-chg/", true)
+		remote = testCompositeName(tt, "composite-chg/", false)
+		obj1   = testCompositeName(tt, "composite-chg/obj-", true)
+		obj2   = testCompositeName(tt, "composite-chg/obj-", true)
+		obj3   = testCompositeName(tt, "composite-chg/obj-", true)
+	)
+	if _, err := testCompositePut(tt, local, []byte("local"), false); err != nil {
+		tt.Fatal(err)
+	}
+	peer.put(remote, "remote")
+	for objName, members := range map[string][]string{obj1: {local, remote}, obj2: {remote, local}, obj3: {local, local}} {
+		if _, err := testCompositePut(tt, objName, testCompositeManifest(members...), true); err != nil {
+			tt.Fatal(err)
+		}
+	}
+
+	// remote member changed: obj1 fails midway, obj2 upfront
+	peer.put(remote, "remote+appended")
+	if rec, _, err := testCompositeGet(tt, obj1, ""); err != errSendingResp || rec.Body.String() != "local" {
+		tt.Fatalf("%s: expected %v after sending the first member, got %v (%q)", obj1, errSendingResp, err, rec.Body.String())
+	}
+	if rec, errCode, err := testCompositeGet(tt, obj2, ""); err == nil || errCode != http.StatusConflict || rec.Body.Len() != 0 {
+		tt.Fatalf("%s: expected %d and nothing sent, got %v(%d), %d bytes", obj2, http.StatusConflict, err, errCode, rec.Body.Len())
+	}
+
+	// local member changed
+	if _, err := testCompositePut(tt, local, []byte("local+appended"), false); err != nil {
+		tt.Fatal(err)
+	}
+	if _, errCode, err := testCompositeGet(tt, obj3, ""); err == nil || errCode != http.StatusConflict {
+		tt.Fatalf("%s: expected %d, got %v(%d)", obj3, http.StatusConflict, err, errCode)
+	}
+	// (reading the unchanged range is fine)
+	peer.put(remote, "remote")
+	if rec, _, err := testCompositeGet(tt, obj2, "bytes=0-5"); err != nil || rec.Body.String() != "remote" {
+		tt.Fatalf("%s: expected %q, got %v (%q)", obj2, "remote", err, rec.Body.String())
+	}
+}
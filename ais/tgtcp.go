@@ -76,8 +76,14 @@ func (t *target) recvCluMetaBytes(action string, body []byte, caller string) err
 	}
 
 	debug.Assert(cm.PrimeTime != 0, t.String()) // expecting
+	now := time.Now().UnixNano()
+	if action == apc.ActSelfJoinTarget && !t.NodeStarted() {
+		if err := checkClockSkew(t.String(), cm.PrimeTime, now, cmn.GCO.Get()); err != nil {
+			return err
+		}
+	}
 	xreg.PrimeTime.Store(cm.PrimeTime)
-	xreg.MyTime.Store(time.Now().UnixNano())
+	xreg.MyTime.Store(now)
 
 	msg := t.newAmsgStr(action, cm.BMD)
 
@@ -286,8 +292,8 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		httpdaeWhat = "httpdaeget-" + getWhat
 	)
 	switch getWhat {
-	case apc.WhatNodeConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
-		apc.WhatSnode, apc.WhatLog, apc.WhatNodeStats, apc.WhatMetricNames:
+	case apc.WhatNodeConfig, apc.WhatNodeOverride, apc.WhatNodeComputed, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
+		apc.WhatSnode, apc.WhatLog, apc.WhatProfile, apc.WhatNodeStats, apc.WhatMetricNames, apc.WhatSLO:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	case apc.WhatSysInfo:
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
@@ -325,6 +331,29 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		diskStats := make(ios.AllDiskStats)
 		fs.FillDiskStats(diskStats)
 		t.writeJSON(w, r, diskStats, httpdaeWhat)
+	case apc.WhatCapHistory:
+		var since int64
+		if s := query.Get(apc.QparamSince); s != "" {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				t.writeErrf(w, r, "invalid %s=%q: %v", apc.QparamSince, s, err)
+				return
+			}
+			since = time.Now().UnixNano() - int64(d)
+		}
+		tstats := t.statsT.(*stats.Trunner)
+		t.writeJSON(w, r, tstats.CapHistory(since), httpdaeWhat)
+	case apc.WhatBckUsage:
+		period := query.Get(apc.QparamPeriod)
+		if period != "" {
+			if _, err := time.Parse(stats.BckUsagePeriodFmt, period); err != nil {
+				t.writeErrf(w, r, "invalid %s=%q (expecting calendar month, e.g. %q)", apc.QparamPeriod, period,
+					time.Now().UTC().Format(stats.BckUsagePeriodFmt))
+				return
+			}
+		}
+		tstats := t.statsT.(*stats.Trunner)
+		t.writeJSON(w, r, tstats.BckUsage(period), httpdaeWhat)
 	case apc.WhatRemoteAIS:
 		var (
 			aisBackend = t.aisBackend()
@@ -675,6 +704,11 @@ func (f *delb) do(nbck *meta.Bck) bool {
 		xreg.DoAbort(flt, errors.New("apply-bmd"))
 		// NOTE: apc.ActMakeNCopies takes care of itself
 	}
+	if f.obck.Props.Replication.Enabled && f.obck.Props.Replication != nbck.Props.Replication {
+		// (disabled, or otherwise reconfigured - will be renewed upon the next change)
+		flt := xreg.Flt{Kind: apc.ActReplicate, Bck: nbck}
+		xreg.DoAbort(flt, errors.New("apply-bmd"))
+	}
 	if f.obck.Props.EC.Enabled && !nbck.Props.EC.Enabled {
 		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
 		xreg.DoAbort(flt, errors.New("apply-bmd"))
@@ -691,6 +725,7 @@ func (t *target) _postBMD(newBMD *bucketMD, tag string, rmbcks []*meta.Bck) {
 			for _, b := range bcks {
 				core.UncacheBck(b)
 			}
+			core.UntrackBck(bcks...)
 		}(rmbcks...)
 	}
 	if tag != bmdReg {
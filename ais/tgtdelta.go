@@ -0,0 +1,108 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/delta"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// delta sync (rsync-like):
+// - GET(object) with apc.QparamDeltaSig: compute and return the signature of the existing object;
+// - PUT(object) with apc.QparamDelta: reconstruct the new content from the existing object and
+//   the received delta, and proceed with a regular PUT
+// See also: cmn/delta
+
+func (t *target) getDeltaSig(w http.ResponseWriter, r *http.Request, lom *core.LOM, dpq *dpq) {
+	blockSize := int64(delta.DefaultBlockSize)
+	if dpq.deltaSig != "" { // apc.QparamDeltaSig
+		bs, err := cos.ParseSize(dpq.deltaSig, cos.UnitsIEC)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if bs > 0 {
+			blockSize = bs
+		}
+	}
+	if err := delta.ValidateBlockSize(blockSize); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+
+	lom.Lock(false)
+	sig, errCode, err := deltaSig(lom, blockSize)
+	lom.Unlock(false)
+	if err != nil {
+		t._erris(w, r, dpq.silent, err, errCode)
+		return
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	if _, err := sig.WriteTo(w); err != nil {
+		nlog.Warningln("failed to send", lom.Cname(), "delta signature:", err)
+	}
+}
+
+// (under rlock)
+func deltaSig(lom *core.LOM, blockSize int64) (*delta.Sig, int, error) {
+	if err := lom.Load(true, true); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, 0, err
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		return nil, 0, err
+	}
+	sig, err := delta.NewSig(fh, deltaBase(lom), blockSize)
+	cos.Close(fh)
+	return sig, 0, err
+}
+
+func deltaBase(lom *core.LOM) *delta.Base {
+	base := &delta.Base{Version: lom.Version(), Size: lom.SizeBytes()}
+	base.CksumType, base.CksumVal = lom.Checksum().Get()
+	return base
+}
+
+// validate the base and start reconstructing; the caller must close the returned handle
+// - StatusPreconditionFailed when the object does not exist or has changed since the signature
+func (poi *putOI) applyDelta(body io.Reader) (fh cos.LomReader, errCode int, err error) {
+	var (
+		lom = poi.lom
+		dr  *delta.Reader
+	)
+	if dr, err = delta.NewReader(body); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	lom.Lock(false)
+	if err = lom.Load(true, true); err != nil {
+		if cos.IsNotExist(err, 0) {
+			errCode = http.StatusPreconditionFailed
+		}
+	} else if base := deltaBase(lom); *base != dr.Hdr.Base {
+		errCode = http.StatusPreconditionFailed
+		err = fmt.Errorf("%s: delta base mismatch (have %+v, expecting %+v)", lom, *base, dr.Hdr.Base)
+	} else {
+		// (the handle remains valid even if the object gets overwritten in the meantime)
+		fh, err = lom.NewHandle()
+	}
+	lom.Unlock(false)
+	if err != nil {
+		return nil, errCode, err
+	}
+
+	dr.SetBase(fh)
+	poi.r = io.NopCloser(dr) // (request body gets closed by net/http)
+	poi.size = max(dr.Hdr.Size, 0)
+	return fh, 0, nil
+}
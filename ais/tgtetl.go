@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -111,7 +112,7 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// /v1/etl/<etl-name>/logs or /v1/etl/<etl-name>/health or /v1/etl/<etl-name>/metrics
+	// /v1/etl/<etl-name>/logs or /v1/etl/<etl-name>/health or /v1/etl/<etl-name>/metrics or /v1/etl/<etl-name>/stats
 	switch apiItems[1] {
 	case apc.ETLLogs:
 		t.logsETL(w, r, apiItems[0])
@@ -120,6 +121,8 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request) {
 	case apc.ETLMetrics:
 		k8s.InitMetricsClient()
 		t.metricsETL(w, r, apiItems[0])
+	case apc.ETLStats:
+		t.statsETL(w, r, apiItems[0])
 	default:
 		t.writeErrURL(w, r)
 	}
@@ -152,10 +155,11 @@ func (t *target) stopETL(w http.ResponseWriter, r *http.Request, etlName string)
 	}
 }
 
-func (t *target) doETL(w http.ResponseWriter, r *http.Request, etlName string, bck *meta.Bck, objName string) {
+func (t *target) doETL(w http.ResponseWriter, r *http.Request, dpq *dpq, bck *meta.Bck, objName string) {
 	var (
-		comm etl.Communicator
-		err  error
+		comm    etl.Communicator
+		err     error
+		etlName = dpq.etlName
 	)
 	comm, err = etl.GetCommunicator(etlName)
 	if err != nil {
@@ -169,6 +173,11 @@ func (t *target) doETL(w http.ResponseWriter, r *http.Request, etlName string, b
 		t.writeErr(w, r, err)
 		return
 	}
+	if msg := t.owner.etl.get().get(etlName); msg != nil && !msg.CacheBck().IsEmpty() {
+		if t.cachedETL(w, r, dpq, comm, msg, bck, objName) {
+			return
+		}
+	}
 	if err := comm.InlineTransform(w, r, bck, objName); err != nil {
 		errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
 			err.Error())
@@ -178,6 +187,75 @@ func (t *target) doETL(w http.ResponseWriter, r *http.Request, etlName string, b
 	}
 }
 
+// GET transformed object from the ETL cache bucket (see etl.CacheObjName and etl.CacheTag);
+// on cache miss, transform, store, and then serve the result;
+// returns false when the transformation cannot be cached (to fall back to the regular inline transform)
+func (t *target) cachedETL(w http.ResponseWriter, r *http.Request, dpq *dpq, comm etl.Communicator, msg etl.InitMsg,
+	bck *meta.Bck, objName string) bool {
+	src := core.AllocLOM(objName)
+	defer core.FreeLOM(src)
+	if err := src.InitBck(bck.Bucket()); err != nil {
+		return false
+	}
+	if err := src.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return false // e.g., remote object not present in the cluster
+	}
+	tag := etl.CacheTag(msg, src)
+	cachedName := etl.CacheObjName(msg, src, r.URL.RawQuery)
+	if tag == "" || cachedName == "" {
+		return false
+	}
+
+	cbck := meta.CloneBck(msg.CacheBck())
+	lom := core.AllocLOM(cachedName)
+	if err := lom.InitBck(cbck.Bucket()); err != nil {
+		nlog.Warningln(t.String(), "etl", msg.Name(), "cache bucket", cbck.Cname(""), "err:", err)
+		core.FreeLOM(lom)
+		return false
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil || !etl.CacheHit(lom, tag) {
+		if err := t.putETLCached(comm, lom, bck, objName, tag); err != nil {
+			errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: msg.Name(), PodName: comm.PodName(), SvcName: comm.SvcName()},
+				err.Error())
+			comm.Xact().AddErr(errV)
+			t.writeErr(w, r, errV)
+			core.FreeLOM(lom)
+			return true
+		}
+	} else if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(t.String(), "etl", msg.Name(), "cache hit:", lom.Cname())
+	}
+
+	dpq.etlName = "" // serve the cached object as is
+	dpq.noDfltETL = true
+	lom = t.getObject(w, r, dpq, cbck, lom)
+	core.FreeLOM(lom)
+	return true
+}
+
+func (t *target) putETLCached(comm etl.Communicator, lom *core.LOM, bck *meta.Bck, objName, tag string) error {
+	reader, err := comm.OfflineTransform(bck, objName, 0 /*no timeout*/)
+	if err != nil {
+		return err
+	}
+	lom.SetCustomKey(etl.CacheTagObjMD, tag)
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = "etl"
+		params.Reader = reader
+		params.OWT = cmn.OwtPut
+		params.Atime = time.Now()
+		params.Size = reader.Size()
+		params.Xact = comm.Xact()
+	}
+	err = t.PutObject(lom, params)
+	core.FreePutParams(params)
+	if err != nil {
+		return err
+	}
+	return lom.Load(true /*cache it*/, false /*locked*/)
+}
+
 func (t *target) logsETL(w http.ResponseWriter, r *http.Request, etlName string) {
 	logs, err := etl.PodLogs(etlName)
 	if err != nil {
@@ -214,6 +292,19 @@ func (t *target) metricsETL(w http.ResponseWriter, r *http.Request, etlName stri
 	t.writeJSON(w, r, metricMsg, "metrics-etl")
 }
 
+func (t *target) statsETL(w http.ResponseWriter, r *http.Request, etlName string) {
+	stats, err := etl.GetStats(etlName)
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			t.writeErr(w, r, err, http.StatusNotFound, Silent)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+	t.writeJSON(w, r, stats, "stats-etl")
+}
+
 func etlParseObjectReq(_ http.ResponseWriter, r *http.Request) (secret string, bck *meta.Bck, objName string, err error) {
 	var items []string
 	items, err = cmn.ParseURL(r.URL.EscapedPath(), apc.URLPathETLObject.L, 2, false)
@@ -286,7 +377,7 @@ func (t *target) headObjectETL(w http.ResponseWriter, r *http.Request) {
 	}
 
 	lom := core.AllocLOM(objName)
-	errCode, err := t.objHead(w.Header(), r.URL.Query(), bck, lom)
+	errCode, err := t.objHead(r.Context(), w.Header(), r.URL.Query(), bck, lom)
 	core.FreeLOM(lom)
 	if err != nil {
 		// always silent (compare w/ httpobjhead)
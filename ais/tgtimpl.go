@@ -155,6 +155,18 @@ func (t *target) CopyObject(lom *core.LOM, dm core.DM, params *core.CopyParams)
 	return size, err
 }
 
+// RenameObject: same as (single-object) apc.ActRenameObject; the new name may belong to
+// (and the object, therefore, get migrated to) another target
+func (t *target) RenameObject(lom *core.LOM, objNameTo string) error {
+	err := t.objMv(lom, objNameTo)
+	if err == nil {
+		t.statsT.Inc(stats.RenameCount)
+	} else {
+		t.statsT.IncErr(stats.RenameCount)
+	}
+	return err
+}
+
 // use `backend.GetObj` (compare w/ other instances calling `backend.GetObjReader`)
 func (t *target) GetCold(ctx context.Context, lom *core.LOM, owt cmn.OWT) (errCode int, err error) {
 	// 1. lock
@@ -260,6 +272,20 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 		// * https://github.com/NVIDIA/aistore/blob/main/docs/overview.md#terminology
 		mi, _, err := fs.FQN2Mpath(params.SrcFQN)
 		extraCopy = err != nil || !mi.FS.Equal(lom.Mountpath().FS)
+	} else if params.HardLink {
+		// same as above but keeping the source in place; cross-device (or otherwise
+		// failing) link falls back to regular copy
+		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+		if errLink := os.Link(params.SrcFQN, workFQN); errLink == nil {
+			extraCopy, params.Linked = false, true
+			defer func() {
+				if err != nil {
+					cos.RemoveFile(workFQN)
+				}
+			}()
+		} else if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Infof("%s: failed to link %q => %s (%v) - copying instead", t, params.SrcFQN, lom, errLink)
+		}
 	}
 	if extraCopy {
 		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
@@ -282,7 +308,9 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 		}
 
 		fileSize = fi.Size()
-		workFQN = params.SrcFQN
+		if !params.Linked {
+			workFQN = params.SrcFQN
+		}
 		if params.Cksum != nil {
 			lom.SetCksum(params.Cksum) // already computed somewhere else, use it
 		} else {
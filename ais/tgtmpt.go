@@ -0,0 +1,412 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Multipart (resumable) upload - target side (compare w/ S3 compatible tgts3mpt.go):
+// - apc.ActMptStart creates an (in-memory) upload session; the returned upload ID carries
+//   this target's ID, so that proxies redirect all subsequent requests (parts, status,
+//   complete, abort) to this target regardless of cluster map changes;
+// - each part is a separate PUT (apc.QparamMptUploadID, apc.QparamMptPartNum) stored as
+//   a workfile; re-sending a part (e.g., after a network failure) replaces it;
+// - apc.ActMptStatus returns the parts received so far - the basis for resuming;
+// - apc.ActMptComplete concatenates parts 1..N and writes the result via the regular
+//   PUT datapath (checksum, versioning, remote backend, mirroring, and EC included).
+// Sessions are not persisted: those idle for more than `mptIdleTime` are aborted, and
+// parts orphaned by a restart are removed by space cleanup (as old workfiles).
+
+const (
+	mptIdleTime = 24 * time.Hour
+	mptHkIval   = 10 * time.Minute
+	mptIDSepa   = "." // upload ID: <target ID>.<UUID>
+)
+
+type (
+	mptPart struct {
+		cksum *cos.Cksum
+		fqn   string
+		size  int64
+	}
+	mptUpload struct {
+		parts      map[int]*mptPart
+		bck        cmn.Bck
+		objName    string
+		started    int64 // unix nano
+		atime      int64 // mono, last activity
+		mu         sync.Mutex
+		completing bool
+		done       bool // completed or aborted
+	}
+	mptUploads struct {
+		t  *target
+		m  map[string]*mptUpload
+		mu sync.Mutex
+	}
+)
+
+func mptTargetID(uploadID string) (string, error) {
+	tid, uuid, ok := strings.Cut(uploadID, mptIDSepa)
+	if !ok || tid == "" || uuid == "" {
+		return "", fmt.Errorf("invalid multipart upload ID %q", uploadID)
+	}
+	return tid, nil
+}
+
+////////////////
+// mptUploads //
+////////////////
+
+func (ups *mptUploads) init(t *target) {
+	ups.t = t
+	ups.m = make(map[string]*mptUpload, 8)
+	hk.Reg("mpt-uploads"+hk.NameSuffix, ups.housekeep, mptHkIval)
+}
+
+func (ups *mptUploads) start(lom *core.LOM) string {
+	var (
+		id = ups.t.SID() + mptIDSepa + cos.GenUUID()
+		up = &mptUpload{
+			parts:   make(map[int]*mptPart, 16),
+			bck:     *lom.Bucket(),
+			objName: lom.ObjName,
+			started: time.Now().UnixNano(),
+			atime:   mono.NanoTime(),
+		}
+	)
+	ups.mu.Lock()
+	ups.m[id] = up
+	ups.mu.Unlock()
+	return id
+}
+
+func (ups *mptUploads) get(id string, lom *core.LOM) (*mptUpload, int, error) {
+	ups.mu.Lock()
+	up := ups.m[id]
+	ups.mu.Unlock()
+	if up == nil {
+		return nil, http.StatusNotFound, cos.NewErrNotFound(ups.t, "multipart upload "+id)
+	}
+	if !up.bck.Equal(lom.Bucket()) || up.objName != lom.ObjName {
+		return nil, http.StatusBadRequest, fmt.Errorf("multipart upload %q is for %s (not %s)", id,
+			up.bck.Cname(up.objName), lom.Cname())
+	}
+	return up, 0, nil
+}
+
+func (ups *mptUploads) del(id string) {
+	ups.mu.Lock()
+	delete(ups.m, id)
+	ups.mu.Unlock()
+}
+
+func (ups *mptUploads) housekeep() time.Duration {
+	var expired []*mptUpload
+	ups.mu.Lock()
+	for id, up := range ups.m {
+		up.mu.Lock()
+		if !up.completing && mono.Since(up.atime) > mptIdleTime {
+			up.done = true
+			delete(ups.m, id)
+			expired = append(expired, up)
+			nlog.Warningln(ups.t.String(), "aborting idle multipart upload", id, up.bck.Cname(up.objName))
+		}
+		up.mu.Unlock()
+	}
+	ups.mu.Unlock()
+	for _, up := range expired {
+		up.cleanup()
+	}
+	return mptHkIval
+}
+
+///////////////
+// mptUpload //
+///////////////
+
+// add (or replace) part; fails if the upload is being completed or has been aborted
+func (up *mptUpload) add(id string, num int, part *mptPart) error {
+	var prev *mptPart
+	up.mu.Lock()
+	if up.completing || up.done {
+		up.mu.Unlock()
+		return fmt.Errorf("multipart upload %q is being completed or has been aborted (part %d)", id, num)
+	}
+	prev = up.parts[num]
+	up.parts[num] = part
+	up.atime = mono.NanoTime()
+	up.mu.Unlock()
+	if prev != nil {
+		removePart(prev.fqn)
+	}
+	return nil
+}
+
+func (up *mptUpload) status(id string) *apc.MptStatus {
+	up.mu.Lock()
+	up.atime = mono.NanoTime()
+	status := &apc.MptStatus{UploadID: id, Started: up.started, Parts: make([]apc.MptPartInfo, 0, len(up.parts))}
+	for num, part := range up.parts {
+		status.Parts = append(status.Parts, apc.MptPartInfo{Num: num, Size: part.size, Cksum: part.cksum})
+	}
+	up.mu.Unlock()
+	sort.Slice(status.Parts, func(i, j int) bool { return status.Parts[i].Num < status.Parts[j].Num })
+	return status
+}
+
+// validate and return parts 1..NumParts in order; caller must hold the lock
+func (up *mptUpload) _ordered(id string, msg *apc.MptCompleteMsg) ([]*mptPart, error) {
+	if msg.NumParts < 1 || msg.NumParts > apc.MptMaxParts {
+		return nil, fmt.Errorf("multipart upload %q: invalid number of parts %d (expecting 1..%d)", id, msg.NumParts, apc.MptMaxParts)
+	}
+	if len(up.parts) > msg.NumParts {
+		return nil, fmt.Errorf("multipart upload %q: received %d parts, expecting %d", id, len(up.parts), msg.NumParts)
+	}
+	var (
+		parts = make([]*mptPart, 0, msg.NumParts)
+		size  int64
+	)
+	for num := 1; num <= msg.NumParts; num++ {
+		part, ok := up.parts[num]
+		if !ok {
+			return nil, fmt.Errorf("multipart upload %q: missing part %d (of %d)", id, num, msg.NumParts)
+		}
+		parts = append(parts, part)
+		size += part.size
+	}
+	if msg.Size > 0 && msg.Size != size {
+		return nil, fmt.Errorf("multipart upload %q: total size %d differs from the expected %d", id, size, msg.Size)
+	}
+	return parts, nil
+}
+
+func (up *mptUpload) cleanup() {
+	for _, part := range up.parts {
+		removePart(part.fqn)
+	}
+}
+
+func removePart(fqn string) {
+	if err := cos.RemoveFile(fqn); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln("failed to remove multipart upload part:", err)
+	}
+}
+
+////////////
+// target //
+////////////
+
+// POST { apc.ActMptStart, ... } /v1/objects/bucket-name/object-name
+func (t *target) mptAction(w http.ResponseWriter, r *http.Request, apireq *apiRequest, msg *apc.ActMsg) {
+	lom := core.AllocLOM(apireq.items[1])
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(apireq.bck.Bucket()); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if msg.Action == apc.ActMptStart {
+		id := t.mpt.start(lom)
+		w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(id)))
+		w.Write([]byte(id))
+		return
+	}
+
+	id := msg.Name
+	up, errCode, err := t.mpt.get(id, lom)
+	if err != nil {
+		t.writeErr(w, r, err, errCode)
+		return
+	}
+	switch msg.Action {
+	case apc.ActMptStatus:
+		t.writeJSON(w, r, up.status(id), apc.ActMptStatus)
+	case apc.ActMptAbort:
+		up.mu.Lock()
+		if up.completing {
+			up.mu.Unlock()
+			t.writeErrStatusf(w, r, http.StatusConflict, "multipart upload %q is being completed", id)
+			return
+		}
+		up.done = true
+		up.mu.Unlock()
+		t.mpt.del(id)
+		up.cleanup()
+	case apc.ActMptComplete:
+		var cmsg apc.MptCompleteMsg
+		if err := cos.MorphMarshal(msg.Value, &cmsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t, msg.Action, msg.Value, err)
+			return
+		}
+		if errCode, err := t.mptComplete(w.Header(), r, lom, id, up, &cmsg); err != nil {
+			t.writeErr(w, r, err, errCode)
+		}
+	default:
+		t.writeErrAct(w, r, msg.Action)
+	}
+}
+
+// PUT /v1/objects/bucket-name/object-name?mpt_upload_id=...&mpt_part_num=...
+func (t *target) mptPutPart(resphdr http.Header, r *http.Request, lom *core.LOM, dpq *dpq) (int, error) {
+	id := dpq.mptID
+	num, err := strconv.Atoi(dpq.mptPart)
+	if err != nil || num < 1 || num > apc.MptMaxParts {
+		return http.StatusBadRequest, fmt.Errorf("multipart upload %q: invalid part number %q (expecting 1..%d)",
+			id, dpq.mptPart, apc.MptMaxParts)
+	}
+	up, errCode, err := t.mpt.get(id, lom)
+	if err != nil {
+		return errCode, err
+	}
+
+	// write
+	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileMpt+"."+id+"."+dpq.mptPart)
+	fh, err := lom.CreateFile(wfqn)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		buf, slab = t.gmm.Alloc()
+		cksumH    = &cos.CksumHash{}
+	)
+	if ty := lom.CksumType(); ty != cos.ChecksumNone {
+		cksumH = cos.NewCksumHash(ty)
+	}
+	size, err := io.CopyBuffer(multiWriter(cksumH.H, fh), r.Body, buf)
+	slab.Free(buf)
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil && r.ContentLength >= 0 && size != r.ContentLength {
+		err = fmt.Errorf("multipart upload %q, part %d: received %d bytes, expected %d", id, num, size, r.ContentLength)
+	}
+	if err != nil {
+		removePart(wfqn)
+		return 0, err
+	}
+
+	// validate (end-to-end) and add
+	part := &mptPart{fqn: wfqn, size: size}
+	if cksumH.H != nil {
+		cksumH.Finalize()
+		part.cksum = cksumH.Clone()
+		if ty := r.Header.Get(apc.HdrObjCksumType); ty == part.cksum.Ty() {
+			if recv := cos.NewCksum(ty, r.Header.Get(apc.HdrObjCksumVal)); !part.cksum.Equal(recv) {
+				removePart(wfqn)
+				detail := fmt.Sprintf("multipart upload %q, %s, part %d", id, lom, num)
+				return http.StatusBadRequest, cos.NewErrDataCksum(part.cksum, recv, detail)
+			}
+		}
+		resphdr.Set(apc.HdrObjCksumType, part.cksum.Ty())
+		resphdr.Set(apc.HdrObjCksumVal, part.cksum.Val())
+	}
+	if err := up.add(id, num, part); err != nil {
+		removePart(wfqn)
+		return http.StatusConflict, err
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln(t.String(), "multipart upload", id, lom.Cname(), "part", num, "size", size)
+	}
+	return 0, nil
+}
+
+func (t *target) mptComplete(resphdr http.Header, r *http.Request, lom *core.LOM, id string, up *mptUpload,
+	msg *apc.MptCompleteMsg) (int, error) {
+	up.mu.Lock()
+	if up.completing || up.done {
+		up.mu.Unlock()
+		return http.StatusConflict, fmt.Errorf("multipart upload %q is already being completed or has been aborted", id)
+	}
+	parts, err := up._ordered(id, msg)
+	if err != nil {
+		up.mu.Unlock()
+		return http.StatusBadRequest, err
+	}
+	up.completing = true
+	up.mu.Unlock()
+
+	errCode, err := t._mptPut(resphdr, r, lom, parts)
+	if err != nil {
+		// keep the session (and its parts) to retry completion, or resume
+		up.mu.Lock()
+		up.completing = false
+		up.atime = mono.NanoTime()
+		up.mu.Unlock()
+		return errCode, err
+	}
+	up.mu.Lock()
+	up.done = true
+	up.mu.Unlock()
+	t.mpt.del(id)
+	up.cleanup()
+	return 0, nil
+}
+
+// concatenate parts and PUT via the regular datapath
+func (t *target) _mptPut(resphdr http.Header, r *http.Request, lom *core.LOM, parts []*mptPart) (int, error) {
+	var (
+		readers = make([]io.Reader, 0, len(parts))
+		files   = make([]*os.File, 0, len(parts))
+		size    int64
+	)
+	defer func() {
+		for _, fh := range files {
+			cos.Close(fh)
+		}
+	}()
+	for _, part := range parts {
+		fh, err := os.Open(part.fqn)
+		if err != nil {
+			return 0, err
+		}
+		files = append(files, fh)
+		readers = append(readers, fh)
+		size += part.size
+	}
+	skipVC := cmn.Rom.Features().IsSet(feat.SkipVC)
+	if !skipVC {
+		_ = lom.Load(true, false)
+	}
+	poi := allocPOI()
+	{
+		poi.t = t
+		poi.lom = lom
+		poi.config = cmn.GCO.Get()
+		poi.r = io.NopCloser(io.MultiReader(readers...))
+		poi.resphdr = resphdr
+		poi.workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+		poi.atime = time.Now().UnixNano()
+		poi.size = size
+		poi.owt = cmn.OwtPut
+		poi.skipVC = skipVC
+		poi.restful = true
+	}
+	// optional end-to-end protection: checksum of the entire object
+	if ty := r.Header.Get(apc.HdrObjCksumType); ty != "" {
+		poi.cksumToUse = cos.NewCksum(ty, r.Header.Get(apc.HdrObjCksumVal))
+	}
+	errCode, err := poi.putObject()
+	freePOI(poi)
+	return errCode, err
+}
@@ -0,0 +1,59 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestMptTargetID(t *testing.T) {
+	tests := []struct {
+		id, tid string
+		ok      bool
+	}{
+		{"TqPtghbiRw.aB3-xY_9", "TqPtghbiRw", true},
+		{"TqPtghbiRw.", "", false},
+		{".aB3-xY_9", "", false},
+		{"TqPtghbiRw", "", false},
+		{"", "", false},
+	}
+	for _, test := range tests {
+		tid, err := mptTargetID(test.id)
+		if (err == nil) != test.ok || tid != test.tid {
+			t.Errorf("%q: expected (%q, ok=%t), got (%q, %v)", test.id, test.tid, test.ok, tid, err)
+		}
+	}
+}
+
+func TestMptOrdered(t *testing.T) {
+	up := &mptUpload{parts: map[int]*mptPart{
+		1: {fqn: "p1", size: 10},
+		2: {fqn: "p2", size: 10},
+		3: {fqn: "p3", size: 5},
+	}}
+	tests := []struct {
+		msg apc.MptCompleteMsg
+		ok  bool
+	}{
+		{apc.MptCompleteMsg{NumParts: 3}, true},
+		{apc.MptCompleteMsg{NumParts: 3, Size: 25}, true},
+		{apc.MptCompleteMsg{NumParts: 3, Size: 26}, false}, // size mismatch
+		{apc.MptCompleteMsg{NumParts: 2}, false},           // extra part
+		{apc.MptCompleteMsg{NumParts: 4}, false},           // missing part
+		{apc.MptCompleteMsg{NumParts: 0}, false},
+	}
+	for _, test := range tests {
+		parts, err := up._ordered("id", &test.msg)
+		if (err == nil) != test.ok {
+			t.Errorf("%+v: expected ok=%t, got %v", test.msg, test.ok, err)
+			continue
+		}
+		if err == nil && (len(parts) != 3 || parts[0].fqn != "p1" || parts[2].fqn != "p3") {
+			t.Errorf("%+v: parts out of order", test.msg)
+		}
+	}
+}
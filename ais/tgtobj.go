@@ -12,8 +12,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/textproto"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,6 +42,7 @@ import (
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
 )
 
 //
@@ -68,21 +72,26 @@ type (
 
 	getOI struct {
 		w          http.ResponseWriter
-		ctx        context.Context // context used when getting object from remote backend (access creds)
+		ctx        context.Context // request context (canceled or timed-out - see reqCtx); also carries remote backend access creds
 		t          *target         // this
 		lom        *core.LOM       // obj
 		archive    archiveQuery    // archive query
+		coldsema   chan struct{}   // cold GET admission (see coldq)
 		ranges     byteRanges      // range read (see https://www.rfc-editor.org/rfc/rfc7233#section-2.1)
 		atime      int64           // access time.Now()
 		ltime      int64           // mono.NanoTime, to measure latency
+		vival      time.Duration   // 'versioning.validate_warm_get_interval' (zero when requested via QparamLatestVer)
 		isGFN      bool            // is GFN
 		chunked    bool            // chunked transfer (en)coding: https://tools.ietf.org/html/rfc7230#page-36
 		unlocked   bool            // internal
+		shedded    bool            // passed memory-pressure shedding (see shedder.admit)
 		verchanged bool            // version changed
 		retry      bool            // once
 		cold       bool            // true if executed backend.Get
 		latestVer  bool            // QparamLatestVer || 'versioning.*_warm_get'
+		verconf    bool            // latestVer per 'versioning.*_warm_get' (pinned objects are exempt)
 		isS3       bool            // calling via /s3 API
+		manifest   bool            // QparamComposite=false: read composite object's manifest as is
 	}
 
 	// textbook append: (packed) handle and control structure (see also `putA2I` arch below)
@@ -141,6 +150,9 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 		poi.cksumToUse = poi.lom.ObjAttrs().FromHeader(r.Header)
 		poi.owt = cmn.OwtPut // default
 	}
+	if err := poi.lom.ObjAttrs().UserMDFromHeader(r.Header); err != nil {
+		return http.StatusBadRequest, err
+	}
 	if dpq.owt != "" {
 		poi.owt.FromS(dpq.owt)
 	}
@@ -160,6 +172,46 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 			poi.size = size
 		}
 	}
+	if poi.restful && !poi.t2t && poi.xctn == nil {
+		poi.provClient(r)
+	}
+	_, trailer := r.Trailer[textproto.CanonicalMIMEHeaderKey(apc.HdrObjCksumVal)]
+
+	// delta sync: content is encoded as delta against the existing object
+	if cos.IsParseBool(dpq.delta) { // apc.QparamDelta
+		if trailer {
+			return http.StatusBadRequest, fmt.Errorf("%s: delta PUT does not support checksum trailer", poi.lom)
+		}
+		fh, errCode, err := poi.applyDelta(r.Body)
+		if err != nil {
+			return errCode, err
+		}
+		defer cos.Close(fh)
+	}
+
+	// composite object: request body is the manifest (see tgtcomposite.go)
+	if cos.IsParseBool(dpq.composite) { // apc.QparamComposite
+		if trailer || cos.IsParseBool(dpq.delta) {
+			return http.StatusBadRequest, fmt.Errorf("%s: composite PUT does not support checksum trailer or delta", poi.lom)
+		}
+		if errCode, err := poi.composite(r.Body); err != nil {
+			return errCode, err
+		}
+	}
+
+	// checksum value arrives as HTTP trailer (streaming PUT)
+	if trailer {
+		if poi.cksumToUse.IsEmpty() {
+			return http.StatusBadRequest, fmt.Errorf("%s: checksum trailer requires %q header", poi.lom, apc.HdrObjCksumType)
+		}
+		poi.r = &cksumTrailerReader{
+			r:       r.Body,
+			cksum:   cos.NewCksumHash(poi.cksumToUse.Ty()),
+			trailer: r.Trailer,
+			lom:     poi.lom,
+		}
+		poi.cksumToUse = nil // (not known yet)
+	}
 	return poi.putObject()
 }
 
@@ -176,10 +228,16 @@ func (poi *putOI) putObject() (errCode int, err error) {
 		}
 	}
 
-	buf, slab, lmfh, erw := poi.write()
+	if poi.xctn != nil && poi.owt < cmn.OwtRebalance {
+		poi.provJob()
+	}
+	buf, slab, lmfh, ecode, erw := poi.write()
 	poi._cleanup(buf, slab, lmfh, erw)
 	if erw != nil {
-		err, errCode = erw, http.StatusInternalServerError
+		err, errCode = erw, ecode
+		if errCode == 0 {
+			errCode = http.StatusInternalServerError
+		}
 		goto rerr
 	}
 
@@ -198,6 +256,10 @@ func (poi *putOI) putObject() (errCode int, err error) {
 				cos.NamedVal64{Name: stats.PutThroughput, Value: poi.lom.SizeBytes()},
 				cos.NamedVal64{Name: stats.PutLatency, Value: mono.SinceNano(poi.ltime)},
 			)
+			if tstats, ok := poi.t.statsT.(*stats.Trunner); ok { // (unit tests use mock tracker)
+				tstats.AddBckPut(poi.lom.Bucket(), poi.lom.SizeBytes())
+			}
+			poi.t.ratelim.charge(poi.lom, true /*put*/, poi.lom.SizeBytes())
 			// RESTful PUT response header
 			if poi.resphdr != nil {
 				cmn.ToHeader(poi.lom.ObjAttrs(), poi.resphdr)
@@ -219,6 +281,26 @@ rerr:
 	return
 }
 
+// provenance: the job that creates the object _or_ the client that PUTs it
+// (cmn.ProvJobObjMD et al.)
+func (poi *putOI) provJob() {
+	poi.lom.DelCustomKeys(cmn.ProvClientObjMD)
+	poi.lom.SetCustomKey(cmn.ProvJobObjMD, poi.xctn.Kind())
+	poi.lom.SetCustomKey(cmn.ProvXactObjMD, poi.xctn.ID())
+}
+
+func (poi *putOI) provClient(r *http.Request) {
+	client, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		client = r.RemoteAddr
+	}
+	if ua := r.Header.Get(cos.HdrUserAgent); ua != "" {
+		client += " (" + ua + ")"
+	}
+	poi.lom.DelCustomKeys(cmn.ProvJobObjMD, cmn.ProvXactObjMD)
+	poi.lom.SetCustomKey(cmn.ProvClientObjMD, client)
+}
+
 // verbose only
 func (poi *putOI) loghdr() string {
 	sb := strings.Builder{}
@@ -268,6 +350,9 @@ func (poi *putOI) finalize() (errCode int, err error) {
 		}
 	}
 	poi.t.putMirror(poi.lom)
+	if poi.owt < cmn.OwtRebalance {
+		poi.t.replicate(poi.lom, false /*del*/)
+	}
 	return 0, nil
 }
 
@@ -296,6 +381,18 @@ func (poi *putOI) fini() (errCode int, err error) {
 		}
 	}
 
+	// deduplicate (the workfile is still private - not locking yet)
+	if dedup := &lom.Bprops().Dedup; dedup.Enabled {
+		var stored int64
+		if stored, err = lom.Dedup(poi.workFQN, dedup); err != nil {
+			return
+		}
+		if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+			nlog.Infof("PUT (%s): dedup stored %s out of %s", poi.loghdr(), cos.ToSizeIEC(stored, 1),
+				cos.ToSizeIEC(lom.SizeBytes(true), 1))
+		}
+	}
+
 	// locking strategies: optimistic and otherwise
 	// (see GetCold() implementation and cmn.OWT enum)
 	switch poi.owt {
@@ -318,8 +415,17 @@ func (poi *putOI) fini() (errCode int, err error) {
 	}
 
 	// ais versioning
+	var (
+		verFQN string
+		retain int
+	)
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		if poi.owt < cmn.OwtRebalance {
+			if retain = lom.VersionConf().Retain; retain > 0 {
+				if verFQN, err = lom.RetainPrev(); err != nil {
+					return
+				}
+			}
 			if poi.skipVC {
 				err = lom.IncVersion()
 				debug.AssertNoErr(err)
@@ -333,8 +439,18 @@ func (poi *putOI) fini() (errCode int, err error) {
 
 	// done
 	if err = lom.RenameFrom(poi.workFQN); err != nil {
+		if verFQN != "" {
+			if errV := cos.RemoveFile(verFQN); errV != nil {
+				nlog.Errorln(poi.loghdr(), "failed to roll back retained version:", errV)
+			}
+		}
 		return
 	}
+	if verFQN != "" {
+		if err = lom.TrimPrev(retain); err != nil {
+			return
+		}
+	}
 	if lom.HasCopies() {
 		if errdc := lom.DelAllCopies(); errdc != nil {
 			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
@@ -371,7 +487,8 @@ func (poi *putOI) putRemote() (errCode int, err error) {
 
 // LOM is updated at the end of this call with size and checksum.
 // `poi.r` (reader) is also closed upon exit.
-func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err error) {
+// Returns http.StatusBadRequest when the client's request is at fault (zero otherwise).
+func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, errCode int, err error) {
 	var (
 		written int64
 		cksums  = struct {
@@ -420,7 +537,24 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 		writers = append(writers, lmfh)
 		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), poi.r, buf) // (ditto)
 	}
+	if poi.xctn != nil && written > 0 {
+		poi.xctn.DiskWriteAdd(written)
+	}
 	if err != nil {
+		if cos.IsErrBadCksum(err) { // (via cksumTrailerReader)
+			poi.t.statsT.AddMany(
+				cos.NamedVal64{Name: stats.ErrCksumCount, Value: 1},
+				cos.NamedVal64{Name: stats.ErrCksumSize, Value: written},
+			)
+		}
+		if tr, ok := poi.r.(*cksumTrailerReader); ok && tr.invalid {
+			errCode = http.StatusBadRequest
+		}
+		return
+	}
+	if poi.restful && poi.size > 0 && written != poi.size {
+		err = fmt.Errorf("%s: received %d bytes, expected %d (Content-Length)", poi.lom, written, poi.size)
+		errCode = http.StatusBadRequest
 		return
 	}
 
@@ -492,6 +626,49 @@ func (poi *putOI) validateCksum(c *cmn.CksumConf) (v bool) {
 	return
 }
 
+////////////////////////
+// cksumTrailerReader //
+////////////////////////
+
+// validates streamed content against the checksum that arrives as HTTP trailer
+// (the trailer is only available upon reading the entire request body)
+type cksumTrailerReader struct {
+	r       io.ReadCloser
+	cksum   *cos.CksumHash
+	trailer http.Header
+	lom     *core.LOM
+	invalid bool // missing or mismatching trailer (client error)
+}
+
+func (tr *cksumTrailerReader) Read(p []byte) (n int, err error) {
+	n, err = tr.r.Read(p)
+	if n > 0 {
+		tr.cksum.H.Write(p[:n])
+	}
+	if err == io.EOF {
+		if errV := tr.validate(); errV != nil {
+			tr.invalid = true
+			err = errV
+		}
+	}
+	return
+}
+
+func (tr *cksumTrailerReader) Close() error { return tr.r.Close() }
+
+func (tr *cksumTrailerReader) validate() error {
+	val := tr.trailer.Get(apc.HdrObjCksumVal)
+	if val == "" {
+		return fmt.Errorf("%s: missing %q trailer", tr.lom, apc.HdrObjCksumVal)
+	}
+	tr.cksum.Finalize()
+	expct := cos.NewCksum(tr.cksum.Ty(), val)
+	if !tr.cksum.Equal(expct) {
+		return cos.NewErrDataCksum(expct, &tr.cksum.Cksum, tr.lom.String())
+	}
+	return nil
+}
+
 //
 // GET(object)
 //
@@ -503,6 +680,10 @@ func (goi *getOI) getObject() (errCode int, err error) {
 	if !goi.unlocked {
 		goi.lom.Unlock(false)
 	}
+	if goi.coldsema != nil {
+		<-goi.coldsema
+		goi.coldsema = nil
+	}
 	return errCode, err
 }
 
@@ -555,13 +736,19 @@ do:
 			}
 			goto fin
 		}
-	} else if goi.latestVer { // apc.QparamLatestVer or 'versioning.validate_warm_get'
+	} else if _, stale := goi.lom.GetCustomKey(cmn.StaleObjMD); stale && goi.lom.Bck().IsRemote() {
+		// marked stale by out-of-band change detection (apc.ActCheckOOB) - (re)fetch;
+		// the mark stays until replaced by the new version under wlock (below)
+		cold, goi.verchanged = true, true
+	} else if goi.mustValidate() {
 		eq, errCodeSync, errSync := goi.lom.CheckRemoteMD(true /* rlocked */, false /*synchronize*/)
 		if errSync != nil {
 			return errCodeSync, errSync
 		}
 		if !eq {
 			cold, goi.verchanged = true, true
+		} else if goi.vival > 0 {
+			goi.lom.SetVtime(mono.NanoTime())
 		}
 	}
 
@@ -592,6 +779,31 @@ do:
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
 
+		// admission control (released by getObject)
+		if goi.coldsema == nil {
+			if !goi.shedded && goi.t.shed.busy() {
+				// may be delayed or shed - without holding the rlock
+				goi.lom.Unlock(false)
+				if err = goi.t.shed.admit("cold GET", stats.ShedGetColdCount); err != nil {
+					goi.unlocked = true
+					return http.StatusServiceUnavailable, err
+				}
+				goi.shedded = true
+				goi.lom.Lock(false)
+				goto do // (ditto)
+			}
+			if goi.coldsema = goi.t.coldq.try(); goi.coldsema == nil {
+				// must wait in the queue - without holding the rlock
+				goi.lom.Unlock(false)
+				if goi.coldsema, err = goi.t.coldq.acquire(goi.t); err != nil {
+					goi.unlocked = true
+					return http.StatusServiceUnavailable, err
+				}
+				goi.lom.Lock(false)
+				goto do // (the object may have been cold-GET-ed in the meantime)
+			}
+		}
+
 		if loaded, err = goi._coldLock(); err != nil {
 			return 0, err
 		}
@@ -599,7 +811,7 @@ do:
 			goto fin
 		}
 
-		// zero-out prev. version custom metadata, if any
+		// zero-out prev. version custom metadata, if any (including cmn.StaleObjMD mark)
 		goi.lom.SetCustomMD(nil)
 
 		// get remote reader (compare w/ t.GetCold)
@@ -612,10 +824,11 @@ do:
 			}
 			return res.ErrCode, res.Err
 		}
+		res.R = cos.NewCtxReader(goi.ctx, res.R) // abandoned (or timed-out) request: stop reading remote
 		goi.cold = true
 
 		// fast path limitations: read archived; compute more checksums (TODO: reduce)
-		fast = fast && goi.archive.filename == "" &&
+		fast = fast && goi.archive.filename == "" && goi.archive.glob == "" &&
 			(ckconf.Type == cos.ChecksumNone || (!ckconf.ValidateColdGet && !ckconf.EnableReadRange))
 
 		// fast path
@@ -661,6 +874,12 @@ fin:
 
 // upgrade rlock => wlock
 // done early to prevent multiple cold-readers duplicating network/disk operation and overwriting each other
+// warm GET: whether to check in-cluster object against its remote counterpart -
+// apc.QparamLatestVer or 'versioning.validate_warm_get' (subject to the interval and pinning)
+func (goi *getOI) mustValidate() bool {
+	return goi.latestVer && !goi.lom.ValidatedWithin(goi.vival) && !(goi.verconf && goi.lom.IsPinned())
+}
+
 func (goi *getOI) _coldLock() (loaded bool, err error) {
 	var (
 		t, lom = goi.t, goi.lom
@@ -947,14 +1166,28 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 
 func (goi *getOI) finalize() (errCode int, err error) {
 	var (
-		lmfh *os.File
+		lmfh cos.LomReader
 		hrng *htrange
 		fqn  = goi.lom.FQN
 	)
-	if !goi.cold && !goi.isGFN {
+	if goi.lom.IsComposite() && !goi.manifest {
+		manifest, err := goi.lom.LoadComposite()
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if manifest != nil {
+			return goi.getComposite(manifest)
+		}
+	}
+	switch {
+	case goi.lom.IsDedup(), goi.lom.IsPacked():
+		lmfh, err = goi.lom.NewHandle()
+	case !goi.cold && !goi.isGFN:
 		fqn = goi.lom.LBGet() // best-effort GET load balancing (see also mirror.findLeastUtilized())
+		fallthrough
+	default:
+		lmfh, err = cos.NewFileHandle(fqn)
 	}
-	lmfh, err = os.Open(fqn)
 	if err != nil {
 		if os.IsNotExist(err) {
 			errCode = http.StatusNotFound
@@ -976,8 +1209,8 @@ func (goi *getOI) finalize() (errCode int, err error) {
 		if hrng, errCode, err = goi.parseRange(hdr, rsize); err != nil {
 			goto ret
 		}
-		if goi.archive.filename != "" {
-			err = cmn.NewErrUnsupp("range-read archived file", goi.archive.filename)
+		if goi.archive.filename != "" || goi.archive.glob != "" {
+			err = cmn.NewErrUnsupp("range-read archived file", cos.Either(goi.archive.filename, goi.archive.glob))
 			errCode = http.StatusRequestedRangeNotSatisfiable
 			goto ret
 		}
@@ -989,7 +1222,7 @@ ret:
 }
 
 // in particular, setup reader and writer and set headers
-func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange) (errCode int, err error) {
+func (goi *getOI) fini(fqn string, lmfh cos.LomReader, hdr http.Header, hrng *htrange) (errCode int, err error) {
 	var (
 		size   int64
 		reader io.Reader = lmfh
@@ -1031,6 +1264,20 @@ func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange
 		hdr.Del(apc.HdrObjCksumType)
 		hdr.Set(apc.HdrArchmime, mime)
 		hdr.Set(apc.HdrArchpath, goi.archive.filename)
+	case goi.archive.glob != "": // archive: wildcard
+		var (
+			sgl  *memsys.SGL
+			mime string
+		)
+		if sgl, mime, errCode, err = goi.archGlob(lmfh); err != nil {
+			return
+		}
+		defer sgl.Free()
+		reader, size = sgl, sgl.Size()
+		hdr.Del(apc.HdrObjCksumVal)
+		hdr.Del(apc.HdrObjCksumType)
+		hdr.Set(apc.HdrArchmime, mime)
+		hdr.Set(apc.HdrArchglob, goi.archive.glob)
 	case hrng != nil: // range
 		ckconf := goi.lom.CksumConf()
 		cksumRange := ckconf.Type != cos.ChecksumNone && ckconf.EnableReadRange
@@ -1067,10 +1314,63 @@ func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange
 	return
 }
 
+// extract all archived files that match the wildcard (apc.QparamArchglob) and
+// pack them into a new TAR - in memory, to know the size upfront
+func (goi *getOI) archGlob(lmfh cos.LomReader) (sgl *memsys.SGL, mime string, errCode int, err error) {
+	var ar archive.Reader
+	mime, err = archive.MimeFile(lmfh, goi.t.smm, goi.archive.mime, goi.lom.ObjName)
+	if err != nil {
+		return
+	}
+	if ar, err = archive.NewReader(mime, lmfh, goi.lom.SizeBytes()); err != nil {
+		return nil, "", 0, fmt.Errorf("failed to open %s: %w", goi.lom.Cname(), err)
+	}
+	var (
+		cnt int
+		aw  archive.Writer
+	)
+	sgl = goi.t.gmm.NewSGL(0)
+	aw = archive.NewWriter(archive.ExtTar, sgl, nil /*cksum*/, nil /*opts*/)
+	rcb := func(filename string, reader cos.ReadCloseSizer, hdr any) (bool, error) {
+		defer reader.Close()
+		if th, ok := hdr.(*tar.Header); ok && th.Typeflag != tar.TypeReg {
+			return false, nil
+		}
+		name := strings.TrimPrefix(filename, "./")
+		if ok, _ := path.Match(goi.archive.glob, name); !ok {
+			return false, nil
+		}
+		oah := cos.SimpleOAH{Size: reader.Size(), Atime: goi.atime}
+		if err := aw.Write(name, oah, reader); err != nil {
+			return true, err
+		}
+		cnt++
+		return false, nil
+	}
+	_, err = ar.Range("", rcb)
+	aw.Fini()
+	switch {
+	case err != nil:
+		err = cmn.NewErrFailedTo(goi.t, "extract "+goi.archive.glob+" from", goi.lom, err)
+	case cnt == 0:
+		errCode, err = http.StatusNotFound, cos.NewErrNotFound(goi.t, goi.archive.glob+" in "+goi.lom.Cname())
+	default:
+		return sgl, mime, 0, nil
+	}
+	sgl.Free()
+	return nil, "", errCode, err
+}
+
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
-	written, err := cos.CopyBuffer(goi.w, r, buf)
+	written, err := cos.CopyBuffer(goi.w, cos.NewCtxReader(goi.ctx, io.NopCloser(r)), buf)
 	if err != nil {
-		if !cos.IsRetriableConnErr(err) {
+		errCtx := goi.ctx.Err()
+		if errCtx != nil && written == 0 {
+			// nothing sent yet (timed-out or canceled prior to transmitting)
+			goi.w.Header().Del(cos.HdrContentLength)
+			return errCtx
+		}
+		if errCtx == nil && !cos.IsRetriableConnErr(err) {
 			goi.t.fsErr(err, fqn)
 		}
 		nlog.Errorln(cmn.NewErrFailedTo(goi.t, "GET", fqn, err))
@@ -1104,12 +1404,19 @@ func (goi *getOI) stats(written int64) {
 		cos.NamedVal64{Name: stats.GetThroughput, Value: written},                // vis-à-vis user (as written m.b. range)
 		cos.NamedVal64{Name: stats.GetLatency, Value: mono.SinceNano(goi.ltime)}, // see also: stats.GetColdRwLatency
 	)
+	if tstats, ok := goi.t.statsT.(*stats.Trunner); ok { // per-bucket usage (chargeback)
+		tstats.AddBckGet(goi.lom.Bucket(), written)
+	}
+	goi.t.ratelim.charge(goi.lom, false /*put*/, written)
 	if goi.verchanged {
 		goi.t.statsT.AddMany(
 			cos.NamedVal64{Name: stats.VerChangeCount, Value: 1},
 			cos.NamedVal64{Name: stats.VerChangeSize, Value: goi.lom.SizeBytes()},
 		)
 	}
+	if cmn.Rom.Features().IsSet(feat.TrackObjectAccess) {
+		core.TrackAccess(goi.lom, goi.atime)
+	}
 }
 
 // parse & validate user-spec-ed goi.ranges, and set response header
@@ -1132,8 +1439,8 @@ func (goi *getOI) parseRange(resphdr http.Header, size int64) (hrng *htrange, er
 		errCode = http.StatusRequestedRangeNotSatisfiable
 		return
 	}
-	if goi.archive.filename != "" {
-		err = cmn.NewErrUnsupp("range-read archived file", goi.archive.filename)
+	if goi.archive.filename != "" || goi.archive.glob != "" {
+		err = cmn.NewErrUnsupp("range-read archived file", cos.Either(goi.archive.filename, goi.archive.glob))
 		errCode = http.StatusRequestedRangeNotSatisfiable
 		return
 	}
@@ -1190,7 +1497,11 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, errCode int, err error) {
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			if a.lom.IsDedup() || a.lom.IsPacked() {
+				a.hdl.partialCksum, err = a.lom.CopyContent(workFQN, buf, a.lom.CksumType())
+			} else {
+				_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			}
 			a.lom.Unlock(false)
 			if err != nil {
 				errCode = http.StatusInternalServerError
@@ -1427,6 +1738,10 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 	dst2, err := lom.Copy2FQN(dst.FQN, coi.Buf)
 	if err == nil {
 		size = lom.SizeBytes()
+		if coi.Xact != nil {
+			coi.Xact.DiskReadAdd(size)
+			coi.Xact.DiskWriteAdd(size)
+		}
 		if coi.Finalize {
 			t.putMirror(dst2)
 		}
@@ -1470,19 +1785,20 @@ func (coi *copyOI) _send(t *target, lom *core.LOM, sargs *sendArgs) (size int64,
 		debug.Assert(coi.DP == nil)
 		debug.Assert(sargs.owt == cmn.OwtPromote)
 
-		fh, err := cos.NewFileHandle(lom.FQN)
+		fh, err := lom.NewHandle()
 		if err != nil {
 			if os.IsNotExist(err) {
 				return 0, nil
 			}
 			return 0, cmn.NewErrFailedTo(t, "open", lom.FQN, err)
 		}
-		fi, err := fh.Stat()
+		if size, err = fh.Seek(0, io.SeekEnd); err == nil {
+			_, err = fh.Seek(0, io.SeekStart)
+		}
 		if err != nil {
 			fh.Close()
-			return 0, cmn.NewErrFailedTo(t, "fstat", lom.FQN, err)
+			return 0, cmn.NewErrFailedTo(t, "seek", lom.FQN, err)
 		}
-		size = fi.Size()
 		sargs.reader, sargs.objAttrs = fh, lom
 	case coi.DP == nil:
 		// 2. migrate/replicate lom
@@ -1498,6 +1814,9 @@ func (coi *copyOI) _send(t *target, lom *core.LOM, sargs *sendArgs) (size int64,
 		}
 		size = lom.SizeBytes()
 		sargs.reader, sargs.objAttrs = reader, lom
+		if coi.Xact != nil {
+			coi.Xact.DiskReadAdd(size)
+		}
 	default:
 		// 3. DP transform (possibly, no-op)
 		// If the object is not present call t.Backend.GetObjReader
@@ -1591,7 +1910,8 @@ func (a *putA2I) do() (int, error) {
 	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put {
+	// (not applicable to deduplicated or packed content)
+	if a.mime == archive.ExtTar && !a.put && !a.lom.IsDedup() && !a.lom.IsPacked() {
 		var (
 			err       error
 			fh        *os.File
@@ -1628,11 +1948,12 @@ func (a *putA2I) do() (int, error) {
 
 cpap: // copy + append
 	var (
-		err       error
-		lmfh, wfh *os.File
-		workFQN   string
-		cksum     cos.CksumHashSize
-		aw        archive.Writer
+		err     error
+		lmfh    cos.LomReader
+		wfh     *os.File
+		workFQN string
+		cksum   cos.CksumHashSize
+		aw      archive.Writer
 	)
 	workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppendToArch)
 	wfh, err = os.OpenFile(workFQN, os.O_CREATE|os.O_WRONLY, cos.PermRWR)
@@ -1649,7 +1970,7 @@ cpap: // copy + append
 		aw.Fini()
 	} else {
 		// copy + append
-		lmfh, err = os.Open(a.lom.FQN)
+		lmfh, err = a.lom.NewHandle()
 		if err != nil {
 			cos.Close(wfh)
 			return http.StatusNotFound, err
@@ -1765,6 +2086,23 @@ func (t *target) putMirror(lom *core.LOM) {
 	xputlrep.Repl(lom)
 }
 
+//
+// async replication (main)
+//
+
+func (t *target) replicate(lom *core.LOM, del bool) {
+	if !lom.Bprops().Replication.Enabled {
+		return
+	}
+	rns := xreg.RenewReplicate(lom.Bck())
+	if rns.Err != nil {
+		nlog.Errorf("%s: %s %v", t, lom, rns.Err)
+		return
+	}
+	xrepl := rns.Entry.Get().(*xs.XactRepl)
+	xrepl.Repl(lom.ObjName, del)
+}
+
 //
 // mem pools
 //
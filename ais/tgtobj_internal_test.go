@@ -5,17 +5,22 @@
 package ais
 
 import (
+	"bytes"
+	cryptorand "crypto/rand"
 	"flag"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
@@ -91,6 +96,101 @@ func TestMain(m *testing.M) {
 	m.Run()
 }
 
+func TestCksumTrailerReader(tt *testing.T) {
+	lom := core.AllocLOM("objname")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	const data = "streaming PUT with checksum trailer"
+	_, cksum, err := cos.CopyAndChecksum(io.Discard, strings.NewReader(data), nil, cos.ChecksumXXHash)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"valid", cksum.Value(), false},
+		{"mismatch", "0123456789abcdef", true},
+		{"missing", "", true},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(tt *testing.T) {
+			tr := &cksumTrailerReader{
+				r:       io.NopCloser(strings.NewReader(data)),
+				cksum:   cos.NewCksumHash(cos.ChecksumXXHash),
+				trailer: http.Header{},
+				lom:     lom,
+			}
+			if test.value != "" {
+				tr.trailer.Set(apc.HdrObjCksumVal, test.value)
+			}
+			_, err := io.ReadAll(tr)
+			if test.wantErr != (err != nil) {
+				tt.Fatalf("expected error: %t, got: %v", test.wantErr, err)
+			}
+			if test.name == "mismatch" && !cos.IsErrBadCksum(err) {
+				tt.Fatalf("expected bad checksum error, got: %v", err)
+			}
+		})
+	}
+}
+
+// client errors: bad or missing checksum trailer and short body
+func TestPutBadRequest(tt *testing.T) {
+	lom := core.AllocLOM("bad-put-obj")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	const data = "streaming PUT with checksum trailer"
+	trailer := http.Header{}
+	trailer.Set(apc.HdrObjCksumVal, "0123456789abcdef")
+	tests := []struct {
+		name string
+		r    io.ReadCloser
+		size int64
+	}{
+		{"cksum-mismatch", &cksumTrailerReader{
+			r:       io.NopCloser(strings.NewReader(data)),
+			cksum:   cos.NewCksumHash(cos.ChecksumXXHash),
+			trailer: trailer,
+			lom:     lom,
+		}, 0},
+		{"cksum-missing", &cksumTrailerReader{
+			r:       io.NopCloser(strings.NewReader(data)),
+			cksum:   cos.NewCksumHash(cos.ChecksumXXHash),
+			trailer: http.Header{},
+			lom:     lom,
+		}, 0},
+		{"short-body", io.NopCloser(strings.NewReader(data)), int64(len(data)) + 10},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(tt *testing.T) {
+			poi := &putOI{
+				atime:   time.Now().UnixNano(),
+				t:       t,
+				lom:     lom,
+				r:       test.r,
+				size:    test.size,
+				workFQN: path.Join(testMountpath, "bad-put-obj.work"),
+				config:  cmn.GCO.Get(),
+				owt:     cmn.OwtPut,
+				restful: true,
+			}
+			errCode, err := poi.putObject()
+			if err == nil {
+				tt.Fatal("expected error")
+			}
+			if errCode != http.StatusBadRequest {
+				tt.Fatalf("expected status %d, got %d (%v)", http.StatusBadRequest, errCode, err)
+			}
+		})
+	}
+}
+
 func BenchmarkObjPut(b *testing.B) {
 	benches := []struct {
 		fileSize int64
@@ -266,3 +366,141 @@ func BenchmarkObjGetDiscard(b *testing.B) {
 		})
 	}
 }
+
+func TestGetValidateInterval(tt *testing.T) {
+	lom := core.AllocLOM("vival-obj")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	goi := &getOI{t: t, lom: lom, latestVer: true, verconf: true, vival: time.Hour}
+	if !goi.mustValidate() {
+		tt.Fatal("never validated: expected to validate")
+	}
+	lom.SetVtime(mono.NanoTime())
+	if goi.mustValidate() {
+		tt.Fatalf("validated within %v: expected to skip", goi.vival)
+	}
+
+	// explicit apc.QparamLatestVer (zero interval) - always
+	goi.vival, goi.verconf = 0, false
+	if !goi.mustValidate() {
+		tt.Fatal("latest version requested: expected to validate")
+	}
+
+	// interval expired
+	goi.vival, goi.verconf = time.Hour, true
+	lom.SetVtime(mono.NanoTime() - int64(2*time.Hour))
+	if !goi.mustValidate() {
+		tt.Fatal("interval expired: expected to validate")
+	}
+
+	// pinned (per bucket config only)
+	lom.SetCustomKey(cmn.PinnedObjMD, "true")
+	if goi.mustValidate() {
+		tt.Fatal("pinned: expected to skip")
+	}
+	goi.latestVer = false
+	if goi.mustValidate() {
+		tt.Fatal("validation not requested")
+	}
+}
+
+type provXact struct{ core.Xact }
+
+func (provXact) Kind() string { return apc.ActDownload }
+func (provXact) ID() string   { return "xid-1" }
+
+func TestPutProvenance(tt *testing.T) {
+	lom := core.AllocLOM("prov-obj")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		tt.Fatal(err)
+	}
+	poi := &putOI{t: t, lom: lom, xctn: provXact{}}
+	poi.provJob()
+	if v, _ := lom.GetCustomKey(cmn.ProvJobObjMD); v != apc.ActDownload {
+		tt.Fatalf("expected job %q, got %q", apc.ActDownload, v)
+	}
+	if v, _ := lom.GetCustomKey(cmn.ProvXactObjMD); v != "xid-1" {
+		tt.Fatalf("expected job ID %q, got %q", "xid-1", v)
+	}
+
+	// overwritten by a client: job provenance is removed
+	r := httptest.NewRequest(http.MethodPut, "/v1/objects/"+testBucket+"/prov-obj", http.NoBody)
+	r.RemoteAddr = "10.0.0.1:51234"
+	r.Header.Set(cos.HdrUserAgent, "ais/1.3")
+	poi.provClient(r)
+	if v, _ := lom.GetCustomKey(cmn.ProvClientObjMD); v != "10.0.0.1 (ais/1.3)" {
+		tt.Fatalf("unexpected client %q", v)
+	}
+	if _, ok := lom.GetCustomKey(cmn.ProvXactObjMD); ok {
+		tt.Fatal("expected job provenance to be removed")
+	}
+}
+
+func TestAppendDedup(tt *testing.T) {
+	const bname = "bck-dedup"
+	var (
+		bck  = meta.NewBck(bname, apc.AIS, cmn.NsGlobal)
+		bmd  = t.owner.bmd.get().clone()
+		data = make([]byte, 100*cos.KiB+17)
+		more = []byte("appended to a deduplicated object")
+		buf  = make([]byte, 16*cos.KiB)
+	)
+	bmd.add(bck, &cmn.Bprops{
+		Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash},
+		Dedup: cmn.DedupConf{Enabled: true, Chunking: apc.DedupFixed, ChunkSize: 16 * cos.KiB},
+	})
+	t.owner.bmd.putPersist(bmd, nil)
+	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	_, _ = cryptorand.Read(data)
+
+	lom := core.AllocLOM("dedup-obj")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		tt.Fatal(err)
+	}
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       io.NopCloser(bytes.NewReader(data)),
+		workFQN: path.Join(testMountpath, "dedup-obj.work"),
+		config:  cmn.GCO.Get(),
+	}
+	if _, err := poi.putObject(); err != nil {
+		tt.Fatal(err)
+	}
+	if err := lom.Load(false, false); err != nil {
+		tt.Fatal(err)
+	}
+	if !lom.IsDedup() {
+		tt.Fatalf("%s: expected deduplicated object", lom)
+	}
+
+	aoi := &apndOI{
+		started: time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       io.NopCloser(bytes.NewReader(more)),
+		op:      apc.AppendOp,
+	}
+	hdl, _, err := aoi.apnd(buf)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	if err := aoi.parse(hdl); err != nil {
+		tt.Fatal(err)
+	}
+	defer os.Remove(aoi.hdl.workFQN)
+
+	// the object's content (not its manifest) followed by the appended data
+	b, err := os.ReadFile(aoi.hdl.workFQN)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	if !bytes.Equal(b, append(data, more...)) {
+		tt.Fatalf("appended content differs: size %d, expected %d", len(b), len(data)+len(more))
+	}
+}
@@ -0,0 +1,222 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// per-bucket rate limiting (see cmn.RateLimitConf):
+// - token bucket per (bucket, verb) and per limit: requests/s and bytes/s;
+// - each target enforces its 1/(number of active targets) share of the configured
+//   (cluster-wide) limits - objects are distributed evenly, and so is the load;
+// - a request is admitted iff its bucket has tokens: one request token and non-negative
+//   byte tokens (bytes are charged post-factum, when the size becomes known);
+// - the rest get rejected with http.StatusTooManyRequests and Retry-After;
+// - the burst is one second worth of tokens.
+
+const (
+	ratelimIdle = 10 * time.Minute // forget idle (and deleted) buckets
+	ratelimIval = ratelimIdle / 2
+)
+
+type (
+	tokenBucket struct {
+		rate   float64 // tokens per second; zero: unlimited
+		tokens float64 // (can go negative when charged post-factum)
+		last   int64   // mono time of the last refill
+	}
+	verbLimits struct {
+		reqs  tokenBucket
+		bytes tokenBucket
+	}
+	bckRateLim struct {
+		get, put verbLimits
+		conf     cmn.RateLimitConf
+		nt       int   // number of active targets the limits were computed with
+		used     int64 // mono time
+		mu       sync.Mutex
+	}
+	ratelim struct {
+		t    *target
+		bcks sync.Map // bucket ID => *bckRateLim
+	}
+	errRateLimited struct {
+		what       string
+		bname      string
+		retryAfter time.Duration
+	}
+)
+
+func (rl *ratelim) init(t *target) {
+	rl.t = t
+	hk.Reg("ratelim"+hk.NameSuffix, rl.housekeep, ratelimIval)
+}
+
+func (rl *ratelim) housekeep() time.Duration {
+	now := mono.NanoTime()
+	rl.bcks.Range(func(k, v any) bool {
+		brl := v.(*bckRateLim)
+		brl.mu.Lock()
+		if time.Duration(now-brl.used) > ratelimIdle {
+			rl.bcks.Delete(k)
+		}
+		brl.mu.Unlock()
+		return true
+	})
+	return ratelimIval
+}
+
+func (rl *ratelim) get(lom *core.LOM) *bckRateLim {
+	bprops := lom.Bprops()
+	if bprops == nil || !bprops.RateLimit.Enabled {
+		return nil
+	}
+	v, ok := rl.bcks.Load(bprops.BID)
+	if !ok {
+		v, _ = rl.bcks.LoadOrStore(bprops.BID, &bckRateLim{})
+	}
+	brl := v.(*bckRateLim)
+	nt := max(rl.t.owner.smap.get().CountActiveTs(), 1)
+	brl.mu.Lock()
+	if brl.conf != bprops.RateLimit || brl.nt != nt {
+		brl.reset(&bprops.RateLimit, nt)
+	}
+	brl.used = mono.NanoTime()
+	brl.mu.Unlock()
+	return brl
+}
+
+// GET or PUT admission; returns errRateLimited (and increments the error count) when rejected
+func (rl *ratelim) admit(lom *core.LOM, put bool) error {
+	brl := rl.get(lom)
+	if brl == nil {
+		return nil
+	}
+	brl.mu.Lock()
+	wait := brl.verb(put).admit(mono.NanoTime())
+	brl.mu.Unlock()
+	if wait == 0 {
+		return nil
+	}
+	rl.t.statsT.IncErr(stats.ErrRateLimitCount)
+	what := "GET"
+	if put {
+		what = "PUT"
+	}
+	return &errRateLimited{what: what, bname: lom.Bck().Cname(""), retryAfter: wait}
+}
+
+// charge the bytes read or written
+func (rl *ratelim) charge(lom *core.LOM, put bool, size int64) {
+	if size <= 0 {
+		return
+	}
+	brl := rl.get(lom)
+	if brl == nil {
+		return
+	}
+	brl.mu.Lock()
+	vl := brl.verb(put)
+	vl.bytes.take(mono.NanoTime(), float64(size))
+	brl.mu.Unlock()
+}
+
+////////////////
+// bckRateLim //
+////////////////
+
+func (brl *bckRateLim) reset(conf *cmn.RateLimitConf, nt int) {
+	var (
+		now   = mono.NanoTime()
+		share = func(limit int64) float64 { return float64(limit) / float64(nt) }
+	)
+	brl.conf, brl.nt = *conf, nt
+	brl.get.reqs.init(share(int64(conf.GetRPS)), now)
+	brl.get.bytes.init(share(int64(conf.GetBPS)), now)
+	brl.put.reqs.init(share(int64(conf.PutRPS)), now)
+	brl.put.bytes.init(share(int64(conf.PutBPS)), now)
+}
+
+func (brl *bckRateLim) verb(put bool) *verbLimits {
+	if put {
+		return &brl.put
+	}
+	return &brl.get
+}
+
+// returns zero when admitted, otherwise the time until it will be
+func (vl *verbLimits) admit(now int64) time.Duration {
+	if wait := vl.bytes.wait(now); wait > 0 {
+		return wait
+	}
+	if wait := vl.reqs.wait(now); wait > 0 {
+		return wait
+	}
+	vl.reqs.take(now, 1)
+	return 0
+}
+
+/////////////////
+// tokenBucket //
+/////////////////
+
+func (tb *tokenBucket) init(rate float64, now int64) {
+	tb.rate, tb.tokens, tb.last = rate, rate, now
+}
+
+func (tb *tokenBucket) refill(now int64) {
+	elapsed := float64(now-tb.last) / float64(time.Second)
+	tb.tokens = min(tb.tokens+tb.rate*elapsed, tb.rate)
+	tb.last = now
+}
+
+// time to wait until there's at least one token (zero: proceed)
+func (tb *tokenBucket) wait(now int64) time.Duration {
+	if tb.rate == 0 {
+		return 0
+	}
+	tb.refill(now)
+	if tb.tokens >= 1 || (tb.rate < 1 && tb.tokens > 0) {
+		return 0
+	}
+	need := min(1, tb.rate) - tb.tokens
+	return time.Duration(need / tb.rate * float64(time.Second))
+}
+
+func (tb *tokenBucket) take(now int64, n float64) {
+	if tb.rate == 0 {
+		return
+	}
+	tb.refill(now)
+	tb.tokens -= n
+}
+
+////////////////////
+// errRateLimited //
+////////////////////
+
+func (e *errRateLimited) Error() string {
+	return fmt.Sprintf("%s %s rejected: rate limit exceeded, please retry after %v", e.what, e.bname,
+		e.retryAfter.Round(time.Millisecond))
+}
+
+// set Retry-After (seconds) and return http status (compare with errShed)
+func (e *errRateLimited) hdr(w http.ResponseWriter) int {
+	secs := max(int64((e.retryAfter+time.Second-1)/time.Second), 1)
+	w.Header().Set(cos.HdrRetryAfter, strconv.FormatInt(secs, 10))
+	return http.StatusTooManyRequests
+}
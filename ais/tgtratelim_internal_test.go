@@ -0,0 +1,75 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestRateLimReqs(tt *testing.T) {
+	var (
+		brl = &bckRateLim{}
+		now = int64(time.Hour)
+	)
+	// 10 req/s cluster-wide over 2 targets: 5 req/s (and burst) per target
+	brl.reset(&cmn.RateLimitConf{GetRPS: 10, Enabled: true}, 2)
+	brl.get.reqs.last = now
+	for i := 0; i < 5; i++ {
+		if wait := brl.get.admit(now); wait != 0 {
+			tt.Fatalf("request #%d: expected to be admitted, got wait %v", i, wait)
+		}
+	}
+	wait := brl.get.admit(now)
+	if wait <= 0 || wait > 200*time.Millisecond {
+		tt.Fatalf("expected to be rejected with wait in (0, 200ms], got %v", wait)
+	}
+	if wait := brl.get.admit(now + int64(wait)); wait != 0 {
+		tt.Fatalf("expected to be admitted after waiting, got %v", wait)
+	}
+	// PUT: unlimited
+	for i := 0; i < 100; i++ {
+		if wait := brl.put.admit(now); wait != 0 {
+			tt.Fatalf("PUT #%d: expected to be admitted, got wait %v", i, wait)
+		}
+	}
+}
+
+func TestRateLimBytes(tt *testing.T) {
+	var (
+		brl = &bckRateLim{}
+		now = int64(time.Hour)
+	)
+	brl.reset(&cmn.RateLimitConf{PutBPS: cos.MiB, Enabled: true}, 1)
+	brl.put.bytes.last = now
+	if wait := brl.put.admit(now); wait != 0 {
+		tt.Fatalf("expected to be admitted, got wait %v", wait)
+	}
+	// charged post-factum: 3MiB => ~2s in debt
+	brl.put.bytes.take(now, 3*cos.MiB)
+	wait := brl.put.admit(now)
+	if wait < 2*time.Second || wait > 3*time.Second {
+		tt.Fatalf("expected to be rejected with wait ~2s, got %v", wait)
+	}
+	if wait := brl.put.admit(now + int64(wait)); wait != 0 {
+		tt.Fatalf("expected to be admitted after waiting, got %v", wait)
+	}
+}
+
+func TestRateLimErr(tt *testing.T) {
+	w := httptest.NewRecorder()
+	err := &errRateLimited{what: "GET", bname: "ais://abc", retryAfter: 1500 * time.Millisecond}
+	if code := err.hdr(w); code != http.StatusTooManyRequests {
+		tt.Fatalf("expected %d, got %d", http.StatusTooManyRequests, code)
+	}
+	if ra := w.Header().Get(cos.HdrRetryAfter); ra != "2" {
+		tt.Fatalf("expected Retry-After 2, got %q", ra)
+	}
+}
@@ -5,7 +5,6 @@
 package ais
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -310,7 +309,13 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		op.ObjAttrs = *lom.ObjAttrs()
 	} else {
 		// cold HEAD
-		objAttrs, errCode, err := t.Backend(lom.Bck()).HeadObj(context.Background(), lom)
+		ctx, cancel, err := reqCtx(r, cmn.GCO.Get().Timeout.ObjHead.D())
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		objAttrs, errCode, err := t.Backend(lom.Bck()).HeadObj(ctx, lom)
+		cancel()
 		if err != nil {
 			s3.WriteErr(w, r, err, errCode)
 			return
@@ -0,0 +1,153 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/metrics"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/sys"
+)
+
+// memory-pressure aware request shedding (see cmn.ShedConf):
+// - periodically (housekeeping) evaluate memsys pressure and the size of the Go heap;
+// - under high pressure (or when the heap is within 1/4 of `max_heap`) delay low-priority work;
+// - under extreme pressure, OOM, or when the heap exceeds `max_heap` - shed it
+//   (http.StatusServiceUnavailable and Retry-After);
+// - low-priority work: list-objects pages, cold GETs (prior to entering coldq),
+//   and out-of-band scrubs (apc.ActCheckOOB)
+// High-priority work (warm GETs, PUTs, control plane) is never affected.
+
+const (
+	shedIval = 2 * time.Second
+
+	shedHeapMetric = "/memory/classes/heap/objects:bytes"
+)
+
+const (
+	shedNone = iota
+	shedDelay
+	shedAll
+)
+
+type (
+	shedder struct {
+		t     *target
+		level atomic.Int32
+	}
+	errShed struct {
+		what       string
+		retryAfter time.Duration
+	}
+)
+
+func (sh *shedder) init(t *target) {
+	sh.t = t
+	hk.Reg("shed"+hk.NameSuffix, sh.housekeep, shedIval)
+}
+
+func (sh *shedder) housekeep() time.Duration {
+	var (
+		conf  = &cmn.GCO.Get().Shed
+		level = int32(shedNone)
+		mem   sys.MemStat
+		heap  uint64
+	)
+	if conf.Enabled {
+		_ = mem.Get()
+		heap = heapSize()
+		level = sh.eval(conf, &mem, heap)
+	}
+	if prev := sh.level.Swap(level); prev != level {
+		nlog.Warningln(sh.t.String(), "shedding low-priority work:", _shedLevel(prev), "=>", _shedLevel(level),
+			"[ heap", cos.ToSizeIEC(int64(heap), 1), sh.t.gmm.Str(&mem), "]")
+	}
+	return shedIval
+}
+
+func (sh *shedder) eval(conf *cmn.ShedConf, mem *sys.MemStat, heap uint64) (level int32) {
+	switch sh.t.gmm.Pressure(mem) {
+	case memsys.PressureExtreme, memsys.OOM:
+		return shedAll
+	case memsys.PressureHigh:
+		level = shedDelay
+	}
+	if conf.MaxHeap > 0 {
+		maxHeap := uint64(conf.MaxHeap)
+		switch {
+		case heap >= maxHeap:
+			level = shedAll
+		case heap >= maxHeap-maxHeap>>2:
+			level = max(level, shedDelay)
+		}
+	}
+	return level
+}
+
+func heapSize() uint64 {
+	sample := []metrics.Sample{{Name: shedHeapMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+func _shedLevel(level int32) string {
+	switch level {
+	case shedDelay:
+		return "delay"
+	case shedAll:
+		return "shed"
+	default:
+		return "none"
+	}
+}
+
+// true when admit may delay or shed
+func (sh *shedder) busy() bool { return sh.level.Load() != shedNone }
+
+// returns nil when it's ok to proceed (possibly, after a delay);
+// otherwise, increments the `stat` counter and returns errShed
+func (sh *shedder) admit(what, stat string) error {
+	switch sh.level.Load() {
+	case shedNone:
+		return nil
+	case shedDelay:
+		conf := &cmn.GCO.Get().Shed
+		sh.t.statsT.Inc(stats.ShedDelayedCount)
+		time.Sleep(conf.Delay.D())
+		if sh.level.Load() != shedAll {
+			return nil
+		}
+	}
+	sh.t.statsT.Inc(stat)
+	return &errShed{what: what, retryAfter: shedIval}
+}
+
+/////////////
+// errShed //
+/////////////
+
+func (e *errShed) Error() string {
+	return fmt.Sprintf("%s rejected: memory pressure, please retry after %v", e.what, e.retryAfter)
+}
+
+// set Retry-After (seconds) and return http status (compare with errColdGetBusy)
+func (e *errShed) hdr(w http.ResponseWriter) int {
+	secs := max(int64((e.retryAfter+time.Second-1)/time.Second), 1)
+	w.Header().Set(cos.HdrRetryAfter, strconv.FormatInt(secs, 10))
+	return http.StatusServiceUnavailable
+}
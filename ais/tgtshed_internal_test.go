@@ -0,0 +1,127 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/sys"
+)
+
+func testShedConf(tt *testing.T, conf cmn.ShedConf) {
+	prev := cmn.GCO.Get()
+	config := cmn.GCO.BeginUpdate()
+	config.Shed = conf
+	cmn.GCO.CommitUpdate(config)
+	tt.Cleanup(func() {
+		cmn.GCO.BeginUpdate()
+		cmn.GCO.CommitUpdate(prev)
+	})
+}
+
+func TestShedEval(tt *testing.T) {
+	const maxHeap = 100 * cos.MiB
+	var (
+		sh      = &shedder{t: t}
+		plenty  = &sys.MemStat{Free: math.MaxUint64, ActualFree: math.MaxUint64}
+		extreme = &sys.MemStat{}
+	)
+	tests := []struct {
+		name     string
+		mem      *sys.MemStat
+		maxHeap  int64
+		heap     uint64
+		expected int32
+	}{
+		{"no-limit", plenty, 0, 10 * cos.GiB, shedNone},
+		{"below", plenty, maxHeap, 50 * cos.MiB, shedNone},
+		{"approaching", plenty, maxHeap, 80 * cos.MiB, shedDelay},
+		{"at-limit", plenty, maxHeap, maxHeap, shedAll},
+		{"above", plenty, maxHeap, 2 * maxHeap, shedAll},
+		{"extreme-pressure", extreme, 0, 0, shedAll},
+		{"extreme-pressure-small-heap", extreme, maxHeap, cos.MiB, shedAll},
+	}
+	for _, test := range tests {
+		conf := &cmn.ShedConf{Enabled: true, MaxHeap: cos.SizeIEC(test.maxHeap)}
+		if level := sh.eval(conf, test.mem, test.heap); level != test.expected {
+			tt.Errorf("%s: expected %q, got %q", test.name, _shedLevel(test.expected), _shedLevel(level))
+		}
+	}
+}
+
+func TestShedAdmit(tt *testing.T) {
+	const delay = 20 * time.Millisecond
+	testShedConf(tt, cmn.ShedConf{Enabled: true, Delay: cos.Duration(delay)})
+	sh := &shedder{t: t}
+
+	// none
+	if sh.busy() {
+		tt.Fatal("expected not busy")
+	}
+	if err := sh.admit("list-objects page", stats.ShedListCount); err != nil {
+		tt.Fatal(err)
+	}
+
+	// shed
+	sh.level.Store(shedAll)
+	err := sh.admit("list-objects page", stats.ShedListCount)
+	var errShd *errShed
+	if !errors.As(err, &errShd) {
+		tt.Fatalf("expected errShed, got %v", err)
+	}
+	rec := httptest.NewRecorder()
+	if status := errShd.hdr(rec); status != http.StatusServiceUnavailable {
+		tt.Fatalf("expected status %d, got %d", http.StatusServiceUnavailable, status)
+	}
+	if v := rec.Header().Get(cos.HdrRetryAfter); v != "2" {
+		tt.Fatalf("expected Retry-After %q, got %q", "2", v)
+	}
+
+	// delay, then proceed
+	sh.level.Store(shedDelay)
+	if !sh.busy() {
+		tt.Fatal("expected busy")
+	}
+	started := time.Now()
+	if err := sh.admit("list-objects page", stats.ShedListCount); err != nil {
+		tt.Fatal(err)
+	}
+	if elapsed := time.Since(started); elapsed < delay {
+		tt.Fatalf("expected to be delayed by at least %v, got %v", delay, elapsed)
+	}
+
+	// delay, and meanwhile the pressure gets worse
+	go func() {
+		time.Sleep(delay / 4)
+		sh.level.Store(shedAll)
+	}()
+	sh.level.Store(shedDelay)
+	if err := sh.admit("list-objects page", stats.ShedListCount); !errors.As(err, &errShd) {
+		tt.Fatalf("expected errShed, got %v", err)
+	}
+}
+
+func TestShedDisabled(tt *testing.T) {
+	testShedConf(tt, cmn.ShedConf{Enabled: false, MaxHeap: 1})
+	sh := &shedder{t: t}
+	sh.level.Store(shedAll)
+	if ival := sh.housekeep(); ival != shedIval {
+		tt.Fatalf("expected housekeeping interval %v, got %v", shedIval, ival)
+	}
+	if level := sh.level.Load(); level != shedNone {
+		tt.Fatalf("expected %q when disabled, got %q", _shedLevel(shedNone), _shedLevel(level))
+	}
+	if err := sh.admit("cold GET", stats.ShedGetColdCount); err != nil {
+		tt.Fatal(err)
+	}
+}
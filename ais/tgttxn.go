@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -159,6 +160,9 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 	if err == nil {
 		if xid != "" {
 			w.Header().Set(apc.HdrXactionID, xid)
+			if msg.ReqID != "" {
+				setXactReqID(xid, msg.ReqID)
+			}
 		}
 		return
 	}
@@ -174,6 +178,13 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// associate xaction with the originating client request (see cos.HdrReqID)
+func setXactReqID(xid, reqID string) {
+	if xctn, err := xreg.GetXact(xid); err == nil && xctn != nil {
+		xctn.SetReqID(reqID)
+	}
+}
+
 //
 // createBucket
 //
@@ -1009,6 +1020,15 @@ func prmScan(dirFQN string, prmMsg *apc.PromoteArgs) (fqns []string, totalN int,
 		if de.IsDir() {
 			return
 		}
+		if len(prmMsg.Include) > 0 || len(prmMsg.Exclude) > 0 {
+			relname, err := filepath.Rel(dirFQN, fqn)
+			if err != nil {
+				return err
+			}
+			if !prmMsg.Selected(relname) {
+				return nil
+			}
+		}
 		if len(fqns) == 0 {
 			fqns = make([]string, 0, promoteNumSync)
 		}
@@ -1041,8 +1061,11 @@ func prmScan(dirFQN string, prmMsg *apc.PromoteArgs) (fqns []string, totalN int,
 
 // synchronously wo/ xaction
 func (t *target) prmNumFiles(c *txnSrv, txnPrm *txnPromote, confirmedFshare bool) error {
-	smap := t.owner.smap.Get()
-	config := cmn.GCO.Get()
+	var (
+		errs   cos.Errs
+		smap   = t.owner.smap.Get()
+		config = cmn.GCO.Get()
+	)
 	for _, fqn := range txnPrm.fqns {
 		objName, err := xs.PrmObjName(fqn, txnPrm.dirFQN, txnPrm.msg.ObjName)
 		if err != nil {
@@ -1066,12 +1089,21 @@ func (t *target) prmNumFiles(c *txnSrv, txnPrm *txnPromote, confirmedFshare bool
 				ObjName:      objName,
 				OverwriteDst: txnPrm.msg.OverwriteDst,
 				DeleteSrc:    txnPrm.msg.DeleteSrc,
+				HardLink:     txnPrm.msg.HardLink,
 			},
 		}
 		if _, err := t.Promote(&params); err != nil {
-			return err
+			err = fmt.Errorf("failed to promote %q: %w", fqn, err)
+			if !txnPrm.msg.ContinueOnError {
+				return err
+			}
+			nlog.Errorln(t.String()+":", err)
+			errs.Add(err)
 		}
 	}
+	if errs.Cnt() > 0 {
+		return &errs
+	}
 	return nil
 }
 
@@ -0,0 +1,59 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// GET(object) with apc.QparamObjVersion: read one of the previous versions retained
+// upon overwrite (see versioning.retain and core/lver.go);
+// returns false when the requested version is the current one (to proceed with a regular GET)
+func (t *target) getPrevVersion(w http.ResponseWriter, r *http.Request, lom *core.LOM, dpq *dpq) bool {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err == nil {
+		if lom.Version() == dpq.objVer {
+			return false
+		}
+	} else if !cos.IsNotExist(err, 0) {
+		t._erris(w, r, dpq.silent, err, 0)
+		return true
+	}
+
+	prev, err := lom.LoadPrev(dpq.objVer)
+	if err != nil {
+		errCode := 0
+		if cos.IsNotExist(err, 0) {
+			errCode = http.StatusNotFound
+			if vers, _ := lom.PrevVersions(); len(vers) > 0 {
+				err = cos.NewErrNotFound(t, lom.Cname()+" version "+dpq.objVer+
+					" (available previous versions: "+strings.Join(vers, ", ")+")")
+			}
+		}
+		t._erris(w, r, dpq.silent, err, errCode)
+		return true
+	}
+	defer core.FreeLOM(prev)
+
+	lmfh, err := prev.NewHandle()
+	if err != nil {
+		t._erris(w, r, dpq.silent, err, 0)
+		return true
+	}
+	hdr := w.Header()
+	cmn.ToHeader(prev.ObjAttrs(), hdr)
+	hdr.Set(cos.HdrContentType, cos.ContentBinary)
+	http.ServeContent(w, r, "", time.Time{}, lmfh) // (handles range reads)
+	cos.Close(lmfh)
+	return true
+}
@@ -132,6 +132,9 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err)
 			return
 		}
+		if msg.ReqID != "" {
+			setXactReqID(xid, msg.ReqID)
+		}
 		if l := len(xid); l > 0 {
 			w.Header().Set(cos.HdrContentLength, strconv.Itoa(l))
 			w.Write([]byte(xid))
@@ -243,6 +246,17 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 			core.FreeLOM(lom)
 		}
 		return xid, err
+	case apc.ActReplicate:
+		if !bck.Props.Replication.Enabled {
+			return xid, fmt.Errorf("cannot start %q: replication is disabled for %s", args, bck)
+		}
+		rns := xreg.RenewReplicate(bck)
+		if rns.Err != nil {
+			return xid, rns.Err
+		}
+		xrepl := rns.Entry.Get().(*xs.XactRepl)
+		xrepl.Resync()
+		xid = xrepl.ID()
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return xid, fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
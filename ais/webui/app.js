@@ -0,0 +1,287 @@
+// AIStore web dashboard: read-only views of the cluster, buckets, and jobs (xactions).
+// Uses the regular REST API and, for listings that require a request body, /webui/api/
+// (see ais/prxwebui.go).
+"use strict";
+
+const refreshInterval = 5000; // ms
+const tokenKey = "ais-token";
+
+let timer = null;
+let lsoState = null; // { bck, prefix, uuid, token }
+
+// utilities
+
+function $(id) {
+  return document.getElementById(id);
+}
+
+function esc(s) {
+  return String(s ?? "").replace(/[&<>"']/g, (c) => ({
+    "&": "&amp;", "<": "&lt;", ">": "&gt;", '"': "&quot;", "'": "&#39;",
+  }[c]));
+}
+
+function formatSize(n) {
+  n = Number(n) || 0;
+  const units = ["B", "KiB", "MiB", "GiB", "TiB", "PiB"];
+  let i = 0;
+  while (n >= 1024 && i < units.length - 1) {
+    n /= 1024;
+    i++;
+  }
+  return (i === 0 ? n : n.toFixed(2)) + units[i];
+}
+
+function formatTime(s) {
+  if (!s || s.startsWith("0001-")) {
+    return "-";
+  }
+  return new Date(s).toLocaleString();
+}
+
+function bckName(bck) {
+  if (!bck || !bck.name) {
+    return "";
+  }
+  const ns = bck.namespace && bck.namespace.name ? "@" + bck.namespace.uuid + "#" + bck.namespace.name + "/" : "";
+  return (bck.provider || "ais") + "://" + ns + bck.name;
+}
+
+async function get(path) {
+  const headers = {};
+  const token = localStorage.getItem(tokenKey);
+  if (token) {
+    headers["Authorization"] = "Bearer " + token;
+  }
+  const resp = await fetch(path, { headers });
+  if (!resp.ok) {
+    const text = await resp.text();
+    let msg = text;
+    try {
+      msg = JSON.parse(text).message || text;
+    } catch (e) {
+      // plain text
+    }
+    throw new Error(resp.status + ": " + msg);
+  }
+  const text = await resp.text();
+  return text ? JSON.parse(text) : null;
+}
+
+function showError(err) {
+  const el = $("error");
+  el.textContent = err ? String(err.message || err) : "";
+  el.hidden = !err;
+}
+
+function card(label, value, cls) {
+  return `<div class="card"><div class="label">${esc(label)}</div>` +
+    `<div class="value ${cls || ""}">${esc(value)}</div></div>`;
+}
+
+// cluster
+
+async function viewCluster() {
+  const [smap, stats] = await Promise.all([
+    get("/v1/cluster?what=smap"),
+    get("/v1/cluster?what=stats").catch(() => null),
+  ]);
+  const proxies = Object.values(smap.pmap || {});
+  const targets = Object.values(smap.tmap || {});
+
+  let used = 0, avail = 0, alerts = 0;
+  const rows = [];
+  for (const si of proxies.concat(targets)) {
+    let cdf = null;
+    if (stats && si.daemon_type === "target" && stats.target && stats.target[si.daemon_id]) {
+      cdf = stats.target[si.daemon_id].capacity;
+    }
+    let u = 0, a = 0;
+    if (cdf && cdf.Mountpaths) {
+      for (const mp of Object.values(cdf.Mountpaths)) {
+        u += Number(mp.used) || 0;
+        a += Number(mp.avail) || 0;
+      }
+    }
+    used += u;
+    avail += a;
+    const alert = cdf && cdf.cs_err ? cdf.cs_err : "";
+    if (alert) {
+      alerts++;
+    }
+    const primary = smap.proxy_si && smap.proxy_si.daemon_id === si.daemon_id ? " (primary)" : "";
+    rows.push(`<tr><td>${esc(si.daemon_id + primary)}</td><td>${esc(si.daemon_type)}</td>` +
+      `<td>${esc(si.public_net && si.public_net.direct_url)}</td>` +
+      `<td class="num">${cdf ? formatSize(u) : "-"}</td><td class="num">${cdf ? formatSize(a) : "-"}</td>` +
+      `<td>${cdf ? `${cdf.pct_min}% / ${cdf.pct_avg}% / ${cdf.pct_max}%` : "-"}</td>` +
+      `<td class="warn">${esc(alert)}</td></tr>`);
+  }
+  $("nodes").innerHTML = rows.join("");
+
+  const pct = used + avail > 0 ? Math.round((100 * used) / (used + avail)) : 0;
+  $("summary").innerHTML =
+    card("Health", stats ? (alerts ? "alerts: " + alerts : "ok") : "unknown", stats && !alerts ? "ok" : "warn") +
+    card("Proxies", proxies.length) +
+    card("Targets", targets.length) +
+    card("Used capacity", `${formatSize(used)} (${pct}%)`) +
+    card("Available", formatSize(avail)) +
+    card("Cluster map", "v" + smap.version);
+}
+
+// buckets and objects
+
+async function viewBuckets() {
+  lsoState = null;
+  $("bck-title").textContent = "Buckets";
+  $("bck-filter").hidden = true;
+  $("more").hidden = true;
+  $("bck-head").innerHTML = "<tr><th>Bucket</th><th>Provider</th><th>Created</th></tr>";
+  const bcks = (await get("/webui/api/buckets")) || [];
+  bcks.sort((a, b) => bckName(a).localeCompare(bckName(b)));
+  $("bck-rows").innerHTML = bcks.map((bck) => {
+    const q = new URLSearchParams({ provider: bck.provider || "ais" });
+    if (bck.namespace && bck.namespace.name) {
+      q.set("namespace", "@" + bck.namespace.uuid + "#" + bck.namespace.name);
+    }
+    const href = "#objects/" + encodeURIComponent(bck.name) + "?" + q.toString();
+    const created = bck.props && bck.props.created ? formatTime(new Date(Number(bck.props.created) / 1e6).toISOString()) : "-";
+    return `<tr><td><a href="${esc(href)}">${esc(bckName(bck))}</a></td><td>${esc(bck.provider)}</td>` +
+      `<td>${esc(created)}</td></tr>`;
+  }).join("") || `<tr><td colspan="3">no buckets</td></tr>`;
+}
+
+async function viewObjects(hash, more) {
+  const [path, qs] = hash.slice("objects/".length).split("?");
+  const name = decodeURIComponent(path);
+  const q = new URLSearchParams(qs || "");
+  if (!more) {
+    lsoState = { name, q, prefix: $("prefix").value, uuid: "", token: "" };
+    $("bck-rows").innerHTML = "";
+  }
+  $("bck-title").textContent = (q.get("provider") || "ais") + "://" + name;
+  $("bck-filter").hidden = false;
+  $("bck-head").innerHTML = "<tr><th>Name</th><th>Size</th><th>Last access</th></tr>";
+
+  const lq = new URLSearchParams(lsoState.q);
+  lq.set("prefix", lsoState.prefix);
+  if (lsoState.uuid) {
+    lq.set("uuid", lsoState.uuid);
+    lq.set("token", lsoState.token);
+  }
+  const lst = await get("/webui/api/objects/" + encodeURIComponent(name) + "?" + lq.toString());
+  const entries = (lst && lst.entries) || [];
+  $("bck-rows").insertAdjacentHTML("beforeend", entries.map((en) =>
+    `<tr><td>${esc(en.name)}</td><td class="num">${formatSize(en.size)}</td><td>${esc(en.atime || "-")}</td></tr>`,
+  ).join(""));
+  if (!more && entries.length === 0) {
+    $("bck-rows").innerHTML = `<tr><td colspan="3">no objects</td></tr>`;
+  }
+  lsoState.uuid = lst ? lst.uuid : "";
+  lsoState.token = lst ? lst.continuation_token : "";
+  $("more").hidden = !lsoState.token;
+}
+
+// jobs (xactions)
+
+async function viewJobs() {
+  const snaps = (await get("/webui/api/xactions")) || {};
+  const running = $("running").checked;
+  const xs = new Map(); // xaction ID => aggregated (across targets) snapshot
+  for (const list of Object.values(snaps)) {
+    for (const snap of list || []) {
+      const ended = snap["end-time"] && !snap["end-time"].startsWith("0001-");
+      if (running && ended) {
+        continue;
+      }
+      let x = xs.get(snap.id);
+      if (!x) {
+        x = { snap, objs: 0, bytes: 0, running: false, aborted: false };
+        xs.set(snap.id, x);
+      }
+      x.objs += Number(snap.stats && snap.stats["loc-objs"]) || 0;
+      x.bytes += Number(snap.stats && snap.stats["loc-bytes"]) || 0;
+      x.running = x.running || !ended;
+      x.aborted = x.aborted || snap.aborted;
+    }
+  }
+  const rows = Array.from(xs.values()).sort((a, b) => (b.snap["start-time"] || "").localeCompare(a.snap["start-time"] || ""));
+  $("xactions").innerHTML = rows.map((x) => {
+    const state = x.aborted ? "aborted" : (x.running ? "running" : "finished");
+    const bck = bckName(x.snap.bck) || (bckName(x.snap["src-bck"]) ? bckName(x.snap["src-bck"]) + " => " + bckName(x.snap["dst-bck"]) : "");
+    return `<tr><td>${esc(x.snap.id)}</td><td>${esc(x.snap.kind)}</td><td>${esc(bck)}</td>` +
+      `<td class="num">${x.objs}</td><td class="num">${formatSize(x.bytes)}</td>` +
+      `<td>${esc(formatTime(x.snap["start-time"]))}</td><td>${esc(x.running ? "-" : formatTime(x.snap["end-time"]))}</td>` +
+      `<td class="${x.aborted ? "warn" : (x.running ? "ok" : "")}">${state}</td></tr>`;
+  }).join("") || `<tr><td colspan="8">no ${running ? "running " : ""}jobs</td></tr>`;
+}
+
+// routing
+
+function currentHash() {
+  return location.hash.slice(1) || "cluster";
+}
+
+async function render(more) {
+  const hash = currentHash();
+  const view = hash.startsWith("objects/") ? "buckets" : hash;
+  for (const section of document.querySelectorAll("main > section")) {
+    section.hidden = section.id !== view;
+  }
+  for (const a of document.querySelectorAll("nav a")) {
+    a.classList.toggle("active", a.dataset.view === view);
+  }
+  try {
+    switch (view) {
+      case "cluster":
+        await viewCluster();
+        break;
+      case "buckets":
+        if (hash.startsWith("objects/")) {
+          await viewObjects(hash, more);
+        } else {
+          await viewBuckets();
+        }
+        break;
+      case "jobs":
+        await viewJobs();
+        break;
+    }
+    showError(null);
+    $("refreshed").textContent = new Date().toLocaleTimeString();
+  } catch (err) {
+    showError(err);
+  }
+
+  // auto-refresh cluster and jobs (bucket listings refresh on demand)
+  clearTimeout(timer);
+  if (view === "cluster" || view === "jobs") {
+    timer = setTimeout(() => render(false), refreshInterval);
+  }
+}
+
+window.addEventListener("hashchange", () => {
+  $("prefix").value = "";
+  render(false);
+});
+
+$("auth").addEventListener("submit", (ev) => {
+  ev.preventDefault();
+  const token = $("token").value.trim();
+  if (token) {
+    localStorage.setItem(tokenKey, token);
+  } else {
+    localStorage.removeItem(tokenKey);
+  }
+  $("token").value = "";
+  render(false);
+});
+
+$("bck-filter").addEventListener("submit", (ev) => {
+  ev.preventDefault();
+  render(false);
+});
+
+$("more").addEventListener("click", () => render(true));
+$("running").addEventListener("change", () => render(false));
+
+render(false);
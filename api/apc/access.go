@@ -5,6 +5,7 @@
 package apc
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -81,10 +82,32 @@ const (
 
 	AccessNone = AccessAttrs(0)
 
+	// denied when the bucket is frozen, regardless of its access (see cmn.Bprops.Frozen)
+	AccessFrozen = AcePUT | AceAPPEND | AceObjDELETE | AceObjMOVE | AcePromote | AceObjUpdate
+
 	// permission to perform cluster-level ops
 	AccessCluster = AceListBuckets | AceCreateBucket | AceDestroyBucket | AceMoveBucket | AceAdmin
 )
 
+// allow and/or deny permissions relative to the current (bucket) access - computed
+// by the primary from the current value (and under the BMD lock), see ActUpdateAccess
+type AccessUpdate struct {
+	Allow AccessAttrs `json:"allow,string"`
+	Deny  AccessAttrs `json:"deny,string"`
+}
+
+func (u *AccessUpdate) Validate() error {
+	if u.Allow == 0 && u.Deny == 0 {
+		return errors.New("access update: nothing to allow or deny")
+	}
+	if both := u.Allow & u.Deny; both != 0 {
+		return fmt.Errorf("access update: cannot both allow and deny %q", both.Describe(true))
+	}
+	return nil
+}
+
+func (u *AccessUpdate) Apply(access AccessAttrs) AccessAttrs { return (access | u.Allow) &^ u.Deny }
+
 // verbs
 func SupportedPermissions() []string {
 	accList := []string{"ro", "rw", "su"}
@@ -14,12 +14,15 @@ import (
 // ActMsg.Action
 // includes Xaction.Kind == ActMsg.Action (when the action is asynchronous)
 const (
-	ActCreateBck   = "create-bck"  // NOTE: compare w/ ActAddRemoteBck below
-	ActDestroyBck  = "destroy-bck" // destroy bucket data and metadata
-	ActSetBprops   = "set-bprops"
-	ActResetBprops = "reset-bprops"
+	ActCreateBck    = "create-bck"  // NOTE: compare w/ ActAddRemoteBck below
+	ActDestroyBck   = "destroy-bck" // destroy bucket data and metadata
+	ActSetBprops    = "set-bprops"
+	ActResetBprops  = "reset-bprops"
+	ActUpdateAccess = "update-access" // allow and/or deny bucket permissions, see AccessUpdate
 
 	ActSummaryBck = "summary-bck"
+	ActHeatmapBck = "heatmap-bck" // per-object access statistics, see feat.TrackObjectAccess
+	ActSampleBck  = "sample-bck"  // uniform random sample of object names
 
 	ActECEncode  = "ec-encode" // erasure code a bucket
 	ActECGet     = "ec-get"    // read erasure coded objects
@@ -31,14 +34,23 @@ const (
 
 	ActETLInline = "etl-inline"
 
-	ActDsort    = "dsort"
-	ActDownload = "download"
+	ActDsort     = "dsort"
+	ActInitShard = "init-shard" // pack loose objects into shards, see InitShardMsg
+	ActDownload  = "download"
 
 	ActBlobDl = "blob-download"
 
+	// multipart (resumable) upload: ActMsg.Name is the upload ID (see MptStatus, api.PutObjectMultipart)
+	ActMptStart    = "mpt-start"
+	ActMptStatus   = "mpt-status"
+	ActMptComplete = "mpt-complete"
+	ActMptAbort    = "mpt-abort"
+
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
 
+	ActReplicate = "replicate" // async replication to remote ais cluster (see cmn.ReplConf)
+
 	ActRebalance = "rebalance"
 	ActMoveBck   = "move-bck"
 
@@ -50,12 +62,14 @@ const (
 	ActStoreCleanup = "cleanup-store"
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
+	ActCheckOOB       = "check-oob"        // detect out-of-band changes, see CheckOOBMsg
 	ActInvalListCache = "inval-listobj-cache"
 	ActList           = "list"
 	ActLoadLomCache   = "load-lom-cache"
 	ActNewPrimary     = "new-primary"
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
+	ActSetObjProps    = "set-obj-props" // PATCH(object), see ObjPropsToSet
 
 	// cp (reverse)
 	ActResetStats  = "reset-stats"
@@ -64,6 +78,8 @@ const (
 
 	ActRotateLogs = "rotate-logs"
 
+	ActEnrollAuthN = "enroll-authn" // register with AuthN (see ActValEnrollAuthN)
+
 	ActShutdownCluster = "shutdown" // see also: ActShutdownNode
 
 	// multi-object (via `ListRange`)
@@ -72,7 +88,9 @@ const (
 	ActETLObjects      = "etl-listrange"
 	ActEvictObjects    = "evict-listrange"
 	ActPrefetchObjects = "prefetch-listrange"
-	ActArchive         = "archive" // see ArchiveMsg
+	ActArchive         = "archive"       // see ArchiveMsg
+	ActRenamePrefix    = "rename-prefix" // rename virtual directory, see RenamePrefixMsg
+	ActBatchObjOps     = "batch-obj-ops" // set-props and/or delete multiple objects, see BatchOpsMsg
 
 	ActAttachRemAis = "attach"
 	ActDetachRemAis = "detach"
@@ -142,6 +160,7 @@ type (
 		Value  any    `json:"value"`  // action-specific and optional
 		Action string `json:"action"` // ActShutdown, ActRebalance, and many more (see apc/const.go)
 		Name   string `json:"name"`   // action-specific name (e.g., bucket name)
+		ReqID  string `json:"-"`      // (internal use) ID of the request that carried this message - see cos.HdrReqID
 	}
 	ActValRmNode struct {
 		DaemonID          string `json:"sid"`
@@ -150,6 +169,11 @@ type (
 		KeepInitialConfig bool   `json:"keep_initial_config"` // ditto (to be able to restart a node from scratch)
 		NoShutdown        bool   `json:"no_shutdown"`
 	}
+	ActValEnrollAuthN struct {
+		URL   string `json:"url"`   // AuthN URL
+		Token string `json:"token"` // one-time enrollment token issued by AuthN
+		Alias string `json:"alias,omitempty"`
+	}
 )
 
 type (
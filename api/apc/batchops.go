@@ -0,0 +1,92 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Batch object operations (ActBatchObjOps): a list of set-properties and delete operations
+// applied in a single round trip, with per-item results. Semantics:
+//   - all-or-nothing for the items owned (per HRW) by the same target: each target locks
+//     its items, validates all of them and only then applies - metadata updates first,
+//     deletions last; any failure rolls back all metadata updates (and skips the remaining
+//     deletions);
+//   - best-effort across targets: items owned by different targets succeed or fail independently.
+// (remote deletions - for buckets with remote backends - cannot be rolled back.)
+
+const (
+	BatchSetProps = "set-props" // see ObjPropsToSet
+	BatchDelete   = "delete"
+)
+
+const MaxBatchOps = 10000
+
+type (
+	BatchOp struct {
+		Props   *ObjPropsToSet `json:"props,omitempty"` // BatchSetProps only
+		ObjName string         `json:"name"`
+		Op      string         `json:"op"` // enum Batch* above
+	}
+	BatchOpsMsg struct {
+		Ops []BatchOp `json:"ops"`
+	}
+
+	BatchOpResult struct {
+		ObjName string `json:"name"`
+		Err     string `json:"error,omitempty"`
+		Idx     int    `json:"idx"`              // index in BatchOpsMsg.Ops
+		Status  int    `json:"status,omitempty"` // http status (when failed)
+	}
+	// in the BatchOpsMsg.Ops order
+	BatchOpsResult struct {
+		Items []BatchOpResult `json:"items"`
+	}
+)
+
+func (msg *BatchOpsMsg) Validate() error {
+	if len(msg.Ops) == 0 {
+		return errors.New("batch: no operations")
+	}
+	if len(msg.Ops) > MaxBatchOps {
+		return fmt.Errorf("batch: too many operations (%d, max %d)", len(msg.Ops), MaxBatchOps)
+	}
+	names := make(map[string]struct{}, len(msg.Ops))
+	for i := range msg.Ops {
+		op := &msg.Ops[i]
+		if op.ObjName == "" {
+			return fmt.Errorf("batch: operation #%d: empty object name", i)
+		}
+		// (objects get locked for the duration of the batch)
+		if _, ok := names[op.ObjName]; ok {
+			return fmt.Errorf("batch: duplicate object name %q", op.ObjName)
+		}
+		names[op.ObjName] = struct{}{}
+		switch op.Op {
+		case BatchSetProps:
+			if op.Props == nil {
+				return fmt.Errorf("batch: %s %q: no properties to set", op.Op, op.ObjName)
+			}
+			if err := op.Props.Validate(); err != nil {
+				return fmt.Errorf("batch: %s %q: %v", op.Op, op.ObjName, err)
+			}
+		case BatchDelete:
+		default:
+			return fmt.Errorf("batch: invalid operation %q (expecting %q or %q)", op.Op, BatchSetProps, BatchDelete)
+		}
+	}
+	return nil
+}
+
+// number of failed items
+func (res *BatchOpsResult) NumErrs() (n int) {
+	for i := range res.Items {
+		if res.Items[i].Err != "" {
+			n++
+		}
+	}
+	return n
+}
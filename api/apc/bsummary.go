@@ -4,6 +4,27 @@
  */
 package apc
 
+import (
+	"errors"
+	"fmt"
+)
+
+// BsummCtrlMsg.GroupBy enum
+const (
+	BsummGroupExt    = "ext"          // file extension, e.g. ".jpg", ".tar.gz"
+	BsummGroupCtype  = "content-type" // backend-provided content type or, if none, derived from the extension
+	BsummGroupPrefix = "prefix"       // top-level virtual directory (relative to BsummCtrlMsg.Prefix)
+)
+
+// group names for objects that have no extension and no (known) content type, respectively
+const (
+	BsummGroupNone    = "(none)"
+	BsummGroupUnknown = "(unknown)"
+)
+
+// max number of distinct groups (or prefixes - see PrefixDepth) per bucket per target
+const MaxBsummGroups = 16 * 1024
+
 type (
 	// to generate bucket summary (or summaries)
 	BsummCtrlMsg struct {
@@ -12,6 +33,31 @@ type (
 		ObjCached     bool   `json:"cached"`
 		BckPresent    bool   `json:"present"`
 		DontAddRemote bool   `json:"dont_add_remote"`
+		// when positive: also break down (present objects') sizes by virtual directory, up to this depth
+		// relative to `Prefix` (e.g., depth 2: "a/", "a/b/", "c/", ...) - see BsummResult.ByPrefix
+		PrefixDepth int `json:"prefix_depth,omitempty"`
+		// optional: per-group counts and sizes of present objects (see BsummGroup* enum and BsummResult.Groups)
+		GroupBy string `json:"group_by,omitempty"`
+		// when positive: estimate rather than count - each target samples up to this many (present) objects
+		// per bucket and extrapolates from the bucket's size on disk (see BsummResult.Estimate)
+		SampleSize int `json:"sample_size,omitempty"`
+	}
+
+	// sampled bucket summary (see BsummCtrlMsg.SampleSize): when not exact, BsummResult.ObjCount.Present
+	// and TotalSize.PresentObjs are estimates, with the former within [CountLow, CountHigh]
+	// at 95% confidence (the bounds account for sampling error only)
+	BsummEstimate struct {
+		Sampled   uint64 `json:"sampled,string"`
+		CountLow  uint64 `json:"obj_count_low,string"`
+		CountHigh uint64 `json:"obj_count_high,string"`
+		Exact     bool   `json:"exact"` // all present objects were visited - nothing to extrapolate
+	}
+
+	// number of objects and their total size in a given group or under a given prefix
+	// (see BsummCtrlMsg.GroupBy and PrefixDepth, respectively)
+	BsummGroup struct {
+		Count uint64 `json:"count,string"`
+		Size  uint64 `json:"size,string"`
 	}
 
 	// "summarized" result for a given bucket
@@ -31,7 +77,31 @@ type (
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
-		UsedPct      uint64 `json:"used_pct"`
-		IsBckPresent bool   `json:"is_present"` // in BMD
+		ByPrefix      map[string]BsummGroup `json:"by_prefix,omitempty"` // see BsummCtrlMsg.PrefixDepth
+		Groups        map[string]BsummGroup `json:"groups,omitempty"`    // see BsummCtrlMsg.GroupBy
+		Estimate      *BsummEstimate        `json:"estimate,omitempty"`  // see BsummCtrlMsg.SampleSize
+		UsedPct       uint64                `json:"used_pct"`
+		IsBckPresent  bool                  `json:"is_present"`                   // in BMD
+		PrefixesTrunc bool                  `json:"prefixes_truncated,omitempty"` // too many prefixes - see MaxBsummGroups
+		GroupsTrunc   bool                  `json:"groups_truncated,omitempty"`   // ditto, groups
 	}
 )
+
+func (msg *BsummCtrlMsg) Validate() error {
+	switch msg.GroupBy {
+	case "", BsummGroupExt, BsummGroupCtype, BsummGroupPrefix:
+	default:
+		return fmt.Errorf("invalid bucket summary group-by %q (expecting one of: %q, %q, %q)",
+			msg.GroupBy, BsummGroupExt, BsummGroupCtype, BsummGroupPrefix)
+	}
+	if msg.PrefixDepth < 0 {
+		return fmt.Errorf("invalid bucket summary prefix depth %d", msg.PrefixDepth)
+	}
+	if msg.SampleSize < 0 {
+		return fmt.Errorf("invalid bucket summary sample size %d", msg.SampleSize)
+	}
+	if msg.SampleSize > 0 && (msg.GroupBy != "" || msg.PrefixDepth > 0) {
+		return errors.New("sampled (estimated) bucket summary cannot be combined with group-by or prefix depth")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Composite object: ordered list of member objects (in the same bucket) that reads as one.
+// - PUT(object) with QparamComposite=true carries the manifest (below) that lists member names;
+//   the cluster validates the members and stores the manifest (with resolved sizes) as the object;
+// - GET(object) streams the members concatenated, in order (range reads included);
+// - GET(object) with QparamComposite=false returns the (stored) manifest as is.
+
+const (
+	MaxCompositeMembers      = 10_000
+	MaxCompositeManifestSize = 4 * cos.MiB
+)
+
+type (
+	CompositeMember struct {
+		Name string `json:"name"`
+		Size int64  `json:"size,omitempty"` // resolved when storing the manifest
+	}
+	CompositeManifest struct {
+		Members []CompositeMember `json:"members"`
+		Size    int64             `json:"size,omitempty"` // total (logical) size
+	}
+)
+
+func (m *CompositeManifest) Validate(objName string) error {
+	switch {
+	case len(m.Members) == 0:
+		return errors.New("composite manifest: empty list of members")
+	case len(m.Members) > MaxCompositeMembers:
+		return fmt.Errorf("composite manifest: number of members (%d) exceeds the maximum (%d)",
+			len(m.Members), MaxCompositeMembers)
+	}
+	for i := range m.Members {
+		name := m.Members[i].Name
+		if name == "" {
+			return fmt.Errorf("composite manifest: member #%d has empty name", i)
+		}
+		if name == objName {
+			return fmt.Errorf("composite manifest: %q cannot be a member of itself", objName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+// object deduplication: chunking enum
+// (bucket-configurable, see cmn.DedupConf)
+const (
+	DedupFixed = "fixed" // fixed-size chunks
+	DedupCDC   = "cdc"   // content-defined chunking (variable-size chunks, gear-based rolling hash)
+)
+
+var SupportedDedupChunking = []string{DedupFixed, DedupCDC}
+
+func IsValidDedupChunking(c string) bool {
+	return c == "" || cos.StringInSlice(c, SupportedDedupChunking)
+}
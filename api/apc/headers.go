@@ -22,6 +22,7 @@ const (
 	HdrBucketVerEnabled = HeaderPrefix + "versioning-enabled" // Enable/disable object versioning in a bucket.
 	HdrBucketCreated    = HeaderPrefix + "created"            // Bucket creation time.
 	HdrBackendProvider  = HeaderPrefix + "provider"           // ProviderAmazon et al. - see cmn/bck.go.
+	HdrBucketAccess     = HeaderPrefix + "bucket-access"      // Remote bucket permissions imported from the backend (apc.AccessAttrs).
 
 	// including BucketProps.Extra.AWS
 	HdrS3Region   = HeaderPrefix + "cloud_region"
@@ -35,19 +36,25 @@ const (
 	HdrRemAisUUID  = HeaderPrefix + "remote-ais-uuid"
 	HdrRemAisAlias = HeaderPrefix + "remote-ais-alias"
 	HdrRemAisURL   = HeaderPrefix + "remote-ais-url"
+	HdrRemAisErrs  = HeaderPrefix + "remote-ais-errors" // list-buckets (QparamRemAisAll): remote clusters that failed
 
 	HdrRemoteOffline = HeaderPrefix + "remote-offline" // When accessing cached remote bucket with no backend connectivity.
 
 	// Object props headers
-	HdrObjCksumType = HeaderPrefix + "checksum-type"  // Checksum type, one of SupportedChecksums().
-	HdrObjCksumVal  = HeaderPrefix + "checksum-value" // Checksum value.
-	HdrObjAtime     = HeaderPrefix + "atime"          // Object access time.
-	HdrObjCustomMD  = HeaderPrefix + "custom-md"      // Object custom metadata.
-	HdrObjVersion   = HeaderPrefix + "version"        // Object version/generation - ais or cloud.
+	HdrObjCksumType  = HeaderPrefix + "checksum-type"  // Checksum type, one of SupportedChecksums().
+	HdrObjCksumVal   = HeaderPrefix + "checksum-value" // Checksum value.
+	HdrObjAtime      = HeaderPrefix + "atime"          // Object access time.
+	HdrObjCustomMD   = HeaderPrefix + "custom-md"      // Object custom metadata.
+	HdrObjMetaPrefix = HeaderPrefix + "meta-"          // PUT: user-defined metadata, one header per key (e.g. "ais-meta-color: red").
+	HdrObjVersion    = HeaderPrefix + "version"        // Object version/generation - ais or cloud.
 
 	// Archive filename and format (mime type)
 	HdrArchpath = HeaderPrefix + "archpath"
 	HdrArchmime = HeaderPrefix + "archmime"
+	HdrArchglob = HeaderPrefix + "archglob" // (response is a TAR of all archived files that match)
+
+	// Per-request timeout (e.g. "30s") - overrides the configured default (see timeout.object_*_time)
+	HdrTimeout = HeaderPrefix + "timeout"
 
 	// Append object header.
 	HdrAppendHandle = HeaderPrefix + "append-handle"
@@ -0,0 +1,73 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "sort"
+
+// per-object access statistics ("heatmap"), see also: feat.TrackObjectAccess
+
+const (
+	DefaultHeatmapTopN = 100
+	MaxHeatmapTopN     = 10000
+)
+
+type (
+	HeatmapMsg struct {
+		TopN int `json:"top_n"` // number of hottest objects to return (0: DefaultHeatmapTopN)
+	}
+
+	HeatmapEntry struct {
+		Name  string `json:"name"`
+		Count int64  `json:"count"`
+		Atime int64  `json:"atime"` // last access (Unix nanoseconds)
+	}
+
+	Heatmap struct {
+		Top []HeatmapEntry `json:"top"` // hottest objects, in descending order
+		// Histogram[i] is the number of (tracked) objects accessed [2^i, 2^(i+1)) times
+		Histogram []int64 `json:"histogram"`
+		Total     int64   `json:"total"`   // total number of accesses
+		Tracked   int64   `json:"tracked"` // number of currently tracked objects
+		Evicted   int64   `json:"evicted"` // number of objects that were tracked and (as the coldest ones) evicted
+	}
+)
+
+func (msg *HeatmapMsg) Validate() {
+	switch {
+	case msg.TopN <= 0:
+		msg.TopN = DefaultHeatmapTopN
+	case msg.TopN > MaxHeatmapTopN:
+		msg.TopN = MaxHeatmapTopN
+	}
+}
+
+// merge other (e.g., another target's) heatmap; objects are assumed to be distinct
+func (h *Heatmap) Merge(other *Heatmap, topN int) {
+	h.Total += other.Total
+	h.Tracked += other.Tracked
+	h.Evicted += other.Evicted
+	for i, n := range other.Histogram {
+		if i >= len(h.Histogram) {
+			h.Histogram = append(h.Histogram, other.Histogram[i:]...)
+			break
+		}
+		h.Histogram[i] += n
+	}
+	h.Top = append(h.Top, other.Top...)
+	h.SortTop(topN)
+}
+
+// sort in descending order (by access count and, secondly, last-access time) and trim
+func (h *Heatmap) SortTop(topN int) {
+	sort.Slice(h.Top, func(i, j int) bool {
+		if h.Top[i].Count != h.Top[j].Count {
+			return h.Top[i].Count > h.Top[j].Count
+		}
+		return h.Top[i].Atime > h.Top[j].Atime
+	})
+	if topN > 0 && len(h.Top) > topN {
+		h.Top = h.Top[:topN]
+	}
+}
@@ -0,0 +1,103 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// initial sharding: pack loose objects of a bucket into shards of a given (target) size
+// (see also: dsort to _re_-shard)
+
+// shard name template placeholders
+const (
+	ShardTmplTID = "{tid}" // target ID (each target packs the objects it stores)
+	ShardTmplSeq = "{seq}" // shard sequence number (per target), 6 digits zero-padded
+)
+
+// packing order (of the samples within each target)
+const (
+	ShardOrderName = "name" // sorted by sample name: samples sharing a prefix (virtual directory) end up in the same shard(s)
+	ShardOrderHash = "hash" // by the hash of the sample name (pseudo-random, reproducible)
+)
+
+const (
+	DefaultInitShardTmpl = "shards/" + ShardTmplTID + "-" + ShardTmplSeq + ".tar"
+	DefaultInitShardSize = cos.GiB
+)
+
+var SupportedShardOrder = []string{ShardOrderName, ShardOrderHash}
+
+type (
+	// Sample is a group of objects that share the same name up to (and not including)
+	// the first dot of the basename, e.g. "a/001.jpg" and "a/001.cls" (sample "a/001").
+	// Samples are never split between shards.
+	InitShardMsg struct {
+		Prefix    string `json:"prefix"`     // source: (loose) objects that have names starting with the prefix
+		Template  string `json:"template"`   // shard names, e.g. DefaultInitShardTmpl; the extension defines the format
+		Order     string `json:"order"`      // packing order (enum ShardOrder*)
+		ShardSize int64  `json:"shard_size"` // target shard size: shards are finalized once reaching (or exceeding) it
+		DeleteSrc bool   `json:"delete_src"` // remove the packed objects once the shard is stored
+	}
+
+	// (per target) manifest object named ManifestName(tid)
+	InitShardManifest struct {
+		Shards map[string][]string `json:"shards"` // shard name => samples
+		TID    string              `json:"tid"`
+	}
+)
+
+func (msg *InitShardMsg) Validate() error {
+	if msg.Template == "" {
+		msg.Template = DefaultInitShardTmpl
+	}
+	if msg.Order == "" {
+		msg.Order = ShardOrderName
+	}
+	if msg.ShardSize == 0 {
+		msg.ShardSize = DefaultInitShardSize
+	}
+	if !strings.Contains(msg.Template, ShardTmplTID) || !strings.Contains(msg.Template, ShardTmplSeq) {
+		return fmt.Errorf("invalid shard name template %q: must contain both %s and %s placeholders",
+			msg.Template, ShardTmplTID, ShardTmplSeq)
+	}
+	if msg.TmplPrefix() == "" {
+		return errors.New("invalid shard name template " + msg.Template + ": must start with a (non-empty) prefix, e.g. \"shards/\"")
+	}
+	if msg.Prefix != "" && strings.HasPrefix(msg.Prefix, msg.TmplPrefix()) {
+		return fmt.Errorf("source prefix %q cannot be contained in the shard name prefix %q", msg.Prefix, msg.TmplPrefix())
+	}
+	if !cos.StringInSlice(msg.Order, SupportedShardOrder) {
+		return fmt.Errorf("invalid packing order %q (expecting one of: %v)", msg.Order, SupportedShardOrder)
+	}
+	if msg.ShardSize < 0 {
+		return fmt.Errorf("invalid shard size %d", msg.ShardSize)
+	}
+	return nil
+}
+
+// the (static) part of the template preceding the first placeholder;
+// objects with names starting with it are never packed
+func (msg *InitShardMsg) TmplPrefix() string {
+	i := strings.IndexByte(msg.Template, '{')
+	if i < 0 {
+		return msg.Template
+	}
+	return msg.Template[:i]
+}
+
+func (msg *InitShardMsg) ShardName(tid string, seq int) string {
+	name := strings.ReplaceAll(msg.Template, ShardTmplTID, tid)
+	return strings.ReplaceAll(name, ShardTmplSeq, fmt.Sprintf("%06d", seq))
+}
+
+// manifest is stored next to the shards, e.g. "shards/manifest-<tid>.json"
+func (msg *InitShardMsg) ManifestName(tid string) string {
+	return msg.TmplPrefix() + "manifest-" + tid + ".json"
+}
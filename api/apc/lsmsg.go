@@ -5,6 +5,7 @@
 package apc
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -65,11 +66,11 @@ const (
 	// requesting proxy and, subsequently, to client.
 	LsWantOnlyRemoteProps
 
-	// List bucket entries without recursion (POSIX-wise). Note that the result in this case
-	// will include matching directories.
-	// TODO: works only with AIS bucket and only via S3 (no CLI yet). More exactly:
-	// - update AIS CLI to support non-recursive list-objects operation
-	// - when listing remote bucket, call backend (`Backend()`) to list non-recursively
+	// List bucket entries without recursion (POSIX-wise), similar to S3 listing with delimiter '/'.
+	// The result includes objects at the level of the `Prefix` and virtual directories
+	// (aka common prefixes - entries flagged with EntryIsDir and named without trailing '/').
+	// Targets do not descend into the listed directories.
+	// TODO: remote backends other than AWS (currently, list cached objects with LsObjCached)
 	LsNoRecursion
 
 	// For remote metadata-capable buckets (ie., bck.HasVersioningMD() == true):
@@ -77,6 +78,13 @@ const (
 	// and if it does:
 	// - check whether remote version differs from its in-cluster copy
 	LsVerChanged
+
+	// "Head of bucket": the first PageSize objects discovered by the targets - unordered,
+	// best-effort, and without continuation token (a single page that requires neither
+	// list-objects xaction nor cross-target merge); e.g., to sample or check non-emptiness
+	// of a huge bucket. Remote buckets: in-cluster objects with LsObjCached, otherwise
+	// the first page from the backend.
+	LsHead
 )
 
 // List objects default page size
@@ -146,8 +154,19 @@ type LsoMsg struct {
 	SID               string `json:"target"`             // selected target to solely execute backend.list-objects
 	Flags             uint64 `json:"flags,string"`       // enum {LsObjCached, ...} - "LsoMsg flags" above
 	PageSize          uint   `json:"pagesize"`           // max entries returned by list objects call
+	CustomMD          string `json:"custom_md"`          // filter by custom metadata (see LsoMDFilter)
 }
 
+// list only objects with matching custom metadata: comma-separated "key=value" and/or "key" (presence)
+// entries - all must match; objects present in the cluster only (implies LsObjCached)
+type (
+	LsoMDFilter []lsoMDCond
+	lsoMDCond   struct {
+		key, val string
+		any      bool
+	}
+)
+
 ////////////
 // LsoMsg //
 ////////////
@@ -210,3 +229,36 @@ func (lsmsg *LsoMsg) Clone() *LsoMsg {
 	cos.CopyStruct(c, lsmsg)
 	return c
 }
+
+func (lsmsg *LsoMsg) MDFilter() (LsoMDFilter, error) {
+	if lsmsg.CustomMD == "" {
+		return nil, nil
+	}
+	var (
+		entries = strings.Split(lsmsg.CustomMD, ",")
+		flt     = make(LsoMDFilter, 0, len(entries))
+	)
+	for _, kv := range entries {
+		var cond lsoMDCond
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			cond.key, cond.val = strings.TrimSpace(k), strings.TrimSpace(v)
+		} else {
+			cond.key, cond.any = strings.TrimSpace(kv), true
+		}
+		if cond.key == "" {
+			return nil, fmt.Errorf("invalid custom metadata filter %q: empty key", lsmsg.CustomMD)
+		}
+		flt = append(flt, cond)
+	}
+	return flt, nil
+}
+
+func (flt LsoMDFilter) Match(md cos.StrKVs) bool {
+	for _, cond := range flt {
+		v, ok := md[cond.key]
+		if !ok || (!cond.any && v != cond.val) {
+			return false
+		}
+	}
+	return true
+}
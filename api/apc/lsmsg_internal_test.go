@@ -0,0 +1,45 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestLsoMDFilter(t *testing.T) {
+	md := cos.StrKVs{"color": "red", "shape": "round", "empty": ""}
+	tests := []struct {
+		custom   string
+		expected bool
+	}{
+		{"color=red", true},
+		{"color=blue", false},
+		{"color", true},
+		{"size", false},
+		{"color=red,shape", true},
+		{"color=red, shape=round", true},
+		{"color=red,shape=square", false},
+		{"empty=", true},
+		{"empty", true},
+	}
+	for _, test := range tests {
+		msg := &LsoMsg{CustomMD: test.custom}
+		flt, err := msg.MDFilter()
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", test.custom, err)
+		}
+		if matched := flt.Match(md); matched != test.expected {
+			t.Errorf("%q: expected %t, got %t", test.custom, test.expected, matched)
+		}
+	}
+	for _, custom := range []string{"=red", "color=red,", ","} {
+		msg := &LsoMsg{CustomMD: custom}
+		if _, err := msg.MDFilter(); err == nil {
+			t.Errorf("%q: expected error", custom)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+// multipart (resumable) upload: a target-side session that accumulates numbered parts
+// (each a separate PUT) until completed - or aborted
+const (
+	MptMaxParts = 10000
+)
+
+type (
+	// ActMptComplete (ActMsg.Value): all parts 1..NumParts must be present
+	MptCompleteMsg struct {
+		NumParts int   `json:"num_parts"`
+		Size     int64 `json:"size,omitempty"` // optional: expected total size (bytes)
+	}
+	// ActMptStatus (response): parts received so far, in ascending order
+	MptStatus struct {
+		UploadID string        `json:"upload_id"`
+		Parts    []MptPartInfo `json:"parts"`
+		Started  int64         `json:"started,string"` // unix nano
+	}
+	MptPartInfo struct {
+		Cksum *cos.Cksum `json:"cksum,omitempty"`
+		Num   int        `json:"num"`
+		Size  int64      `json:"size,string"`
+	}
+)
@@ -0,0 +1,44 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object properties to set via PATCH(object) with ActSetObjProps:
+//   - custom (user) metadata: added or updated (replaced in its entirety with QparamNewCustom);
+//   - pinned objects do not get evicted by LRU and (unless explicitly requested via QparamLatestVer)
+//     are not validated against (nor updated from) the remote backend;
+//   - custom checksum gets validated against the object's content prior to being stored.
+type ObjPropsToSet struct {
+	Custom    cos.StrKVs `json:"custom,omitempty"`
+	Pinned    *bool      `json:"pinned,omitempty"`
+	CksumType string     `json:"cksum_type,omitempty"`
+	CksumVal  string     `json:"cksum_value,omitempty"`
+}
+
+func (props *ObjPropsToSet) Validate() error {
+	if len(props.Custom) == 0 && props.Pinned == nil && props.CksumType == "" && props.CksumVal == "" {
+		return errors.New("no object properties to set")
+	}
+	for k := range props.Custom {
+		if k == "" {
+			return errors.New("custom metadata: empty key")
+		}
+	}
+	if props.CksumType == "" && props.CksumVal == "" {
+		return nil
+	}
+	if props.CksumType == "" || props.CksumVal == "" {
+		return errors.New("custom checksum requires both type and value")
+	}
+	if props.CksumType == cos.ChecksumNone {
+		return errors.New("invalid custom checksum type \"" + cos.ChecksumNone + "\"")
+	}
+	return cos.ValidateCksumType(props.CksumType)
+}
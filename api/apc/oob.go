@@ -0,0 +1,53 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// out-of-band change detection (ActCheckOOB): each target lists the remote backend and compares
+// the objects it stores (in-cluster) with their remote counterparts (size, version, ETag, checksums);
+// stale objects - changed or deleted out-of-band, i.e., bypassing aistore - get reported and,
+// optionally, marked or evicted
+
+// what to do with stale objects
+const (
+	OOBReport = "report" // report only (default)
+	OOBMark   = "mark"   // mark stale: the next GET will (re)fetch the object from the remote backend
+	OOBEvict  = "evict"  // evict stale objects (remotely deleted objects are evicted in all cases but "report")
+)
+
+var SupportedOOBActions = []string{OOBReport, OOBMark, OOBEvict}
+
+type (
+	CheckOOBMsg struct {
+		Prefix string `json:"prefix"` // check only the objects with names starting with the prefix
+		Action string `json:"action"` // enum OOB* above
+	}
+	// per-target summary report (via extended xaction stats, see core.Snap.Ext)
+	CheckOOBStats struct {
+		Action  string   `json:"action"`
+		Recent  []string `json:"recent_stale,omitempty"` // names of (some of) the stale objects
+		Scanned int64    `json:"scanned"`                // number of cached objects
+		Changed int64    `json:"changed"`                // changed remotely
+		Deleted int64    `json:"deleted"`                // deleted remotely
+		Marked  int64    `json:"marked"`
+		Evicted int64    `json:"evicted"`
+	}
+)
+
+func (msg *CheckOOBMsg) Validate() error {
+	if msg.Action == "" {
+		msg.Action = OOBReport
+	}
+	for _, a := range SupportedOOBActions {
+		if msg.Action == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid out-of-band check action %q (expecting one of: %s)", msg.Action, strings.Join(SupportedOOBActions, ", "))
+}
@@ -1,9 +1,15 @@
 // Package apc: API control messages and constants
 /*
- * Copyright (c) 2018-2022, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
  */
 package apc
 
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
 // common part that's used in `api.PromoteArgs` and `PromoteParams`(server side), both
 type PromoteArgs struct {
 	DaemonID  string `json:"tid,omitempty"` // target ID
@@ -17,4 +23,60 @@ type PromoteArgs struct {
 	// and _not_ to try to auto-detect if it is;
 	// (auto-detection takes time, etc.)
 	SrcIsNotFshare bool `json:"notshr,omitempty"` // the source is not a file share equally accessible by all targets
+	// ingest by hard-linking the source when it resides on the same filesystem as the destination
+	// mountpath (otherwise, fall back to copying); ignored when DeleteSrc is set (in which case
+	// the source gets moved - renamed - whenever possible)
+	HardLink bool `json:"hln,omitempty"`
+	// keep promoting remaining files in presence of per-file errors (see PromoteStats below)
+	ContinueOnError bool `json:"coer,omitempty"`
+	// shell filename patterns (see filepath.Match) to select and skip source files, respectively;
+	// a pattern containing path separator is matched against the source's relative pathname,
+	// otherwise - against its base name
+	Include []string `json:"incl,omitempty"`
+	Exclude []string `json:"excl,omitempty"`
+}
+
+// per-target promote summary (via extended xaction stats, see core.Snap.Ext)
+type PromoteStats struct {
+	Recent  []string `json:"recent_errs,omitempty"` // (some of the) per-file errors
+	Linked  int64    `json:"linked"`                // hard-linked (ie., not copied) files
+	Skipped int64    `json:"skipped"`               // excluded by the Include/Exclude patterns
+	Failed  int64    `json:"failed"`                // failed to promote
+}
+
+func (args *PromoteArgs) ValidatePatterns() error {
+	for _, pattern := range args.Include {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid include pattern %q: %v", pattern, err)
+		}
+	}
+	for _, pattern := range args.Exclude {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid exclude pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// returns true if the source file (given its pathname relative to the promoted directory)
+// is selected by the include/exclude patterns
+func (args *PromoteArgs) Selected(relname string) bool {
+	if len(args.Include) > 0 && !_prmMatch(args.Include, relname) {
+		return false
+	}
+	return len(args.Exclude) == 0 || !_prmMatch(args.Exclude, relname)
+}
+
+func _prmMatch(patterns []string, relname string) bool {
+	base := filepath.Base(relname)
+	for _, pattern := range patterns {
+		name := base
+		if strings.ContainsRune(pattern, filepath.Separator) {
+			name = relname
+		}
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
 }
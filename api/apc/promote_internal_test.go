@@ -0,0 +1,42 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "testing"
+
+func TestPromoteArgsSelected(t *testing.T) {
+	tests := []struct {
+		args     PromoteArgs
+		relname  string
+		expected bool
+	}{
+		{PromoteArgs{}, "a/b/c.tar", true},
+		{PromoteArgs{Include: []string{"*.tar"}}, "a/b/c.tar", true},
+		{PromoteArgs{Include: []string{"*.tar"}}, "a/b/c.tgz", false},
+		{PromoteArgs{Include: []string{"*.tar", "*.tgz"}}, "c.tgz", true},
+		{PromoteArgs{Include: []string{"a/*/c.tar"}}, "a/b/c.tar", true},
+		{PromoteArgs{Include: []string{"a/*.tar"}}, "a/b/c.tar", false},
+		{PromoteArgs{Exclude: []string{"*.tmp"}}, "a/b/c.tmp", false},
+		{PromoteArgs{Exclude: []string{"*.tmp"}}, "a/b/c.tar", true},
+		{PromoteArgs{Include: []string{"*.tar"}, Exclude: []string{"skip-*"}}, "a/skip-c.tar", false},
+		{PromoteArgs{Include: []string{"*.tar"}, Exclude: []string{"skip-*"}}, "a/c.tar", true},
+	}
+	for _, test := range tests {
+		if err := test.args.ValidatePatterns(); err != nil {
+			t.Fatalf("%+v: unexpected error: %v", test.args, err)
+		}
+		if selected := test.args.Selected(test.relname); selected != test.expected {
+			t.Errorf("%+v, %q: expected %t, got %t", test.args, test.relname, test.expected, selected)
+		}
+	}
+}
+
+func TestPromoteArgsValidatePatterns(t *testing.T) {
+	for _, args := range []PromoteArgs{{Include: []string{"[a-"}}, {Exclude: []string{"ok", "\\"}}} {
+		if err := args.ValidatePatterns(); err == nil {
+			t.Errorf("%+v: expected invalid pattern error", args)
+		}
+	}
+}
@@ -16,8 +16,9 @@ const (
 	GCP   = "gcp"
 	HDFS  = "hdfs"
 	HTTP  = "ht"
+	OCI   = "oci" // Oracle Cloud Infrastructure (OCI) Object Storage
 
-	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), hdfs://, ht://" // NOTE: must include all
+	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), oci://, hdfs://, ht://" // NOTE: must include all
 
 	NsUUIDPrefix = '@' // BEWARE: used by on-disk layout
 	NsNamePrefix = '#' // BEWARE: used by on-disk layout
@@ -35,12 +36,12 @@ const (
 	AISScheme     = "ais"
 )
 
-var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP)
+var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, OCI, HDFS, HTTP)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
 func IsCloudProvider(p string) bool {
-	return p == AWS || p == GCP || p == Azure
+	return p == AWS || p == GCP || p == Azure || p == OCI
 }
 
 func IsRemoteProvider(p string) bool {
@@ -88,6 +89,8 @@ func DisplayProvider(p string) string {
 		return "Azure"
 	case GCP, GSScheme:
 		return "GCP"
+	case OCI:
+		return "OCI"
 	case HDFS:
 		return "HDFS"
 	case HTTP:
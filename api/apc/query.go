@@ -12,6 +12,7 @@ const (
 	QparamProps = "props" // e.g. "checksum, size"|"atime, size"|"cached"|"bucket, size"| ...
 
 	QparamUUID    = "uuid"     // xaction
+	QparamIval    = "interval" // xaction watch: time between stats updates (e.g., "2s")
 	QparamJobID   = "jobid"    // job
 	QparamETLName = "etl_name" // etl
 
@@ -46,22 +47,36 @@ const (
 	// - docs/cli/aws_profile_endpoint.md
 	QparamDontHeadRemote = "dont_head_remote_bck"
 
+	// Create bucket with the named set of properties defined in the cluster config
+	// (see cmn.BucketProfilesConf)
+	QparamBprofile = "bprofile"
+
 	// When evicting, keep remote bucket in BMD (i.e., evict data only)
 	QparamKeepRemote = "keep_bck_md"
 
 	// (api.GetBucketInfo)
 	QparamBsummRemote = "bsumm_remote"
 
+	// (api.GetBucketSummaryStream) stream per-target results as they arrive
+	QparamBsummStream = "bsumm_stream"
+
 	// "presence" in a given cluster shall not be be confused with "existence" (possibly, remote).
 	// See also:
 	// - Flt* enum below
 	// - ListObjsMsg flags, docs/providers.md (for terminology)
 	QparamFltPresence = "presence"
 
+	// (api.ListBucketsAll) list-buckets: include buckets from all attached remote ais clusters
+	QparamRemAisAll = "remais_all"
+
 	// APPEND(object) operation - QparamAppendType enum below
 	QparamAppendType   = "append_type"
 	QparamAppendHandle = "append_handle"
 
+	// PUT(part) of a multipart upload: upload ID and part number (1-based) - see ActMptStart
+	QparamMptUploadID = "mpt_upload_id"
+	QparamMptPartNum  = "mpt_part_num"
+
 	// HTTP bucket support.
 	QparamOrigURL = "original_url"
 
@@ -70,10 +85,28 @@ const (
 	QparamLogOff  = "offset"
 	QparamAllLogs = "all"
 
+	// Get runtime profile (WhatProfile)
+	QparamProfile     = "profile" // see { ProfileCPU, ...} enum
+	QparamProfileSecs = "seconds" // sampling duration (cpu, mutex, block)
+
+	// AuthN audit log: only the events that occurred within the specified duration (e.g., "24h");
+	// also, target's capacity history (apc.WhatCapHistory)
+	QparamSince = "since"
+
+	// AuthN audit log: at most this number of the most recent events
+	QparamLimit = "limit"
+
+	// target's per-bucket usage (apc.WhatBckUsage): calendar month, e.g. "2024-06"
+	QparamPeriod = "period"
+
 	// Archive filename and format (mime type)
 	QparamArchpath = "archpath"
 	QparamArchmime = "archmime"
 
+	// GET: extract all archived files that match the given wildcard (shell pattern, see path.Match)
+	// and return them as a (new) TAR; mutually exclusive with QparamArchpath
+	QparamArchglob = "archglob"
+
 	// Skip loading existing object's metadata, in part to
 	// compare its Checksum and update its existing Version (if exists).
 	// Can be used to reduce PUT latency when:
@@ -94,6 +127,27 @@ const (
 
 	QparamSync = "synchronize" // TODO: in progress
 
+	// delta sync (see cmn/delta):
+	// - GET(object) with QparamDeltaSig returns the object's signature; the value is block size ("0" for default)
+	// - PUT(object) with QparamDelta (true) carries content encoded as delta against the existing object
+	QparamDeltaSig = "delta_sig"
+	QparamDelta    = "delta"
+
+	// composite objects (see apc.CompositeManifest):
+	// - PUT(object) with QparamComposite (true): request body is the manifest
+	// - GET(object) with QparamComposite (false): return the manifest instead of the concatenated members
+	QparamComposite = "composite"
+
+	// GET(object) a given previous version of the object (see versioning.retain)
+	QparamObjVersion = "version"
+
+	// set-config (cluster):
+	// - QparamStage (true): validate the update on all affected nodes and return the changes (cmn.StagedConfig)
+	//   without applying
+	// - QparamApplyTo: limit the update to a subset of nodes - node-local override (see QparamApplyTo enum)
+	QparamStage   = "stage"
+	QparamApplyTo = "apply_to"
+
 	QparamSilent = "sln" // when true., skip nlog.Error* (motivation: can be quite numerous and/or ignorable)
 )
 
@@ -123,6 +177,12 @@ func IsFltNoProps(v int) bool {
 	return v == FltExistsNoProps || v == FltPresentNoProps
 }
 
+// QparamApplyTo enum (or else, node ID)
+const (
+	ApplyToProxies = "proxies"
+	ApplyToTargets = "targets"
+)
+
 // QparamAppendType enum.
 const (
 	AppendOp = "append"
@@ -148,6 +208,7 @@ const (
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return cluster info and status
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
+	QparamReqID            = "rid" // request ID (cos.HdrReqID) of the redirected request
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
 
@@ -177,11 +238,16 @@ const (
 	// config
 	WhatNodeConfig    = "config" // query specific node for (cluster config + overrides, local config)
 	WhatClusterConfig = "cluster_config"
+	WhatNodeOverride  = "config_override" // names of the (inherited) cluster config values that the node overrides
+	WhatNodeComputed  = "config_computed" // values the node computes (auto-tunes) at startup, e.g. for cgroup limits
 	// stats
 	WhatNodeStats          = "stats"
 	WhatNodeStatsAndStatus = "status"
 	WhatMetricNames        = "metrics"
 	WhatDiskStats          = "disk"
+	WhatCapHistory         = "cap_history" // target's persisted per-mountpath capacity snapshots (see QparamSince)
+	WhatBckUsage           = "bck_usage"   // target's per-bucket GET/PUT bytes and counts per calendar month (see QparamPeriod)
+	WhatSLO                = "slo"         // node's SLO monitors (see cmn.SLOConf)
 	// assorted
 	WhatMountpaths = "mountpaths"
 	WhatRemoteAIS  = "remote"
@@ -190,6 +256,8 @@ const (
 	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
 	// log
 	WhatLog = "log"
+	// runtime profile (pprof format; requires admin access)
+	WhatProfile = "profile"
 	// xactions
 	WhatOneXactStatus   = "status"      // IC status by uuid (returns a single matching xaction or none)
 	WhatAllXactStatus   = "status_all"  // ditto - all matching xactions
@@ -201,6 +269,17 @@ const (
 	WhatICBundle = "ic_bundle"
 )
 
+// QparamProfile enum.
+const (
+	ProfileCPU       = "cpu"
+	ProfileHeap      = "heap"
+	ProfileGoroutine = "goroutine"
+	ProfileMutex     = "mutex"
+	ProfileBlock     = "block"
+)
+
+var SupportedProfiles = []string{ProfileCPU, ProfileHeap, ProfileGoroutine, ProfileMutex, ProfileBlock}
+
 // QparamLogSev enum.
 const (
 	LogInfo = "info"
@@ -0,0 +1,20 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+// bi-directional bucket replication: conflict resolution policy
+// (bucket-configurable, see cmn.ReplConf)
+const (
+	ReplConflictNewer  = "newer"  // last writer wins: higher version or, if not versioned, more recent write (default)
+	ReplConflictReject = "reject" // keep both sides as is and report the conflict
+)
+
+var SupportedReplConflict = []string{ReplConflictNewer, ReplConflictReject}
+
+func IsValidReplConflict(c string) bool {
+	return c == "" || cos.StringInSlice(c, SupportedReplConflict)
+}
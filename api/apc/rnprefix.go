@@ -0,0 +1,30 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// rename virtual directory (ActRenamePrefix): each target renames the objects it stores
+// under `Prefix` as `NewPrefix` + (the rest of the object name); renamed objects that
+// (per HRW) belong to other targets get migrated
+type RenamePrefixMsg struct {
+	Prefix    string `json:"prefix"`     // e.g. "dir1/"
+	NewPrefix string `json:"new_prefix"` // e.g. "dir2/"
+}
+
+func (msg *RenamePrefixMsg) Validate() error {
+	if msg.Prefix == "" || msg.NewPrefix == "" {
+		return errors.New("rename-prefix: source and destination prefixes must be non-empty")
+	}
+	// (otherwise, renamed objects would get renamed again)
+	if strings.HasPrefix(msg.Prefix, msg.NewPrefix) || strings.HasPrefix(msg.NewPrefix, msg.Prefix) {
+		return fmt.Errorf("rename-prefix: %q and %q must not contain one another", msg.Prefix, msg.NewPrefix)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// uniform random sample of (in-cluster) object names
+
+const (
+	DefaultSampleSize = 100
+	MaxSampleSize     = 100_000
+)
+
+type (
+	SampleMsg struct {
+		Prefix string `json:"prefix"` // sample only the objects that have names starting with the prefix
+		Size   int    `json:"size"`   // number of names to return (0: DefaultSampleSize)
+	}
+
+	Sample struct {
+		Names []string `json:"names"` // in random order
+		Total int64    `json:"total"` // number of objects the sample was drawn from
+	}
+)
+
+func (msg *SampleMsg) Validate() {
+	switch {
+	case msg.Size <= 0:
+		msg.Size = DefaultSampleSize
+	case msg.Size > MaxSampleSize:
+		msg.Size = MaxSampleSize
+	}
+}
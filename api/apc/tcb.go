@@ -6,20 +6,39 @@ package apc
 
 import (
 	"errors"
+	"fmt"
+	"path"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
 // copy & (offline) transform bucket to bucket
+//
+// Template: selects source objects that match the (bash, at, or fmt-style) range template,
+// e.g. "shard-{0000..4999}.tar"; applies in addition to Prefix, if specified.
+// NOTE: in TCObjsMsg, the field is shadowed by ListRange.Template
+//
+// Rename: destination naming template that may contain the following placeholders:
+//
+//	{name} - source object name (e.g., "a/b/c.tar")
+//	{dir}  - virtual directory including trailing '/' ("a/b/"); empty if none
+//	{file} - base name ("c.tar")
+//	{stem} - base name without extension ("c")
+//	{ext}  - extension including '.' (".tar"); empty if none
+//	{rel}  - source name relative to Prefix
+//
+// e.g. "{dir}archived/{stem}-v2{ext}"; Prepend, if specified, is applied on top
 type (
 	CopyBckMsg struct {
-		Prepend   string `json:"prepend"`     // destination naming, as in: dest-obj-name = Prepend + source-obj-name
-		Prefix    string `json:"prefix"`      // prefix to select matching _source_ objects or virtual directories
-		DryRun    bool   `json:"dry_run"`     // visit all source objects, don't make any modifications
-		Force     bool   `json:"force"`       // force running in presence of "limited coexistence" type conflicts
-		LatestVer bool   `json:"latest-ver"`  // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
-		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
+		Prepend   string `json:"prepend"`            // destination naming, as in: dest-obj-name = Prepend + source-obj-name
+		Prefix    string `json:"prefix"`             // prefix to select matching _source_ objects or virtual directories
+		Template  string `json:"template,omitempty"` // range template to select matching _source_ objects (see above)
+		Rename    string `json:"rename,omitempty"`   // destination naming template (see above)
+		DryRun    bool   `json:"dry_run"`            // visit all source objects, don't make any modifications
+		Force     bool   `json:"force"`              // force running in presence of "limited coexistence" type conflicts
+		LatestVer bool   `json:"latest-ver"`         // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
+		Sync      bool   `json:"synchronize"`        // see also: 'versioning.synchronize'
 	}
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
@@ -42,14 +61,14 @@ type (
 // TCBMsg //
 ////////////
 
-func (msg *TCBMsg) Validate(isEtl bool) (err error) {
+func (msg *TCBMsg) Validate(isEtl bool) error {
 	if isEtl && msg.Transform.Name == "" {
-		err = errors.New("ETL name can't be empty")
+		return errors.New("ETL name can't be empty")
 	}
-	return
+	return msg.CopyBckMsg.Validate()
 }
 
-// Replace extension and add suffix if provided.
+// Replace extension, rename, and prepend - in that order and if provided.
 func (msg *TCBMsg) ToName(name string) string {
 	if msg.Ext != nil {
 		if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
@@ -59,8 +78,52 @@ func (msg *TCBMsg) ToName(name string) string {
 			}
 		}
 	}
+	if msg.Rename != "" {
+		name = msg.rename(name)
+	}
 	if msg.Prepend != "" {
 		name = msg.Prepend + name
 	}
 	return name
 }
+
+////////////////
+// CopyBckMsg //
+////////////////
+
+var renameNameVars = []string{"{name}", "{file}", "{stem}", "{rel}"}
+
+func (msg *CopyBckMsg) Validate() error {
+	if msg.Template != "" {
+		if _, err := cos.NewParsedTemplate(msg.Template); err != nil {
+			return fmt.Errorf("invalid source template %q: %w", msg.Template, err)
+		}
+	}
+	if msg.Rename != "" {
+		for _, v := range renameNameVars {
+			if strings.Contains(msg.Rename, v) {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid rename template %q: must contain at least one of %v (otherwise, all objects would be copied under the same name)",
+			msg.Rename, renameNameVars)
+	}
+	return nil
+}
+
+func (msg *CopyBckMsg) rename(name string) string {
+	var (
+		dir, file = path.Split(name)
+		ext       = path.Ext(file)
+		stem      = strings.TrimSuffix(file, ext)
+	)
+	r := strings.NewReplacer(
+		"{name}", name,
+		"{dir}", dir,
+		"{file}", file,
+		"{stem}", stem,
+		"{ext}", ext,
+		"{rel}", strings.TrimPrefix(name, msg.Prefix),
+	)
+	return r.Replace(msg.Rename)
+}
@@ -0,0 +1,48 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestTCBMsgToName(t *testing.T) {
+	tests := []struct {
+		msg      TCBMsg
+		name     string
+		expected string
+	}{
+		{TCBMsg{}, "a/b/c.tar", "a/b/c.tar"},
+		{TCBMsg{CopyBckMsg: CopyBckMsg{Prepend: "x/"}}, "a/b/c.tar", "x/a/b/c.tar"},
+		{TCBMsg{CopyBckMsg: CopyBckMsg{Rename: "{dir}archived/{stem}-v2{ext}"}}, "a/b/c.tar", "a/b/archived/c-v2.tar"},
+		{TCBMsg{CopyBckMsg: CopyBckMsg{Rename: "{dir}{stem}{ext}.bak"}}, "README", "README.bak"},
+		{TCBMsg{CopyBckMsg: CopyBckMsg{Rename: "flat/{file}"}}, "a/b/c.tar", "flat/c.tar"},
+		{TCBMsg{CopyBckMsg: CopyBckMsg{Prefix: "a/b/", Rename: "new/{rel}"}}, "a/b/c/d.tar", "new/c/d.tar"},
+		{TCBMsg{CopyBckMsg: CopyBckMsg{Prepend: "p-", Rename: "{stem}{ext}"}}, "a/c.tar", "p-c.tar"},
+		{TCBMsg{Ext: cos.StrKVs{"tar": "tgz"}, CopyBckMsg: CopyBckMsg{Rename: "{dir}{stem}-1{ext}"}}, "a/c.tar", "a/c-1.tgz"},
+	}
+	for _, test := range tests {
+		if err := test.msg.Validate(false); err != nil {
+			t.Fatalf("%+v: unexpected error: %v", test.msg, err)
+		}
+		if name := test.msg.ToName(test.name); name != test.expected {
+			t.Errorf("%+v: ToName(%q) = %q, expected %q", test.msg, test.name, name, test.expected)
+		}
+	}
+}
+
+func TestCopyBckMsgValidate(t *testing.T) {
+	for _, msg := range []CopyBckMsg{
+		{Rename: "constant"},
+		{Rename: "{dir}{ext}"},
+		{Template: "shard-{10..1}.tar"},
+	} {
+		if err := msg.Validate(); err == nil {
+			t.Errorf("%+v: expected error", msg)
+		}
+	}
+}
@@ -32,7 +32,11 @@ const (
 	Users     = "users"    // AuthN
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
+	Audit     = "audit"    // AuthN
+	Enroll    = "enroll"   // AuthN
 	IC        = "ic"       // information center
+	WebUI     = "webui"    // built-in web dashboard (served by proxies)
+	OpenAPI   = "openapi"  // OpenAPI 3 document (see api/openapi)
 
 	// l3 ---
 
@@ -44,6 +48,7 @@ const (
 	Keepalive = "keepalive"
 	AdminJoin = "join-by-admin" // when node is joined by admin ("manual join")
 	SelfJoin  = "autoreg"       // auto-join cluster at startup
+	RotateKey = "rotate-key"    // AuthN: rotate token-signing secret
 
 	// target
 	Mountpaths = "mountpaths"
@@ -56,6 +61,7 @@ const (
 	Sort     = "sort"
 	Finished = "finished"
 	Progress = "progress"
+	Watch    = "watch" // WebSocket: xaction stats
 
 	// dsort, dloader, query
 	Metrics     = "metrics"
@@ -79,6 +85,7 @@ const (
 	ETLStart   = Start
 	ETLHealth  = "health"
 	ETLMetrics = "metrics"
+	ETLStats   = "stats"
 )
 
 // RESTful l3, internal use
@@ -104,8 +111,10 @@ var (
 	URLPathNotifs    = urlpath(Version, Notifs)
 	URLPathTxn       = urlpath(Version, Txn)
 	URLPathXactions  = urlpath(Version, Xactions)
+	URLPathXactWatch = urlpath(Version, Xactions, Watch)
 	URLPathIC        = urlpath(Version, IC)
 	URLPathHealth    = urlpath(Version, Health)
+	URLPathOpenAPI   = urlpath(Version, OpenAPI)
 	URLPathMetasync  = urlpath(Version, Metasync)
 	URLPathRebalance = urlpath(Version, Rebalance)
 
@@ -155,6 +164,8 @@ var (
 	URLPathUsers    = urlpath(Version, Users)
 	URLPathClusters = urlpath(Version, Clusters)
 	URLPathRoles    = urlpath(Version, Roles)
+	URLPathAudit    = urlpath(Version, Audit)
+	URLPathEnroll   = urlpath(Version, Enroll)
 )
 
 func (u URLPath) Join(words ...string) string {
@@ -7,7 +7,9 @@ package authn
 import (
 	"errors"
 	"net/http"
+	"net/url"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -298,3 +300,74 @@ func SetConfig(bp api.BaseParams, conf *ConfigToUpdate) error {
 	}
 	return reqParams.DoRequest()
 }
+
+// GetAuditLog returns AuthN audit events (oldest first); non-zero `since` limits
+// the result to the events that occurred within the specified duration, and non-zero
+// `limit` - to the most recent `limit` events
+func GetAuditLog(bp api.BaseParams, since time.Duration, limit int) ([]*AuditEvent, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAudit.S
+		q := url.Values{}
+		if since > 0 {
+			q.Set(apc.QparamSince, since.String())
+		}
+		if limit > 0 {
+			q.Set(apc.QparamLimit, strconv.Itoa(limit))
+		}
+		reqParams.Query = q
+	}
+	events := make([]*AuditEvent, 0)
+	_, err := reqParams.DoReqAny(&events)
+	return events, err
+}
+
+// Issue one-time cluster enrollment token (see also: api.EnrollAuthN)
+func IssueEnrollToken(bp api.BaseParams, expire *time.Duration) (token *EnrollToken, err error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathEnroll.S
+		reqParams.Body = cos.MustMarshal(EnrollTokenMsg{ExpiresIn: expire})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// (called by the primary proxy of the cluster that is being enrolled)
+func Enroll(bp api.BaseParams, msg *EnrollMsg) (sealed *SealedSecret, err error) {
+	bp.Method = http.MethodPut
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathEnroll.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&sealed); err != nil {
+		return nil, err
+	}
+	return sealed, nil
+}
+
+// Generate new token-signing secret and push it to all registered clusters.
+// Tokens signed with the previous secret remain valid until they expire.
+func RotateSecret(bp api.BaseParams) error {
+	bp.Method = http.MethodPut
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDae.Join(apc.RotateKey)
+	}
+	return reqParams.DoRequest()
+}
@@ -39,6 +39,7 @@ type (
 	}
 	ServerConf struct {
 		Secret       string       `json:"secret"`
+		PrevSecret   string       `json:"prev_secret,omitempty"` // prior to the last rotation
 		ExpirePeriod cos.Duration `json:"expiration_time"`
 	}
 	TimeoutConf struct {
@@ -74,6 +75,14 @@ func (c *Config) Secret() (secret string) {
 	return
 }
 
+// current and previous (ie., prior to the last rotation) secrets
+func (c *Config) Secrets() (secret, prev string) {
+	c.RLock()
+	secret, prev = c.Server.Secret, c.Server.PrevSecret
+	c.RUnlock()
+	return
+}
+
 func (c *Config) Verbose() bool {
 	level, err := strconv.Atoi(c.Log.Level)
 	debug.AssertNoErr(err)
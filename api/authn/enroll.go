@@ -0,0 +1,134 @@
+// Package authn provides AuthN API over HTTP(S)
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package authn
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"time"
+)
+
+// Cluster enrollment (trust bootstrap):
+//  1. AuthN admin issues one-time enrollment token (see IssueEnrollToken)
+//  2. AIS admin passes the token to the (primary of the) cluster (see api.EnrollAuthN)
+//  3. the cluster generates ephemeral X25519 key pair and sends EnrollMsg to AuthN
+//  4. AuthN consumes the token, registers the cluster, and replies with its
+//     token-signing secret sealed with the ECDH-derived shared key (SealedSecret)
+//
+// Signing key rotation:
+// AuthN generates new secret, keeps the previous one to validate not-yet-expired
+// tokens, and pushes the new secret to all registered clusters - this time sealed with
+// the key derived from the previous secret (see SealSecretSym).
+
+type (
+	EnrollToken struct {
+		Token   string    `json:"token"`
+		Expires time.Time `json:"expires"`
+	}
+	// (AIS cluster => AuthN)
+	EnrollMsg struct {
+		Token     string   `json:"token"` // one-time enrollment token
+		ClusterID string   `json:"cluster_id"`
+		Alias     string   `json:"alias,omitempty"`
+		URLs      []string `json:"urls"`
+		PubKey    []byte   `json:"pub_key"` // cluster's ephemeral X25519 public key
+	}
+	// (AuthN admin => AuthN) request to issue enrollment token
+	EnrollTokenMsg struct {
+		ExpiresIn *time.Duration `json:"expires_in,omitempty"`
+	}
+	SealedSecret struct {
+		PubKey []byte `json:"pub_key,omitempty"` // sender's ephemeral X25519 public key (empty when symmetric)
+		Nonce  []byte `json:"nonce"`
+		Data   []byte `json:"data"` // AES-GCM sealed secret
+	}
+)
+
+var errEmptySecret = errors.New("sealed secret is empty")
+
+// SealSecret seals `secret` for the owner of the X25519 `peerPubKey`
+func SealSecret(peerPubKey []byte, secret string) (*SealedSecret, error) {
+	curve := ecdh.X25519()
+	peer, err := curve.NewPublicKey(peerPubKey)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := priv.ECDH(peer)
+	if err != nil {
+		return nil, err
+	}
+	ss, err := seal(shared, secret)
+	if err != nil {
+		return nil, err
+	}
+	ss.PubKey = priv.PublicKey().Bytes()
+	return ss, nil
+}
+
+// Open un-seals the secret using own ephemeral private key
+func (ss *SealedSecret) Open(priv *ecdh.PrivateKey) (string, error) {
+	peer, err := ecdh.X25519().NewPublicKey(ss.PubKey)
+	if err != nil {
+		return "", err
+	}
+	shared, err := priv.ECDH(peer)
+	if err != nil {
+		return "", err
+	}
+	return ss.open(shared)
+}
+
+// SealSecretSym seals `secret` with the key derived from the secret shared by both sides
+func SealSecretSym(shared, secret string) (*SealedSecret, error) { return seal([]byte(shared), secret) }
+
+func (ss *SealedSecret) OpenSym(shared string) (string, error) { return ss.open([]byte(shared)) }
+
+func seal(shared []byte, secret string) (*SealedSecret, error) {
+	gcm, err := newGCM(shared)
+	if err != nil {
+		return nil, err
+	}
+	ss := &SealedSecret{Nonce: make([]byte, gcm.NonceSize())}
+	if _, err := rand.Read(ss.Nonce); err != nil {
+		return nil, err
+	}
+	ss.Data = gcm.Seal(nil, ss.Nonce, []byte(secret), nil)
+	return ss, nil
+}
+
+func (ss *SealedSecret) open(shared []byte) (string, error) {
+	if len(ss.Data) == 0 {
+		return "", errEmptySecret
+	}
+	gcm, err := newGCM(shared)
+	if err != nil {
+		return "", err
+	}
+	if len(ss.Nonce) != gcm.NonceSize() {
+		return "", errors.New("invalid nonce size")
+	}
+	b, err := gcm.Open(nil, ss.Nonce, ss.Data, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func newGCM(shared []byte) (cipher.AEAD, error) {
+	key := sha256.Sum256(shared)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -16,6 +16,21 @@ const (
 	AdminRole = "Admin"
 )
 
+// AuditEvent.Kind enum
+const (
+	AuditLogin       = "login"        // login attempt (success means token issued)
+	AuditRevokeToken = "revoke-token" // token revoked (logout)
+	AuditPermDenied  = "perm-denied"  // admin-only request denied
+	AuditEnroll      = "enroll"       // cluster enrollment (see enroll.go)
+	AuditRotateKey   = "rotate-key"   // token-signing secret rotated
+	AuditAddUser     = "add-user"     // user and role changes (AuditEvent.Target is the affected user or role)
+	AuditUpdateUser  = "update-user"
+	AuditDelUser     = "del-user"
+	AuditAddRole     = "add-role"
+	AuditUpdateRole  = "update-role"
+	AuditDelRole     = "del-role"
+)
+
 type (
 	User struct {
 		ID          string    `json:"id"`
@@ -53,6 +68,17 @@ type (
 		BucketACLs  []*BckACL `json:"buckets"`
 		IsAdmin     bool      `json:"admin"`
 	}
+	// AuthN audit log record
+	AuditEvent struct {
+		Time      time.Time `json:"time"`
+		Kind      string    `json:"kind"` // enum { AuditLogin, ... } above
+		UserID    string    `json:"user,omitempty"`
+		Target    string    `json:"target,omitempty"` // user or role that was added, updated, or deleted
+		ClusterID string    `json:"cluster,omitempty"`
+		Addr      string    `json:"addr,omitempty"` // client's remote address
+		Err       string    `json:"err,omitempty"`
+		Success   bool      `json:"success"`
+	}
 )
 
 //////////
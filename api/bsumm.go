@@ -5,7 +5,11 @@
 package api
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"sort"
@@ -26,6 +30,9 @@ const fmtErrStatus = "expecting status (ok, partial, accepted), or error - got %
 type (
 	BsummCB func(*cmn.AllBsummResults, bool)
 
+	// (streaming) callback: returning an error terminates the stream
+	BsummStreamCB func(*cmn.BsummStreamResult) error
+
 	BsummArgs struct {
 		Callback  BsummCB
 		CallAfter time.Duration
@@ -289,6 +296,129 @@ func _bsummDontWait(reqParams *ReqParams, msg *apc.BsummCtrlMsg, res *cmn.AllBsu
 	return
 }
 
+// GetBucketSummaryStream is the streaming variant of GetBucketSummary that does not poll:
+// the proxy keeps the connection open and delivers per-target (partial and final) results
+// as they arrive, one `cmn.BsummStreamResult` at a time, which is when `cb` gets called.
+// Each result also includes the running total across all targets that have reported so far.
+// Returns the final aggregated (sorted) results when all targets are done.
+// The proxy terminates the stream (with an error) once its 'client.client_long_timeout' expires;
+// the job itself continues, and its results can be queried by the returned job ID.
+// NOTE: for huge buckets, use http client without (or with a sufficiently large) request timeout.
+func GetBucketSummaryStream(bp BaseParams, qbck cmn.QueryBcks, msg *apc.BsummCtrlMsg, cb BsummStreamCB) (xid string,
+	res cmn.AllBsummResults, err error) {
+	if msg == nil {
+		msg = &apc.BsummCtrlMsg{ObjCached: true, BckPresent: true}
+	}
+	bp.Method = http.MethodGet
+	q := qbck.NewQuery()
+	q.Set(apc.QparamBsummStream, "true")
+
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(qbck.Name)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSummaryBck, Value: msg})
+	}
+	resp, err := reqParams.do()
+	if err == nil {
+		if err = reqParams.checkResp(resp); err == nil {
+			xid = resp.Header.Get(apc.HdrXactionID)
+			res, err = _bsummStream(resp.Body, cb)
+		}
+		resp.Body.Close() // (not draining - the stream may have been terminated by the callback)
+	}
+	FreeRp(reqParams)
+	return xid, res, err
+}
+
+// one record per line (NOTE: jsoniter.Decoder fails to return io.EOF when the last record
+// is followed by '\n'; hence, reading line by line)
+func _bsummStream(body io.Reader, cb BsummStreamCB) (res cmn.AllBsummResults, err error) {
+	br := bufio.NewReader(body)
+	for err == nil {
+		line, errR := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			err = _bsummRecord(line, &res, cb)
+		}
+		if errR != nil {
+			if err == nil && errR != io.EOF {
+				err = errR
+			}
+			break
+		}
+	}
+	return res, err
+}
+
+func _bsummRecord(line []byte, res *cmn.AllBsummResults, cb BsummStreamCB) error {
+	var tres cmn.BsummStreamResult
+	if err := jsoniter.Unmarshal(line, &tres); err != nil {
+		return err
+	}
+	if tres.Err != "" {
+		return errors.New(tres.Err)
+	}
+	sort.Sort(tres.Total)
+	*res = tres.Total
+	if cb != nil {
+		return cb(&tres)
+	}
+	return nil
+}
+
+// GetBucketHeatmap returns per-object access statistics for a given bucket:
+// top-N hottest objects and access-count histogram aggregated across all targets.
+// Requires feature flag `feat.TrackObjectAccess` (otherwise, the result is empty).
+func GetBucketHeatmap(bp BaseParams, bck cmn.Bck, msg *apc.HeatmapMsg) (*apc.Heatmap, error) {
+	if msg == nil {
+		msg = &apc.HeatmapMsg{}
+	}
+	bp.Method = http.MethodGet
+	heatmap := &apc.Heatmap{}
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActHeatmapBck, Value: msg})
+	}
+	_, err := reqParams.DoReqAny(heatmap)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return heatmap, nil
+}
+
+// SampleObjects returns a uniform random sample of the names of (in-cluster) objects
+// in a given bucket - without listing the bucket: each target makes a single pass
+// through the objects it stores (reservoir sampling), and the proxy merges the results.
+// See also: apc.SampleMsg
+func SampleObjects(bp BaseParams, bck cmn.Bck, msg *apc.SampleMsg) (*apc.Sample, error) {
+	if msg == nil {
+		msg = &apc.SampleMsg{}
+	}
+	bp.Method = http.MethodGet
+	sample := &apc.Sample{}
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSampleBck, Value: msg})
+	}
+	_, err := reqParams.DoReqAny(sample)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return sample, nil
+}
+
 func _invalidStatus(status int) error {
 	return &cmn.ErrHTTP{
 		Message: fmt.Sprintf(fmtErrStatus, status),
@@ -0,0 +1,114 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func testBsumm(name string, size uint64) *cmn.BsummResult {
+	summ := &cmn.BsummResult{Bck: cmn.Bck{Name: name, Provider: apc.AIS}}
+	summ.TotalSize.OnDisk = size
+	return summ
+}
+
+// newline-delimited stream, as in ais/prxbsumm.go
+func testBsummBody(records ...*cmn.BsummStreamResult) *bytes.Buffer {
+	body := &bytes.Buffer{}
+	for _, tres := range records {
+		body.Write(cos.MustMarshal(tres))
+		body.WriteByte('\n')
+	}
+	return body
+}
+
+func TestBsummStream(t *testing.T) {
+	body := testBsummBody(
+		&cmn.BsummStreamResult{TID: "t1", Summaries: cmn.AllBsummResults{testBsumm("b", 1)},
+			Total: cmn.AllBsummResults{testBsumm("b", 1)}},
+		&cmn.BsummStreamResult{TID: "t2", Summaries: cmn.AllBsummResults{testBsumm("a", 2)},
+			Total: cmn.AllBsummResults{testBsumm("b", 1), testBsumm("a", 2)}},
+		&cmn.BsummStreamResult{TID: "t1", Done: true, Summaries: cmn.AllBsummResults{testBsumm("b", 3)},
+			Total: cmn.AllBsummResults{testBsumm("b", 3), testBsumm("a", 2)}},
+		&cmn.BsummStreamResult{TID: "t2", Done: true, Summaries: cmn.AllBsummResults{testBsumm("a", 4)},
+			Total: cmn.AllBsummResults{testBsumm("b", 3), testBsumm("a", 4)}},
+	)
+	var (
+		tids []string
+		done int
+	)
+	res, err := _bsummStream(body, func(tres *cmn.BsummStreamResult) error {
+		tids = append(tids, tres.TID)
+		if tres.Done {
+			done++
+		}
+		return nil
+	})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, strings.Join(tids, ",") == "t1,t2,t1,t2", "unexpected callback sequence %v", tids)
+	tassert.Errorf(t, done == 2, "expected 2 done, got %d", done)
+
+	// final total, sorted
+	tassert.Fatalf(t, len(res) == 2, "expected 2 buckets, got %d", len(res))
+	tassert.Errorf(t, res[0].Bck.Name == "a" && res[1].Bck.Name == "b", "expected sorted results, got %s, %s",
+		res[0].Bck.Name, res[1].Bck.Name)
+	tassert.Errorf(t, res[0].TotalSize.OnDisk == 4 && res[1].TotalSize.OnDisk == 3, "expected final totals, got %d, %d",
+		res[0].TotalSize.OnDisk, res[1].TotalSize.OnDisk)
+}
+
+// error that terminates the stream after the (200) status has been sent
+func TestBsummStreamErr(t *testing.T) {
+	const errMsg = "summarize-bucket[xyz]: timed out"
+	body := testBsummBody(
+		&cmn.BsummStreamResult{TID: "t1", Summaries: cmn.AllBsummResults{testBsumm("a", 1)},
+			Total: cmn.AllBsummResults{testBsumm("a", 1)}},
+		&cmn.BsummStreamResult{Err: errMsg},
+		&cmn.BsummStreamResult{TID: "t2", Total: cmn.AllBsummResults{testBsumm("a", 2)}}, // (not expected)
+	)
+	var calls int
+	res, err := _bsummStream(body, func(*cmn.BsummStreamResult) error { calls++; return nil })
+	tassert.Fatalf(t, err != nil && err.Error() == errMsg, "expected in-band error %q, got %v", errMsg, err)
+	tassert.Errorf(t, calls == 1, "expected a single callback prior to the error, got %d", calls)
+	tassert.Errorf(t, len(res) == 1 && res[0].TotalSize.OnDisk == 1, "expected partial results prior to the error, got %v", res)
+}
+
+func TestBsummStreamCallback(t *testing.T) {
+	var (
+		errStop = errors.New("stop")
+		body    = testBsummBody(
+			&cmn.BsummStreamResult{TID: "t1", Total: cmn.AllBsummResults{testBsumm("a", 1)}},
+			&cmn.BsummStreamResult{TID: "t2", Total: cmn.AllBsummResults{testBsumm("a", 2)}},
+		)
+		calls int
+	)
+	_, err := _bsummStream(body, func(*cmn.BsummStreamResult) error { calls++; return errStop })
+	tassert.Errorf(t, err == errStop && calls == 1, "expected the callback to terminate the stream, got %v after %d calls", err, calls)
+
+	// empty and truncated streams
+	res, err := _bsummStream(&bytes.Buffer{}, nil)
+	tassert.Errorf(t, err == nil && len(res) == 0, "empty stream: %v, %v", res, err)
+	_, err = _bsummStream(strings.NewReader(`{"tid":"t1","total":[`), nil)
+	tassert.Errorf(t, err != nil, "expected error decoding truncated stream")
+
+	// last record without '\n'
+	res, err = _bsummStream(strings.NewReader(`{"tid":"t1","total":[{"name":"a","provider":"ais"}],"done":true}`), nil)
+	tassert.Errorf(t, err == nil && len(res) == 1, "expected a single result, got %v, %v", res, err)
+
+	// connection dropped midway
+	errReset := errors.New("connection reset by peer")
+	r := io.MultiReader(testBsummBody(&cmn.BsummStreamResult{TID: "t1"}), iotest.ErrReader(errReset))
+	_, err = _bsummStream(r, nil)
+	tassert.Errorf(t, err == errReset, "expected %v, got %v", errReset, err)
+}
@@ -23,6 +23,14 @@ func SetBucketProps(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (string,
 	return patchBprops(bp, bck, b)
 }
 
+// UpdateBucketAccess allows and/or denies the specified permissions - relative to the bucket's
+// current access (the resulting access mask gets computed by the cluster, atomically).
+// See also: SetBucketProps with cmn.BpropsToSet.Access (to set access mask as a whole)
+func UpdateBucketAccess(bp BaseParams, bck cmn.Bck, allow, deny apc.AccessAttrs) (string, error) {
+	b := cos.MustMarshal(apc.ActMsg{Action: apc.ActUpdateAccess, Value: &apc.AccessUpdate{Allow: allow, Deny: deny}})
+	return patchBprops(bp, bck, b)
+}
+
 // ResetBucketProps resets the properties of a bucket to the global configuration.
 func ResetBucketProps(bp BaseParams, bck cmn.Bck) (string, error) {
 	b := cos.MustMarshal(apc.ActMsg{Action: apc.ActResetBprops})
@@ -128,6 +136,22 @@ func CreateBucket(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadRe
 	if len(dontHeadRemote) > 0 && dontHeadRemote[0] {
 		q.Set(apc.QparamDontHeadRemote, "true")
 	}
+	return createBucket(bp, bck, props, q)
+}
+
+// CreateBucketWithProfile creates bucket with the properties from the named bucket profile
+// (see `bucket_profiles` in the cluster config). Optional `props`, if specified, override
+// the profile.
+func CreateBucketWithProfile(bp BaseParams, bck cmn.Bck, profile string, props *cmn.BpropsToSet) error {
+	if err := bck.Validate(); err != nil {
+		return err
+	}
+	q := make(url.Values, 4)
+	q.Set(apc.QparamBprofile, profile)
+	return createBucket(bp, bck, props, q)
+}
+
+func createBucket(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet, q url.Values) error {
 	bp.Method = http.MethodPost
 	reqParams := AllocRp()
 	{
@@ -176,6 +200,9 @@ func DestroyBucket(bp BaseParams, bck cmn.Bck) error {
 // * apc.FltExistsOutside - copy only those remote objects that are not (present) in AIS
 //
 // msg.Prefix, if specified, applies always and regardless.
+// msg.Template (range template, e.g. "shard-{0000..0999}.tar"), if specified, further selects source objects.
+// msg.Rename (e.g. "{dir}{stem}-v2{ext}") and/or msg.Prepend, if specified, determine destination names -
+// see apc.CopyBckMsg for details.
 //
 // Returns xaction ID if successful, an error otherwise. See also closely related api.ETLBucket
 func CopyBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltPresence ...int) (xid string, err error) {
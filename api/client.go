@@ -91,6 +91,9 @@ func SetAuxHeaders(r *http.Request, bp *BaseParams) {
 	if bp.UA != "" {
 		r.Header.Set(cos.HdrUserAgent, bp.UA)
 	}
+	if r.Header.Get(cos.HdrReqID) == "" {
+		r.Header.Set(cos.HdrReqID, cos.CryptoRandS(cos.LenShortID))
+	}
 }
 
 func GetWhatRawQuery(getWhat, getProps string) string {
@@ -329,6 +332,14 @@ func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 	if resp.StatusCode < http.StatusBadRequest {
 		return nil
 	}
+	herr := reqParams.readErr(resp)
+	if herr.ReqID == "" && resp.Request != nil {
+		herr.ReqID = resp.Request.Header.Get(cos.HdrReqID) // (e.g., older server)
+	}
+	return herr
+}
+
+func (reqParams *ReqParams) readErr(resp *http.Response) *cmn.ErrHTTP {
 	if reqParams.BaseParams.Method == http.MethodHead {
 		// HEAD request does not return body
 		if msg := resp.Header.Get(apc.HdrError); msg != "" {
@@ -247,10 +247,15 @@ func SetPrimaryProxy(bp BaseParams, newPrimaryID string, force bool) error {
 // sets the cluster-wide configuration accordingly. Setting cluster-wide
 // configuration requires sending the request to a proxy.
 func SetClusterConfig(bp BaseParams, nvs cos.StrKVs, transient bool) error {
-	q := make(url.Values, len(nvs))
-	for key, val := range nvs {
-		q.Set(key, val)
-	}
+	return SetClusterConfigScoped(bp, nvs, transient, "")
+}
+
+// SetClusterConfigScoped is SetClusterConfig limited to a subset of nodes, whereby
+// `applyTo` is one of: apc.ApplyToProxies, apc.ApplyToTargets, or node ID (empty: all nodes).
+// Scoped updates are stored as node-local overrides (compare with SetDaemonConfig).
+// Either way, the update is validated on all affected nodes prior to being applied.
+func SetClusterConfigScoped(bp BaseParams, nvs cos.StrKVs, transient bool, applyTo string) error {
+	q := cluConfQuery(nvs, applyTo)
 	if transient {
 		q.Set(apc.ActTransient, "true")
 	}
@@ -266,6 +271,39 @@ func SetClusterConfig(bp BaseParams, nvs cos.StrKVs, transient bool) error {
 	return err
 }
 
+// StageClusterConfig validates the update (same arguments as in SetClusterConfigScoped)
+// and returns the resulting per-node changes - without applying anything.
+// Nodes that would not change are omitted.
+func StageClusterConfig(bp BaseParams, nvs cos.StrKVs, applyTo string) (cmn.StagedConfig, error) {
+	q := cluConfQuery(nvs, applyTo)
+	q.Set(apc.QparamStage, "true")
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathCluSetConf.S
+		reqParams.Query = q
+	}
+	staged := make(cmn.StagedConfig, 8)
+	_, err := reqParams.DoReqAny(&staged)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return staged, nil
+}
+
+func cluConfQuery(nvs cos.StrKVs, applyTo string) url.Values {
+	q := make(url.Values, len(nvs)+2)
+	for key, val := range nvs {
+		q.Set(key, val)
+	}
+	if applyTo != "" {
+		q.Set(apc.QparamApplyTo, applyTo)
+	}
+	return q
+}
+
 // SetClusterConfigUsingMsg sets the cluster-wide configuration
 // using the `cmn.ConfigToSet` parameter provided.
 func SetClusterConfigUsingMsg(bp BaseParams, configToUpdate *cmn.ConfigToSet, transient bool) error {
@@ -304,6 +342,11 @@ func RotateClusterLogs(bp BaseParams) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActRotateLogs})
 }
 
+// Register the cluster with AuthN using one-time enrollment token (see api/authn/enroll.go)
+func EnrollAuthN(bp BaseParams, args *apc.ActValEnrollAuthN) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActEnrollAuthN, Value: args})
+}
+
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
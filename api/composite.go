@@ -0,0 +1,58 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// PutComposite creates (or overwrites) a composite object that reads as its member
+// objects (in the same bucket) concatenated, in the specified order - see apc.CompositeManifest.
+// The members must exist (and must not be composite themselves).
+func PutComposite(bp BaseParams, bck cmn.Bck, objName string, members []string) error {
+	manifest := apc.CompositeManifest{Members: make([]apc.CompositeMember, len(members))}
+	for i, name := range members {
+		manifest.Members[i].Name = name
+	}
+	q := bck.NewQuery()
+	q.Set(apc.QparamComposite, "true")
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(&manifest)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// GetCompositeManifest returns the (stored) manifest of a composite object,
+// including resolved member sizes
+func GetCompositeManifest(bp BaseParams, bck cmn.Bck, objName string) (*apc.CompositeManifest, error) {
+	q := bck.NewQuery()
+	q.Set(apc.QparamComposite, "false")
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = q
+	}
+	manifest := &apc.CompositeManifest{}
+	_, err := reqParams.DoReqAny(manifest)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
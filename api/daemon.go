@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -25,6 +26,12 @@ type GetLogInput struct {
 	All      bool
 }
 
+type GetProfileInput struct {
+	Writer   io.Writer
+	Kind     string        // one of: apc.SupportedProfiles
+	Duration time.Duration // sampling duration: cpu, mutex, and block profiles (zero means default: 30s)
+}
+
 // GetMountpaths given the direct public URL of the target, returns the target's mountpaths or error.
 func GetMountpaths(bp BaseParams, node *meta.Snode) (mpl *apc.MountpathList, err error) {
 	bp.Method = http.MethodGet
@@ -149,6 +156,38 @@ func GetDaemonConfig(bp BaseParams, node *meta.Snode) (config *cmn.Config, err e
 	return config, nil
 }
 
+// GetDaemonConfigOverride returns (sorted) names of the inherited (cluster) config values
+// that the node overrides (compare with `api.SetDaemonConfig`)
+func GetDaemonConfigOverride(bp BaseParams, node *meta.Snode) (names []string, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatNodeOverride}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err = reqParams.DoReqAny(&names)
+	FreeRp(reqParams)
+	return names, err
+}
+
+// GetDaemonConfigComputed returns the values that the node computes (auto-tunes) at startup,
+// e.g.: number of CPUs and GOMAXPROCS given cgroup CPU quota, memory limits, and more
+func GetDaemonConfigComputed(bp BaseParams, node *meta.Snode) (kvs cos.StrKVs, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatNodeComputed}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err = reqParams.DoReqAny(&kvs)
+	FreeRp(reqParams)
+	return kvs, err
+}
+
 // names _and_ kinds, i.e. (name, kind) pairs
 func GetMetricNames(bp BaseParams, node *meta.Snode) (kvs cos.StrKVs, err error) {
 	bp.Method = http.MethodGet
@@ -207,6 +246,62 @@ func GetDiskStats(bp BaseParams, tid string) (res ios.AllDiskStats, err error) {
 	return
 }
 
+// Returns target's persisted per-mountpath capacity snapshots (oldest first)
+// taken within the specified duration (zero `since` - all available)
+func GetCapHistory(bp BaseParams, tid string, since time.Duration) (res []stats.CapSnap, err error) {
+	q := url.Values{apc.QparamWhat: []string{apc.WhatCapHistory}}
+	if since > 0 {
+		q.Set(apc.QparamSince, since.String())
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = q
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{tid}}
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return
+}
+
+// Returns target's per-bucket usage (GET/PUT bytes and request counts) aggregated per
+// calendar month - see stats.BckUsagePeriodFmt; empty `period` - all persisted periods.
+// To compute cluster-wide totals, query all targets and use BckUsagePeriods.Merge
+func GetBucketUsage(bp BaseParams, tid, period string) (res stats.BckUsagePeriods, err error) {
+	q := url.Values{apc.QparamWhat: []string{apc.WhatBckUsage}}
+	if period != "" {
+		q.Set(apc.QparamPeriod, period)
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = q
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{tid}}
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return
+}
+
+// Returns the state of the node's SLO monitors (see cmn.SLOConf), sorted by name
+func GetSLO(bp BaseParams, node *meta.Snode) (res []stats.SLOStatus, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatSLO}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return
+}
+
 // Returns both node's stats and extended status
 func GetStatsAndStatus(bp BaseParams, node *meta.Snode) (daeStatus *stats.NodeStatus, err error) {
 	bp.Method = http.MethodGet
@@ -222,6 +317,28 @@ func GetStatsAndStatus(bp BaseParams, node *meta.Snode) (daeStatus *stats.NodeSt
 	return daeStatus, err
 }
 
+// Same as above but directly from the BaseParams-referenced node - the node that is not necessarily
+// a cluster member (e.g., started and waiting to join); also returns the node's wall clock time
+// at the moment of responding (via standard "Date" header - one second resolution)
+func GetNodeStatus(bp BaseParams) (daeStatus *stats.NodeStatus, nodeTime time.Time, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatNodeStatsAndStatus}}
+	}
+	resp, err := reqParams.do()
+	if err == nil {
+		err = reqParams.readAny(resp, &daeStatus)
+		nodeTime, _ = http.ParseTime(resp.Header.Get("Date"))
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+	}
+	FreeRp(reqParams)
+	return daeStatus, nodeTime, err
+}
+
 // Returns log of a specific node in a cluster.
 func GetDaemonLog(bp BaseParams, node *meta.Snode, args GetLogInput) (int64, error) {
 	w := args.Writer
@@ -252,6 +369,31 @@ func GetDaemonLog(bp BaseParams, node *meta.Snode, args GetLogInput) (int64, err
 	return 0, err
 }
 
+// GetDaemonProfile collects (or, in case of cpu, mutex, and block profiles, samples) and
+// writes a given node's runtime profile in pprof format; requires admin access.
+func GetDaemonProfile(bp BaseParams, node *meta.Snode, args GetProfileInput) (int64, error) {
+	q := make(url.Values, 3)
+	q.Set(apc.QparamWhat, apc.WhatProfile)
+	q.Set(apc.QparamProfile, args.Kind)
+	if args.Duration > 0 {
+		q.Set(apc.QparamProfileSecs, strconv.Itoa(int(args.Duration.Round(time.Second)/time.Second)))
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = q
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	wrap, err := reqParams.doWriter(args.Writer)
+	FreeRp(reqParams)
+	if err == nil {
+		return wrap.n, nil
+	}
+	return 0, err
+}
+
 // SetDaemonConfig, given key value pairs, sets the configuration accordingly for a specific node.
 func SetDaemonConfig(bp BaseParams, nodeID string, nvs cos.StrKVs, transient ...bool) error {
 	bp.Method = http.MethodPut
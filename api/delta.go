@@ -0,0 +1,185 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/delta"
+)
+
+// delta sync (rsync-like PUT) - see cmn/delta
+type (
+	PutDeltaArgs struct {
+		// block size to compute the signature of the existing object
+		// (zero means delta.DefaultBlockSize)
+		BlockSize int64
+		PutArgs
+	}
+
+	// request body: delta-encoded content (re-openable to support redirects and retries)
+	deltaBody struct {
+		src   cos.ReadOpenCloser
+		sig   *delta.Sig
+		stats *delta.Stats
+		pr    *io.PipeReader
+		size  int64
+	}
+)
+
+var errDeltaClosed = errors.New("delta body closed")
+
+// GetObjectDeltaSig returns the signature of the existing object: rolling and strong
+// checksums of its `blockSize` blocks (zero `blockSize` means delta.DefaultBlockSize)
+func GetObjectDeltaSig(bp BaseParams, bck cmn.Bck, objName string, blockSize int64) (*delta.Sig, error) {
+	q := bck.NewQuery()
+	q.Set(apc.QparamDeltaSig, strconv.FormatInt(blockSize, 10))
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = q
+	}
+	r, err := reqParams.doReader()
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := delta.ReadSig(r)
+	cos.Close(r)
+	return sig, err
+}
+
+// PutObjectDelta writes a new version of an existing object while transferring only
+// the changes, i.e.:
+// - gets the signature of the current version (see GetObjectDeltaSig);
+// - streams the new content (`args.Reader`) encoded as delta against the current version.
+//
+// Falls back to regular PutObject if the object does not exist or gets modified
+// in the meantime - in which case the returned stats are nil.
+func PutObjectDelta(args *PutDeltaArgs) (oah ObjAttrs, stats *delta.Stats, err error) {
+	sig, err := GetObjectDeltaSig(args.BaseParams, args.Bck, args.ObjName, args.BlockSize)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			oah, err = PutObject(&args.PutArgs)
+		}
+		return oah, nil, err
+	}
+	stats = &delta.Stats{}
+	oah, err = args.putDelta(sig, stats)
+	if err == nil || !cmn.IsStatusPreconditionFailed(err) {
+		return oah, stats, err
+	}
+
+	// the object has changed (or disappeared) since we got its signature
+	r, err := args.Reader.Open()
+	if err != nil {
+		return oah, nil, err
+	}
+	pargs := args.PutArgs
+	pargs.Reader = r
+	oah, err = PutObject(&pargs)
+	return oah, nil, err
+}
+
+func (args *PutDeltaArgs) putDelta(sig *delta.Sig, stats *delta.Stats) (oah ObjAttrs, err error) {
+	var (
+		resp  *http.Response
+		query = args.Bck.NewQuery()
+		cksum = args.Cksum
+		size  = int64(-1)
+	)
+	query.Set(apc.QparamDelta, "true")
+	if args.Size != 0 {
+		size = int64(args.Size)
+	}
+	// end-to-end protection: checksum of the new (reconstructed) content
+	if cksum != nil && cksum.Ty() != cos.ChecksumNone && cksum.Value() == "" {
+		var (
+			r      io.ReadCloser
+			ckhash *cos.CksumHash
+		)
+		if r, err = args.Reader.Open(); err != nil {
+			cos.Close(args.Reader)
+			return
+		}
+		_, ckhash, err = cos.CopyAndChecksum(io.Discard, r, nil, cksum.Ty())
+		cos.Close(r)
+		if err != nil {
+			cos.Close(args.Reader)
+			return
+		}
+		cksum = cos.NewCksum(cksum.Ty(), hex.EncodeToString(ckhash.Sum()))
+	}
+
+	body := newDeltaBody(args.Reader, sig, size, stats)
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathObjects.Join(args.Bck.Name, args.ObjName)
+		reqArgs.Query = query
+		reqArgs.BodyR = body
+	}
+	resp, err = DoWithRetry(args.BaseParams.Client, args.put(body, cksum), reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	if err == nil {
+		oah.wrespHeader = resp.Header
+	}
+	return
+}
+
+func (args *PutDeltaArgs) put(body *deltaBody, cksum *cos.Cksum) NewRequestCB {
+	return func(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+		req, err := reqArgs.Req()
+		if err != nil {
+			return nil, newErrCreateHTTPRequest(err)
+		}
+		req.GetBody = func() (io.ReadCloser, error) { return body.Open() }
+		if cksum != nil && cksum.Ty() != cos.ChecksumNone {
+			req.Header.Set(apc.HdrObjCksumType, cksum.Ty())
+			req.Header.Set(apc.HdrObjCksumVal, cksum.Value())
+		}
+		SetAuxHeaders(req, &args.BaseParams)
+		return req, nil
+	}
+}
+
+///////////////
+// deltaBody //
+///////////////
+
+// encodes in the background; closes the source when done
+func newDeltaBody(src cos.ReadOpenCloser, sig *delta.Sig, size int64, stats *delta.Stats) *deltaBody {
+	pr, pw := io.Pipe()
+	go func() {
+		st, err := delta.Encode(pw, src, sig, size)
+		if err == nil {
+			*stats = st
+		}
+		pw.CloseWithError(err)
+		cos.Close(src)
+	}()
+	return &deltaBody{src: src, sig: sig, size: size, stats: stats, pr: pr}
+}
+
+func (b *deltaBody) Read(p []byte) (int, error) { return b.pr.Read(p) }
+func (b *deltaBody) Close() error               { return b.pr.CloseWithError(errDeltaClosed) }
+
+func (b *deltaBody) Open() (cos.ReadOpenCloser, error) {
+	src, err := b.src.Open()
+	if err != nil {
+		return nil, err
+	}
+	return newDeltaBody(src, b.sig, b.size, b.stats), nil
+}
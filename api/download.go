@@ -67,6 +67,17 @@ func DownloadMulti(bp BaseParams, description string, bck cmn.Bck, msg any, inte
 	return DownloadWithParam(bp, dload.TypeMulti, dlBody)
 }
 
+// download links listed in the manifest object (newline-delimited links or CSV "url,objname")
+func DownloadManifest(bp BaseParams, descr string, bck, mbck cmn.Bck, mobj string, ivals ...time.Duration) (string, error) {
+	dlBody := dload.ManifestBody{ManifestBck: mbck, ManifestObj: mobj}
+	if len(ivals) > 0 {
+		dlBody.ProgressInterval = ivals[0].String()
+	}
+	dlBody.Bck = bck
+	dlBody.Description = descr
+	return DownloadWithParam(bp, dload.TypeManifest, dlBody)
+}
+
 func DownloadBackend(bp BaseParams, descr string, bck cmn.Bck, prefix, suffix string, ivals ...time.Duration) (string, error) {
 	dlBody := dload.BackendBody{Prefix: prefix, Suffix: suffix}
 	if len(ivals) > 0 {
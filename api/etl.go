@@ -99,6 +99,21 @@ func ETLMetrics(params BaseParams, etlName string) (healths etl.CPUMemByTarget,
 	return
 }
 
+// per-target runtime statistics: objects and bytes transformed, failures, and latency
+// (use etl.StatsByTarget.Total() to aggregate)
+func ETLStats(params BaseParams, etlName string) (stats etl.StatsByTarget, err error) {
+	params.Method = http.MethodGet
+	path := apc.URLPathETL.Join(etlName, apc.ETLStats)
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = params
+		reqParams.Path = path
+	}
+	_, err = reqParams.DoReqAny(&stats)
+	FreeRp(reqParams)
+	return
+}
+
 func ETLHealth(params BaseParams, etlName string) (healths etl.HealthByTarget, err error) {
 	params.Method = http.MethodGet
 	path := apc.URLPathETL.Join(etlName, apc.ETLHealth)
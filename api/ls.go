@@ -7,6 +7,7 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -19,6 +20,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn/mono"
 )
 
+// see ListBucketsAll
+var ErrRemAisPartial = errors.New("partial result: failed to list buckets at remote cluster(s)")
+
 const (
 	maxListPageRetries = 3
 
@@ -74,6 +78,43 @@ func ListBuckets(bp BaseParams, qbck cmn.QueryBcks, fltPresence int) (cmn.Bcks,
 	return bcks, nil
 }
 
+// ListBucketsAll is ListBuckets that, in addition, includes buckets from all attached
+// remote ais clusters - namespaced as `@alias` (or `@uuid` when there's no alias) - in a single call.
+// - applies only to `qbck` with global (or no) namespace and provider "ais" or "" (all providers);
+// - remote clusters are queried in parallel; when some of them fail, the function returns
+// the buckets that it was able to list along with ErrRemAisPartial (use errors.Is to check)
+func ListBucketsAll(bp BaseParams, qbck cmn.QueryBcks, fltPresence int) (cmn.Bcks, error) {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamFltPresence, strconv.Itoa(fltPresence))
+	q.Set(apc.QparamRemAisAll, "true")
+	qbck.AddToQuery(q)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.S
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActList, Name: qbck.Name})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	bcks := cmn.Bcks{}
+	resp, err := reqParams.do()
+	if err == nil {
+		err = reqParams.readAny(resp, &bcks)
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+	}
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	if s := resp.Header.Get(apc.HdrRemAisErrs); s != "" {
+		return bcks, fmt.Errorf("%w: %s", ErrRemAisPartial, s)
+	}
+	return bcks, nil
+}
+
 // QueryBuckets is a little convenience helper. It returns true if the selection contains
 // at least one bucket that satisfies the (qbck) criteria.
 // - `fltPresence` - as per QparamFltPresence enum (see api/apc/query.go)
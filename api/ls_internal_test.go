@@ -0,0 +1,48 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestListBucketsAll(t *testing.T) {
+	var (
+		failed string
+		bcks   = cmn.Bcks{
+			{Name: "local", Provider: apc.AIS},
+			{Name: "remote", Provider: apc.AIS, Ns: cmn.Ns{UUID: "alias1"}},
+		}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !cos.IsParseBool(r.URL.Query().Get(apc.QparamRemAisAll)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if failed != "" {
+			w.Header().Set(apc.HdrRemAisErrs, failed)
+		}
+		w.Write(cos.MustMarshal(bcks))
+	}))
+	defer srv.Close()
+	bp := BaseParams{Client: http.DefaultClient, URL: srv.URL}
+
+	res, err := ListBucketsAll(bp, cmn.QueryBcks{}, apc.FltExists)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(res) == 2 && res[1].Ns.UUID == "alias1", "unexpected %v", res)
+
+	failed = "@alias2: connection refused"
+	res, err = ListBucketsAll(bp, cmn.QueryBcks{}, apc.FltExists)
+	tassert.Fatalf(t, errors.Is(err, ErrRemAisPartial), "expected partial-result error, got %v", err)
+	tassert.Fatalf(t, len(res) == 2, "expected partial result, got %v", res)
+}
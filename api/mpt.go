@@ -0,0 +1,240 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Multipart (resumable) upload:
+// - StartMultipart returns upload ID;
+// - PutPart uploads numbered parts (1-based, any order, possibly in parallel);
+//   re-uploading a part replaces it;
+// - MultipartStatus lists the parts received so far;
+// - CompleteMultipart creates the object from parts 1..N; AbortMultipart discards the upload.
+// PutObjectMultipart does it all, with retries, and can resume an interrupted upload.
+
+const (
+	DefaultMptPartSize = 64 * cos.MiB
+	DefaultMptWorkers  = 4
+)
+
+type PutMptArgs struct {
+	Reader io.ReaderAt // e.g., *os.File
+
+	// optional: retry policy for each part (see PutObjectResilient)
+	Policy *RetryPolicy
+
+	BaseParams BaseParams
+
+	Bck     cmn.Bck
+	ObjName string
+
+	// upload ID (to resume) - if empty, a new upload is started;
+	// upon failure, contains the ID to resume with (see also AbortMultipart)
+	UploadID string
+
+	Size       int64 // total size (bytes)
+	PartSize   int64 // zero: DefaultMptPartSize
+	NumWorkers int   // zero: DefaultMptWorkers
+}
+
+// PutObjectMultipart uploads `args.Size` bytes from `args.Reader` in parts;
+// when resuming (non-empty `args.UploadID`), skips the parts that were already received
+// (as per MultipartStatus) and re-sends the rest
+func PutObjectMultipart(args *PutMptArgs) (oah ObjAttrs, err error) {
+	var (
+		partSize = args.PartSize
+		workers  = args.NumWorkers
+		bp       = args.BaseParams
+	)
+	if partSize <= 0 {
+		partSize = DefaultMptPartSize
+	}
+	if workers <= 0 {
+		workers = DefaultMptWorkers
+	}
+	if args.Size <= 0 {
+		return oah, errors.New("api.PutObjectMultipart: expecting positive size")
+	}
+	numParts := int((args.Size + partSize - 1) / partSize)
+	if numParts > apc.MptMaxParts {
+		return oah, fmt.Errorf("api.PutObjectMultipart: too many parts (%d > %d) - increase part size", numParts, apc.MptMaxParts)
+	}
+
+	// 1. start or resume
+	received := make(map[int]int64, numParts)
+	if args.UploadID == "" {
+		if args.UploadID, err = StartMultipart(bp, args.Bck, args.ObjName); err != nil {
+			return oah, err
+		}
+	} else {
+		status, err := MultipartStatus(bp, args.Bck, args.ObjName, args.UploadID)
+		if err != nil {
+			return oah, err
+		}
+		for _, part := range status.Parts {
+			received[part.Num] = part.Size
+		}
+	}
+
+	// 2. parts
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		errs  []error
+		partN = make(chan int, numParts)
+	)
+	for num := 1; num <= numParts; num++ {
+		off := int64(num-1) * partSize
+		if size, ok := received[num]; ok && size == min(partSize, args.Size-off) {
+			continue
+		}
+		partN <- num
+	}
+	close(partN)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for num := range partN {
+				off := int64(num-1) * partSize
+				r := io.NewSectionReader(args.Reader, off, min(partSize, args.Size-off))
+				if err := args.putPart(num, r); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("part %d: %w", num, err))
+					mu.Unlock()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return oah, fmt.Errorf("multipart upload %q of %s failed (resume with the same upload ID): %w",
+			args.UploadID, args.Bck.Cname(args.ObjName), errors.Join(errs...))
+	}
+
+	// 3. complete
+	return CompleteMultipart(bp, args.Bck, args.ObjName, args.UploadID, &apc.MptCompleteMsg{NumParts: numParts, Size: args.Size})
+}
+
+func (args *PutMptArgs) putPart(num int, r *io.SectionReader) (err error) {
+	var p RetryPolicy
+	if args.Policy != nil {
+		p = *args.Policy
+	}
+	p.init()
+	sleep := p.Sleep
+	for retry := 0; ; retry++ {
+		roc := &seekROC{r: r}
+		if _, err = roc.Open(); err != nil {
+			return err
+		}
+		err = PutPart(args.BaseParams, args.Bck, args.ObjName, args.UploadID, num, roc, r.Size())
+		if err == nil || retry >= p.MaxRetries || !p.Retriable(err) {
+			return err
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(retry+1, err)
+		}
+		sleep = p.wait(sleep)
+	}
+}
+
+func StartMultipart(bp BaseParams, bck cmn.Bck, objName string) (uploadID string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMptStart})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	_, err = reqParams.doReqStr(&uploadID)
+	FreeRp(reqParams)
+	return uploadID, err
+}
+
+// PUT part number `partNum` (1-based) of the specified upload
+func PutPart(bp BaseParams, bck cmn.Bck, objName, uploadID string, partNum int, r cos.ReadOpenCloser, size int64) error {
+	q := make(url.Values, 4)
+	q = bck.AddToQuery(q)
+	q.Set(apc.QparamMptUploadID, uploadID)
+	q.Set(apc.QparamMptPartNum, strconv.Itoa(partNum))
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = bp.URL
+		reqArgs.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqArgs.Query = q
+		reqArgs.BodyR = r
+	}
+	putArgs := &PutArgs{BaseParams: bp, Bck: bck, ObjName: objName, Reader: r, Size: uint64(size)}
+	_, err := DoWithRetry(bp.Client, putArgs.put, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	return err
+}
+
+// parts received so far (ascending)
+func MultipartStatus(bp BaseParams, bck cmn.Bck, objName, uploadID string) (status *apc.MptStatus, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMptStatus, Name: uploadID})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	status = &apc.MptStatus{}
+	_, err = reqParams.DoReqAny(status)
+	FreeRp(reqParams)
+	return status, err
+}
+
+// create the object from parts 1..msg.NumParts; upon failure, the upload (and its parts) remain
+// and can be resumed or completed again
+func CompleteMultipart(bp BaseParams, bck cmn.Bck, objName, uploadID string, msg *apc.MptCompleteMsg) (oah ObjAttrs, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMptComplete, Name: uploadID, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	oah.wrespHeader, _, err = reqParams.doReqHdr()
+	FreeRp(reqParams)
+	return oah, err
+}
+
+// discard the upload and all its parts
+func AbortMultipart(bp BaseParams, bck cmn.Bck, objName, uploadID string) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMptAbort, Name: uploadID})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
@@ -72,6 +72,54 @@ func Prefetch(bp BaseParams, bck cmn.Bck, msg apc.PrefetchMsg) (string, error) {
 	return dolr(bp, bck, apc.ActPrefetchObjects, msg, q)
 }
 
+// InitShard packs loose objects of a given bucket into shards (of a given size), and stores
+// per-target manifests that map shards to samples.
+// Returns xaction ID; see also: apc.InitShardMsg
+func InitShard(bp BaseParams, bck cmn.Bck, msg *apc.InitShardMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	return dolr(bp, bck, apc.ActInitShard, msg, q)
+}
+
+// CheckOOB compares the objects cached in-cluster with their remote counterparts and,
+// depending on msg.Action, reports, marks, or evicts those that changed (or were deleted) out-of-band.
+// Returns xaction ID; per-target summaries are reported via extended xaction stats (see apc.CheckOOBStats)
+func CheckOOB(bp BaseParams, bck cmn.Bck, msg *apc.CheckOOBMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	return dolr(bp, bck, apc.ActCheckOOB, msg, q)
+}
+
+// RenamePrefix renames (moves) all objects under msg.Prefix (e.g., virtual directory "dir1/")
+// as msg.NewPrefix + (the rest of the name); ais buckets only. Returns xaction ID
+func RenamePrefix(bp BaseParams, bck cmn.Bck, msg *apc.RenamePrefixMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	return dolr(bp, bck, apc.ActRenamePrefix, msg, q)
+}
+
+// BatchObjOps executes a batch of set-properties and delete operations (see apc.BatchOpsMsg)
+// and returns per-item results in the request order; atomicity is per target (i.e., for the
+// objects stored on the same target), see also apc.BatchOpsResult.NumErrs
+func BatchObjOps(bp BaseParams, bck cmn.Bck, msg *apc.BatchOpsMsg) (*apc.BatchOpsResult, error) {
+	bp.Method = http.MethodPost
+	res := &apc.BatchOpsResult{}
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActBatchObjOps, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // multi-object list-range (delete, prefetch, evict, archive, copy, and etl)
 func dolr(bp BaseParams, bck cmn.Bck, action string, msg any, q url.Values) (xid string, err error) {
 	reqParams := AllocRp()
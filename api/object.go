@@ -41,6 +41,8 @@ type (
 		// 2. `apc.QparamOrigURL`: GET from a vanilla http(s) location (`ht://` bucket with the corresponding `OrigURLBck`)
 		// 3. `apc.QparamSilent`: do not log errors
 		// 4. `apc.QparamLatestVer`: get latest version from the associated Cloud bucket; see also: `ValidateWarmGet`
+		// 5. `apc.QparamArchpath` or `apc.QparamArchglob`: read archived file(s) - one named file or all matching files (as TAR)
+		// 6. `apc.QparamObjVersion`: read a given (previous) version of the object - see also: `GetObjectVersion`
 		Query url.Values
 
 		// The field is exclusively used to facilitate Range Read.
@@ -49,6 +51,10 @@ type (
 		// For range formatting, see the spec:
 		// * https://www.rfc-editor.org/rfc/rfc7233#section-2.1
 		Header http.Header
+
+		// optional; if non-zero, the cluster stops serving this request (including reading
+		// from remote backend) upon timeout - see apc.HdrTimeout and 'timeout.object_get_time'
+		Timeout time.Duration
 	}
 
 	// `ObjAttrs` represents object attributes and can be further used to retrieve
@@ -74,6 +80,12 @@ type (
 		// - otherwise, compare the two checksums upon writing (aka, "end-to-end protection")
 		Cksum *cos.Cksum
 
+		// optional; if set and `Cksum` carries type but no value:
+		// compute the checksum while streaming and send it as HTTP trailer -
+		// instead of reading (and hashing) the entire `Reader` prior to sending.
+		// Implies chunked transfer encoding (and therefore, `Size` is ignored)
+		CksumTrailer bool
+
 		BaseParams BaseParams
 
 		Bck     cmn.Bck
@@ -87,6 +99,14 @@ type (
 		// - we massively write a new content into a bucket, and/or
 		// - we simply don't care.
 		SkipVC bool
+
+		// optional; if non-zero, the cluster fails this PUT upon timeout
+		// (see apc.HdrTimeout and 'timeout.object_put_time')
+		Timeout time.Duration
+
+		// optional user-defined metadata: (key, value) pairs to store along with the object
+		// (see apc.HdrObjMetaPrefix; keys are case-insensitive and must not be system-reserved)
+		CustomMD cos.StrKVs
 	}
 
 	// (see also: api.PutApndArchArgs)
@@ -128,6 +148,14 @@ func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 		w = args.Writer
 	}
 	q, hdr = args.Query, args.Header
+	if args.Timeout > 0 {
+		if hdr == nil {
+			hdr = make(http.Header, 1)
+		} else {
+			hdr = hdr.Clone()
+		}
+		hdr.Set(apc.HdrTimeout, args.Timeout.String())
+	}
 	return
 }
 
@@ -242,12 +270,32 @@ func GetObjectReader(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs)
 	return
 }
 
+// GetObjectVersion reads a given version of the object: current or previous
+// (the latter requires `versioning.retain` - ais:// buckets only).
+func GetObjectVersion(bp BaseParams, bck cmn.Bck, objName, version string, args *GetArgs) (ObjAttrs, error) {
+	var a GetArgs
+	if args != nil {
+		a = *args
+	}
+	q := make(url.Values, len(a.Query)+1)
+	for k, vs := range a.Query {
+		q[k] = vs
+	}
+	q.Set(apc.QparamObjVersion, version)
+	a.Query = q
+	return GetObject(bp, bck, objName, &a)
+}
+
 /////////////
 // PutArgs //
 /////////////
 
 func (args *PutArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
 
+func (args *PutArgs) withTrailer() bool {
+	return args.CksumTrailer && args.Cksum != nil && args.Cksum.Ty() != cos.ChecksumNone && args.Cksum.Value() == ""
+}
+
 func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	req, err := reqArgs.Req()
 	if err != nil {
@@ -255,6 +303,26 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	}
 	// Go http doesn't automatically set this for files, so to handle redirect we do it here.
 	req.GetBody = args.getBody
+	if args.Timeout > 0 {
+		req.Header.Set(apc.HdrTimeout, args.Timeout.String())
+	}
+	for k, v := range args.CustomMD {
+		req.Header.Set(apc.HdrObjMetaPrefix+k, v)
+	}
+	if args.withTrailer() {
+		tr := newTrailerReader(req.Body, args.Cksum.Ty())
+		req.Header.Set(apc.HdrObjCksumType, args.Cksum.Ty())
+		req.Body, req.Trailer = tr, tr.trailer
+		req.GetBody = func() (io.ReadCloser, error) {
+			r, err := args.Reader.Open()
+			if err != nil {
+				return nil, err
+			}
+			return newTrailerReader(r, args.Cksum.Ty()), nil
+		}
+		SetAuxHeaders(req, &args.BaseParams)
+		return req, nil
+	}
 	if args.Cksum != nil && args.Cksum.Ty() != cos.ChecksumNone {
 		req.Header.Set(apc.HdrObjCksumType, args.Cksum.Ty())
 		ckVal := args.Cksum.Value()
@@ -371,6 +439,30 @@ func SetObjectCustomProps(bp BaseParams, bck cmn.Bck, objName string, custom cos
 	return err
 }
 
+// SetObjectProps sets object's custom (user) metadata, pin status, and/or custom checksum
+// (that must match the object's content). With `setNew` set, the specified custom metadata
+// replaces all existing custom keys (compare with SetObjectCustomProps).
+// See also: apc.ObjPropsToSet
+func SetObjectProps(bp BaseParams, bck cmn.Bck, objName string, props *apc.ObjPropsToSet, setNew bool) error {
+	q := make(url.Values, 4)
+	q = bck.AddToQuery(q)
+	if setNew {
+		q.Set(apc.QparamNewCustom, "true")
+	}
+	bp.Method = http.MethodPatch
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSetObjProps, Value: props})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
 	bp.Method = http.MethodDelete
 	reqParams := AllocRp()
@@ -429,7 +521,11 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 		reqArgs.Query = query
 		reqArgs.BodyR = args.Reader
 	}
-	resp, err = DoWithRetry(args.BaseParams.Client, args.put, reqArgs) //nolint:bodyclose // is closed inside
+	client := args.BaseParams.Client
+	if args.withTrailer() {
+		client = trailerClient(client)
+	}
+	resp, err = DoWithRetry(client, args.put, reqArgs) //nolint:bodyclose // is closed inside
 	cmn.FreeHra(reqArgs)
 	if err == nil {
 		oah.wrespHeader = resp.Header
@@ -637,3 +733,60 @@ func _retry(err error, resp *http.Response) bool {
 	}
 	return err != nil && cos.IsRetriableConnErr(err)
 }
+
+//
+// PUT with checksum trailer (see PutArgs.CksumTrailer)
+//
+
+// computes checksum while being read; upon EOF sets the corresponding trailer
+type trailerReader struct {
+	r       io.ReadCloser
+	cksum   *cos.CksumHash
+	trailer http.Header
+}
+
+func newTrailerReader(r io.ReadCloser, ty string) *trailerReader {
+	return &trailerReader{
+		r:       r,
+		cksum:   cos.NewCksumHash(ty),
+		trailer: http.Header{http.CanonicalHeaderKey(apc.HdrObjCksumVal): nil},
+	}
+}
+
+func (tr *trailerReader) Read(p []byte) (n int, err error) {
+	n, err = tr.r.Read(p)
+	if n > 0 {
+		tr.cksum.H.Write(p[:n])
+	}
+	if err == io.EOF {
+		tr.cksum.Finalize()
+		tr.trailer.Set(apc.HdrObjCksumVal, tr.cksum.Value())
+	}
+	return
+}
+
+func (tr *trailerReader) Close() error { return tr.r.Close() }
+
+// net/http does not carry trailers over redirects (and AIS gateway always redirects PUT);
+// hence, shallow copy of the client with redirect policy that takes the trailer
+// from the (new) request body - see GetBody above
+func trailerClient(client *http.Client) *http.Client {
+	const maxRedirects = 10 // (net/http default)
+	var (
+		c        = *client
+		redirect = client.CheckRedirect
+	)
+	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if tr, ok := req.Body.(*trailerReader); ok {
+			req.Trailer = tr.trailer
+		}
+		if redirect != nil {
+			return redirect(req, via)
+		}
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		return nil
+	}
+	return &c
+}
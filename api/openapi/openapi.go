@@ -0,0 +1,255 @@
+// Package openapi generates OpenAPI 3 document that describes AIStore REST API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// The document is generated from the annotated route registry (see routes.go), with
+// request and response schemas derived from the corresponding Go types (see schema.go).
+//
+// Limitations:
+// - bucket and object names are path parameters; object names may contain slashes
+//   (and are not URL-escaped by AIS clients), which OpenAPI 3 cannot express;
+// - the same (method, path) may perform different actions (apc.ActMsg.Action) and
+//   return different responses (e.g., GET /v1/cluster?what=...) - in which case the
+//   response is described as `oneOf`.
+
+const Version = "3.0.3"
+
+type (
+	// annotated route
+	Route struct {
+		Method  string
+		Path    string // e.g. "/v1/objects/{bucket}/{object}"; path parameters are always strings
+		ID      string // operationId (unique)
+		Tag     string // (used to group operations)
+		Summary string
+		Desc    string
+		Query   []Param
+		Headers []Param
+		Actions []string // apc.ActMsg actions accepted by this route (see `Body`)
+		Body    any      // JSON request body: Go type (e.g., `apc.ActMsg{}`)
+		Resp    any      // JSON response: Go type or OneOf
+		BodyRaw bool     // binary request body (e.g., object content)
+		RespRaw bool     // binary response (e.g., object content)
+		RespTxt bool     // plain text response (e.g., xaction ID)
+	}
+	Param struct {
+		Name     string
+		Desc     string
+		Required bool
+	}
+	// different responses depending on the request (e.g., `?what=`)
+	OneOf []any
+)
+
+// OpenAPI 3 document (the subset used by AIS)
+type (
+	Document struct {
+		OpenAPI    string                           `json:"openapi"`
+		Info       Info                             `json:"info"`
+		Paths      map[string]map[string]*Operation `json:"paths"` // path => method (lowercase) => operation
+		Components Components                       `json:"components"`
+		Security   []map[string][]string            `json:"security,omitempty"`
+		Tags       []Tag                            `json:"tags,omitempty"`
+	}
+	Info struct {
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		Version     string `json:"version"`
+	}
+	Tag struct {
+		Name string `json:"name"`
+	}
+	Components struct {
+		Schemas         map[string]*Schema         `json:"schemas"`
+		SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+	}
+	SecurityScheme struct {
+		Type   string `json:"type"`
+		Scheme string `json:"scheme"`
+	}
+	Operation struct {
+		OperationID string               `json:"operationId"`
+		Summary     string               `json:"summary,omitempty"`
+		Description string               `json:"description,omitempty"`
+		Tags        []string             `json:"tags,omitempty"`
+		Parameters  []*Parameter         `json:"parameters,omitempty"`
+		RequestBody *RequestBody         `json:"requestBody,omitempty"`
+		Responses   map[string]*Response `json:"responses"`
+	}
+	Parameter struct {
+		Schema      *Schema `json:"schema"`
+		Name        string  `json:"name"`
+		In          string  `json:"in"` // "path" | "query" | "header"
+		Description string  `json:"description,omitempty"`
+		Required    bool    `json:"required,omitempty"`
+	}
+	RequestBody struct {
+		Content     map[string]*MediaType `json:"content"`
+		Description string                `json:"description,omitempty"`
+		Required    bool                  `json:"required,omitempty"`
+	}
+	Response struct {
+		Content     map[string]*MediaType `json:"content,omitempty"`
+		Description string                `json:"description"`
+	}
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+)
+
+const (
+	mimeJSON   = "application/json"
+	mimeBinary = "application/octet-stream"
+	mimeText   = "text/plain"
+
+	bearerAuth = "bearerAuth"
+)
+
+// Generate returns OpenAPI document that describes all registered routes (see `Routes`)
+func Generate(version string) (*Document, error) {
+	return generate(Routes, version)
+}
+
+func generate(routes []*Route, version string) (*Document, error) {
+	var (
+		sg   = newSchemaGen()
+		tags = make(map[string]struct{}, 8)
+		ids  = make(map[string]struct{}, len(routes))
+		doc  = &Document{
+			OpenAPI: Version,
+			Info: Info{
+				Title:       "AIStore REST API",
+				Description: "AIStore (AIS) proxy (gateway) and target REST API",
+				Version:     version,
+			},
+			Paths: make(map[string]map[string]*Operation, len(routes)),
+			Components: Components{
+				SecuritySchemes: map[string]*SecurityScheme{bearerAuth: {Type: "http", Scheme: "bearer"}},
+			},
+			// optional: required only when AuthN is enabled
+			Security: []map[string][]string{{}, {bearerAuth: {}}},
+		}
+	)
+	for _, r := range routes {
+		if _, ok := ids[r.ID]; ok || r.ID == "" {
+			return nil, fmt.Errorf("route %s %s: duplicate or missing operation ID %q", r.Method, r.Path, r.ID)
+		}
+		ids[r.ID] = struct{}{}
+		ops, ok := doc.Paths[r.Path]
+		if !ok {
+			ops = make(map[string]*Operation, 2)
+			doc.Paths[r.Path] = ops
+		}
+		method := strings.ToLower(r.Method)
+		if _, ok := ops[method]; ok {
+			return nil, fmt.Errorf("route %s %s: duplicate (method, path)", r.Method, r.Path)
+		}
+		ops[method] = r.operation(sg)
+		if r.Tag != "" {
+			tags[r.Tag] = struct{}{}
+		}
+	}
+	doc.Components.Schemas = sg.schemas
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, Tag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(i, j int) bool { return doc.Tags[i].Name < doc.Tags[j].Name })
+	return doc, nil
+}
+
+///////////
+// Route //
+///////////
+
+func (r *Route) operation(sg *schemaGen) *Operation {
+	op := &Operation{
+		OperationID: r.ID,
+		Summary:     r.Summary,
+		Description: r.Desc,
+		Responses:   make(map[string]*Response, 2),
+	}
+	if r.Tag != "" {
+		op.Tags = []string{r.Tag}
+	}
+
+	// parameters: path, query, header
+	for _, name := range pathParams(r.Path) {
+		op.Parameters = append(op.Parameters, &Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	for _, p := range r.Query {
+		op.Parameters = append(op.Parameters, &Parameter{Name: p.Name, In: "query", Description: p.Desc,
+			Required: p.Required, Schema: &Schema{Type: "string"}})
+	}
+	for _, p := range r.Headers {
+		op.Parameters = append(op.Parameters, &Parameter{Name: p.Name, In: "header", Description: p.Desc,
+			Required: p.Required, Schema: &Schema{Type: "string"}})
+	}
+
+	// request body
+	switch {
+	case r.BodyRaw:
+		op.RequestBody = &RequestBody{Content: mediaType(mimeBinary, &Schema{Type: "string", Format: "binary"})}
+	case r.Body != nil:
+		op.RequestBody = &RequestBody{Required: true, Content: mediaType(mimeJSON, sg.schema(r.Body))}
+		if len(r.Actions) > 0 {
+			op.RequestBody.Description = "action message; supported actions: " + strings.Join(r.Actions, ", ")
+		}
+	}
+
+	// responses
+	ok := &Response{Description: "OK"}
+	switch {
+	case r.RespRaw:
+		ok.Content = mediaType(mimeBinary, &Schema{Type: "string", Format: "binary"})
+	case r.RespTxt:
+		ok.Content = mediaType(mimeText, &Schema{Type: "string"})
+	case r.Resp != nil:
+		ok.Content = mediaType(mimeJSON, sg.schema(r.Resp))
+	}
+	op.Responses["200"] = ok
+	op.Responses["default"] = &Response{Description: "error", Content: mediaType(mimeJSON, sg.schema(cmn.ErrHTTP{}))}
+	return op
+}
+
+func mediaType(mime string, schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{mime: {Schema: schema}}
+}
+
+// "/v1/objects/{bucket}/{object}" => [bucket, object]
+func pathParams(path string) (names []string) {
+	for {
+		i := strings.IndexByte(path, '{')
+		if i < 0 {
+			return names
+		}
+		j := strings.IndexByte(path[i:], '}')
+		if j < 0 {
+			return names
+		}
+		names = append(names, path[i+1:i+j])
+		path = path[i+j+1:]
+	}
+}
+
+//
+// common parameters
+//
+
+var (
+	qparamProvider  = Param{Name: apc.QparamProvider, Desc: "backend provider (\"ais\" (default), \"aws\", \"gcp\", \"azure\", \"ht\")"}
+	qparamNamespace = Param{Name: apc.QparamNamespace, Desc: "bucket namespace, e.g. \"@uuid#namespace\""}
+	qparamWhat      = Param{Name: apc.QparamWhat, Required: true, Desc: "what to query"}
+	qparamsBck      = []Param{qparamProvider, qparamNamespace}
+	hdrTimeout      = Param{Name: apc.HdrTimeout, Desc: "request timeout, e.g. \"30s\" (default: timeout.object_*_time config)"}
+)
@@ -0,0 +1,191 @@
+// Package openapi generates OpenAPI 3 document that describes AIStore REST API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestGenerate(t *testing.T) {
+	doc, err := Generate("test")
+	tassert.CheckFatal(t, err)
+	b, err := json.Marshal(doc)
+	tassert.CheckFatal(t, err)
+
+	// all references must resolve
+	var refs []string
+	walkRefs(t, b, &refs)
+	tassert.Fatalf(t, len(refs) > 0, "expected schema references")
+	for _, ref := range refs {
+		name := strings.TrimPrefix(ref, refPrefix)
+		_, ok := doc.Components.Schemas[name]
+		tassert.Errorf(t, ok && name != ref, "unresolved reference %q", ref)
+	}
+
+	for path, ops := range doc.Paths {
+		tassert.Errorf(t, strings.HasPrefix(path, "/"+apc.Version+"/"), "%s: expecting versioned path", path)
+		for method, op := range ops {
+			// path parameters
+			for _, name := range pathParams(path) {
+				var found bool
+				for _, p := range op.Parameters {
+					found = found || (p.In == "path" && p.Name == name)
+				}
+				tassert.Errorf(t, found, "%s %s: missing path parameter %q", method, path, name)
+			}
+			tassert.Errorf(t, op.Responses["200"] != nil, "%s %s: missing response", method, path)
+		}
+	}
+
+	// action messages with specific values
+	op := doc.Paths[apc.URLPathBuckets.Join("{bucket}")]["get"]
+	value := op.RequestBody.Content[mimeJSON].Schema.Props["value"]
+	tassert.Fatalf(t, value != nil && len(value.OneOf) > 0 && value.OneOf[0].Ref == refPrefix+"apc.LsoMsg",
+		"list-objects: unexpected value schema %+v", value)
+}
+
+// Every action and URL path used by the Go API (package api) must be in the registry.
+// The test parses api/*.go and api/apc/*.go sources (compare with TestGenerate that
+// only validates what's already registered).
+func TestRouteCoverage(t *testing.T) {
+	// not described by this document
+	skip := cos.NewStrSet(
+		"URLPathS3",    // S3 compatibility API, see docs/s3compat.md
+		"ActTransient", // (used as query parameter, see setClusterConfig)
+	)
+
+	// apc constants and variables: name => value (actions) or name only (URL paths)
+	var (
+		fset    = token.NewFileSet()
+		actions = make(map[string]string, 128)
+		paths   = cos.NewStrSet()
+	)
+	for _, f := range parseDir(t, fset, "../apc") {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || (gd.Tok != token.CONST && gd.Tok != token.VAR) {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					switch {
+					case gd.Tok == token.VAR && strings.HasPrefix(name.Name, "URLPath"):
+						paths.Add(name.Name)
+					case gd.Tok == token.CONST && strings.HasPrefix(name.Name, "Act") && i < len(vs.Values):
+						if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							actions[name.Name], _ = strconv.Unquote(lit.Value)
+						}
+					}
+				}
+			}
+		}
+	}
+	tassert.Fatalf(t, len(actions) > 0 && len(paths) > 0, "failed to parse api/apc")
+
+	registered := cos.NewStrSet()
+	for _, r := range Routes {
+		registered.Add(r.Actions...)
+	}
+	refs := cos.NewStrSet() // apc names referenced by the registry
+	for _, f := range parseDir(t, fset, ".") {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok && x.Name == "apc" {
+					refs.Add(sel.Sel.Name)
+				}
+			}
+			return true
+		})
+	}
+
+	var n int
+	for _, f := range parseDir(t, fset, "..") {
+		ast.Inspect(f, func(node ast.Node) bool {
+			sel, ok := node.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "apc" {
+				return true
+			}
+			name := sel.Sel.Name
+			if skip.Contains(name) {
+				return true
+			}
+			pos := fset.Position(sel.Pos())
+			if action, ok := actions[name]; ok {
+				n++
+				tassert.Errorf(t, registered.Contains(action), "%s: action apc.%s (%q) is missing in the route registry",
+					pos, name, action)
+			} else if paths.Contains(name) {
+				n++
+				tassert.Errorf(t, refs.Contains(name), "%s: apc.%s is missing in the route registry", pos, name)
+			}
+			return true
+		})
+	}
+	tassert.Fatalf(t, n > 0, "failed to parse api")
+}
+
+func parseDir(t *testing.T, fset *token.FileSet, dir string) (files []*ast.File) {
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	tassert.CheckFatal(t, err)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	routes := []*Route{
+		{Method: "GET", Path: "/v1/a", ID: "a"},
+		{Method: "PUT", Path: "/v1/b", ID: "a"},
+	}
+	_, err := generate(routes, "test")
+	tassert.Errorf(t, err != nil, "expected duplicate operation ID error")
+
+	routes[1].ID, routes[1].Method, routes[1].Path = "b", "GET", "/v1/a"
+	_, err = generate(routes, "test")
+	tassert.Errorf(t, err != nil, "expected duplicate (method, path) error")
+}
+
+func walkRefs(t *testing.T, b []byte, refs *[]string) {
+	var v any
+	tassert.CheckFatal(t, json.Unmarshal(b, &v))
+	var walk func(v any)
+	walk = func(v any) {
+		switch x := v.(type) {
+		case map[string]any:
+			for k, e := range x {
+				if s, ok := e.(string); ok && k == "$ref" {
+					*refs = append(*refs, s)
+				} else {
+					walk(e)
+				}
+			}
+		case []any:
+			for _, e := range x {
+				walk(e)
+			}
+		}
+	}
+	walk(v)
+}
@@ -0,0 +1,435 @@
+// Package openapi generates OpenAPI 3 document that describes AIStore REST API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/ext/dsort"
+	"github.com/NVIDIA/aistore/ext/etl"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Route registry: public REST API (see also: api/apc/urlpaths.go, docs/http_api.md)
+//
+// NOTE: when adding or changing public endpoints (and the corresponding Go API in api/),
+// update the registry as well (enforced by TestRouteCoverage).
+
+const (
+	tagBuckets  = "buckets"
+	tagObjects  = "objects"
+	tagCluster  = "cluster"
+	tagNode     = "node"
+	tagDownload = "download"
+	tagDsort    = "dsort"
+	tagETL      = "etl"
+	tagMisc     = "misc"
+)
+
+var (
+	pathBucket = apc.URLPathBuckets.Join("{bucket}")
+	pathObject = apc.URLPathObjects.Join("{bucket}", "{object}")
+	pathETL    = apc.URLPathETL.Join("{etl}")
+)
+
+var Routes = []*Route{
+	//
+	// buckets
+	//
+	{
+		Method: http.MethodGet, Path: apc.URLPathBuckets.S, ID: "listBuckets", Tag: tagBuckets,
+		Summary: "List buckets",
+		Query:   append([]Param{{Name: apc.QparamFltPresence, Desc: "presence filter (apc.Flt* enum)"}}, qparamsBck...),
+		Actions: []string{apc.ActList},
+		Body:    apc.ActMsg{},
+		Resp:    cmn.Bcks{},
+	},
+	{
+		Method: http.MethodGet, Path: pathBucket, ID: "listObjects", Tag: tagBuckets,
+		Summary: "List objects (action 'list', value: list-objects message), get bucket summary, object access heatmap, or random sample of object names",
+		Query: append([]Param{{Name: apc.QparamBsummStream,
+			Desc: "bucket summary: stream per-target results (newline-delimited cmn.BsummStreamResult)"}}, qparamsBck...),
+		Actions: []string{apc.ActList, apc.ActSummaryBck, apc.ActHeatmapBck, apc.ActSampleBck},
+		Body:    apc.ActMsg{Value: OneOf{apc.LsoMsg{}, apc.HeatmapMsg{}, apc.SampleMsg{}}},
+		Resp:    OneOf{cmn.LsoResult{}, cmn.AllBsummResults{}, apc.Heatmap{}, apc.Sample{}},
+	},
+	{
+		Method: http.MethodHead, Path: pathBucket, ID: "headBucket", Tag: tagBuckets,
+		Summary: "Get bucket properties (returned in the '" + apc.HdrBucketProps + "' response header)",
+		Query:   append([]Param{{Name: apc.QparamDontAddRemote, Desc: "do not add remote bucket to cluster's metadata"}}, qparamsBck...),
+	},
+	{
+		Method: http.MethodPost, Path: pathBucket, ID: "bucketAction", Tag: tagBuckets,
+		Summary: "Create, copy, rename, transform bucket; copy, transform, and prefetch multiple objects; and more",
+		Desc: "Returns xaction (job) ID, if applicable; '" + apc.ActBatchObjOps + "' (value: apc.BatchOpsMsg) " +
+			"returns per-object results (JSON-encoded apc.BatchOpsResult)",
+		Query: append([]Param{
+			{Name: apc.QparamBckTo, Desc: "destination bucket (copy, rename, transform)"},
+			{Name: apc.QparamBprofile, Desc: "create bucket with the named set of properties"},
+		}, qparamsBck...),
+		Actions: []string{apc.ActCreateBck, apc.ActMoveBck, apc.ActCopyBck, apc.ActETLBck, apc.ActCopyObjects,
+			apc.ActETLObjects, apc.ActPrefetchObjects, apc.ActAddRemoteBck, apc.ActInvalListCache,
+			apc.ActMakeNCopies, apc.ActECEncode, apc.ActBatchObjOps, apc.ActInitShard, apc.ActCheckOOB, apc.ActRenamePrefix},
+		Body: apc.ActMsg{Value: OneOf{apc.TCBMsg{}, apc.TCObjsMsg{}, apc.PrefetchMsg{}, apc.BatchOpsMsg{},
+			apc.InitShardMsg{}, apc.CheckOOBMsg{}, apc.RenamePrefixMsg{}}},
+		RespTxt: true,
+	},
+	{
+		Method: http.MethodPut, Path: pathBucket, ID: "archiveObjects", Tag: tagBuckets,
+		Summary: "Archive multiple objects (TAR, TGZ, ZIP, etc.)",
+		Query:   qparamsBck,
+		Actions: []string{apc.ActArchive},
+		Body:    apc.ActMsg{Value: cmn.ArchiveBckMsg{}},
+		RespTxt: true,
+	},
+	{
+		Method: http.MethodPatch, Path: pathBucket, ID: "setBucketProps", Tag: tagBuckets,
+		Summary: "Set (action 'set-bprops', value: properties to update) or reset bucket properties; allow and/or deny access (action 'update-access')",
+		Query:   qparamsBck,
+		Actions: []string{apc.ActSetBprops, apc.ActResetBprops, apc.ActUpdateAccess},
+		Body:    apc.ActMsg{Value: OneOf{cmn.BpropsToSet{}, apc.AccessUpdate{}}},
+		RespTxt: true,
+	},
+	{
+		Method: http.MethodDelete, Path: pathBucket, ID: "deleteBucket", Tag: tagBuckets,
+		Summary: "Destroy bucket, evict remote bucket, delete or evict multiple objects",
+		Query:   append([]Param{{Name: apc.QparamKeepRemote, Desc: "when evicting: keep remote bucket's metadata"}}, qparamsBck...),
+		Actions: []string{apc.ActDestroyBck, apc.ActEvictRemoteBck, apc.ActDeleteObjects, apc.ActEvictObjects},
+		Body:    apc.ActMsg{Value: apc.ListRange{}},
+		RespTxt: true,
+	},
+
+	//
+	// objects
+	//
+	{
+		Method: http.MethodGet, Path: pathObject, ID: "getObject", Tag: tagObjects,
+		Summary: "Read object (or a range of bytes, or an archived file)",
+		Query: append([]Param{
+			{Name: apc.QparamArchpath, Desc: "read the named file from the object formatted as archive (shard)"},
+			{Name: apc.QparamArchglob, Desc: "read all archived files that match the wildcard, packed as a new TAR"},
+			{Name: apc.QparamArchmime, Desc: "archive format (when it cannot be deduced from the object name)"},
+			{Name: apc.QparamLatestVer, Desc: "check in-cluster version against the remote and get the latest, if need be"},
+			{Name: apc.QparamDeltaSig, Desc: "return delta signature of the object (value: block size, '0' for default)"},
+			{Name: apc.QparamComposite, Desc: "'false': return composite object's manifest instead of the concatenated members"},
+		}, qparamsBck...),
+		Headers: []Param{{Name: "Range", Desc: "HTTP range (RFC 7233)"}, hdrTimeout},
+		RespRaw: true,
+	},
+	{
+		Method: http.MethodPut, Path: pathObject, ID: "putObject", Tag: tagObjects,
+		Summary: "Write object, append to object, write object as delta against its current version, or write a part of multipart upload",
+		Query: append([]Param{
+			{Name: apc.QparamAppendType, Desc: "'append' | 'flush'"},
+			{Name: apc.QparamAppendHandle, Desc: "append handle returned by the previous append"},
+			{Name: apc.QparamMptUploadID, Desc: "multipart upload ID (see action 'mpt-start')"},
+			{Name: apc.QparamMptPartNum, Desc: "multipart upload: part number (1-based)"},
+			{Name: apc.QparamDelta, Desc: "'true': request body is delta-encoded (see cmn/delta)"},
+			{Name: apc.QparamComposite, Desc: "'true': request body is composite object's manifest (see apc.CompositeManifest)"},
+			{Name: apc.QparamSkipVC, Desc: "skip loading existing object's metadata"},
+		}, qparamsBck...),
+		Headers: []Param{
+			{Name: apc.HdrObjCksumType, Desc: "checksum type, e.g. 'xxhash', 'md5', 'sha256'"},
+			{Name: apc.HdrObjCksumVal, Desc: "checksum value (end-to-end protection)"},
+			hdrTimeout,
+		},
+		BodyRaw: true,
+	},
+	{
+		Method: http.MethodHead, Path: pathObject, ID: "headObject", Tag: tagObjects,
+		Summary: "Get object properties (returned in the response headers)",
+		Query:   append([]Param{{Name: apc.QparamFltPresence, Desc: "presence filter (apc.Flt* enum)"}}, qparamsBck...),
+		Headers: []Param{hdrTimeout},
+	},
+	{
+		Method: http.MethodDelete, Path: pathObject, ID: "deleteObject", Tag: tagObjects,
+		Summary: "Delete object",
+		Query:   qparamsBck,
+	},
+	{
+		Method: http.MethodPatch, Path: pathObject, ID: "setObjectCustomProps", Tag: tagObjects,
+		Summary: "Set object's custom (user-defined) properties (value: key-value map) or, with action 'set-obj-props', custom metadata, pinning, and validated checksum",
+		Query:   append([]Param{{Name: apc.QparamNewCustom, Desc: "remove existing custom keys"}}, qparamsBck...),
+		Actions: []string{apc.ActSetObjProps},
+		Body:    apc.ActMsg{Value: OneOf{map[string]string{}, apc.ObjPropsToSet{}}},
+	},
+	{
+		Method: http.MethodPost, Path: pathObject, ID: "objectAction", Tag: tagObjects,
+		Summary: "Rename object (action 'rename-obj', name: new object name); multipart (resumable) upload: " +
+			"start (returns upload ID), status (parts received so far), complete, or abort",
+		Desc:    "multipart upload: name is the upload ID (except 'mpt-start'); parts are written via PUT with upload ID and part number",
+		Query:   qparamsBck,
+		Actions: []string{apc.ActRenameObject, apc.ActMptStart, apc.ActMptStatus, apc.ActMptComplete, apc.ActMptAbort},
+		Body:    apc.ActMsg{Value: apc.MptCompleteMsg{}},
+		Resp:    apc.MptStatus{},
+	},
+	{
+		Method: http.MethodPost, Path: apc.URLPathObjects.Join("{bucket}"), ID: "objectsAction", Tag: tagObjects,
+		Summary: "Promote files and directories (action 'promote'); download large remote object (action 'blob-download')",
+		Desc:    "Returns xaction (job) ID, if applicable",
+		Query:   qparamsBck,
+		Actions: []string{apc.ActPromote, apc.ActBlobDl},
+		Body:    apc.ActMsg{Value: OneOf{apc.PromoteArgs{}, apc.BlobMsg{}}},
+		RespTxt: true,
+	},
+
+	//
+	// cluster
+	//
+	{
+		Method: http.MethodGet, Path: apc.URLPathClu.S, ID: "queryCluster", Tag: tagCluster,
+		Summary: "Query cluster map, metadata, configuration, statistics, jobs (xactions), and more",
+		Desc: "what: " + apc.WhatSmap + " | " + apc.WhatBMD + " | " + apc.WhatClusterConfig + " | " + apc.WhatNodeStats +
+			" | " + apc.WhatSysInfo + " | " + apc.WhatRemoteAIS + " | " + apc.WhatTargetIPs + " | " + apc.WhatMountpaths +
+			" | " + apc.WhatOneXactStatus + " | " + apc.WhatAllXactStatus + " | " + apc.WhatQueryXactStats +
+			" | " + apc.WhatAllRunningXacts + "; xaction queries require xaction query message in the request body",
+		Query: []Param{qparamWhat},
+		Body:  xact.QueryMsg{},
+		Resp: OneOf{meta.Smap{}, meta.BMD{}, cmn.ClusterConfig{}, stats.Cluster{}, apc.ClusterSysInfo{},
+			meta.RemAisVec{}, nl.Status{}, []*nl.Status{}, xact.MultiSnap{}, []string{}},
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathClu.S, ID: "clusterAction", Tag: tagCluster,
+		Summary: "Cluster-wide actions: configure, start and stop jobs (xactions), maintain and decommission nodes, shutdown",
+		Desc:    "Returns xaction (job) ID, if applicable",
+		Actions: []string{apc.ActSetConfig, apc.ActResetConfig, apc.ActRotateLogs, apc.ActResetStats,
+			apc.ActXactStart, apc.ActXactStop, apc.ActStartMaintenance, apc.ActStopMaintenance,
+			apc.ActDecommissionNode, apc.ActShutdownNode, apc.ActShutdownCluster, apc.ActDecommissionCluster,
+			apc.ActRmNodeUnsafe, apc.ActEnrollAuthN},
+		Body:    apc.ActMsg{Value: OneOf{cmn.ConfigToSet{}, xact.ArgsMsg{}, apc.ActValRmNode{}, apc.ActValEnrollAuthN{}}},
+		RespTxt: true,
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathCluSetConf.S, ID: "setClusterConfig", Tag: tagCluster,
+		Summary: "Update cluster configuration via query parameters, e.g. '?log.level=4&lru.enabled=false'",
+		Query: []Param{
+			{Name: apc.ActTransient, Desc: "'true': update in memory only"},
+			{Name: apc.QparamStage, Desc: "'true': validate on all affected nodes and return per-node changes without applying"},
+			{Name: apc.QparamApplyTo, Desc: "'" + apc.ApplyToProxies + "' | '" + apc.ApplyToTargets + "' | node ID: update as node-local override"},
+		},
+		Resp: cmn.StagedConfig{},
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathXactWatch.S, ID: "watchXactions", Tag: tagCluster,
+		Summary: "Subscribe to xaction stats (WebSocket upgrade); snapshots are pushed periodically until all xactions finish",
+		Query: []Param{
+			{Name: apc.QparamUUID, Desc: "comma-separated xaction IDs", Required: true},
+			{Name: apc.QparamIval, Desc: "interval between updates, e.g. '2s' (default 1s)"},
+		},
+		Resp: xact.WatchMsg{},
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathCluProxy.Join("{node}"), ID: "setPrimaryProxy", Tag: tagCluster,
+		Summary: "Designate new primary proxy (gateway)",
+		Query:   []Param{{Name: apc.QparamForce, Desc: "force (advanced usage only)"}},
+	},
+	{
+		Method: http.MethodPost, Path: apc.URLPathCluUserReg.S, ID: "joinCluster", Tag: tagCluster,
+		Summary: "Join node to the cluster",
+		Body:    meta.Snode{},
+		Resp:    apc.JoinNodeResult{},
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathCluAttach.S, ID: "attachRemoteAIS", Tag: tagCluster,
+		Summary: "Attach remote AIS cluster, e.g. '?what=remote&alias=url'",
+		Query:   []Param{qparamWhat},
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathCluDetach.S, ID: "detachRemoteAIS", Tag: tagCluster,
+		Summary: "Detach remote AIS cluster, e.g. '?what=remote&alias'",
+		Query:   []Param{qparamWhat},
+	},
+
+	//
+	// node
+	//
+	{
+		Method: http.MethodGet, Path: apc.URLPathDae.S, ID: "queryThisNode", Tag: tagNode,
+		Summary: "Query cluster map or bucket metadata as seen by the node that receives the request",
+		Desc:    "what: " + apc.WhatSmap + " | " + apc.WhatBMD,
+		Query:   []Param{qparamWhat},
+		Resp:    OneOf{meta.Smap{}, meta.BMD{}},
+	},
+	// via proxy: reverse-proxied to the node specified by ID
+	{
+		Method: http.MethodGet, Path: apc.URLPathReverseDae.S, ID: "queryNode", Tag: tagNode,
+		Summary: "Query node's configuration, status, statistics, mountpaths, log, and more",
+		Desc: "what: " + apc.WhatNodeConfig + " | " + apc.WhatNodeOverride + " | " + apc.WhatNodeComputed +
+			" | " + apc.WhatNodeStatsAndStatus + " | " + apc.WhatNodeStats + " | " + apc.WhatMetricNames + " | " + apc.WhatDiskStats + " | " + apc.WhatMountpaths + " | " + apc.WhatSmap +
+			" | " + apc.WhatBMD + " | " + apc.WhatSysInfo + " | " + apc.WhatLog + " | " + apc.WhatCapHistory + " | " + apc.WhatBckUsage +
+			" | " + apc.WhatSLO,
+		Query: []Param{qparamWhat,
+			{Name: apc.QparamSince, Desc: "capacity history: only snapshots taken within the specified duration (e.g., \"168h\")"},
+			{Name: apc.QparamPeriod, Desc: "bucket usage: calendar month (e.g., \"2024-06\"); default: all persisted months"},
+		},
+		Headers: []Param{{Name: apc.HdrNodeID, Desc: "node ID", Required: true}},
+		Resp: OneOf{cmn.Config{}, stats.NodeStatus{}, apc.MountpathList{}, meta.Smap{}, map[string]string{}, []string{},
+			[]stats.CapSnap{}, stats.BckUsagePeriods{}, []stats.SLOStatus{}},
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathReverseDae.S, ID: "nodeAction", Tag: tagNode,
+		Summary: "Node actions: configure, reset statistics, rotate logs",
+		Headers: []Param{{Name: apc.HdrNodeID, Desc: "node ID", Required: true}},
+		Actions: []string{apc.ActSetConfig, apc.ActResetConfig, apc.ActResetStats, apc.ActRotateLogs},
+		Body:    apc.ActMsg{},
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathReverseDae.Join(apc.ActSetConfig), ID: "setNodeConfig", Tag: tagNode,
+		Summary: "Update node configuration via query parameters",
+		Headers: []Param{{Name: apc.HdrNodeID, Desc: "node ID", Required: true}},
+	},
+	{
+		Method: http.MethodPut, Path: apc.URLPathReverseDae.Join(apc.Mountpaths), ID: "attachMountpath", Tag: tagNode,
+		Summary: "Attach mountpath (value: mountpath)",
+		Headers: []Param{{Name: apc.HdrNodeID, Desc: "target ID", Required: true}},
+		Actions: []string{apc.ActMountpathAttach},
+		Body:    apc.ActMsg{},
+	},
+	{
+		Method: http.MethodPost, Path: apc.URLPathReverseDae.Join(apc.Mountpaths), ID: "enableDisableMountpath", Tag: tagNode,
+		Summary: "Enable or disable mountpath (value: mountpath)",
+		Headers: []Param{{Name: apc.HdrNodeID, Desc: "target ID", Required: true}},
+		Actions: []string{apc.ActMountpathEnable, apc.ActMountpathDisable},
+		Body:    apc.ActMsg{},
+	},
+	{
+		Method: http.MethodDelete, Path: apc.URLPathReverseDae.Join(apc.Mountpaths), ID: "detachMountpath", Tag: tagNode,
+		Summary: "Detach mountpath (value: mountpath)",
+		Headers: []Param{{Name: apc.HdrNodeID, Desc: "target ID", Required: true}},
+		Actions: []string{apc.ActMountpathDetach},
+		Body:    apc.ActMsg{},
+	},
+
+	//
+	// download
+	//
+	{
+		Method: http.MethodPost, Path: apc.URLPathDownload.S, ID: "startDownload", Tag: tagDownload,
+		Summary: "Start download job",
+		Body:    dload.Body{},
+		Resp:    dload.DlPostResp{},
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathDownload.S, ID: "getDownload", Tag: tagDownload,
+		Summary: "Get download job status (by ID) or list download jobs",
+		Body:    dload.AdminBody{},
+		Resp:    OneOf{dload.StatusResp{}, dload.JobInfos{}},
+	},
+	{
+		Method: http.MethodDelete, Path: apc.URLPathDownloadAbort.S, ID: "abortDownload", Tag: tagDownload,
+		Summary: "Abort download job",
+		Body:    dload.AdminBody{},
+	},
+	{
+		Method: http.MethodDelete, Path: apc.URLPathDownloadRemove.S, ID: "removeDownload", Tag: tagDownload,
+		Summary: "Remove finished download job",
+		Body:    dload.AdminBody{},
+	},
+
+	//
+	// dsort
+	//
+	{
+		Method: http.MethodPost, Path: apc.URLPathdSort.S, ID: "startDsort", Tag: tagDsort,
+		Summary: "Start distributed shuffle (dsort) job",
+		Body:    dsort.RequestSpec{},
+		RespTxt: true,
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathdSort.S, ID: "getDsort", Tag: tagDsort,
+		Summary: "List dsort jobs or get dsort job metrics (by ID)",
+		Query: []Param{
+			{Name: apc.QparamUUID, Desc: "job ID"},
+			{Name: apc.QparamRegex, Desc: "list: regex to filter jobs"},
+			{Name: apc.QparamOnlyActive, Desc: "list: only active jobs"},
+		},
+		Resp: OneOf{[]*dsort.JobInfo{}, map[string]*dsort.JobInfo{}},
+	},
+	{
+		Method: http.MethodDelete, Path: apc.URLPathdSortAbort.S, ID: "abortDsort", Tag: tagDsort,
+		Summary: "Abort dsort job",
+		Query:   []Param{{Name: apc.QparamUUID, Desc: "job ID", Required: true}},
+	},
+	{
+		Method: http.MethodDelete, Path: apc.URLPathdSort.S, ID: "removeDsort", Tag: tagDsort,
+		Summary: "Remove finished dsort job",
+		Query:   []Param{{Name: apc.QparamUUID, Desc: "job ID", Required: true}},
+	},
+
+	//
+	// ETL
+	//
+	{
+		Method: http.MethodPut, Path: apc.URLPathETL.S, ID: "initETL", Tag: tagETL,
+		Summary: "Initialize ETL (from spec or code)",
+		Body:    OneOf{etl.InitSpecMsg{}, etl.InitCodeMsg{}},
+		RespTxt: true,
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathETL.S, ID: "listETL", Tag: tagETL,
+		Summary: "List ETLs",
+		Resp:    []etl.Info{},
+	},
+	{
+		Method: http.MethodGet, Path: pathETL, ID: "getETL", Tag: tagETL,
+		Summary: "Get ETL init message",
+		Resp:    OneOf{etl.InitSpecMsg{}, etl.InitCodeMsg{}},
+	},
+	{
+		Method: http.MethodDelete, Path: pathETL, ID: "deleteETL", Tag: tagETL,
+		Summary: "Delete ETL",
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathETL.Join("{etl}", apc.ETLLogs), ID: "getETLLogs", Tag: tagETL,
+		Summary: "Get ETL logs",
+		Resp:    etl.LogsByTarget{},
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathETL.Join("{etl}", apc.ETLHealth), ID: "getETLHealth", Tag: tagETL,
+		Summary: "Get ETL health",
+		Resp:    etl.HealthByTarget{},
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathETL.Join("{etl}", apc.ETLMetrics), ID: "getETLMetrics", Tag: tagETL,
+		Summary: "Get ETL CPU and memory usage",
+		Resp:    etl.CPUMemByTarget{},
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathETL.Join("{etl}", apc.ETLStats), ID: "getETLStats", Tag: tagETL,
+		Summary: "Get ETL runtime statistics: objects and bytes transformed, failures, and latency histogram",
+		Resp:    etl.StatsByTarget{},
+	},
+	{
+		Method: http.MethodPost, Path: apc.URLPathETL.Join("{etl}", apc.ETLStart), ID: "startETL", Tag: tagETL,
+		Summary: "Start (previously stopped) ETL",
+	},
+	{
+		Method: http.MethodPost, Path: apc.URLPathETL.Join("{etl}", apc.ETLStop), ID: "stopETL", Tag: tagETL,
+		Summary: "Stop ETL",
+	},
+
+	//
+	// misc
+	//
+	{
+		Method: http.MethodGet, Path: apc.URLPathHealth.S, ID: "health", Tag: tagMisc,
+		Summary: "Probe node liveness and readiness",
+		Query: []Param{
+			{Name: apc.QparamHealthReadiness, Desc: "readiness probe (e.g., K8s)"},
+			{Name: apc.QparamPrimaryReadyReb, Desc: "check whether primary is ready to rebalance cluster"},
+		},
+	},
+	{
+		Method: http.MethodGet, Path: apc.URLPathOpenAPI.S, ID: "getOpenAPI", Tag: tagMisc,
+		Summary: "Get this document (OpenAPI 3)",
+		Resp:    map[string]any{},
+	},
+}
@@ -0,0 +1,186 @@
+// Package openapi generates OpenAPI 3 document that describes AIStore REST API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type (
+	Schema struct {
+		Items    *Schema            `json:"items,omitempty"`
+		Props    map[string]*Schema `json:"properties,omitempty"`
+		AddProps *Schema            `json:"additionalProperties,omitempty"`
+		Ref      string             `json:"$ref,omitempty"`
+		Type     string             `json:"type,omitempty"`
+		Format   string             `json:"format,omitempty"`
+		Desc     string             `json:"description,omitempty"`
+		OneOf    []*Schema          `json:"oneOf,omitempty"`
+	}
+
+	// derives schemas from Go types; named structs become (shared) components
+	schemaGen struct {
+		schemas map[string]*Schema
+	}
+)
+
+const refPrefix = "#/components/schemas/"
+
+var (
+	typeTime      = reflect.TypeOf(time.Time{})
+	typeMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typeTextMarsh = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func newSchemaGen() *schemaGen {
+	return &schemaGen{schemas: make(map[string]*Schema, 64)}
+}
+
+// v: Go value (e.g., `cmn.Bck{}`) or OneOf
+//   - interface fields that are set (e.g., `apc.ActMsg{Value: apc.LsoMsg{}}`) are described
+//     by their respective values - in which case the struct is not a shared component
+func (sg *schemaGen) schema(v any) *Schema {
+	if one, ok := v.(OneOf); ok {
+		s := &Schema{OneOf: make([]*Schema, 0, len(one))}
+		for _, v := range one {
+			s.OneOf = append(s.OneOf, sg.schema(v))
+		}
+		return s
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Struct && hasValues(rv) {
+		s := &Schema{Type: "object", Props: make(map[string]*Schema, rv.NumField())}
+		sg.fields(rv.Type(), rv, s)
+		return s
+	}
+	return sg.typeSchema(reflect.TypeOf(v))
+}
+
+// whether any of the struct's interface fields is set
+func hasValues(rv reflect.Value) bool {
+	for i := 0; i < rv.NumField(); i++ {
+		f := rv.Field(i)
+		if f.Kind() == reflect.Interface && !f.IsNil() && rv.Type().Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+func (sg *schemaGen) typeSchema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == typeTime:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Implements(typeMarshaler) || reflect.PointerTo(t).Implements(typeMarshaler):
+		// custom JSON (e.g., cos.Duration, cos.SizeIEC): any value
+		return &Schema{Desc: t.String()}
+	case t.Implements(typeTextMarsh) || reflect.PointerTo(t).Implements(typeTextMarsh):
+		return &Schema{Type: "string", Desc: t.String()}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: sg.typeSchema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AddProps: sg.typeSchema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return sg.structSchema(t)
+		}
+		name := schemaName(t)
+		if _, ok := sg.schemas[name]; !ok {
+			sg.schemas[name] = &Schema{} // (recursive types)
+			sg.schemas[name] = sg.structSchema(t)
+		}
+		return &Schema{Ref: refPrefix + name}
+	default: // interface, et al.
+		return &Schema{}
+	}
+}
+
+func (sg *schemaGen) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Props: make(map[string]*Schema, t.NumField())}
+	sg.fields(t, reflect.Value{}, s)
+	return s
+}
+
+// (flattening embedded structs the same way encoding/json does)
+// rv: struct value (optional) - see `schema` above
+func (sg *schemaGen) fields(t reflect.Type, rv reflect.Value, s *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				sg.fields(ft, reflect.Value{}, s)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if strings.Contains(opts, "string") {
+			s.Props[name] = &Schema{Type: "string", Desc: "(stringified " + f.Type.Kind().String() + ")"}
+			continue
+		}
+		if rv.IsValid() && f.Type.Kind() == reflect.Interface && !rv.Field(i).IsNil() {
+			s.Props[name] = sg.schema(rv.Field(i).Interface())
+			continue
+		}
+		s.Props[name] = sg.typeSchema(f.Type)
+	}
+}
+
+// e.g. "cmn.Bprops"
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndexByte(pkg, '/'); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	name := t.Name()
+	if i := strings.IndexByte(name, '['); i >= 0 { // generics
+		name = name[:i]
+	}
+	if pkg == "" {
+		return name
+	}
+	return pkg + "." + name
+}
@@ -0,0 +1,146 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// PutObjectResilient: PUT from a seekable reader with automatic retries
+// (compare with PutObject that retries only a few times and only when the connection
+// is refused or reset - within 1-2 seconds)
+//
+// Upon a transient failure the entire content is re-sent from the reader's
+// original position. For large objects, see PutObjectMultipart that only re-sends
+// the failed parts (and can also resume an interrupted upload).
+
+const (
+	DefaultPutRetries  = 5
+	DefaultPutSleep    = time.Second
+	DefaultPutMaxSleep = 30 * time.Second
+)
+
+type (
+	// zero values: defaults (above)
+	RetryPolicy struct {
+		// optional: overrides the default classification of retriable errors (see IsRetriablePut)
+		Retriable func(err error) bool
+		// optional: callback to observe (e.g., log) failed attempts
+		OnRetry    func(retry int, err error)
+		Sleep      time.Duration // initial delay between retries; doubles each time up to MaxSleep
+		MaxSleep   time.Duration
+		MaxRetries int
+	}
+
+	// cos.ReadOpenCloser over caller's io.ReadSeeker: "opening" is seeking back to the start;
+	// closing is a no-op (the reader belongs to the caller)
+	seekROC struct {
+		r     io.ReadSeeker
+		start int64
+	}
+)
+
+// interface guard
+var _ cos.ReadOpenCloser = (*seekROC)(nil)
+
+// - `args.Reader` must be nil (`r` is used instead);
+// - `args.Size`, if zero, is determined by seeking to the end of `r`
+func PutObjectResilient(args *PutArgs, r io.ReadSeeker, policy *RetryPolicy) (oah ObjAttrs, err error) {
+	if args.Reader != nil {
+		return oah, errors.New("api.PutObjectResilient: expecting nil args.Reader")
+	}
+	var p RetryPolicy
+	if policy != nil {
+		p = *policy
+	}
+	p.init()
+
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return oah, err
+	}
+	a := *args
+	if a.Size == 0 && !a.withTrailer() {
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return oah, err
+		}
+		a.Size = uint64(end - start)
+	}
+	sleep := p.Sleep
+	for retry := 0; ; retry++ {
+		if _, err = r.Seek(start, io.SeekStart); err != nil {
+			return oah, err
+		}
+		a.Reader = &seekROC{r: r, start: start}
+		oah, err = PutObject(&a)
+		if err == nil || retry >= p.MaxRetries || !p.Retriable(err) {
+			return oah, err
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(retry+1, err)
+		}
+		sleep = p.wait(sleep)
+	}
+}
+
+// default classification: connection refused, reset, or broken; unexpected EOF;
+// HTTP 408, 429, 502, 503, and 504
+func IsRetriablePut(err error) bool {
+	if cos.IsRetriableConnErr(err) || cos.IsEOF(err) {
+		return true
+	}
+	var herr *cmn.ErrHTTP
+	if errors.As(err, &herr) {
+		switch herr.Status {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) init() {
+	if p.Retriable == nil {
+		p.Retriable = IsRetriablePut
+	}
+	if p.MaxRetries == 0 {
+		p.MaxRetries = DefaultPutRetries
+	}
+	if p.Sleep == 0 {
+		p.Sleep = DefaultPutSleep
+	}
+	if p.MaxSleep == 0 {
+		p.MaxSleep = DefaultPutMaxSleep
+	}
+	p.MaxSleep = max(p.MaxSleep, p.Sleep)
+}
+
+// sleep and return the next (doubled) delay
+func (p *RetryPolicy) wait(sleep time.Duration) time.Duration {
+	time.Sleep(sleep)
+	return min(2*sleep, p.MaxSleep)
+}
+
+/////////////
+// seekROC //
+/////////////
+
+func (s *seekROC) Read(b []byte) (int, error) { return s.r.Read(b) }
+func (*seekROC) Close() error                 { return nil }
+
+func (s *seekROC) Open() (cos.ReadOpenCloser, error) {
+	if _, err := s.r.Seek(s.start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,82 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPutObjectResilient(t *testing.T) {
+	const (
+		prefix  = "header-to-skip:"
+		content = "the quick brown fox jumps over the lazy dog"
+	)
+	var (
+		attempts int
+		received []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		b, _ := io.ReadAll(r.Body)
+		received = append(received, string(b))
+		if attempts <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var (
+		r       = strings.NewReader(prefix + content)
+		retries []int
+		policy  = &RetryPolicy{
+			Sleep:   time.Millisecond,
+			OnRetry: func(retry int, _ error) { retries = append(retries, retry) },
+		}
+		args = &PutArgs{
+			BaseParams: BaseParams{Client: http.DefaultClient, URL: srv.URL},
+			Bck:        cmn.Bck{Name: "bck", Provider: apc.AIS},
+			ObjName:    "obj",
+		}
+	)
+	_, err := r.Seek(int64(len(prefix)), io.SeekStart) // must upload from the current position
+	tassert.CheckFatal(t, err)
+
+	_, err = PutObjectResilient(args, r, policy)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, attempts == 3 && len(retries) == 2, "expected 3 attempts (2 retries), got %d (%v)", attempts, retries)
+	for i, s := range received {
+		tassert.Errorf(t, s == content, "attempt %d: expected %q, got %q", i, content, s)
+	}
+
+	// non-retriable
+	attempts, received = 0, nil
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusForbidden)
+	})
+	_, err = PutObjectResilient(args, bytes.NewReader([]byte(content)), policy)
+	tassert.Fatalf(t, err != nil && attempts == 1, "expected a single failed attempt, got %d (%v)", attempts, err)
+
+	// exhausted retries
+	attempts = 0
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	_, err = PutObjectResilient(args, bytes.NewReader([]byte(content)), &RetryPolicy{MaxRetries: 2, Sleep: time.Millisecond})
+	tassert.Fatalf(t, err != nil && attempts == 3, "expected 3 failed attempts, got %d (%v)", attempts, err)
+}
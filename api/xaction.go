@@ -5,6 +5,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -19,6 +20,9 @@ import (
 	"github.com/NVIDIA/aistore/xact"
 )
 
+// returned (wrapped) by the WaitForXaction* family upon timeout
+var ErrWaitTimeout = errors.New("timed out")
+
 // Start xaction
 func StartXaction(bp BaseParams, args *xact.ArgsMsg, extra string) (xid string, err error) {
 	if !xact.Table[args.Kind].Startable {
@@ -245,7 +249,7 @@ func _waitx(bp BaseParams, args *xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bo
 		sleep = min(maxSleep, sleep+sleep/2)
 
 		if elapsed = mono.Since(begin); elapsed >= total {
-			err = fmt.Errorf("api.wait: timed out (%v) waiting for %s", total, args.String())
+			err = fmt.Errorf("api.wait: %w (%v) waiting for %s", ErrWaitTimeout, total, args.String())
 			return
 		}
 	}
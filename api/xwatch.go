@@ -0,0 +1,253 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	"golang.org/x/net/websocket"
+)
+
+// WatchXaction subscribes to the stats of the specified xactions: the cluster pushes
+// snapshots (xact.WatchMsg) over WebSocket every `ival` (zero means xact.DfltWatchIval).
+// The returned channel gets closed:
+// - when all the xactions finish (the last message has `Fin` set), or
+// - upon error (the last message has `Err` set), or
+// - when the context gets canceled.
+func WatchXaction(ctx context.Context, bp BaseParams, ids []string, ival time.Duration) (<-chan *xact.WatchMsg, error) {
+	config, err := watchConfig(&bp, ids, ival)
+	if err != nil {
+		return nil, err
+	}
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ch   = make(chan *xact.WatchMsg, 4)
+		done = make(chan struct{})
+	)
+	go func() {
+		select {
+		case <-ctx.Done():
+			ws.Close() // unblock the receiver
+		case <-done:
+		}
+	}()
+	go func() {
+		defer func() {
+			ws.Close()
+			close(done)
+			close(ch)
+		}()
+		for {
+			msg := &xact.WatchMsg{}
+			if err := websocket.JSON.Receive(ws, msg); err != nil {
+				if ctx.Err() == nil && err != io.EOF {
+					ch <- &xact.WatchMsg{Err: err.Error(), Time: time.Now().UnixNano()}
+				}
+				return
+			}
+			select {
+			case ch <- msg:
+			case <-ctx.Done():
+				return
+			}
+			if msg.Fin || msg.Err != "" {
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// WaitForXactionProgress waits for the xaction to finish, invoking `cb` (if not nil) with
+// cluster-wide stats snapshots every `ival` (zero means xact.DfltWatchIval).
+// Given xaction ID, subscribes to the stats (see WatchXaction), and polls otherwise (or if the
+// former fails). Xactions that idle before finishing (xact.IdlesBeforeFinishing) are done when idle.
+// Returns:
+// - nil when the xaction finishes;
+// - cmn.ErrAborted if the xaction gets aborted;
+// - ctx.Err() when the context gets canceled (e.g., upon Ctrl-C - see signal.NotifyContext);
+// - ErrWaitTimeout upon `args.Timeout` (zero and negative values - as per WaitForXactionIC);
+// - error returned by the callback, if any (the wait stops right away).
+func WaitForXactionProgress(ctx context.Context, bp BaseParams, args *xact.ArgsMsg, ival time.Duration,
+	cb func(xact.MultiSnap) error) error {
+	total, _ := _times(args)
+	wctx, cancel := context.WithTimeout(ctx, total)
+	defer cancel()
+
+	if ival == 0 {
+		ival = xact.DfltWatchIval
+	}
+	ival = min(max(ival, xact.MinWatchIval), xact.MaxWatchIval)
+	w := &xwait{args: args, cb: cb}
+	if kind, _ := xact.GetKindName(args.Kind); kind != "" {
+		w.ci = _consIdle(kind, args.ID)
+	}
+
+	// stream
+	if xact.IsValidUUID(args.ID) && args.DaemonID == "" {
+		if done, err := w.watch(wctx, bp, ival); done {
+			return w.ctxErr(ctx, wctx, total, err)
+		}
+	}
+
+	// poll
+	for {
+		snaps, err := QueryXactionSnaps(bp, args)
+		switch {
+		case err == nil:
+			if done, err := w.check(snaps); done {
+				return err
+			}
+		case cos.IsRetriableConnErr(err) || cmn.IsStatusServiceUnavailable(err):
+		default:
+			return w.ctxErr(ctx, wctx, total, err)
+		}
+		select {
+		case <-wctx.Done():
+			return w.ctxErr(ctx, wctx, total, nil)
+		case <-time.After(ival):
+		}
+	}
+}
+
+type xwait struct {
+	started time.Time // first snapshot without the xaction (see check)
+	args    *xact.ArgsMsg
+	cb      func(xact.MultiSnap) error
+	ci      *consIdle
+}
+
+func _consIdle(kind, xid string) *consIdle {
+	if xact.IdlesBeforeFinishing(kind) {
+		return &consIdle{xid: xid}
+	}
+	return nil
+}
+
+// returns false to fall back to polling (e.g., when the cluster does not support streaming)
+func (w *xwait) watch(wctx context.Context, bp BaseParams, ival time.Duration) (bool, error) {
+	sctx, scancel := context.WithCancel(wctx)
+	defer scancel()
+	ch, err := WatchXaction(sctx, bp, []string{w.args.ID}, ival)
+	if err != nil {
+		return false, nil
+	}
+	for msg := range ch {
+		if msg.Err != "" {
+			return false, nil
+		}
+		if done, err := w.check(msg.Snaps); done {
+			return true, err
+		}
+	}
+	// closed: canceled or timed out (otherwise, w.check must've returned done on the final message)
+	return wctx.Err() != nil, nil
+}
+
+func (w *xwait) check(snaps xact.MultiSnap) (bool, error) {
+	if w.cb != nil {
+		if err := w.cb(snaps); err != nil {
+			return true, err
+		}
+	}
+	if w.ci == nil && w.args.Kind == "" {
+		// (kind from the snapshot)
+		for _, xsnaps := range snaps {
+			if len(xsnaps) > 0 {
+				w.ci = _consIdle(xsnaps[0].Kind, w.args.ID)
+				w.args.Kind = xsnaps[0].Kind
+				break
+			}
+		}
+	}
+	found, finished, aborted := snaps.IsFinished(w.args.ID)
+	if aborted != nil {
+		var err error
+		if aborted.AbortErr != "" {
+			err = errors.New(aborted.AbortErr)
+		}
+		return true, cmn.NewErrAborted(aborted.Kind+xact.LeftID+aborted.ID+xact.RightID, "", err)
+	}
+	if finished {
+		return true, nil
+	}
+	if w.ci != nil {
+		done, _ := w.ci.check(snaps)
+		return done, nil
+	}
+	// not (yet) started vs. does not exist
+	if found {
+		w.started = time.Time{}
+	} else if w.started.IsZero() {
+		w.started = time.Now()
+	} else if time.Since(w.started) > 2*xact.MinPollTime {
+		return true, cmn.NewErrXactNotFoundError(w.args.String())
+	}
+	return false, nil
+}
+
+func (w *xwait) ctxErr(ctx, wctx context.Context, total time.Duration, err error) error {
+	switch {
+	case err != nil:
+		return err
+	case ctx.Err() != nil:
+		return ctx.Err()
+	case wctx.Err() != nil:
+		return fmt.Errorf("api.wait: %w (%v) waiting for %s", ErrWaitTimeout, total, w.args.String())
+	}
+	return nil
+}
+
+func watchConfig(bp *BaseParams, ids []string, ival time.Duration) (*websocket.Config, error) {
+	u, err := url.Parse(bp.URL)
+	if err != nil {
+		return nil, err
+	}
+	origin := url.URL{Scheme: u.Scheme, Host: u.Host}
+	if u.Scheme == "https" {
+		u.Scheme = "wss"
+	} else {
+		u.Scheme = "ws"
+	}
+	u.Path = path.Join(u.Path, apc.URLPathXactWatch.S)
+	q := url.Values{apc.QparamUUID: []string{strings.Join(ids, ",")}}
+	if ival > 0 {
+		q.Set(apc.QparamIval, ival.String())
+	}
+	u.RawQuery = q.Encode()
+
+	config, err := websocket.NewConfig(u.String(), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	if bp.Token != "" {
+		config.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+bp.Token)
+	}
+	if bp.UA != "" {
+		config.Header.Set(cos.HdrUserAgent, bp.UA)
+	}
+	if bp.Client != nil {
+		if tr, ok := bp.Client.Transport.(*http.Transport); ok && tr.TLSClientConfig != nil {
+			config.TlsConfig = tr.TLSClientConfig
+		}
+	}
+	return config, nil
+}
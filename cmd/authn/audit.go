@@ -0,0 +1,152 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Audit log: authentication events (logins, token revocations), user and role changes,
+// and permission denials.
+// Each event is stored in the DB under a key that starts with the (zero-padded) event time
+// followed by a sequence number, so that lexicographic order of the keys is chronological
+// and simultaneous events do not overwrite each other. Listing and pruning iterate
+// the keys in order and stop as soon as they reach the requested time boundary.
+// Events older than `auditRetention` are periodically removed.
+
+func (m *mgr) auditKey(ev *authn.AuditEvent) string {
+	return fmt.Sprintf("%s-%016x-%s", auditPrefix(ev.Time), m.auditSeq.Inc(), ev.UserID)
+}
+
+func auditPrefix(t time.Time) string { return fmt.Sprintf("%020d", t.UnixNano()) }
+
+// record audit event; failures are logged and otherwise ignored
+func (m *mgr) audit(r *http.Request, ev *authn.AuditEvent) {
+	ev.Time = time.Now()
+	if r != nil {
+		ev.Addr = r.RemoteAddr
+	}
+	if err := m.db.Set(auditCollection, m.auditKey(ev), ev); err != nil {
+		nlog.Errorf("%s: failed to record audit event %+v: %v", m, ev, err)
+	}
+	if !ev.Success && Conf.Verbose() {
+		nlog.Warningf("%s: %s(%q) from %s failed: %s", m, ev.Kind, ev.UserID, ev.Addr, ev.Err)
+	}
+	if now := mono.NanoTime(); time.Duration(now-m.auditPruned.Load()) > auditPruneInterval {
+		m.auditPruned.Store(now)
+		go m.pruneAudit()
+	}
+}
+
+// record user or role change made by the requesting admin (see validateAdminPerms)
+func (m *mgr) auditChange(r *http.Request, kind, target string, err error) {
+	ev := &authn.AuditEvent{Kind: kind, Target: target, Success: err == nil}
+	if r != nil {
+		ev.UserID, _ = checkAdminPerms(r)
+	}
+	if err != nil {
+		ev.Err = err.Error()
+	}
+	m.audit(r, ev)
+}
+
+// returns (chronologically sorted) audit events:
+// - non-zero `since` limits the result to the events that occurred within the specified duration;
+// - non-zero `limit` further limits it to the most recent `limit` events
+func (m *mgr) auditList(since time.Duration, limit int) ([]*authn.AuditEvent, error) {
+	var (
+		events []*authn.AuditEvent
+		from   string
+	)
+	if since > 0 {
+		from = auditPrefix(time.Now().Add(-since))
+	}
+	err := m.db.Iterate(auditCollection, "", true /*desc*/, func(key, value string) bool {
+		if key < from {
+			return false
+		}
+		ev := &authn.AuditEvent{}
+		if err := jsoniter.Unmarshal([]byte(value), ev); err != nil {
+			nlog.Errorf("%s: failed to parse audit event %q: %v", m, key, err)
+			return true
+		}
+		events = append(events, ev)
+		return limit <= 0 || len(events) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(events)
+	return events, nil
+}
+
+func (m *mgr) pruneAudit() {
+	var (
+		keys  []string
+		upto  = auditPrefix(time.Now().Add(-auditRetention))
+		visit = func(key, _ string) bool {
+			if key >= upto {
+				return false
+			}
+			keys = append(keys, key)
+			return true
+		}
+	)
+	if err := m.db.Iterate(auditCollection, "", false, visit); err != nil {
+		nlog.Errorln(err)
+		return
+	}
+	for _, key := range keys {
+		m.db.Delete(auditCollection, key)
+	}
+}
+
+func (h *hserv) auditHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		cmn.WriteErr405(w, r, http.MethodGet)
+		return
+	}
+	if _, err := parseURL(w, r, 0, apc.URLPathAudit.L); err != nil {
+		return
+	}
+	if err := h.validateAdminPerms(w, r); err != nil {
+		return
+	}
+	var (
+		since time.Duration
+		limit int64
+		query = r.URL.Query()
+		err   error
+	)
+	if s := query.Get(apc.QparamSince); s != "" {
+		if since, err = time.ParseDuration(s); err != nil {
+			cmn.WriteErrMsg(w, r, fmt.Sprintf("invalid %s=%q: %v", apc.QparamSince, s, err))
+			return
+		}
+	}
+	if s := query.Get(apc.QparamLimit); s != "" {
+		if limit, err = strconv.ParseInt(s, 10, 64); err != nil || limit < 0 {
+			cmn.WriteErrMsg(w, r, fmt.Sprintf("invalid %s=%q", apc.QparamLimit, s))
+			return
+		}
+	}
+	events, err := h.mgr.auditList(since, int(limit))
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, events, "audit")
+}
@@ -7,6 +7,7 @@ package main
 import (
 	"net/http"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/jsp"
@@ -15,19 +16,27 @@ import (
 
 var Conf = &authn.Config{}
 
-func configHandler(w http.ResponseWriter, r *http.Request) {
+func (h *hserv) configHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
-		httpConfigGet(w, r)
+		h.httpConfigGet(w, r)
 	case http.MethodPut:
-		httpConfigPut(w, r)
+		apiItems, err := parseURL(w, r, 0, apc.URLPathDae.L)
+		if err != nil {
+			return
+		}
+		if len(apiItems) > 0 && apiItems[0] == apc.RotateKey {
+			h.httpRotateKey(w, r)
+			return
+		}
+		h.httpConfigPut(w, r)
 	default:
 		cmn.WriteErr405(w, r, http.MethodPut, http.MethodGet)
 	}
 }
 
-func httpConfigGet(w http.ResponseWriter, r *http.Request) {
-	if err := validateAdminPerms(w, r); err != nil {
+func (h *hserv) httpConfigGet(w http.ResponseWriter, r *http.Request) {
+	if err := h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 	Conf.RLock()
@@ -35,8 +44,8 @@ func httpConfigGet(w http.ResponseWriter, r *http.Request) {
 	Conf.RUnlock()
 }
 
-func httpConfigPut(w http.ResponseWriter, r *http.Request) {
-	if err := validateAdminPerms(w, r); err != nil {
+func (h *hserv) httpConfigPut(w http.ResponseWriter, r *http.Request) {
+	if err := h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 	updateCfg := &authn.ConfigToUpdate{}
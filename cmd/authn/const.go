@@ -17,9 +17,18 @@ const (
 	rolesCollection    = "role"
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
+	auditCollection    = "audit"
+	enrollCollection   = "enroll"
 
 	adminUserID   = "admin"
 	adminUserPass = "admin"
 
 	foreverTokenTime = 24 * 365 * 20 * time.Hour // kind of never-expired token
+
+	auditRetention     = 30 * 24 * time.Hour // audit events older than this are removed
+	auditPruneInterval = time.Hour
+
+	enrollTokenTime = time.Hour // default enrollment token lifetime
+	enrollTokenLen  = 32
+	secretLen       = 32
 )
@@ -0,0 +1,192 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Cluster enrollment and signing key rotation (see api/authn/enroll.go for the protocol)
+
+var errInvalidEnrollToken = errors.New("invalid or expired enrollment token")
+
+func (m *mgr) issueEnrollToken(expiresIn time.Duration) (*authn.EnrollToken, error) {
+	if expiresIn <= 0 {
+		expiresIn = enrollTokenTime
+	}
+	et := &authn.EnrollToken{
+		Token:   cos.CryptoRandS(enrollTokenLen),
+		Expires: time.Now().Add(expiresIn),
+	}
+	if err := m.db.Set(enrollCollection, et.Token, et); err != nil {
+		return nil, err
+	}
+	return et, nil
+}
+
+// consume one-time enrollment token, register (or re-register) the cluster,
+// and return the secret sealed with the cluster's public key
+func (m *mgr) enroll(msg *authn.EnrollMsg) (*authn.SealedSecret, error) {
+	if msg.Token == "" {
+		return nil, errInvalidEnrollToken
+	}
+	et := &authn.EnrollToken{}
+	if err := m.db.Get(enrollCollection, msg.Token, et); err != nil {
+		return nil, errInvalidEnrollToken
+	}
+	m.db.Delete(enrollCollection, msg.Token) // one-time
+	if et.Expires.Before(time.Now()) {
+		return nil, errInvalidEnrollToken
+	}
+	if msg.ClusterID == "" {
+		return nil, errors.New("cluster UUID is undefined")
+	}
+	if len(msg.URLs) == 0 {
+		return nil, fmt.Errorf("cluster %s: no URLs", msg.ClusterID)
+	}
+
+	clu := &authn.CluACL{ID: msg.ClusterID, Alias: msg.Alias, URLs: msg.URLs}
+	cid := m.cluLookup(clu.ID, clu.Alias)
+	switch {
+	case cid == "":
+		if err := m.db.Set(clustersCollection, clu.ID, clu); err != nil {
+			return nil, err
+		}
+		m.createRolesForCluster(clu)
+	case cid == clu.ID: // re-enrolling
+		if err := m.updateClusterRec(clu); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("alias %q is used for cluster %q", clu.Alias, cid)
+	}
+	return authn.SealSecret(msg.PubKey, Conf.Secret())
+}
+
+func (m *mgr) updateClusterRec(info *authn.CluACL) error {
+	clu := &authn.CluACL{}
+	if err := m.db.Get(clustersCollection, info.ID, clu); err != nil {
+		return err
+	}
+	if info.Alias != "" {
+		clu.Alias = info.Alias
+	}
+	clu.URLs = info.URLs
+	return m.db.Set(clustersCollection, clu.ID, clu)
+}
+
+// Generate new secret, keep the current one to validate not-yet-expired tokens,
+// and push the new secret (sealed with the current one) to all registered clusters.
+// Clusters that fail to receive the update must be re-enrolled.
+func (m *mgr) rotateSecret() error {
+	secret := cos.CryptoRandS(secretLen)
+
+	Conf.Lock()
+	prev, prevPrev := Conf.Server.Secret, Conf.Server.PrevSecret
+	Conf.Server.Secret, Conf.Server.PrevSecret = secret, prev
+	Conf.Unlock()
+
+	if err := jsp.SaveMeta(configPath, Conf, nil); err != nil {
+		Conf.Lock()
+		Conf.Server.Secret, Conf.Server.PrevSecret = prev, prevPrev
+		Conf.Unlock()
+		return err
+	}
+	sealed, err := authn.SealSecretSym(prev, secret)
+	if err != nil {
+		return err
+	}
+	go m.broadcast(http.MethodPut, apc.Tokens, cos.MustMarshal(sealed), "rotate-secret")
+	return nil
+}
+
+//
+// handlers
+//
+
+// [METHOD] /v1/enroll
+func (h *hserv) enrollHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.httpEnrollTokenPost(w, r)
+	case http.MethodPut:
+		h.httpEnroll(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodPost, http.MethodPut)
+	}
+}
+
+// (admin) issue enrollment token
+func (h *hserv) httpEnrollTokenPost(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathEnroll.L); err != nil {
+		return
+	}
+	if err := h.validateAdminPerms(w, r); err != nil {
+		return
+	}
+	msg := &authn.EnrollTokenMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	var expiresIn time.Duration
+	if msg.ExpiresIn != nil {
+		expiresIn = *msg.ExpiresIn
+	}
+	et, err := h.mgr.issueEnrollToken(expiresIn)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, et, "enroll-token")
+}
+
+// (cluster) enroll using one-time token
+func (h *hserv) httpEnroll(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathEnroll.L); err != nil {
+		return
+	}
+	msg := &authn.EnrollMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	ev := &authn.AuditEvent{Kind: authn.AuditEnroll, ClusterID: msg.ClusterID}
+	sealed, err := h.mgr.enroll(msg)
+	if err != nil {
+		ev.Err = err.Error()
+		h.mgr.audit(r, ev)
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	ev.Success = true
+	h.mgr.audit(r, ev)
+	nlog.Infoln("enrolled cluster", msg.ClusterID, msg.Alias, msg.URLs)
+	writeJSON(w, sealed, "enroll")
+}
+
+// (admin) PUT /v1/daemon/rotate-key
+func (h *hserv) httpRotateKey(w http.ResponseWriter, r *http.Request) {
+	if err := h.validateAdminPerms(w, r); err != nil {
+		return
+	}
+	ev := &authn.AuditEvent{Kind: authn.AuditRotateKey}
+	if err := h.mgr.rotateSecret(); err != nil {
+		ev.Err = err.Error()
+		h.mgr.audit(r, ev)
+		cmn.WriteErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	ev.Success = true
+	h.mgr.audit(r, ev)
+}
@@ -85,7 +85,9 @@ func (h *hserv) registerPublicHandlers() {
 	h.registerHandler(apc.URLPathTokens.S, h.tokenHandler)
 	h.registerHandler(apc.URLPathClusters.S, h.clusterHandler)
 	h.registerHandler(apc.URLPathRoles.S, h.roleHandler)
-	h.registerHandler(apc.URLPathDae.S, configHandler)
+	h.registerHandler(apc.URLPathDae.S, h.configHandler)
+	h.registerHandler(apc.URLPathAudit.S, h.auditHandler)
+	h.registerHandler(apc.URLPathEnroll.S, h.enrollHandler)
 }
 
 func (h *hserv) userHandler(w http.ResponseWriter, r *http.Request) {
@@ -140,12 +142,15 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErrMsg(w, r, "empty token")
 		return
 	}
-	secret := Conf.Secret()
-	if _, err := tok.DecryptToken(msg.Token, secret); err != nil {
+	secret, prev := Conf.Secrets()
+	tk, err := tok.DecryptToken(msg.Token, secret, prev)
+	if err != nil {
+		h.mgr.audit(r, &authn.AuditEvent{Kind: authn.AuditRevokeToken, Err: err.Error()})
 		cmn.WriteErr(w, r, err)
 		return
 	}
 	h.mgr.revokeToken(msg.Token)
+	h.mgr.audit(r, &authn.AuditEvent{Kind: authn.AuditRevokeToken, UserID: tk.UserID, Success: true})
 }
 
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
@@ -153,10 +158,12 @@ func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
+	if err = h.validateAdminPerms(w, r); err != nil {
 		return
 	}
-	if err := h.mgr.delUser(apiItems[0]); err != nil {
+	err = h.mgr.delUser(apiItems[0])
+	h.mgr.auditChange(r, authn.AuditDelUser, apiItems[0], err)
+	if err != nil {
 		nlog.Errorf("Failed to delete user: %v\n", err)
 		cmn.WriteErrMsg(w, r, "Failed to delete user: "+err.Error())
 	}
@@ -180,7 +187,7 @@ func (h *hserv) httpUserPut(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
+	if err = h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 	var (
@@ -195,7 +202,9 @@ func (h *hserv) httpUserPut(w http.ResponseWriter, r *http.Request) {
 	if Conf.Verbose() {
 		nlog.Infof("PUT user %q", userID)
 	}
-	if err := h.mgr.updateUser(userID, updateReq); err != nil {
+	err = h.mgr.updateUser(userID, updateReq)
+	h.mgr.auditChange(r, authn.AuditUpdateUser, userID, err)
+	if err != nil {
 		cmn.WriteErr(w, r, err)
 		return
 	}
@@ -203,14 +212,16 @@ func (h *hserv) httpUserPut(w http.ResponseWriter, r *http.Request) {
 
 // Adds h new user to user list
 func (h *hserv) userAdd(w http.ResponseWriter, r *http.Request) {
-	if err := validateAdminPerms(w, r); err != nil {
+	if err := h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 	info := &authn.User{}
 	if err := cmn.ReadJSON(w, r, info); err != nil {
 		return
 	}
-	if err := h.mgr.addUser(info); err != nil {
+	err := h.mgr.addUser(info)
+	h.mgr.auditChange(r, authn.AuditAddUser, info.ID, err)
+	if err != nil {
 		cmn.WriteErrMsg(w, r, fmt.Sprintf("Failed to add user: %v", err), http.StatusInternalServerError)
 		return
 	}
@@ -264,29 +275,33 @@ func (h *hserv) httpUserGet(w http.ResponseWriter, r *http.Request) {
 
 // Checks if the request header contains valid admin credentials.
 // (admin is created at deployment time and cannot be modified via API)
-func validateAdminPerms(w http.ResponseWriter, r *http.Request) error {
-	token, err := tok.ExtractToken(r.Header)
+// Denials are recorded in the audit log.
+func (h *hserv) validateAdminPerms(w http.ResponseWriter, r *http.Request) error {
+	userID, err := checkAdminPerms(r)
 	if err != nil {
+		h.mgr.audit(r, &authn.AuditEvent{Kind: authn.AuditPermDenied, UserID: userID, Err: err.Error()})
 		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
-		return err
 	}
-	secret := Conf.Secret()
-	tk, err := tok.DecryptToken(token, secret)
+	return err
+}
+
+func checkAdminPerms(r *http.Request) (userID string, err error) {
+	token, err := tok.ExtractToken(r.Header)
 	if err != nil {
-		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
-		return err
+		return "", err
+	}
+	secret, prev := Conf.Secrets()
+	tk, err := tok.DecryptToken(token, secret, prev)
+	if err != nil {
+		return "", err
 	}
 	if tk.Expires.Before(time.Now()) {
-		err := fmt.Errorf("not authorized: %s", tk)
-		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
-		return err
+		return tk.UserID, fmt.Errorf("not authorized: %s", tk)
 	}
 	if !tk.IsAdmin {
-		err := fmt.Errorf("not authorized: requires admin (%s)", tk)
-		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
-		return err
+		return tk.UserID, fmt.Errorf("not authorized: requires admin (%s)", tk)
 	}
-	return nil
+	return tk.UserID, nil
 }
 
 // Generate h token for h user if provided credentials are valid.
@@ -302,19 +317,26 @@ func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 	if err = cmn.ReadJSON(w, r, msg); err != nil {
 		return
 	}
+	userID := apiItems[0]
+	ev := &authn.AuditEvent{Kind: authn.AuditLogin, UserID: userID, ClusterID: msg.ClusterID}
 	if msg.Password == "" {
+		ev.Err = "empty password"
+		h.mgr.audit(r, ev)
 		cmn.WriteErrMsg(w, r, "Not authorized", http.StatusUnauthorized)
 		return
 	}
-	userID := apiItems[0]
 	pass := msg.Password
 
 	tokenString, err := h.mgr.issueToken(userID, pass, msg)
 	if err != nil {
+		ev.Err = err.Error()
+		h.mgr.audit(r, ev)
 		nlog.Errorf("Failed to generate token for user %q: %v\n", userID, err)
 		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
 		return
 	}
+	ev.Success = true
+	h.mgr.audit(r, ev)
 
 	repl := fmt.Sprintf(`{"token": %q}`, tokenString)
 	writeBytes(w, []byte(repl), "auth")
@@ -342,7 +364,7 @@ func (h *hserv) httpSrvPost(w http.ResponseWriter, r *http.Request) {
 	if _, err := parseURL(w, r, 0, apc.URLPathClusters.L); err != nil {
 		return
 	}
-	if err := validateAdminPerms(w, r); err != nil {
+	if err := h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 	cluConf := &authn.CluACL{}
@@ -359,7 +381,7 @@ func (h *hserv) httpSrvPut(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err := validateAdminPerms(w, r); err != nil {
+	if err := h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 	cluConf := &authn.CluACL{}
@@ -377,7 +399,7 @@ func (h *hserv) httpSrvDelete(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
+	if err = h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 
@@ -483,12 +505,14 @@ func (h *hserv) httpRoleDel(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
+	if err = h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 
 	roleID := apiItems[0]
-	if err = h.mgr.delRole(roleID); err != nil {
+	err = h.mgr.delRole(roleID)
+	h.mgr.auditChange(r, authn.AuditDelRole, roleID, err)
+	if err != nil {
 		cmn.WriteErr(w, r, err)
 	}
 }
@@ -498,14 +522,16 @@ func (h *hserv) httpRolePost(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
+	if err = h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 	info := &authn.Role{}
 	if err := cmn.ReadJSON(w, r, info); err != nil {
 		return
 	}
-	if err := h.mgr.addRole(info); err != nil {
+	err = h.mgr.addRole(info)
+	h.mgr.auditChange(r, authn.AuditAddRole, info.ID, err)
+	if err != nil {
 		cmn.WriteErrMsg(w, r, fmt.Sprintf("Failed to add role: %v", err), http.StatusInternalServerError)
 	}
 }
@@ -515,7 +541,7 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
+	if err = h.validateAdminPerms(w, r); err != nil {
 		return
 	}
 
@@ -529,7 +555,9 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 	if Conf.Verbose() {
 		nlog.Infof("PUT role %q\n", role)
 	}
-	if err := h.mgr.updateRole(role, updateReq); err != nil {
+	err = h.mgr.updateRole(role, updateReq)
+	h.mgr.auditChange(r, authn.AuditUpdateRole, role, err)
+	if err != nil {
 		if cos.IsErrNotFound(err) {
 			cmn.WriteErr(w, r, err, http.StatusNotFound)
 		} else {
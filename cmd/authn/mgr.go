@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
@@ -24,9 +25,11 @@ import (
 )
 
 type mgr struct {
-	clientH   *http.Client
-	clientTLS *http.Client
-	db        kvdb.Driver
+	clientH     *http.Client
+	clientTLS   *http.Client
+	db          kvdb.Driver
+	auditPruned atomic.Int64 // mono-time of the last audit log cleanup
+	auditSeq    atomic.Int64 // (disambiguates audit events that happen to have the same timestamp)
 }
 
 var (
@@ -474,9 +477,9 @@ func (m *mgr) generateRevokedTokenList() ([]string, error) {
 
 	now := time.Now()
 	revokeList := make([]string, 0, len(tokens))
-	secret := Conf.Secret()
+	secret, prev := Conf.Secrets()
 	for _, token := range tokens {
-		tk, err := tok.DecryptToken(token, secret)
+		tk, err := tok.DecryptToken(token, secret, prev)
 		if err != nil {
 			m.db.Delete(revokedCollection, token)
 			continue
@@ -70,13 +70,14 @@ func ExtractToken(hdr http.Header) (string, error) {
 	return s[idx+1:], nil
 }
 
-func DecryptToken(tokenStr, secret string) (*Token, error) {
-	jwtToken, err := jwt.Parse(tokenStr, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
-		}
-		return []byte(secret), nil
-	})
+// DecryptToken validates the token against the current `secret` and, in case
+// of signature mismatch, against the previous one (if defined) - the latter
+// to keep user tokens valid across signing key rotation.
+func DecryptToken(tokenStr, secret string, prevSecret ...string) (*Token, error) {
+	jwtToken, err := parse(tokenStr, secret)
+	if err != nil && len(prevSecret) > 0 && prevSecret[0] != "" && isSignatureErr(err) {
+		jwtToken, err = parse(tokenStr, prevSecret[0])
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +92,20 @@ func DecryptToken(tokenStr, secret string) (*Token, error) {
 	return tk, nil
 }
 
+func parse(tokenStr, secret string) (*jwt.Token, error) {
+	return jwt.Parse(tokenStr, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+}
+
+func isSignatureErr(err error) bool {
+	var verr *jwt.ValidationError
+	return errors.As(err, &verr) && verr.Errors&jwt.ValidationErrorSignatureInvalid != 0
+}
+
 ///////////
 // Token //
 ///////////
@@ -5,6 +5,8 @@
 package main
 
 import (
+	"crypto/ecdh"
+	"crypto/rand"
 	"testing"
 	"time"
 
@@ -193,6 +195,125 @@ func TestToken(t *testing.T) {
 	}
 }
 
+func TestAudit(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	mgr.audit(nil, &authn.AuditEvent{Kind: authn.AuditLogin, UserID: users[0], Err: errInvalidCredentials.Error()})
+	mgr.audit(nil, &authn.AuditEvent{Kind: authn.AuditLogin, UserID: users[1], Success: true})
+	mgr.audit(nil, &authn.AuditEvent{Kind: authn.AuditPermDenied, UserID: users[1]})
+
+	events, err := mgr.auditList(0, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(events) == 3, "expected 3 audit events, got %d", len(events))
+	for i := 1; i < len(events); i++ {
+		tassert.Errorf(t, !events[i].Time.Before(events[i-1].Time), "expected chronological order: %+v", events)
+	}
+	tassert.Errorf(t, events[0].UserID == users[0] && !events[0].Success, "unexpected first event %+v", events[0])
+	tassert.Errorf(t, events[2].Kind == authn.AuditPermDenied, "unexpected last event %+v", events[2])
+
+	// too old to be included
+	old := &authn.AuditEvent{Time: time.Now().Add(-2 * time.Hour), Kind: authn.AuditLogin, UserID: users[2]}
+	tassert.CheckFatal(t, mgr.db.Set(auditCollection, mgr.auditKey(old), old))
+	events, err = mgr.auditList(time.Hour, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(events) == 3, "expected 3 recent audit events, got %d", len(events))
+
+	// same user, same timestamp
+	now := time.Now()
+	for _, kind := range []string{authn.AuditLogin, authn.AuditPermDenied} {
+		ev := &authn.AuditEvent{Time: now, Kind: kind, UserID: users[2]}
+		tassert.CheckFatal(t, mgr.db.Set(auditCollection, mgr.auditKey(ev), ev))
+	}
+	events, err = mgr.auditList(time.Hour, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(events) == 5, "expected 5 recent audit events, got %d", len(events))
+	tassert.Errorf(t, events[3].Kind == authn.AuditLogin && events[4].Kind == authn.AuditPermDenied,
+		"expected simultaneous events in the recorded order: %+v, %+v", events[3], events[4])
+
+	// user and role changes; most recent
+	mgr.auditChange(nil, authn.AuditAddRole, "role", nil)
+	mgr.auditChange(nil, authn.AuditDelUser, users[0], errInvalidCredentials)
+	events, err = mgr.auditList(0, 2)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(events) == 2, "expected 2 most recent audit events, got %d", len(events))
+	tassert.Errorf(t, events[0].Kind == authn.AuditAddRole && events[0].Target == "role" && events[0].Success,
+		"unexpected event %+v", events[0])
+	tassert.Errorf(t, events[1].Kind == authn.AuditDelUser && events[1].Target == users[0] && !events[1].Success,
+		"unexpected event %+v", events[1])
+
+	// prune
+	ancient := &authn.AuditEvent{Time: time.Now().Add(-auditRetention - time.Hour), Kind: authn.AuditLogin}
+	tassert.CheckFatal(t, mgr.db.Set(auditCollection, mgr.auditKey(ancient), ancient))
+	events, err = mgr.auditList(0, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(events) == 9, "expected 9 audit events, got %d", len(events))
+	mgr.pruneAudit()
+	events, err = mgr.auditList(0, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(events) == 8, "expected 8 audit events after pruning, got %d", len(events))
+}
+
+func TestEnroll(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	et, err := mgr.issueEnrollToken(0)
+	tassert.CheckFatal(t, err)
+	priv, err := ecdh.X25519().GenerateKey(rand.Reader)
+	tassert.CheckFatal(t, err)
+	msg := &authn.EnrollMsg{
+		Token:     et.Token,
+		ClusterID: "ABCD",
+		Alias:     "cluster-test",
+		URLs:      []string{"http://localhost:8080"},
+		PubKey:    priv.PublicKey().Bytes(),
+	}
+	sealed, err := mgr.enroll(msg)
+	tassert.CheckFatal(t, err)
+	secret, err := sealed.Open(priv)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, secret == Conf.Secret(), "unsealed secret mismatch")
+
+	clu, err := mgr.getCluster(msg.Alias)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, clu.ID == msg.ClusterID, "expected cluster %q, got %+v", msg.ClusterID, clu)
+
+	// one-time
+	_, err = mgr.enroll(msg)
+	tassert.Errorf(t, err == errInvalidEnrollToken, "expected %v, got %v", errInvalidEnrollToken, err)
+
+	// expired
+	et, err = mgr.issueEnrollToken(time.Millisecond)
+	tassert.CheckFatal(t, err)
+	time.Sleep(2 * time.Millisecond)
+	msg.Token = et.Token
+	_, err = mgr.enroll(msg)
+	tassert.Errorf(t, err == errInvalidEnrollToken, "expected %v, got %v", errInvalidEnrollToken, err)
+}
+
+func TestRotatedSecret(t *testing.T) {
+	const prev, secret = "prev-secret", "new-secret"
+	token, err := tok.IssueAdminJWT(time.Now().Add(time.Hour), adminUserID, prev)
+	tassert.CheckFatal(t, err)
+
+	_, err = tok.DecryptToken(token, secret)
+	tassert.Errorf(t, err != nil, "expected signature mismatch")
+	tk, err := tok.DecryptToken(token, secret, prev)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.UserID == adminUserID, "unexpected token %s", tk)
+
+	sealed, err := authn.SealSecretSym(prev, secret)
+	tassert.CheckFatal(t, err)
+	s, err := sealed.OpenSym(prev)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, s == secret, "unsealed secret mismatch")
+	_, err = sealed.OpenSym(secret)
+	tassert.Errorf(t, err != nil, "expected failure to open with a wrong key")
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
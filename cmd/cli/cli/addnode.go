@@ -0,0 +1,182 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles 'ais cluster add-node'.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+// scale-out helper: validate, join, and (optionally) wait for the resulting rebalance
+// - validation is done prior to joining any of the nodes - all or nothing (unless '--force');
+// - each node is contacted directly (it does not need to be a cluster member) to check:
+//   software version (vs the gateway this CLI is talking to), clock skew, and (targets) mountpaths;
+// - when multiple nodes are joining the last triggered rebalance is the one to wait for
+//   (a new rebalance aborts the one that's running)
+
+// max clock skew: aisnode's own (see ais/selftest) plus one second resolution of the "Date" header
+const addNodeMaxSkew = 3 * time.Second
+
+type addNodeCtx struct {
+	refStatus *stats.NodeStatus
+	smap      *meta.Smap
+	refSkew   time.Duration // gateway's clock vs local
+}
+
+type joiningNode struct {
+	addr string
+	role string
+}
+
+func addNodeHandler(c *cli.Context) error {
+	var (
+		nodes []*joiningNode
+		ctx   addNodeCtx
+		err   error
+	)
+	if c.NArg() > 0 {
+		return incorrectUsageMsg(c, "unexpected argument %q (use %s and/or %s to specify nodes)",
+			c.Args().Get(0), qflprn(addNodeTargetFlag), qflprn(addNodeProxyFlag))
+	}
+	for _, role := range []string{apc.Target, apc.Proxy} {
+		flag := addNodeTargetFlag
+		if role == apc.Proxy {
+			flag = addNodeProxyFlag
+		}
+		if !flagIsSet(c, flag) {
+			continue
+		}
+		for _, addr := range splitCsv(parseStrFlag(c, flag)) {
+			if addr != "" {
+				nodes = append(nodes, &joiningNode{addr: addr, role: role})
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		return missingArgumentsError(c, flprn(addNodeTargetFlag)+" or "+flprn(addNodeProxyFlag))
+	}
+
+	// 1. validate all
+	if ctx.smap, err = getClusterMap(c); err != nil {
+		return err
+	}
+	ctx.refStatus, ctx.refSkew, err = _nodeStatus(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	var errs []error
+	for _, nd := range nodes {
+		if err := ctx.validate(nd); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		if !flagIsSet(c, forceFlag) {
+			return fmt.Errorf("%w\n(use %s to join anyway)", errors.Join(errs...), qflprn(forceFlag))
+		}
+		for _, err := range errs {
+			actionWarn(c, err.Error())
+		}
+	}
+
+	// 2. join
+	var rebID string
+	for _, nd := range nodes {
+		sname, xid, err := joinNode(c, nd.addr, nd.role)
+		if err != nil {
+			return fmt.Errorf("failed to join %s %s: %v", nd.role, nd.addr, V(err))
+		}
+		fmt.Fprintf(c.App.Writer, "%s successfully joined the cluster\n", sname)
+		if xid != "" {
+			rebID = xid
+		}
+	}
+	if rebID == "" {
+		return nil
+	}
+
+	// 3. rebalance: wait with or without progress
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) && !flagIsSet(c, progressFlag) {
+		fmt.Fprintf(c.App.Writer, fmtRebalanceStarted, rebID)
+		return nil
+	}
+	xargs := xact.ArgsMsg{ID: rebID, Kind: apc.ActRebalance, Timeout: -1 /*long*/}
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	msg := "Waiting for " + xactCname(apc.ActRebalance, rebID)
+	if flagIsSet(c, progressFlag) {
+		fmt.Fprintln(c.App.Writer, msg+" ...")
+		return xactProgress(c, &xargs, 0, 0)
+	}
+	fmt.Fprint(c.App.Writer, msg+" ...")
+	if err := api.WaitForXactionProgress(context.Background(), apiBP, &xargs, _refreshRate(c), nil); err != nil {
+		fmt.Fprintln(c.App.Writer)
+		return V(err)
+	}
+	actionDone(c, fmtXactSucceeded)
+	return nil
+}
+
+func (ctx *addNodeCtx) validate(nd *joiningNode) error {
+	bp := apiBP
+	bp.URL = getPrefixFromPrimary() + nd.addr
+	status, skew, err := _nodeStatus(bp)
+	if err != nil {
+		return fmt.Errorf("%s %s: failed to connect: %v", nd.role, nd.addr, err)
+	}
+
+	si := status.Snode
+	if si == nil {
+		return fmt.Errorf("%s %s: failed to get node info", nd.role, nd.addr)
+	}
+	sname := si.StringEx()
+	switch {
+	case si.Type() != nd.role:
+		return fmt.Errorf("%s at %s is not a %s", sname, nd.addr, nd.role)
+	case ctx.smap.GetNode(si.ID()) != nil:
+		return fmt.Errorf("%s (%s) is already a cluster member", sname, nd.addr)
+	case status.Version != ctx.refStatus.Version:
+		return fmt.Errorf("%s: software version %s does not match cluster version %s",
+			sname, status.Version, ctx.refStatus.Version)
+	}
+	if d := skew - ctx.refSkew; d > addNodeMaxSkew || d < -addNodeMaxSkew {
+		return fmt.Errorf("%s: clock skew vs cluster is about %v (max allowed %v) - synchronize the clocks (NTP) of all nodes",
+			sname, d.Round(time.Second), addNodeMaxSkew)
+	}
+	if nd.role == apc.Target {
+		if len(status.TargetCDF.Mountpaths) == 0 {
+			return fmt.Errorf("%s: no available mountpaths", sname)
+		}
+		if status.TargetCDF.CsErr != "" {
+			return fmt.Errorf("%s: %s", sname, status.TargetCDF.CsErr)
+		}
+	}
+	return nil
+}
+
+// returns node's status and its (approximate) clock skew vs local
+func _nodeStatus(bp api.BaseParams) (*stats.NodeStatus, time.Duration, error) {
+	started := time.Now()
+	status, nodeTime, err := api.GetNodeStatus(bp)
+	if err != nil {
+		return nil, 0, err
+	}
+	if nodeTime.IsZero() {
+		return status, 0, nil
+	}
+	mid := started.Add(time.Since(started) / 2)
+	return status, nodeTime.Sub(mid.Truncate(time.Second)), nil
+}
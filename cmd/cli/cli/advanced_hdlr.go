@@ -7,10 +7,16 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
@@ -58,6 +64,25 @@ var (
 				Action:       rotateLogs,
 				BashComplete: suggestAllNodes,
 			},
+			{
+				Name: cmdWhy,
+				Usage: "explain object placement: show HRW scores of all targets and mountpaths for a given object,\n" +
+					indent4 + "\twhich target (and mountpath) currently stores it, and whether it is misplaced",
+				ArgsUsage:    objectArgument,
+				Action:       whyHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
+			{
+				Name: cmdProfile,
+				Usage: "download a given node's runtime profile (requires admin access), e.g.:\n" +
+					indent4 + "\t - 'ais advanced profile t[abc] --cpu 30s --out prof.pb.gz' - sample CPU for 30s;\n" +
+					indent4 + "\t - 'ais advanced profile p[xyz] --heap' - heap profile, saved as p[xyz]-heap.pb.gz;\n" +
+					indent4 + "\t   (to view: 'go tool pprof -http=:6060 prof.pb.gz')",
+				ArgsUsage:    nodeIDArgument,
+				Flags:        []cli.Flag{profCPUFlag, profHeapFlag, profGoroutineFlag, profMutexFlag, profBlockFlag, profOutFlag, yesFlag},
+				Action:       profileHandler,
+				BashComplete: suggestAllNodes,
+			},
 		},
 	}
 )
@@ -163,3 +188,227 @@ func rotateLogs(c *cli.Context) error {
 	actionDone(c, "cluster: rotated all logs")
 	return nil
 }
+
+//
+// why (explain object placement)
+//
+
+func whyHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	// placement depends on cluster config (capacity weights, partitions) - see core/meta/hrw.go
+	config, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	cmn.Rom.Set(config)
+
+	uname := bck.MakeUname(objName)
+	sis, scores := smap.HrwScores(uname)
+	if len(sis) == 0 {
+		return cmn.NewErrNoNodes(apc.Target, smap.CountTargets())
+	}
+
+	// where is it now? (ask each target directly)
+	var (
+		locs = make(map[string]string, 2) // target ID => mountpath
+		errs = make(map[string]error, 2)
+	)
+	for _, tsi := range sis {
+		bp := apiBP
+		bp.URL = tsi.URL(cmn.NetPublic)
+		op, err := api.HeadObject(bp, bck, objName, apc.FltPresent, true /*silent*/)
+		switch {
+		case err == nil:
+			locs[tsi.ID()] = locMpath(op.Location)
+		case !cmn.IsStatusNotFound(err):
+			errs[tsi.ID()] = err
+		}
+	}
+
+	// targets
+	s := "Placement: HRW"
+	if config.Rebalance.CapWeights {
+		s += ", capacity-weighted"
+	}
+	if n := config.Rebalance.Partitions; n > 0 {
+		s += fmt.Sprintf(", %d partitions", n)
+	}
+	fmt.Fprintln(c.App.Writer, s)
+	fmt.Fprintln(c.App.Writer)
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\tHRW SCORE\tSTORES OBJECT")
+	for i, tsi := range sis {
+		name := tsi.StringEx()
+		if i == 0 {
+			name += " (designated)"
+		}
+		stores := "no"
+		if mpath, ok := locs[tsi.ID()]; ok {
+			stores = "yes: " + mpath
+		} else if err, ok := errs[tsi.ID()]; ok {
+			stores = "unknown: " + err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%016x\t%s\n", name, scores[i], stores)
+	}
+	tw.Flush()
+
+	// mountpaths: designated target and all other targets that store the object
+	var (
+		tsi        = sis[0]
+		hrwMpath   string
+		mpathsShow = []*meta.Snode{tsi}
+	)
+	for _, si := range sis[1:] {
+		if _, ok := locs[si.ID()]; ok {
+			mpathsShow = append(mpathsShow, si)
+		}
+	}
+	for _, si := range mpathsShow {
+		mpl, err := api.GetMountpaths(apiBP, si)
+		if err != nil {
+			return V(err)
+		}
+		hrw := whyMpaths(c, si, mpl.Available, uname)
+		if si.ID() == tsi.ID() {
+			hrwMpath = hrw
+		}
+	}
+
+	// verdict
+	fmt.Fprintln(c.App.Writer)
+	mpath, ok := locs[tsi.ID()]
+	switch {
+	case len(locs) == 0:
+		s = fmt.Sprintf("%s is not present in the cluster (expected location: %s at %s)", bck.Cname(objName), tsi.StringEx(), hrwMpath)
+	case ok && mpath == hrwMpath:
+		s = fmt.Sprintf("%s is properly located (%s at %s)", bck.Cname(objName), tsi.StringEx(), mpath)
+	case ok:
+		s = fmt.Sprintf("%s is misplaced: stored at %s (expected %s) - resilver to fix", bck.Cname(objName), mpath, hrwMpath)
+	default:
+		others := make([]string, 0, len(locs))
+		for tid := range locs {
+			others = append(others, meta.Tname(tid))
+		}
+		sort.Strings(others)
+		s = fmt.Sprintf("%s is misplaced: stored by %s (expected %s) - rebalance to fix", bck.Cname(objName),
+			strings.Join(others, ", "), tsi.StringEx())
+	}
+	if ok && len(locs) > 1 {
+		s += fmt.Sprintf("; note: %d other target(s) store (misplaced) copies", len(locs)-1)
+	}
+	fmt.Fprintln(c.App.Writer, s)
+	return nil
+}
+
+// print mountpath HRW scores (descending); return the designated mountpath
+func whyMpaths(c *cli.Context, tsi *meta.Snode, mpaths []string, uname string) string {
+	scores := make(map[string]uint64, len(mpaths))
+	for _, mpath := range mpaths {
+		scores[mpath] = fs.HrwScore(mpath, uname)
+	}
+	sort.Slice(mpaths, func(i, j int) bool { return scores[mpaths[i]] > scores[mpaths[j]] })
+
+	fmt.Fprintln(c.App.Writer)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s MOUNTPATH\tHRW SCORE\n", tsi.StringEx())
+	for i, mpath := range mpaths {
+		name := mpath
+		if i == 0 {
+			name += " (designated)"
+		}
+		fmt.Fprintf(tw, "%s\t%016x\n", name, scores[mpath])
+	}
+	tw.Flush()
+	if len(mpaths) == 0 {
+		return ""
+	}
+	return mpaths[0]
+}
+
+// object location (see core.LOM.Location): "t[ID]:mp[/path, disks]" => "/path"
+func locMpath(loc string) string {
+	i := strings.Index(loc, "mp[")
+	if i < 0 {
+		return loc
+	}
+	mpath := loc[i+3:]
+	if j := strings.IndexAny(mpath, ",]"); j >= 0 {
+		mpath = mpath[:j]
+	}
+	return mpath
+}
+
+//
+// profile (runtime, pprof format)
+//
+
+func profileHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	node, sname, err := getNode(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	args := api.GetProfileInput{}
+	for _, flag := range []cli.Flag{profCPUFlag, profHeapFlag, profGoroutineFlag, profMutexFlag, profBlockFlag} {
+		if !flagIsSet(c, flag) {
+			continue
+		}
+		if args.Kind != "" {
+			return incorrectUsageMsg(c, "%s and %s are mutually exclusive", qflprn(flag), "--"+args.Kind)
+		}
+		args.Kind = flag.GetName()
+		if df, ok := flag.(DurationFlag); ok {
+			args.Duration = parseDurationFlag(c, df)
+		}
+	}
+	if args.Kind == "" {
+		return missingArgumentsError(c, "one of: "+strings.Join(apc.SupportedProfiles, ", "))
+	}
+
+	outFile := parseStrFlag(c, profOutFlag)
+	if outFile == "" {
+		outFile = node.ID() + "-" + args.Kind + ".pb.gz"
+	}
+	if _, err := os.Stat(outFile); err == nil && !flagIsSet(c, yesFlag) {
+		if !confirm(c, fmt.Sprintf("Destination %q exists. Overwrite?", outFile)) {
+			return nil
+		}
+	}
+	file, err := os.Create(outFile)
+	if err != nil {
+		return err
+	}
+	if args.Duration > 0 {
+		fmt.Fprintf(c.App.Writer, "Sampling %s %s profile for %v...\n", sname, args.Kind, args.Duration)
+	}
+	args.Writer = file
+	_, err = api.GetDaemonProfile(apiBP, node, args)
+	file.Close()
+	if err != nil {
+		os.Remove(outFile)
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("%s %s profile saved as %s", sname, args.Kind, outFile))
+	return nil
+}
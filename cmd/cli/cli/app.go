@@ -96,7 +96,11 @@ func Run(version, buildtime string, args []string) error {
 
 	teb.Init(os.Stdout, cfg.NoColor)
 
-	// run
+	return a.run(args)
+}
+
+// run once or, if requested (see longRun), repeatedly
+func (a *acli) run(args []string) error {
 	if err := a.runOnce(args); err != nil {
 		return err
 	}
@@ -123,17 +127,20 @@ func (a *acli) runOnce(args []string) error {
 			fmt.Fprint(a.app.Writer, ". ")
 			if err = a.app.Run(args); err == nil {
 				fmt.Fprintln(a.app.Writer)
-				break
+				return nil
 			}
 		}
 	}
-	return formatErr(err)
+	return &errExit{err: formatErr(err), code: exitCode(err)}
 }
 
 func (a *acli) runForever(args []string) error {
 	rate := a.longRun.refreshRate
 	for {
 		time.Sleep(rate)
+		if shellInterrupted() {
+			return nil
+		}
 		printLongRunFooter(a.outWriter, a.longRun.lfooter)
 		if err := a.runOnce(args); err != nil {
 			return err
@@ -154,6 +161,9 @@ func (a *acli) runN(args []string) error {
 	fmt.Fprintln(a.outWriter, delim)
 	for i := 2; i <= a.longRun.count; i++ {
 		time.Sleep(a.longRun.refreshRate)
+		if shellInterrupted() {
+			return nil
+		}
 		if err := a.runOnce(args); err != nil {
 			return err
 		}
@@ -184,7 +194,7 @@ func (a *acli) init(version string) {
 	app.Version = version
 	app.EnableBashCompletion = true
 	app.HideHelp = true
-	app.Flags = []cli.Flag{cli.HelpFlag}
+	app.Flags = []cli.Flag{cli.HelpFlag, globalYesFlag, quietFlag}
 	app.CommandNotFound = commandNotFoundHandler
 	app.OnUsageError = onUsageErrorHandler
 	app.Metadata = map[string]any{metadata: a.longRun}
@@ -213,10 +223,13 @@ func (a *acli) setupCommands() {
 		advancedCmd,
 		storageCmd,
 		archCmd,
+		modelCmd,
+		viewCmd,
 		logCmd,
 		perfCmd,
 		remClusterCmd,
 		a.getAliasCmd(),
+		a.getShellCmd(),
 	}
 
 	if k8sDetected {
@@ -275,7 +288,10 @@ func commandNotFoundHandler(c *cli.Context, cmd string) {
 	}
 	err := commandNotFoundError(c, cmd)
 	fmt.Fprint(c.App.ErrWriter, err)
-	os.Exit(1)
+	if inShell() {
+		return // (keep going)
+	}
+	os.Exit(ExitUsage)
 }
 
 func onUsageErrorHandler(c *cli.Context, err error, _ bool) error {
@@ -121,7 +121,8 @@ var (
 			indent4 + "\t- ais archive get ais://abc/trunk-0123.tar.lz4 /tmp/out - extract entire shard to /tmp/out/trunk...\n" +
 			indent4 + "\t- ais archive get ais://abc/trunk-0123.tar.lz4/file456 /tmp/out - extract one named file\n" +
 			indent4 + "\t- ais archive get ais://abc/trunk-0123.tar.lz4 --archpath file456 /tmp/out - same as above\n" +
-			indent4 + "\t- ais archive get ais://abc/trunk-0123.tar.lz4/file456 /tmp/out/file456.new - same as above w/ rename",
+			indent4 + "\t- ais archive get ais://abc/trunk-0123.tar.lz4/file456 /tmp/out/file456.new - same as above w/ rename\n" +
+			indent4 + "\t- ais archive get ais://abc/trunk-0123.tar.lz4 --archglob '*.jpg' /tmp/out - extract all matching files to /tmp/out/trunk-0123/",
 		ArgsUsage:    getShardArgument,
 		Flags:        rmFlags(objectCmdGet.Flags, headObjPresentFlag, lengthFlag, offsetFlag),
 		Action:       getArchHandler,
@@ -38,6 +38,8 @@ const (
 	flagsAuthRevokeToken = "revoke_token"
 	flagsAuthRoleShow    = "role_show"
 	flagsAuthConfShow    = "conf_show"
+	flagsAuthAudit       = "audit"
+	flagsAuthEnrollToken = "enroll_token"
 )
 
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
@@ -52,6 +54,54 @@ var (
 		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
 		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
 		flagsAuthConfShow:    {jsonFlag},
+		flagsAuthAudit:       {auditSinceFlag, auditLimitFlag, jsonFlag, noHeaderFlag},
+		flagsAuthEnrollToken: {enrollExpireFlag, jsonFlag},
+	}
+
+	// roles: defined separately to be used both ways - verb first (e.g., 'ais auth add role')
+	// and noun first (e.g., 'ais auth role add'); see authCmdRole below
+	authCmdRoleShow = cli.Command{
+		Name:         cmdAuthRole,
+		Usage:        "show existing AuthN roles",
+		ArgsUsage:    showAuthRoleArgument,
+		Flags:        authFlags[flagsAuthRoleShow],
+		Action:       wrapAuthN(showAuthRoleHandler),
+		BashComplete: oneRoleCompletions,
+	}
+	authCmdRoleAdd = cli.Command{
+		Name:         cmdAuthRole,
+		Usage:        "create a new role",
+		ArgsUsage:    addSetAuthRoleArgument,
+		Flags:        authFlags[flagsAuthRoleAddSet],
+		Action:       wrapAuthN(addAuthRoleHandler),
+		BashComplete: addRoleCompletions,
+	}
+	authCmdRoleSet = cli.Command{
+		Name:         cmdAuthRole,
+		Usage:        "update an existing role for all users that have it",
+		ArgsUsage:    addSetAuthRoleArgument,
+		Flags:        authFlags[flagsAuthRoleAddSet],
+		Action:       wrapAuthN(updateAuthRoleHandler),
+		BashComplete: setRoleCompletions,
+	}
+	authCmdRoleRm = cli.Command{
+		Name:         cmdAuthRole,
+		Usage:        "remove an existing role",
+		ArgsUsage:    deleteAuthRoleArgument,
+		Action:       wrapAuthN(deleteRoleHandler),
+		BashComplete: oneRoleCompletions,
+	}
+
+	// e.g.: 'ais auth role add bucket-reader --cluster clu --bucket ais://abc ro'
+	authCmdRole = cli.Command{
+		Name:  cmdAuthRole,
+		Usage: "manage AuthN roles: cluster- and bucket-level permissions that can be assigned to users",
+		Subcommands: []cli.Command{
+			makeAlias(authCmdRoleAdd, "ais auth add role", false, cmdAuthAdd),
+			makeAlias(authCmdRoleSet, "ais auth set role", false, cmdAuthSet),
+			makeAlias(authCmdRoleShow, "ais auth show role", false, cmdAuthShow),
+			makeAlias(authCmdRoleRm, "ais auth rm role", false, cmdAuthRemove),
+		},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -65,14 +115,7 @@ var (
 				ArgsUsage: showAuthClusterArgument,
 				Action:    wrapAuthN(showAuthClusterHandler),
 			},
-			{
-				Name:         cmdAuthRole,
-				Usage:        "show existing AuthN roles",
-				ArgsUsage:    showAuthRoleArgument,
-				Flags:        authFlags[flagsAuthRoleShow],
-				Action:       wrapAuthN(showAuthRoleHandler),
-				BashComplete: oneRoleCompletions,
-			},
+			authCmdRoleShow,
 			{
 				Name:      cmdAuthUser,
 				Usage:     "show user list and details",
@@ -115,13 +158,13 @@ var (
 						Action:    wrapAuthN(addAuthClusterHandler),
 					},
 					{
-						Name:         cmdAuthRole,
-						Usage:        "create a new role",
-						ArgsUsage:    addSetAuthRoleArgument,
-						Flags:        authFlags[flagsAuthRoleAddSet],
-						Action:       wrapAuthN(addAuthRoleHandler),
-						BashComplete: addRoleCompletions,
+						Name: cmdAuthEnrollToken,
+						Usage: "issue one-time token to enroll AIS cluster (see also: 'ais auth enroll'), e.g.:\n" +
+							indent1 + "\t* ais auth add enrollment-token --expire 10m\t- the token must be used within 10 minutes",
+						Flags:  authFlags[flagsAuthEnrollToken],
+						Action: wrapAuthN(addEnrollTokenHandler),
 					},
+					authCmdRoleAdd,
 				},
 			},
 			// rm
@@ -143,13 +186,7 @@ var (
 						Action:       wrapAuthN(deleteAuthClusterHandler),
 						BashComplete: oneClusterCompletions,
 					},
-					{
-						Name:         cmdAuthRole,
-						Usage:        "remove an existing role",
-						ArgsUsage:    deleteAuthRoleArgument,
-						Action:       wrapAuthN(deleteRoleHandler),
-						BashComplete: oneRoleCompletions,
-					},
+					authCmdRoleRm,
 					{
 						Name:      cmdAuthToken,
 						Usage:     "revoke AuthN token",
@@ -185,16 +222,11 @@ var (
 						Action:       wrapAuthN(updateAuthUserHandler),
 						BashComplete: oneUserCompletionsWithRoles,
 					},
-					{
-						Name:         cmdAuthRole,
-						Usage:        "update an existing role for all users that have it",
-						ArgsUsage:    addSetAuthRoleArgument,
-						Flags:        authFlags[flagsAuthRoleAddSet],
-						Action:       wrapAuthN(updateAuthRoleHandler),
-						BashComplete: setRoleCompletions,
-					},
+					authCmdRoleSet,
 				},
 			},
+			// role (noun first)
+			authCmdRole,
 			// login, logout
 			{
 				Name:      cmdAuthLogin,
@@ -209,6 +241,30 @@ var (
 				Flags:  authFlags[flagsAuthUserLogout],
 				Action: wrapAuthN(logoutUserHandler),
 			},
+			// enroll, rotate-key
+			{
+				Name: cmdAuthEnroll,
+				Usage: "register AIS cluster with AuthN using one-time enrollment token\n" +
+					indent1 + "(the cluster receives AuthN secret via key exchange, no need to copy it), e.g.:\n" +
+					indent1 + "\t* ais auth enroll $(ais auth add enrollment-token) mycluster",
+				ArgsUsage: enrollAuthArgument,
+				Action:    wrapAuthN(enrollAuthHandler),
+			},
+			{
+				Name: cmdAuthRotateKey,
+				Usage: "rotate AuthN token-signing secret and push the new one to all registered clusters\n" +
+					indent1 + "(previously issued tokens remain valid until they expire)",
+				Action: wrapAuthN(rotateAuthKeyHandler),
+			},
+			// audit
+			{
+				Name: cmdAuthAudit,
+				Usage: "show AuthN audit log: login attempts, revoked tokens, user and role changes, and permission denials, e.g.:\n" +
+					indent1 + "\t* ais auth audit --since 24h\t- show the events that occurred during the last 24 hours\n" +
+					indent1 + "\t* ais auth audit --limit 100\t- show the 100 most recent events",
+				Flags:  authFlags[flagsAuthAudit],
+				Action: wrapAuthN(showAuthAuditHandler),
+			},
 		},
 	}
 )
@@ -432,6 +488,42 @@ func addAuthClusterHandler(c *cli.Context) (err error) {
 	return authn.RegisterCluster(authParams, cluSpec)
 }
 
+func addEnrollTokenHandler(c *cli.Context) error {
+	expireIn := apc.Duration(parseDurationFlag(c, enrollExpireFlag))
+	token, err := authn.IssueEnrollToken(authParams, expireIn)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(token, "", teb.Jopts(true))
+	}
+	fmt.Fprintln(c.App.Writer, token.Token)
+	return nil
+}
+
+func enrollAuthHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, "enrollment token")
+	}
+	if c.NArg() > 2 {
+		return incorrectUsageMsg(c, "too many arguments: %v", c.Args())
+	}
+	args := &apc.ActValEnrollAuthN{URL: authParams.URL, Token: c.Args().Get(0), Alias: c.Args().Get(1)}
+	if err := api.EnrollAuthN(apiBP, args); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "Cluster enrolled with AuthN at %s\n", authParams.URL)
+	return nil
+}
+
+func rotateAuthKeyHandler(c *cli.Context) error {
+	if err := authn.RotateSecret(authParams); err != nil {
+		return err
+	}
+	actionDone(c, "AuthN secret rotated")
+	return nil
+}
+
 func updateAuthClusterHandler(c *cli.Context) (err error) {
 	cluSpec, err := parseClusterSpecs(c)
 	if err != nil {
@@ -719,6 +811,26 @@ func showAuthConfigHandler(c *cli.Context) (err error) {
 	}
 }
 
+func showAuthAuditHandler(c *cli.Context) error {
+	var since time.Duration
+	if flagIsSet(c, auditSinceFlag) {
+		since = parseDurationFlag(c, auditSinceFlag)
+	}
+	events, err := authn.GetAuditLog(authParams, since, parseIntFlag(c, auditLimitFlag))
+	if err != nil {
+		return err
+	}
+	usejs := flagIsSet(c, jsonFlag)
+	if len(events) == 0 && !usejs {
+		fmt.Fprintln(c.App.Writer, "No audit events")
+		return nil
+	}
+	if flagIsSet(c, noHeaderFlag) {
+		return teb.Print(events, teb.AuthNAuditTmplNoHdr)
+	}
+	return teb.Print(events, teb.AuthNAuditTmpl, teb.Jopts(usejs))
+}
+
 func authNConfigFromArgs(c *cli.Context) (conf *authn.ConfigToUpdate, err error) {
 	conf = &authn.ConfigToUpdate{Server: &authn.ServerConfToSet{}}
 	items := c.Args()
@@ -0,0 +1,142 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file contains implementation of `ais show storage usage`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/urfave/cli"
+)
+
+// cluster-wide per-bucket usage (chargeback/showback): sum of per-target GET/PUT
+// bytes and counts aggregated per calendar month
+func showBckUsageHandler(c *cli.Context) error {
+	var (
+		qbck   cmn.QueryBcks
+		period = parseStrFlag(c, bckUsagePeriodFlag)
+		err    error
+	)
+	if c.NArg() > 0 {
+		if qbck, err = parseQueryBckURI(c, c.Args().Get(0)); err != nil {
+			return err
+		}
+	}
+	if period != "" {
+		if _, err := time.Parse(stats.BckUsagePeriodFmt, period); err != nil {
+			return fmt.Errorf("invalid %s=%q (expecting calendar month, e.g. %q)", flprn(bckUsagePeriodFlag), period,
+				time.Now().UTC().Format(stats.BckUsagePeriodFmt))
+		}
+	}
+	if flagIsSet(c, jsonFlag) && flagIsSet(c, bckUsageCSVFlag) {
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(jsonFlag), qflprn(bckUsageCSVFlag))
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+
+	total := make(stats.BckUsagePeriods, 4)
+	for tid, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		res, err := api.GetBucketUsage(apiBP, tid, period)
+		if err != nil {
+			return V(err)
+		}
+		total.Merge(res)
+	}
+	if !qbck.IsEmpty() {
+		for p, bcks := range total {
+			for cname := range bcks {
+				bck, _, err := cmn.ParseBckObjectURI(cname, cmn.ParseURIOpts{})
+				if err != nil || !qbck.Contains(&bck) {
+					delete(bcks, cname)
+				}
+			}
+			if len(bcks) == 0 {
+				delete(total, p)
+			}
+		}
+	}
+
+	switch {
+	case flagIsSet(c, jsonFlag):
+		return teb.Print(total, "", teb.Jopts(true))
+	case flagIsSet(c, bckUsageCSVFlag):
+		return _bckUsageCSV(c, total)
+	case len(total) == 0:
+		if period == "" {
+			period = "any period"
+		}
+		fmt.Fprintf(c.App.Writer, "No bucket usage recorded for %s\n", period)
+		return nil
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "PERIOD\tBUCKET\tGET(N)\tGET(SIZE)\tPUT(N)\tPUT(SIZE)\t")
+	}
+	for _, p := range _sortedUsage(total) {
+		u := total[p.period][p.cname]
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%d\t%s\t\n", p.period, p.cname,
+			u.GetCount, teb.FmtSize(u.GetBytes, units, 2), u.PutCount, teb.FmtSize(u.PutBytes, units, 2))
+	}
+	tw.Flush()
+	return nil
+}
+
+// raw (unformatted) numbers for spreadsheets and billing pipelines
+func _bckUsageCSV(c *cli.Context, total stats.BckUsagePeriods) error {
+	w := csv.NewWriter(c.App.Writer)
+	if !flagIsSet(c, noHeaderFlag) {
+		w.Write([]string{"period", "bucket", "get_count", "get_bytes", "put_count", "put_bytes"}) //nolint:errcheck // see w.Error below
+	}
+	for _, p := range _sortedUsage(total) {
+		u := total[p.period][p.cname]
+		w.Write([]string{p.period, p.cname, //nolint:errcheck // ditto
+			strconv.FormatInt(u.GetCount, 10), strconv.FormatInt(u.GetBytes, 10),
+			strconv.FormatInt(u.PutCount, 10), strconv.FormatInt(u.PutBytes, 10)})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+type usageRow struct {
+	period string
+	cname  string
+}
+
+// most recent period first; buckets in alphabetical order
+func _sortedUsage(total stats.BckUsagePeriods) []usageRow {
+	rows := make([]usageRow, 0, len(total)*4)
+	for p, bcks := range total {
+		for cname := range bcks {
+			rows = append(rows, usageRow{p, cname})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].period != rows[j].period {
+			return rows[i].period > rows[j].period
+		}
+		return rows[i].cname < rows[j].cname
+	})
+	return rows
+}
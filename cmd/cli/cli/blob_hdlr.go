@@ -6,7 +6,7 @@
 package cli
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -180,66 +180,41 @@ func blobAllProgress(c *cli.Context, bck cmn.Bck, objNames, xids []string) (err
 	return err
 }
 
-func _blobOneProgress(xid string, bar *mpb.Bar, errCh chan error, sleep time.Duration) {
+// (subscribes to xaction stats - see api.WaitForXactionProgress)
+func _blobOneProgress(xid string, bar *mpb.Bar, errCh chan error, ival time.Duration) {
 	var (
-		xargs    = xact.ArgsMsg{ID: xid, Kind: apc.ActBlobDl}
 		currSize int64
 		fullSize = int64(-1)
-		done     bool
+		xargs    = xact.ArgsMsg{ID: xid, Kind: apc.ActBlobDl, Timeout: -1 /*long*/}
 	)
-	for {
-		daemonID, snap, errN := getXactSnap(&xargs)
-		if errN != nil {
-			errCh <- errN
-			break
-		}
-		done = snap.Finished()
-		debug.Assert(snap.ID == xargs.ID)
-		if xargs.DaemonID == "" {
-			xargs.DaemonID = daemonID
+	cb := func(snaps xact.MultiSnap) error {
+		snap := findSnap(snaps, xid)
+		if snap == nil {
+			return nil // not started yet
 		}
 		if fullSize < 0 && snap.Stats.InBytes != 0 {
 			fullSize = snap.Stats.InBytes
 			bar.SetTotal(fullSize, false)
 		}
-		debug.Assert(xargs.DaemonID == daemonID)
 		if snap.Stats.Bytes != 0 {
 			bar.IncrInt64(snap.Stats.Bytes - currSize)
 			currSize = snap.Stats.Bytes
-			if currSize == fullSize {
-				done = true
-			}
-		}
-		if snap.IsAborted() && (fullSize > 0 && currSize < fullSize) {
-			errCh <- errors.New(snap.AbortErr)
-			break
 		}
-		if done {
-			bar.SetTotal(currSize, true)
-			return // --> ok
-		}
-		time.Sleep(sleep)
+		return nil
+	}
+	if err := api.WaitForXactionProgress(context.Background(), apiBP, &xargs, ival, cb); err != nil {
+		errCh <- V(err)
+		bar.Abort(true)
+		return
 	}
-	bar.Abort(true)
+	bar.SetTotal(currSize, true) // --> ok
 }
 
 func _blobWaitOne(c *cli.Context, xid, text string) error {
-	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActBlobDl}
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActBlobDl, Timeout: -1 /*long*/}
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
 	}
 	fmt.Fprintln(c.App.Writer, text+" ...")
-	for {
-		_, snap, errN := getXactSnap(&xargs)
-		if errN != nil {
-			return errN
-		}
-		if snap.IsAborted() {
-			return errors.New(snap.AbortErr)
-		}
-		debug.Assert(snap.ID == xargs.ID)
-		if snap.Finished() {
-			return nil
-		}
-	}
+	return api.WaitForXactionProgress(context.Background(), apiBP, &xargs, _refreshRate(c), nil)
 }
@@ -0,0 +1,575 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais cluster init` - initial cluster bootstrap.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+// `ais cluster init` generates:
+//   - DIR/ais.json                    - cluster configuration (see cmn.DefaultClusterConfig);
+//   - DIR/<node>/ais_local.json       - local configuration, one per node, with the node's
+//                                       confdir DIR/<node> and logs in DIR/<node>/log;
+//   - DIR/systemd/aisnode-<node>.service (--emit systemd), or
+//   - DIR/k8s/ais.yaml                (--emit k8s) - in place of all the above.
+//
+// Nodes are numbered proxies first; node k (k = 0, 1, ...) listens on the base ports + k.
+// With --start, all nodes get started locally (primary first) and the resulting cluster
+// is then verified: all nodes joined and the cluster is healthy.
+
+const (
+	bootEmitConfigs = "configs"
+	bootEmitSystemd = "systemd"
+	bootEmitK8s     = "k8s"
+
+	bootClusterConfName = "ais.json"
+	bootLocalConfName   = "ais_local.json"
+	bootDfltDir         = "ais-cluster"
+	bootDfltAisnode     = "/usr/local/bin/aisnode"
+
+	bootK8sImage   = "aistorage/aisnode:latest"
+	bootK8sConfDir = "/etc/ais/config"
+)
+
+type (
+	bootOpts struct {
+		dir          string
+		emit         string
+		mpaths       []string
+		backends     []string
+		hostname     string
+		hostnameCtrl string
+		hostnameData string
+		aisnode      string
+		k8sNode      string
+		nproxy       int
+		ntarget      int
+		ntest        int
+		port         int
+		portCtrl     int
+		portData     int
+		start        bool
+	}
+	bootNode struct {
+		Name      string
+		Role      string
+		Primary   bool
+		Local     cmn.LocalConfig
+		LocalConf string // (k8s) marshaled Local
+		Mpaths    []string
+		Args      string // aisnode command line
+		K8sArgs   string // ditto, YAML list
+		Aisnode   string // (systemd)
+	}
+	bootCluster struct {
+		opts        *bootOpts
+		primaryURL  string
+		clusterConf []byte
+		nodes       []*bootNode
+	}
+)
+
+func clusterInitHandler(c *cli.Context) (err error) {
+	opts, err := bootParseOpts(c)
+	if err != nil {
+		return err
+	}
+	b := &bootCluster{opts: opts}
+	if err := b.genConfigs(); err != nil {
+		return err
+	}
+	if err := b.checkDir(c); err != nil {
+		return err
+	}
+	switch opts.emit {
+	case bootEmitK8s:
+		err = b.emitK8s(c)
+	case bootEmitSystemd:
+		if err = b.writeConfigs(); err == nil {
+			err = b.emitSystemd(c)
+		}
+	default:
+		err = b.writeConfigs()
+	}
+	if err != nil {
+		return err
+	}
+	b.showSummary(c)
+
+	if !opts.start {
+		return nil
+	}
+	if err := b.startNodes(c); err != nil {
+		return err
+	}
+	return b.verify(c)
+}
+
+//
+// options: flags and (when none specified) interactive prompts
+//
+
+func bootParseOpts(c *cli.Context) (*bootOpts, error) {
+	opts := &bootOpts{
+		dir:          c.Args().Get(0),
+		emit:         parseStrFlag(c, bootEmitFlag),
+		hostname:     parseStrFlag(c, bootHostnameFlag),
+		hostnameCtrl: parseStrFlag(c, bootHostnameCtrlFlag),
+		hostnameData: parseStrFlag(c, bootHostnameDataFlag),
+		aisnode:      parseStrFlag(c, bootAisnodeFlag),
+		k8sNode:      parseStrFlag(c, bootK8sNodeFlag),
+		nproxy:       parseIntFlag(c, bootProxiesFlag),
+		ntarget:      parseIntFlag(c, bootTargetsFlag),
+		ntest:        parseIntFlag(c, bootTestMpathsFlag),
+		port:         parseIntFlag(c, bootPortFlag),
+		portCtrl:     parseIntFlag(c, bootPortCtrlFlag),
+		portData:     parseIntFlag(c, bootPortDataFlag),
+		start:        flagIsSet(c, bootStartFlag),
+	}
+	mpaths := parseStrFlag(c, bootMountpathsFlag)
+	backends := parseStrFlag(c, bootBackendsFlag)
+
+	// interactive: neither arguments nor options (and no '--yes')
+	if c.NArg() == 0 && c.NumFlags() == 0 && !assumeYes(c) {
+		var err error
+		opts.dir = bootPrompt(c, "Deployment directory", bootDfltDir)
+		if opts.nproxy, err = bootPromptInt(c, "Number of proxies", opts.nproxy); err != nil {
+			return nil, err
+		}
+		if opts.ntarget, err = bootPromptInt(c, "Number of targets", opts.ntarget); err != nil {
+			return nil, err
+		}
+		mpaths = bootPrompt(c, "Target mountpaths, comma-separated (none - use test mountpaths)", "")
+		opts.hostname = bootPrompt(c, "Public hostname or IPv4 (none - all interfaces)", "")
+		if opts.port, err = bootPromptInt(c, "Base public port", opts.port); err != nil {
+			return nil, err
+		}
+		backends = bootPrompt(c, "Cloud backends, comma-separated (e.g. aws,gcp)", "")
+		opts.emit = bootPrompt(c, "Generate ("+bootEmitConfigs+", "+bootEmitSystemd+", or "+bootEmitK8s+")", opts.emit)
+		if opts.emit == bootEmitK8s {
+			opts.k8sNode = bootPrompt(c, "Kubernetes node to deploy on", "")
+		} else if opts.emit == bootEmitConfigs {
+			opts.start = confirm(c, "Start the cluster now?")
+		}
+	}
+
+	if opts.dir == "" {
+		opts.dir = bootDfltDir
+	}
+	dir, err := filepath.Abs(cos.ExpandPath(opts.dir))
+	if err != nil {
+		return nil, err
+	}
+	opts.dir = dir
+	if mpaths != "" {
+		opts.mpaths = splitCsv(mpaths)
+	}
+	if backends != "" {
+		for _, p := range splitCsv(backends) {
+			provider := apc.NormalizeProvider(p)
+			if !apc.IsCloudProvider(provider) {
+				return nil, fmt.Errorf("invalid backend %q (expecting one of: %v)", p, apc.Providers.ToSlice())
+			}
+			opts.backends = append(opts.backends, provider)
+		}
+	}
+	return opts, opts.validate(c)
+}
+
+func (opts *bootOpts) validate(c *cli.Context) error {
+	if opts.nproxy < 1 || opts.ntarget < 1 {
+		return incorrectUsageMsg(c, "cluster requires at least one proxy and one target (got %d and %d)",
+			opts.nproxy, opts.ntarget)
+	}
+	if len(opts.mpaths) == 0 && opts.ntest < 1 {
+		return incorrectUsageMsg(c, "%s must be positive (or specify %s)", qflprn(bootTestMpathsFlag), qflprn(bootMountpathsFlag))
+	}
+	for _, mpath := range opts.mpaths {
+		if _, err := cmn.ValidateMpath(mpath); err != nil {
+			return err
+		}
+	}
+	switch opts.emit {
+	case bootEmitConfigs, bootEmitSystemd:
+	case bootEmitK8s:
+		if len(opts.mpaths) == 0 || opts.k8sNode == "" {
+			return incorrectUsageMsg(c, "'%s %s' requires %s and %s", flprn(bootEmitFlag), bootEmitK8s,
+				qflprn(bootMountpathsFlag), qflprn(bootK8sNodeFlag))
+		}
+	default:
+		return incorrectUsageMsg(c, "invalid %s value %q (expecting one of: %s, %s, %s)", qflprn(bootEmitFlag),
+			opts.emit, bootEmitConfigs, bootEmitSystemd, bootEmitK8s)
+	}
+	if opts.start && opts.emit != bootEmitConfigs {
+		return incorrectUsageMsg(c, "%s cannot be used with '%s %s'", qflprn(bootStartFlag), flprn(bootEmitFlag), opts.emit)
+	}
+	last := opts.nproxy + opts.ntarget - 1
+	for _, port := range []int{opts.port, opts.portCtrl, opts.portData} {
+		if _, err := cmn.ValidatePort(port); err != nil {
+			return err
+		}
+		if _, err := cmn.ValidatePort(port + last); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bootPrompt(c *cli.Context, prompt, dflt string) string {
+	if dflt != "" {
+		prompt += " [" + dflt + "]"
+	}
+	if v := strings.TrimSpace(readValue(c, prompt)); v != "" {
+		return v
+	}
+	return dflt
+}
+
+func bootPromptInt(c *cli.Context, prompt string, dflt int) (int, error) {
+	v := bootPrompt(c, prompt, strconv.Itoa(dflt))
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid number %q", prompt, v)
+	}
+	return n, nil
+}
+
+/////////////////
+// bootCluster //
+/////////////////
+
+func (b *bootCluster) genConfigs() error {
+	opts := b.opts
+	b.primaryURL = "http://" + cos.Either(opts.hostname, "localhost") + ":" + strconv.Itoa(opts.port)
+
+	// cluster config
+	cc, err := cmn.DefaultClusterConfig(b.primaryURL, opts.backends...)
+	if err != nil {
+		return fmt.Errorf("failed to generate cluster config: %v", err)
+	}
+	if b.clusterConf, err = jsonMarshalIndent(cc); err != nil {
+		return err
+	}
+
+	// nodes
+	for k := 0; k < opts.nproxy+opts.ntarget; k++ {
+		node := b.newNode(k)
+		config := &cmn.Config{ClusterConfig: *cc, LocalConfig: node.Local}
+		config.SetRole(node.Role)
+		if err := config.Validate(); err != nil {
+			return fmt.Errorf("%s: invalid configuration: %v", node.Name, err)
+		}
+		b.nodes = append(b.nodes, node)
+	}
+	return nil
+}
+
+func (b *bootCluster) newNode(k int) *bootNode {
+	var (
+		opts = b.opts
+		node = &bootNode{Role: apc.Proxy, Primary: k == 0}
+		idx  = k + 1
+		k8s  = opts.emit == bootEmitK8s
+	)
+	if k >= opts.nproxy {
+		node.Role = apc.Target
+		idx = k - opts.nproxy + 1
+	}
+	node.Name = node.Role + strconv.Itoa(idx)
+
+	lc := &node.Local
+	if k8s {
+		lc.ConfigDir = filepath.Join("/etc/ais", node.Name)
+		lc.LogDir = "/var/log/ais"
+	} else {
+		lc.ConfigDir = filepath.Join(opts.dir, node.Name)
+		lc.LogDir = filepath.Join(lc.ConfigDir, "log")
+	}
+	lc.HostNet = cmn.LocalNetConfig{
+		Hostname:             opts.hostname,
+		HostnameIntraControl: opts.hostnameCtrl,
+		HostnameIntraData:    opts.hostnameData,
+		Port:                 opts.port + k,
+		PortIntraControl:     opts.portCtrl + k,
+		PortIntraData:        opts.portData + k,
+	}
+	switch {
+	case len(opts.mpaths) == 0:
+		lc.TestFSP = cmn.TestFSPConf{Root: filepath.Join(opts.dir, "mp"), Count: opts.ntest}
+		if node.Role == apc.Target {
+			lc.TestFSP.Instance = idx
+		}
+	case node.Role == apc.Target:
+		lc.FSP.Paths = make(cos.StrSet, len(opts.mpaths))
+		for _, mpath := range opts.mpaths {
+			if opts.ntarget > 1 {
+				mpath = filepath.Join(mpath, node.Name)
+			}
+			lc.FSP.Paths.Set(mpath)
+			node.Mpaths = append(node.Mpaths, mpath)
+		}
+	}
+
+	// aisnode command line
+	confPath, localPath := filepath.Join(opts.dir, bootClusterConfName), filepath.Join(lc.ConfigDir, bootLocalConfName)
+	if k8s {
+		confPath, localPath = bootK8sConfDir+"/"+bootClusterConfName, bootK8sConfDir+"/"+node.Name+".json"
+	}
+	args := []string{"-config=" + confPath, "-local_config=" + localPath, "-role=" + node.Role}
+	if node.Role == apc.Proxy {
+		args = append(args, "-ntargets="+strconv.Itoa(opts.ntarget))
+	}
+	node.Args = strings.Join(args, " ")
+	node.K8sArgs = `"` + strings.Join(args, `", "`) + `"`
+	return node
+}
+
+// the deployment directory, if exists, must be empty (or the user must agree to overwrite)
+func (b *bootCluster) checkDir(c *cli.Context) error {
+	entries, err := os.ReadDir(b.opts.dir)
+	if err != nil || len(entries) == 0 {
+		return nil
+	}
+	if !flagIsSet(c, yesFlag) && !confirm(c, fmt.Sprintf("Directory %q is not empty. Overwrite existing configuration?", b.opts.dir)) {
+		return errors.New("canceled")
+	}
+	return nil
+}
+
+func (b *bootCluster) writeConfigs() error {
+	if err := cos.CreateDir(b.opts.dir); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(b.opts.dir, bootClusterConfName), b.clusterConf, cos.PermRWR); err != nil {
+		return err
+	}
+	for _, node := range b.nodes {
+		if err := cos.CreateDir(node.Local.LogDir); err != nil {
+			return err
+		}
+		data, err := jsonMarshalIndent(&node.Local)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(node.Local.ConfigDir, bootLocalConfName), data, cos.PermRWR); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *bootCluster) aisnodePath() string {
+	if b.opts.aisnode != "" {
+		return b.opts.aisnode
+	}
+	if path, err := exec.LookPath("aisnode"); err == nil {
+		return path
+	}
+	return ""
+}
+
+func (b *bootCluster) emitSystemd(c *cli.Context) error {
+	var (
+		dir     = filepath.Join(b.opts.dir, "systemd")
+		tmpl    = template.Must(template.New("systemd").Parse(bootSystemdTmpl))
+		aisnode = b.aisnodePath()
+	)
+	if aisnode == "" {
+		aisnode = bootDfltAisnode
+		actionWarn(c, fmt.Sprintf("aisnode executable not found - using %q (to specify, use %s)", aisnode, qflprn(bootAisnodeFlag)))
+	}
+	if err := cos.CreateDir(dir); err != nil {
+		return err
+	}
+	for _, node := range b.nodes {
+		var buf bytes.Buffer
+		node.Aisnode = aisnode
+		if err := tmpl.Execute(&buf, node); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "aisnode-"+node.Name+".service"), buf.Bytes(), cos.PermRWR); err != nil {
+			return err
+		}
+	}
+	actionNote(c, fmt.Sprintf("to install: 'sudo cp %s/*.service /etc/systemd/system && sudo systemctl daemon-reload',\n"+
+		"then start the primary (%s) followed by all other nodes: 'sudo systemctl start aisnode-<NODE>'", dir, b.nodes[0].Name))
+	return nil
+}
+
+func (b *bootCluster) emitK8s(c *cli.Context) error {
+	var (
+		dir   = filepath.Join(b.opts.dir, "k8s")
+		fname = filepath.Join(dir, "ais.yaml")
+		funcs = template.FuncMap{"indent": func(n int, s string) string {
+			pad := strings.Repeat(" ", n)
+			return pad + strings.ReplaceAll(strings.TrimRight(s, "\n"), "\n", "\n"+pad)
+		}}
+		tmpl = template.Must(template.New("k8s").Funcs(funcs).Parse(bootK8sTmpl))
+		buf  bytes.Buffer
+	)
+	for _, node := range b.nodes {
+		data, err := jsonMarshalIndent(&node.Local)
+		if err != nil {
+			return err
+		}
+		node.LocalConf = string(data)
+	}
+	err := tmpl.Execute(&buf, map[string]any{
+		"Fname":       fname,
+		"ClusterConf": string(b.clusterConf),
+		"Nodes":       b.nodes,
+		"Image":       bootK8sImage,
+		"K8sNode":     b.opts.k8sNode,
+	})
+	if err != nil {
+		return err
+	}
+	if err := cos.CreateDir(dir); err != nil {
+		return err
+	}
+	if err := os.WriteFile(fname, buf.Bytes(), cos.PermRWR); err != nil {
+		return err
+	}
+	actionNote(c, fmt.Sprintf("review and deploy: 'kubectl apply -f %s'", fname))
+	return nil
+}
+
+func (b *bootCluster) showSummary(c *cli.Context) {
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NODE\tROLE\tPORTS (PUBLIC, CONTROL, DATA)\tMOUNTPATHS")
+	for _, node := range b.nodes {
+		var (
+			role   = node.Role
+			hn     = &node.Local.HostNet
+			mpaths = strings.Join(node.Mpaths, ", ")
+		)
+		if node.Primary {
+			role += " (primary)"
+		}
+		if node.Role == apc.Proxy {
+			mpaths = "-"
+		} else if mpaths == "" {
+			tfsp := &node.Local.TestFSP
+			mpaths = fmt.Sprintf("%d test mountpath%s under %s", tfsp.Count, cos.Plural(tfsp.Count), tfsp.Root)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d, %d, %d\t%s\n", node.Name, role, hn.Port, hn.PortIntraControl, hn.PortIntraData, mpaths)
+	}
+	tw.Flush()
+	fmt.Fprintln(c.App.Writer)
+	if b.opts.emit != bootEmitK8s {
+		actionDone(c, fmt.Sprintf("Generated configuration for %d nodes (proxies: %d, targets: %d) in %s",
+			len(b.nodes), b.opts.nproxy, b.opts.ntarget, b.opts.dir))
+	}
+}
+
+//
+// start and verify (local deployment)
+//
+
+func (b *bootCluster) startNodes(c *cli.Context) error {
+	aisnode := b.aisnodePath()
+	if aisnode == "" {
+		return fmt.Errorf("aisnode executable not found in $PATH (use %s to specify)", qflprn(bootAisnodeFlag))
+	}
+	var (
+		primary  = b.nodes[0]
+		addr     = net.JoinHostPort(cos.Either(b.opts.hostname, "localhost"), strconv.Itoa(primary.Local.HostNet.Port))
+		deadline = time.Now().Add(parseDurationFlag(c, bootTimeoutFlag))
+	)
+	// primary first; it won't report healthy until all targets join - wait only for it to start listening
+	if err := primary.start(aisnode); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "Started primary %s, waiting for it to listen on %s...\n", primary.Name, addr)
+	for {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		if err == nil {
+			conn.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for primary %s at %s (see %s)", primary.Name, addr,
+				filepath.Join(primary.Local.ConfigDir, "aisnode.out"))
+		}
+		time.Sleep(time.Second)
+	}
+	for _, node := range b.nodes[1:] {
+		if err := node.start(aisnode); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "Started %d node%s\n", len(b.nodes), cos.Plural(len(b.nodes)))
+	return nil
+}
+
+func (node *bootNode) start(aisnode string) error {
+	out, err := os.Create(filepath.Join(node.Local.ConfigDir, "aisnode.out"))
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	cmd := exec.Command(aisnode, strings.Split(node.Args, " ")...)
+	cmd.Stdout, cmd.Stderr = out, out
+	cmd.Env = os.Environ()
+	if node.Primary {
+		cmd.Env = append(cmd.Env, env.AIS.IsPrimary+"=true")
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start %s: %v", node.Name, err)
+	}
+	pid := strconv.Itoa(cmd.Process.Pid)
+	if err := os.WriteFile(filepath.Join(node.Local.ConfigDir, "aisnode.pid"), []byte(pid+"\n"), cos.PermRWR); err != nil {
+		return err
+	}
+	return cmd.Process.Release()
+}
+
+func (b *bootCluster) verify(c *cli.Context) error {
+	var (
+		bp       = remBaseParams(b.primaryURL)
+		timeout  = parseDurationFlag(c, bootTimeoutFlag)
+		deadline = time.Now().Add(timeout)
+		np, nt   int
+	)
+	for {
+		smap, err := api.GetClusterMap(bp)
+		if err == nil {
+			np, nt = smap.CountActivePs(), smap.CountActiveTs()
+			if np == b.opts.nproxy && nt == b.opts.ntarget && api.Health(bp, true /*cluster is ready*/) == nil {
+				break
+			}
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %v waiting for the cluster to become healthy: %d/%d proxies, %d/%d targets"+
+				" (see %s/<NODE>/aisnode.out)", timeout, np, b.opts.nproxy, nt, b.opts.ntarget, b.opts.dir)
+		}
+		time.Sleep(time.Second)
+	}
+	actionDone(c, fmt.Sprintf("Cluster is up and healthy (proxies: %d, targets: %d)", np, nt))
+	tip := fmt.Sprintf("to use this cluster, 'export %s=%s' (to stop it, run 'ais cluster shutdown')",
+		env.AIS.Endpoint, b.primaryURL)
+	actionNote(c, tip)
+	return nil
+}
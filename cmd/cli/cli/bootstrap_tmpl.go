@@ -0,0 +1,80 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file contains templates used by `ais cluster init`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+// one unit per node (note: the primary must be started first)
+const bootSystemdTmpl = `[Unit]
+Description=AIStore {{.Role}} {{.Name}}
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+{{- if .Primary}}
+Environment=AIS_IS_PRIMARY=true
+{{- end}}
+ExecStart={{.Aisnode}} {{.Args}}
+Restart=on-failure
+LimitNOFILE=1048576
+
+[Install]
+WantedBy=multi-user.target
+`
+
+// single-node deployment (all pods share the node's network, see nodeName);
+// for production K8s deployments, see https://github.com/NVIDIA/ais-k8s
+const bootK8sTmpl = `# generated by 'ais cluster init' - review before applying: kubectl apply -f {{.Fname}}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ais-config
+data:
+  ais.json: |
+{{.ClusterConf | indent 4}}
+{{- range .Nodes}}
+  {{.Name}}.json: |
+{{.LocalConf | indent 4}}
+{{- end}}
+{{- range .Nodes}}
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: ais-{{.Name}}
+  labels:
+    app: ais
+    component: {{.Role}}
+spec:
+  nodeName: {{$.K8sNode}}
+  hostNetwork: true
+  containers:
+    - name: aisnode
+      image: {{$.Image}}
+      command: ["aisnode"]
+      args: [{{.K8sArgs}}]
+{{- if .Primary}}
+      env:
+        - name: AIS_IS_PRIMARY
+          value: "true"
+{{- end}}
+      volumeMounts:
+        - name: config
+          mountPath: /etc/ais/config
+{{- range $i, $mp := .Mpaths}}
+        - name: mp{{$i}}
+          mountPath: {{$mp}}
+{{- end}}
+  volumes:
+    - name: config
+      configMap:
+        name: ais-config
+{{- range $i, $mp := .Mpaths}}
+    - name: mp{{$i}}
+      hostPath:
+        path: {{$mp}}
+        type: DirectoryOrCreate
+{{- end}}
+{{- end}}
+`
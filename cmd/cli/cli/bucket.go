@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -22,8 +23,13 @@ import (
 )
 
 // Creates new ais bucket
-func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadRemote bool) (err error) {
-	if err = api.CreateBucket(apiBP, bck, props, dontHeadRemote); err != nil {
+func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BpropsToSet, profile string, dontHeadRemote bool) (err error) {
+	if profile != "" {
+		err = api.CreateBucketWithProfile(apiBP, bck, profile, props)
+	} else {
+		err = api.CreateBucket(apiBP, bck, props, dontHeadRemote)
+	}
+	if err != nil {
 		if herr, ok := err.(*cmn.ErrHTTP); ok {
 			if herr.Status == http.StatusConflict {
 				desc := fmt.Sprintf("Bucket %q already exists", bck)
@@ -155,39 +161,30 @@ func _evictBck(c *cli.Context, bck cmn.Bck) (err error) {
 }
 
 func listOrSummBuckets(c *cli.Context, qbck cmn.QueryBcks, lsb lsbCtx) error {
-	bcks, err := api.ListBuckets(apiBP, qbck, lsb.fltPresence)
-	if err != nil {
-		return V(err)
-	}
-
+	var (
+		bcks    cmn.Bcks
+		partial error
+		err     error
+	)
 	// NOTE:
 	// typing `ls ais://@` (with an '@' symbol) to query remote ais buckets may not be
 	// very obvious (albeit documented); thus, for the sake of usability making
-	// an exception - extending ais queries to include remote ais
-
+	// an exception - extending ais queries to include remote ais (all attached clusters, single call)
 	if lsb.all && qbck.Ns.IsGlobal() && (qbck.Provider == apc.AIS || qbck.Provider == "") {
-		if remais, err := api.GetRemoteAIS(apiBP); err == nil && len(remais.A) > 0 {
-			qrais := qbck
-			qrais.Ns = cmn.NsAnyRemote
-			if brais, err := api.ListBuckets(apiBP, qrais, lsb.fltPresence); err == nil && len(brais) > 0 {
-			outer:
-				for i := range brais {
-					bn := brais[i]
-					for j := range bcks {
-						bo := bcks[j]
-						if bn.Equal(&bo) {
-							continue outer
-						}
-					}
-					bcks = append(bcks, bn)
-				}
-			}
+		bcks, err = api.ListBucketsAll(apiBP, qbck, lsb.fltPresence)
+		if errors.Is(err, api.ErrRemAisPartial) {
+			partial, err = newErrPartial(err), nil // list what we have, and exit with ExitPartial
 		}
+	} else {
+		bcks, err = api.ListBuckets(apiBP, qbck, lsb.fltPresence)
+	}
+	if err != nil {
+		return V(err)
 	}
 
 	if len(bcks) == 0 && apc.IsFltPresent(lsb.fltPresence) && !qbck.IsAIS() {
 		_lsTip(c, qbck)
-		return nil
+		return partial
 	}
 
 	var nbcks cmn.Bcks
@@ -208,10 +205,16 @@ func listOrSummBuckets(c *cli.Context, qbck cmn.QueryBcks, lsb lsbCtx) error {
 				fmt.Fprintf(c.App.Writer, "listed %d buckets with none matching %q regex",
 					len(bcks), lsb.regexStr)
 			}
-			return nil
+			return partial
 		}
 	}
 
+	// machine-readable output: all providers in a single (JSON, CSV) listing
+	if flagIsSet(c, lsFormatFlag) {
+		listBckTable(c, qbck, nbcks, lsb)
+		return partial
+	}
+
 	//
 	// by provider
 	//
@@ -233,7 +236,7 @@ func listOrSummBuckets(c *cli.Context, qbck cmn.QueryBcks, lsb lsbCtx) error {
 	if cnt > 0 || total == 0 {
 		fmt.Fprintln(c.App.Writer)
 	}
-	return nil
+	return partial
 }
 
 func _lsTip(c *cli.Context, qbck cmn.QueryBcks) {
@@ -432,12 +435,41 @@ func configureNCopies(c *cli.Context, bck cmn.Bck, copies int) (err error) {
 }
 
 // erasure code the entire bucket
-func ecEncode(c *cli.Context, bck cmn.Bck, data, parity int) (err error) {
-	var xid string
-	if xid, err = api.ECEncodeBucket(apiBP, bck, data, parity); err != nil {
-		return
+func ecEncode(c *cli.Context, bck cmn.Bck, data, parity int) error {
+	var (
+		totalObjs, totalSize int64
+		showProgress         = flagIsSet(c, progressFlag)
+	)
+	if showProgress {
+		var err error
+		if totalObjs, totalSize, err = bckTotals(bck, "", apc.FltPresent); err != nil {
+			return err
+		}
 	}
-	msg := fmt.Sprintf("Erasure-coding bucket %s. ", bck.Cname(""))
-	actionDone(c, msg+toMonitorMsg(c, xid, ""))
-	return
+	xid, err := api.ECEncodeBucket(apiBP, bck, data, parity)
+	if err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("Erasure-coding bucket %s", bck.Cname(""))
+	if !showProgress && !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, msg+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait, with or without progress
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActECEncode, Timeout: -1 /*long*/}
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	if showProgress {
+		fmt.Fprintln(c.App.Writer, msg+" ...")
+		return xactProgress(c, &xargs, totalObjs, totalSize)
+	}
+	fmt.Fprint(c.App.Writer, msg+" ...")
+	if err := api.WaitForXactionProgress(context.Background(), apiBP, &xargs, _refreshRate(c), nil); err != nil {
+		fmt.Fprintln(c.App.Writer)
+		return V(err)
+	}
+	actionDone(c, fmtXactSucceeded)
+	return nil
 }
@@ -6,11 +6,14 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -72,6 +75,7 @@ var (
 		commandCreate: {
 			ignoreErrorFlag,
 			bucketPropsFlag,
+			bucketProfileFlag,
 			forceFlag,
 			dontHeadRemoteFlag,
 		},
@@ -82,12 +86,14 @@ var (
 		commandCopy: {
 			listFlag,
 			templateFlag,
+			fromStdinFlag,
 			verbObjPrefixFlag,
 			copyAllObjsFlag,
 			continueOnErrorFlag,
 			forceFlag,
 			copyDryRunFlag,
 			copyPrependFlag,
+			copyRenameFlag,
 			progressFlag,
 			refreshFlag,
 			waitFlag,
@@ -101,6 +107,7 @@ var (
 		},
 		commandEvict: append(
 			listRangeProgressWaitFlags,
+			fromStdinFlag,
 			keepMDFlag,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			dryRunFlag,
@@ -115,13 +122,18 @@ var (
 		commandList: {
 			allObjsOrBcksFlag,
 			listObjCachedFlag,
+			lsMetaFilterFlag,
+			lsHeadFlag,
 			nameOnlyFlag,
 			objPropsFlag,
 			regexLsAnyFlag,
 			templateFlag,
 			listObjPrefixFlag,
+			noRecursFlag,
 			pageSizeFlag,
 			pagedFlag,
+			lsStreamFlag,
+			lsFormatFlag,
 			objLimitFlag,
 			refreshFlag,
 			showUnmatchedFlag,
@@ -143,6 +155,21 @@ var (
 			enableFlag,
 			disableFlag,
 		},
+		cmdHeatmap: {
+			heatmapTopFlag,
+			jsonFlag,
+		},
+		cmdDu: {
+			duDepthFlag,
+			unitsFlag,
+			jsonFlag,
+		},
+		cmdSample: {
+			sampleCountFlag,
+			listObjPrefixFlag,
+			noFooterFlag,
+			jsonFlag,
+		},
 	}
 
 	bckSummaryFlags = append(storageSummFlags, validateSummaryFlag)
@@ -174,6 +201,39 @@ var (
 		Action:       lruBucketHandler,
 		BashComplete: bucketCompletions(bcmplop{}),
 	}
+	bucketCmdHeatmap = cli.Command{
+		Name: cmdHeatmap,
+		Usage: "show per-object access statistics: top-N hottest objects and access histogram, e.g.:\n" +
+			indent1 + "\t* ais bucket heatmap ais://abc\t- show (by default, 100) most frequently accessed objects;\n" +
+			indent1 + "\t* ais bucket heatmap s3://abc --top 10 --json\t- same as above, limit to 10, and output JSON.\n" +
+			indent1 + "\tNOTE: requires feature flag Track-Object-Access (see 'ais config cluster features')",
+		ArgsUsage:    bucketArgument,
+		Flags:        bucketCmdsFlags[cmdHeatmap],
+		Action:       heatmapBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+	bucketCmdSample = cli.Command{
+		Name: cmdSample,
+		Usage: "show uniform random sample of object names (without listing the bucket), e.g.:\n" +
+			indent1 + "\t* ais bucket sample ais://abc\t- show (by default, 100) randomly selected names;\n" +
+			indent1 + "\t* ais bucket sample s3://abc --count 1000 --prefix images/\t- sample 1000 names from the virtual subdirectory \"images\".\n" +
+			indent1 + "\tNOTE: only in-cluster objects are sampled",
+		ArgsUsage:    bucketArgument,
+		Flags:        bucketCmdsFlags[cmdSample],
+		Action:       sampleBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+	bucketCmdDu = cli.Command{
+		Name: cmdDu,
+		Usage: "show (in-cluster) bucket size broken down by virtual directory, e.g.:\n" +
+			indent1 + "\t* ais bucket du ais://abc\t- show sizes of the top-level directories;\n" +
+			indent1 + "\t* ais du s3://abc/images/ --depth 2\t- same, for \"images/\" subdirectories, two levels deep.\n" +
+			indent1 + "\tNOTE: copies (mirroring) add to the sizes but are not counted as objects",
+		ArgsUsage:    optionalPrefixArgument,
+		Flags:        bucketCmdsFlags[cmdDu],
+		Action:       duBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
 	bucketObjCmdEvict = cli.Command{
 		Name: commandEvict,
 		Usage: "evict one remote bucket, multiple remote buckets, or\n" +
@@ -205,6 +265,48 @@ var (
 		Action:       mvBucketHandler,
 		BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0, 2),
 	}
+	bucketCmdAccess = cli.Command{
+		Name: cmdAccess,
+		Usage: "allow or deny bucket permissions (relative to the current access), e.g.:\n" +
+			indent1 + "\t* ais bucket access deny ais://nnn DELETE-OBJECT,PUT\t- make the bucket append-only (sort of);\n" +
+			indent1 + "\t* ais bucket access allow ais://nnn rw\t- allow all read-write operations;\n" +
+			indent1 + "\tpermissions are case-insensitive and can be abbreviated (e.g., 'delete' for 'DELETE-OBJECT') as long as\n" +
+			indent1 + "\tit is unambiguous; to show current access, run 'ais bucket props show BUCKET access'",
+		Subcommands: []cli.Command{
+			{
+				Name:         cmdAccessAllow,
+				Usage:        "allow the specified permissions (in addition to the ones already allowed)",
+				ArgsUsage:    bucketAccessArgument,
+				Action:       allowBckAccessHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+			{
+				Name:         cmdAccessDeny,
+				Usage:        "deny the specified permissions (leaving all others intact)",
+				ArgsUsage:    bucketAccessArgument,
+				Action:       denyBckAccessHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+		},
+	}
+	bucketCmdFreeze = cli.Command{
+		Name: cmdFreeze,
+		Usage: "make bucket read-only: deny PUT, APPEND, DELETE (and all other object modifications) cluster-wide,\n" +
+			indent1 + "\tregardless of the bucket's access permissions, e.g.:\n" +
+			indent1 + "\t* ais bucket freeze ais://dataset-v2\t- publish finalized dataset version;\n" +
+			indent1 + "\tsee also: 'ais bucket unfreeze'",
+		ArgsUsage:    bucketArgument,
+		Action:       freezeBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+	bucketCmdUnfreeze = cli.Command{
+		Name:         cmdUnfreeze,
+		Usage:        "undo 'ais bucket freeze': allow object modifications (subject to the bucket's access permissions)",
+		ArgsUsage:    bucketArgument,
+		Action:       unfreezeBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+
 	bucketCmdSetProps = cli.Command{
 		Name: cmdSetBprops,
 		Usage: "update bucket properties; the command accepts both JSON-formatted input and plain Name=Value pairs, e.g.:\n" +
@@ -231,6 +333,9 @@ var (
 			bucketsObjectsCmdList,
 			bucketCmdSummary,
 			bucketCmdLRU,
+			bucketCmdHeatmap,
+			bucketCmdSample,
+			bucketCmdDu,
 			bucketObjCmdEvict,
 			makeAlias(showCmdBucket, "", true, commandShow), // alias for `ais show`
 			{
@@ -252,6 +357,9 @@ var (
 					multiple: true, provider: apc.AIS,
 				}),
 			},
+			bucketCmdAccess,
+			bucketCmdFreeze,
+			bucketCmdUnfreeze,
 			{
 				Name:   cmdProps,
 				Usage:  "show, update or reset bucket properties",
@@ -289,9 +397,16 @@ func createBucketHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	dontHeadRemote := flagIsSet(c, dontHeadRemoteFlag)
+	var (
+		dontHeadRemote = flagIsSet(c, dontHeadRemoteFlag)
+		profile        = parseStrFlag(c, bucketProfileFlag)
+	)
+	if profile != "" && dontHeadRemote {
+		return incorrectUsageMsg(c, "flags %s and %s are mutually exclusive",
+			qflprn(bucketProfileFlag), qflprn(dontHeadRemoteFlag))
+	}
 	for _, bck := range buckets {
-		if err := createBucket(c, bck, props, dontHeadRemote); err != nil {
+		if err := createBucket(c, bck, props, profile, dontHeadRemote); err != nil {
 			return err
 		}
 	}
@@ -454,6 +569,148 @@ func toggleLRU(c *cli.Context, bck cmn.Bck, p *cmn.Bprops, toggle bool) (err err
 	return updateBckProps(c, bck, p, toggledProps)
 }
 
+func heatmapBucketHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if _, err := headBucket(bck, true /* don't add */); err != nil {
+		return err
+	}
+	msg := &apc.HeatmapMsg{TopN: parseIntFlag(c, heatmapTopFlag)}
+	heatmap, err := api.GetBucketHeatmap(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(heatmap, "", teb.Jopts(true))
+	}
+	if heatmap.Total == 0 {
+		fmt.Fprintf(c.App.Writer, "No recorded accesses for %s (tip: check feature flag Track-Object-Access)\n",
+			bck.Cname(""))
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "%s: %d access(es), %d tracked object(s), %d evicted\n\n",
+		bck.Cname(""), heatmap.Total, heatmap.Tracked, heatmap.Evicted)
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tACCESS COUNT\tLAST ACCESS")
+	for _, e := range heatmap.Top {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", e.Name, e.Count, cos.FormatNanoTime(e.Atime, ""))
+	}
+	tw.Flush()
+
+	fmt.Fprintln(c.App.Writer)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "ACCESS COUNT\tOBJECTS")
+	for i, n := range heatmap.Histogram {
+		if n == 0 {
+			continue
+		}
+		lo, hi := int64(1)<<i, int64(1)<<(i+1)-1
+		if lo == hi {
+			fmt.Fprintf(tw, "%d\t%d\n", lo, n)
+		} else {
+			fmt.Fprintf(tw, "%d-%d\t%d\n", lo, hi, n)
+		}
+	}
+	tw.Flush()
+	return nil
+}
+
+func sampleBucketHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if _, err := headBucket(bck, true /* don't add */); err != nil {
+		return err
+	}
+	msg := &apc.SampleMsg{Prefix: parseStrFlag(c, listObjPrefixFlag), Size: parseIntFlag(c, sampleCountFlag)}
+	sample, err := api.SampleObjects(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(sample, "", teb.Jopts(true))
+	}
+	for _, name := range sample.Names {
+		fmt.Fprintln(c.App.Writer, name)
+	}
+	if !flagIsSet(c, noFooterFlag) {
+		fmt.Fprintf(c.App.Writer, "Sampled %d out of %d object(s) in %s\n", len(sample.Names), sample.Total, bck.Cname(msg.Prefix))
+	}
+	return nil
+}
+
+func duBucketHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, prefix, err := parseBckObjURI(c, c.Args().Get(0), true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	depth := parseIntFlag(c, duDepthFlag)
+	if depth < 1 {
+		return incorrectUsageMsg(c, "%s must be a positive integer (got %d)", qflprn(duDepthFlag), depth)
+	}
+	if _, err := headBucket(bck, true /* don't add */); err != nil {
+		return err
+	}
+	msg := &apc.BsummCtrlMsg{Prefix: prefix, PrefixDepth: depth, ObjCached: true, BckPresent: true}
+	_, res, err := api.GetBucketSummary(apiBP, cmn.QueryBcks(bck), msg, api.BsummArgs{})
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(res, "", teb.Jopts(true))
+	}
+	if len(res) == 0 || res[0].ObjCount.Present == 0 {
+		fmt.Fprintf(c.App.Writer, "%s is empty\n", bck.Cname(prefix))
+		return nil
+	}
+	summ := res[0]
+
+	// parent directories come first (sorted), subdirectories indented
+	prefixes := make([]string, 0, len(summ.ByPrefix))
+	for p := range summ.ByPrefix {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	base := prefix[:strings.LastIndexByte(prefix, '/')+1]
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "SIZE\tOBJECTS\tPREFIX")
+	fmt.Fprintf(tw, "%s\t%s\t%s\n", teb.FmtSize(int64(summ.TotalSize.PresentObjs), units, 2),
+		cos.FormatBigNum(int(summ.ObjCount.Present)), bck.Cname(prefix))
+	for _, p := range prefixes {
+		v := summ.ByPrefix[p]
+		level := strings.Count(p[len(base):], "/")
+		fmt.Fprintf(tw, "%s\t%s\t%s%s\n", teb.FmtSize(int64(v.Size), units, 2), cos.FormatBigNum(int(v.Count)),
+			strings.Repeat("  ", level), p)
+	}
+	tw.Flush()
+	if summ.PrefixesTrunc {
+		warn := fmt.Sprintf("too many virtual directories (over %d per target) - the breakdown is incomplete; "+
+			"consider smaller %s or longer prefix", apc.MaxBsummGroups, qflprn(duDepthFlag))
+		actionWarn(c, warn)
+	}
+	return nil
+}
+
 func setPropsHandler(c *cli.Context) (err error) {
 	var currProps *cmn.Bprops
 	bck, err := parseBckURI(c, c.Args().Get(0), false)
@@ -506,6 +763,120 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currProps *cmn.Bprops, updatePr
 	return nil
 }
 
+func allowBckAccessHandler(c *cli.Context) error { return updateBckAccess(c, true /*allow*/) }
+func denyBckAccessHandler(c *cli.Context) error  { return updateBckAccess(c, false) }
+
+func updateBckAccess(c *cli.Context, allow bool) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, "permissions")
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	perms, err := parseAccessPerms(c.Args().Tail())
+	if err != nil {
+		return err
+	}
+	currProps, err := headBucket(bck, false /* don't add */)
+	if err != nil {
+		return err
+	}
+	var allowed, denied apc.AccessAttrs
+	if allow {
+		allowed = perms
+	} else {
+		denied = perms
+	}
+	if _, err := api.UpdateBucketAccess(apiBP, bck, allowed, denied); err != nil {
+		return V(err)
+	}
+	newProps, err := headBucket(bck, true /* don't add */)
+	if err != nil {
+		return err
+	}
+	showDiff(c, currProps, newProps)
+	actionDone(c, "\nBucket access successfully updated.")
+	return nil
+}
+
+func freezeBucketHandler(c *cli.Context) error   { return freezeBucket(c, true /*freeze*/) }
+func unfreezeBucketHandler(c *cli.Context) error { return freezeBucket(c, false) }
+
+func freezeBucket(c *cli.Context, freeze bool) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if _, err := api.SetBucketProps(apiBP, bck, &cmn.BpropsToSet{Frozen: apc.Bool(freeze)}); err != nil {
+		return V(err)
+	}
+	if freeze {
+		actionDone(c, "Bucket "+bck.Cname("")+" is now frozen (read-only)")
+	} else {
+		actionDone(c, "Bucket "+bck.Cname("")+" is no longer frozen")
+	}
+	return nil
+}
+
+// comma- and/or space-separated permissions, including "ro", "rw", and "su";
+// (case-insensitive) unambiguous abbreviations are also accepted, e.g. "delete" => DELETE-OBJECT
+func parseAccessPerms(args []string) (access apc.AccessAttrs, err error) {
+	for _, arg := range args {
+		for _, perm := range splitCsv(arg) {
+			if perm = strings.TrimSpace(perm); perm == "" {
+				continue
+			}
+			acc, err := apc.StrToAccess(perm)
+			if err != nil {
+				if acc, err = accessByPrefix(perm); err != nil {
+					return 0, err
+				}
+			}
+			access |= acc
+		}
+	}
+	if access == 0 {
+		return 0, errors.New("no permissions specified")
+	}
+	return access, nil
+}
+
+func accessByPrefix(perm string) (apc.AccessAttrs, error) {
+	var (
+		match string
+		all   = apc.SupportedPermissions()
+		up    = strings.ToUpper(perm)
+	)
+	for _, v := range all {
+		if strings.EqualFold(v, perm) {
+			return apc.StrToAccess(v)
+		}
+	}
+	for _, v := range all {
+		if !strings.HasPrefix(strings.ToUpper(v), up) {
+			continue
+		}
+		if match != "" {
+			return 0, fmt.Errorf("ambiguous permission %q (matches %q and %q)", perm, match, v)
+		}
+		match = v
+	}
+	if match == "" {
+		return 0, fmt.Errorf("invalid permission %q (expecting one of: %s)", perm, strings.Join(all, ", "))
+	}
+	return apc.StrToAccess(match)
+}
+
 func displayPropsEqMsg(c *cli.Context, bck cmn.Bck) {
 	args := c.Args().Tail()
 	if len(args) == 1 && !isJSON(args[0]) {
@@ -559,6 +930,9 @@ func listAnyHandler(c *cli.Context) error {
 		}
 		return err
 	}
+	if _, _, err := parseFormatFlag(c, ""); err != nil {
+		return err
+	}
 
 	switch {
 	case objName != "": // list archive OR show specific obj (HEAD(obj))
@@ -0,0 +1,176 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles 'ais cluster support-bundle'.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+// support bundle: a single TAR.GZ to attach to bug reports
+// - cluster: Smap, BMD, cluster config, running jobs;
+// - each node: its config, stats and status, and current log;
+// - all configs are redacted (see bundleRedact);
+// - failure to collect any given item does not fail the command - the errors
+//   are reported and also included in the bundle (as "errors.txt")
+
+const bundleRedacted = "<redacted>"
+
+// (substrings of) JSON keys whose values are never included
+var bundleSecrets = []string{"secret", "password", "passwd", "token", "credential", "access_key"}
+
+type bundle struct {
+	aw   archive.Writer
+	errs []string
+	now  int64
+}
+
+func supportBundleHandler(c *cli.Context) error {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	outFile := parseStrFlag(c, bundleOutFlag)
+	if outFile == "" {
+		outFile = "ais-support-bundle-" + time.Now().Format("20060102-150405") + archive.ExtTarGz
+	} else if !strings.HasSuffix(outFile, archive.ExtTarGz) && !strings.HasSuffix(outFile, archive.ExtTgz) {
+		outFile += archive.ExtTarGz
+	}
+	if _, err := os.Stat(outFile); err == nil && !flagIsSet(c, yesFlag) {
+		if !confirm(c, fmt.Sprintf("Destination %q exists. Overwrite?", outFile)) {
+			return nil
+		}
+	}
+	file, err := os.Create(outFile)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", outFile, err)
+	}
+
+	b := &bundle{aw: archive.NewWriter(archive.ExtTarGz, file, nil /*cksum*/, &archive.Opts{}), now: time.Now().UnixNano()}
+	fmt.Fprintf(c.App.Writer, "Collecting support bundle from %d node%s...\n", smap.Count(), cos.Plural(smap.Count()))
+
+	// cluster
+	b.addJSON("cluster/smap.json", smap, nil)
+	bmd, err := api.GetBMD(apiBP)
+	b.addJSON("cluster/bmd.json", bmd, err)
+	cconfig, err := api.GetClusterConfig(apiBP)
+	b.addJSON("cluster/config.json", cconfig, err)
+	xs, err := api.QueryXactionSnaps(apiBP, &xact.ArgsMsg{OnlyRunning: true})
+	b.addJSON("cluster/running-jobs.json", xs, err)
+
+	// nodes
+	for _, nmap := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+		for _, node := range nmap {
+			b.addNode(node)
+		}
+	}
+
+	if len(b.errs) > 0 {
+		b.add("errors.txt", []byte(strings.Join(b.errs, "\n")+"\n"))
+	}
+	b.aw.Fini()
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	for _, e := range b.errs {
+		actionWarn(c, e)
+	}
+	actionDone(c, "Support bundle saved as "+outFile)
+	return nil
+}
+
+func (b *bundle) addNode(node *meta.Snode) {
+	dir := apc.Target + "-" + node.ID() + "/"
+	if node.IsProxy() {
+		dir = apc.Proxy + "-" + node.ID() + "/"
+	}
+	config, err := api.GetDaemonConfig(apiBP, node)
+	b.addJSON(dir+"config.json", config, err)
+	ds, err := api.GetStatsAndStatus(apiBP, node)
+	b.addJSON(dir+"stats.json", ds, err)
+
+	var buf bytes.Buffer
+	if _, err := api.GetDaemonLog(apiBP, node, api.GetLogInput{Writer: &buf}); err != nil {
+		b.errs = append(b.errs, fmt.Sprintf("%s: failed to get log: %v", node.StringEx(), err))
+		return
+	}
+	b.add(dir+"ais.log", buf.Bytes())
+}
+
+func (b *bundle) addJSON(name string, v any, err error) {
+	if err == nil {
+		var out []byte
+		if out, err = bundleRedact(v); err == nil {
+			b.add(name, out)
+			return
+		}
+	}
+	b.errs = append(b.errs, fmt.Sprintf("%s: %v", name, err))
+}
+
+func (b *bundle) add(name string, data []byte) {
+	oah := cos.SimpleOAH{Size: int64(len(data)), Atime: b.now}
+	if err := b.aw.Write(name, oah, bytes.NewReader(data)); err != nil {
+		b.errs = append(b.errs, fmt.Sprintf("%s: failed to archive: %v", name, err))
+	}
+}
+
+// marshal, and redact values of the (nested) keys that may contain secrets
+func bundleRedact(v any) ([]byte, error) {
+	b, err := jsoniter.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m any
+	if err := jsoniter.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return jsonMarshalIndent(_redact(m))
+}
+
+func _redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			switch {
+			case !_isSecret(k):
+				v[k] = _redact(val)
+			case val == nil || val == "":
+				// nothing to hide
+			default:
+				v[k] = bundleRedacted // including nested (e.g., credentials section)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = _redact(v[i])
+		}
+	}
+	return v
+}
+
+func _isSecret(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range bundleSecrets {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
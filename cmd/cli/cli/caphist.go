@@ -0,0 +1,129 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file contains implementation of `ais show storage --history`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/urfave/cli"
+)
+
+const (
+	capHistMaxRows  = 24 // per mountpath (downsampled unless verbose)
+	capHistBarWidth = 40
+)
+
+// in addition to the standard Go durations, support "d" (days), e.g. "7d"
+func parseHistoryDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid duration %q (expecting, e.g., \"7d\" or \"36h\")", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+func showCapHistory(c *cli.Context, tid string) error {
+	since, err := parseHistoryDuration(parseStrFlag(c, capHistoryFlag))
+	if err != nil {
+		return err
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	tids := make([]string, 0, len(smap.Tmap))
+	if tid != "" {
+		tids = append(tids, tid)
+	} else {
+		for id, tsi := range smap.Tmap {
+			if !tsi.InMaintOrDecomm() {
+				tids = append(tids, id)
+			}
+		}
+		if len(tids) == 0 {
+			return cmn.NewErrNoNodes(apc.Target, smap.CountTargets())
+		}
+		sort.Strings(tids)
+	}
+
+	all := make(map[string][]stats.CapSnap, len(tids))
+	for _, id := range tids {
+		snaps, err := api.GetCapHistory(apiBP, id, since)
+		if err != nil {
+			return V(err)
+		}
+		all[id] = snaps
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+
+	verbose := flagIsSet(c, verboseFlag)
+	for i, id := range tids {
+		if i > 0 {
+			fmt.Fprintln(c.App.Writer)
+		}
+		tname := smap.Tmap[id].StringEx()
+		snaps := all[id]
+		if len(snaps) == 0 {
+			fmt.Fprintf(c.App.Writer, "%s: no capacity history for the last %s\n", tname, since)
+			continue
+		}
+		mpaths := make([]string, 0, len(snaps[len(snaps)-1].Mountpaths))
+		for mpath := range snaps[len(snaps)-1].Mountpaths {
+			mpaths = append(mpaths, mpath)
+		}
+		sort.Strings(mpaths)
+		for _, mpath := range mpaths {
+			fmt.Fprintf(c.App.Writer, "%s %s:\n", tname, mpath)
+			_capHistTable(c, snaps, mpath, units, verbose)
+		}
+	}
+	return nil
+}
+
+func _capHistTable(c *cli.Context, snaps []stats.CapSnap, mpath, units string, verbose bool) {
+	step := 1
+	if !verbose && len(snaps) > capHistMaxRows {
+		step = (len(snaps) + capHistMaxRows - 1) / capHistMaxRows
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tUSED\tAVAIL\tUSED(%)\t")
+	for i := 0; i < len(snaps); i += step {
+		// always include the most recent snapshot
+		if i+step >= len(snaps) {
+			i = len(snaps) - 1
+		}
+		capacity, ok := snaps[i].Mountpaths[mpath]
+		if !ok {
+			continue
+		}
+		bar := strings.Repeat("#", int(capacity.PctUsed)*capHistBarWidth/100)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d%%\t%s\n", cos.FormatNanoTime(snaps[i].Time, ""),
+			teb.FmtSize(int64(capacity.Used), units, 2), teb.FmtSize(int64(capacity.Avail), units, 2),
+			capacity.PctUsed, bar)
+	}
+	tw.Flush()
+}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
@@ -26,10 +28,36 @@ const (
 
 var (
 	clusterCmdsFlags = map[string][]cli.Flag{
-		cmdCluAttach: {},
-		cmdCluDetach: {},
+		cmdCluAttach: {
+			skipRemValidationFlag,
+			remTestReadFlag,
+		},
+		cmdCluDetach:  {},
+		cmdCluRemTest: {remTestReadFlag},
+		cmdCluInit: {
+			bootProxiesFlag,
+			bootTargetsFlag,
+			bootMountpathsFlag,
+			bootTestMpathsFlag,
+			bootHostnameFlag,
+			bootHostnameCtrlFlag,
+			bootHostnameDataFlag,
+			bootPortFlag,
+			bootPortCtrlFlag,
+			bootPortDataFlag,
+			bootBackendsFlag,
+			bootEmitFlag,
+			bootStartFlag,
+			bootAisnodeFlag,
+			bootK8sNodeFlag,
+			bootTimeoutFlag,
+			yesFlag,
+		},
 		cmdCluConfig: {
 			transientFlag,
+			stageConfigFlag,
+			applyToFlag,
+			jsonFlag, // to show
 		},
 		cmdShutdown: {
 			yesFlag,
@@ -38,6 +66,15 @@ var (
 		cmdJoin: {
 			roleFlag,
 		},
+		cmdAddNode: {
+			addNodeTargetFlag,
+			addNodeProxyFlag,
+			forceFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+			progressFlag,
+			refreshFlag,
+		},
 		cmdStartMaint: {
 			noRebalanceFlag,
 			yesFlag,
@@ -62,8 +99,13 @@ var (
 			rmUserDataFlag,
 			yesFlag,
 		},
-		commandStart: {},
-		commandStop:  {},
+		commandStart: {
+			waitFlag,
+			waitJobXactFinishedFlag,
+			progressFlag,
+			refreshFlag,
+		},
+		commandStop: {},
 		commandShow: {
 			allJobsFlag,
 			noHeaderFlag,
@@ -92,20 +134,33 @@ var (
 		Subcommands: []cli.Command{
 			makeAlias(showCmdCluster, "", true, commandShow), // alias for `ais show`
 			{
-				Name:      cmdCluAttach,
-				Usage:     "attach remote ais cluster",
+				Name: cmdCluAttach,
+				Usage: "attach remote ais cluster; prior to attaching, check remote cluster's health\n" +
+					indent1 + "and cluster map (and, optionally, test-read a given remote bucket or object), e.g.:\n" +
+					indent1 + "\t* ais cluster remote-attach remais=http://10.0.0.1:51080\n" +
+					indent1 + "\t* ais cluster remote-attach remais=http://10.0.0.1:51080 --test-read ais://abc",
 				ArgsUsage: attachRemoteAISArgument,
-				Flags:     clusterCmdsFlags[cmdAttach],
+				Flags:     clusterCmdsFlags[cmdCluAttach],
 				Action:    attachRemoteAISHandler,
 			},
 			{
 				Name:         cmdCluDetach,
 				Usage:        "detach remote ais cluster",
 				ArgsUsage:    detachRemoteAISArgument,
-				Flags:        clusterCmdsFlags[cmdDetach],
+				Flags:        clusterCmdsFlags[cmdCluDetach],
 				Action:       detachRemoteAISHandler,
 				BashComplete: suggestRemote,
 			},
+			{
+				Name: cmdCluRemTest,
+				Usage: "diagnose attached remote ais cluster: check its health and cluster map, list its buckets\n" +
+					indent1 + "via this cluster (and, optionally, test-read a given remote bucket or object), e.g.:\n" +
+					indent1 + "\t* ais cluster remote-test remais --test-read ais://abc/obj",
+				ArgsUsage:    aliasArgument,
+				Flags:        clusterCmdsFlags[cmdCluRemTest],
+				Action:       remoteTestHandler,
+				BashComplete: suggestRemote,
+			},
 			{
 				Name:  cmdRebalance,
 				Usage: "administratively start and stop global rebalance; show global rebalance",
@@ -121,6 +176,15 @@ var (
 					},
 				},
 			},
+			{
+				Name: cmdAddNode,
+				Usage: "join new node(s) - targets and/or proxies - that are already running (e.g., started with '-standby');\n" +
+					indent1 + "prior to joining, validate each node: software version, clock skew, and (targets) mountpaths;\n" +
+					indent1 + "optionally, wait for the triggered rebalance to finish, e.g.:\n" +
+					indent1 + "\t* ais cluster add-node --target 10.0.0.5:51081,10.0.0.6:51081 --progress",
+				Flags:  clusterCmdsFlags[cmdAddNode],
+				Action: addNodeHandler,
+			},
 			{
 				Name:         cmdPrimary,
 				Usage:        "select a new primary proxy/gateway",
@@ -139,7 +203,39 @@ var (
 				Flags:     []cli.Flag{logSevFlag},
 				Action:    downloadAllLogs,
 			},
+			{
+				Name: cmdSupportBundle,
+				Usage: "collect configs (with secrets redacted), cluster map, BMD, running jobs, stats, and current logs\n" +
+					indent4 + "\tfrom all nodes into a single TAR.GZ to attach to bug reports, e.g.:\n" +
+					indent4 + "\t - 'support-bundle --out /tmp/bundle.tgz'",
+				Flags:  []cli.Flag{bundleOutFlag, yesFlag},
+				Action: supportBundleHandler,
+			},
+			{
+				Name: cmdCluInit,
+				Usage: "bootstrap a new cluster: generate cluster and node configurations (mountpaths, networks, ports)\n" +
+					indent4 + "\tand, optionally, start all nodes locally and verify the resulting cluster; or else generate\n" +
+					indent4 + "\tsystemd units or Kubernetes manifest; prompts interactively when no options are given, e.g.:\n" +
+					indent4 + "\t - 'init' - interactive;\n" +
+					indent4 + "\t - 'init /tmp/ais --proxies 1 --targets 3 --start' - local cluster with test mountpaths;\n" +
+					indent4 + "\t - 'init /etc/ais --mountpaths /ais/nvme0,/ais/nvme1 --backends aws --emit systemd'",
+				ArgsUsage: "[DIR]",
+				Flags:     clusterCmdsFlags[cmdCluInit],
+				Action:    clusterInitHandler,
+			},
 
+			{
+				Name: cmdCluConfig,
+				Usage: "update cluster configuration in a single all-or-nothing call: validate on all nodes, apply, and persist\n" +
+					indent1 + "as cluster-level config (that overrides node-local inherited values); roll back upon partial failure, e.g.:\n" +
+					indent1 + "\t* ais cluster configure lru.enabled=false checksum.type=xxhash\n" +
+					indent1 + "\t* ais cluster configure log.level=4 --stage\n" +
+					indent1 + "\t(same as 'ais config cluster')",
+				ArgsUsage:    keyValuePairsArgument,
+				Flags:        clusterCmdsFlags[cmdCluConfig],
+				Action:       setCluConfigHandler,
+				BashComplete: setCluConfigCompletions,
+			},
 			// cluster level (compare with the below)
 			{
 				Name:   cmdShutdown,
@@ -219,14 +315,133 @@ func attachRemoteAISHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return
 	}
+	validate := !flagIsSet(c, skipRemValidationFlag)
+	if validate {
+		if err = checkRemAIS(c, remBaseParams(url), parseStrFlag(c, remTestReadFlag)); err != nil {
+			return fmt.Errorf("remote cluster %s=%s failed validation: %v\n(use %s to attach anyway)",
+				alias, url, err, qflprn(skipRemValidationFlag))
+		}
+	}
 	if err = api.AttachRemoteAIS(apiBP, alias, url); err != nil {
 		return
 	}
 	msg := fmt.Sprintf("Remote cluster (%s=%s) successfully attached", alias, url)
 	actionDone(c, msg)
+	if validate {
+		// the cluster itself (as opposed to this CLI) must be able to reach the remote
+		if ra, err := findRemAIS(alias); err == nil && ra.Smap == nil {
+			actionWarn(c, fmt.Sprintf("remote cluster %s is attached but currently unreachable from this cluster "+
+				"(run 'ais cluster %s %s' to diagnose)\n", alias, cmdCluRemTest, alias))
+		}
+	}
 	return
 }
 
+func remoteTestHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	ra, err := findRemAIS(c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	// 1. as seen by this cluster
+	if ra.Smap == nil {
+		return fmt.Errorf("remote cluster %s (%s) is attached but unreachable from this cluster", ra.Alias, ra.URL)
+	}
+	fmt.Fprintf(c.App.Writer, "Remote cluster %s (%s): online, %s\n", ra.Alias, ra.URL, ra.Smap.StringEx())
+
+	// 2. directly from this CLI
+	if err := checkRemAIS(c, remBaseParams(ra.URL), "" /*test-read via cluster (below)*/); err != nil {
+		actionWarn(c, fmt.Sprintf("remote cluster %s is not accessible from this host: %v\n", ra.Alias, err))
+	}
+
+	// 3. via this cluster, with its access (and credentials)
+	qbck := cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.Ns{UUID: ra.Alias}}
+	bcks, err := api.ListBuckets(apiBP, qbck, apc.FltExists)
+	if err != nil {
+		return fmt.Errorf("failed to list %s buckets via this cluster: %v", qbck, V(err))
+	}
+	fmt.Fprintf(c.App.Writer, "Listed %d %s bucket(s) via this cluster\n", len(bcks), qbck)
+	if !flagIsSet(c, remTestReadFlag) {
+		return nil
+	}
+	bck, objName, err := parseBckObjURI(c, parseStrFlag(c, remTestReadFlag), true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	bck.Ns.UUID = ra.Alias
+	return remTestRead(c, apiBP, bck, objName)
+}
+
+// check health and cluster map of a remote cluster (and, optionally, test-read)
+func checkRemAIS(c *cli.Context, bp api.BaseParams, testRead string) error {
+	if err := api.Health(bp); err != nil {
+		return fmt.Errorf("health check: %v", err)
+	}
+	smap, err := api.GetClusterMap(bp)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster map: %v", err)
+	}
+	if smap.CountActiveTs() == 0 {
+		return fmt.Errorf("no active targets in %s", smap.StringEx())
+	}
+	fmt.Fprintf(c.App.Writer, "Remote cluster at %s: healthy, %s\n", bp.URL, smap.StringEx())
+	if testRead == "" {
+		return nil
+	}
+	bck, objName, err := parseBckObjURI(c, testRead, true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	return remTestRead(c, bp, bck, objName)
+}
+
+func remTestRead(c *cli.Context, bp api.BaseParams, bck cmn.Bck, objName string) error {
+	if objName != "" {
+		props, err := api.HeadObject(bp, bck, objName, apc.FltPresent, true /*silent*/)
+		if err != nil {
+			return fmt.Errorf("test-read %s: %v", bck.Cname(objName), err)
+		}
+		fmt.Fprintf(c.App.Writer, "Test-read %s: ok (size %s)\n", bck.Cname(objName), cos.ToSizeIEC(props.Size, 2))
+		return nil
+	}
+	lst, err := api.ListObjectsPage(bp, bck, &apc.LsoMsg{PageSize: 1})
+	if err != nil {
+		return fmt.Errorf("test-read %s: %v", bck.Cname(""), err)
+	}
+	fmt.Fprintf(c.App.Writer, "Test-read %s: ok (%d object name(s) listed)\n", bck.Cname(""), len(lst.Entries))
+	return nil
+}
+
+func findRemAIS(aliasOrUUID string) (*meta.RemAis, error) {
+	all, err := api.GetRemoteAIS(apiBP)
+	if err != nil {
+		return nil, V(err)
+	}
+	for _, ra := range all.A {
+		if ra.Alias == aliasOrUUID || ra.UUID == aliasOrUUID {
+			return ra, nil
+		}
+	}
+	return nil, fmt.Errorf("remote cluster %q is not attached (see 'ais show remote-cluster')", aliasOrUUID)
+}
+
+func remBaseParams(url string) api.BaseParams {
+	bp := api.BaseParams{
+		URL:   url,
+		Token: loggedUserToken,
+		UA:    ua,
+	}
+	if cos.IsHTTPS(bp.URL) {
+		// NOTE: alternatively, cmn.NewClientTLS(..., TLSArgs{SkipVerify: true})
+		bp.Client = clientTLS
+	} else {
+		bp.Client = clientH
+	}
+	return bp
+}
+
 func detachRemoteAISHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		err = missingArgumentsError(c, c.Command.ArgsUsage)
@@ -284,21 +499,11 @@ func clusterDecommissionHandler(c *cli.Context) error {
 	return nil
 }
 
-func joinNodeHandler(c *cli.Context) (err error) {
-	var (
-		daemonType, prefix string
-		addr, rebID        string
-		addrParts          []string
-	)
+func joinNodeHandler(c *cli.Context) error {
+	var daemonType string
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, "public IPv4:PORT address to communicate with the node")
 	}
-	addr = c.Args().Get(0)
-	addrParts = strings.Split(addr, ":")
-	if len(addrParts) != 2 {
-		return fmt.Errorf("invalid address, expecting 'IPv4:PORT'")
-	}
-
 	switch parseStrFlag(c, roleFlag) {
 	case apc.Proxy, roleProxyShort:
 		daemonType = apc.Proxy
@@ -308,12 +513,29 @@ func joinNodeHandler(c *cli.Context) (err error) {
 		return fmt.Errorf("invalid aisnode role, must be one of: %q (or %q), %q (or %q)",
 			apc.Proxy, roleProxyShort, apc.Target, roleTargetShort)
 	}
+	sname, rebID, err := joinNode(c, c.Args().Get(0), daemonType)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "%s successfully joined the cluster\n", sname)
+	if rebID != "" {
+		fmt.Fprintf(c.App.Writer, fmtRebalanceStarted, rebID)
+	}
+	return nil
+}
 
+// join a (running) node given its public IPv4:PORT; returns the node's name and
+// the ID of the rebalance triggered by the join, if any
+func joinNode(c *cli.Context, addr, daemonType string) (sname, rebID string, err error) {
+	addrParts := strings.Split(addr, ":")
+	if len(addrParts) != 2 {
+		return "", "", fmt.Errorf("invalid address %q, expecting 'IPv4:PORT'", addr)
+	}
 	if addrParts[0] == "localhost" {
 		addrParts[0] = "127.0.0.1"
 	}
 
-	prefix = getPrefixFromPrimary()
+	prefix := getPrefixFromPrimary()
 	netInfo := meta.NetInfo{
 		Hostname: addrParts[0],
 		Port:     addrParts[1],
@@ -328,21 +550,12 @@ func joinNodeHandler(c *cli.Context) (err error) {
 		ControlNet: netInfo,
 	}
 	if rebID, nodeInfo.DaeID, err = api.JoinCluster(apiBP, nodeInfo); err != nil {
-		return
+		return "", "", err
 	}
 
 	// double check
-	var sname string
 	_, sname, err = getNode(c, nodeInfo.DaeID)
-	if err != nil {
-		return
-	}
-	fmt.Fprintf(c.App.Writer, "%s successfully joined the cluster\n", sname)
-
-	if rebID != "" {
-		fmt.Fprintf(c.App.Writer, fmtRebalanceStarted, rebID)
-	}
-	return
+	return sname, rebID, err
 }
 
 // (compare w/ cluster-level clusterDecommissionHandler & clusterShutdownHandler)
@@ -43,6 +43,8 @@ var (
 		feat.FeaturesPropName:                 append(feat.All, NilValue),
 		"write_policy.data":                   apc.SupportedWritePolicy,
 		"write_policy.md":                     apc.SupportedWritePolicy,
+		"dedup.chunking":                      apc.SupportedDedupChunking,
+		"replication.conflict":                apc.SupportedReplConflict,
 		"ec.compression":                      apc.SupportedCompression,
 		"compression.checksum":                apc.SupportedCompression,
 		"rebalance.compression":               apc.SupportedCompression,
@@ -56,6 +58,12 @@ var (
 		"checksum.validate_cold_get":          supportedBool,
 		"checksum.validate_warm_get":          supportedBool,
 		"checksum.validate_obj_move":          supportedBool,
+		"dedup.enabled":                       supportedBool,
+		"frozen":                              supportedBool,
+		"packing.enabled":                     supportedBool,
+		"rate_limit.enabled":                  supportedBool,
+		"replication.enabled":                 supportedBool,
+		"replication.bidirectional":           supportedBool,
 		"ec.enabled":                          supportedBool,
 		"fshc.enabled":                        supportedBool,
 		"lru.enabled":                         supportedBool,
@@ -386,7 +394,7 @@ func (opts *bcmplop) remoteBuckets(c *cli.Context) {
 	var (
 		buckets []cmn.Bck
 	)
-	for _, provider := range []string{apc.AWS, apc.GCP, apc.Azure} {
+	for _, provider := range []string{apc.AWS, apc.GCP, apc.Azure, apc.OCI} {
 		qbck := cmn.QueryBcks{Provider: provider}
 		bcks, err := api.ListBuckets(apiBP, qbck, apc.FltPresent) // NOTE: `present` only
 		if err != nil {
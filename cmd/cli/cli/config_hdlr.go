@@ -28,6 +28,8 @@ var (
 	configCmdsFlags = map[string][]cli.Flag{
 		cmdCluster: {
 			transientFlag,
+			stageConfigFlag,
+			applyToFlag,
 			jsonFlag, // to show
 		},
 		cmdNode: {
@@ -50,6 +52,8 @@ Usage examples:
 - ais config cluster checksum.type=xxhash
 - ais config cluster checksum.type=md5 checksum.validate_warm_get=true
 - ais config cluster checksum --json
+- ais config cluster lru.enabled=false --stage
+- ais config cluster disk.disk_util_high_wm=93 --apply-to targets
 For more usage examples, see ` + cmn.GitHubHome + `/blob/main/docs/cli/config.md
 `
 
@@ -137,6 +141,7 @@ func setCluConfigHandler(c *cli.Context) error {
 	var (
 		nvs      cos.StrKVs
 		config   cmn.Config
+		applyTo  string
 		propList = make([]string, 0, 48)
 		args     = c.Args()
 		kvs      = args.Tail()
@@ -177,7 +182,11 @@ func setCluConfigHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	applyTo = parseStrFlag(c, applyToFlag)
 	if useMsg {
+		if flagIsSet(c, stageConfigFlag) || applyTo != "" {
+			return fmt.Errorf("JSON-formatted values cannot be used with %s or %s", qflprn(stageConfigFlag), qflprn(applyToFlag))
+		}
 		if err := setcfg(c, nvs); err != nil { // api.SetClusterConfigUsingMsg (vs. api.SetClusterConfig below)
 			return fmt.Errorf("%v%s", err, examplesCluSetCfg)
 		}
@@ -199,15 +208,23 @@ func setCluConfigHandler(c *cli.Context) error {
 		}
 	}
 
+	if flagIsSet(c, stageConfigFlag) {
+		return stageCluConfig(c, nvs, applyTo)
+	}
+
 	// assorted named fields that require (cluster | node) restart
 	// for the change to take an effect
 	if name := nvs.ContainsAnyMatch(cmn.ConfigRestartRequired); name != "" {
 		warn := fmt.Sprintf("cluster restart required for the change '%s=%s' to take an effect.", name, nvs[name])
 		actionWarn(c, warn)
 	}
-	if err := api.SetClusterConfig(apiBP, nvs, flagIsSet(c, transientFlag)); err != nil {
+	if err := api.SetClusterConfigScoped(apiBP, nvs, flagIsSet(c, transientFlag), applyTo); err != nil {
 		return V(err)
 	}
+	if applyTo != "" {
+		actionDone(c, "Config updated on "+applyTo+" (node-local override)")
+		return nil
+	}
 
 show:
 	var listed = make(cos.StrKVs)
@@ -225,6 +242,27 @@ show:
 	return nil
 }
 
+// validate and show what would change (without applying)
+func stageCluConfig(c *cli.Context, nvs cos.StrKVs, applyTo string) error {
+	staged, err := api.StageClusterConfig(apiBP, nvs, applyTo)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(staged, "", teb.Jopts(true))
+	}
+	if len(staged) == 0 {
+		actionDone(c, "Validated: no changes")
+		return nil
+	}
+	if err := teb.Print(staged, teb.StagedConfigTmpl); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Validated: %d node%s would change (use without %s to apply)",
+		len(staged), cos.Plural(len(staged)), qflprn(stageConfigFlag)))
+	return nil
+}
+
 // an extra call to get the current (ref 836)
 func parseLogModules(v string) (string, error) {
 	config, err := api.GetClusterConfig(apiBP)
@@ -244,6 +282,8 @@ func parseLogModules(v string) (string, error) {
 // ais config cluster backend.conf='{"aws":{}}'
 // ais config cluster backend.conf '{"gcp":{}, "aws":{}}'
 // ais config cluster checksum.type='{"type":"md5"}'
+// ais config cluster slo='{"get-latency": {"metric": "get.ns", "max_avg_latency": "50ms"}}'
+// ais config cluster bucket_profiles='{"training-data": {"ec": {"enabled": true, "data_slices": 4, "parity_slices": 2}}}'
 func isFmtJSON(nvs cos.StrKVs) (val string, ans bool, err error) {
 	jsonRe := regexp.MustCompile(`^{.*}$`)
 	for _, v := range nvs {
@@ -274,6 +314,10 @@ func setcfg(c *cli.Context, nvs cos.StrKVs) error {
 			jsoniter.Unmarshal([]byte(v), &toUpdate.Log)
 		case k == "checksum" || strings.HasPrefix(k, "checksum."):
 			jsoniter.Unmarshal([]byte(v), &toUpdate.Cksum)
+		case k == "bucket_profiles":
+			jsoniter.Unmarshal([]byte(v), &toUpdate.BucketProfiles)
+		case k == "slo":
+			jsoniter.Unmarshal([]byte(v), &toUpdate.SLO)
 		default:
 			return fmt.Errorf("cannot update config using JSON-formatted %q - not implemented yet", k)
 		}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/urfave/cli"
@@ -31,8 +32,11 @@ const (
 	commandETL      = apc.ETL   // TODO: add `ais show etl`
 	commandAlias    = "alias"   // TODO: ditto alias
 	commandArch     = "archive" // TODO: ditto archive
+	commandModel    = "model"
+	commandView     = "view"
 
 	commandSearch = "search"
+	commandShell  = "shell"
 )
 
 // top-level `show`
@@ -48,6 +52,21 @@ const (
 	cmdRandNode      = "random-node"
 	cmdRandMountpath = "random-mountpath"
 	cmdRotateLogs    = "rotate-logs"
+	cmdWhy           = "why"
+	cmdProfile       = "profile"
+)
+
+// named object ranges (`ais view`)
+const (
+	cmdViewCreate = "create"
+	cmdViewShow   = commandShow
+	cmdViewRm     = commandRemove
+)
+
+// model repository subcommands (`ais model`)
+const (
+	cmdModelPush = "push"
+	cmdModelPull = "pull"
 )
 
 // - 2nd level subcommands (mostly, verbs)
@@ -61,6 +80,8 @@ const (
 	commandGet       = "get"
 	commandList      = "ls"
 	commandSetCustom = "set-custom"
+	commandSetProps  = "set-props"
+	commandBatch     = "batch"
 	commandPut       = "put"
 	commandRemove    = "rm"
 	commandRename    = "mv"
@@ -93,9 +114,14 @@ const (
 	cmdDsort        = apc.ActDsort
 	cmdRebalance    = apc.ActRebalance
 	cmdLRU          = apc.ActLRU
+	cmdInitShard    = apc.ActInitShard
+	cmdCheckOOB     = apc.ActCheckOOB
 	cmdStgCleanup   = "cleanup" // display name for apc.ActStoreCleanup
 	cmdStgValidate  = "validate"
 	cmdSummary      = "summary" // ditto apc.ActSummaryBck
+	cmdHeatmap      = "heatmap" // ditto apc.ActHeatmapBck
+	cmdSample       = "sample"  // ditto apc.ActSampleBck
+	cmdDu           = "du"      // apc.ActSummaryBck with size breakdown by prefix
 
 	cmdCluster    = commandCluster
 	cmdNode       = "node"
@@ -109,14 +135,18 @@ const (
 	cmdDetach     = "detach"
 	cmdResetStats = "reset-stats"
 
-	cmdDownloadLogs = "download-logs"
-	cmdViewLogs     = "view-logs" // etl
+	cmdDownloadLogs  = "download-logs"
+	cmdSupportBundle = "support-bundle"
+	cmdViewLogs      = "view-logs" // etl
 
 	// Cluster subcommands
-	cmdCluAttach = "remote-" + cmdAttach
-	cmdCluDetach = "remote-" + cmdDetach
-	cmdCluConfig = "configure"
-	cmdReset     = "reset"
+	cmdCluAttach  = "remote-" + cmdAttach
+	cmdCluDetach  = "remote-" + cmdDetach
+	cmdCluRemTest = "remote-test"
+	cmdCluConfig  = "configure"
+	cmdCluInit    = "init"
+	cmdAddNode    = "add-node"
+	cmdReset      = "reset"
 
 	// Mountpath (disk) actions
 	cmdMpathAttach  = cmdAttach
@@ -136,27 +166,42 @@ const (
 	cmdShowStats      = "stats"
 	cmdMountpath      = "mountpath"
 	cmdCapacity       = "capacity"
+	cmdShowUsage      = "usage"
 	cmdShowDisk       = apc.WhatDiskStats
 	cmdShowCounters   = "counters"
 	cmdShowThroughput = "throughput"
 	cmdShowLatency    = "latency"
+	cmdShowSLO        = "slo"
 
 	// Bucket properties subcommands
 	cmdSetBprops   = "set"
 	cmdResetBprops = cmdReset
 
+	// Bucket access subcommands
+	cmdAccess      = "access"
+	cmdAccessAllow = "allow"
+	cmdAccessDeny  = "deny"
+
+	// Bucket freeze (read-only toggle)
+	cmdFreeze   = "freeze"
+	cmdUnfreeze = "unfreeze"
+
 	// AuthN subcommands
-	cmdAuthAdd     = "add"
-	cmdAuthShow    = "show"
-	cmdAuthSet     = commandSet
-	cmdAuthRemove  = commandRemove
-	cmdAuthLogin   = "login"
-	cmdAuthLogout  = "logout"
-	cmdAuthUser    = "user"
-	cmdAuthRole    = "role"
-	cmdAuthCluster = cmdCluster
-	cmdAuthToken   = "token"
-	cmdAuthConfig  = cmdConfig
+	cmdAuthAdd         = "add"
+	cmdAuthShow        = "show"
+	cmdAuthSet         = commandSet
+	cmdAuthRemove      = commandRemove
+	cmdAuthLogin       = "login"
+	cmdAuthLogout      = "logout"
+	cmdAuthUser        = "user"
+	cmdAuthRole        = "role"
+	cmdAuthCluster     = cmdCluster
+	cmdAuthToken       = "token"
+	cmdAuthConfig      = cmdConfig
+	cmdAuthAudit       = "audit"
+	cmdAuthEnroll      = "enroll"
+	cmdAuthEnrollToken = "enrollment-token"
+	cmdAuthRotateKey   = "rotate-key"
 
 	// K8s subcommans
 	cmdK8s        = "kubectl"
@@ -231,8 +276,9 @@ const (
 	showPerfArgument = "show performance counters, throughput, latency, and more (" + tabtab + " specific view)"
 
 	// ETL
-	etlNameArgument     = "ETL_NAME"
-	etlNameListArgument = "ETL_NAME [ETL_NAME ...]"
+	etlNameArgument         = "ETL_NAME"
+	optionalETLNameArgument = "[ETL_NAME]"
+	etlNameListArgument     = "ETL_NAME [ETL_NAME ...]"
 
 	// key/value
 	keyValuePairsArgument = "KEY=VALUE [KEY=VALUE...]"
@@ -244,6 +290,7 @@ const (
 	bucketsArgument        = "BUCKET [BUCKET...]"
 	bucketPropsArgument    = bucketArgument + " " + jsonKeyValueArgument + " | " + keyValuePairsArgument
 	bucketAndPropsArgument = "BUCKET [PROP_PREFIX]"
+	bucketAccessArgument   = bucketArgument + " PERMISSION[,PERMISSION...] [PERMISSION...]"
 
 	bucketObjectOrTemplateMultiArg = "BUCKET[/OBJECT_NAME_or_TEMPLATE] [BUCKET[/OBJECT_NAME_or_TEMPLATE] ...]"
 
@@ -278,6 +325,23 @@ const (
 		indent1 +
 		"mykey1=value1 mykey2=value2 OR '{\"mykey1\":\"value1\", \"mykey2\":\"value2\"}'"
 
+	setPropsArgument = objectArgument + " " + keyValuePairsArgument + ", e.g.:\n" +
+		indent1 +
+		"mykey1=value1 " + objPropPinned + "=true " + objPropCksumType + "=md5 " + objPropCksumVal + "=0cc175b9c0f1b6a831c399e269772661"
+
+	batchOpsArgument = bucketArgument + " OPS_FILE|-" + ", where OPS_FILE (or STDIN) contains JSON, e.g.:\n" +
+		indent1 +
+		"{\"ops\": [{\"op\": \"set-props\", \"name\": \"o1\", \"props\": {\"custom\": {\"k\": \"v\"}}}, {\"op\": \"delete\", \"name\": \"o2\"}]}"
+
+	// models
+	modelPushArgument = "FILE|DIRECTORY BUCKET/MODEL[@VERSION]"
+	modelPullArgument = "BUCKET/MODEL[@VERSION_or_TAG] [OUT_DIR]"
+	modelListArgument = "BUCKET[/MODEL]"
+
+	// views
+	viewCreateArgument = "NAME BUCKET[/PREFIX_or_TEMPLATE]"
+	viewNameArgument   = "NAME"
+
 	// nodes
 	nodeIDArgument            = "NODE_ID"
 	optionalNodeIDArgument    = "[NODE_ID]"
@@ -323,6 +387,7 @@ const (
 	addSetAuthRoleArgument    = "ROLE [PERMISSION ...]"
 	deleteAuthRoleArgument    = "ROLE"
 	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE" //nolint:gosec // false positive G101
+	enrollAuthArgument        = "ENROLLMENT_TOKEN [ALIAS]"
 
 	// Alias
 	aliasURLPairArgument = "ALIAS=URL (or UUID=URL)"
@@ -342,6 +407,19 @@ const (
 	cfgScopeInherited = "inherited"
 )
 
+// origin of a node's (inherited) config value
+const (
+	cfgOriginCluster = "cluster"
+	cfgOriginNode    = "node override"
+)
+
+// reserved (system) names in 'ais object set-props' (all other names denote custom metadata)
+const (
+	objPropPinned    = "pinned"
+	objPropCksumType = "checksum.type"
+	objPropCksumVal  = "checksum.value"
+)
+
 //
 // Command-line Options aka Flags
 //
@@ -350,7 +428,7 @@ var (
 	indent2 = strings.Repeat(indent1, 2)
 	indent4 = strings.Repeat(indent1, 4)
 
-	archFormats = ".tar, .tgz or .tar.gz, .zip, .tar.lz4" // namely, archive.FileExtensions
+	archFormats = ".tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst" // namely, archive.FileExtensions
 	archExts    = "(" + archFormats + ")"
 
 	//
@@ -387,6 +465,12 @@ var (
 			indent4 + "\t--props \"ec, copies, custom, location\"",
 	}
 
+	objProvenanceFlag = cli.BoolFlag{
+		Name: "provenance",
+		Usage: "show where the object came from: original source (URL or remote backend),\n" +
+			indent4 + "	job (download, dsort, ETL, copy, etc.) that created it, or the client that PUT it",
+	}
+
 	// prefix (to match)
 	listObjPrefixFlag = cli.StringFlag{
 		Name: "prefix",
@@ -487,6 +571,14 @@ var (
 			indent1 + "\t see also: 'ais bucket props show' and 'ais bucket props set')",
 	}
 
+	bucketProfileFlag = cli.StringFlag{
+		Name: "profile",
+		Usage: "create bucket with the named set of properties (bucket profile) defined in the cluster config, e.g.:\n" +
+			indent1 + "\t* ais create ais://mmm --profile training-data\n" +
+			indent1 + "\t* ais create ais://nnn --profile training-data --props='checksum.type=md5'\n" +
+			indent1 + "\t(tip: '--props', if specified, override the profile; to define profiles, run 'ais config cluster bucket_profiles')",
+	}
+
 	forceFlag = cli.BoolFlag{Name: "force,f", Usage: "force an action"}
 
 	// units enum { unitsIEC, unitsSI, unitsRaw }
@@ -504,6 +596,11 @@ var (
 		Usage: "list bucket's content alphabetically starting with the first name _after_ the specified",
 	}
 	objLimitFlag = cli.IntFlag{Name: "limit", Usage: "limit object name count (0 - unlimited)"}
+	lsHeadFlag   = cli.IntFlag{
+		Name: "head",
+		Usage: "list the first N objects discovered by the cluster - unordered, best-effort, and fast;\n" +
+			indent4 + "\te.g., to peek into or check whether a very large bucket is empty: 'ais ls ais://abc --head 1'",
+	}
 	pageSizeFlag = cli.IntFlag{
 		Name:  "page-size",
 		Usage: "maximum number of names per page (0 - the maximum is defined by the corresponding backend)",
@@ -511,6 +608,40 @@ var (
 	copiesFlag   = cli.IntFlag{Name: "copies", Usage: "number of object replicas", Value: 1, Required: true}
 	maxPagesFlag = cli.IntFlag{Name: "max-pages", Usage: "display up to this number pages of bucket objects"}
 
+	// bucket heatmap
+	heatmapTopFlag = cli.IntFlag{
+		Name:  "top",
+		Usage: "number of hottest objects to show",
+		Value: apc.DefaultHeatmapTopN,
+	}
+
+	// bucket du
+	duDepthFlag = cli.IntFlag{
+		Name:  "depth",
+		Usage: "break down sizes by virtual directory up to this depth (relative to the prefix, if specified)",
+		Value: 1,
+	}
+	bsummGroupByFlag = cli.StringFlag{
+		Name: "group-by",
+		Usage: "in addition to totals, show object counts and sizes grouped by one of:\n" +
+			indent4 + "\t'" + apc.BsummGroupExt + "' - file extension (e.g., '.jpg', '.tar.gz');\n" +
+			indent4 + "\t'" + apc.BsummGroupCtype + "' - content type (backend-provided or derived from the extension);\n" +
+			indent4 + "\t'" + apc.BsummGroupPrefix + "' - top-level virtual directory (relative to the prefix, if specified)",
+	}
+	bsummEstimateFlag = cli.IntFlag{
+		Name: "estimate",
+		Usage: "quickly estimate (rather than count) the number of objects and their total size:\n" +
+			indent4 + "\tsample up to N objects per target (per bucket) and extrapolate from the bucket's size on disk;\n" +
+			indent4 + "\tthe results are labeled as estimates and include 95% confidence bounds for the number of objects",
+	}
+
+	// bucket sample
+	sampleCountFlag = cli.IntFlag{
+		Name:  "count",
+		Usage: "number of randomly selected object names to show",
+		Value: apc.DefaultSampleSize,
+	}
+
 	validateSummaryFlag = cli.BoolFlag{
 		Name:  "validate",
 		Usage: "perform checks (correctness of placement, number of copies, and more) and show the corresponding error counts",
@@ -520,10 +651,28 @@ var (
 		Usage: "show object numbers, bucket sizes, and used capacity;\n" +
 			indent4 + "\tnote: applies only to buckets and objects that are _present_ in the cluster",
 	}
+	noRecursFlag = cli.BoolFlag{
+		Name: "no-recursion,nr",
+		Usage: "list only objects and virtual directories at the given (prefix) level - do not recurse into directories, e.g.:\n" +
+			indent4 + "\t'ais ls ais://abc --no-recursion' - list top-level objects and directories;\n" +
+			indent4 + "\t'ais ls ais://abc --prefix a/b/ --nr' - list the content of the virtual directory a/b/",
+	}
 	pagedFlag = cli.BoolFlag{
 		Name:  "paged",
 		Usage: "list objects page by page, one page at a time (see also '--page-size' and '--limit')",
 	}
+	lsStreamFlag = cli.BoolFlag{
+		Name: "stream",
+		Usage: "stream the listing: print one JSON object per line (JSON Lines) as pages arrive,\n" +
+			indent4 + "\tinstead of accumulating the entire listing in memory (e.g., to pipe a huge listing into other tools)",
+	}
+	lsFormatFlag = cli.StringFlag{
+		Name: "format",
+		Usage: "machine-readable output instead of the default table: one of 'json', 'csv', or 'template=<go-template>', e.g.:\n" +
+			indent4 + "\t'ais ls ais://abc --format json | jq ...'\n" +
+			indent4 + "\t'ais ls s3: --format csv --no-headers';\n" +
+			indent4 + "\t'ais ls ais://abc --props size,atime --format \"template={{.Name}}\\t{{.Size}}\"' - template is executed for each listed entry",
+	}
 	showUnmatchedFlag = cli.BoolFlag{
 		Name:  "show-unmatched",
 		Usage: "list also objects that were _not_ matched by regex and/or template (range)",
@@ -551,6 +700,30 @@ var (
 		Usage: "log severity is either 'i' or 'info' (default, can be omitted), or 'error', whereby error logs contain\n" +
 			indent4 + "\tonly errors and warnings, e.g.: '--severity info', '--severity error', '--severity e'",
 	}
+	// runtime profiling ('ais advanced profile')
+	profCPUFlag = DurationFlag{
+		Name:  apc.ProfileCPU,
+		Usage: "sample CPU usage for the specified duration, e.g. '--cpu 30s' (max 5m)",
+	}
+	profHeapFlag      = cli.BoolFlag{Name: apc.ProfileHeap, Usage: "memory allocations of live objects"}
+	profGoroutineFlag = cli.BoolFlag{Name: apc.ProfileGoroutine, Usage: "stack traces of all current goroutines"}
+	profMutexFlag     = DurationFlag{
+		Name:  apc.ProfileMutex,
+		Usage: "sample holders of contended mutexes for the specified duration (max 5m)",
+	}
+	profBlockFlag = DurationFlag{
+		Name:  apc.ProfileBlock,
+		Usage: "sample goroutines blocked on synchronization primitives for the specified duration (max 5m)",
+	}
+	profOutFlag = cli.StringFlag{
+		Name:  "out",
+		Usage: "destination file (default: '<NODE_ID>-<PROFILE>.pb.gz' in the current directory)",
+	}
+
+	bundleOutFlag = cli.StringFlag{
+		Name:  "out",
+		Usage: "destination TAR.GZ (default: 'ais-support-bundle-<timestamp>.tar.gz' in the current directory)",
+	}
 	logFlushFlag = DurationFlag{
 		Name:  "log-flush",
 		Usage: "can be used in combination with " + qflprn(refreshFlag) + " to override configured '" + nodeLogFlushName + "'",
@@ -572,6 +745,13 @@ var (
 		Value: dload.DownloadProgressInterval.String(),
 	}
 
+	dloadSyncIntervalFlag = cli.StringFlag{
+		Name: "sync-interval",
+		Usage: "continuously mirror remote bucket (prefix) or range of links: re-list and download new and changed objects\n" +
+			indent4 + "\tevery so often, until the job is aborted (use with '--sync' to also delete objects removed at the source);\n" +
+			indent4 + "\te.g.: --sync-interval 1h (minimum: 10s); valid time units: " + timeUnits,
+	}
+
 	limitConnectionsFlag = cli.IntFlag{
 		Name:  "max-conns",
 		Usage: "max number of connections each target can make concurrently (up to num mountpaths)",
@@ -587,6 +767,11 @@ var (
 		Name:  "object-list,from",
 		Usage: "path to file containing JSON array of object names to download",
 	}
+	dloadManifestFlag = cli.StringFlag{
+		Name: "manifest",
+		Usage: "download all links listed in the specified object (that must be already stored in the cluster):\n" +
+			indent4 + "\tone link per line or CSV 'url,objname', e.g.: --manifest ais://nnn/urls.csv (see docs/downloader.md)",
+	}
 
 	// sync
 	latestVerFlag = cli.BoolFlag{
@@ -598,6 +783,12 @@ var (
 			indent1 + "\t- the latter can be done using 'ais bucket props set BUCKET versioning'\n" +
 			indent1 + "\t- see also: 'ais ls --check-versions', 'ais cp', 'ais prefetch', 'ais get'",
 	}
+	objVersionFlag = cli.StringFlag{
+		Name: "version",
+		Usage: "GET the specified version of the object (ais:// buckets only);\n" +
+			indent1 + "\tprevious versions are retained upon overwrite if the bucket is configured to do so, e.g.:\n" +
+			indent1 + "\t'ais bucket props set ais://abc versioning.retain 5'",
+	}
 	syncFlag = cli.BoolFlag{
 		Name: "sync",
 		Usage: "synchronize destination bucket with its remote (e.g., Cloud or remote AIS) source;\n" +
@@ -612,6 +803,39 @@ var (
 	dsortFcountFlag = cli.IntFlag{Name: "fcount", Value: 5, Usage: "number of files in a shard"}
 	dsortSpecFlag   = cli.StringFlag{Name: "file,f", Value: "", Usage: "path to JSON or YAML job specification"}
 
+	// initial sharding (apc.ActInitShard)
+	shardTmplFlag = cli.StringFlag{
+		Name: "shard-template",
+		Usage: "shard naming template; must contain \"" + apc.ShardTmplTID + "\" and \"" + apc.ShardTmplSeq + "\" placeholders,\n" +
+			indent4 + "\tand must end with one of the supported archive extensions (" + strings.Join(archive.FileExtensions, ", ") + ")",
+		Value: apc.DefaultInitShardTmpl,
+	}
+	shardOrderFlag = cli.StringFlag{
+		Name:  "order",
+		Usage: "order in which samples are packed into shards: \"" + apc.ShardOrderName + "\" or \"" + apc.ShardOrderHash + "\" (shuffle)",
+		Value: apc.ShardOrderName,
+	}
+	shardSizeFlag = cli.StringFlag{
+		Name:  "shard-size",
+		Usage: "target shard size in IEC or SI units, or \"raw\" bytes (e.g.: 256mb, 1GiB); see '--units'",
+		Value: "1GiB",
+	}
+	shardDeleteSrcFlag = cli.BoolFlag{
+		Name:  deleteSrcFlag.Name,
+		Usage: "delete loose (source) objects once they have been successfully sharded",
+	}
+
+	// out-of-band change detection (apc.ActCheckOOB)
+	oobActionFlag = cli.StringFlag{
+		Name: "stale",
+		Usage: "what to do with stale objects (changed or deleted out-of-band), one of:\n" +
+			indent4 + "\t\"" + apc.OOBReport + "\" - report only;\n" +
+			indent4 + "\t\"" + apc.OOBMark + "\" - mark stale, to be (re)fetched from the remote backend upon the next GET;\n" +
+			indent4 + "\t\"" + apc.OOBEvict + "\" - evict\n" +
+			indent4 + "\t(in all cases but \"" + apc.OOBReport + "\", objects deleted out-of-band get evicted)",
+		Value: apc.OOBReport,
+	}
+
 	cleanupFlag = cli.BoolFlag{
 		Name:  "cleanup",
 		Usage: "remove old bucket and create it again (warning: removes the entire content of the old bucket)",
@@ -665,6 +889,13 @@ var (
 			indent4 + "\t--template \"/abc/prefix-{0010..9999..2}-suffix\"",
 	}
 
+	fromStdinFlag = cli.BoolFlag{
+		Name: "from-stdin",
+		Usage: "read object names from standard input, one name per line, e.g.:\n" +
+			indent4 + "\t'cat names.txt | ais prefetch s3://abc --from-stdin';\n" +
+			indent4 + "\t'ais ls ais://abc --prefix a/b --format \"template={{.Name}}\" | ais cp ais://abc ais://xyz --from-stdin'\n" +
+			indent4 + "\t(large inputs are split into batches of up to 10K names, one job per batch)",
+	}
 	listRangeProgressWaitFlags = []cli.Flag{
 		listFlag,
 		templateFlag,
@@ -745,6 +976,22 @@ var (
 	deleteSrcFlag = cli.BoolFlag{Name: "delete-src", Usage: "delete successfully promoted source"}
 	targetIDFlag  = cli.StringFlag{Name: "target-id", Usage: "ais target designated to carry out the entire operation"}
 
+	hardLinkFlag = cli.BoolFlag{
+		Name: "hard-link",
+		Usage: "promote by hard-linking source files that reside on the same filesystem as the destination\n" +
+			indent4 + "\t(otherwise, copy); note: the object and its source then share the same inode",
+	}
+	includeFlag = cli.StringFlag{
+		Name: "include",
+		Usage: "comma-separated list of shell filename patterns to select source files, e.g.:\n" +
+			indent4 + "\t--include '*.tar,*.tgz'\t- only tarballs;\n" +
+			indent4 + "\t--include 'train/*.jpg'\t- patterns with path separators match relative pathnames",
+	}
+	excludeFlag = cli.StringFlag{
+		Name:  "exclude",
+		Usage: "comma-separated list of shell filename patterns to skip source files (see also: '--include')",
+	}
+
 	notFshareFlag = cli.BoolFlag{
 		Name: "not-file-share",
 		Usage: "each target must act autonomously skipping file-share auto-detection and promoting the entire source " +
@@ -753,6 +1000,14 @@ var (
 
 	yesFlag = cli.BoolFlag{Name: "yes,y", Usage: "assume 'yes' to all questions"}
 
+	// global (app-level) flags, e.g.: 'ais --yes --quiet bucket rm ais://abc'
+	// (for non-interactive use: cron, CI, scripts)
+	globalYesFlag = cli.BoolFlag{Name: yesFlag.Name, Usage: "non-interactive mode: assume 'yes' to all questions (all commands)"}
+	quietFlag     = cli.BoolFlag{
+		Name:  "quiet,q",
+		Usage: "no progress bars and informational messages - print only results and errors (all commands)",
+	}
+
 	chunkSizeFlag = cli.StringFlag{
 		Name:  "chunk-size",
 		Usage: "chunk size in IEC or SI units, or \"raw\" bytes (e.g.: 4mb, 1MiB, 1048576, 128k; see '--units')",
@@ -778,6 +1033,36 @@ var (
 		Usage: "concatenate files: append a file or multiple files as a new _or_ to an existing object",
 	}
 
+	nameByHashFlag = cli.StringFlag{
+		Name: "name-by-hash",
+		Usage: "when writing from standard input: name the object by its content digest (e.g. sha256),\n" +
+			indent4 + "\twith the destination object name (if any) becoming a prefix; skip writing if the object exists\n" +
+			indent4 + "\t(e.g.: 'cat data | ais put - ais://nnn/cas/ --name-by-hash sha256')",
+	}
+
+	deltaFlag = cli.BoolFlag{
+		Name: "delta",
+		Usage: "when overwriting an existing object with a single file: transfer only the changes\n" +
+			indent4 + "\t(rsync-like delta sync against the object's current version; regular PUT if the object does not exist)",
+	}
+
+	putObjMetaFlag = cli.StringFlag{
+		Name: "meta",
+		Usage: "user-defined metadata to store with the object(s): comma-separated key=value pairs, e.g.:\n" +
+			indent4 + "\t--meta color=red,owner=alice (keys are case-insensitive; see also 'ais ls --meta-filter')",
+	}
+	lsMetaFilterFlag = cli.StringFlag{
+		Name: "meta-filter",
+		Usage: "list only objects with matching custom metadata: comma-separated key=value and/or key (presence), e.g.:\n" +
+			indent4 + "\t--meta-filter color=red,owner (all must match; in-cluster objects only - implies '--cached')",
+	}
+
+	modelTagFlag = cli.StringFlag{
+		Name: "tag",
+		Usage: "comma-separated list of tags to assign to the pushed model version, in addition to 'latest'\n" +
+			indent4 + "\t(e.g.: 'ais model push ./ckpt ais://models/llama-7b --tag prod,stable')",
+	}
+
 	skipVerCksumFlag = cli.BoolFlag{
 		Name:  "skip-vc",
 		Usage: "skip loading object metadata (and the associated checksum & version related processing)",
@@ -804,6 +1089,13 @@ var (
 		Name:  archpathFlag.Name,
 		Usage: "extract the specified file from an archive (shard)",
 	}
+	archglobFlag = cli.StringFlag{
+		Name: "archglob",
+		Usage: "extract all files that match the wildcard (shell pattern) from an archive (shard);\n" +
+			indent4 + "\tthe matching files are packed (server-side) into a new TAR, e.g.:\n" +
+			indent4 + "\t- '--archglob \"*.jpg\"' - all jpegs at the top level of the shard;\n" +
+			indent4 + "\t- '--archglob \"train/*/*.cls\"' - note that '*' does not match '/'",
+	}
 	extractFlag = cli.BoolFlag{
 		Name:  "extract,x",
 		Usage: "extract all files from archive(s)",
@@ -844,6 +1136,18 @@ var (
 			indent4 + "\tvalid time units: " + timeUnits,
 		Value: 24 * time.Hour,
 	}
+	enrollExpireFlag = DurationFlag{
+		Name: "expire,e",
+		Usage: "enrollment token expiration time;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: time.Hour,
+	}
+	auditSinceFlag = DurationFlag{
+		Name: "since",
+		Usage: "show only the events that occurred within the specified duration, e.g. '--since 24h';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	auditLimitFlag = cli.IntFlag{Name: "limit", Usage: "show at most this number of the most recent events (0 - unlimited)"}
 
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
@@ -856,6 +1160,12 @@ var (
 			indent4 + "\t--prepend=abc\t- prefix all copied object names with \"abc\"\n" +
 			indent4 + "\t--prepend=abc/\t- copy objects into a virtual directory \"abc\" (note trailing filepath separator)",
 	}
+	copyRenameFlag = cli.StringFlag{
+		Name: "rename",
+		Usage: "destination naming template with placeholders {name}, {dir}, {file}, {stem}, {ext}, and {rel} (relative to --prefix), e.g.:\n" +
+			indent4 + "\t--rename='{dir}{stem}-v2{ext}'\t- copy \"a/b/c.tar\" as \"a/b/c-v2.tar\"\n" +
+			indent4 + "\t--rename='flat/{file}'\t- copy all objects into a single virtual directory \"flat\"",
+	}
 
 	// ETL
 	etlExtFlag  = cli.StringFlag{Name: "ext", Usage: "mapping from old to new extensions of transformed objects' names"}
@@ -907,8 +1217,27 @@ var (
 			indent4 + "\t - url - URL that points towards the data to transform (the support is currently limited to '--comm-type=hpull')\n" +
 			indent4 + "\t - fqn - Fully-qualified name (FQN) of a locally stored object (requires trusted ETL container, might not be always available)",
 	}
+	etlStatsFlag = cli.BoolFlag{
+		Name: "stats",
+		Usage: "show runtime statistics: number of transformed objects, bytes in/out (as seen by the ETL container),\n" +
+			indent4 + "\tnumber of failures, average and p99 latency, and transformation throughput",
+	}
+	etlCacheBckFlag = cli.StringFlag{
+		Name: "cache-bck",
+		Usage: "cache transformed objects in the specified ais:// bucket (to be created if doesn't exist);\n" +
+			indent4 + "\tcached objects are served as long as the source object and the ETL itself do not change, e.g.:\n" +
+			indent4 + "\t'--cache-bck ais://etl-cache'",
+	}
 
 	// Node
+	addNodeTargetFlag = cli.StringFlag{
+		Name:  "target",
+		Usage: "comma-separated list of public IPv4:PORT addresses of the targets to join, e.g.: '--target 10.0.0.5:51081,10.0.0.6:51081'",
+	}
+	addNodeProxyFlag = cli.StringFlag{
+		Name:  "proxy",
+		Usage: "comma-separated list of public IPv4:PORT addresses of the proxies (gateways) to join",
+	}
 	roleFlag = cli.StringFlag{
 		Name: "role", Required: true,
 		Usage: "role of this AIS daemon: proxy or target",
@@ -929,16 +1258,127 @@ var (
 		Name:  "rm-user-data",
 		Usage: "remove all user data when decommissioning node from the cluster",
 	}
+	// remote ais cluster: attach and test
+	skipRemValidationFlag = cli.BoolFlag{
+		Name:  "skip-validation",
+		Usage: "attach remote cluster without first checking its health and cluster map",
+	}
+	remTestReadFlag = cli.StringFlag{
+		Name: "test-read",
+		Usage: "bucket or object in the remote cluster to test-read, e.g.:\n" +
+			indent4 + "\t--test-read ais://abc\t- list the first page of remote bucket ais://abc;\n" +
+			indent4 + "\t--test-read ais://abc/obj\t- HEAD remote object ais://abc/obj",
+	}
 	keepInitialConfigFlag = cli.BoolFlag{
 		Name: "keep-initial-config",
 		Usage: "keep the original plain-text configuration the node was deployed with\n" +
 			indent4 + "\t(the option can be used to restart aisnode from scratch)",
 	}
 
+	// cluster init (bootstrap)
+	bootProxiesFlag = cli.IntFlag{
+		Name:  "proxies",
+		Usage: "number of proxies (gateways) to deploy",
+		Value: 1,
+	}
+	bootTargetsFlag = cli.IntFlag{
+		Name:  "targets",
+		Usage: "number of storage targets to deploy",
+		Value: 1,
+	}
+	bootMountpathsFlag = cli.StringFlag{
+		Name: "mountpaths",
+		Usage: "comma-separated list of (absolute) target mountpaths, e.g.:\n" +
+			indent4 + "\t--mountpaths /ais/nvme0,/ais/nvme1\n" +
+			indent4 + "\t(with multiple targets, each target gets its own subdirectory under each mountpath);\n" +
+			indent4 + "\twhen omitted, targets use test mountpaths (directories) under the deployment directory",
+	}
+	bootTestMpathsFlag = cli.IntFlag{
+		Name:  "test-mountpaths",
+		Usage: "number of test mountpaths (directories) per target - used only when '--mountpaths' is not specified",
+		Value: 2,
+	}
+	bootHostnameFlag = cli.StringFlag{
+		Name:  "hostname",
+		Usage: "public (client-facing) hostname or IPv4 address (default: all interfaces)",
+	}
+	bootHostnameCtrlFlag = cli.StringFlag{
+		Name:  "hostname-intra-control",
+		Usage: "intra-cluster control network hostname or IPv4 address (default: same as public)",
+	}
+	bootHostnameDataFlag = cli.StringFlag{
+		Name:  "hostname-intra-data",
+		Usage: "intra-cluster data network hostname or IPv4 address (default: same as public)",
+	}
+	bootPortFlag = cli.IntFlag{
+		Name:  "port",
+		Usage: "base public port; nodes get consecutive ports starting from this one (proxies first)",
+		Value: 8080,
+	}
+	bootPortCtrlFlag = cli.IntFlag{
+		Name:  "port-intra-control",
+		Usage: "base intra-cluster control port",
+		Value: 9080,
+	}
+	bootPortDataFlag = cli.IntFlag{
+		Name:  "port-intra-data",
+		Usage: "base intra-cluster data port",
+		Value: 10080,
+	}
+	bootBackendsFlag = cli.StringFlag{
+		Name: "backends",
+		Usage: "comma-separated list of cloud backends to enable, e.g.: '--backends aws,gcp'\n" +
+			indent4 + "\t(note: aisnode must be built with the corresponding build tags)",
+	}
+	bootEmitFlag = cli.StringFlag{
+		Name: "emit",
+		Usage: "what to generate:\n" +
+			indent4 + "\t" + bootEmitConfigs + "\t- plain-text cluster and node configurations (default);\n" +
+			indent4 + "\t" + bootEmitSystemd + "\t- configurations and systemd units, one per node;\n" +
+			indent4 + "\t" + bootEmitK8s + "\t- single-node Kubernetes manifest (requires '--mountpaths' and '--k8s-node')",
+		Value: bootEmitConfigs,
+	}
+	bootStartFlag = cli.BoolFlag{
+		Name:  "start",
+		Usage: "start all configured nodes locally (primary first) and wait for the cluster to become healthy",
+	}
+	bootAisnodeFlag = cli.StringFlag{
+		Name:  "aisnode",
+		Usage: "path to the aisnode executable (default: aisnode found in $PATH)",
+	}
+	bootK8sNodeFlag = cli.StringFlag{
+		Name:  "k8s-node",
+		Usage: "name of the Kubernetes node to deploy all pods on (used with '--emit " + bootEmitK8s + "')",
+	}
+	bootTimeoutFlag = DurationFlag{
+		Name: "timeout",
+		Usage: "maximum time to wait for the started cluster to become healthy;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: time.Minute,
+	}
+
 	transientFlag = cli.BoolFlag{
 		Name:  "transient",
 		Usage: "update config in memory without storing the change(s) on disk",
 	}
+	inheritedFlag = cli.BoolFlag{
+		Name:  "inherited",
+		Usage: "show node's inherited (cluster) configuration marking the origin of each value: cluster or node-local override",
+	}
+	computedFlag = cli.BoolFlag{
+		Name: "computed",
+		Usage: "show values the node computes (auto-tunes) at startup, in part based on cgroup (container) limits:\n" +
+			indent4 + "\tnumber of CPUs, GOMAXPROCS, memory limits, worker parallelism, and more",
+	}
+	stageConfigFlag = cli.BoolFlag{
+		Name:  "stage",
+		Usage: "validate the update on all affected nodes and show the resulting changes, node by node - without applying",
+	}
+	applyToFlag = cli.StringFlag{
+		Name: "apply-to",
+		Usage: "limit the update to a subset of nodes: 'proxies' | 'targets' | NODE_ID\n" +
+			indent4 + "\t(the update gets stored as node-local override - see 'ais config node')",
+	}
 
 	setNewCustomMDFlag = cli.BoolFlag{
 		Name:  "set-new-custom",
@@ -959,6 +1399,21 @@ var (
 		Name:  "summary",
 		Usage: "tally up target disks to show per-target read/write summary stats and average utilizations",
 	}
+	capHistoryFlag = cli.StringFlag{
+		Name: "history",
+		Usage: "show per-mountpath capacity history (hourly snapshots persisted by each target) for the specified duration, e.g.:\n" +
+			indent4 + "\t--history 7d\t- last 7 days;\n" +
+			indent4 + "\t--history 36h\t- last 36 hours;\n" +
+			indent4 + "\t(tip: use '--verbose' to show all snapshots, '--json' to export them)",
+	}
+	bckUsagePeriodFlag = cli.StringFlag{
+		Name:  "period",
+		Usage: "calendar month (UTC) to show per-bucket usage for, e.g. '--period 2024-06' (default: all persisted months)",
+	}
+	bckUsageCSVFlag = cli.BoolFlag{
+		Name:  "csv",
+		Usage: "export per-bucket usage in CSV format (raw numbers: sizes in bytes)",
+	}
 	mountpathFlag = cli.BoolFlag{
 		Name:  "mountpath",
 		Usage: "show target mountpaths with underlying disks and used/available capacities",
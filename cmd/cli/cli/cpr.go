@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -40,31 +41,12 @@ type cprCtx struct {
 
 func (cpr *cprCtx) copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltPresence int) error {
 	// 1. get from-bck summary
-	qbck := cmn.QueryBcks(bckFrom)
-	ctx := &bsummCtx{
-		qbck:         qbck,
-		longWaitTime: listObjectsWaitTime,
-	}
-	ctx.msg.Prefix = msg.Prefix
-	ctx.msg.ObjCached = apc.IsFltPresent(fltPresence)
-	ctx.msg.BckPresent = apc.IsFltPresent(fltPresence)
-
-	// compare w/ newBsummContext
-	ctx.args.DontWait = false
-	ctx.args.Callback = nil
-
-	// TODO -- FIXME: revisit
-	_ /*xid*/, summaries, err := ctx.slow()
+	var err error
+	cpr.totals.objs, cpr.totals.size, err = bckTotals(bckFrom, msg.Prefix, fltPresence)
 	if err != nil {
 		return err
 	}
 
-	for _, res := range summaries {
-		debug.Assertf(res.Bck.Equal(&bckFrom), "%s != %s", res.Bck, bckFrom)
-		cpr.totals.size += int64(res.TotalSize.PresentObjs + res.TotalSize.RemoteObjs)
-		cpr.totals.objs += int64(res.ObjCount.Present + res.ObjCount.Remote)
-	}
-
 	if cpr.totals.objs == 0 {
 		debug.Assert(cpr.totals.size == 0)
 		if !apc.IsFltPresent(fltPresence) {
@@ -116,6 +98,33 @@ func (cpr *cprCtx) copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.C
 	return err
 }
 
+// (objects, bytes) in a given bucket, via bucket summary
+func bckTotals(bck cmn.Bck, prefix string, fltPresence int) (objs, size int64, err error) {
+	ctx := &bsummCtx{
+		qbck:         cmn.QueryBcks(bck),
+		longWaitTime: listObjectsWaitTime,
+	}
+	ctx.msg.Prefix = prefix
+	ctx.msg.ObjCached = apc.IsFltPresent(fltPresence)
+	ctx.msg.BckPresent = apc.IsFltPresent(fltPresence)
+
+	// compare w/ newBsummContext
+	ctx.args.DontWait = false
+	ctx.args.Callback = nil
+
+	// TODO -- FIXME: revisit
+	_ /*xid*/, summaries, err := ctx.slow()
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, res := range summaries {
+		debug.Assertf(res.Bck.Equal(&bck), "%s != %s", res.Bck, bck)
+		size += int64(res.TotalSize.PresentObjs + res.TotalSize.RemoteObjs)
+		objs += int64(res.ObjCount.Present + res.ObjCount.Remote)
+	}
+	return objs, size, nil
+}
+
 func (cpr *cprCtx) multiobj(c *cli.Context, text string) (err error) {
 	var (
 		progress *mpb.Progress
@@ -204,11 +213,11 @@ outer:
 		totalWait += cpr.sleep
 		cpr.sinceUpd += cpr.sleep
 		if cpr.sinceUpd > timeoutNoChange && cpr.objs < cpr.totals.objs {
-			rerr = fmt.Errorf("%s: timeout with no apparent progress for %v (%s)", cpr.loghdr, cpr.sinceUpd, cpr.log())
+			rerr = timeoutErrorf("%s: timeout with no apparent progress for %v (%s)", cpr.loghdr, cpr.sinceUpd, cpr.log())
 			break
 		}
 		if cpr.timeout != 0 && totalWait > cpr.timeout {
-			rerr = fmt.Errorf("%s: timeout %v (%s)", cpr.loghdr, cpr.timeout, cpr.log())
+			rerr = timeoutErrorf("%s: timeout %v (%s)", cpr.loghdr, cpr.timeout, cpr.log())
 			break
 		}
 	}
@@ -222,6 +231,59 @@ outer:
 	}
 }
 
+// wait for a given xaction to finish while showing its progress: objects and bytes
+// - with zero totals (e.g., rebalance that cannot know in advance) showing the running counts only;
+// - compare with cprCtx.do() above that polls x-copy-bucket
+func xactProgress(c *cli.Context, xargs *xact.ArgsMsg, totalObjs, totalSize int64) error {
+	var (
+		objs, size int64
+		objsArg    = barArgs{barType: unitsArg, barText: "Objects:", total: totalObjs, noTotal: totalObjs == 0}
+		sizeArg    = barArgs{barType: sizeArg, barText: "Size:   ", total: totalSize, noTotal: totalSize == 0}
+	)
+	progress, bars := simpleBar(objsArg, sizeArg)
+	cb := func(xs xact.MultiSnap) error {
+		var o, s int64
+		for _, snaps := range xs {
+			for _, xsnap := range snaps {
+				if xargs.ID != "" && xsnap.ID != xargs.ID {
+					continue
+				}
+				if xsnap.Kind == apc.ActRebalance {
+					// received (migrated) objects; outbound may double-count retransmissions
+					o += xsnap.Stats.InObjs
+					s += xsnap.Stats.InBytes
+				} else {
+					o += xsnap.Stats.Objs
+					s += xsnap.Stats.Bytes
+				}
+			}
+		}
+		if o > objs {
+			bars[0].IncrInt64(o - objs)
+			objs = o
+		}
+		if s > size {
+			bars[1].IncrInt64(s - size)
+			size = s
+		}
+		return nil
+	}
+	err := api.WaitForXactionProgress(context.Background(), apiBP, xargs, _refreshRate(c), cb)
+	if err != nil {
+		bars[0].Abort(true)
+		bars[1].Abort(true)
+	} else {
+		bars[0].SetTotal(objs, true)
+		bars[1].SetTotal(size, true)
+	}
+	progress.Wait()
+	if err != nil {
+		return V(err)
+	}
+	actionDone(c, fmtXactSucceeded)
+	return nil
+}
+
 func (cpr *cprCtx) updObjs(objs int64) {
 	if objs <= cpr.objs {
 		return
@@ -19,6 +19,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/config"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -116,6 +117,7 @@ func startDsortHandler(c *cli.Context) (err error) {
 		shift          int
 		srcbck, dstbck cmn.Bck
 		spec           dsort.RequestSpec
+		view           *config.View // source '@VIEW', if any
 	)
 	// parse command line
 	specPath = parseStrFlag(c, dsortSpecFlag)
@@ -128,7 +130,11 @@ func startDsortHandler(c *cli.Context) (err error) {
 		specBytes = []byte(c.Args().Get(0))
 		shift = 1
 	}
-	if c.NArg() > shift {
+	if c.NArg() > shift && isView(c.Args().Get(shift)) {
+		if srcbck, view, err = viewBck(c, c.Args().Get(shift)); err != nil {
+			return err
+		}
+	} else if c.NArg() > shift {
 		srcbck, err = parseBckURI(c, c.Args().Get(shift), true)
 		if err != nil {
 			return fmt.Errorf("failed to parse source bucket: %v\n(see %s for details)",
@@ -179,6 +185,9 @@ func startDsortHandler(c *cli.Context) (err error) {
 	if !srcbck.IsEmpty() {
 		spec.InputBck = srcbck
 	}
+	if view != nil {
+		spec.InputFormat = apc.ListRange{ObjNames: view.ObjNames, Template: view.Template}
+	}
 	if !dstbck.IsEmpty() {
 		spec.OutputBck = dstbck
 	}
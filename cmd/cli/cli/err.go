@@ -6,12 +6,15 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
 	"strings"
 
+	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/cli/config"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
@@ -26,6 +29,17 @@ const (
 	errFmtExclusive  = "flags %s and %s are mutually exclusive"
 )
 
+// CLI exit codes - a stable contract: scripts and automation may branch on the failure class
+const (
+	ExitErr         = 1 // all other (unclassified) errors
+	ExitUsage       = 2 // incorrect usage: unknown command, missing or invalid arguments and flags
+	ExitNotFound    = 3 // bucket, object, job, node, etc. does not exist
+	ExitPermission  = 4 // unauthorized or access denied
+	ExitTimeout     = 5 // timed out (e.g., waiting for a job to finish)
+	ExitPartial     = 6 // multi-object operation failed for some (but not all) objects
+	ExitUnreachable = 7 // cluster cannot be reached
+)
+
 type (
 	errUsage struct {
 		helpData      any
@@ -43,6 +57,19 @@ type (
 		name   string
 		suffix string
 	}
+	// CLI-side timeout (compare with api.ErrWaitTimeout)
+	errTimeout struct {
+		msg string
+	}
+	// some (but not all) objects, files, etc. failed
+	errPartial struct {
+		err error
+	}
+	// formatted error that carries its exit code
+	errExit struct {
+		err  error
+		code int
+	}
 )
 
 //////////////
@@ -83,6 +110,8 @@ func (e *errAdditionalInfo) Error() string {
 	return fmt.Sprintf("%s.\n%s\n", e.baseErr.Error(), strToSentence(e.additionalInfo))
 }
 
+func (e *errAdditionalInfo) Unwrap() error { return e.baseErr }
+
 /////////////////////
 // errDoesNotExist //
 /////////////////////
@@ -96,10 +125,93 @@ func isErrDoesNotExist(err error) bool {
 	return ok
 }
 
+////////////////
+// errTimeout //
+////////////////
+
+func timeoutErrorf(format string, a ...any) error { return &errTimeout{fmt.Sprintf(format, a...)} }
+
+func (e *errTimeout) Error() string { return e.msg }
+
+////////////////
+// errPartial //
+////////////////
+
+func newErrPartial(err error) error { return &errPartial{err} }
+
+func (e *errPartial) Error() string { return e.err.Error() }
+func (e *errPartial) Unwrap() error { return e.err }
+
+/////////////
+// errExit //
+/////////////
+
+func (e *errExit) Error() string { return e.err.Error() }
+
+// ExitCode returns the process exit code for the error returned by Run (see ExitUsage et al.)
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var eexit *errExit
+	if errors.As(err, &eexit) {
+		return eexit.code
+	}
+	return ExitErr
+}
+
+// classify the (raw, not yet formatted) error
+func exitCode(err error) int {
+	var (
+		eusage   *errUsage
+		epartial *errPartial
+		etimeout *errTimeout
+		enotexst *errDoesNotExist
+		herr     *cmn.ErrHTTP
+	)
+	switch {
+	case errors.As(err, &eusage):
+		return ExitUsage
+	case errors.As(err, &epartial):
+		return ExitPartial
+	case isUnreachable(err):
+		return ExitUnreachable
+	case errors.As(err, &etimeout), errors.Is(err, api.ErrWaitTimeout), errors.Is(err, context.DeadlineExceeded), os.IsTimeout(err):
+		return ExitTimeout
+	case errors.As(err, &enotexst):
+		return ExitNotFound
+	case errors.As(err, &herr):
+		switch herr.Status {
+		case http.StatusNotFound:
+			return ExitNotFound
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ExitPermission
+		case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+			return ExitTimeout
+		}
+	case cos.IsNotExist(err, 0):
+		return ExitNotFound
+	case errors.Is(err, os.ErrPermission):
+		return ExitPermission
+	}
+	return ExitErr
+}
+
 //
 // misc. utils, helpers
 //
 
+var dialRegex = regexp.MustCompile("dial.*(timeout|refused)")
+
+// same as below, without formatting
+func isUnreachable(err error) bool {
+	var herr *cmn.ErrHTTP
+	if errors.As(err, &herr) {
+		return cos.IsUnreachable(herr, herr.Status)
+	}
+	return dialRegex.MatchString(err.Error())
+}
+
 func isUnreachableError(err error) (msg string, unreachable bool) {
 	switch err := err.(type) {
 	case *cmn.ErrHTTP:
@@ -113,8 +225,7 @@ func isUnreachableError(err error) (msg string, unreachable bool) {
 		return "", false
 	default:
 		msg = err.Error()
-		regx := regexp.MustCompile("dial.*(timeout|refused)")
-		if unreachable = regx.MatchString(msg); unreachable {
+		if unreachable = dialRegex.MatchString(msg); unreachable {
 			i := strings.Index(msg, "dial")
 			debug.Assert(i >= 0)
 			msg = msg[i:]
@@ -330,15 +441,24 @@ func formatErr(err error) error {
 	switch err := err.(type) {
 	case *cmn.ErrHTTP:
 		herr := err
-		return redErr(herr)
-	case *errUsage:
+		return redErr(withReqID(herr))
+	case *errUsage, *errExit:
 		return err
 	case *errAdditionalInfo:
 		err.baseErr = formatErr(err.baseErr)
 		return err
 	default:
-		return redErr(err)
+		return redErr(withReqID(err))
+	}
+}
+
+// add request ID (that the cluster includes in its error logs), if available
+func withReqID(err error) error {
+	herr := cmn.Err2HTTPErr(err)
+	if herr == nil || herr.ReqID == "" || strings.Contains(err.Error(), herr.ReqID) {
+		return err
 	}
+	return fmt.Errorf("%w [reqid=%s]", err, herr.ReqID)
 }
 
 func isStartingUp(err error) bool {
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
@@ -37,6 +38,7 @@ var (
 			chunkSizeFlag,
 			waitPodReadyTimeoutFlag,
 			etlNameFlag,
+			etlCacheBckFlag,
 		},
 		cmdSpec: {
 			fromFileFlag,
@@ -44,6 +46,7 @@ var (
 			argTypeFlag,
 			waitPodReadyTimeoutFlag,
 			etlNameFlag,
+			etlCacheBckFlag,
 		},
 		cmdStop: {
 			allRunningJobsFlag,
@@ -54,6 +57,7 @@ var (
 			etlExtFlag,
 			forceFlag,
 			copyPrependFlag,
+			copyRenameFlag,
 			copyDryRunFlag,
 			etlBucketRequestTimeout,
 			listFlag,
@@ -66,9 +70,12 @@ var (
 		cmdStart: {},
 	}
 	showCmdETL = cli.Command{
-		Name:   commandShow,
-		Usage:  "show ETL(s)",
-		Action: etlListHandler,
+		Name:         commandShow,
+		Usage:        "show ETL(s) or, with '--stats', runtime statistics of the specified ETL",
+		ArgsUsage:    optionalETLNameArgument,
+		Flags:        []cli.Flag{etlStatsFlag, unitsFlag, noHeaderFlag, jsonFlag},
+		Action:       etlListHandler,
+		BashComplete: etlIDCompletions,
 		Subcommands: []cli.Command{
 			{
 				Name:      cmdDetails,
@@ -208,6 +215,9 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 		msg.ArgTypeX = parseStrFlag(c, argTypeFlag)
 		msg.Spec = spec
 	}
+	if err := parseETLCacheBck(c, &msg.InitMsgBase); err != nil {
+		return err
+	}
 	if !strings.HasSuffix(msg.CommTypeX, etl.CommTypeSeparator) {
 		msg.CommTypeX += etl.CommTypeSeparator
 	}
@@ -231,6 +241,18 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 	return nil
 }
 
+func parseETLCacheBck(c *cli.Context, msg *etl.InitMsgBase) error {
+	if !flagIsSet(c, etlCacheBckFlag) {
+		return nil
+	}
+	bck, err := parseBckURI(c, parseStrFlag(c, etlCacheBckFlag), true /*error only*/)
+	if err != nil {
+		return err
+	}
+	msg.CacheBckX = bck
+	return nil
+}
+
 func etlInitCodeHandler(c *cli.Context) (err error) {
 	var (
 		msg      = &etl.InitCodeMsg{}
@@ -277,6 +299,9 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 	}
 
 	msg.Timeout = cos.Duration(parseDurationFlag(c, waitPodReadyTimeoutFlag))
+	if err := parseETLCacheBck(c, &msg.InitMsgBase); err != nil {
+		return err
+	}
 
 	// funcs
 	msg.Funcs.Transform = parseStrFlag(c, funcTransformFlag)
@@ -299,10 +324,53 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 }
 
 func etlListHandler(c *cli.Context) (err error) {
+	if flagIsSet(c, etlStatsFlag) {
+		if c.NArg() == 0 {
+			return missingArgumentsError(c, etlNameArgument)
+		}
+		return etlPrintStats(c, c.Args().Get(0))
+	}
+	if c.NArg() > 0 {
+		return etlPrintDetails(c, c.Args().Get(0))
+	}
 	_, err = etlList(c, false)
 	return
 }
 
+// per-target and cluster-wide
+func etlPrintStats(c *cli.Context, etlName string) error {
+	units, errU := parseUnitsFlag(c, unitsFlag)
+	if errU != nil {
+		return errU
+	}
+	stats, err := api.ETLStats(apiBP, etlName)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(stats, "", teb.Jopts(true))
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TARGET\tOBJECTS\tBYTES IN\tBYTES OUT\tFAILURES\tERR RATE\tAVG LATENCY\tP99 LATENCY\tTHROUGHPUT")
+	}
+	row := func(name string, s *etl.Stats) {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%.2f%%\t%s\t%s\t%s/s\n", name, cos.FormatBigNum(int(s.ObjCount)),
+			teb.FmtSize(s.InBytes, units, 2), teb.FmtSize(s.OutBytes, units, 2), s.Errors, s.ErrRate()*100,
+			teb.FmtDuration(int64(s.LatAvg()), units), teb.FmtDuration(int64(s.LatP99()), units),
+			teb.FmtSize(s.Throughput(), units, 2))
+	}
+	for _, s := range stats {
+		row(s.TargetID, s)
+	}
+	if len(stats) > 1 {
+		row(tgtTotal, stats.Total())
+	}
+	return tw.Flush()
+}
+
 func showETLs(c *cli.Context, etlName string, caption bool) (int, error) {
 	if etlName == "" {
 		return etlList(c, caption)
@@ -101,11 +101,24 @@ func fl1n(flagName string) string {
 	return l[0]
 }
 
+// global flags (see app.Flags)
+func assumeYes(c *cli.Context) bool { return c.GlobalBool(fl1n(globalYesFlag.Name)) }
+func isQuiet(c *cli.Context) bool   { return c.GlobalBool(fl1n(quietFlag.Name)) }
+
 func flagIsSet(c *cli.Context, flag cli.Flag) (v bool) {
 	name := fl1n(flag.GetName()) // take the first of multiple names
 	switch flag.(type) {
 	case cli.BoolFlag:
 		v = c.Bool(name)
+		// global '--yes' and '--quiet' take precedence
+		switch name {
+		case fl1n(yesFlag.Name):
+			v = v || assumeYes(c)
+		case fl1n(progressFlag.Name):
+			v = v && !isQuiet(c)
+		case fl1n(nonverboseFlag.Name):
+			v = v || isQuiet(c)
+		}
 	case cli.BoolTFlag:
 		v = c.BoolT(name)
 	default:
@@ -149,6 +162,19 @@ func parseUnitsFlag(c *cli.Context, flag cli.StringFlag) (units string, err erro
 	return
 }
 
+// `--format` (json, csv, or user template) combined with `--units`;
+// returns (opts, true) when the user requested machine-readable output
+func parseFormatFlag(c *cli.Context, units string) (opts teb.Opts, formatted bool, err error) {
+	if flagIsSet(c, lsFormatFlag) {
+		if opts, err = teb.ParseFormat(parseStrFlag(c, lsFormatFlag)); err != nil {
+			return opts, false, fmt.Errorf("%s: %v", flprn(lsFormatFlag), err)
+		}
+		formatted = true
+	}
+	opts.AltMap = teb.FuncMapUnits(units)
+	return opts, formatted, nil
+}
+
 //nolint:gocritic // ignoring hugeParam - following the orig. github.com/urfave style
 func parseSizeFlag(c *cli.Context, flag cli.StringFlag, unitsParsed ...string) (int64, error) {
 	var (
@@ -57,6 +57,13 @@ func getHandler(c *cli.Context) error {
 			return fmt.Errorf(errFmtExclusive, qflprn(latestVerFlag), qflprn(getObjCachedFlag))
 		}
 	}
+	if flagIsSet(c, objVersionFlag) {
+		for _, flag := range []cli.Flag{latestVerFlag, getObjPrefixFlag, archpathGetFlag, archglobFlag, extractFlag} {
+			if flagIsSet(c, flag) {
+				return fmt.Errorf(errFmtExclusive, qflprn(objVersionFlag), qflprn(flag))
+			}
+		}
+	}
 
 	// source
 	uri := c.Args().Get(0)
@@ -124,6 +131,19 @@ func getHandler(c *cli.Context) error {
 		}
 	}
 
+	if flagIsSet(c, archglobFlag) {
+		if archpath != "" {
+			return fmt.Errorf(errFmtExclusive, qflprn(archglobFlag), qflprn(archpathGetFlag))
+		}
+		if flagIsSet(c, getObjPrefixFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(getObjPrefixFlag), qflprn(archglobFlag))
+		}
+		if flagIsSet(c, headObjPresentFlag) || flagIsSet(c, lengthFlag) {
+			return fmt.Errorf("option %s cannot be used with %s or %s",
+				qflprn(archglobFlag), qflprn(headObjPresentFlag), qflprn(lengthFlag))
+		}
+	}
+
 	// GET multiple -- currently, only prefix (TODO: list/range)
 	if flagIsSet(c, getObjPrefixFlag) {
 		if objName != "" {
@@ -337,9 +357,10 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEntry, shardName,
 // get one (main function)
 func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, quiet, extract bool) (err error) {
 	var (
-		getArgs api.GetArgs
-		oah     api.ObjAttrs
-		units   string
+		getArgs  api.GetArgs
+		oah      api.ObjAttrs
+		units    string
+		archglob = parseStrFlag(c, archglobFlag) // (the response is a TAR of all matching files)
 	)
 	if outFile == fileStdIO && extract {
 		return errors.New("cannot extract archived files to standard output - not implemented yet")
@@ -374,9 +395,12 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 	// where to
 	if outFile == "" {
 		// archive
-		if archpath != "" {
+		switch {
+		case archpath != "":
 			outFile = filepath.Base(archpath)
-		} else {
+		case archglob != "":
+			outFile = globTarName(objName)
+		default:
 			outFile = filepath.Base(objName)
 		}
 	} else if outFile != fileStdIO && outFile != discardIO {
@@ -388,9 +412,12 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 			// destination is: directory | file (confirm overwrite)
 			if finfo.IsDir() {
 				// archive
-				if archpath != "" {
+				switch {
+				case archpath != "":
 					outFile = filepath.Join(outFile, filepath.Base(archpath))
-				} else {
+				case archglob != "":
+					outFile = filepath.Join(outFile, globTarName(objName))
+				default:
 					outFile = filepath.Join(outFile, filepath.Base(objName))
 				}
 				// TODO: strictly speaking: fstat again and confirm if exists
@@ -426,18 +453,31 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 	}
 
 	// finally, http query
-	if bck.IsHTTP() || archpath != "" || flagIsSet(c, silentFlag) || flagIsSet(c, latestVerFlag) {
+	version := parseStrFlag(c, objVersionFlag)
+	if bck.IsHTTP() || archpath != "" || archglob != "" || flagIsSet(c, silentFlag) || flagIsSet(c, latestVerFlag) {
 		getArgs.Query = _getQparams(c, &bck, archpath)
 	}
 
 	// do
-	if flagIsSet(c, cksumFlag) {
+	switch {
+	case flagIsSet(c, cksumFlag):
+		if version != "" {
+			if getArgs.Query == nil {
+				getArgs.Query = make(url.Values, 1)
+			}
+			getArgs.Query.Set(apc.QparamObjVersion, version)
+		}
 		oah, err = api.GetObjectWithValidation(apiBP, bck, objName, &getArgs)
-	} else {
+	case version != "":
+		oah, err = api.GetObjectVersion(apiBP, bck, objName, version, &getArgs)
+	default:
 		oah, err = api.GetObject(apiBP, bck, objName, &getArgs)
 	}
 	if err != nil {
-		if cmn.IsStatusNotFound(err) && archpath == "" {
+		if version != "" {
+			return err // (not found: the error lists available versions, if any)
+		}
+		if cmn.IsStatusNotFound(err) && archpath == "" && archglob == "" {
 			err = &errDoesNotExist{what: "object", name: bck.Cname(objName)}
 		}
 		return err
@@ -448,7 +488,11 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 		objLen = oah.Size()
 	)
 	if extract {
-		mime, err = doExtract(objName, outFile, objLen)
+		name := objName
+		if archglob != "" {
+			name = globTarName(objName)
+		}
+		mime, err = doExtract(name, outFile, objLen)
 		if err != nil {
 			if cliConfVerbose() {
 				return fmt.Errorf("failed to extract %s (from local %q): %v", bck.Cname(objName), outFile, err)
@@ -492,8 +536,14 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 		fmt.Fprintf(c.App.Writer, "Read%s range (length %s (%dB) at offset %d)%s\n", discard, sz, objLen, offset, out)
 	case archpath != "":
 		fmt.Fprintf(c.App.Writer, "GET%s %s from %s%s (%s)\n", discard, archpath, bck.Cname(objName), out, sz)
+	case archglob != "" && extract:
+		fmt.Fprintf(c.App.Writer, "GET files matching %q from %s (%s) and extract%s\n", archglob, bck.Cname(objName), sz, out)
+	case archglob != "":
+		fmt.Fprintf(c.App.Writer, "GET%s files matching %q from %s%s (%s)\n", discard, archglob, bck.Cname(objName), out, sz)
 	case extract:
 		fmt.Fprintf(c.App.Writer, "GET %s from %s as %q (%s) and extract%s\n", objName, bn, outFile, sz, out)
+	case version != "":
+		fmt.Fprintf(c.App.Writer, "GET%s %s (version %s) from %s%s (%s)\n", discard, objName, version, bn, out, sz)
 	default:
 		fmt.Fprintf(c.App.Writer, "GET%s %s from %s%s (%s)\n", discard, objName, bn, out, sz)
 	}
@@ -509,6 +559,9 @@ func _getQparams(c *cli.Context, bck *cmn.Bck, archpath string) (q url.Values) {
 	if archpath != "" {
 		q.Set(apc.QparamArchpath, archpath)
 	}
+	if flagIsSet(c, archglobFlag) {
+		q.Set(apc.QparamArchglob, parseStrFlag(c, archglobFlag))
+	}
 	if flagIsSet(c, silentFlag) {
 		q.Set(apc.QparamSilent, "true")
 	}
@@ -518,6 +571,16 @@ func _getQparams(c *cli.Context, bck *cmn.Bck, archpath string) (q url.Values) {
 	return q
 }
 
+// local name of the TAR that contains archived files selected by '--archglob'
+// (e.g., "shard-0123.tar.lz4" => "shard-0123.tar")
+func globTarName(objName string) string {
+	name := filepath.Base(objName)
+	if mime, err := archive.Mime("", name); err == nil {
+		name = strings.TrimSuffix(name, mime)
+	}
+	return name + archive.ExtTar
+}
+
 //
 // post-GET extraction
 //
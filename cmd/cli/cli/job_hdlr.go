@@ -6,15 +6,18 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/dload"
@@ -65,12 +68,14 @@ var (
 			descJobFlag,
 			limitConnectionsFlag,
 			objectsListFlag,
+			dloadManifestFlag,
 			dloadProgressFlag,
 			progressFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
 			limitBytesPerHourFlag,
 			syncFlag,
+			dloadSyncIntervalFlag,
 			unitsFlag,
 		},
 		cmdDsort: {
@@ -79,6 +84,7 @@ var (
 		},
 		commandPrefetch: append(
 			listRangeProgressWaitFlags,
+			fromStdinFlag,
 			dryRunFlag,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			latestVerFlag,
@@ -97,6 +103,22 @@ var (
 			lruBucketsFlag,
 			forceFlag,
 		},
+		cmdCheckOOB: {
+			listObjPrefixFlag,
+			oobActionFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
+		cmdInitShard: {
+			listObjPrefixFlag,
+			shardTmplFlag,
+			shardOrderFlag,
+			shardSizeFlag,
+			unitsFlag,
+			shardDeleteSrcFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
 	}
 
 	jobStartResilver = cli.Command{
@@ -150,6 +172,27 @@ var (
 				Action:       startLRUHandler,
 				BashComplete: bucketCompletions(bcmplop{}),
 			},
+			{
+				Name: cmdInitShard,
+				Usage: "pack loose objects into shards of a given size, e.g.:\n" +
+					indent1 + "\t- 'init-shard ais://abc --shard-size 256MiB'\t- shard the entire bucket;\n" +
+					indent1 + "\t- 'init-shard ais://abc --prefix images/ --order hash --delete-src'\t- shuffle, shard, and remove the originals;\n" +
+					indent1 + "\tobjects that share the same name (up to the first dot) form a sample that never gets split between shards",
+				ArgsUsage:    bucketArgument,
+				Flags:        startSpecialFlags[cmdInitShard],
+				Action:       startInitShardHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+			{
+				Name: cmdCheckOOB,
+				Usage: "detect out-of-band changes: compare cached objects with their remote counterparts, e.g.:\n" +
+					indent1 + "\t- 'check-oob s3://abc --wait'\t- report objects that were changed or deleted remotely (bypassing aistore);\n" +
+					indent1 + "\t- 'check-oob gs://abc --prefix images/ --stale evict'\t- evict stale objects",
+				ArgsUsage:    bucketArgument,
+				Flags:        startSpecialFlags[cmdCheckOOB],
+				Action:       startCheckOOBHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
 			{
 				Name:  commandETL,
 				Usage: "start ETL",
@@ -379,7 +422,7 @@ func startXaction(c *cli.Context, xargs *xact.ArgsMsg, extra string) error {
 	}
 	actionDone(c, msg)
 
-	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) && !flagIsSet(c, progressFlag) {
 		return nil
 	}
 	return waitJob(c, xargs.Kind, xid, xargs.Bck)
@@ -390,9 +433,17 @@ func startDownloadHandler(c *cli.Context) error {
 		description      = parseStrFlag(c, descJobFlag)
 		timeout          = parseStrFlag(c, dloadTimeoutFlag)
 		objectsListPath  = parseStrFlag(c, objectsListFlag)
+		manifest         = parseStrFlag(c, dloadManifestFlag)
 		progressInterval = parseStrFlag(c, dloadProgressFlag)
+		syncIval         = parseStrFlag(c, dloadSyncIntervalFlag)
 		id               string
 	)
+	if syncIval != "" && (flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag) || flagIsSet(c, progressFlag)) {
+		return fmt.Errorf("continuous download (%s) runs until aborted and cannot be waited upon", qflprn(dloadSyncIntervalFlag))
+	}
+	if manifest != "" {
+		return startManifestDownload(c, manifest, description, timeout, progressInterval)
+	}
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
@@ -420,35 +471,11 @@ func startDownloadHandler(c *cli.Context) error {
 		return err
 	}
 
-	limitBPH, err := parseSizeFlag(c, limitBytesPerHourFlag)
+	basePayload, err := dloadBase(c, bck, description, timeout, progressInterval)
 	if err != nil {
 		return err
 	}
 
-	if _, err := time.ParseDuration(progressInterval); err != nil {
-		return err
-	}
-
-	basePayload := dload.Base{
-		Bck:              bck,
-		Timeout:          timeout,
-		Description:      description,
-		ProgressInterval: progressInterval,
-		Limits: dload.Limits{
-			Connections:  parseIntFlag(c, limitConnectionsFlag),
-			BytesPerHour: int(limitBPH),
-		},
-	}
-
-	if basePayload.Bck.Props, err = api.HeadBucket(apiBP, basePayload.Bck, true /* don't add */); err != nil {
-		if !cmn.IsStatusNotFound(err) {
-			return err
-		}
-		warn := fmt.Sprintf("destination bucket %s doesn't exist. Bucket with default properties will be created.",
-			basePayload.Bck.Cname(""))
-		actionWarn(c, warn)
-	}
-
 	// Heuristics to determine the download type.
 	var dlType dload.Type
 	if objectsListPath != "" {
@@ -493,6 +520,10 @@ func startDownloadHandler(c *cli.Context) error {
 		}
 	}
 
+	if syncIval != "" && dlType != dload.TypeRange && dlType != dload.TypeBackend {
+		return fmt.Errorf("%s requires remote bucket (or prefix) or range of links as the source", qflprn(dloadSyncIntervalFlag))
+	}
+
 	switch dlType {
 	case dload.TypeSingle:
 		payload := dload.SingleBody{
@@ -524,16 +555,18 @@ func startDownloadHandler(c *cli.Context) error {
 		id, err = api.DownloadWithParam(apiBP, dlType, payload)
 	case dload.TypeRange:
 		payload := dload.RangeBody{
-			Base:     basePayload,
-			Subdir:   pathSuffix, // in this case pathSuffix is a subdirectory in which the objects are to be saved
-			Template: source.link,
+			Base:         basePayload,
+			Subdir:       pathSuffix, // in this case pathSuffix is a subdirectory in which the objects are to be saved
+			Template:     source.link,
+			SyncInterval: syncIval,
 		}
 		id, err = api.DownloadWithParam(apiBP, dlType, payload)
 	case dload.TypeBackend:
 		payload := dload.BackendBody{
-			Base:   basePayload,
-			Sync:   flagIsSet(c, syncFlag),
-			Prefix: source.backend.prefix,
+			Base:         basePayload,
+			Sync:         flagIsSet(c, syncFlag),
+			Prefix:       source.backend.prefix,
+			SyncInterval: syncIval,
 		}
 		id, err = api.DownloadWithParam(apiBP, dlType, payload)
 	default:
@@ -543,7 +576,68 @@ func startDownloadHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	return dloadStarted(c, id)
+}
+
+// download links listed in the manifest object (that must be already stored in the cluster)
+func startManifestDownload(c *cli.Context, manifest, description, timeout, progressInterval string) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, "destination")
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	mbck, mobj, err := parseBckObjURI(c, manifest, false /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), true /*error only*/)
+	if err != nil {
+		return err
+	}
+	basePayload, err := dloadBase(c, bck, description, timeout, progressInterval)
+	if err != nil {
+		return err
+	}
+	payload := dload.ManifestBody{Base: basePayload, ManifestBck: mbck, ManifestObj: mobj}
+	id, err := api.DownloadWithParam(apiBP, dload.TypeManifest, payload)
+	if err != nil {
+		return V(err)
+	}
+	return dloadStarted(c, id)
+}
 
+func dloadBase(c *cli.Context, bck cmn.Bck, description, timeout, progressInterval string) (basePayload dload.Base, err error) {
+	limitBPH, err := parseSizeFlag(c, limitBytesPerHourFlag)
+	if err != nil {
+		return
+	}
+	if _, err = time.ParseDuration(progressInterval); err != nil {
+		return
+	}
+	basePayload = dload.Base{
+		Bck:              bck,
+		Timeout:          timeout,
+		Description:      description,
+		ProgressInterval: progressInterval,
+		Limits: dload.Limits{
+			Connections:  parseIntFlag(c, limitConnectionsFlag),
+			BytesPerHour: int(limitBPH),
+		},
+	}
+	if basePayload.Bck.Props, err = api.HeadBucket(apiBP, basePayload.Bck, true /* don't add */); err != nil {
+		if !cmn.IsStatusNotFound(err) {
+			return
+		}
+		err = nil
+		warn := fmt.Sprintf("destination bucket %s doesn't exist. Bucket with default properties will be created.",
+			basePayload.Bck.Cname(""))
+		actionWarn(c, warn)
+	}
+	return
+}
+
+func dloadStarted(c *cli.Context, id string) error {
 	fmt.Fprintf(c.App.Writer, "Started download job %s\n", id)
 
 	if flagIsSet(c, progressFlag) {
@@ -650,7 +744,7 @@ func waitDownload(c *cli.Context, id string) (err error) {
 		time.Sleep(refreshRate)
 		elapsed += refreshRate
 		if timeout != 0 && elapsed > timeout {
-			return fmt.Errorf("timed out waiting for %s", qn)
+			return timeoutErrorf("timed out waiting for %s", qn)
 		}
 	}
 	if aborted {
@@ -695,6 +789,129 @@ func startLRUHandler(c *cli.Context) (err error) {
 	return
 }
 
+func startInitShardHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	msg := &apc.InitShardMsg{
+		Prefix:    parseStrFlag(c, listObjPrefixFlag),
+		Template:  parseStrFlag(c, shardTmplFlag),
+		Order:     parseStrFlag(c, shardOrderFlag),
+		DeleteSrc: flagIsSet(c, shardDeleteSrcFlag),
+	}
+	if msg.ShardSize, err = parseSizeFlag(c, shardSizeFlag); err != nil {
+		return err
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	xid, err := api.InitShard(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(apc.ActInitShard)
+	text := fmt.Sprintf("%s %s", xactCname(xname, xid), bck.Cname(msg.Prefix))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActInitShard, Timeout: timeout}
+	if err := waitXact(apiBP, &xargs); err != nil {
+		return err
+	}
+	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	return nil
+}
+
+func startCheckOOBHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if !bck.IsRemote() {
+		return fmt.Errorf("%s is not a remote bucket (expecting a cloud or remote ais bucket)", bck.Cname(""))
+	}
+	msg := &apc.CheckOOBMsg{
+		Prefix: parseStrFlag(c, listObjPrefixFlag),
+		Action: parseStrFlag(c, oobActionFlag),
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	xid, err := api.CheckOOB(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(apc.ActCheckOOB)
+	text := fmt.Sprintf("%s %s", xactCname(xname, xid), bck.Cname(msg.Prefix))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait and summarize
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActCheckOOB, Timeout: timeout}
+	if err := waitXact(apiBP, &xargs); err != nil {
+		return err
+	}
+	xs, err := api.QueryXactionSnaps(apiBP, &xact.ArgsMsg{ID: xid})
+	if err != nil {
+		return V(err)
+	}
+	return showOOBSummary(c, xs)
+}
+
+// sum up per-target reports
+func showOOBSummary(c *cli.Context, xs xact.MultiSnap) error {
+	var (
+		total  = &apc.CheckOOBStats{}
+		recent []string
+	)
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			ext := &apc.CheckOOBStats{}
+			if err := cos.MorphMarshal(snap.Ext, ext); err != nil {
+				continue
+			}
+			total.Scanned += ext.Scanned
+			total.Changed += ext.Changed
+			total.Deleted += ext.Deleted
+			total.Marked += ext.Marked
+			total.Evicted += ext.Evicted
+			recent = append(recent, ext.Recent...)
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "cached: %d, changed remotely: %d, deleted remotely: %d, marked: %d, evicted: %d\n",
+		total.Scanned, total.Changed, total.Deleted, total.Marked, total.Evicted)
+	if len(recent) > 0 {
+		sort.Strings(recent)
+		fmt.Fprintln(c.App.Writer, "stale (sample):")
+		for _, name := range recent {
+			fmt.Fprintln(c.App.Writer, indent1+name)
+		}
+	}
+	return nil
+}
+
 //
 // job stop
 //
@@ -983,14 +1200,6 @@ func waitJob(c *cli.Context, name, xid string, bck cmn.Bck) error {
 		}
 		return waitDsortHandler(c, xid /*job ID*/)
 	}
-	// TODO: niy
-	if flagIsSet(c, refreshFlag) {
-		warn := fmt.Sprintf("ignoring flag %s  - not fully implemented yet", qflprn(refreshFlag))
-		actionWarn(c, warn)
-	} else if flagIsSet(c, progressFlag) {
-		warn := fmt.Sprintf("ignoring flag %s  - not fully implemented yet", qflprn(progressFlag))
-		actionWarn(c, warn)
-	}
 	// x-wait
 	var (
 		xactID, xname = xid, name
@@ -1002,16 +1211,41 @@ func waitJob(c *cli.Context, name, xid string, bck cmn.Bck) error {
 			return incorrectUsageMsg(c, "unrecognized or misplaced option '%s'", name)
 		}
 	}
-	xargs := xact.ArgsMsg{ID: xactID, Kind: xactKind}
+	xargs := xact.ArgsMsg{ID: xactID, Kind: xactKind, Timeout: -1} // (no timeout - until Ctrl-C)
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
 	}
 	msg := formatXactMsg(xactID, xname, bck)
+	if flagIsSet(c, progressFlag) && xactID != "" {
+		// progress bars: objects and bytes (the totals are not known in advance)
+		fmt.Fprintln(c.App.Writer, "Waiting for "+msg+" ...")
+		return xactProgress(c, &xargs, 0, 0)
+	}
+	var cb func(xact.MultiSnap) error
+	if flagIsSet(c, progressFlag) || flagIsSet(c, refreshFlag) {
+		cb = func(xs xact.MultiSnap) error {
+			var objs, size int64
+			for _, snaps := range xs {
+				for _, snap := range snaps {
+					if xactID == "" || snap.ID == xactID {
+						objs += snap.Stats.Objs
+						size += snap.Stats.Bytes
+					}
+				}
+			}
+			fmt.Fprintf(c.App.Writer, "\n\t%d objects (%s)", objs, cos.ToSizeIEC(size, 2))
+			return nil
+		}
+	}
 	fmt.Fprintf(c.App.Writer, "Waiting for "+msg+" ...")
-	err := waitXact(apiBP, &xargs)
-	if err == nil {
-		actionDone(c, " done.")
+	if err := api.WaitForXactionProgress(context.Background(), apiBP, &xargs, _refreshRate(c), cb); err != nil {
+		fmt.Fprintln(c.App.Writer)
+		return V(err)
+	}
+	if cb != nil {
+		fmt.Fprintln(c.App.Writer)
 	}
+	actionDone(c, " done.")
 	return nil
 }
 
@@ -1052,7 +1286,7 @@ func waitDownloadHandler(c *cli.Context, id string) error {
 		time.Sleep(refreshRate)
 		total += refreshRate
 		if timeout != 0 && total > timeout {
-			return fmt.Errorf("timed out waiting for %s", qn)
+			return timeoutErrorf("timed out waiting for %s", qn)
 		}
 	}
 	actionDone(c, "\n"+qn+" finished")
@@ -1100,7 +1334,7 @@ func waitDsortHandler(c *cli.Context, id string) error {
 		time.Sleep(refreshRate)
 		total += refreshRate
 		if timeout != 0 && total > timeout {
-			return fmt.Errorf("timed out waiting for %s", qn)
+			return timeoutErrorf("timed out waiting for %s", qn)
 		}
 	}
 	if total > wasFast {
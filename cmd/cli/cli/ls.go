@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -21,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
@@ -64,6 +66,7 @@ func listBckTableNoSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks, fl
 		hideFooter = flagIsSet(c, noFooterFlag)
 		data       = make([]teb.ListBucketsHelper, 0, len(bcks))
 	)
+	opts, formatted, _ := parseFormatFlag(c, "") // validated by listAnyHandler
 	if !apc.IsFltPresent(fltPresence) {
 		bmd, err = api.GetBMD(apiBP)
 		if err != nil {
@@ -106,11 +109,11 @@ func listBckTableNoSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks, fl
 		return 0
 	}
 	if hideHeader {
-		teb.Print(data, teb.ListBucketsBodyNoSummary)
+		teb.Print(data, teb.ListBucketsBodyNoSummary, opts)
 	} else {
-		teb.Print(data, teb.ListBucketsTmplNoSummary)
+		teb.Print(data, teb.ListBucketsTmplNoSummary, opts)
 	}
-	if hideFooter {
+	if hideFooter || formatted {
 		return footer.nb
 	}
 
@@ -140,8 +143,8 @@ func listBckTableWithSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks,
 		actionWarn(c, errU.Error())
 		units = ""
 	}
+	opts, formatted, _ := parseFormatFlag(c, units) // validated by listAnyHandler
 	var (
-		opts       = teb.Opts{AltMap: teb.FuncMapUnits(units)}
 		maxwait    = listObjectsWaitTime
 		bckPresent = apc.IsFltPresent(args.FltPresence) // all-buckets part in the `allObjsOrBcksFlag`
 		dontWait   = flagIsSet(c, dontWaitFlag)
@@ -149,8 +152,10 @@ func listBckTableWithSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks,
 		prev       = ctx.started
 	)
 	debug.Assert(args.Summarize)
-	args.CallAfter = ctx.args.CallAfter
-	args.Callback = ctx.args.Callback // reusing bsummCtx.progress()
+	if !formatted {
+		args.CallAfter = ctx.args.CallAfter
+		args.Callback = ctx.args.Callback // reusing bsummCtx.progress()
+	}
 
 	// one at a time
 	for i := range bcks {
@@ -187,6 +192,9 @@ func listBckTableWithSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks,
 			bck.Name += " (URL: " + props.Extra.HTTP.OrigURLBck + ")"
 		}
 		data = append(data, teb.ListBucketsHelper{XactID: xid, Bck: bck, Props: props, Info: info})
+		if formatted {
+			continue // print all at once (below)
+		}
 
 		now := mono.NanoTime()
 		if elapsed := time.Duration(now - prev); elapsed < maxwait && i < len(bcks)-1 {
@@ -207,6 +215,14 @@ func listBckTableWithSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks,
 	if footer.nb == 0 {
 		return 0
 	}
+	if formatted {
+		if hideHeader {
+			teb.Print(data, teb.ListBucketsSummBody, opts)
+		} else {
+			teb.Print(data, teb.ListBucketsSummTmpl, opts)
+		}
+		return footer.nb
+	}
 
 	if footer.robj == 0 && apc.IsRemoteProvider(qbck.Provider) && !args.WithRemote {
 		fmt.Fprintln(c.App.Writer)
@@ -283,6 +299,14 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		msg.SetFlag(apc.LsVerChanged)
 	}
 
+	if flagIsSet(c, lsMetaFilterFlag) {
+		msg.CustomMD = parseStrFlag(c, lsMetaFilterFlag)
+		if _, err := msg.MDFilter(); err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(lsMetaFilterFlag), err)
+		}
+		msg.SetFlag(apc.LsObjCached)
+		addCachedCol = false
+	}
 	if flagIsSet(c, listObjCachedFlag) {
 		if flagIsSet(c, verChangedFlag) {
 			actionWarn(c, "checking remote versions may take some time...\n")
@@ -308,6 +332,12 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	if listArch {
 		msg.SetFlag(apc.LsArchDir)
 	}
+	if flagIsSet(c, noRecursFlag) {
+		if listArch {
+			return fmt.Errorf(errFmtExclusive, qflprn(noRecursFlag), qflprn(listArchFlag))
+		}
+		msg.SetFlag(apc.LsNoRecursion)
+	}
 
 	var (
 		props    []string
@@ -371,6 +401,36 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	}
 	msg.PageSize = uint(pageSize)
 
+	// "head of bucket": a single unordered page
+	if flagIsSet(c, lsHeadFlag) {
+		n := parseIntFlag(c, lsHeadFlag)
+		if n <= 0 {
+			return fmt.Errorf("invalid %s=%d: expecting a positive number", qflprn(lsHeadFlag), n)
+		}
+		if flagIsSet(c, noRecursFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(lsHeadFlag), qflprn(noRecursFlag))
+		}
+		msg.SetFlag(apc.LsHead)
+		msg.PageSize, limit = uint(n), n
+	}
+
+	// machine-readable output is accumulated and printed once (compare with `--stream`)
+	if flagIsSet(c, lsFormatFlag) {
+		for _, f := range []cli.BoolFlag{lsStreamFlag, pagedFlag, showUnmatchedFlag} {
+			if flagIsSet(c, f) {
+				return fmt.Errorf(errFmtExclusive, qflprn(lsFormatFlag), qflprn(f))
+			}
+		}
+	}
+
+	// list page by page, stream JSON lines
+	if flagIsSet(c, lsStreamFlag) {
+		if flagIsSet(c, pagedFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(lsStreamFlag), qflprn(pagedFlag))
+		}
+		return lsStream(c, bck, msg, lstFilter, limit)
+	}
+
 	// list page by page, print pages one at a time
 	if flagIsSet(c, pagedFlag) {
 		pageCounter, maxPages, toShow := 0, parseIntFlag(c, maxPagesFlag), limit
@@ -423,6 +483,9 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		callAfter = parseDurationFlag(c, refreshFlag)
 	}
 	args := api.ListArgs{Callback: u.cb, CallAfter: callAfter, Limit: uint(limit)}
+	if flagIsSet(c, lsFormatFlag) {
+		args.Callback = nil // no progress when output is meant for scripts
+	}
 	objList, err := api.ListObjects(apiBP, bck, msg, args)
 	if err != nil {
 		return lsoErr(msg, err)
@@ -431,6 +494,41 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		addCachedCol, bck.IsRemote(), msg.IsFlagSet(apc.LsVerChanged))
 }
 
+// one JSON-encoded entry per line, page by page - memory usage is bounded by the page size
+func lsStream(c *cli.Context, bck cmn.Bck, msg *apc.LsoMsg, lstFilter *lstFilter, limit int) error {
+	var (
+		bw       = bufio.NewWriter(c.App.Writer)
+		enc      = jsoniter.NewEncoder(bw)
+		maxPages = parseIntFlag(c, maxPagesFlag)
+		cnt      int
+	)
+	for pages := 1; ; pages++ {
+		lst, err := api.ListObjectsPage(apiBP, bck, msg)
+		if err != nil {
+			bw.Flush()
+			return lsoErr(msg, err)
+		}
+		for _, en := range lst.Entries {
+			if !lstFilter.and(en) {
+				continue
+			}
+			if err := enc.Encode(en); err != nil {
+				return err
+			}
+			cnt++
+			if limit > 0 && cnt >= limit {
+				return bw.Flush()
+			}
+		}
+		if err := bw.Flush(); err != nil {
+			return err // e.g., broken pipe
+		}
+		if msg.ContinuationToken == "" || (maxPages > 0 && pages >= maxPages) {
+			return nil
+		}
+	}
+}
+
 func lsoErr(msg *apc.LsoMsg, err error) error {
 	if herr, ok := err.(*cmn.ErrHTTP); ok && msg.IsFlagSet(apc.LsBckPresent) {
 		if herr.TypeCode == "ErrRemoteBckNotFound" {
@@ -491,11 +589,27 @@ func printLso(c *cli.Context, entries cmn.LsoEntries, lstFilter *lstFilter, prop
 		}
 	}
 
+	// virtual directories (non-recursive listing)
+	for _, e := range matched {
+		if e.IsDir() && !strings.HasSuffix(e.Name, "/") {
+			e.Name += "/"
+		}
+	}
+
 	tmpl := teb.LsoTemplate(propsList, hideHeader, addCachedCol, addStatusCol)
-	opts := teb.Opts{AltMap: teb.FuncMapUnits(units)}
+	opts, formatted, err := parseFormatFlag(c, units)
+	if err != nil {
+		return err
+	}
+	if matched == nil {
+		matched = cmn.LsoEntries{} // JSON: empty list rather than null
+	}
 	if err := teb.Print(matched, tmpl, opts); err != nil {
 		return err
 	}
+	if formatted {
+		return nil
+	}
 	if !hideFooter && len(matched) > 10 {
 		listed := fblue("Listed:")
 		fmt.Fprintln(c.App.Writer, listed, cos.FormatBigNum(len(matched)), "names")
@@ -0,0 +1,581 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais model` commands: model repository layered over AIS buckets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+// Model repository naming conventions (any bucket can serve as a model store):
+//
+//	MODEL/VERSION/ARTIFACT                  - model artifacts (weights, configs, tokenizers, etc.)
+//	MODEL/VERSION/.manifest.json            - list of artifacts with sizes and checksums (written last)
+//	MODEL/.tags/TAG                         - tag (e.g. "latest") that resolves to VERSION
+//
+// - model names may contain slashes (e.g. "org/llama-7b");
+// - a version without manifest is incomplete (e.g., interrupted push) and is not listed;
+// - "latest" tag is updated upon every push.
+
+const (
+	modelManifestName = ".manifest.json"
+	modelTagsDir      = ".tags"
+	modelTagLatest    = "latest"
+	modelCksumType    = cos.ChecksumSHA256
+	modelVersionPref  = "v"
+)
+
+type (
+	modelArtifact struct {
+		Name  string `json:"name"`
+		Cksum string `json:"checksum"`
+		Size  int64  `json:"size"`
+	}
+	modelManifest struct {
+		Model     string          `json:"model"`
+		Version   string          `json:"version"`
+		CksumType string          `json:"checksum_type"`
+		Created   string          `json:"created"`
+		Artifacts []modelArtifact `json:"artifacts"`
+		Size      int64           `json:"size"`
+	}
+	// parsed BUCKET/MODEL[@VERSION_or_TAG]
+	modelRef struct {
+		bck     cmn.Bck
+		model   string
+		version string // (or tag)
+	}
+	// models and their versions and tags, as listed
+	modelEntry struct {
+		tags     map[string]string // tag => version
+		versions []string
+	}
+)
+
+var (
+	modelCmdsFlags = map[string][]cli.Flag{
+		cmdModelPush: {
+			modelTagFlag,
+			verboseFlag,
+		},
+		cmdModelPull: {
+			verboseFlag,
+		},
+		cmdList: {
+			noHeaderFlag,
+		},
+	}
+
+	modelCmd = cli.Command{
+		Name:  commandModel,
+		Usage: "push, pull, and list versioned ML models (model repository over AIS buckets)",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdModelPush,
+				Usage: "upload model artifacts (local directory or file) as a new model version, e.g.:\n" +
+					indent1 + "\t- 'ais model push ./ckpt ais://models/llama-7b' - push as the next version (v1, v2, ...) and tag it 'latest';\n" +
+					indent1 + "\t- 'ais model push ./ckpt ais://models/llama-7b@v3 --tag prod' - push as version 'v3' and tag it 'latest' and 'prod'",
+				ArgsUsage:    modelPushArgument,
+				Flags:        modelCmdsFlags[cmdModelPush],
+				Action:       modelPushHandler,
+				BashComplete: putPromApndCompletions,
+			},
+			{
+				Name: cmdModelPull,
+				Usage: "download model artifacts and verify their checksums, e.g.:\n" +
+					indent1 + "\t- 'ais model pull ais://models/llama-7b' - pull the 'latest' version into ./llama-7b;\n" +
+					indent1 + "\t- 'ais model pull ais://models/llama-7b@prod /data/llama' - pull the version tagged 'prod' into /data/llama",
+				ArgsUsage:    modelPullArgument,
+				Flags:        modelCmdsFlags[cmdModelPull],
+				Action:       modelPullHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+			{
+				Name:         cmdList,
+				Usage:        "list models in a bucket or versions (and tags) of a given model",
+				ArgsUsage:    modelListArgument,
+				Flags:        modelCmdsFlags[cmdList],
+				Action:       modelListHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+		},
+	}
+)
+
+//
+// push
+//
+
+func modelPushHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	src := c.Args().Get(0)
+	ref, err := parseModelRef(c, c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	tags := []string{modelTagLatest}
+	for _, tag := range splitCsv(parseStrFlag(c, modelTagFlag)) {
+		if err := validateModelName(tag, "tag"); err != nil {
+			return err
+		}
+		if tag != modelTagLatest {
+			tags = append(tags, tag)
+		}
+	}
+
+	// artifacts
+	files, err := modelFiles(src)
+	if err != nil {
+		return err
+	}
+
+	// version: explicit or next
+	models, err := listModels(ref.bck, ref.model)
+	if err != nil {
+		return err
+	}
+	entry := models[ref.model]
+	switch {
+	case ref.version == "":
+		ref.version = nextModelVersion(entry)
+	case entry != nil && cos.StringInSlice(ref.version, entry.versions):
+		return fmt.Errorf("%s already exists (model versions are immutable)", ref)
+	case entry != nil && entry.tags[ref.version] != "":
+		return fmt.Errorf("%s: %q is a tag (cannot be used as a version name)", ref, ref.version)
+	}
+
+	manifest := &modelManifest{
+		Model:     ref.model,
+		Version:   ref.version,
+		CksumType: modelCksumType,
+		Created:   time.Now().UTC().Format(time.RFC3339),
+		Artifacts: make([]modelArtifact, 0, len(files)),
+	}
+	for _, name := range sortedKeys(files) {
+		art, err := pushArtifact(ref, name, files[name])
+		if err != nil {
+			return err
+		}
+		if flagIsSet(c, verboseFlag) {
+			fmt.Fprintf(c.App.Writer, "%s => %s (%s)\n", files[name], ref.bck.Cname(ref.objName(name)),
+				cos.ToSizeIEC(art.Size, 2))
+		}
+		manifest.Artifacts = append(manifest.Artifacts, *art)
+		manifest.Size += art.Size
+	}
+
+	// commit: manifest, and then tags
+	if err := putModelObj(ref.bck, ref.objName(modelManifestName), cos.MustMarshal(manifest)); err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if err := putModelObj(ref.bck, ref.tagName(tag), []byte(ref.version)); err != nil {
+			return err
+		}
+	}
+	actionDone(c, fmt.Sprintf("Pushed %s: %d artifact%s, %s (tags: %s)", ref, len(manifest.Artifacts),
+		cos.Plural(len(manifest.Artifacts)), cos.ToSizeIEC(manifest.Size, 2), strings.Join(tags, ", ")))
+	return nil
+}
+
+// local artifacts: relative (slash-separated) name => path
+func modelFiles(src string) (map[string]string, error) {
+	finfo, err := os.Stat(src)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]string, 4)
+	if !finfo.IsDir() {
+		files[filepath.Base(src)] = src
+		return files, nil
+	}
+	err = filepath.WalkDir(src, func(path string, de fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !de.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = path
+		return nil
+	})
+	if err == nil && len(files) == 0 {
+		err = fmt.Errorf("%q contains no files", src)
+	}
+	return files, err
+}
+
+func pushArtifact(ref *modelRef, name, path string) (*modelArtifact, error) {
+	fh, err := cos.NewFileHandle(path)
+	if err != nil {
+		return nil, err
+	}
+	size, cksum, err := cos.CopyAndChecksum(io.Discard, fh, nil, modelCksumType)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+	if _, err := fh.Seek(0, io.SeekStart); err != nil {
+		fh.Close()
+		return nil, err
+	}
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        ref.bck,
+		ObjName:    ref.objName(name),
+		Reader:     fh,
+		Cksum:      cksum.Clone(), // end-to-end protection
+		Size:       uint64(size),
+	}
+	if _, err := api.PutObject(&putArgs); err != nil {
+		return nil, err
+	}
+	return &modelArtifact{Name: name, Cksum: cksum.Value(), Size: size}, nil
+}
+
+func putModelObj(bck cmn.Bck, objName string, b []byte) error {
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     cos.NewByteHandle(b),
+		Size:       uint64(len(b)),
+	}
+	_, err := api.PutObject(&putArgs)
+	return err
+}
+
+//
+// pull
+//
+
+func modelPullHandler(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	ref, err := parseModelRef(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	dst := c.Args().Get(1)
+	if dst == "" {
+		dst = filepath.Base(filepath.FromSlash(ref.model))
+	}
+	if err := resolveModelVersion(ref); err != nil {
+		return err
+	}
+	manifest, err := getModelManifest(ref)
+	if err != nil {
+		return err
+	}
+	for i := range manifest.Artifacts {
+		art := &manifest.Artifacts[i]
+		path, err := pullArtifact(ref, manifest.CksumType, art, dst)
+		if err != nil {
+			return err
+		}
+		if flagIsSet(c, verboseFlag) {
+			fmt.Fprintf(c.App.Writer, "%s => %s (%s)\n", ref.bck.Cname(ref.objName(art.Name)), path,
+				cos.ToSizeIEC(art.Size, 2))
+		}
+	}
+	actionDone(c, fmt.Sprintf("Pulled %s => %s: %d artifact%s, %s (checksums verified)", ref, dst,
+		len(manifest.Artifacts), cos.Plural(len(manifest.Artifacts)), cos.ToSizeIEC(manifest.Size, 2)))
+	return nil
+}
+
+func pullArtifact(ref *modelRef, cksumType string, art *modelArtifact, dst string) (string, error) {
+	rel := filepath.FromSlash(art.Name)
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%s: invalid artifact name %q", ref, art.Name)
+	}
+	path := filepath.Join(dst, rel)
+	if err := cos.CreateDir(filepath.Dir(path)); err != nil {
+		return "", err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	cksum := cos.NewCksumHash(cksumType)
+	oah, err := api.GetObject(apiBP, ref.bck, ref.objName(art.Name), &api.GetArgs{Writer: io.MultiWriter(file, cksum.H)})
+	if errC := file.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		cksum.Finalize()
+		switch {
+		case oah.Size() != art.Size:
+			err = fmt.Errorf("%s: artifact %q size mismatch: got %d, expected %d", ref, art.Name, oah.Size(), art.Size)
+		case cksum.Value() != art.Cksum:
+			err = fmt.Errorf("%s: artifact %q checksum mismatch: got %s, expected %s", ref, art.Name,
+				cos.SHead(cksum.Value()), cos.SHead(art.Cksum))
+		}
+	}
+	if err != nil {
+		os.Remove(path)
+	}
+	return path, err
+}
+
+// tag => version (in particular, when not specified: "latest")
+func resolveModelVersion(ref *modelRef) error {
+	tag := ref.version
+	if tag == "" {
+		tag = modelTagLatest
+	}
+	b := &bytes.Buffer{}
+	_, err := api.GetObject(apiBP, ref.bck, ref.tagName(tag), &api.GetArgs{Writer: b})
+	switch {
+	case err == nil:
+		ref.version = strings.TrimSpace(b.String())
+	case !cmn.IsStatusNotFound(err):
+		return err
+	case ref.version == "":
+		return fmt.Errorf("model %s not found (or has no %q tag)", ref.bck.Cname(ref.model), modelTagLatest)
+	}
+	return nil
+}
+
+func getModelManifest(ref *modelRef) (*modelManifest, error) {
+	b := &bytes.Buffer{}
+	if _, err := api.GetObject(apiBP, ref.bck, ref.objName(modelManifestName), &api.GetArgs{Writer: b}); err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return nil, fmt.Errorf("%s not found", ref)
+		}
+		return nil, err
+	}
+	manifest := &modelManifest{}
+	if err := json.Unmarshal(b.Bytes(), manifest); err != nil {
+		return nil, fmt.Errorf("%s: invalid manifest: %v", ref, err)
+	}
+	return manifest, nil
+}
+
+//
+// list
+//
+
+func modelListHandler(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, model, err := parseBckObjURI(c, c.Args().Get(0), true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	model = strings.TrimSuffix(model, "/")
+	models, err := listModels(bck, model)
+	if err != nil {
+		return err
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+
+	// all models
+	if model == "" {
+		if len(models) == 0 {
+			fmt.Fprintf(c.App.Writer, "No models in %s\n", bck.Cname(""))
+			return nil
+		}
+		if !flagIsSet(c, noHeaderFlag) {
+			fmt.Fprintln(tw, "MODEL\tVERSIONS\tLATEST")
+		}
+		for _, name := range sortedKeys(models) {
+			entry := models[name]
+			fmt.Fprintf(tw, "%s\t%d\t%s\n", name, len(entry.versions), entry.tags[modelTagLatest])
+		}
+		return tw.Flush()
+	}
+
+	// versions of a given model
+	entry, ok := models[model]
+	if !ok {
+		return fmt.Errorf("model %s not found", bck.Cname(model))
+	}
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "VERSION\tARTIFACTS\tSIZE\tCREATED\tTAGS")
+	}
+	tags := make(map[string][]string, len(entry.tags))
+	for tag, version := range entry.tags {
+		tags[version] = append(tags[version], tag)
+	}
+	for _, version := range entry.versions {
+		ref := &modelRef{bck: bck, model: model, version: version}
+		manifest, err := getModelManifest(ref)
+		if err != nil {
+			return err
+		}
+		sort.Strings(tags[version])
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\n", version, len(manifest.Artifacts), cos.ToSizeIEC(manifest.Size, 2),
+			manifest.Created, strings.Join(tags[version], ", "))
+	}
+	return tw.Flush()
+}
+
+// list (all or one) models: versions are identified by their manifests
+func listModels(bck cmn.Bck, model string) (map[string]*modelEntry, error) {
+	var prefix string
+	if model != "" {
+		prefix = model + "/"
+	}
+	lsmsg := &apc.LsoMsg{Prefix: prefix, Props: apc.GetPropsName}
+	lst, err := api.ListObjects(apiBP, bck, lsmsg, api.ListArgs{})
+	if err != nil {
+		return nil, err
+	}
+	models := make(map[string]*modelEntry, 4)
+	for _, en := range lst.Entries {
+		var (
+			name, version, tag string
+			base               = filepath.Base(en.Name)
+			dir                = filepath.Dir(en.Name)
+		)
+		switch {
+		case base == modelManifestName:
+			name, version = filepath.Dir(dir), filepath.Base(dir)
+		case filepath.Base(dir) == modelTagsDir:
+			name, tag = filepath.Dir(dir), base
+		default:
+			continue
+		}
+		// (nested models under a given one)
+		if name == "." || (model != "" && name != model) {
+			continue
+		}
+		entry, ok := models[name]
+		if !ok {
+			entry = &modelEntry{tags: make(map[string]string, 2)}
+			models[name] = entry
+		}
+		if version != "" {
+			entry.versions = append(entry.versions, version)
+			continue
+		}
+		b := &bytes.Buffer{}
+		if _, err := api.GetObject(apiBP, bck, en.Name, &api.GetArgs{Writer: b}); err != nil {
+			return nil, err
+		}
+		entry.tags[tag] = strings.TrimSpace(b.String())
+	}
+	for _, entry := range models {
+		sort.Slice(entry.versions, func(i, j int) bool { return lessModelVersion(entry.versions[i], entry.versions[j]) })
+	}
+	return models, nil
+}
+
+//
+// versions
+//
+
+func modelVersionNum(version string) (int64, bool) {
+	if !strings.HasPrefix(version, modelVersionPref) {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(version[len(modelVersionPref):], 10, 64)
+	return n, err == nil && n > 0
+}
+
+// numeric versions (v1, v2, ..., v10) first, in numeric order
+func lessModelVersion(a, b string) bool {
+	na, oka := modelVersionNum(a)
+	nb, okb := modelVersionNum(b)
+	switch {
+	case oka && okb:
+		return na < nb
+	case oka != okb:
+		return oka
+	default:
+		return a < b
+	}
+}
+
+func nextModelVersion(entry *modelEntry) string {
+	var highest int64
+	if entry != nil {
+		for _, version := range entry.versions {
+			if n, ok := modelVersionNum(version); ok && n > highest {
+				highest = n
+			}
+		}
+	}
+	return modelVersionPref + strconv.FormatInt(highest+1, 10)
+}
+
+//////////////
+// modelRef //
+//////////////
+
+func parseModelRef(c *cli.Context, uri string) (*modelRef, error) {
+	bck, objName, err := parseBckObjURI(c, uri, false /*emptyObjnameOK*/)
+	if err != nil {
+		return nil, err
+	}
+	ref := &modelRef{bck: bck, model: strings.TrimSuffix(objName, "/")}
+	if i := strings.LastIndexByte(ref.model, '@'); i >= 0 {
+		ref.model, ref.version = ref.model[:i], ref.model[i+1:]
+		if err := validateModelName(ref.version, "version (or tag)"); err != nil {
+			return nil, err
+		}
+	}
+	if ref.model == "" {
+		return nil, incorrectUsageMsg(c, "%q: missing model name", uri)
+	}
+	for _, s := range strings.Split(ref.model, "/") {
+		if s == "" || s[0] == '.' {
+			return nil, fmt.Errorf("invalid model name %q", ref.model)
+		}
+	}
+	return ref, nil
+}
+
+func validateModelName(s, what string) error {
+	if s == "" || s[0] == '.' || strings.ContainsAny(s, "/@") {
+		return fmt.Errorf("invalid model %s %q", what, s)
+	}
+	return nil
+}
+
+func (ref *modelRef) objName(name string) string { return ref.model + "/" + ref.version + "/" + name }
+func (ref *modelRef) tagName(tag string) string  { return ref.model + "/" + modelTagsDir + "/" + tag }
+
+func (ref *modelRef) String() string {
+	if ref.version == "" {
+		return ref.bck.Cname(ref.model)
+	}
+	return ref.bck.Cname(ref.model) + "@" + ref.version
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -6,9 +6,12 @@
 package cli
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -19,25 +22,30 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
+	"golang.org/x/term"
 )
 
-const dryRunExamplesCnt = 10
+const (
+	dryRunExamplesCnt = 10
+	stdinBatchSize    = 10000 // max number of object names (read from stdin) in a single list-range request
+)
 
 type lrCtx struct {
 	listObjs, tmplObjs string
+	objNames           []string // when not empty, takes precedence over listObjs (see `fromStdinFlag`)
 	bck                cmn.Bck
 }
 
 // x-TCO: multi-object transform or copy
-func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName string) error {
+func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, objNames []string, tmplObjs, etlName string) error {
 	var (
 		lrMsg        apc.ListRange
 		numObjs      int64
 		showProgress = flagIsSet(c, progressFlag)
 	)
 	// 1. list or template
-	if listObjs != "" {
-		lrMsg.ObjNames = splitCsv(listObjs)
+	if len(objNames) > 0 {
+		lrMsg.ObjNames = objNames
 		numObjs = int64(len(lrMsg.ObjNames))
 	} else if tmplObjs == "" {
 		// motivation: copy the entire bucket via x-tco rather than x-tcb
@@ -61,6 +69,8 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	msg := cmn.TCObjsMsg{ToBck: bckTo}
 	{
 		msg.ListRange = lrMsg
+		msg.Prepend = parseStrFlag(c, copyPrependFlag)
+		msg.Rename = parseStrFlag(c, copyRenameFlag)
 		msg.DryRun = flagIsSet(c, copyDryRunFlag)
 		if flagIsSet(c, etlBucketRequestTimeout) {
 			msg.Timeout = cos.Duration(etlBucketRequestTimeout.Value)
@@ -125,10 +135,66 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	return err
 }
 
+// `--from-stdin`: read object names (one per line) and run `cb` for each consecutive batch
+func stdinBatches(c *cli.Context, cb func(objNames []string) error) error {
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		return incorrectUsageMsg(c, "option %s expects object names piped into standard input, e.g.: 'cat names.txt | ais %s ... %s'",
+			qflprn(fromStdinFlag), c.Command.FullName(), flprn(fromStdinFlag))
+	}
+	n, err := readNameBatches(stdinReader, stdinBatchSize, cb)
+	if err == nil && n == 0 {
+		err = fmt.Errorf("%s: no object names in standard input", flprn(fromStdinFlag))
+	}
+	return err
+}
+
+// (empty lines are skipped; names are trimmed of leading and trailing whitespace - same as in `--list`)
+func readNameBatches(r io.Reader, batchSize int, cb func(objNames []string) error) (n int, _ error) {
+	var (
+		scanner = bufio.NewScanner(r)
+		batch   = make([]string, 0, min(batchSize, 1024))
+	)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" {
+			continue
+		}
+		batch = append(batch, name)
+		if len(batch) < batchSize {
+			continue
+		}
+		if err := cb(batch); err != nil {
+			return n, err
+		}
+		n += len(batch)
+		batch = make([]string, 0, batchSize)
+	}
+	if err := scanner.Err(); err != nil {
+		return n, err
+	}
+	if len(batch) == 0 {
+		return n, nil
+	}
+	n += len(batch)
+	return n, cb(batch)
+}
+
 //
 // evict, rm, prefetch ------------------------------------------------------------------------
 //
 
+// (compare with `--list`, which is limited by the maximum length of a command line)
+func lrFromStdin(c *cli.Context, bck cmn.Bck) error {
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "option %s requires a single bucket argument (have %d: %v)",
+			qflprn(fromStdinFlag), c.NArg(), c.Args())
+	}
+	return stdinBatches(c, func(objNames []string) error {
+		lr := &lrCtx{objNames: objNames, bck: bck}
+		return lr.do(c)
+	})
+}
+
 func evictHandler(c *cli.Context) error {
 	if flagIsSet(c, verboseFlag) && flagIsSet(c, nonverboseFlag) {
 		return incorrectUsageMsg(c, errFmtExclusive, qflprn(verboseFlag), qflprn(nonverboseFlag))
@@ -149,7 +215,10 @@ func evictHandler(c *cli.Context) error {
 
 // handle one BUCKET[/OBJECT_NAME_or_TEMPLATE] (command line may contain multiple of those)
 func _evictOne(c *cli.Context, shift int) error {
-	uri := preparseBckObjURI(c.Args().Get(shift))
+	uri, err := preparseViewOrURI(c, shift)
+	if err != nil {
+		return err
+	}
 	bck, objNameOrTmpl, err := parseBckObjURI(c, uri, true /*emptyObjnameOK*/)
 	if err != nil {
 		return err
@@ -165,10 +234,13 @@ func _evictOne(c *cli.Context, shift int) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, fromStdinFlag) {
+		return lrFromStdin(c, bck)
+	}
 
 	switch {
 	case listObjs != "" || tmplObjs != "": // 1. multi-obj
-		lrCtx := &lrCtx{listObjs, tmplObjs, bck}
+		lrCtx := &lrCtx{listObjs: listObjs, tmplObjs: tmplObjs, bck: bck}
 		return lrCtx.do(c)
 	case objName == "": // 2. entire bucket
 		return evictBucket(c, bck)
@@ -210,7 +282,10 @@ func rmHandler(c *cli.Context) error {
 
 // handle one BUCKET[/OBJECT_NAME_or_TEMPLATE] (command line may contain multiple of those)
 func _rmOne(c *cli.Context, shift int) error {
-	uri := preparseBckObjURI(c.Args().Get(shift))
+	uri, err := preparseViewOrURI(c, shift)
+	if err != nil {
+		return err
+	}
 	bck, objNameOrTmpl, err := parseBckObjURI(c, uri, true /*emptyObjnameOK*/)
 	if err != nil {
 		return err
@@ -222,10 +297,13 @@ func _rmOne(c *cli.Context, shift int) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, fromStdinFlag) {
+		return lrFromStdin(c, bck)
+	}
 
 	switch {
 	case listObjs != "" || tmplObjs != "": // 1. multi-obj
-		lrCtx := &lrCtx{listObjs, tmplObjs, bck}
+		lrCtx := &lrCtx{listObjs: listObjs, tmplObjs: tmplObjs, bck: bck}
 		return lrCtx.do(c)
 	case objName == "": // 2. all objects
 		if flagIsSet(c, rmrfFlag) {
@@ -277,7 +355,10 @@ func startPrefetchHandler(c *cli.Context) error {
 
 // ditto
 func _prefetchOne(c *cli.Context, shift int) error {
-	uri := preparseBckObjURI(c.Args().Get(shift))
+	uri, err := preparseViewOrURI(c, shift)
+	if err != nil {
+		return err
+	}
 	bck, objNameOrTmpl, err := parseBckObjURI(c, uri, true /*emptyObjnameOK*/)
 	if err != nil {
 		return err
@@ -292,11 +373,14 @@ func _prefetchOne(c *cli.Context, shift int) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, fromStdinFlag) {
+		return lrFromStdin(c, bck)
+	}
 
 	if listObjs == "" && tmplObjs == "" {
 		listObjs = objName
 	}
-	lrCtx := &lrCtx{listObjs, tmplObjs, bck}
+	lrCtx := &lrCtx{listObjs: listObjs, tmplObjs: tmplObjs, bck: bck}
 	return lrCtx.do(c)
 }
 
@@ -312,9 +396,12 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 		emptyTemplate bool
 	)
 	// 1. parse
-	if lr.listObjs != "" {
+	switch {
+	case len(lr.objNames) > 0:
+		fileList = lr.objNames
+	case lr.listObjs != "":
 		fileList = splitCsv(lr.listObjs)
-	} else {
+	default:
 		pt, err = cos.NewParsedTemplate(lr.tmplObjs) // NOTE: prefix w/ no range is fine
 		if err != nil {
 			if err != cos.ErrEmptyTemplate {
@@ -333,7 +420,7 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 
 	// 3. do
 	xid, kind, action, errV := lr._do(c, fileList)
-	if err != nil {
+	if errV != nil {
 		return V(errV)
 	}
 
@@ -342,7 +429,7 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 		xname, text string
 		num         int64
 	)
-	if lr.listObjs != "" {
+	if fileList != nil {
 		num = int64(len(fileList))
 		s := fmt.Sprintf("%v", fileList)
 		if num > 4 {
@@ -370,7 +457,16 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 			loghdr: text,
 		}
 		cpr.totals.objs = num
-		return cpr.multiobj(c, text)
+		if kind == apc.ActEvictObjects && len(pt.Ranges) == 0 {
+			// prefix (or entire bucket): the number of objects to evict is the number of those in-cluster
+			if cached, ok := lr.numCached(pt.Prefix); ok {
+				cpr.totals.objs = cached
+			}
+		}
+		if err := cpr.multiobj(c, text); err != nil {
+			return err
+		}
+		return lr.report(c, xid, kind)
 	}
 
 	// 6. otherwise, wait or exit
@@ -393,6 +489,46 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 		return err
 	}
 	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	return lr.report(c, xid, kind)
+}
+
+// number of objects (under a given prefix) present in the cluster
+func (lr *lrCtx) numCached(prefix string) (int64, bool) {
+	msg := &apc.BsummCtrlMsg{Prefix: prefix, ObjCached: true, BckPresent: true}
+	_, res, err := api.GetBucketSummary(apiBP, cmn.QueryBcks(lr.bck), msg, api.BsummArgs{})
+	if err != nil || len(res) == 0 {
+		return 0, false
+	}
+	return int64(res[0].ObjCount.Present), true
+}
+
+// final tally for evict and delete: objects removed and bytes reclaimed (summed up across targets)
+func (lr *lrCtx) report(c *cli.Context, xid, kind string) error {
+	var verb string
+	switch kind {
+	case apc.ActEvictObjects:
+		verb = "evicted"
+	case apc.ActDeleteObjects:
+		verb = "deleted"
+	default:
+		return nil
+	}
+	if flagIsSet(c, nonverboseFlag) {
+		return nil
+	}
+	xs, err := queryXactions(&xact.ArgsMsg{ID: xid, Kind: kind})
+	if err != nil {
+		return V(err)
+	}
+	var objs, size int64
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			objs += snap.Stats.Objs
+			size += snap.Stats.Bytes
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "%s %d object%s from %s (%s reclaimed)\n",
+		verb, objs, cos.Plural(int(objs)), lr.bck.Cname(""), cos.ToSizeIEC(size, 2))
 	return nil
 }
 
@@ -19,7 +19,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
@@ -32,13 +34,24 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 		recurs = flagIsSet(c, recursFlag)
 	)
 	args := apc.PromoteArgs{
-		DaemonID:       target,
-		ObjName:        objName,
-		SrcFQN:         fqn,
-		Recursive:      recurs,
-		SrcIsNotFshare: flagIsSet(c, notFshareFlag),
-		OverwriteDst:   flagIsSet(c, overwriteFlag),
-		DeleteSrc:      flagIsSet(c, deleteSrcFlag),
+		DaemonID:        target,
+		ObjName:         objName,
+		SrcFQN:          fqn,
+		Recursive:       recurs,
+		SrcIsNotFshare:  flagIsSet(c, notFshareFlag),
+		OverwriteDst:    flagIsSet(c, overwriteFlag),
+		DeleteSrc:       flagIsSet(c, deleteSrcFlag),
+		HardLink:        flagIsSet(c, hardLinkFlag),
+		ContinueOnError: flagIsSet(c, continueOnErrorFlag),
+	}
+	if flagIsSet(c, includeFlag) {
+		args.Include = splitCsv(parseStrFlag(c, includeFlag))
+	}
+	if flagIsSet(c, excludeFlag) {
+		args.Exclude = splitCsv(parseStrFlag(c, excludeFlag))
+	}
+	if err := args.ValidatePatterns(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
 	}
 	xid, err := api.Promote(apiBP, bck, &args)
 	if err != nil {
@@ -51,9 +64,51 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 	if xid != "" {
 		s2 = fmt.Sprintf(", xaction ID %q", xid)
 	}
-	// alternatively, print(fmtXactStatusCheck, apc.ActPromote, ...)
-	msg := fmt.Sprintf("%spromoted %q => %s%s\n", s1, fqn, bck.Cname(""), s2)
-	actionDone(c, msg)
+	if xid == "" || (!flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag)) {
+		// alternatively, print(fmtXactStatusCheck, apc.ActPromote, ...)
+		msg := fmt.Sprintf("%spromoted %q => %s%s\n", s1, fqn, bck.Cname(""), s2)
+		actionDone(c, msg)
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintf(c.App.Writer, "%spromoting %q => %s%s ...\n", s1, fqn, bck.Cname(""), s2)
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActPromote, Timeout: timeout}
+	if err := waitXact(apiBP, &xargs); err != nil {
+		return err
+	}
+	return promoteReport(c, xid)
+}
+
+// per-target summary, including (some of) the per-file errors, if any
+func promoteReport(c *cli.Context, xid string) error {
+	snaps, err := api.QueryXactionSnaps(apiBP, &xact.ArgsMsg{ID: xid, Kind: apc.ActPromote})
+	if err != nil {
+		return V(err)
+	}
+	var failed int64
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			var stats apc.PromoteStats
+			if snap.Ext == nil || cos.MorphMarshal(snap.Ext, &stats) != nil {
+				continue
+			}
+			fmt.Fprintf(c.App.Writer, "%s: promoted %d (linked %d), skipped %d, failed %d\n",
+				meta.Tname(tid), snap.Stats.Objs, stats.Linked, stats.Skipped, stats.Failed)
+			for _, e := range stats.Recent {
+				fmt.Fprintln(c.App.ErrWriter, "\t"+e)
+			}
+			failed += stats.Failed
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to promote %d file%s", failed, cos.Plural(int(failed)))
+	}
+	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
 	return nil
 }
 
@@ -91,6 +146,67 @@ func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
 }
 
 // replace common abbreviations (such as `~/`) and return an absolute path
+// rename virtual directory: `ais object mv BUCKET/dir1/ BUCKET/dir2/`
+func mvPrefix(c *cli.Context, bck cmn.Bck, prefix, newPrefix string) error {
+	msg := &apc.RenamePrefixMsg{Prefix: prefix, NewPrefix: newPrefix}
+	if err := msg.Validate(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
+	lr := &lrCtx{bck: bck}
+	num, ok := lr.numCached(prefix)
+	if ok && num == 0 {
+		return fmt.Errorf("virtual directory %s is empty or does not exist", bck.Cname(prefix))
+	}
+	xid, err := api.RenamePrefix(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(apc.ActRenamePrefix)
+	text := fmt.Sprintf("%s: %s => %s", xactCname(xname, xid), bck.Cname(prefix), bck.Cname(newPrefix))
+
+	switch {
+	case flagIsSet(c, progressFlag):
+		cpr := cprCtx{xname: xname, xid: xid, from: bck.Cname(prefix), to: bck.Cname(newPrefix), loghdr: text}
+		cpr.totals.objs = num
+		if err := cpr.multiobj(c, text); err != nil {
+			return err
+		}
+	case flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag):
+		var timeout time.Duration
+		if flagIsSet(c, waitJobXactFinishedFlag) {
+			timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+		}
+		fmt.Fprintln(c.App.Writer, text+" ...")
+		xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActRenamePrefix, Timeout: timeout}
+		if err := waitXact(apiBP, &xargs); err != nil {
+			return err
+		}
+		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	default:
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// summary
+	if flagIsSet(c, nonverboseFlag) {
+		return nil
+	}
+	xs, err := queryXactions(&xact.ArgsMsg{ID: xid, Kind: apc.ActRenamePrefix})
+	if err != nil {
+		return V(err)
+	}
+	var objs, size int64
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			objs += snap.Stats.Objs
+			size += snap.Stats.Bytes
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "renamed %d object%s (%s) %s => %s\n",
+		objs, cos.Plural(int(objs)), cos.ToSizeIEC(size, 2), bck.Cname(prefix), bck.Cname(newPrefix))
+	return nil
+}
+
 func absPath(fileName string) (path string, err error) {
 	path = cos.ExpandPath(fileName)
 	if path, err = filepath.Abs(path); err != nil {
@@ -276,6 +392,9 @@ func showObjProps(c *cli.Context, bck cmn.Bck, objName string) error {
 		return fmt.Errorf("%q not found in %s%s", objName, bck.Cname(""), hint)
 	}
 
+	if flagIsSet(c, objProvenanceFlag) {
+		return showObjProvenance(c, objProps)
+	}
 	if flagIsSet(c, jsonFlag) {
 		return teb.Print(objProps, teb.PropValTmpl, teb.Jopts(true))
 	}
@@ -319,6 +438,40 @@ func showObjProps(c *cli.Context, bck cmn.Bck, objName string) error {
 	return teb.Print(propNVs, teb.PropValTmpl)
 }
 
+// (see cmn.ProvJobObjMD et al.)
+func showObjProvenance(c *cli.Context, op *cmn.ObjectProps) error {
+	var (
+		custom = op.GetCustomMD()
+		prov   = make(nvpairList, 0, 6)
+	)
+	prov = append(prov, nvpair{"name", op.Bck.Cname(op.Name)})
+	for _, kv := range [][2]string{
+		{"source", cmn.SourceObjMD},
+		{"original-url", cmn.OrigURLObjMD},
+		{"job", cmn.ProvJobObjMD},
+		{"job-id", cmn.ProvXactObjMD},
+		{"client", cmn.ProvClientObjMD},
+	} {
+		if v, ok := custom[kv[1]]; ok && v != "" {
+			prov = append(prov, nvpair{kv[0], v})
+		}
+	}
+	if len(prov) == 1 {
+		prov = append(prov, nvpair{"source", teb.NotSetVal})
+	}
+	if flagIsSet(c, jsonFlag) {
+		m := make(cos.StrKVs, len(prov))
+		for _, nv := range prov {
+			m[nv.Name] = nv.Value
+		}
+		return teb.Print(m, "", teb.Jopts(true))
+	}
+	if flagIsSet(c, noHeaderFlag) {
+		return teb.Print(prov, teb.PropValTmplNoHdr)
+	}
+	return teb.Print(prov, teb.PropValTmpl)
+}
+
 func propVal(op *cmn.ObjectProps, name string) (v string) {
 	switch name {
 	case apc.GetPropsName:
@@ -423,5 +576,8 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 	warn := fmt.Sprintf("failed to delete %d object%s from %s: (%d deleted, %d error%s)\n", l-cnt, cos.Plural(l-cnt),
 		bck, cnt, errCnt64, cos.Plural(int(errCnt64)))
 	actionWarn(c, warn)
+	if cnt > 0 {
+		return newErrPartial(firstErr)
+	}
 	return firstErr
 }
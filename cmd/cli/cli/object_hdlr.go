@@ -6,16 +6,19 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
@@ -25,24 +28,33 @@ var (
 	objectCmdsFlags = map[string][]cli.Flag{
 		commandRemove: append(
 			listRangeProgressWaitFlags,
+			fromStdinFlag,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			rmrfFlag,
 			verboseFlag, // rm -rf
 			nonverboseFlag,
 			yesFlag,
 		),
-		commandRename: {},
+		commandRename: {
+			waitFlag,
+			waitJobXactFinishedFlag,
+			progressFlag,
+			refreshFlag,
+			nonverboseFlag,
+		},
 		commandGet: {
 			offsetFlag,
 			lengthFlag,
 			cksumFlag,
 			yesFlag,
 			headObjPresentFlag,
+			objVersionFlag,
 			latestVerFlag,
 			refreshFlag,
 			progressFlag,
 			// archive
 			archpathGetFlag,
+			archglobFlag,
 			extractFlag,
 			// multi-object options (passed to list-objects)
 			getObjPrefixFlag,
@@ -60,6 +72,8 @@ var (
 			concurrencyFlag,
 			dryRunFlag,
 			recursFlag,
+			includeFlag,
+			excludeFlag,
 			verboseFlag,
 			yesFlag,
 			continueOnErrorFlag,
@@ -69,16 +83,34 @@ var (
 			putObjDfltCksumFlag,
 			// append
 			appendConcatFlag,
+			// stdin
+			nameByHashFlag,
+			// delta sync
+			deltaFlag,
+			// user metadata
+			putObjMetaFlag,
 		),
 		commandSetCustom: {
 			setNewCustomMDFlag,
 		},
+		commandSetProps: {
+			setNewCustomMDFlag,
+		},
+		commandBatch: {
+			jsonFlag,
+		},
 		commandPromote: {
 			recursFlag,
 			overwriteFlag,
 			notFshareFlag,
 			deleteSrcFlag,
+			hardLinkFlag,
+			includeFlag,
+			excludeFlag,
+			continueOnErrorFlag,
 			targetIDFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
 			verboseFlag,
 		},
 		commandConcat: {
@@ -102,7 +134,7 @@ var (
 			indent4 + "\twrite the content locally with destination options including: filename, directory, STDOUT ('-'), or '/dev/null' (discard);\n" +
 			indent4 + "\tassorted options further include:\n" +
 			indent4 + "\t- '--prefix' to get multiple objects in one shot (empty prefix for the entire bucket);\n" +
-			indent4 + "\t- '--extract' or '--archpath' to extract archived content;\n" +
+			indent4 + "\t- '--extract', '--archpath', or '--archglob' to extract archived content;\n" +
 			indent4 + "\t- '--progress' and '--refresh' to watch progress bar;\n" +
 			indent4 + "\t- '-v' to produce verbose output when getting multiple objects.",
 		ArgsUsage:    getObjectArgument,
@@ -122,7 +154,9 @@ var (
 			indent1 + "\t- (notice quotation marks and a forward slash after 'markdown/' destination);\n" +
 			indent1 + "\t- '--compute-checksum': use '--compute-checksum' to facilitate end-to-end protection;\n" +
 			indent1 + "\t- '--progress': progress bar, to show running counts and sizes of uploaded files;\n" +
+			indent1 + "\t- '--include', '--exclude': select directory's files, e.g.: 'ais put ~/images ais://abc -r --include \"*.jpg\" --exclude \"tmp/*\"';\n" +
 			indent1 + "\t- Ctrl-D: when writing directly from standard input use Ctrl-D to terminate;\n" +
+			indent1 + "\t- '--name-by-hash': content-addressed naming, e.g.: 'cat data | ais put - ais://nnn --name-by-hash sha256';\n" +
 			indent1 + "\t- '--append' to append (concatenate) files, e.g.: 'ais put docs ais://nnn/all-docs --append';\n" +
 			indent1 + "\t- '--dry-run': see the results without making any changes.\n" +
 			indent1 + "\tNotes:\n" +
@@ -144,6 +178,8 @@ var (
 			indent1 + "\t- 'promote /tmp/subdir/f3 ais://nnn/aaa/'\t - ais://nnn/aaa/f3\n" +
 			indent1 + "\t- 'promote /tmp/subdir ais://nnn'\t - ais://nnn/f1, ais://nnn/f2, ais://nnn/f3\n" +
 			indent1 + "\t- 'promote /tmp/subdir ais://nnn/aaa/'\t - ais://nnn/aaa/f1, ais://nnn/aaa/f2, ais://nnn/aaa/f3\n" +
+			indent1 + "Instead of copying, files can be also moved ('--delete-src') or hard-linked ('--hard-link'), e.g.:\n" +
+			indent1 + "\t- 'promote /data/shards ais://nnn -r --hard-link --include '*.tar' --cont-on-err --wait'\n" +
 			indent1 + "Other supported options follow below.",
 		ArgsUsage:    promoteObjectArgument,
 		Flags:        objectCmdsFlags[commandPromote],
@@ -168,6 +204,28 @@ var (
 		Action:    setCustomPropsHandler,
 	}
 
+	objectCmdSetProps = cli.Command{
+		Name: commandSetProps,
+		Usage: "set object's properties: custom metadata, pin status, and/or checksum, e.g.:\n" +
+			indent1 + "\t- 'set-props ais://abc/obj " + objPropPinned + "=true'\t- pin the object (no LRU eviction and no validation against remote);\n" +
+			indent1 + "\t- 'set-props s3://abc/obj " + objPropCksumType + "=md5 " + objPropCksumVal + "=...'\t- set custom checksum (must match the content);\n" +
+			indent1 + "\t- 'set-props ais://abc/obj mykey=value " + objPropPinned + "=false'\t- add custom key and unpin",
+		ArgsUsage:    setPropsArgument,
+		Flags:        objectCmdsFlags[commandSetProps],
+		Action:       setObjPropsHandler,
+		BashComplete: bucketCompletions(bcmplop{separator: true}),
+	}
+
+	objectCmdBatch = cli.Command{
+		Name: commandBatch,
+		Usage: "update properties and/or remove multiple objects in a single round trip;\n" +
+			indent1 + "all or nothing for the objects stored on the same target (see also: 'set-props', 'rm')",
+		ArgsUsage:    batchOpsArgument,
+		Flags:        objectCmdsFlags[commandBatch],
+		Action:       batchObjOpsHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+
 	objectCmdPrefetch = cli.Command{
 		Name:         commandPrefetch,
 		Usage:        prefetchUsage,
@@ -203,13 +261,17 @@ var (
 			makeAlias(bucketCmdCopy, "", true, commandCopy), // alias for `ais [bucket] cp`
 			objectCmdConcat,
 			objectCmdSetCustom,
+			objectCmdSetProps,
+			objectCmdBatch,
 			objectCmdRemove,
 			objectCmdPrefetch,
 			bucketObjCmdEvict,
 			makeAlias(showCmdObject, "", true, commandShow), // alias for `ais show`
 			{
-				Name:         commandRename,
-				Usage:        "move/rename object",
+				Name: commandRename,
+				Usage: "move/rename object or virtual directory, e.g.:\n" +
+					indent1 + "\t- 'ais object mv ais://nnn/aaa ais://nnn/bbb' - rename object;\n" +
+					indent1 + "\t- 'ais object mv ais://nnn/dir1/ ais://nnn/dir2/ --progress' - rename all objects under 'dir1/' as 'dir2/...'",
 				ArgsUsage:    renameObjectArgument,
 				Flags:        objectCmdsFlags[commandRename],
 				Action:       mvObjectHandler,
@@ -266,6 +328,17 @@ func mvObjectHandler(c *cli.Context) (err error) {
 		return incorrectUsageMsg(c, "source and destination are the same object")
 	}
 
+	// virtual directory
+	if cos.IsLastB(oldObj, '/') {
+		if newObj == "" {
+			return missingArgumentsError(c, "destination virtual directory")
+		}
+		if !cos.IsLastB(newObj, '/') {
+			newObj += "/"
+		}
+		return mvPrefix(c, bck, oldObj, newObj)
+	}
+
 	if err = api.RenameObject(apiBP, bck, oldObj, newObj); err != nil {
 		return
 	}
@@ -284,6 +357,28 @@ func putHandler(c *cli.Context) error {
 	if err := a.parse(c, true /*empty dst oname*/); err != nil {
 		return err
 	}
+	if flagIsSet(c, nameByHashFlag) && !a.src.stdin {
+		return fmt.Errorf("%s requires standard input as the source ('-')", qflprn(nameByHashFlag))
+	}
+	if flagIsSet(c, deltaFlag) && !a.srcIsRegular() {
+		return fmt.Errorf("%s requires a single source file", qflprn(deltaFlag))
+	}
+	var include, exclude []string
+	if flagIsSet(c, includeFlag) || flagIsSet(c, excludeFlag) {
+		if a.src.stdin || a.srcIsRegular() || len(a.src.fdnames) > 0 || (a.pt != nil && len(a.pt.Ranges) > 0) {
+			return fmt.Errorf("%s and %s require source directory", qflprn(includeFlag), qflprn(excludeFlag))
+		}
+		if flagIsSet(c, includeFlag) {
+			include = splitCsv(parseStrFlag(c, includeFlag))
+		}
+		if flagIsSet(c, excludeFlag) {
+			exclude = splitCsv(parseStrFlag(c, excludeFlag))
+		}
+		sel := apc.PromoteArgs{Include: include, Exclude: exclude}
+		if err := sel.ValidatePatterns(); err != nil {
+			return incorrectUsageMsg(c, "%v", err)
+		}
+	}
 	if flagIsSet(c, dryRunFlag) {
 		dryRunCptn(c)
 	}
@@ -352,6 +447,17 @@ func putHandler(c *cli.Context) error {
 		return err
 	}
 	debug.Assert(ndir == 1)
+	if len(include) > 0 || len(exclude) > 0 {
+		root := srcpath
+		if finfo, err := os.Stat(srcpath); err != nil || !finfo.IsDir() {
+			root = filepath.Dir(srcpath) // (with filename-matching pattern)
+		}
+		var skipped int
+		if fobjs, skipped = fobjs.filter(root, include, exclude); skipped > 0 && flagIsSet(c, verboseFlag) {
+			actionNote(c, fmt.Sprintf("skipping %d file%s excluded by %s and/or %s", skipped, cos.Plural(skipped),
+				qflprn(includeFlag), qflprn(excludeFlag)))
+		}
+	}
 	return verbFobjs(c, &a, fobjs, a.dst.bck, ndir, a.src.recurs)
 }
 
@@ -376,6 +482,9 @@ func warnMultiSrcDstPrefix(c *cli.Context, a *putargs, from string) bool {
 }
 
 func putStdin(c *cli.Context, a *putargs) error {
+	if flagIsSet(c, nameByHashFlag) {
+		return putStdinByHash(c, a)
+	}
 	chunkSize, err := parseSizeFlag(c, chunkSizeFlag)
 	if err != nil {
 		return err
@@ -390,6 +499,9 @@ func putStdin(c *cli.Context, a *putargs) error {
 	if flagIsSet(c, verboseFlag) {
 		actionWarn(c, "To terminate input, press Ctrl-D two or more times")
 	}
+	if flagIsSet(c, putObjMetaFlag) {
+		return fmt.Errorf("%s is not supported with chunked standard input (use %s)", qflprn(putObjMetaFlag), qflprn(nameByHashFlag))
+	}
 	cksum, err := cksumToCompute(c, a.dst.bck)
 	if err != nil {
 		return err
@@ -401,6 +513,68 @@ func putStdin(c *cli.Context, a *putargs) error {
 	return nil
 }
 
+// content-addressed PUT: spool standard input to a temp file while computing its digest,
+// and then PUT the latter under the name given by the digest (unless already present)
+func putStdinByHash(c *cli.Context, a *putargs) error {
+	ty := parseStrFlag(c, nameByHashFlag)
+	if err := cos.ValidateCksumType(ty); err != nil || ty == cos.ChecksumNone {
+		types := cos.SupportedChecksums()
+		return fmt.Errorf("invalid %s %q: expecting one of %v", qflprn(nameByHashFlag), ty, types[:len(types)-1]) // (excl. none)
+	}
+	customMD, err := parseObjMetaFlag(c)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, verboseFlag) {
+		actionWarn(c, "To terminate input, press Ctrl-D two or more times")
+	}
+	fh, err := os.CreateTemp("", "ais-put-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fh.Name())
+
+	cksum := cos.NewCksumHash(ty)
+	size, err := io.Copy(cos.NewWriterMulti(cksum.H, fh), os.Stdin)
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		return err
+	}
+	cksum.Finalize()
+
+	var (
+		objName = a.dst.oname + cksum.Value()
+		cname   = a.dst.bck.Cname(objName)
+	)
+	if _, err := api.HeadObject(apiBP, a.dst.bck, objName, apc.FltPresentNoProps, true); err == nil {
+		actionDone(c, fmt.Sprintf("%s already exists - nothing to do\n", cname))
+		return nil
+	} else if !cmn.IsStatusNotFound(err) {
+		return V(err)
+	}
+
+	reader, err := cos.NewFileHandle(fh.Name())
+	if err != nil {
+		return err
+	}
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        a.dst.bck,
+		ObjName:    objName,
+		Reader:     reader,
+		Cksum:      cksum.Clone(), // (end-to-end protection)
+		Size:       uint64(size),
+		CustomMD:   customMD,
+	}
+	if _, err := api.PutObject(&putArgs); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("PUT (standard input) => %s\n", cname))
+	return nil
+}
+
 func concatHandler(c *cli.Context) (err error) {
 	var (
 		bck     cmn.Bck
@@ -455,6 +629,112 @@ func promoteHandler(c *cli.Context) (err error) {
 	return promote(c, bck, objName, fqn)
 }
 
+func setObjPropsHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, "property key-value pairs")
+	}
+	props, err := parseObjProps(c.Args().Tail())
+	if err != nil {
+		return err
+	}
+	if err := props.Validate(); err != nil {
+		return err
+	}
+	if err := api.SetObjectProps(apiBP, bck, objName, props, flagIsSet(c, setNewCustomMDFlag)); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Properties of %s updated (to show, run 'ais show object %s --props=all').",
+		bck.Cname(objName), bck.Cname(objName)))
+	return nil
+}
+
+func batchObjOpsHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, "OPS_FILE or '-' (STDIN)")
+	}
+	var (
+		b     []byte
+		fname = c.Args().Get(1)
+	)
+	if fname == fileStdIO {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(fname)
+	}
+	if err != nil {
+		return err
+	}
+	msg := &apc.BatchOpsMsg{}
+	if err := jsoniter.Unmarshal(b, msg); err != nil {
+		return fmt.Errorf("failed to parse batch operations from %q: %v", fname, err)
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	res, err := api.BatchObjOps(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(res, "", teb.Jopts(true))
+	}
+	n := res.NumErrs()
+	if n == 0 {
+		actionDone(c, fmt.Sprintf("Executed %d operation(s) on %s.", len(res.Items), bck.Cname("")))
+		return nil
+	}
+	for i := range res.Items {
+		item := &res.Items[i]
+		if item.Err != "" {
+			fmt.Fprintf(c.App.ErrWriter, "%s %s: %s\n", msg.Ops[item.Idx].Op, bck.Cname(item.ObjName), item.Err)
+		}
+	}
+	return fmt.Errorf("%d (out of %d) operation(s) failed", n, len(res.Items))
+}
+
+func parseObjProps(pairs []string) (*apc.ObjPropsToSet, error) {
+	props := &apc.ObjPropsToSet{}
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid property %q (tip: use syntax key1=value1 key2=value2 ...)", pair)
+		}
+		switch name {
+		case objPropPinned:
+			pinned, err := cos.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s value %q: %v", objPropPinned, value, err)
+			}
+			props.Pinned = &pinned
+		case objPropCksumType:
+			props.CksumType = value
+		case objPropCksumVal:
+			props.CksumVal = value
+		default:
+			if props.Custom == nil {
+				props.Custom = make(cos.StrKVs, len(pairs))
+			}
+			props.Custom[name] = value
+		}
+	}
+	return props, nil
+}
+
 func setCustomPropsHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -215,6 +215,13 @@ func parseObjListTemplate(c *cli.Context, objNameOrTmpl string) (objName, listOb
 		err = incorrectUsageMsg(c, errFmtExclusive, qflprn(listFlag), qflprn(templateFlag))
 		return "", "", "", err
 	}
+	if flagIsSet(c, fromStdinFlag) {
+		if listObjs != "" || tmplObjs != "" || objNameOrTmpl != "" {
+			err = fmt.Errorf("option %s cannot be used together with object names, templates, and prefixes (%s, %s, %s)",
+				qflprn(fromStdinFlag), qflprn(listFlag), qflprn(templateFlag), qflprn(verbObjPrefixFlag))
+		}
+		return "", "", "", err
+	}
 
 	if objNameOrTmpl != "" {
 		if listObjs != "" || tmplObjs != "" {
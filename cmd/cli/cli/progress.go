@@ -7,6 +7,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -23,6 +24,7 @@ type (
 		barText string
 		total   int64
 		options []mpb.BarOption
+		noTotal bool // total is unknown: show current counter only (see xactProgress)
 	}
 
 	// TODO: is obsolete (reimpl. via simpleBar)
@@ -38,13 +40,23 @@ func simpleBar(args ...barArgs) (progress *mpb.Progress, bars []*mpb.Bar) {
 
 	for _, a := range args {
 		var argDecorators []decor.Decorator
-		switch a.barType {
-		case unitsArg:
+		switch {
+		case a.noTotal && a.barType == unitsArg:
+			argDecorators = []decor.Decorator{
+				decor.Name(a.barText, decor.WC{W: len(a.barText) + 1, C: decor.DidentRight}),
+				decor.Any(func(s *decor.Statistics) string { return strconv.FormatInt(s.Current, 10) }, decor.WCSyncWidth),
+			}
+		case a.noTotal && a.barType == sizeArg:
+			argDecorators = []decor.Decorator{
+				decor.Name(a.barText, decor.WC{W: len(a.barText) + 1, C: decor.DidentRight}),
+				decor.Any(func(s *decor.Statistics) string { return cos.ToSizeIEC(s.Current, 2) }, decor.WCSyncWidth),
+			}
+		case a.barType == unitsArg:
 			argDecorators = []decor.Decorator{
 				decor.Name(a.barText, decor.WC{W: len(a.barText) + 1, C: decor.DidentRight}),
 				decor.CountersNoUnit("%d/%d", decor.WCSyncWidth),
 			}
-		case sizeArg:
+		case a.barType == sizeArg:
 			argDecorators = []decor.Decorator{
 				decor.Name(a.barText, decor.WC{W: len(a.barText) + 1, C: decor.DidentRight}),
 				decor.CountersKibiByte("% .2f / % .2f", decor.WCSyncWidth),
@@ -54,11 +66,10 @@ func simpleBar(args ...barArgs) (progress *mpb.Progress, bars []*mpb.Bar) {
 		}
 		options := make([]mpb.BarOption, 0, len(a.options)+2)
 		options = append(options, a.options...)
-		options = append(
-			options,
-			mpb.PrependDecorators(argDecorators...),
-			mpb.AppendDecorators(decor.Percentage(decor.WCSyncWidth)),
-		)
+		options = append(options, mpb.PrependDecorators(argDecorators...))
+		if !a.noTotal {
+			options = append(options, mpb.AppendDecorators(decor.Percentage(decor.WCSyncWidth)))
+		}
 		bars = append(bars, progress.AddBar(a.total, options...))
 	}
 	return
@@ -20,6 +20,10 @@ var (
 		commandECEncode: {
 			dataSlicesFlag,
 			paritySlicesFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+			progressFlag,
+			refreshFlag,
 		},
 	}
 
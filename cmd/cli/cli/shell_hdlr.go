@@ -0,0 +1,395 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file contains implementation of the interactive shell (`ais shell`).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/urfave/cli"
+	"golang.org/x/term"
+)
+
+// Interactive shell (REPL):
+// - runs CLI commands in-process and, therefore, reuses (keep-alive) connections to the cluster;
+// - <TAB> completion via the same providers that serve bash completion (`--generate-bash-completion`);
+// - command history (up/down arrows) for the duration of the session;
+// - `use BUCKET[/PREFIX]` to set the current bucket that can then be referenced as ':' or ':OBJECT_NAME'.
+// When stdin is not a terminal (e.g. `ais shell < script`), executes commands line by line.
+
+const (
+	shellUse  = "use"
+	shellExit = "exit"
+	shellQuit = "quit"
+
+	shellBckRef = ':' // `use ais://abc` followed by `get :obj1 /tmp/obj1`
+)
+
+const shellUsage = "interactive shell: run CLI commands with <TAB> completion and command history, e.g.:\n" +
+	indent1 + "\t- 'use ais://abc'\t- set current bucket, to reference it as ':' or ':OBJECT_NAME' - e.g., 'ls :' or 'get :obj1 /tmp/obj1';\n" +
+	indent1 + "\t- 'use'\t- show current bucket; 'use -' to unset;\n" +
+	indent1 + "\t- 'exit' or 'quit' (or Ctrl-D)\t- exit the shell.\n" +
+	indent1 + "Ctrl-C stops commands that run with '--refresh'; press Ctrl-C again to exit the shell"
+
+type shell struct {
+	a           *acli
+	t           *term.Terminal
+	uri         string // current bucket (and, optionally, prefix) - see `shellUse`
+	running     atomic.Bool
+	interrupted atomic.Bool
+}
+
+var (
+	ishell atomic.Pointer[shell]
+
+	errShellExit = errors.New("exit")
+)
+
+func (a *acli) getShellCmd() cli.Command {
+	return cli.Command{
+		Name:   commandShell,
+		Usage:  shellUsage,
+		Action: a.shellHandler,
+	}
+}
+
+func inShell() bool { return ishell.Load() != nil }
+
+// Interrupted is called upon SIGINT (Ctrl-C); returns false unless the interactive shell
+// handles it - by stopping the command that is currently running (see `longRun`)
+func Interrupted() bool {
+	sh := ishell.Load()
+	if sh == nil || !sh.running.Load() {
+		return false
+	}
+	if sh.interrupted.Swap(true) {
+		return false // 2nd Ctrl-C
+	}
+	fmt.Fprintln(sh.a.errWriter, "\n(interrupted - press Ctrl-C again to exit the shell)")
+	return true
+}
+
+func shellInterrupted() bool {
+	sh := ishell.Load()
+	return sh != nil && sh.interrupted.Load()
+}
+
+func (a *acli) shellHandler(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return incorrectUsageMsg(c, "unexpected argument %q", c.Args().First())
+	}
+	sh := &shell{a: a}
+	if !ishell.CompareAndSwap(nil, sh) {
+		return errors.New("already running interactive shell")
+	}
+	defer ishell.Store(nil)
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return sh.script(os.Stdin)
+	}
+	return sh.repl(fd)
+}
+
+// non-interactive: execute commands line by line
+func (sh *shell) script(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if err := sh.exec(scanner.Text()); err != nil {
+			if err == errShellExit {
+				return nil
+			}
+			fmt.Fprintln(sh.a.errWriter, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func (sh *shell) repl(fd int) error {
+	sh.t = term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, "")
+	sh.t.AutoCompleteCallback = sh.complete
+
+	for {
+		if w, h, err := term.GetSize(fd); err == nil && w > 0 && h > 0 {
+			sh.t.SetSize(w, h)
+		}
+		sh.t.SetPrompt(sh.prompt())
+
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		line, err := sh.t.ReadLine()
+		term.Restore(fd, state)
+
+		if err != nil {
+			if err == io.EOF { // Ctrl-D or Ctrl-C
+				fmt.Fprintln(sh.a.outWriter)
+				return nil
+			}
+			return err
+		}
+		if err := sh.exec(line); err != nil {
+			if err == errShellExit {
+				return nil
+			}
+			fmt.Fprintln(sh.a.errWriter, err)
+		}
+	}
+}
+
+func (sh *shell) prompt() string {
+	if sh.uri == "" {
+		return cliName + "> "
+	}
+	return cliName + " [" + sh.uri + "]> "
+}
+
+func (sh *shell) exec(line string) error {
+	words, err := splitLine(line)
+	if err != nil {
+		return err
+	}
+	if len(words) > 0 && words[0] == cliName { // (habit)
+		words = words[1:]
+	}
+	if len(words) == 0 || strings.HasPrefix(words[0], "#") {
+		return nil
+	}
+	switch words[0] {
+	case shellExit, shellQuit:
+		return errShellExit
+	case shellUse:
+		return sh.use(words[1:])
+	case commandShell:
+		return errors.New("already running interactive shell")
+	}
+
+	sh.interrupted.Store(false)
+	sh.running.Store(true)
+	err = sh.a.run(append([]string{cliName}, sh.expand(words)...))
+	sh.running.Store(false)
+
+	// (not to confuse the next command or, upon exit, the shell itself)
+	if sh.a.longRun.outFile != nil {
+		sh.a.longRun.outFile.Close()
+	}
+	*sh.a.longRun = longRun{}
+	return err
+}
+
+// `use [BUCKET[/PREFIX] | -]`
+func (sh *shell) use(args []string) error {
+	if len(args) > 0 && args[0] == "bucket" { // (`use bucket ais://abc`)
+		args = args[1:]
+	}
+	switch {
+	case len(args) == 0:
+		if sh.uri == "" {
+			fmt.Fprintln(sh.a.outWriter, "no bucket in use")
+		} else {
+			fmt.Fprintln(sh.a.outWriter, sh.uri)
+		}
+		return nil
+	case len(args) > 1:
+		return fmt.Errorf("%s: expecting a single bucket argument, got %v", shellUse, args)
+	case args[0] == "-":
+		sh.uri = ""
+		return nil
+	}
+	uri := strings.TrimSuffix(args[0], "/")
+	bck, _, err := cmn.ParseBckObjectURI(uri, cmn.ParseURIOpts{})
+	if err != nil {
+		return err
+	}
+	if bck.Name == "" {
+		return fmt.Errorf("%s: expecting bucket name, got %q", shellUse, args[0])
+	}
+	sh.uri = uri
+	return nil
+}
+
+// ':' => current bucket; ':NAME' => current bucket + "/" + NAME
+func (sh *shell) expand(words []string) []string {
+	if sh.uri == "" {
+		return words
+	}
+	out := make([]string, len(words))
+	for i, w := range words {
+		switch {
+		case w == string(shellBckRef):
+			out[i] = sh.uri
+		case len(w) > 1 && w[0] == shellBckRef:
+			out[i] = sh.uri + "/" + w[1:]
+		default:
+			out[i] = w
+		}
+	}
+	return out
+}
+
+//
+// <TAB> completion
+//
+
+func (sh *shell) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+	head, tail := line[:pos], line[pos:]
+	words, err := splitLine(head)
+	if err != nil {
+		return "", 0, false
+	}
+	var cur string
+	if len(words) > 0 && head != "" && !unicode.IsSpace(rune(head[len(head)-1])) {
+		cur = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+	if len(words) > 0 && words[0] == cliName {
+		words = words[1:]
+	}
+
+	// same as cmd/cli/autocomplete/bash
+	args := append([]string{cliName}, sh.expand(words)...)
+	if strings.HasPrefix(cur, "-") {
+		args = append(args, cur)
+	}
+	args = append(args, "--generate-bash-completion")
+
+	if !strings.HasSuffix(head, cur) { // (quoted or escaped)
+		return line, pos, true
+	}
+	var (
+		cands []string
+		all   = sh.candidates(args)
+	)
+	if len(words) == 0 {
+		all = append(all, shellUse, shellExit, shellQuit)
+	}
+	for _, cand := range all {
+		if strings.HasPrefix(cand, cur) {
+			cands = append(cands, cand)
+		}
+	}
+	switch len(cands) {
+	case 0:
+		return line, pos, true
+	case 1:
+		cand := cands[0]
+		if !strings.HasSuffix(cand, "/") {
+			cand += " "
+		}
+		head = head[:len(head)-len(cur)] + cand
+		return head + tail, len(head), true
+	}
+	if prefix := commonPrefix(cands); len(prefix) > len(cur) {
+		head = head[:len(head)-len(cur)] + prefix
+		return head + tail, len(head), true
+	}
+	sort.Strings(cands)
+	fmt.Fprintln(sh.t, strings.Join(cands, "  "))
+	return line, pos, true
+}
+
+// run the app in bash-completion mode and capture (the candidates) it prints
+func (sh *shell) candidates(args []string) []string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil
+	}
+	devnull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		r.Close()
+		w.Close()
+		return nil
+	}
+	var (
+		app                     = sh.a.app
+		stdout, stderr          = os.Stdout, os.Stderr
+		appWriter, appErrWriter = app.Writer, app.ErrWriter
+		ch                      = make(chan []byte, 1)
+	)
+	go func() {
+		b, _ := io.ReadAll(r)
+		ch <- b
+	}()
+	os.Stdout, os.Stderr, app.Writer, app.ErrWriter = w, devnull, w, devnull
+	_ = app.Run(args)
+	os.Stdout, os.Stderr, app.Writer, app.ErrWriter = stdout, stderr, appWriter, appErrWriter
+
+	w.Close()
+	devnull.Close()
+	out := <-ch
+	r.Close()
+	return strings.Fields(string(out))
+}
+
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		i := 0
+		for i < len(prefix) && i < len(w) && prefix[i] == w[i] {
+			i++
+		}
+		prefix = prefix[:i]
+	}
+	return prefix
+}
+
+// split command line into words separated by white spaces while respecting
+// single and double quotes and backslash escapes
+func splitLine(line string) (words []string, err error) {
+	var (
+		sb             strings.Builder
+		quote          rune
+		inWord, escape bool
+	)
+	for _, r := range line {
+		switch {
+		case escape:
+			sb.WriteRune(r)
+			escape = false
+		case r == '\\' && quote != '\'':
+			escape, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				sb.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, sb.String())
+				sb.Reset()
+				inWord = false
+			}
+		default:
+			sb.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escape {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", line)
+	}
+	if inWord {
+		words = append(words, sb.String())
+	}
+	return words, nil
+}
@@ -58,6 +58,7 @@ var (
 		cmdObject: {
 			objPropsFlag, // --props [list]
 			allPropsFlag,
+			objProvenanceFlag,
 			objNotCachedPropsFlag,
 			noHeaderFlag,
 			jsonFlag,
@@ -87,6 +88,8 @@ var (
 		cmdConfig: {
 			jsonFlag,
 			noHeaderFlag,
+			inheritedFlag,
+			computedFlag,
 		},
 		cmdShowRemoteAIS: {
 			noHeaderFlag,
@@ -108,8 +111,10 @@ var (
 			showCmdRebalance,
 			showCmdConfig,
 			showCmdRemoteAIS,
+			showCmdSLO,
 			showCmdJob,
 			showCmdLog,
+			makeAlias(showCmdETL, "", true, commandETL), // alias for `ais etl show`
 		},
 	}
 
@@ -124,6 +129,7 @@ var (
 			showCmdMpath,
 			showCmdMpathCapacity,
 			showCmdStgSummary,
+			showCmdBckUsage,
 		},
 	}
 	showCmdObject = cli.Command{
@@ -736,6 +742,9 @@ func showNodeConfig(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, computedFlag) {
+		return showNodeComputed(c, node, usejs)
+	}
 	config, err := api.GetDaemonConfig(apiBP, node)
 	if err != nil {
 		return V(err)
@@ -745,9 +754,12 @@ func showNodeConfig(c *cli.Context) error {
 		ClusterConfigDiff []propDiff
 		LocalConfigPairs  nvpairList
 	}{}
+	if flagIsSet(c, inheritedFlag) {
+		scope = cfgScopeInherited
+	}
 	for _, a := range c.Args().Tail() {
 		if a == scopeAll || a == cfgScopeInherited || a == cfgScopeLocal {
-			if scope != "" {
+			if scope != "" && scope != a {
 				return incorrectUsageMsg(c, "... %s %s ...", scope, a)
 			}
 			scope = a
@@ -816,6 +828,11 @@ func showNodeConfig(c *cli.Context) error {
 		flatNode := flattenJSON(config.ClusterConfig, section)
 		flatCluster := flattenJSON(cluConf, section)
 		data.ClusterConfigDiff = diffConfigs(flatNode, flatCluster)
+		overrides, err := api.GetDaemonConfigOverride(apiBP, node)
+		if err != nil {
+			return V(err)
+		}
+		markOrigin(data.ClusterConfigDiff, overrides)
 		if scope == cfgScopeAll {
 			data.LocalConfigPairs = flattenJSON(config.LocalConfig, section)
 		}
@@ -835,6 +852,40 @@ func showNodeConfig(c *cli.Context) error {
 	return err
 }
 
+func showNodeComputed(c *cli.Context, node *meta.Snode, usejs bool) error {
+	kvs, err := api.GetDaemonConfigComputed(apiBP, node)
+	if err != nil {
+		return V(err)
+	}
+	if usejs {
+		return teb.Print(kvs, "", teb.Jopts(usejs))
+	}
+	nvs := make(nvpairList, 0, len(kvs))
+	for name, value := range kvs {
+		nvs = append(nvs, nvpair{Name: name, Value: value})
+	}
+	sort.Slice(nvs, func(i, j int) bool { return nvs[i].Name < nvs[j].Name })
+	if flagIsSet(c, noHeaderFlag) {
+		return teb.Print(nvs, teb.PropValTmplNoHdr)
+	}
+	return teb.Print(nvs, teb.PropValTmpl)
+}
+
+// given the names of the node-local overrides - that is, of the node's values
+// that _do not_ follow the cluster config
+func markOrigin(diff []propDiff, overrides []string) {
+	for i := range diff {
+		diff[i].Origin = cfgOriginCluster
+		for _, name := range overrides {
+			// (an override may be an entire section, e.g. "backend")
+			if diff[i].Name == name || strings.HasPrefix(diff[i].Name, name+cmn.IterFieldNameSepa) {
+				diff[i].Origin = cfgOriginNode
+				break
+			}
+		}
+	}
+}
+
 func showRemoteAISHandler(c *cli.Context) error {
 	const (
 		warnRemAisOffline = `remote ais cluster at %s is currently unreachable.
@@ -854,17 +905,7 @@ For details and usage examples, see: docs/cli/config.md`
 	}
 	for _, ra := range all.A {
 		uptime := teb.UnknownStatusVal
-		bp := api.BaseParams{
-			URL:   ra.URL,
-			Token: loggedUserToken,
-			UA:    ua,
-		}
-		if cos.IsHTTPS(bp.URL) {
-			// NOTE: alternatively, cmn.NewClientTLS(..., TLSArgs{SkipVerify: true})
-			bp.Client = clientTLS
-		} else {
-			bp.Client = clientH
-		}
+		bp := remBaseParams(ra.URL)
 		if clutime, _, err := api.HealthUptime(bp); err == nil {
 			ns, _ := strconv.ParseInt(clutime, 10, 64)
 			uptime = time.Duration(ns).String()
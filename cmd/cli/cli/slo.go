@@ -0,0 +1,114 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file contains implementation of `ais show slo`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/urfave/cli"
+)
+
+const sloUsage = "show SLO monitors (average latency and error rate thresholds) and their current state, for all or selected node;\n" +
+	indent1 + "(tip: to define monitors, run 'ais config cluster slo')\n" +
+	indent1 + "e.g.:\n" +
+	indent1 + "\t- 'ais show slo'\t- all nodes;\n" +
+	indent1 + "\t- 'ais show slo t[abc]'\t- a given node"
+
+var showCmdSLO = cli.Command{
+	Name:         cmdShowSLO,
+	Usage:        sloUsage,
+	ArgsUsage:    optionalNodeIDArgument,
+	Flags:        []cli.Flag{jsonFlag, noHeaderFlag},
+	Action:       showSLOHandler,
+	BashComplete: suggestAllNodes,
+}
+
+func showSLOHandler(c *cli.Context) error {
+	node, _, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	var nodes []*meta.Snode
+	if node != nil {
+		nodes = append(nodes, node)
+	} else {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return err
+		}
+		for _, nm := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+			ids := make([]string, 0, len(nm))
+			for id, si := range nm {
+				if !si.InMaintOrDecomm() {
+					ids = append(ids, id)
+				}
+			}
+			sort.Strings(ids)
+			for _, id := range ids {
+				nodes = append(nodes, nm[id])
+			}
+		}
+	}
+
+	var (
+		all = make(map[string][]stats.SLOStatus, len(nodes))
+		num int
+	)
+	for _, si := range nodes {
+		states, err := api.GetSLO(apiBP, si)
+		if err != nil {
+			return V(err)
+		}
+		all[si.ID()] = states
+		num += len(states)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+	if num == 0 {
+		fmt.Fprintln(c.App.Writer, "No SLO monitors configured (see 'ais config cluster slo --json')")
+		return nil
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NODE\tMONITOR\tMETRIC\tTHRESHOLD\tCURRENT\tSTATUS\tBREACHES\tLAST BREACH")
+	}
+	for _, si := range nodes {
+		for _, st := range all[si.ID()] {
+			var (
+				current    = teb.NotSetVal
+				status     = teb.NotSetVal // no data yet
+				lastBreach = teb.NotSetVal
+			)
+			if st.Updated != 0 {
+				current, status = st.Current, "ok"
+				if st.Breached {
+					status = "BREACHED"
+				}
+			}
+			if st.LastBreach != 0 {
+				lastBreach = cos.FormatNanoTime(st.LastBreach, "")
+			}
+			metric := st.Metric
+			if st.Stat != "" {
+				metric = st.Stat + "(" + st.Metric + ")" // e.g. "avg(get.ns)"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", si.StringEx(), st.Name, metric, st.Threshold,
+				current, status, strconv.FormatInt(st.Breaches, 10), lastBreach)
+		}
+	}
+	return tw.Flush()
+}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -118,6 +119,8 @@ var (
 	storageSummFlags = append(
 		longRunFlags,
 		bsummPrefixFlag,
+		bsummGroupByFlag,
+		bsummEstimateFlag,
 		listObjCachedFlag,
 		unitsFlag,
 		verboseFlag,
@@ -128,6 +131,9 @@ var (
 		commandStorage: append(
 			longRunFlags,
 			jsonFlag,
+			capHistoryFlag,
+			unitsFlag,
+			verboseFlag,
 		),
 		cmdShowDisk: append(
 			longRunFlags,
@@ -140,6 +146,13 @@ var (
 			longRunFlags,
 			jsonFlag,
 		),
+		cmdShowUsage: {
+			bckUsagePeriodFlag,
+			bckUsageCSVFlag,
+			jsonFlag,
+			unitsFlag,
+			noHeaderFlag,
+		},
 		cmdStgValidate: append(
 			longRunFlags,
 			waitJobXactFinishedFlag,
@@ -165,6 +178,16 @@ var (
 		Action:       summaryStorageHandler,
 		BashComplete: bucketCompletions(bcmplop{}),
 	}
+	showCmdBckUsage = cli.Command{
+		Name: cmdShowUsage,
+		Usage: "show per-bucket usage: bytes served (GET), bytes ingested (PUT), and request counts\n" +
+			indent4 + "\taggregated cluster-wide per calendar month, e.g. for chargeback or showback\n" +
+			indent4 + "\t(tip: use '--csv' or '--json' to export)",
+		ArgsUsage:    optionalBucketArgument,
+		Flags:        storageFlags[cmdShowUsage],
+		Action:       showBckUsageHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
 	showCmdMpath = cli.Command{
 		Name:         cmdMountpath,
 		Usage:        "show target mountpaths",
@@ -190,12 +213,26 @@ var (
 			},
 			mpathCmd,
 			showCmdDisk,
+			showCmdBckUsage,
 			cleanupCmd,
 		},
 	}
 )
 
 func showStorageHandler(c *cli.Context) (err error) {
+	if flagIsSet(c, capHistoryFlag) {
+		tsi, _, err := arg0Node(c)
+		if err != nil {
+			return err
+		}
+		if tsi == nil {
+			return showCapHistory(c, "") // all targets
+		}
+		if tsi.IsProxy() {
+			return fmt.Errorf("%s is a 'proxy' aka gateway (capacity history is maintained by targets)", tsi.StringEx())
+		}
+		return showCapHistory(c, tsi.ID())
+	}
 	return showDiskStats(c, "") // all targets, all disks
 }
 
@@ -356,6 +393,9 @@ func summaryStorageHandler(c *cli.Context) error {
 	setLongRunParams(c)
 
 	var news = true
+	if err := ctx.msg.Validate(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
 	if xid := c.Args().Get(1); xid != "" && cos.IsValidUUID(xid) {
 		ctx.msg.UUID = xid
 		news = false
@@ -399,9 +439,82 @@ func summaryStorageHandler(c *cli.Context) error {
 	opts := teb.Opts{AltMap: altMap}
 	hideHeader := flagIsSet(c, noHeaderFlag)
 	if hideHeader {
-		return teb.Print(summaries, teb.BucketsSummariesBody, opts)
+		err = teb.Print(summaries, teb.BucketsSummariesBody, opts)
+	} else {
+		err = teb.Print(summaries, teb.BucketsSummariesTmpl, opts)
+	}
+	if err != nil {
+		return err
+	}
+	if ctx.msg.SampleSize > 0 {
+		showBsummEstimates(c, summaries)
+	}
+	if ctx.msg.GroupBy == "" {
+		return nil
+	}
+	for _, summ := range summaries {
+		showBsummGroups(c, summ, ctx.msg.GroupBy, units, hideHeader)
+	}
+	return nil
+}
+
+// per-bucket breakdown (`--group-by`), largest groups first
+func showBsummGroups(c *cli.Context, summ *cmn.BsummResult, groupBy, units string, hideHeader bool) {
+	if len(summ.Groups) == 0 {
+		return
+	}
+	groups := make([]string, 0, len(summ.Groups))
+	for g := range summ.Groups {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		gi, gj := summ.Groups[groups[i]], summ.Groups[groups[j]]
+		if gi.Size != gj.Size {
+			return gi.Size > gj.Size
+		}
+		return groups[i] < groups[j]
+	})
+
+	fmt.Fprintln(c.App.Writer)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !hideHeader {
+		fmt.Fprintf(tw, "%s\t%s\tOBJECTS\tSIZE\t%%\n", "BUCKET", strings.ToUpper(groupBy))
+	}
+	total := summ.TotalSize.PresentObjs
+	for _, g := range groups {
+		v := summ.Groups[g]
+		name := g
+		if name == "" {
+			name = "(top level)"
+		}
+		var pct uint64
+		if total > 0 {
+			pct = cos.DivRoundU64(v.Size*100, total)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d%%\n", summ.Bck.Cname(""), name, cos.FormatBigNum(int(v.Count)),
+			teb.FmtSize(int64(v.Size), units, 2), pct)
+	}
+	tw.Flush()
+	if summ.GroupsTrunc {
+		warn := fmt.Sprintf("%s: too many distinct groups (over %d per target) - the breakdown is incomplete",
+			summ.Bck.Cname(""), apc.MaxBsummGroups)
+		actionWarn(c, warn)
+	}
+}
+
+// label estimated (sampled) results as such
+func showBsummEstimates(c *cli.Context, summaries cmn.AllBsummResults) {
+	for _, summ := range summaries {
+		e := summ.Estimate
+		if e == nil || e.Exact {
+			continue
+		}
+		note := fmt.Sprintf("%s: estimated from %s sampled objects - approx. %s objects (95%% confidence: %s to %s)",
+			summ.Bck.Cname(""), cos.FormatBigNum(int(e.Sampled)), cos.FormatBigNum(int(summ.ObjCount.Present)),
+			cos.FormatBigNum(int(e.CountLow)), cos.FormatBigNum(int(e.CountHigh)))
+		actionNote(c, note)
 	}
-	return teb.Print(summaries, teb.BucketsSummariesTmpl, opts)
 }
 
 func newBsummContext(c *cli.Context, units string, qbck cmn.QueryBcks, bckPresent, dontWait bool) *bsummCtx {
@@ -415,6 +528,10 @@ func newBsummContext(c *cli.Context, units string, qbck cmn.QueryBcks, bckPresen
 	ctx.msg.Prefix = parseStrFlag(c, bsummPrefixFlag)
 	ctx.msg.ObjCached = flagIsSet(c, listObjCachedFlag)
 	ctx.msg.BckPresent = bckPresent
+	if flagIsSet(c, bsummGroupByFlag) {
+		ctx.msg.GroupBy = parseStrFlag(c, bsummGroupByFlag)
+	}
+	ctx.msg.SampleSize = parseIntFlag(c, bsummEstimateFlag)
 
 	ctx.args.DontWait = dontWait
 
@@ -444,10 +561,29 @@ func (ctx *bsummCtx) slow() (xid string, res cmn.AllBsummResults, err error) {
 }
 
 func (ctx *bsummCtx) get() (err error) {
+	if ctx.args.Callback != nil && !ctx.dontWait {
+		return ctx.stream()
+	}
 	ctx.xid, ctx.res, err = api.GetBucketSummary(apiBP, ctx.qbck, &ctx.msg, ctx.args)
 	return
 }
 
+// incremental progress: show (partial) results as they arrive, target by target
+func (ctx *bsummCtx) stream() (err error) {
+	after := ctx.started + ctx.args.CallAfter.Nanoseconds()
+	cb := func(tres *cmn.BsummStreamResult) error {
+		if mono.NanoTime() >= after {
+			ctx.progress(&tres.Total, false)
+		}
+		return nil
+	}
+	ctx.xid, ctx.res, err = api.GetBucketSummaryStream(apiBP, ctx.qbck, &ctx.msg, cb)
+	if err == nil {
+		ctx.progress(&ctx.res, true)
+	}
+	return
+}
+
 // re-print line per bucket
 func (ctx *bsummCtx) progress(summaries *cmn.AllBsummResults, done bool) {
 	if done {
@@ -38,6 +38,8 @@ func copyBucketHandler(c *cli.Context) (err error) {
 	switch {
 	case c.NArg() == 0:
 		err = missingArgumentsError(c, c.Command.ArgsUsage)
+	case isView(c.Args().Get(0)):
+		bckFrom, bckTo, err = copyFromView(c)
 	case c.NArg() == 1:
 		bckFrom, objFrom, err = parseBckObjURI(c, c.Args().Get(0), true /*emptyObjnameOK*/)
 	default:
@@ -68,6 +70,21 @@ func copyBucketHandler(c *cli.Context) (err error) {
 	return copyTransform(c, "" /*etlName*/, objFrom, bckFrom, bckTo, flagIsSet(c, copyAllObjsFlag))
 }
 
+// 'ais cp @VIEW [DST_BUCKET]'
+func copyFromView(c *cli.Context) (bckFrom, bckTo cmn.Bck, err error) {
+	var uri string
+	if uri, err = expandView(c, c.Args().Get(0)); err != nil {
+		return
+	}
+	if bckFrom, err = parseBckURI(c, uri, true /*errorOnly*/); err != nil {
+		return
+	}
+	if c.NArg() > 1 {
+		bckTo, err = parseBckURI(c, c.Args().Get(1), true /*errorOnly*/)
+	}
+	return
+}
+
 //
 // main function: (cp | etl) & (bucket | multi-object)
 //
@@ -111,10 +128,13 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 		actionWarn(c, warn)
 	}
 
-	dryRun := flagIsSet(c, copyDryRunFlag)
+	var (
+		dryRun    = flagIsSet(c, copyDryRunFlag)
+		fromStdin = flagIsSet(c, fromStdinFlag)
+	)
 
 	// either 1. copy/transform bucket (x-tcb)
-	if objName == "" && listObjs == "" && tmplObjs == "" {
+	if objName == "" && listObjs == "" && tmplObjs == "" && !fromStdin {
 		// NOTE: e.g. 'ais cp gs://abc gs:/abc' to sync remote bucket => aistore
 		if bckFrom.Equal(&bckTo) && !bckFrom.IsRemote() {
 			return incorrectUsageMsg(c, errFmtSameBucket, commandCopy, bckTo)
@@ -136,29 +156,48 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 	}
 	if dryRun {
 		var prompt string
-		if listObjs != "" {
+		switch {
+		case fromStdin:
+			prompt = fmt.Sprintf("%s objects named in standard input ...\n", text2)
+		case listObjs != "":
 			prompt = fmt.Sprintf("%s %q ...\n", text2, listObjs)
-		} else {
+		default:
 			prompt = fmt.Sprintf("%s objects that match the pattern %q ...\n", text2, tmplObjs)
 		}
 		dryRunCptn(c) // TODO: ditto
 		actionDone(c, prompt)
 	}
-	return runTCO(c, bckFrom, bckTo, listObjs, tmplObjs, etlName)
+	if fromStdin {
+		return stdinBatches(c, func(objNames []string) error {
+			return runTCO(c, bckFrom, bckTo, objNames, "", etlName)
+		})
+	}
+	var objNames []string
+	if listObjs != "" {
+		objNames = splitCsv(listObjs)
+	}
+	return runTCO(c, bckFrom, bckTo, objNames, tmplObjs, etlName)
 }
 
 func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
 	{
 		msg.Prepend = parseStrFlag(c, copyPrependFlag)
+		msg.Rename = parseStrFlag(c, copyRenameFlag)
 		msg.Prefix = parseStrFlag(c, verbObjPrefixFlag)
 		msg.DryRun = flagIsSet(c, copyDryRunFlag)
 		msg.Force = flagIsSet(c, forceFlag)
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
 	}
-	if msg.Sync && msg.Prepend != "" {
+	switch {
+	case msg.Sync && msg.Prepend != "":
 		err = fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
-			msg.Prepend, qflprn(progressFlag))
+			msg.Prepend, qflprn(syncFlag))
+	case msg.Sync && msg.Rename != "":
+		err = fmt.Errorf("rename option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
+			msg.Rename, qflprn(syncFlag))
+	default:
+		err = msg.Validate()
 	}
 	return err
 }
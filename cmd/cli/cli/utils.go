@@ -73,6 +73,7 @@ type (
 		Name    string
 		Current string
 		Old     string
+		Origin  string // (inherited) cluster config vs node-local override
 	}
 )
 
@@ -323,7 +324,8 @@ func parseFeatureFlags(v string) (res feat.Flags, err error) {
 	return
 }
 
-// TODO: support `allow` and `deny` verbs/operations on existing access permissions
+// NOTE: `access` sets the entire permission mask; to allow or deny permissions relative
+// to the current value, see `ais bucket access allow|deny` (apc.ActUpdateAccess)
 func makeBckPropPairs(values []string) (nvs cos.StrKVs, err error) {
 	props := make([]string, 0, 20)
 	err = cmn.IterFields(&cmn.BpropsToSet{}, func(tag string, _ cmn.IterField) (error, bool) {
@@ -585,10 +587,12 @@ func isUnsetTime(c *cli.Context, ts string) bool {
 	return strings.HasPrefix(tss, "1969") || strings.HasPrefix(tss, "1970")
 }
 
+// (shared, so that consecutive prompts do not lose buffered input)
+var stdinReader = bufio.NewReader(os.Stdin)
+
 func readValue(c *cli.Context, prompt string) string {
 	fmt.Fprintf(c.App.Writer, prompt+": ")
-	reader := bufio.NewReader(os.Stdin)
-	line, err := reader.ReadString('\n')
+	line, err := stdinReader.ReadString('\n')
 	if err != nil {
 		return ""
 	}
@@ -596,6 +600,9 @@ func readValue(c *cli.Context, prompt string) string {
 }
 
 func confirm(c *cli.Context, prompt string, warning ...string) (ok bool) {
+	if assumeYes(c) {
+		return true
+	}
 	var err error
 	prompt += " [Y/N]"
 	if len(warning) != 0 {
@@ -864,14 +871,28 @@ func selectProvidersExclRais(bcks cmn.Bcks) (sorted []string) {
 
 // see related: `verboseWarnings()`
 
-func actionDone(c *cli.Context, msg string) { fmt.Fprintln(c.App.Writer, msg) }
+// NOTE: informational output (done, note, caption) is suppressed with global '--quiet'; warnings are not
+
+func actionDone(c *cli.Context, msg string) {
+	if !isQuiet(c) {
+		fmt.Fprintln(c.App.Writer, msg)
+	}
+}
+
 func actionWarn(c *cli.Context, msg string) { fmt.Fprintln(c.App.ErrWriter, fcyan("Warning: ")+msg) }
-func actionNote(c *cli.Context, msg string) { fmt.Fprintln(c.App.ErrWriter, fblue("Note: ")+msg) }
+
+func actionNote(c *cli.Context, msg string) {
+	if !isQuiet(c) {
+		fmt.Fprintln(c.App.ErrWriter, fblue("Note: ")+msg)
+	}
+}
 
 func actionCptn(c *cli.Context, prefix, msg string) {
-	if prefix == "" {
+	switch {
+	case isQuiet(c):
+	case prefix == "":
 		fmt.Fprintln(c.App.Writer, fcyan(msg))
-	} else {
+	default:
 		fmt.Fprintln(c.App.Writer, fcyan(prefix)+msg)
 	}
 }
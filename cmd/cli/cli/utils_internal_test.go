@@ -5,10 +5,18 @@
 package cli
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/tools/tassert"
@@ -465,3 +473,215 @@ func TestParseBckObjectURI(t *testing.T) {
 		tassert.Errorf(t, err != nil, "expected error on %s (bck: %q, obj_name: %q)", test.uri, bck, objName)
 	}
 }
+
+func TestModelVersions(t *testing.T) {
+	versions := []string{"v10", "beta", "v2", "v1", "alpha", "v9"}
+	sort.Slice(versions, func(i, j int) bool { return lessModelVersion(versions[i], versions[j]) })
+	expected := []string{"v1", "v2", "v9", "v10", "alpha", "beta"}
+	tassert.Fatalf(t, reflect.DeepEqual(versions, expected), "expected %v, got %v", expected, versions)
+
+	tassert.Errorf(t, nextModelVersion(nil) == "v1", "expected v1, got %s", nextModelVersion(nil))
+	entry := &modelEntry{versions: versions}
+	tassert.Errorf(t, nextModelVersion(entry) == "v11", "expected v11, got %s", nextModelVersion(entry))
+
+	for _, s := range []string{"", ".hidden", "a/b", "v1@latest"} {
+		tassert.Errorf(t, validateModelName(s, "version") != nil, "expected %q to be invalid", s)
+	}
+}
+
+func TestLocMpath(t *testing.T) {
+	tests := map[string]string{
+		"t[ikGtVvsu]:mp[/tmp/ais/mp1/1, fs=tmpfs]": "/tmp/ais/mp1/1",
+		"t[ikGtVvsu]:mp[/ais/nvme0n1, nvme0n1]":    "/ais/nvme0n1",
+		"t[ikGtVvsu]:mp[/ais/mp, [sda sdb]]":       "/ais/mp",
+		"unexpected":                               "unexpected",
+	}
+	for loc, expected := range tests {
+		tassert.Errorf(t, locMpath(loc) == expected, "%q: expected %q, got %q", loc, expected, locMpath(loc))
+	}
+}
+
+func TestBundleRedact(t *testing.T) {
+	in := map[string]any{
+		"auth":    map[string]any{"enabled": true, "secret": "s3cr3t"},
+		"backend": map[string]any{"aws": map[string]any{"credentials": map[string]any{"id": "x"}, "region": "us-east-1"}},
+		"list":    []any{map[string]any{"Access_Key": "AKIA"}, "token"},
+		"empty":   map[string]any{"token": ""},
+	}
+	out, err := bundleRedact(in)
+	tassert.CheckFatal(t, err)
+	s := string(out)
+	for _, secret := range []string{"s3cr3t", "AKIA", `"id"`} {
+		tassert.Errorf(t, !strings.Contains(s, secret), "expected %q to be redacted:\n%s", secret, s)
+	}
+	for _, keep := range []string{"us-east-1", `"enabled": true`, `"token"`, `"token": ""`} {
+		tassert.Errorf(t, strings.Contains(s, keep), "expected %q to be kept:\n%s", keep, s)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{nil, 0},
+		{errors.New("something went wrong"), ExitErr},
+		{&errUsage{message: "missing argument"}, ExitUsage},
+		{&errDoesNotExist{what: "bucket", name: "ais://nnn"}, ExitNotFound},
+		{&cmn.ErrHTTP{Status: http.StatusNotFound, Message: "not found"}, ExitNotFound},
+		{&cmn.ErrHTTP{Status: http.StatusForbidden, Message: "denied"}, ExitPermission},
+		{&cmn.ErrHTTP{Status: http.StatusUnauthorized, Message: "token expired"}, ExitPermission},
+		{&cmn.ErrHTTP{Status: http.StatusInternalServerError, Message: "oops"}, ExitErr},
+		{timeoutErrorf("timed out waiting for %s", "x"), ExitTimeout},
+		{fmt.Errorf("api.wait: %w (1m) waiting for x", api.ErrWaitTimeout), ExitTimeout},
+		{newErrPartial(errors.New("failed to put 1 file")), ExitPartial},
+		{newAdditionalInfoError(&errDoesNotExist{what: "object", name: "o"}, "try again"), ExitNotFound},
+		{errors.New("Get \"http://localhost:8080\": dial tcp 127.0.0.1:8080: connect: connection refused"), ExitUnreachable},
+	}
+	for i, test := range tests {
+		var err error
+		if test.err != nil {
+			err = &errExit{err: test.err, code: exitCode(test.err)}
+		}
+		if code := ExitCode(err); code != test.code {
+			t.Errorf("%d: %v: expected exit code %d, got %d", i, test.err, test.code, code)
+		}
+	}
+}
+
+func TestGlobalYesQuiet(t *testing.T) {
+	var yes, progress, nonverbose, confirmed bool
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{globalYesFlag, quietFlag}
+	app.Commands = []cli.Command{{
+		Name:  "rm",
+		Flags: []cli.Flag{yesFlag, progressFlag, nonverboseFlag},
+		Action: func(c *cli.Context) error {
+			yes, progress, nonverbose = flagIsSet(c, yesFlag), flagIsSet(c, progressFlag), flagIsSet(c, nonverboseFlag)
+			confirmed = assumeYes(c) && confirm(c, "remove?") // (must not prompt)
+			return nil
+		},
+	}}
+	tassert.CheckFatal(t, app.Run([]string{"ais", "--yes", "--quiet", "rm", "--progress"}))
+	tassert.Errorf(t, yes && confirmed, "expected global --yes to be honored")
+	tassert.Errorf(t, !progress && nonverbose, "expected global --quiet to be honored")
+
+	tassert.CheckFatal(t, app.Run([]string{"ais", "rm", "--yes", "--progress"}))
+	tassert.Errorf(t, yes && progress && !nonverbose, "expected command-level flags only")
+}
+
+func TestShellSplitLine(t *testing.T) {
+	tests := []struct {
+		line  string
+		words []string
+		err   bool
+	}{
+		{line: "", words: nil},
+		{line: "  ls   ais://abc ", words: []string{"ls", "ais://abc"}},
+		{line: `put "my file" :obj`, words: []string{"put", "my file", ":obj"}},
+		{line: `get :a\ b '/tmp/x "y"'`, words: []string{"get", ":a b", `/tmp/x "y"`}},
+		{line: `ls ''`, words: []string{"ls", ""}},
+		{line: `ls "abc`, err: true},
+		{line: `ls abc\`, err: true},
+	}
+	for _, test := range tests {
+		words, err := splitLine(test.line)
+		if test.err {
+			tassert.Errorf(t, err != nil, "%q: expected error", test.line)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, reflect.DeepEqual(words, test.words), "%q: expected %q, got %q", test.line, test.words, words)
+	}
+
+	sh := &shell{uri: "ais://abc/dir"}
+	words := sh.expand([]string{"ls", ":", "get", ":obj", "/tmp/:x"})
+	tassert.Errorf(t, reflect.DeepEqual(words, []string{"ls", "ais://abc/dir", "get", "ais://abc/dir/obj", "/tmp/:x"}),
+		"unexpected expansion: %q", words)
+}
+
+func TestLsFormat(t *testing.T) {
+	for _, s := range []string{"", "yaml", "template", "template="} {
+		_, err := teb.ParseFormat(s)
+		tassert.Errorf(t, err != nil, "%q: expected error", s)
+	}
+
+	var (
+		buf     bytes.Buffer
+		entries = cmn.LsoEntries{{Name: "a/b", Size: 1024}, {Name: "c,d", Size: 7}}
+		tmpl    = teb.LsoTemplate([]string{apc.GetPropsName, apc.GetPropsSize}, false, false, false)
+		w       = teb.Writer
+	)
+	teb.Writer = &buf
+	defer func() { teb.Writer = w }()
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{format: "csv", expected: "NAME,SIZE\na/b,1024\n\"c,d\",7\n"},
+		{format: `template={{.Name}}\t{{.Size}}`, expected: "a/b\t1024\nc,d\t7\n"},
+		{format: "json", expected: `"name": "c,d"`},
+	}
+	for _, test := range tests {
+		opts, err := teb.ParseFormat(test.format)
+		tassert.CheckFatal(t, err)
+		opts.AltMap = teb.FuncMapUnits(cos.UnitsRaw)
+		buf.Reset()
+		tassert.CheckFatal(t, teb.Print(entries, tmpl, opts))
+		tassert.Errorf(t, strings.Contains(buf.String(), test.expected), "%q: expected %q, got %q", test.format, test.expected, buf.String())
+	}
+}
+
+func TestReadNameBatches(t *testing.T) {
+	var (
+		batches [][]string
+		input   = "a\n  b/c  \n\n\r\nd e\nf\r\ng\n"
+		cb      = func(objNames []string) error {
+			batches = append(batches, objNames)
+			return nil
+		}
+	)
+	n, err := readNameBatches(strings.NewReader(input), 2, cb)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == 5, "expected 5 names, got %d", n)
+	expected := [][]string{{"a", "b/c"}, {"d e", "f"}, {"g"}}
+	tassert.Errorf(t, reflect.DeepEqual(batches, expected), "expected %q, got %q", expected, batches)
+
+	batches = nil
+	n, err = readNameBatches(strings.NewReader("\n \n"), 2, cb)
+	tassert.Errorf(t, err == nil && n == 0 && len(batches) == 0, "expected no names (%d, %v, %q)", n, err, batches)
+
+	errStop := errors.New("stop")
+	n, err = readNameBatches(strings.NewReader(input), 2, func([]string) error { return errStop })
+	tassert.Errorf(t, err == errStop && n == 0, "expected %v after the first batch, got (%d, %v)", errStop, n, err)
+}
+
+func TestFobjsFilter(t *testing.T) {
+	all := fobjs{
+		{path: "/images/cat.jpg"},
+		{path: "/images/notes.txt"},
+		{path: "/images/tmp/dog.jpg"},
+		{path: "/images/train/bird.jpg"},
+		{path: "/images/train/bird.png"},
+	}
+	tests := []struct {
+		include, exclude []string
+		expected         []string
+	}{
+		{[]string{"*.jpg"}, nil, []string{"/images/cat.jpg", "/images/tmp/dog.jpg", "/images/train/bird.jpg"}},
+		{[]string{"*.jpg", "*.png"}, []string{"tmp/*"}, []string{"/images/cat.jpg", "/images/train/bird.jpg", "/images/train/bird.png"}},
+		{[]string{"train/*"}, []string{"*.png"}, []string{"/images/train/bird.jpg"}},
+		{nil, []string{"*.jpg"}, []string{"/images/notes.txt", "/images/train/bird.png"}},
+	}
+	for _, test := range tests {
+		in := append(fobjs{}, all...)
+		out, skipped := in.filter("/images", test.include, test.exclude)
+		names := make([]string, 0, len(out))
+		for _, fo := range out {
+			names = append(names, fo.path)
+		}
+		tassert.Errorf(t, reflect.DeepEqual(names, test.expected) && skipped == len(all)-len(out),
+			"include %v, exclude %v: expected %v, got %v (skipped %d)", test.include, test.exclude, test.expected, names, skipped)
+	}
+}
@@ -35,6 +35,7 @@ type (
 		workerCnt int
 		refresh   time.Duration
 		cksum     *cos.Cksum
+		customMD  cos.StrKVs
 		cptn      string
 		totalSize int64
 		dryRun    bool
@@ -84,6 +85,10 @@ func verbFobjs(c *cli.Context, wop wop, fobjs []fobj, bck cmn.Bck, ndir int, rec
 	if err != nil {
 		return err
 	}
+	customMD, err := parseObjMetaFlag(c)
+	if err != nil {
+		return err
+	}
 
 	// confirm
 	if flagIsSet(c, dryRunFlag) {
@@ -104,6 +109,7 @@ func verbFobjs(c *cli.Context, wop wop, fobjs []fobj, bck cmn.Bck, ndir int, rec
 		workerCnt: numWorkers,
 		refresh:   refresh,
 		cksum:     cksum,
+		customMD:  customMD,
 		cptn:      cptn,
 		totalSize: totalSize,
 		dryRun:    flagIsSet(c, dryRunFlag),
@@ -171,7 +177,11 @@ func (p *uparams) do(c *cli.Context) error {
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
 	if numFailed := u.errCount.Load(); numFailed > 0 {
-		return fmt.Errorf("failed to %s %d file%s", p.wop.verb(), numFailed, cos.Plural(int(numFailed)))
+		err := fmt.Errorf("failed to %s %d file%s", p.wop.verb(), numFailed, cos.Plural(int(numFailed)))
+		if int(numFailed) < len(p.fobjs) {
+			err = newErrPartial(err)
+		}
+		return err
 	}
 	if !flagIsSet(c, dryRunFlag) {
 		if !flagIsSet(c, yesFlag) {
@@ -196,6 +206,7 @@ func (p *uparams) _putOne(c *cli.Context, fobj fobj, reader cos.ReadOpenCloser,
 		Cksum:      p.cksum,
 		Size:       uint64(fobj.size),
 		SkipVC:     skipVC,
+		CustomMD:   p.customMD,
 	}
 	_, err = api.PutObject(&putArgs)
 	return
@@ -359,6 +370,10 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 	if err != nil {
 		return err
 	}
+	customMD, err := parseObjMetaFlag(c)
+	if err != nil {
+		return err
+	}
 	fh, err := cos.NewFileHandle(path)
 	if err != nil {
 		return err
@@ -379,6 +394,10 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 		Reader:     reader,
 		Cksum:      cksum,
 		SkipVC:     flagIsSet(c, skipVerCksumFlag),
+		CustomMD:   customMD,
+	}
+	if flagIsSet(c, deltaFlag) {
+		return putDelta(c, &putArgs, progress)
 	}
 	_, err = api.PutObject(&putArgs)
 	if progress != nil {
@@ -387,6 +406,19 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 	return err
 }
 
+func putDelta(c *cli.Context, putArgs *api.PutArgs, progress *mpb.Progress) error {
+	_, stats, err := api.PutObjectDelta(&api.PutDeltaArgs{PutArgs: *putArgs})
+	if progress != nil {
+		progress.Wait()
+	}
+	if err != nil || stats == nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "delta: transferred %s, reused %s\n",
+		cos.ToSizeIEC(stats.Literal, 2), cos.ToSizeIEC(stats.Copied, 2))
+	return nil
+}
+
 // PUT and then APPEND fixed-sized chunks using `api.PutObject`, `api.AppendObject` and `api.FlushObject`
 // - currently, is only used to PUT from standard input when we do expect to overwrite existing destination object
 // - APPEND and flush will only be executed with there's a second chunk
@@ -518,6 +550,25 @@ func cksumToCompute(c *cli.Context, bck cmn.Bck) (*cos.Cksum, error) {
 	return cksums[0], nil
 }
 
+// '--meta k1=v1,k2=v2'
+func parseObjMetaFlag(c *cli.Context) (cos.StrKVs, error) {
+	if !flagIsSet(c, putObjMetaFlag) {
+		return nil, nil
+	}
+	var (
+		s  = parseStrFlag(c, putObjMetaFlag)
+		md = make(cos.StrKVs, 4)
+	)
+	for _, kv := range splitCsv(s) {
+		k, v, ok := strings.Cut(kv, keyAndValueSeparator)
+		if k = strings.TrimSpace(k); !ok || k == "" {
+			return nil, fmt.Errorf("invalid %s %q: expecting comma-separated key=value pairs", qflprn(putObjMetaFlag), s)
+		}
+		md[strings.ToLower(k)] = v
+	}
+	return md, nil
+}
+
 // in addition to computeCksumFlag
 // an alternative way to specify expected PUT checksum
 func altCksumToComp(c *cli.Context) []*cos.Cksum {
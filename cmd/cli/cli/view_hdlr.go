@@ -0,0 +1,233 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais view` commands: named object ranges.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+// View is a named (bucket + prefix, template, or list of objects) selection stored in the CLI config.
+// Referenced as '@NAME', a view replaces BUCKET[/OBJECT_NAME_or_TEMPLATE] argument and the respective
+// '--list' or '--template' option - wherever a list or range of objects is accepted:
+// prefetch, evict, copy (source), and dsort (input).
+
+const viewPrefix = "@"
+
+const invalidView = "view name must start with a letter and can only contain letters, numbers, hyphens (-), and underscores (_)"
+
+var (
+	viewCmd = cli.Command{
+		Name:  commandView,
+		Usage: "create, show, and remove named object ranges (views) to use as '@NAME' in place of BUCKET/TEMPLATE",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdViewCreate,
+				Usage: "save a bucket along with prefix, template, or list of objects under a given name, e.g.:\n" +
+					indent1 + "\t- 'ais view create train-set s3://abc --template \"shard-{0000..0999}.tar\"' - create view;\n" +
+					indent1 + "\t- 'ais prefetch @train-set' - prefetch all objects in the view;\n" +
+					indent1 + "\t- 'ais cp @train-set ais://dst' - copy them to another bucket;\n" +
+					indent1 + "\t- 'ais dsort spec.json @train-set ais://out' - use them as dsort input",
+				ArgsUsage: viewCreateArgument,
+				Flags: []cli.Flag{
+					listFlag,
+					templateFlag,
+					verbObjPrefixFlag,
+				},
+				Action:       createViewHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
+			{
+				Name:         cmdViewShow,
+				Usage:        "show all (or selected) views",
+				ArgsUsage:    viewNameArgument,
+				Action:       showViewsHandler,
+				BashComplete: viewCompletions,
+			},
+			{
+				Name:         cmdViewRm,
+				Usage:        "remove view",
+				ArgsUsage:    viewNameArgument,
+				Action:       rmViewHandler,
+				BashComplete: viewCompletions,
+			},
+		},
+	}
+)
+
+func isView(arg string) bool { return len(arg) > len(viewPrefix) && strings.HasPrefix(arg, viewPrefix) }
+
+func getView(arg string) (*config.View, error) {
+	name := strings.TrimPrefix(arg, viewPrefix)
+	v, ok := cfg.Views[name]
+	if !ok {
+		return nil, &errDoesNotExist{what: "view", name: name, suffix: " (see 'ais view show')"}
+	}
+	return v, nil
+}
+
+// resolve '@NAME' command-line argument: set the respective '--list' or '--template'
+// and return the view's bucket
+func expandView(c *cli.Context, arg string) (string, error) {
+	v, err := getView(arg)
+	if err != nil {
+		return "", err
+	}
+	if flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) || flagIsSet(c, verbObjPrefixFlag) {
+		return "", incorrectUsageMsg(c, "view %s cannot be used together with %s, %s, or %s",
+			arg, qflprn(listFlag), qflprn(templateFlag), qflprn(verbObjPrefixFlag))
+	}
+	if len(v.ObjNames) > 0 {
+		err = c.Set(listFlag.Name, strings.Join(v.ObjNames, ","))
+	} else {
+		err = c.Set(templateFlag.Name, v.Template)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot use view %s with '%s': %v", arg, c.Command.Name, err)
+	}
+	return v.Bucket, nil
+}
+
+// same as preparseBckObjURI but also resolves views
+// (a view selects objects via '--list' or '--template' and, therefore, cannot be combined with other arguments)
+func preparseViewOrURI(c *cli.Context, shift int) (string, error) {
+	arg := c.Args().Get(shift)
+	if !isView(arg) {
+		return preparseBckObjURI(arg), nil
+	}
+	if c.NArg() > 1 {
+		return "", incorrectUsageMsg(c, "view %s must be the only %s argument", arg, c.Command.Name)
+	}
+	return expandView(c, arg)
+}
+
+//
+// handlers
+//
+
+func createViewHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	name := c.Args().Get(0)
+	if !validateAlias(name) {
+		return errors.New(invalidView)
+	}
+	bck, objNameOrTmpl, err := parseBckObjURI(c, c.Args().Get(1), true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	objName, listObjs, tmplObjs, err := parseObjListTemplate(c, objNameOrTmpl)
+	if err != nil {
+		return err
+	}
+	if objName != "" {
+		tmplObjs = objName // (a "pure" prefix)
+	}
+	v := &config.View{Bucket: bck.Cname("")}
+	if listObjs != "" {
+		v.ObjNames = splitCsv(listObjs)
+	} else {
+		if _, err := cos.NewParsedTemplate(tmplObjs); err != nil && err != cos.ErrEmptyTemplate {
+			return fmt.Errorf("invalid template %q: %v", tmplObjs, err)
+		}
+		v.Template = tmplObjs
+	}
+
+	if cfg.Views == nil {
+		cfg.Views = make(config.ViewConfig, 4)
+	}
+	_, exists := cfg.Views[name]
+	cfg.Views[name] = v
+	if err := config.Save(cfg); err != nil {
+		return err
+	}
+	if exists {
+		actionDone(c, fmt.Sprintf("Updated view %q: %s", name, _viewStr(v)))
+	} else {
+		actionDone(c, fmt.Sprintf("Created view %q: %s (use it as '%s%s')", name, _viewStr(v), viewPrefix, name))
+	}
+	return nil
+}
+
+func showViewsHandler(c *cli.Context) error {
+	names := make([]string, 0, len(cfg.Views))
+	if c.NArg() > 0 {
+		name := strings.TrimPrefix(c.Args().Get(0), viewPrefix)
+		if _, err := getView(name); err != nil {
+			return err
+		}
+		names = append(names, name)
+	} else {
+		for name := range cfg.Views {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	if len(names) == 0 {
+		fmt.Fprintln(c.App.Writer, "No views (tip: see 'ais view create --help')")
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "VIEW\tBUCKET\tOBJECTS")
+	for _, name := range names {
+		v := cfg.Views[name]
+		fmt.Fprintf(tw, "%s%s\t%s\t%s\n", viewPrefix, name, v.Bucket, _viewObjs(v))
+	}
+	return tw.Flush()
+}
+
+func rmViewHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	name := strings.TrimPrefix(c.Args().Get(0), viewPrefix)
+	if _, err := getView(name); err != nil {
+		return err
+	}
+	delete(cfg.Views, name)
+	return config.Save(cfg)
+}
+
+func viewCompletions(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	for name := range cfg.Views {
+		fmt.Println(name)
+	}
+}
+
+func _viewObjs(v *config.View) string {
+	switch {
+	case len(v.ObjNames) > 0:
+		return "list: " + strings.Join(v.ObjNames, ",")
+	case v.Template == "":
+		return "(entire bucket)"
+	default:
+		return "template: " + v.Template
+	}
+}
+
+func _viewStr(v *config.View) string { return v.Bucket + " " + _viewObjs(v) }
+
+// dsort input: bucket and apc.ListRange
+func viewBck(c *cli.Context, arg string) (bck cmn.Bck, v *config.View, err error) {
+	if v, err = getView(arg); err != nil {
+		return
+	}
+	bck, err = parseBckURI(c, v.Bucket, true /*errorOnly*/)
+	return
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -209,6 +210,28 @@ func (w *walkCtx) do(fqn string, info os.FileInfo, err error) error {
 // fobjs //
 ///////////
 
+// select (and skip) files by shell filename patterns relative to the source directory `root`
+// (same semantics as 'ais object promote --include/--exclude', see apc.PromoteArgs.Selected)
+func (a fobjs) filter(root string, include, exclude []string) (fobjs, int) {
+	var (
+		sel     = apc.PromoteArgs{Include: include, Exclude: exclude}
+		out     = a[:0]
+		skipped int
+	)
+	for _, fo := range a {
+		relname, err := filepath.Rel(root, fo.path)
+		if err != nil {
+			relname = filepath.Base(fo.path)
+		}
+		if sel.Selected(relname) {
+			out = append(out, fo)
+		} else {
+			skipped++
+		}
+	}
+	return out, skipped
+}
+
 func (a fobjs) Len() int           { return len(a) }
 func (a fobjs) Less(i, j int) bool { return a[i].path < a[j].path }
 func (a fobjs) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
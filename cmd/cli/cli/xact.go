@@ -161,6 +161,16 @@ func flattenXactStats(snap *core.Snap, units string) nvpairList {
 			nvpair{Name: "out.obj.size", Value: printtedVal},
 		)
 	}
+	// resource usage
+	if snap.Stats.CPU != 0 {
+		props = append(props, nvpair{Name: "res.cpu", Value: time.Duration(snap.Stats.CPU).String()})
+	}
+	if snap.Stats.DiskRead != 0 || snap.Stats.DiskWrite != 0 {
+		props = append(props,
+			nvpair{Name: "res.disk.read.size", Value: teb.FmtSize(snap.Stats.DiskRead, units, 2)},
+			nvpair{Name: "res.disk.write.size", Value: teb.FmtSize(snap.Stats.DiskWrite, units, 2)},
+		)
+	}
 	// NOTE: extended stats
 	if extStats, ok := snap.Ext.(map[string]any); ok {
 		for k, v := range extStats {
@@ -202,6 +212,18 @@ func getXactSnap(xargs *xact.ArgsMsg) (string, *core.Snap, error) {
 	return "", nil, nil
 }
 
+// first matching snapshot (intended for single-target xactions, e.g. blob-download)
+func findSnap(xs xact.MultiSnap, xid string) *core.Snap {
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			if snap.ID == xid {
+				return snap
+			}
+		}
+	}
+	return nil
+}
+
 func queryXactions(xargs *xact.ArgsMsg) (xs xact.MultiSnap, err error) {
 	orig := apiBP.Client.Timeout
 	if !xargs.OnlyRunning {
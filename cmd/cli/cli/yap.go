@@ -144,7 +144,7 @@ func (a *putargs) parse(c *cli.Context, emptyDstOnameOK bool) (err error) {
 		// STDIN
 		if a.src.arg == "-" {
 			a.src.stdin = true
-			if a.dst.oname == "" {
+			if a.dst.oname == "" && !flagIsSet(c, nameByHashFlag) {
 				err = fmt.Errorf("missing destination object name (in %s) - required when writing directly from standard input",
 					c.Command.ArgsUsage)
 			}
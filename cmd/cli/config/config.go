@@ -50,12 +50,21 @@ type (
 	}
 	AliasConfig cos.StrKVs // (see DefaultAliasConfig below)
 
+	// named object range ("view") to be referenced as '@NAME' (see `ais view`)
+	View struct {
+		Bucket   string   `json:"bucket"`             // e.g. "s3://abc"
+		Template string   `json:"template,omitempty"` // prefix and/or brace expansion (empty: entire bucket)
+		ObjNames []string `json:"list,omitempty"`     // (mutually exclusive with template)
+	}
+	ViewConfig map[string]*View
+
 	// all of the above
 	Config struct {
 		Cluster         ClusterConfig `json:"cluster"`
 		Timeout         TimeoutConfig `json:"timeout"`
 		Auth            AuthConfig    `json:"auth"`
 		Aliases         AliasConfig   `json:"aliases"`
+		Views           ViewConfig    `json:"views,omitempty"`
 		DefaultProvider string        `json:"default_provider,omitempty"` // NOTE: not supported yet (see app.go)
 		NoColor         bool          `json:"no_color"`
 		Verbose         bool          `json:"verbose"` // more warnings, errors with backtraces and details
@@ -72,12 +81,14 @@ var (
 		"put":      "object put",
 		"rmo":      "object rm",
 		"prefetch": "object prefetch", // same as "job start prefetch"
+		"mv":       "object mv",
 		// bucket
 		"ls":     "bucket ls",
 		"create": "bucket create",
 		"cp":     "bucket cp",
 		"rmb":    "bucket rm",
 		"evict":  "bucket evict",
+		"du":     "bucket du",
 		// job
 		"start":         "job start",
 		"stop":          "job stop",
@@ -22,8 +22,11 @@ func dispatchInterruptHandler() {
 	stopCh := make(chan os.Signal, 1)
 	signal.Notify(stopCh, os.Interrupt)
 	go func() {
-		<-stopCh
-		os.Exit(0)
+		for range stopCh {
+			if !cli.Interrupted() {
+				os.Exit(0)
+			}
+		}
 	}()
 }
 
@@ -31,14 +34,14 @@ func main() {
 	dispatchInterruptHandler()
 
 	if err := cli.Init(); err != nil {
-		exitf("%v", err)
+		exit(err, cli.ExitErr)
 	}
 	if err := cli.Run(cmn.VersionCLI+"."+build, buildtime, os.Args); err != nil {
-		exitf("%v", err)
+		exit(err, cli.ExitCode(err))
 	}
 }
 
-func exitf(f string, a ...any) {
-	fmt.Fprintf(os.Stderr, f+"\n", a...)
-	os.Exit(1)
+func exit(err error, code int) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(code)
 }
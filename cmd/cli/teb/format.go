@@ -0,0 +1,97 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// this file: machine-readable alternatives to the built-in tables (the `--format` option)
+
+const (
+	FmtJSON  = "json"
+	FmtCSV   = "csv"
+	FmtTempl = "template"
+)
+
+// parse "json", "csv", or "template=<go-template>"
+func ParseFormat(s string) (opts Opts, _ error) {
+	kind, templ, hasTempl := strings.Cut(s, "=")
+	switch kind {
+	case FmtJSON:
+		opts.UseJSON = true
+	case FmtCSV:
+		opts.CSV = true
+	case FmtTempl:
+		if !hasTempl || templ == "" {
+			return opts, fmt.Errorf("invalid format %q: expecting %s=<go-template>, e.g. '%s={{.Name}}'", s, FmtTempl, FmtTempl)
+		}
+		// as in `"{{.Name}}\t{{.Size}}"` typed in a shell
+		templ = strings.ReplaceAll(templ, `\t`, "\t")
+		opts.Templ = strings.ReplaceAll(templ, `\n`, "\n")
+	default:
+		return opts, fmt.Errorf("invalid format %q: expecting one of: %s, %s, %s=<go-template>", s, FmtJSON, FmtCSV, FmtTempl)
+	}
+	return opts, nil
+}
+
+// user-defined template: executed once per element when `object` is a slice
+// (one line per bucket, object, etc.), and once for the entire object otherwise
+func printTempl(object any, fmap template.FuncMap, templ string) error {
+	parsed, err := template.New("UserTemplate").Funcs(fmap).Parse(templ)
+	if err != nil {
+		return err
+	}
+	var (
+		w = bufio.NewWriter(Writer)
+		v = reflect.ValueOf(object)
+	)
+	if v.Kind() != reflect.Slice {
+		if err := parsed.Execute(w, object); err != nil {
+			return err
+		}
+		w.WriteByte('\n')
+		return w.Flush()
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err := parsed.Execute(w, v.Index(i).Interface()); err != nil {
+			return err
+		}
+		w.WriteByte('\n')
+	}
+	return w.Flush()
+}
+
+// CSV: execute the built-in (tab-separated) template and convert its rows to
+// comma-separated records - same columns and (header) names as in the table view
+func printCSV(object any, parsed *template.Template) error {
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, object); err != nil {
+		return err
+	}
+	w := csv.NewWriter(Writer)
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		// drop the trailing separator, if any (e.g. LsoTemplate terminates each cell with "\t ")
+		line = strings.TrimSuffix(strings.TrimSuffix(line, " "), "\t")
+		cells := strings.Split(line, "\t")
+		for i := range cells {
+			cells[i] = strings.TrimSpace(cells[i])
+		}
+		if err := w.Write(cells); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -22,6 +22,7 @@ const (
 	colMountpath = "MOUNTPATH"
 
 	colUsedAvgMax = "USED(min%, avg%, max%)"
+	colInodesUsed = "INODES USED(%)" // (aggregated: max)
 
 	colDisksFS = "Disks & File System"
 	colFS      = "File System"
@@ -39,6 +40,7 @@ func NewMpathCapTab(st StstMap, c *PerfTabCtx, showMpaths bool) *Table {
 			{name: colMountpath},
 			{name: colCapUsed},
 			{name: colCapAvail},
+			{name: colInodesUsed},
 			{name: colDisk},
 			{name: colFS},
 			{name: colCapStatus},
@@ -49,6 +51,7 @@ func NewMpathCapTab(st StstMap, c *PerfTabCtx, showMpaths bool) *Table {
 			{name: colNumMpaths},
 			{name: colUsedAvgMax},
 			{name: colCapAvail},
+			{name: colInodesUsed},
 			{name: colDisksFS},
 			{name: colCapStatus},
 		}
@@ -135,6 +138,13 @@ func mpathRow(c *PerfTabCtx, cols []*header, mpath string, cdf *fs.CDF, row []st
 	if _idx(cols, colCapAvail) >= 0 {
 		row = append(row, FmtSize(int64(cdf.Avail), c.Units, 2))
 	}
+	if _idx(cols, colInodesUsed) >= 0 {
+		if cdf.Inodes == 0 {
+			row = append(row, unknownVal) // not applicable (e.g., XFS)
+		} else {
+			row = append(row, strconv.Itoa(int(cdf.PctInodes))+"%")
+		}
+	}
 	if _idx(cols, colDisk) >= 0 {
 		if len(cdf.Disks) > 1 {
 			row = append(row, fmt.Sprintf("%v", cdf.Disks))
@@ -164,6 +174,13 @@ func numMpathsRow(ds *stats.NodeStatus, c *PerfTabCtx, cols []*header, row []str
 		avail := _sumupMpathsAvail(tcdf, ds.DeploymentType)
 		row = append(row, FmtSize(int64(avail), c.Units, 2))
 	}
+	if _idx(cols, colInodesUsed) >= 0 {
+		if tcdf.PctInodes == 0 {
+			row = append(row, unknownVal)
+		} else {
+			row = append(row, strconv.Itoa(int(tcdf.PctInodes))+"%")
+		}
+	}
 	if i := _idx(cols, colDisksFS); i >= 0 {
 		row = append(row, _fmtMpathDisks(tcdf.Mountpaths, i))
 	}
@@ -16,7 +16,9 @@ import (
 type Opts struct {
 	AltMap  template.FuncMap
 	Units   string
+	Templ   string // user-defined Go template (see ParseFormat)
 	UseJSON bool
+	CSV     bool
 }
 
 func Jopts(usejs bool) Opts { return Opts{UseJSON: usejs} }
@@ -51,10 +53,17 @@ func Print(object any, templ string, aux ...Opts) error {
 		}
 	}
 
+	if opts.Templ != "" {
+		return printTempl(object, fmap, opts.Templ)
+	}
+
 	parsedTempl, err := template.New("DisplayTemplate").Funcs(fmap).Parse(templ)
 	if err != nil {
 		return err
 	}
+	if opts.CSV {
+		return printCSV(object, parsedTempl)
+	}
 
 	w := tabwriter.NewWriter(Writer, 0, 8, 1, '\t', 0)
 	if err := parsedTempl.Execute(w, object); err != nil {
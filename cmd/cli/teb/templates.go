@@ -82,14 +82,20 @@ const (
 		indent1 + "Build:\t{{ ( BuildTimes .Status) }}\n"
 
 	// Config
-	DaemonConfigTmpl = "{{ if .ClusterConfigDiff }}PROPERTY\t VALUE\t DEFAULT\n{{range $item := .ClusterConfigDiff }}" +
-		"{{ $item.Name }}\t {{ $item.Current }}\t {{ $item.Old }}\n" +
+	DaemonConfigTmpl = "{{ if .ClusterConfigDiff }}PROPERTY\t VALUE\t DEFAULT\t ORIGIN\n{{range $item := .ClusterConfigDiff }}" +
+		"{{ $item.Name }}\t {{ $item.Current }}\t {{ $item.Old }}\t {{ $item.Origin }}\n" +
 		"{{end}}\n{{end}}" +
 		"{{ if .LocalConfigPairs }}PROPERTY\t VALUE\n" +
 		"{{range $item := .LocalConfigPairs }}" +
 		"{{ $item.Name }}\t {{ $item.Value }}\n" +
 		"{{end}}\n{{end}}"
 
+	// staged (validated but not yet applied) config update: node => changes
+	StagedConfigTmpl = "NODE\t PROPERTY\t FROM\t TO\n" +
+		"{{range $node, $changes := . }}{{range $c := $changes }}" +
+		"{{ $node }}\t {{ $c.Name }}\t {{ $c.From }}\t {{ $c.To }}\n" +
+		"{{end}}{{end}}"
+
 	// generic prop/val (name/val, key/val)
 	propValTmplHdr   = "PROPERTY\t VALUE\n"
 	PropValTmpl      = propValTmplHdr + PropValTmplNoHdr
@@ -299,6 +305,12 @@ See '--help' and docs/cli for details.`
 		"{{ $user.ID }}\t{{ JoinList $user.Roles }}\n" +
 		"{{end}}"
 
+	AuthNAuditTmpl      = "TIME\tEVENT\tUSER\tTARGET\tCLUSTER\tADDRESS\tSTATUS\n" + AuthNAuditTmplNoHdr
+	AuthNAuditTmplNoHdr = "{{ range $ev := . }}" +
+		"{{ FormatTimestamp $ev.Time }}\t{{ $ev.Kind }}\t{{ $ev.UserID }}\t{{ $ev.Target }}\t{{ $ev.ClusterID }}\t{{ $ev.Addr }}\t" +
+		"{{ if $ev.Success }}ok{{ else }}failed: {{ $ev.Err }}{{ end }}\n" +
+		"{{end}}"
+
 	AuthNUserVerboseTmpl = "Name\t{{ .ID }}\n" +
 		"Roles\t{{ JoinList .Roles }}\n" +
 		"{{ if ne (len .ClusterACLs) 0 }}" +
@@ -387,10 +399,10 @@ type (
 		NumDisks  int                  `json:"-"`
 	}
 	ListBucketsHelper struct {
-		XactID string
-		Bck    cmn.Bck
-		Props  *cmn.Bprops
-		Info   *cmn.BsummResult
+		XactID string           `json:"xid,omitempty"`
+		Bck    cmn.Bck          `json:"bck"`
+		Props  *cmn.Bprops      `json:"props,omitempty"`
+		Info   *cmn.BsummResult `json:"info,omitempty"`
 	}
 )
 
@@ -406,6 +418,7 @@ var (
 		"FormatMAM":           func(u int64) string { return fmt.Sprintf("%-10s", FmtSize(u, cos.UnitsIEC, 2)) },
 		"FormatMilli":         func(dur cos.Duration) string { return fmtMilli(dur, cos.UnitsIEC) },
 		"FormatDuration":      FormatDuration,
+		"FormatTimestamp":     func(t time.Time) string { return cos.FormatTime(t, time.DateTime) },
 		"FormatStart":         func(s, e time.Time) string { res, _ := FmtStartEnd(s, e); return res },
 		"FormatEnd":           func(s, e time.Time) string { _, res := FmtStartEnd(s, e); return res },
 		"FormatDsortStatus":   dsortJobInfoStatus,
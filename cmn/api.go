@@ -7,6 +7,7 @@ package cmn
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
 	"sort"
 	"strings"
@@ -49,9 +50,19 @@ type (
 		LRU         LRUConf         `json:"lru"`                            // LRU (watermarks and enabled/disabled)
 		Mirror      MirrorConf      `json:"mirror"`                         // mirroring
 		Access      apc.AccessAttrs `json:"access,string"`                  // access permissions
+		Frozen      bool            `json:"frozen"`                         // read-only: deny apc.AccessFrozen operations regardless of access
 		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
 		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		Dedup       DedupConf       `json:"dedup"`                          // deduplication (bucket-only, not inherited)
+		Packing     PackConf        `json:"packing"`                        // small-object packing (bucket-only, not inherited)
+		Replication ReplConf        `json:"replication"`                    // async replication (bucket-only, not inherited)
+		RateLimit   RateLimitConf   `json:"rate_limit"`                     // GET and PUT rate limits (bucket-only, not inherited)
+		ETL         BckETLConf      `json:"etl"`                            // default ETL on GET (bucket-only, not inherited)
+
+		// redundancy (mirror or EC) automatically selected at creation time (see feat.AutoRedundancy);
+		// empty if not selected or when mirror/EC props get changed later on
+		AutoRedundancy string `json:"auto_redundancy,omitempty" list:"readonly"`
 	}
 
 	ExtraProps struct {
@@ -112,8 +123,14 @@ type (
 		Mirror      *MirrorConfToSet      `json:"mirror,omitempty"`
 		EC          *ECConfToSet          `json:"ec,omitempty"`
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
+		Frozen      *bool                 `json:"frozen,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
+		Dedup       *DedupConfToSet       `json:"dedup,omitempty"`
+		Packing     *PackConfToSet        `json:"packing,omitempty"`
+		Replication *ReplConfToSet        `json:"replication,omitempty"`
+		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
+		ETL         *BckETLConfToSet      `json:"etl,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
@@ -203,7 +220,7 @@ func (bp *Bprops) Validate(targetCnt int) error {
 		}
 	}
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.Dedup, &bp.Packing, &bp.Replication, &bp.RateLimit, &bp.ETL} {
 		var err error
 		if pv == &bp.EC {
 			err = bp.EC.ValidateAsProps(targetCnt)
@@ -219,15 +236,27 @@ func (bp *Bprops) Validate(targetCnt int) error {
 			softErr = err
 		}
 	}
+	if err := bp.Versioning.Validate(); err != nil {
+		return err
+	}
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		return fmt.Errorf("cannot enable mirroring and ec at the same time for the same bucket")
 	}
+	if bp.Dedup.Enabled && (bp.Mirror.Enabled || bp.EC.Enabled) {
+		return fmt.Errorf("cannot enable deduplication with mirroring or ec for the same bucket")
+	}
+	if bp.Packing.Enabled && (bp.Mirror.Enabled || bp.EC.Enabled || bp.Dedup.Enabled) {
+		return fmt.Errorf("cannot enable packing with mirroring, ec, or deduplication for the same bucket")
+	}
 	return softErr
 }
 
 func (bp *Bprops) Apply(propsToSet *BpropsToSet) {
 	err := copyProps(propsToSet, bp, apc.Daemon)
 	debug.AssertNoErr(err)
+	if propsToSet.Mirror != nil || propsToSet.EC != nil {
+		bp.AutoRedundancy = "" // (no longer automatic)
+	}
 }
 
 //
@@ -279,6 +308,15 @@ type (
 		apc.BsummResult
 	}
 	AllBsummResults []*BsummResult
+
+	// one line (record) of the bucket-summary stream (see api.GetBucketSummaryStream)
+	BsummStreamResult struct {
+		TID       string          `json:"tid,omitempty"`   // target that has (partially) summarized its buckets
+		Summaries AllBsummResults `json:"summ,omitempty"`  // the target's results so far
+		Total     AllBsummResults `json:"total,omitempty"` // aggregated across all targets that have reported so far
+		Err       string          `json:"err,omitempty"`   // error that terminated the stream
+		Done      bool            `json:"done,omitempty"`  // the target is done
+	}
 )
 
 // interface guard
@@ -295,6 +333,12 @@ func (s AllBsummResults) Aggregate(from *BsummResult) AllBsummResults {
 			return s
 		}
 	}
+	from.ByPrefix = maps.Clone(from.ByPrefix) // (not to share with the caller)
+	from.Groups = maps.Clone(from.Groups)
+	if from.Estimate != nil {
+		e := *from.Estimate
+		from.Estimate = &e
+	}
 	s = append(s, from)
 	return s
 }
@@ -312,6 +356,36 @@ func aggr(from, to *BsummResult) {
 	to.TotalSize.OnDisk += from.TotalSize.OnDisk
 	to.TotalSize.PresentObjs += from.TotalSize.PresentObjs
 	to.TotalSize.RemoteObjs += from.TotalSize.RemoteObjs
+	to.ByPrefix = aggrGroups(from.ByPrefix, to.ByPrefix)
+	to.Groups = aggrGroups(from.Groups, to.Groups)
+	to.PrefixesTrunc = to.PrefixesTrunc || from.PrefixesTrunc
+	to.GroupsTrunc = to.GroupsTrunc || from.GroupsTrunc
+	if from.Estimate != nil {
+		if to.Estimate == nil {
+			to.Estimate = &apc.BsummEstimate{Exact: true}
+		}
+		// (summing up per-target bounds is conservative)
+		to.Estimate.Sampled += from.Estimate.Sampled
+		to.Estimate.CountLow += from.Estimate.CountLow
+		to.Estimate.CountHigh += from.Estimate.CountHigh
+		to.Estimate.Exact = to.Estimate.Exact && from.Estimate.Exact
+	}
+}
+
+func aggrGroups(from, to map[string]apc.BsummGroup) map[string]apc.BsummGroup {
+	if len(from) == 0 {
+		return to
+	}
+	if to == nil {
+		to = make(map[string]apc.BsummGroup, len(from))
+	}
+	for k, v := range from {
+		w := to[k]
+		w.Count += v.Count
+		w.Size += v.Size
+		to[k] = w
+	}
+	return to
 }
 
 func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
@@ -27,15 +27,25 @@ type Entry struct {
 }
 
 func List(fqn string) ([]*Entry, error) {
-	var (
-		lst   []*Entry
-		finfo os.FileInfo
-	)
 	fh, err := os.Open(fqn)
 	if err != nil {
 		return nil, err
 	}
-	mime, err := MimeFile(fh, nil /*NOTE: not reading file magic*/, "", fqn)
+	finfo, err := fh.Stat()
+	if err == nil {
+		lst, err := ListReader(fh, fqn, finfo.Size())
+		cos.Close(fh)
+		return lst, err
+	}
+	cos.Close(fh)
+	return nil, err
+}
+
+// same as above but for any archived content that can be read (and seeked)
+// (usage: deduplicated objects - see core.LOM.NewHandle)
+func ListReader(fh cos.ReadSeekerAt, archname string, size int64) ([]*Entry, error) {
+	var lst []*Entry
+	mime, err := MimeFile(fh, nil /*NOTE: not reading file magic*/, "", archname)
 	if err != nil {
 		return nil, err
 	}
@@ -45,16 +55,14 @@ func List(fqn string) ([]*Entry, error) {
 	case ExtTgz, ExtTarGz:
 		lst, err = lsTgz(fh)
 	case ExtZip:
-		finfo, err = os.Stat(fqn)
-		if err == nil {
-			lst, err = lsZip(fh, finfo.Size())
-		}
+		lst, err = lsZip(fh, size)
 	case ExtTarLz4:
 		lst, err = lsLz4(fh)
+	case ExtTarZst:
+		lst, err = lsZst(fh)
 	default:
 		debug.Assert(false, mime)
 	}
-	cos.Close(fh)
 	if err != nil {
 		return nil, err
 	}
@@ -113,3 +121,11 @@ func lsLz4(reader io.Reader) ([]*Entry, error) {
 	lzr := lz4.NewReader(reader)
 	return lsTar(lzr)
 }
+
+func lsZst(reader io.Reader) ([]*Entry, error) {
+	zsr, err := newZstdDecoder(reader)
+	if err != nil {
+		return nil, err
+	}
+	return lsTar(zsr)
+}
@@ -6,6 +6,7 @@
 package cmn
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
@@ -26,10 +28,20 @@ type (
 		IdleConnTimeout  time.Duration
 		IdleConnsPerHost int
 		MaxIdleConns     int
+		MaxConnsPerHost  int
 		SndRcvBufSize    int
 		WriteBufferSize  int
 		ReadBufferSize   int
+		ConnStats        *ConnStats // optional: count dials and open connections
 		UseHTTPProxyEnv  bool
+		ForceHTTP2       bool // attempt HTTP/2 (TLS only)
+	}
+	// connection pool stats: new connections (dials) and currently open;
+	// a steadily growing number of dials indicates insufficient connection reuse
+	// (and, potentially, ephemeral port exhaustion)
+	ConnStats struct {
+		Dials atomic.Int64
+		Open  atomic.Int64
 	}
 	TLSArgs struct {
 		ClientCA    string
@@ -57,16 +69,22 @@ func NewTransport(cargs TransportArgs) *http.Transport {
 	if cargs.SndRcvBufSize > 0 {
 		dialer.Control = cargs.setSockOpt
 	}
+	dial := dialer.DialContext
+	if cargs.ConnStats != nil {
+		dial = cargs.ConnStats.dialer(dial)
+	}
 	transport := &http.Transport{
-		DialContext:           dialer.DialContext,
+		DialContext:           dial,
 		TLSHandshakeTimeout:   defaultTransport.TLSHandshakeTimeout,
 		ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
 		IdleConnTimeout:       cargs.IdleConnTimeout,
 		MaxIdleConnsPerHost:   cargs.IdleConnsPerHost,
 		MaxIdleConns:          cargs.MaxIdleConns,
+		MaxConnsPerHost:       cargs.MaxConnsPerHost,
 		WriteBufferSize:       cargs.WriteBufferSize,
 		ReadBufferSize:        cargs.ReadBufferSize,
 		DisableCompression:    true, // NOTE: hardcoded - never used
+		ForceAttemptHTTP2:     cargs.ForceHTTP2,
 	}
 
 	// apply global defaults
@@ -92,6 +110,35 @@ func NewTransport(cargs TransportArgs) *http.Transport {
 	return transport
 }
 
+///////////////
+// ConnStats //
+///////////////
+
+type statsConn struct {
+	net.Conn
+	stats  *ConnStats
+	closed atomic.Bool
+}
+
+func (cs *ConnStats) dialer(dial func(context.Context, string, string) (net.Conn, error)) func(context.Context, string, string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		cs.Dials.Inc()
+		cs.Open.Inc()
+		return &statsConn{Conn: conn, stats: cs}, nil
+	}
+}
+
+func (c *statsConn) Close() error {
+	if c.closed.CAS(false, true) {
+		c.stats.Open.Dec()
+	}
+	return c.Conn.Close()
+}
+
 func NewTLS(sargs TLSArgs) (tlsConf *tls.Config, _ error) {
 	var pool *x509.CertPool
 	if sargs.ClientCA != "" {
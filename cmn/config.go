@@ -15,6 +15,8 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/k8s"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	jsoniter "github.com/json-iterator/go"
 )
@@ -116,9 +119,30 @@ type (
 		// Transform (offline) or Copy src Bucket => dst bucket
 		TCB TCBConf `json:"tcb"`
 
+		// list-objects page size limits
+		Lso LsoConf `json:"list_objects"`
+
+		// cold GET admission control (per target)
+		ColdGet ColdGetConf `json:"cold_get"`
+
+		// memory-pressure aware shedding of low-priority work (per target)
+		Shed ShedConf `json:"shed"`
+
+		// goroutine, open file, and socket counts vs thresholds (all nodes)
+		Watchdog WatchdogConf `json:"watchdog"`
+
+		// metrics sinks: StatsD and/or Prometheus (all nodes)
+		Metrics MetricsConf `json:"metrics"`
+
 		// metadata write policy: (immediate | delayed | never)
 		WritePolicy WritePolicyConf `json:"write_policy"`
 
+		// named bucket profiles (templates) - see BucketProfilesConf
+		BucketProfiles BucketProfilesConf `json:"bucket_profiles,omitempty" allow:"cluster"`
+
+		// named service level objectives (latency and error rate thresholds) - see SLOConf
+		SLO SLOConf `json:"slo,omitempty" allow:"cluster"`
+
 		// standalone enumerated features that can be configured
 		// to flip assorted global defaults (see cmn/feat/feat.go)
 		Features feat.Flags `json:"features,string" allow:"cluster"`
@@ -153,10 +177,18 @@ type (
 		Transport   *TransportConfToSet   `json:"transport,omitempty"`
 		Memsys      *MemsysConfToSet      `json:"memsys,omitempty"`
 		TCB         *TCBConfToSet         `json:"tcb,omitempty"`
+		Lso         *LsoConfToSet         `json:"list_objects,omitempty"`
+		ColdGet     *ColdGetConfToSet     `json:"cold_get,omitempty"`
+		Shed        *ShedConfToSet        `json:"shed,omitempty"`
+		Watchdog    *WatchdogConfToSet    `json:"watchdog,omitempty"`
+		Metrics     *MetricsConfToSet     `json:"metrics,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Proxy       *ProxyConfToSet       `json:"proxy,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 
+		BucketProfiles *BucketProfilesConf `json:"bucket_profiles,omitempty"`
+		SLO            *SLOConf            `json:"slo,omitempty"`
+
 		// LocalConfig
 		FSP *FSPConf `json:"fspaths,omitempty"`
 	}
@@ -205,18 +237,64 @@ type (
 	}
 
 	LogConf struct {
-		Level     cos.LogLevel `json:"level"`      // log level (aka verbosity)
-		MaxSize   cos.SizeIEC  `json:"max_size"`   // exceeding this size triggers log rotation
-		MaxTotal  cos.SizeIEC  `json:"max_total"`  // (sum individual log sizes); exceeding this number triggers cleanup
-		FlushTime cos.Duration `json:"flush_time"` // log flush interval
-		StatsTime cos.Duration `json:"stats_time"` // (not used)
+		Level     cos.LogLevel   `json:"level"`      // log level (aka verbosity)
+		MaxSize   cos.SizeIEC    `json:"max_size"`   // exceeding this size triggers log rotation
+		MaxTotal  cos.SizeIEC    `json:"max_total"`  // (sum individual log sizes); exceeding this number triggers cleanup
+		FlushTime cos.Duration   `json:"flush_time"` // log flush interval
+		StatsTime cos.Duration   `json:"stats_time"` // (not used)
+		Format    string         `json:"format"`     // LogFormatText (default) or LogFormatJSON (one JSON object per line)
+		Modules   LogModulesConf `json:"modules"`    // per-module verbosity (overrides Level for the module)
 	}
 	LogConfToSet struct {
-		Level     *cos.LogLevel `json:"level,omitempty"`
-		MaxSize   *cos.SizeIEC  `json:"max_size,omitempty"`
-		MaxTotal  *cos.SizeIEC  `json:"max_total,omitempty"`
-		FlushTime *cos.Duration `json:"flush_time,omitempty"`
-		StatsTime *cos.Duration `json:"stats_time,omitempty"`
+		Level     *cos.LogLevel        `json:"level,omitempty"`
+		MaxSize   *cos.SizeIEC         `json:"max_size,omitempty"`
+		MaxTotal  *cos.SizeIEC         `json:"max_total,omitempty"`
+		FlushTime *cos.Duration        `json:"flush_time,omitempty"`
+		StatsTime *cos.Duration        `json:"stats_time,omitempty"`
+		Format    *string              `json:"format,omitempty"`
+		Modules   *LogModulesConfToSet `json:"modules,omitempty"`
+	}
+
+	// Per-module log level: empty (default) means global log.level; otherwise, 1 to 5 or one of the
+	// LogLevelNames aliases, e.g.: `ais config cluster log.modules.reb=debug`
+	// NOTE: field order and json tags must match cos.Smodules
+	LogModulesConf struct {
+		Transport string `json:"transport,omitempty" list:"omitempty"`
+		AIS       string `json:"ais,omitempty" list:"omitempty"`
+		Memsys    string `json:"memsys,omitempty" list:"omitempty"`
+		Cluster   string `json:"cluster,omitempty" list:"omitempty"`
+		FS        string `json:"fs,omitempty" list:"omitempty"`
+		Reb       string `json:"reb,omitempty" list:"omitempty"`
+		EC        string `json:"ec,omitempty" list:"omitempty"`
+		Stats     string `json:"stats,omitempty" list:"omitempty"`
+		IOS       string `json:"ios,omitempty" list:"omitempty"`
+		Xs        string `json:"xs,omitempty" list:"omitempty"`
+		Backend   string `json:"backend,omitempty" list:"omitempty"`
+		Space     string `json:"space,omitempty" list:"omitempty"`
+		Mirror    string `json:"mirror,omitempty" list:"omitempty"`
+		Dsort     string `json:"dsort,omitempty" list:"omitempty"`
+		Dload     string `json:"downloader,omitempty" list:"omitempty"`
+		ETL       string `json:"etl,omitempty" list:"omitempty"`
+		S3        string `json:"s3,omitempty" list:"omitempty"`
+	}
+	LogModulesConfToSet struct {
+		Transport *string `json:"transport,omitempty"`
+		AIS       *string `json:"ais,omitempty"`
+		Memsys    *string `json:"memsys,omitempty"`
+		Cluster   *string `json:"cluster,omitempty"`
+		FS        *string `json:"fs,omitempty"`
+		Reb       *string `json:"reb,omitempty"`
+		EC        *string `json:"ec,omitempty"`
+		Stats     *string `json:"stats,omitempty"`
+		IOS       *string `json:"ios,omitempty"`
+		Xs        *string `json:"xs,omitempty"`
+		Backend   *string `json:"backend,omitempty"`
+		Space     *string `json:"space,omitempty"`
+		Mirror    *string `json:"mirror,omitempty"`
+		Dsort     *string `json:"dsort,omitempty"`
+		Dload     *string `json:"downloader,omitempty"`
+		ETL       *string `json:"etl,omitempty"`
+		S3        *string `json:"s3,omitempty"`
 	}
 
 	// NOTE: StatsTime is a one important timer
@@ -239,6 +317,11 @@ type (
 		Startup         cos.Duration `json:"startup_time"`
 		JoinAtStartup   cos.Duration `json:"join_startup_time"` // (join cluster at startup) timeout
 		SendFile        cos.Duration `json:"send_file_time"`
+		// per-verb default (object) request timeouts; zero means no timeout
+		// (may be overridden by the client via apc.HdrTimeout)
+		ObjGet  cos.Duration `json:"object_get_time"`
+		ObjPut  cos.Duration `json:"object_put_time"`
+		ObjHead cos.Duration `json:"object_head_time"`
 	}
 	TimeoutConfToSet struct {
 		CplaneOperation *cos.Duration `json:"cplane_operation,omitempty"`
@@ -247,6 +330,9 @@ type (
 		Startup         *cos.Duration `json:"startup_time,omitempty"`
 		JoinAtStartup   *cos.Duration `json:"join_startup_time,omitempty"`
 		SendFile        *cos.Duration `json:"send_file_time,omitempty"`
+		ObjGet          *cos.Duration `json:"object_get_time,omitempty"`
+		ObjPut          *cos.Duration `json:"object_put_time,omitempty"`
+		ObjHead         *cos.Duration `json:"object_head_time,omitempty"`
 	}
 
 	ClientConf struct {
@@ -265,12 +351,24 @@ type (
 		OriginalURL  string `json:"original_url"`
 		DiscoveryURL string `json:"discovery_url"`
 		NonElectable bool   `json:"non_electable"`
+		WebUI        bool   `json:"webui"` // serve built-in web dashboard at /webui
+		// serve GET and HEAD, reject client requests that modify cluster, buckets, or objects;
+		// per proxy (override the inherited value); requires non_electable
+		ReadOnly bool `json:"read_only"`
+		// maximum number of concurrent client connections: total and per client (IP address);
+		// exceeding either limit results in 503 with Retry-After; zero: unlimited
+		MaxConns       int `json:"max_conns"`
+		MaxClientConns int `json:"max_client_conns"`
 	}
 	ProxyConfToSet struct {
-		PrimaryURL   *string `json:"primary_url,omitempty"`
-		OriginalURL  *string `json:"original_url,omitempty"`
-		DiscoveryURL *string `json:"discovery_url,omitempty"`
-		NonElectable *bool   `json:"non_electable,omitempty"`
+		PrimaryURL     *string `json:"primary_url,omitempty"`
+		OriginalURL    *string `json:"original_url,omitempty"`
+		DiscoveryURL   *string `json:"discovery_url,omitempty"`
+		NonElectable   *bool   `json:"non_electable,omitempty"`
+		WebUI          *bool   `json:"webui,omitempty"`
+		ReadOnly       *bool   `json:"read_only,omitempty"`
+		MaxConns       *int    `json:"max_conns,omitempty"`
+		MaxClientConns *int    `json:"max_client_conns,omitempty"`
 	}
 
 	SpaceConf struct {
@@ -306,13 +404,18 @@ type (
 		// CapacityUpdTimeStr denotes the frequency at which AIStore updates local capacity utilization
 		CapacityUpdTime cos.Duration `json:"capacity_upd_time"`
 
+		// VersionEvictTime: previous object versions (see versioning.retain) that are older than
+		// this get evicted first, before LRU proceeds to evict (current) objects; zero - never
+		VersionEvictTime cos.Duration `json:"version_evict_time"`
+
 		// Enabled: LRU will only run when set to true
 		Enabled bool `json:"enabled"`
 	}
 	LRUConfToSet struct {
-		DontEvictTime   *cos.Duration `json:"dont_evict_time,omitempty"`
-		CapacityUpdTime *cos.Duration `json:"capacity_upd_time,omitempty"`
-		Enabled         *bool         `json:"enabled,omitempty"`
+		DontEvictTime    *cos.Duration `json:"dont_evict_time,omitempty"`
+		CapacityUpdTime  *cos.Duration `json:"capacity_upd_time,omitempty"`
+		VersionEvictTime *cos.Duration `json:"version_evict_time,omitempty"`
+		Enabled          *bool         `json:"enabled,omitempty"`
 	}
 
 	DiskConf struct {
@@ -321,6 +424,11 @@ type (
 		DiskUtilMaxWM   int64        `json:"disk_util_max_wm"`
 		IostatTimeLong  cos.Duration `json:"iostat_time_long"`
 		IostatTimeShort cos.Duration `json:"iostat_time_short"`
+		// Linux only: run background jobs' disk IO in a separate (blkio, cgroup v1) cgroup
+		// with its own proportional weight, so that the OS itself makes foreground GET/PUT
+		// win disk bandwidth (empty: disabled)
+		BgCgroup   string `json:"bg_cgroup"`    // child of the aisnode's own blkio cgroup, e.g. "ais-bg"
+		BgIOWeight int    `json:"bg_io_weight"` // blkio.weight: [10, 1000]
 	}
 	DiskConfToSet struct {
 		DiskUtilLowWM   *int64        `json:"disk_util_low_wm,omitempty"`
@@ -328,6 +436,8 @@ type (
 		DiskUtilMaxWM   *int64        `json:"disk_util_max_wm,omitempty"`
 		IostatTimeLong  *cos.Duration `json:"iostat_time_long,omitempty"`
 		IostatTimeShort *cos.Duration `json:"iostat_time_short,omitempty"`
+		BgCgroup        *string       `json:"bg_cgroup,omitempty"`
+		BgIOWeight      *int          `json:"bg_io_weight,omitempty"`
 	}
 
 	RebalanceConf struct {
@@ -335,12 +445,20 @@ type (
 		DestRetryTime cos.Duration `json:"dest_retry_time"`   // max wait for ACKs & neighbors to complete
 		SbundleMult   int          `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
 		Enabled       bool         `json:"enabled"`           // true=auto-rebalance | manual rebalancing
+		// capacity-weighted HRW: targets receive objects in proportion to their total capacities
+		// (toggling it cluster-wide triggers rebalance to re-place existing objects)
+		CapWeights bool `json:"capacity_weights"`
+		// partition-map placement: objects hash into a fixed number of virtual partitions
+		// that, in turn, get assigned to targets (0: disabled, HRW-place each object)
+		Partitions int `json:"partitions"`
 	}
 	RebalanceConfToSet struct {
 		DestRetryTime *cos.Duration `json:"dest_retry_time,omitempty"`
 		Compression   *string       `json:"compression,omitempty"`
 		SbundleMult   *int          `json:"bundle_multiplier"`
 		Enabled       *bool         `json:"enabled,omitempty"`
+		CapWeights    *bool         `json:"capacity_weights,omitempty"`
+		Partitions    *int          `json:"partitions,omitempty"`
 	}
 
 	ResilverConf struct {
@@ -399,15 +517,27 @@ type (
 		// - apc.QparamLatestVer, apc.PrefetchMsg, apc.CopyBckMsg
 		ValidateWarmGet bool `json:"validate_warm_get"`
 
+		// When ValidateWarmGet is enabled: the minimum time between validations of the same
+		// in-cluster object (zero - validate upon every warm GET).
+		// Applies only to the bucket property (apc.QparamLatestVer always validates).
+		ValidateWarmGetIval cos.Duration `json:"validate_warm_get_interval"`
+
 		// A stronger variant of the above that in addition entails:
 		// - deleting in-cluster object if its remote ("cached") counterpart does not exist
 		// See also: apc.QparamSync, apc.CopyBckMsg
 		Sync bool `json:"synchronize"`
+
+		// ais:// buckets only: the number of previous versions to keep upon overwrite
+		// (zero - none); previous versions can be read via apc.QparamObjVersion
+		// See also: lru.version_evict_time
+		Retain int `json:"retain"`
 	}
 	VersionConfToSet struct {
-		Enabled         *bool `json:"enabled,omitempty"`
-		ValidateWarmGet *bool `json:"validate_warm_get,omitempty"`
-		Sync            *bool `json:"synchronize,omitempty"`
+		Enabled             *bool         `json:"enabled,omitempty"`
+		ValidateWarmGet     *bool         `json:"validate_warm_get,omitempty"`
+		ValidateWarmGetIval *cos.Duration `json:"validate_warm_get_interval,omitempty"`
+		Sync                *bool         `json:"synchronize,omitempty"`
+		Retain              *int          `json:"retain,omitempty"`
 	}
 
 	NetConf struct {
@@ -432,21 +562,32 @@ type (
 		ClientAuthTLS   int    `json:"client_auth_tls"`   // #6410 tls.ClientAuthType enum
 		WriteBufferSize int    `json:"write_buffer_size"` // http.Transport.WriteBufferSize; zero defaults to 4KB
 		ReadBufferSize  int    `json:"read_buffer_size"`  // http.Transport.ReadBufferSize; ditto
-		UseHTTPS        bool   `json:"use_https"`         // use HTTPS
-		SkipVerifyCrt   bool   `json:"skip_verify"`       // skip X509 cert verification (used with self-signed certs)
-		Chunked         bool   `json:"chunked_transfer"`  // (https://tools.ietf.org/html/rfc7230#page-36; not used since 02/23)
+		// intra-cluster clients: connection pooling (zero values: cmn.Default* in cmn/network.go); take effect upon restart
+		IdleConnsPerHost int          `json:"idle_conns_per_host"` // http.Transport.MaxIdleConnsPerHost
+		MaxIdleConns     int          `json:"max_idle_conns"`      // http.Transport.MaxIdleConns
+		MaxConnsPerHost  int          `json:"max_conns_per_host"`  // http.Transport.MaxConnsPerHost; zero: no limit
+		IdleConnTimeout  cos.Duration `json:"idle_conn_timeout"`   // http.Transport.IdleConnTimeout
+		HTTP2            bool         `json:"http2"`               // HTTPS only: intra-cluster clients attempt HTTP/2
+		UseHTTPS         bool         `json:"use_https"`           // use HTTPS
+		SkipVerifyCrt    bool         `json:"skip_verify"`         // skip X509 cert verification (used with self-signed certs)
+		Chunked          bool         `json:"chunked_transfer"`    // (https://tools.ietf.org/html/rfc7230#page-36; not used since 02/23)
 	}
 	HTTPConfToSet struct {
-		Certificate     *string `json:"server_crt,omitempty"`
-		CertKey         *string `json:"server_key,omitempty"`
-		ServerNameTLS   *string `json:"domain_tls,omitempty"`
-		ClientCA        *string `json:"client_ca_tls,omitempty"`
-		WriteBufferSize *int    `json:"write_buffer_size,omitempty" list:"readonly"`
-		ReadBufferSize  *int    `json:"read_buffer_size,omitempty" list:"readonly"`
-		ClientAuthTLS   *int    `json:"client_auth_tls,omitempty"`
-		UseHTTPS        *bool   `json:"use_https,omitempty"`
-		SkipVerifyCrt   *bool   `json:"skip_verify,omitempty"`
-		Chunked         *bool   `json:"chunked_transfer,omitempty"`
+		Certificate      *string       `json:"server_crt,omitempty"`
+		CertKey          *string       `json:"server_key,omitempty"`
+		ServerNameTLS    *string       `json:"domain_tls,omitempty"`
+		ClientCA         *string       `json:"client_ca_tls,omitempty"`
+		WriteBufferSize  *int          `json:"write_buffer_size,omitempty" list:"readonly"`
+		ReadBufferSize   *int          `json:"read_buffer_size,omitempty" list:"readonly"`
+		IdleConnsPerHost *int          `json:"idle_conns_per_host,omitempty"`
+		MaxIdleConns     *int          `json:"max_idle_conns,omitempty"`
+		MaxConnsPerHost  *int          `json:"max_conns_per_host,omitempty"`
+		IdleConnTimeout  *cos.Duration `json:"idle_conn_timeout,omitempty"`
+		HTTP2            *bool         `json:"http2,omitempty"`
+		ClientAuthTLS    *int          `json:"client_auth_tls,omitempty"`
+		UseHTTPS         *bool         `json:"use_https,omitempty"`
+		SkipVerifyCrt    *bool         `json:"skip_verify,omitempty"`
+		Chunked          *bool         `json:"chunked_transfer,omitempty"`
 	}
 
 	FSHCConf struct {
@@ -461,12 +602,14 @@ type (
 	}
 
 	AuthConf struct {
-		Secret  string `json:"secret"`
-		Enabled bool   `json:"enabled"`
+		Secret     string `json:"secret"`
+		PrevSecret string `json:"prev_secret,omitempty"` // AuthN secret prior to the last rotation
+		Enabled    bool   `json:"enabled"`
 	}
 	AuthConfToSet struct {
-		Secret  *string `json:"secret,omitempty"`
-		Enabled *bool   `json:"enabled,omitempty"`
+		Secret     *string `json:"secret,omitempty"`
+		PrevSecret *string `json:"prev_secret,omitempty"`
+		Enabled    *bool   `json:"enabled,omitempty"`
 	}
 
 	// keepalive tracker
@@ -509,6 +652,7 @@ type (
 		DsorterMemThreshold string       `json:"dsorter_mem_threshold"`
 		Compression         string       `json:"compression"`       // {CompressAlways,...} in api/apc/compression.go
 		SbundleMult         int          `json:"bundle_multiplier"` // stream-bundle multiplier: num to destination
+		ZstdLevel           int          `json:"zstd_level"`        // .tar.zst output shards: zstd compression level [1, 22]; zero: default
 	}
 	DsortConfToSet struct {
 		DuplicatedRecords   *string       `json:"duplicated_records,omitempty"`
@@ -520,6 +664,7 @@ type (
 		DsorterMemThreshold *string       `json:"dsorter_mem_threshold,omitempty"`
 		Compression         *string       `json:"compression,omitempty"`
 		SbundleMult         *int          `json:"bundle_multiplier,omitempty"`
+		ZstdLevel           *int          `json:"zstd_level,omitempty"`
 	}
 
 	TransportConf struct {
@@ -571,6 +716,147 @@ type (
 		SbundleMult *int    `json:"bundle_multiplier,omitempty"`
 	}
 
+	// server-side list-objects limits: larger (requested) pages are cut down to size
+	// and returned with continuation token; the smaller limits apply under memory pressure
+	// (see memsys.Pressure*)
+	LsoConf struct {
+		MaxPageSize        uint `json:"max_page_size"`         // max number of entries per page
+		MaxPageSizeHigh    uint `json:"max_page_size_high"`    // ditto, under high memory pressure
+		MaxPageSizeExtreme uint `json:"max_page_size_extreme"` // ditto, under extreme memory pressure (and OOM)
+	}
+	LsoConfToSet struct {
+		MaxPageSize        *uint `json:"max_page_size,omitempty"`
+		MaxPageSizeHigh    *uint `json:"max_page_size_high,omitempty"`
+		MaxPageSizeExtreme *uint `json:"max_page_size_extreme,omitempty"`
+	}
+
+	// bounded queue and concurrency limit for backend (cold) GETs:
+	// excess cold reads wait in the queue for up to `queue_timeout`;
+	// when the queue is full (or the wait times out) they are rejected with
+	// http.StatusServiceUnavailable and Retry-After
+	ColdGetConf struct {
+		MaxActive    int          `json:"max_active"`    // max number of concurrent cold GETs
+		MaxQueued    int          `json:"max_queued"`    // max number of cold GETs waiting for their turn (-1: no queueing)
+		QueueTimeout cos.Duration `json:"queue_timeout"` // max time to wait in the queue
+	}
+	ColdGetConfToSet struct {
+		MaxActive    *int          `json:"max_active,omitempty"`
+		MaxQueued    *int          `json:"max_queued,omitempty"`
+		QueueTimeout *cos.Duration `json:"queue_timeout,omitempty"`
+	}
+
+	// low-priority work (list-objects pages, cold GETs, out-of-band scrubs) gets delayed
+	// under high memory pressure and shed (http.StatusServiceUnavailable) under extreme
+	// pressure - or when the Go heap exceeds `max_heap` (see memsys.Pressure*)
+	ShedConf struct {
+		MaxHeap cos.SizeIEC  `json:"max_heap"` // zero: no limit (memory pressure only)
+		Delay   cos.Duration `json:"delay"`    // max time to delay low-priority request under high pressure
+		Enabled bool         `json:"enabled"`
+	}
+	ShedConfToSet struct {
+		MaxHeap *cos.SizeIEC  `json:"max_heap,omitempty"`
+		Delay   *cos.Duration `json:"delay,omitempty"`
+		Enabled *bool         `json:"enabled,omitempty"`
+	}
+
+	// periodically sample the number of goroutines, open file descriptors, and sockets;
+	// report (as stats gauges) and warn when exceeding thresholds or steadily growing
+	WatchdogConf struct {
+		Interval      cos.Duration `json:"interval"`       // sampling interval
+		MaxGoroutines int          `json:"max_goroutines"` // zero: no limit
+		MaxSockets    int          `json:"max_sockets"`    // ditto
+		MaxFDsPct     int          `json:"max_fds_pct"`    // percentage of the process's RLIMIT_NOFILE
+		Enabled       bool         `json:"enabled"`
+	}
+	WatchdogConfToSet struct {
+		Interval      *cos.Duration `json:"interval,omitempty"`
+		MaxGoroutines *int          `json:"max_goroutines,omitempty"`
+		MaxSockets    *int          `json:"max_sockets,omitempty"`
+		MaxFDsPct     *int          `json:"max_fds_pct,omitempty"`
+		Enabled       *bool         `json:"enabled,omitempty"`
+	}
+
+	// where to publish node stats, in addition to logs: push to StatsD and/or
+	// serve (pull) via Prometheus "/metrics" endpoint; takes effect upon restart
+	MetricsConf struct {
+		Sinks string `json:"sinks"` // comma-separated: MetricsStatsD (default), MetricsProm, or both
+	}
+	MetricsConfToSet struct {
+		Sinks *string `json:"sinks,omitempty"`
+	}
+
+	// bucket-only (not inherited from cluster config) - see also apc.SupportedDedupChunking
+	DedupConf struct {
+		Chunking  string      `json:"chunking"`   // enum { apc.DedupFixed, apc.DedupCDC }
+		ChunkSize cos.SizeIEC `json:"chunk_size"` // fixed: chunk size; cdc: average (target) chunk size
+		Enabled   bool        `json:"enabled"`    // store new objects as manifests of content-addressed chunks
+	}
+	DedupConfToSet struct {
+		Chunking  *string      `json:"chunking,omitempty"`
+		ChunkSize *cos.SizeIEC `json:"chunk_size,omitempty"`
+		Enabled   *bool        `json:"enabled,omitempty"`
+	}
+
+	// bucket-only (not inherited from cluster config):
+	// pack small objects into container files ("slab objects") - see fs/pack.go
+	PackConf struct {
+		MaxObjSize    cos.SizeIEC `json:"max_obj_size"`   // objects of up to this size get packed
+		ContainerSize cos.SizeIEC `json:"container_size"` // max size of a single container file
+		CompactPct    int         `json:"compact_pct"`    // compact containers with at least this percentage of garbage
+		Enabled       bool        `json:"enabled"`
+	}
+	PackConfToSet struct {
+		MaxObjSize    *cos.SizeIEC `json:"max_obj_size,omitempty"`
+		ContainerSize *cos.SizeIEC `json:"container_size,omitempty"`
+		CompactPct    *int         `json:"compact_pct,omitempty"`
+		Enabled       *bool        `json:"enabled,omitempty"`
+	}
+
+	// bucket-only (not inherited from cluster config):
+	// cluster-wide GET and PUT limits - requests and bytes per second (zero: unlimited);
+	// each target enforces its (1/number-of-targets) share - see ais/tgtratelim.go
+	RateLimitConf struct {
+		GetRPS  int         `json:"get_rps"`
+		GetBPS  cos.SizeIEC `json:"get_bps"`
+		PutRPS  int         `json:"put_rps"`
+		PutBPS  cos.SizeIEC `json:"put_bps"`
+		Enabled bool        `json:"enabled"`
+	}
+	RateLimitConfToSet struct {
+		GetRPS  *int         `json:"get_rps,omitempty"`
+		GetBPS  *cos.SizeIEC `json:"get_bps,omitempty"`
+		PutRPS  *int         `json:"put_rps,omitempty"`
+		PutBPS  *cos.SizeIEC `json:"put_bps,omitempty"`
+		Enabled *bool        `json:"enabled,omitempty"`
+	}
+
+	// bucket-only (not inherited from cluster config):
+	// default ETL transformer - GET requests (that do not specify their own) get transformed by this one;
+	// the ETL must be initialized (see ext/etl) - the name is its ID
+	BckETLConf struct {
+		ID string `json:"id"`
+	}
+	BckETLConfToSet struct {
+		ID *string `json:"id,omitempty"`
+	}
+
+	// bucket-only (not inherited from cluster config):
+	// asynchronous replication of PUTs and DELETEs to a bucket in attached remote ais cluster
+	ReplConf struct {
+		Dst       string `json:"dst"`        // destination bucket, e.g. "ais://@remais/abc"
+		Conflict  string `json:"conflict"`   // bidirectional only: conflict resolution policy (enum apc.ReplConflict*)
+		QueueSize int    `json:"queue_size"` // max number of pending (not yet replicated) changes per target
+		Bidir     bool   `json:"bidirectional"`
+		Enabled   bool   `json:"enabled"`
+	}
+	ReplConfToSet struct {
+		Dst       *string `json:"dst,omitempty"`
+		Conflict  *string `json:"conflict,omitempty"`
+		QueueSize *int    `json:"queue_size,omitempty"`
+		Bidir     *bool   `json:"bidirectional,omitempty"`
+		Enabled   *bool   `json:"enabled,omitempty"`
+	}
+
 	WritePolicyConf struct {
 		Data apc.WritePolicy `json:"data"`
 		MD   apc.WritePolicy `json:"md"`
@@ -579,6 +865,29 @@ type (
 		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
+
+	// Bucket profile is a named set of bucket properties that overrides cluster defaults
+	// when a new bucket gets created with the profile, e.g.:
+	// "training-data" => {"ec": {"enabled": true, "data_slices": 4, "parity_slices": 2}, "lru": {"enabled": false}}
+	// Props explicitly specified at creation time (if any) take precedence over the profile.
+	// See also: apc.QparamBprofile
+	BucketProfilesConf map[string]*BpropsToSet
+
+	// SLO monitor: a threshold for a given node-level metric that the stats runner
+	// evaluates every `periodic.stats_time` interval, e.g.:
+	// "get-latency" => {"metric": "get.ns", "max_avg_latency": "50ms"}
+	// "get-errors"  => {"metric": "get.n", "max_err_pct": 0.1}
+	// See also: stats.SLOStatus
+	SLOMonitor struct {
+		// latency (".ns") or count (".n") metric, e.g. "get.ns" or "get.n"
+		Metric string `json:"metric"`
+		// latency metrics: max average latency over the interval
+		// (node stats track no latency percentiles)
+		MaxAvgLatency cos.Duration `json:"max_avg_latency,omitempty"`
+		// count metrics: max percentage of errors (e.g. "err.get.n") over the interval
+		MaxErrPct float64 `json:"max_err_pct,omitempty"`
+	}
+	SLOConf map[string]*SLOMonitor
 )
 
 // assorted named fields that require (cluster | node) restart for changes to make an effect
@@ -593,6 +902,15 @@ const (
 
 var SupportedReactions = []string{IgnoreReaction, WarnReaction, AbortReaction}
 
+// versioning.retain
+const MaxRetainVersions = 1000
+
+// log.format
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
 //
 // config meta-versioning & serialization
 //
@@ -635,7 +953,15 @@ var (
 	_ Validator = (*TransportConf)(nil)
 	_ Validator = (*MemsysConf)(nil)
 	_ Validator = (*TCBConf)(nil)
+	_ Validator = (*LsoConf)(nil)
+	_ Validator = (*ColdGetConf)(nil)
+	_ Validator = (*ShedConf)(nil)
+	_ Validator = (*WatchdogConf)(nil)
+	_ Validator = (*MetricsConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
+	_ Validator = (*ProxyConf)(nil)
+	_ Validator = BucketProfilesConf(nil)
+	_ Validator = SLOConf(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
@@ -784,9 +1110,61 @@ func (c *LogConf) Validate() error {
 	if c.StatsTime.D() > 10*time.Minute {
 		return fmt.Errorf("invalid log.stats_time=%s (expected range [log.stats_time, 10m])", c.StatsTime)
 	}
+	switch c.Format {
+	case "":
+		c.Format = LogFormatText
+	case LogFormatText, LogFormatJSON:
+	default:
+		return fmt.Errorf("invalid log.format=%q (expected %q or %q)", c.Format, LogFormatText, LogFormatJSON)
+	}
+	return c.Modules.Validate()
+}
+
+////////////////////
+// LogModulesConf //
+////////////////////
+
+// log level aliases (numeric levels 1 through 5 are also accepted)
+var LogLevelNames = map[string]int{"info": 3, "debug": 4, "trace": 5}
+
+func (c *LogModulesConf) Validate() error {
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if _, err := ParseModLevel(v.Field(i).String()); err != nil {
+			return fmt.Errorf("invalid log.modules.%s: %v", cos.Smodules[i], err)
+		}
+	}
 	return nil
 }
 
+// Levels returns per-module levels indexed by module (see cos.Smodules); zero means not set
+func (c *LogModulesConf) Levels() (levels []int) {
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if s := v.Field(i).String(); s != "" {
+			if levels == nil {
+				levels = make([]int, v.NumField())
+			}
+			levels[i], _ = ParseModLevel(s)
+		}
+	}
+	return levels
+}
+
+func ParseModLevel(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if level, ok := LogLevelNames[s]; ok {
+		return level, nil
+	}
+	level, err := strconv.Atoi(s)
+	if err != nil || level < 1 || level > 5 {
+		return 0, fmt.Errorf("%q (expecting 1 to 5, or one of: info, debug, trace)", s)
+	}
+	return level, nil
+}
+
 ////////////////
 // ClientConf //
 ////////////////
@@ -804,6 +1182,25 @@ func (c *ClientConf) Validate() error {
 	return nil
 }
 
+///////////////
+// ProxyConf //
+///////////////
+
+func (c *ProxyConf) Validate() error {
+	if c.ReadOnly && !c.NonElectable {
+		return errors.New("invalid proxy.read_only=true: read-only proxy must be non-electable (proxy.non_electable=true)")
+	}
+	if c.MaxConns < 0 || c.MaxClientConns < 0 {
+		return fmt.Errorf("invalid proxy.max_conns=%d or proxy.max_client_conns=%d (expecting non-negative)",
+			c.MaxConns, c.MaxClientConns)
+	}
+	if c.MaxConns > 0 && c.MaxClientConns > c.MaxConns {
+		return fmt.Errorf("invalid proxy.max_client_conns=%d: cannot exceed proxy.max_conns=%d",
+			c.MaxClientConns, c.MaxConns)
+	}
+	return nil
+}
+
 /////////////////
 // BackendConf //
 /////////////////
@@ -882,7 +1279,7 @@ func (c *BackendConf) Validate() (err error) {
 func (c *BackendConf) setProvider(provider string) {
 	var ns Ns
 	switch provider {
-	case apc.AWS, apc.Azure, apc.GCP, apc.HDFS:
+	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HDFS:
 		ns = NsGlobal
 	default:
 		debug.Assert(false, "unknown backend provider "+provider)
@@ -958,6 +1355,10 @@ func (c BackendConfAIS) String() (s string) {
 // DiskConf //
 //////////////
 
+// default blkio weight of the cgroup that runs background jobs
+// (compare with the kernel defaults: 500 (CFQ) and 100 (BFQ) - foreground)
+const DefaultBgIOWeight = 50
+
 func (c *DiskConf) Validate() (err error) {
 	lwm, hwm, maxwm := c.DiskUtilLowWM, c.DiskUtilHighWM, c.DiskUtilMaxWM
 	if lwm <= 0 || hwm <= lwm || maxwm <= hwm || maxwm > 100 {
@@ -973,6 +1374,15 @@ func (c *DiskConf) Validate() (err error) {
 		return fmt.Errorf("disk.iostat_time_long %v shorter than disk.iostat_time_short %v",
 			c.IostatTimeLong, c.IostatTimeShort)
 	}
+	if c.BgIOWeight == 0 {
+		c.BgIOWeight = DefaultBgIOWeight // (older configs)
+	}
+	if c.BgIOWeight < 10 || c.BgIOWeight > 1000 {
+		return fmt.Errorf("invalid disk.bg_io_weight: %d (expecting [10, 1000])", c.BgIOWeight)
+	}
+	if c.BgCgroup != "" && (strings.Contains(c.BgCgroup, "/") || c.BgCgroup == "." || c.BgCgroup == "..") {
+		return fmt.Errorf("invalid disk.bg_cgroup %q (expecting cgroup name, not path)", c.BgCgroup)
+	}
 	return nil
 }
 
@@ -1009,6 +1419,9 @@ func (c *LRUConf) Validate() (err error) {
 	if c.CapacityUpdTime.D() < 10*time.Second {
 		err = fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
 	}
+	if c.VersionEvictTime < 0 {
+		err = fmt.Errorf("invalid lru.version_evict_time=%s (expecting non-negative)", c.VersionEvictTime)
+	}
 	return
 }
 
@@ -1056,6 +1469,15 @@ func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	if c.ValidateWarmGetIval < 0 {
+		return fmt.Errorf("invalid versioning.validate_warm_get_interval=%s (expecting non-negative)", c.ValidateWarmGetIval)
+	}
+	if c.Retain < 0 || c.Retain > MaxRetainVersions {
+		return fmt.Errorf("invalid versioning.retain=%d (expecting [0, %d] range)", c.Retain, MaxRetainVersions)
+	}
+	if !c.Enabled && c.Retain > 0 {
+		return errors.New("versioning.retain requires versioning to be enabled")
+	}
 	return nil
 }
 
@@ -1067,10 +1489,15 @@ func (c *VersionConf) String() string {
 	text := "Enabled | Validate on WarmGET: "
 	if c.ValidateWarmGet {
 		text += "yes"
+		if c.ValidateWarmGetIval > 0 {
+			text += " (every " + c.ValidateWarmGetIval.String() + ")"
+		}
 	} else {
 		text += "no"
 	}
-
+	if c.Retain > 0 {
+		text += " | Retain: " + strconv.Itoa(c.Retain)
+	}
 	return text
 }
 
@@ -1171,6 +1598,202 @@ func (c *ECConf) RequiredRestoreTargets() int {
 	return c.DataSlices
 }
 
+///////////////
+// DedupConf //
+///////////////
+
+const (
+	DefaultDedupChunkSize = cos.MiB
+	MinDedupChunkSize     = 4 * cos.KiB
+	MaxDedupChunkSize     = 16 * cos.MiB
+)
+
+func (c *DedupConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Chunking == "" {
+		c.Chunking = apc.DedupFixed
+	}
+	if !apc.IsValidDedupChunking(c.Chunking) {
+		return fmt.Errorf("invalid dedup.chunking %q (expecting one of %v)", c.Chunking, apc.SupportedDedupChunking)
+	}
+	if c.ChunkSize == 0 {
+		c.ChunkSize = DefaultDedupChunkSize
+	}
+	if c.ChunkSize < MinDedupChunkSize || c.ChunkSize > MaxDedupChunkSize {
+		return fmt.Errorf("invalid dedup.chunk_size %s (expecting value in range [%s, %s])", c.ChunkSize,
+			cos.ToSizeIEC(MinDedupChunkSize, 0), cos.ToSizeIEC(MaxDedupChunkSize, 0))
+	}
+	return nil
+}
+
+func (c *DedupConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	return fmt.Sprintf("%s (%s)", c.Chunking, c.ChunkSize)
+}
+
+//////////////
+// PackConf //
+//////////////
+
+const (
+	DefaultPackMaxObjSize    = 16 * cos.KiB
+	MaxPackMaxObjSize        = cos.MiB
+	DefaultPackContainerSize = 64 * cos.MiB
+	MinPackContainerSize     = cos.MiB
+	MaxPackContainerSize     = 4 * cos.GiB
+	DefaultPackCompactPct    = 50
+)
+
+func (c *PackConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxObjSize == 0 {
+		c.MaxObjSize = DefaultPackMaxObjSize
+	}
+	if c.ContainerSize == 0 {
+		c.ContainerSize = DefaultPackContainerSize
+	}
+	if c.CompactPct == 0 {
+		c.CompactPct = DefaultPackCompactPct
+	}
+	if c.MaxObjSize < 0 || c.MaxObjSize > MaxPackMaxObjSize {
+		return fmt.Errorf("invalid packing.max_obj_size %s (expecting value in range (0, %s])", c.MaxObjSize,
+			cos.ToSizeIEC(MaxPackMaxObjSize, 0))
+	}
+	if c.ContainerSize < MinPackContainerSize || c.ContainerSize > MaxPackContainerSize {
+		return fmt.Errorf("invalid packing.container_size %s (expecting value in range [%s, %s])", c.ContainerSize,
+			cos.ToSizeIEC(MinPackContainerSize, 0), cos.ToSizeIEC(MaxPackContainerSize, 0))
+	}
+	if c.MaxObjSize > c.ContainerSize {
+		return fmt.Errorf("invalid packing.max_obj_size %s (must not exceed container_size %s)", c.MaxObjSize, c.ContainerSize)
+	}
+	if c.CompactPct < 1 || c.CompactPct > 100 {
+		return fmt.Errorf("invalid packing.compact_pct %d (expecting value in range [1, 100])", c.CompactPct)
+	}
+	return nil
+}
+
+func (c *PackConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	return fmt.Sprintf("objects up to %s (container %s, compact at %d%%)", c.MaxObjSize, c.ContainerSize, c.CompactPct)
+}
+
+///////////////////
+// RateLimitConf //
+///////////////////
+
+func (c *RateLimitConf) ValidateAsProps(...any) error {
+	if c.GetRPS < 0 || c.PutRPS < 0 || c.GetBPS < 0 || c.PutBPS < 0 {
+		return fmt.Errorf("invalid rate_limit %+v: expecting non-negative values", *c)
+	}
+	if c.Enabled && c.GetRPS == 0 && c.PutRPS == 0 && c.GetBPS == 0 && c.PutBPS == 0 {
+		return errors.New("invalid rate_limit: enabled but none of the limits is set (get_rps, get_bps, put_rps, put_bps)")
+	}
+	return nil
+}
+
+func (c *RateLimitConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	f := func(rps int, bps cos.SizeIEC) string {
+		s := "unlimited"
+		if rps > 0 {
+			s = strconv.Itoa(rps) + " req/s"
+		}
+		if bps > 0 {
+			if rps > 0 {
+				s += ", "
+			} else {
+				s = ""
+			}
+			s += cos.ToSizeIEC(int64(bps), 0) + "/s"
+		}
+		return s
+	}
+	return "GET: " + f(c.GetRPS, c.GetBPS) + "; PUT: " + f(c.PutRPS, c.PutBPS)
+}
+
+////////////////
+// BckETLConf //
+////////////////
+
+func (c *BckETLConf) ValidateAsProps(...any) error {
+	if c.ID == "" {
+		return nil
+	}
+	if err := k8s.ValidateEtlName(c.ID); err != nil {
+		return fmt.Errorf("invalid etl.id: %w", err)
+	}
+	return nil
+}
+
+func (c *BckETLConf) String() string {
+	if c.ID == "" {
+		return "-"
+	}
+	return c.ID
+}
+
+//////////////
+// ReplConf //
+//////////////
+
+const (
+	DefaultReplQueueSize = 4096
+	MaxReplQueueSize     = 1024 * 1024
+)
+
+func (c *ReplConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.QueueSize == 0 {
+		c.QueueSize = DefaultReplQueueSize
+	}
+	if c.QueueSize < 0 || c.QueueSize > MaxReplQueueSize {
+		return fmt.Errorf("invalid replication.queue_size %d (expecting range [1, %d])", c.QueueSize, MaxReplQueueSize)
+	}
+	if !apc.IsValidReplConflict(c.Conflict) {
+		return fmt.Errorf("invalid replication.conflict %q (expecting one of: %v)", c.Conflict, apc.SupportedReplConflict)
+	}
+	if c.Bidir && c.Conflict == "" {
+		c.Conflict = apc.ReplConflictNewer
+	}
+	_, err := c.DstBck()
+	return err
+}
+
+// destination must be an ais bucket in a remote (attached) ais cluster
+func (c *ReplConf) DstBck() (bck Bck, err error) {
+	bck, _, err = ParseBckObjectURI(c.Dst, ParseURIOpts{DefaultProvider: apc.AIS})
+	if err != nil {
+		return bck, fmt.Errorf("invalid replication.dst %q: %v", c.Dst, err)
+	}
+	if !bck.IsRemoteAIS() {
+		return bck, fmt.Errorf("invalid replication.dst %q: expecting ais bucket in a remote ais cluster (e.g. \"ais://@remais/abc\")",
+			c.Dst)
+	}
+	return bck, bck.ValidateName()
+}
+
+func (c *ReplConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	if c.Bidir {
+		return "<=> " + c.Dst + " (conflict: " + c.Conflict + ")"
+	}
+	return "=> " + c.Dst
+}
+
 /////////////////////
 // WritePolicyConf //
 /////////////////////
@@ -1220,6 +1843,13 @@ func (c *NetConf) Validate() (err error) {
 	if c.HTTP.UseHTTPS {
 		c.HTTP.Proto = "https"
 	}
+	if c.HTTP.IdleConnsPerHost < 0 || c.HTTP.MaxIdleConns < 0 || c.HTTP.MaxConnsPerHost < 0 || c.HTTP.IdleConnTimeout < 0 {
+		return fmt.Errorf("invalid (negative) http connection pooling: %+v", c.HTTP)
+	}
+	if c.HTTP.MaxConnsPerHost > 0 && c.HTTP.IdleConnsPerHost > c.HTTP.MaxConnsPerHost {
+		return fmt.Errorf("invalid idle_conns_per_host %d (expecting <= max_conns_per_host %d)",
+			c.HTTP.IdleConnsPerHost, c.HTTP.MaxConnsPerHost)
+	}
 	if c.HTTP.ClientAuthTLS < int(tls.NoClientCert) || c.HTTP.ClientAuthTLS > int(tls.RequireAndVerifyClientCert) {
 		return fmt.Errorf("invalid client_auth_tls %d (expecting range [0 - %d])", c.HTTP.ClientAuthTLS,
 			tls.RequireAndVerifyClientCert)
@@ -1297,6 +1927,8 @@ func (c *LocalNetConfig) Validate(contextConfig *Config) (err error) {
 // DsortConf //
 ///////////////
 
+const MaxZstdLevel = 22 // (standard zstd levels)
+
 func (c *DsortConf) Validate() (err error) {
 	if c.SbundleMult < 0 || c.SbundleMult > 16 {
 		return fmt.Errorf("invalid distributed_sort.bundle_multiplier: %v (expected range [0, 16])", c.SbundleMult)
@@ -1313,6 +1945,9 @@ func (c *DsortConf) ValidateWithOpts(allowEmpty bool) (err error) {
 		return cos.StringInSlice(reaction, SupportedReactions) || (allowEmpty && reaction == "")
 	}
 
+	if c.ZstdLevel < 0 || c.ZstdLevel > MaxZstdLevel {
+		return fmt.Errorf("invalid distributed_sort.zstd_level: %d (expected range [0, %d])", c.ZstdLevel, MaxZstdLevel)
+	}
 	if !checkReaction(c.DuplicatedRecords) {
 		return fmt.Errorf("invalid distributed_sort.duplicated_records: %s (expecting one of: %s)",
 			c.DuplicatedRecords, SupportedReactions)
@@ -1540,6 +2175,170 @@ func (c *TCBConf) Validate() error {
 	return nil
 }
 
+/////////////
+// LsoConf //
+/////////////
+
+const (
+	DefaultLsoMaxPageSize        = 50000
+	DefaultLsoMaxPageSizeHigh    = 5000
+	DefaultLsoMaxPageSizeExtreme = 1000
+
+	MaxLsoPageSize = 100000
+)
+
+func (c *LsoConf) Validate() error {
+	// (older configs)
+	if c.MaxPageSize == 0 {
+		c.MaxPageSize = DefaultLsoMaxPageSize
+	}
+	if c.MaxPageSizeHigh == 0 {
+		c.MaxPageSizeHigh = min(c.MaxPageSize, DefaultLsoMaxPageSizeHigh)
+	}
+	if c.MaxPageSizeExtreme == 0 {
+		c.MaxPageSizeExtreme = min(c.MaxPageSizeHigh, DefaultLsoMaxPageSizeExtreme)
+	}
+	if c.MaxPageSize > MaxLsoPageSize {
+		return fmt.Errorf("invalid list_objects.max_page_size: %d (expected range [1, %d])", c.MaxPageSize, MaxLsoPageSize)
+	}
+	if c.MaxPageSizeHigh > c.MaxPageSize {
+		return fmt.Errorf("invalid list_objects.max_page_size_high: %d (expected range [1, max_page_size=%d])",
+			c.MaxPageSizeHigh, c.MaxPageSize)
+	}
+	if c.MaxPageSizeExtreme > c.MaxPageSizeHigh {
+		return fmt.Errorf("invalid list_objects.max_page_size_extreme: %d (expected range [1, max_page_size_high=%d])",
+			c.MaxPageSizeExtreme, c.MaxPageSizeHigh)
+	}
+	return nil
+}
+
+/////////////////
+// ColdGetConf //
+/////////////////
+
+const (
+	DefaultColdGetMaxActive    = 256
+	DefaultColdGetMaxQueued    = 1024
+	DefaultColdGetQueueTimeout = 10 * time.Second
+
+	ColdGetNoQueue = -1 // max_queued: reject right away when there are max_active cold GETs
+)
+
+func (c *ColdGetConf) Validate() error {
+	// (older configs; note that zero is "unset" - use ColdGetNoQueue to disable queueing)
+	if c.MaxActive == 0 {
+		c.MaxActive = DefaultColdGetMaxActive
+	}
+	if c.MaxQueued == 0 {
+		c.MaxQueued = DefaultColdGetMaxQueued
+	}
+	if c.QueueTimeout == 0 {
+		c.QueueTimeout = cos.Duration(DefaultColdGetQueueTimeout)
+	}
+	if c.MaxActive < 0 {
+		return fmt.Errorf("invalid cold_get.max_active: %d (expecting positive integer)", c.MaxActive)
+	}
+	if c.MaxQueued < ColdGetNoQueue {
+		return fmt.Errorf("invalid cold_get.max_queued: %d (expecting positive integer or %d - no queueing)",
+			c.MaxQueued, ColdGetNoQueue)
+	}
+	if c.QueueTimeout < 0 {
+		return fmt.Errorf("invalid cold_get.queue_timeout: %v", c.QueueTimeout)
+	}
+	return nil
+}
+
+//////////////
+// ShedConf //
+//////////////
+
+const (
+	DefaultShedDelay = 500 * time.Millisecond
+	MaxShedDelay     = 10 * time.Second
+)
+
+func (c *ShedConf) Validate() error {
+	if c.Delay == 0 {
+		c.Delay = cos.Duration(DefaultShedDelay) // (older configs)
+	}
+	if c.Delay < 0 || c.Delay.D() > MaxShedDelay {
+		return fmt.Errorf("invalid shed.delay: %v (expecting (0, %v])", c.Delay, MaxShedDelay)
+	}
+	if c.MaxHeap < 0 {
+		return fmt.Errorf("invalid shed.max_heap: %d", c.MaxHeap)
+	}
+	return nil
+}
+
+//////////////////
+// WatchdogConf //
+//////////////////
+
+const (
+	DefaultWatchdogInterval = time.Minute
+	DefaultWatchdogFDsPct   = 80
+)
+
+func (c *WatchdogConf) Validate() error {
+	if c.Interval == 0 {
+		c.Interval = cos.Duration(DefaultWatchdogInterval) // (older configs)
+	}
+	if c.MaxFDsPct == 0 {
+		c.MaxFDsPct = DefaultWatchdogFDsPct // ditto
+	}
+	if c.Interval.D() < time.Second || c.Interval.D() > time.Hour {
+		return fmt.Errorf("invalid watchdog.interval: %v (expecting [1s, 1h])", c.Interval)
+	}
+	if c.MaxGoroutines < 0 || c.MaxSockets < 0 {
+		return fmt.Errorf("invalid watchdog.max_goroutines=%d or max_sockets=%d (expecting non-negative)",
+			c.MaxGoroutines, c.MaxSockets)
+	}
+	if c.MaxFDsPct < 1 || c.MaxFDsPct > 100 {
+		return fmt.Errorf("invalid watchdog.max_fds_pct: %d (expecting [1, 100])", c.MaxFDsPct)
+	}
+	return nil
+}
+
+/////////////////
+// MetricsConf //
+/////////////////
+
+const (
+	MetricsStatsD = "statsd"
+	MetricsProm   = "prometheus"
+)
+
+func (c *MetricsConf) Validate() error {
+	if c.Sinks == "" {
+		c.Sinks = MetricsStatsD // (older configs)
+	}
+	var statsd, prom int
+	for _, sink := range strings.Split(c.Sinks, ",") {
+		switch strings.TrimSpace(sink) {
+		case MetricsStatsD:
+			statsd++
+		case MetricsProm:
+			prom++
+		default:
+			return fmt.Errorf("invalid metrics.sinks %q: expecting %q, %q, or both (comma-separated)",
+				c.Sinks, MetricsStatsD, MetricsProm)
+		}
+	}
+	if statsd > 1 || prom > 1 {
+		return fmt.Errorf("invalid metrics.sinks %q: duplicate sink", c.Sinks)
+	}
+	return nil
+}
+
+func (c *MetricsConf) Has(sink string) bool {
+	for _, s := range strings.Split(c.Sinks, ",") {
+		if strings.TrimSpace(s) == sink {
+			return true
+		}
+	}
+	return false
+}
+
 /////////////////
 // TimeoutConf //
 /////////////////
@@ -1565,6 +2364,9 @@ func (c *TimeoutConf) Validate() error {
 	if c.SendFile.D() < time.Minute {
 		return fmt.Errorf("invalid timeout.send_file_time=%s (cannot be less than 1m)", c.SendFile)
 	}
+	if c.ObjGet < 0 || c.ObjPut < 0 || c.ObjHead < 0 {
+		return fmt.Errorf("invalid (negative) timeout.object_*_time: get=%s, put=%s, head=%s", c.ObjGet, c.ObjPut, c.ObjHead)
+	}
 	return nil
 }
 
@@ -1583,6 +2385,12 @@ func (c *DownloaderConf) Validate() error {
 // RebalanceConf //
 ///////////////////
 
+// number of virtual partitions (when enabled)
+const (
+	MinPartitions = 16
+	MaxPartitions = 64 * 1024
+)
+
 func (c *RebalanceConf) Validate() error {
 	if j := c.DestRetryTime.D(); j < time.Second || j > 10*time.Minute {
 		return fmt.Errorf("invalid rebalance.dest_retry_time=%s (expected range [1s, 10m])", j)
@@ -1594,6 +2402,10 @@ func (c *RebalanceConf) Validate() error {
 		return fmt.Errorf("invalid rebalance.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
 	}
+	if c.Partitions != 0 && (c.Partitions < MinPartitions || c.Partitions > MaxPartitions) {
+		return fmt.Errorf("invalid rebalance.partitions: %d (expected 0 (disabled) or range [%d, %d])",
+			c.Partitions, MinPartitions, MaxPartitions)
+	}
 	return nil
 }
 
@@ -1613,6 +2425,115 @@ func (c *ResilverConf) String() string {
 	return "Disabled"
 }
 
+////////////////////////
+// BucketProfilesConf //
+////////////////////////
+
+// (value receiver - the map is visited as a leaf config field)
+func (c BucketProfilesConf) Validate() error {
+	for name, props := range c {
+		if !cos.IsAlphaPlus(name) {
+			return fmt.Errorf("invalid bucket profile name %q (use only letters, numbers, dashes (-), and underscores (_))",
+				name)
+		}
+		if props == nil {
+			return fmt.Errorf("bucket profile %q is empty", name)
+		}
+		if props.BackendBck != nil {
+			return fmt.Errorf("bucket profile %q: backend bucket cannot be part of a profile", name)
+		}
+		if props.EC != nil && props.Mirror != nil && props.EC.Enabled != nil && props.Mirror.Enabled != nil &&
+			*props.EC.Enabled && *props.Mirror.Enabled {
+			return fmt.Errorf("bucket profile %q: cannot enable mirroring and ec at the same time", name)
+		}
+	}
+	return nil
+}
+
+// Get returns a copy of the named profile, or nil if the profile does not exist
+func (c BucketProfilesConf) Get(name string) *BpropsToSet {
+	props, ok := c[name]
+	if !ok {
+		return nil
+	}
+	clone := &BpropsToSet{}
+	cos.MustMorphMarshal(props, clone)
+	return clone
+}
+
+func (c BucketProfilesConf) String() string {
+	if len(c) == 0 {
+		return "-"
+	}
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+/////////////
+// SLOConf //
+/////////////
+
+// (value receiver - ditto)
+func (c SLOConf) Validate() error {
+	for name, m := range c {
+		if !cos.IsAlphaPlus(name) {
+			return fmt.Errorf("invalid SLO monitor name %q (use only letters, numbers, dashes (-), and underscores (_))",
+				name)
+		}
+		if m == nil {
+			return fmt.Errorf("SLO monitor %q is empty", name)
+		}
+		switch {
+		case strings.HasSuffix(m.Metric, ".ns"):
+			if m.MaxAvgLatency <= 0 || m.MaxErrPct != 0 {
+				return fmt.Errorf("SLO monitor %q: latency metric %q requires (positive) max_avg_latency and no max_err_pct",
+					name, m.Metric)
+			}
+		case strings.HasSuffix(m.Metric, ".n") && !strings.HasPrefix(m.Metric, "err."):
+			if m.MaxErrPct <= 0 || m.MaxErrPct > 100 || m.MaxAvgLatency != 0 {
+				return fmt.Errorf("SLO monitor %q: count metric %q requires max_err_pct in the range (0, 100] and no max_avg_latency",
+					name, m.Metric)
+			}
+		default:
+			return fmt.Errorf("SLO monitor %q: invalid metric %q (expecting latency (\".ns\") or count (\".n\") metric, e.g. \"get.ns\" or \"get.n\")",
+				name, m.Metric)
+		}
+	}
+	return nil
+}
+
+// evaluated statistic: "avg" (latency) or "err_pct" (count)
+func (m *SLOMonitor) Stat() string {
+	if m.MaxAvgLatency > 0 {
+		return "avg"
+	}
+	return "err_pct"
+}
+
+// threshold, e.g. "50ms" or "0.1%"
+func (m *SLOMonitor) Threshold() string {
+	if m.MaxAvgLatency > 0 {
+		return m.MaxAvgLatency.String()
+	}
+	return strconv.FormatFloat(m.MaxErrPct, 'f', -1, 64) + "%"
+}
+
+func (c SLOConf) String() string {
+	if len(c) == 0 {
+		return "-"
+	}
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
 ////////////////////
 // ConfigToSet //
 ////////////////////
@@ -1621,7 +2542,8 @@ func (c *ResilverConf) String() string {
 func (ctu *ConfigToSet) FillFromQuery(query url.Values) error {
 	var anyExists bool
 	for key := range query {
-		if key == apc.ActTransient {
+		switch key {
+		case apc.ActTransient, apc.QparamStage, apc.QparamApplyTo:
 			continue
 		}
 		anyExists = true
@@ -1657,6 +2579,67 @@ func (ctu *ConfigToSet) FillFromKVS(kvs []string) (err error) {
 	return
 }
 
+// Names returns (sorted) names of the properties that are set - e.g., the values
+// that a given node overrides (see apc.WhatNodeOverride)
+func (ctu *ConfigToSet) Names() (names []string) {
+	namesToSet("", reflect.ValueOf(ctu).Elem(), &names)
+	sort.Strings(names)
+	return names
+}
+
+// (nested *ToSet structs are traversed; any other non-nil pointer is a leaf)
+func namesToSet(prefix string, v reflect.Value, names *[]string) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Ptr || f.IsNil() {
+			continue
+		}
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if elem := f.Elem(); elem.Kind() == reflect.Struct && strings.HasSuffix(elem.Type().Name(), "ToSet") {
+			namesToSet(prefix+name+IterFieldNameSepa, elem, names)
+			continue
+		}
+		*names = append(*names, prefix+name)
+	}
+}
+
+//
+// staged config update: per-node changes that a given update would make (see apc.QparamStage)
+//
+
+type (
+	ConfigChange struct {
+		Name string `json:"name"`
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+	// node ID => changes (nodes that would not change are omitted)
+	StagedConfig map[string][]ConfigChange
+)
+
+// DiffClusterConfig compares the (leaf) values of two configs;
+// returns changes sorted by name
+func DiffClusterConfig(from, to *ClusterConfig) (changes []ConfigChange) {
+	values := make(cos.StrKVs, 128)
+	err := IterFields(from, func(tag string, field IterField) (error, bool) {
+		values[tag] = fmt.Sprintf("%v", field.Value()) // (not field.String() - string-derived types)
+		return nil, false
+	})
+	debug.AssertNoErr(err)
+	err = IterFields(to, func(tag string, field IterField) (error, bool) {
+		if v := fmt.Sprintf("%v", field.Value()); v != values[tag] {
+			changes = append(changes, ConfigChange{Name: tag, From: values[tag], To: v})
+		}
+		return nil, false
+	})
+	debug.AssertNoErr(err)
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes
+}
+
 //
 // misc config utils
 //
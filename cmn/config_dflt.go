@@ -0,0 +1,248 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"strings"
+	"text/template"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// initial (plain-text) cluster configuration - same defaults as deploy/dev/local/aisnode_config.sh
+const dfltClusterConfTmpl = `{
+  "backend": {{.Backends}},
+  "mirror": {
+    "copies":       2,
+    "burst_buffer": 128,
+    "enabled":      false
+  },
+  "ec": {
+    "objsize_limit":  262144,
+    "compression":    "never",
+    "bundle_multiplier":  2,
+    "data_slices":    1,
+    "parity_slices":  1,
+    "enabled":    false,
+    "disk_only":    false
+  },
+  "log": {
+    "level":     "3",
+    "max_size":  "4mb",
+    "max_total": "128mb",
+    "flush_time": "40s",
+    "stats_time": "60s",
+    "format":     "text"
+  },
+  "periodic": {
+    "stats_time":        "10s",
+    "notif_time":        "30s",
+    "retry_sync_time":   "2s"
+  },
+  "timeout": {
+    "cplane_operation":     "2s",
+    "max_keepalive":        "4s",
+    "max_host_busy":        "20s",
+    "startup_time":         "1m",
+    "join_startup_time":    "3m",
+    "send_file_time":       "5m",
+    "object_get_time":      "0s",
+    "object_put_time":      "0s",
+    "object_head_time":     "0s"
+  },
+  "client": {
+    "client_timeout":      "10s",
+    "client_long_timeout": "10m",
+    "list_timeout":        "3m"
+  },
+  "proxy": {
+    "primary_url":   "{{.PrimaryURL}}",
+    "original_url":  "{{.PrimaryURL}}",
+    "discovery_url": "",
+    "non_electable": false,
+    "webui":         false
+  },
+  "space": {
+    "cleanupwm":         65,
+    "lowwm":             75,
+    "highwm":            90,
+    "out_of_space":      95
+  },
+  "lru": {
+    "dont_evict_time":   "120m",
+    "version_evict_time": "0s",
+    "capacity_upd_time": "10m",
+    "enabled":           true
+  },
+  "disk":{
+      "iostat_time_long":  "2s",
+      "iostat_time_short": "100ms",
+      "disk_util_low_wm":  20,
+      "disk_util_high_wm": 80,
+      "disk_util_max_wm":  95,
+      "bg_cgroup":         "",
+      "bg_io_weight":      50
+  },
+  "rebalance": {
+    "dest_retry_time":  "2m",
+    "compression":       "never",
+    "bundle_multiplier":  2,
+    "enabled":           true,
+    "capacity_weights":  false,
+    "partitions":    0
+  },
+  "resilver": {
+    "enabled": true
+  },
+  "checksum": {
+    "type":      "xxhash",
+    "validate_cold_get":  false,
+    "validate_warm_get":  false,
+    "validate_obj_move":  false,
+    "enable_read_range":  false
+  },
+  "transport": {
+    "max_header":    4096,
+    "burst_buffer":    512,
+    "idle_teardown":  "4s",
+    "quiescent":    "10s",
+    "lz4_block":    "256kb",
+    "lz4_frame_checksum":  false
+  },
+  "memsys": {
+    "min_free":    "2gb",
+    "default_buf":    "32kb",
+    "to_gc":    "2gb",
+    "hk_time":    "90s",
+    "min_pct_total":  0,
+    "min_pct_free":    0
+  },
+  "versioning": {
+    "enabled":           true,
+    "validate_warm_get": false,
+    "retain":            0
+  },
+  "net": {
+    "l4": {
+      "proto":              "tcp",
+      "sndrcv_buf_size":    131072
+    },
+    "http": {
+      "use_https":         false,
+      "server_crt":        "server.crt",
+      "server_key":        "server.key",
+      "domain_tls":        "localhost",
+      "client_ca_tls":     "",
+      "client_auth_tls":   0,
+      "write_buffer_size": 0,
+      "read_buffer_size":  0,
+      "idle_conns_per_host": 0,
+      "max_idle_conns":      0,
+      "max_conns_per_host":  0,
+      "idle_conn_timeout":   "0s",
+      "http2":               false,
+      "chunked_transfer":  true,
+      "skip_verify":       false
+    }
+  },
+  "fshc": {
+    "enabled":     true,
+    "test_files":  4,
+    "error_limit": 2
+  },
+  "auth": {
+    "secret":      "",
+    "enabled":     false
+  },
+  "keepalivetracker": {
+    "proxy": {
+      "interval": "10s",
+      "name":     "heartbeat",
+      "factor":   3
+    },
+    "target": {
+      "interval": "10s",
+      "name":     "heartbeat",
+      "factor":   3
+    },
+    "retry_factor":   4
+  },
+  "downloader": {
+    "timeout": "1h"
+  },
+  "distributed_sort": {
+    "duplicated_records":    "ignore",
+    "missing_shards":        "ignore",
+    "ekm_malformed_line":    "abort",
+    "ekm_missing_key":       "abort",
+    "default_max_mem_usage": "80%",
+    "call_timeout":          "10m",
+    "dsorter_mem_threshold": "100GB",
+    "compression":           "never",
+    "bundle_multiplier":   4
+  },
+  "tcb": {
+    "compression":    "never",
+    "bundle_multiplier":  2
+  },
+  "list_objects": {
+    "max_page_size":    50000,
+    "max_page_size_high":    5000,
+    "max_page_size_extreme":  1000
+  },
+  "cold_get": {
+    "max_active":    256,
+    "max_queued":    1024,
+    "queue_timeout":  "10s"
+  },
+  "shed": {
+    "max_heap":  "0",
+    "delay":  "500ms",
+    "enabled":  true
+  },
+  "watchdog": {
+    "interval":    "1m",
+    "max_goroutines":  0,
+    "max_sockets":    0,
+    "max_fds_pct":    80,
+    "enabled":    true
+  },
+  "metrics": {
+    "sinks":  "statsd"
+  },
+  "write_policy": {
+    "data": "",
+    "md": ""
+  },
+  "features": "0"
+}
+`
+
+// DefaultClusterConfig returns initial cluster configuration with the given primary URL
+// and (cloud) backends enabled - used to bootstrap new clusters
+// (see `ais cluster init` and `aisnode -standalone`)
+func DefaultClusterConfig(primaryURL string, backends ...string) (*ClusterConfig, error) {
+	var (
+		sb      strings.Builder
+		cc      = &ClusterConfig{}
+		backend = make(map[string]struct{}, len(backends))
+	)
+	for _, provider := range backends {
+		backend[provider] = struct{}{}
+	}
+	b, err := jsoniter.Marshal(backend)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := template.Must(template.New("config").Parse(dfltClusterConfTmpl))
+	if err := tmpl.Execute(&sb, map[string]string{"Backends": string(b), "PrimaryURL": primaryURL}); err != nil {
+		return nil, err
+	}
+	if err := jsoniter.UnmarshalFromString(sb.String(), cc); err != nil {
+		return nil, err
+	}
+	return cc, nil
+}
@@ -20,4 +20,5 @@ const (
 	CtxReadWrapper contextID = "readWrapper" // context key for ReadWrapperFunc
 	CtxSetSize     contextID = "setSize"     // context key for SetSizeFunc
 	CtxOriginalURL contextID = "origURL"     // context key for OriginalURL for HTTP cloud
+	CtxReqID       contextID = "reqID"       // context key for request ID (HdrReqID) to forward to remote backends
 )
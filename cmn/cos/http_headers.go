@@ -33,13 +33,23 @@ const (
 	HdrContentType        = "Content-Type"
 	HdrContentTypeOptions = "X-Content-Type-Options"
 	HdrContentLength      = "Content-Length"
+	HdrContentMD5         = "Content-MD5" // base64-encoded (Ref: https://www.rfc-editor.org/rfc/rfc1864)
 
 	// misc. gen
-	HdrUserAgent = "User-Agent"
-	HdrAccept    = "Accept"
-	HdrLocation  = "Location"
-	HdrServer    = "Server"
-	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrUserAgent  = "User-Agent"
+	HdrAccept     = "Accept"
+	HdrLocation   = "Location"
+	HdrServer     = "Server"
+	HdrETag       = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrConnection = "Connection"
+
+	HdrRetryAfter = "Retry-After" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Retry-After
+
+	// (de facto standard) request correlation ID: generated by the client (see api.SetAuxHeaders)
+	// or else by the first aistore node that handles the request; propagated via redirects,
+	// intra-cluster control messages, xaction notifications, and (some) remote backends;
+	// included in ErrHTTP, error logs, and responses
+	HdrReqID = "X-Request-Id"
 )
 
 //
@@ -7,6 +7,7 @@ package cos
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -36,6 +37,12 @@ type (
 		io.ReadCloser
 		Open() (ReadOpenCloser, error)
 	}
+	// LomReader reads object content: plain file (FileHandle) or otherwise (see core.LOM.NewHandle)
+	LomReader interface {
+		ReadOpenCloser
+		io.ReaderAt
+		io.Seeker
+	}
 	// ReadSizer is the interface that adds Size method to io.Reader.
 	ReadSizer interface {
 		io.Reader
@@ -55,6 +62,11 @@ type (
 		io.Reader
 		size int64
 	}
+	// ctxReader fails reading once the context is done (canceled or past its deadline)
+	ctxReader struct {
+		io.ReadCloser
+		ctx context.Context
+	}
 
 	// implementations
 
@@ -66,6 +78,10 @@ type (
 		io.Reader
 		io.ReaderAt
 	}
+	ReadSeekerAt interface {
+		io.ReadSeeker
+		io.ReaderAt
+	}
 	deferRCS struct {
 		ReadCloseSizer
 		cb func()
@@ -147,8 +163,10 @@ type (
 var (
 	_ io.Reader      = (*nopReader)(nil)
 	_ ReadOpenCloser = (*FileHandle)(nil)
+	_ LomReader      = (*FileHandle)(nil)
 	_ ReadOpenCloser = (*CallbackROC)(nil)
 	_ ReadSizer      = (*sizedReader)(nil)
+	_ io.ReadCloser  = (*ctxReader)(nil)
 	_ ReadOpenCloser = (*SectionHandle)(nil)
 	_ ReadOpenCloser = (*FileSectionHandle)(nil)
 	_ ReadOpenCloser = (*nopOpener)(nil)
@@ -222,6 +240,25 @@ func (f *FileHandle) Open() (ReadOpenCloser, error) {
 func NewSizedReader(r io.Reader, size int64) ReadSizer { return &sizedReader{r, size} }
 func (f *sizedReader) Size() int64                     { return f.size }
 
+///////////////
+// ctxReader //
+///////////////
+
+// returns the original reader when the context cannot be canceled
+func NewCtxReader(ctx context.Context, r io.ReadCloser) io.ReadCloser {
+	if ctx.Done() == nil {
+		return r
+	}
+	return &ctxReader{r, ctx}
+}
+
+func (r *ctxReader) Read(b []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.ReadCloser.Read(b)
+}
+
 //////////////
 // deferRCS //
 //////////////
@@ -164,6 +164,50 @@ func (pt *ParsedTemplate) Next() (string, bool) {
 	return pt.buf.String(), true
 }
 
+// Match returns true if the name belongs to the set of names generated by the template
+// (that is, would be returned by `Next`); template with no ranges is treated as a prefix.
+// Unlike iteration, matching does not modify the template and is safe for concurrent use.
+func (pt *ParsedTemplate) Match(name string) bool {
+	if !strings.HasPrefix(name, pt.Prefix) {
+		return false
+	}
+	if len(pt.Ranges) == 0 {
+		return true
+	}
+	return pt.match(name[len(pt.Prefix):], 0)
+}
+
+func (pt *ParsedTemplate) match(s string, i int) bool {
+	if i == len(pt.Ranges) {
+		return s == ""
+	}
+	tr := &pt.Ranges[i]
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	// the gap itself may start with digits - try all candidate lengths, longest first
+	for l := n; l > 0; l-- {
+		if !tr.matchDigits(s[:l]) {
+			continue
+		}
+		rest := s[l:]
+		if strings.HasPrefix(rest, tr.Gap) && pt.match(rest[len(tr.Gap):], i+1) {
+			return true
+		}
+	}
+	return false
+}
+
+func (tr *TemplateRange) matchDigits(digits string) bool {
+	v, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || v < tr.Start || v > tr.End || (v-tr.Start)%tr.Step != 0 {
+		return false
+	}
+	// must be formatted exactly as in `Next` (zero-padding included)
+	return fmt.Sprintf("%0*d", tr.DigitCount, v) == digits
+}
+
 //
 // parsing --- parsing --- parsing
 //
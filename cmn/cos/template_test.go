@@ -320,5 +320,24 @@ var _ = Describe("Template", func() {
 				"prefix-0010-gap-1-suffix", "prefix-0012-gap-1-suffix",
 			),
 		)
+
+		DescribeTable("match method",
+			func(template, name string, expected bool) {
+				pt, err := cos.NewParsedTemplate(template)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pt.Match(name)).To(Equal(expected))
+			},
+			Entry("in range", "prefix-{0010..0013..2}-suffix", "prefix-0012-suffix", true),
+			Entry("off step", "prefix-{0010..0013..2}-suffix", "prefix-0011-suffix", false),
+			Entry("out of range", "prefix-{0010..0013..2}-suffix", "prefix-0014-suffix", false),
+			Entry("wrong padding", "prefix-{0010..0013..2}-suffix", "prefix-10-suffix", false),
+			Entry("wrong suffix", "prefix-{0010..0013..2}-suffix", "prefix-0010-suffi", false),
+			Entry("multi-range", "prefix-{0010..0013..2}-gap-{1..2}-suffix", "prefix-0012-gap-2-suffix", true),
+			Entry("gap starting with digits", "a-{1..20}0b", "a-100b", true),
+			Entry("at template", "shard-@999.tar", "shard-123.tar", true),
+			Entry("fmt template", "shard-%03d.tar", "shard-007.tar", true),
+			Entry("no ranges (prefix)", "dir/shard-", "dir/shard-anything", true),
+			Entry("no ranges (no match)", "dir/shard-", "dir/other", false),
+		)
 	})
 })
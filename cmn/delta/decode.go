@@ -0,0 +1,133 @@
+// Package delta: rsync-like delta encoding - signatures, rolling checksum, encode and apply
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package delta
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type (
+	Header struct {
+		Base      Base
+		BlockSize int64
+		Size      int64 // new size or -1 when unknown
+	}
+	// Reader reconstructs the new content from the base and the delta
+	Reader struct {
+		base    io.ReaderAt
+		br      *bufio.Reader
+		Hdr     Header
+		nblocks int64
+		off     int64 // opCopy: current offset in the base
+		rem     int64 // remaining bytes in the current op
+		op      byte
+		done    bool
+	}
+)
+
+var errNoBase = errors.New("delta: base is not set")
+
+// NewReader reads and validates the delta header; the caller then must
+// validate the base (see Header.Base) and provide it via SetBase
+func NewReader(r io.Reader) (*Reader, error) {
+	dr := &Reader{br: bufio.NewReader(r)}
+	if err := readMagic(dr.br, deltaMagic); err != nil {
+		return nil, err
+	}
+	bs, err := readU64(dr.br)
+	if err != nil {
+		return nil, err
+	}
+	dr.Hdr.BlockSize = int64(bs)
+	if err := ValidateBlockSize(dr.Hdr.BlockSize); err != nil {
+		return nil, err
+	}
+	if err := readBase(dr.br, &dr.Hdr.Base); err != nil {
+		return nil, err
+	}
+	size, err := readU64(dr.br)
+	if err != nil {
+		return nil, err
+	}
+	dr.Hdr.Size = int64(size)
+	dr.nblocks = (dr.Hdr.Base.Size + dr.Hdr.BlockSize - 1) / dr.Hdr.BlockSize
+	return dr, nil
+}
+
+func (dr *Reader) SetBase(base io.ReaderAt) { dr.base = base }
+
+func (dr *Reader) Read(b []byte) (n int, err error) {
+	if dr.base == nil {
+		return 0, errNoBase
+	}
+	for dr.rem == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err = dr.next(); err != nil {
+			return 0, err
+		}
+	}
+	m := min(int64(len(b)), dr.rem)
+	switch dr.op {
+	case opCopy:
+		n, err = dr.base.ReadAt(b[:m], dr.off)
+		if err == io.EOF && int64(n) == m {
+			err = nil
+		}
+		dr.off += int64(n)
+	default:
+		n, err = dr.br.Read(b[:m])
+		err = _eof(err)
+	}
+	dr.rem -= int64(n)
+	return n, err
+}
+
+func (dr *Reader) next() error {
+	op, err := dr.br.ReadByte()
+	if err != nil {
+		return _eof(err)
+	}
+	switch op {
+	case opEnd:
+		dr.done = true
+	case opCopy:
+		idx, err := binary.ReadUvarint(dr.br)
+		if err != nil {
+			return _eof(err)
+		}
+		cnt, err := binary.ReadUvarint(dr.br)
+		if err != nil {
+			return _eof(err)
+		}
+		if cnt == 0 || idx >= uint64(dr.nblocks) || cnt > uint64(dr.nblocks)-idx {
+			return fmt.Errorf("delta: block range [%d, +%d) out of bounds (%d)", idx, cnt, dr.nblocks)
+		}
+		dr.off = int64(idx) * dr.Hdr.BlockSize
+		end := int64(idx+cnt) * dr.Hdr.BlockSize
+		if end > dr.Hdr.Base.Size {
+			end = dr.Hdr.Base.Size
+		}
+		dr.rem = end - dr.off
+	case opData:
+		l, err := binary.ReadUvarint(dr.br)
+		if err != nil {
+			return _eof(err)
+		}
+		if l == 0 {
+			return fmt.Errorf("delta: invalid literal length %d", l)
+		}
+		dr.rem = int64(l)
+	default:
+		return fmt.Errorf("delta: invalid op %d", op)
+	}
+	dr.op = op
+	return nil
+}
@@ -0,0 +1,230 @@
+// Package delta: rsync-like delta encoding - signatures, rolling checksum, encode and apply
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package delta
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+)
+
+// Delta wire format (all fixed-size integers big-endian):
+// | deltaMagic | block size (8) | Base | new size (8) | op ... | opEnd |
+// where:
+// - new size is the size of the new content, or -1 when unknown;
+// - op is either opCopy | uvarint(block index) | uvarint(number of consecutive blocks)
+//   or opData | uvarint(length) | literal bytes.
+
+const (
+	opEnd byte = iota
+	opCopy
+	opData
+)
+
+const maxLiteral = 1024 * 1024 // flush pending literal data at this size
+
+type (
+	Stats struct {
+		Copied  int64 // bytes referenced in the base object (not transferred)
+		Literal int64 // bytes transferred as is
+	}
+	encoder struct {
+		sig   *Sig
+		idx   map[uint32][]int64 // weak => full-size block indices
+		src   io.Reader
+		w     *bufio.Writer
+		buf   []byte
+		stats Stats
+		lit   int   // start of the pending literal data
+		pos   int   // start of the rolling window
+		hi    int   // end of data in buf
+		cpy   int64 // pending copy: first block
+		ncpy  int64 // pending copy: number of blocks
+		last  int64 // short last block (if any), or -1
+		bs    int
+		eof   bool
+	}
+)
+
+// Encode scans `src` and writes to `w` the delta between the base (described by `sig`) and
+// the new content; `size` is the size of the new content, or -1 when unknown.
+func Encode(w io.Writer, src io.Reader, sig *Sig, size int64) (Stats, error) {
+	var (
+		cnt = int64(len(sig.Blocks))
+		e   = &encoder{
+			sig:  sig,
+			idx:  make(map[uint32][]int64, cnt),
+			src:  src,
+			w:    bufio.NewWriter(w),
+			bs:   int(sig.BlockSize),
+			last: -1,
+		}
+		b8 [8]byte
+	)
+	for i := int64(0); i < cnt; i++ {
+		if blockLen(i, cnt, sig.BlockSize, sig.Base.Size) == sig.BlockSize {
+			wk := sig.Blocks[i].Weak
+			e.idx[wk] = append(e.idx[wk], i)
+		} else {
+			e.last = i
+		}
+	}
+	e.buf = make([]byte, 2*e.bs+maxLiteral)
+
+	e.w.WriteString(deltaMagic)
+	binary.BigEndian.PutUint64(b8[:], uint64(sig.BlockSize))
+	e.w.Write(b8[:])
+	writeBase(e.w, &sig.Base)
+	binary.BigEndian.PutUint64(b8[:], uint64(size))
+	e.w.Write(b8[:])
+
+	if err := e.run(); err != nil {
+		return e.stats, err
+	}
+	e.flushLit(e.hi)
+	e.flushCopy()
+	e.w.WriteByte(opEnd)
+	return e.stats, e.w.Flush()
+}
+
+func (e *encoder) run() error {
+	var (
+		a, s    uint32
+		rolling bool
+		n       = uint32(e.bs)
+	)
+	for {
+		if err := e.fill(); err != nil {
+			return err
+		}
+		if e.hi-e.pos < e.bs {
+			e.tail()
+			return nil
+		}
+		win := e.buf[e.pos : e.pos+e.bs]
+		if !rolling {
+			a, s = weak(win)
+			rolling = true
+		}
+		if j, ok := e.match(digest(a, s), win); ok {
+			e.flushLit(e.pos)
+			e.addCopy(j)
+			e.pos += e.bs
+			e.lit = e.pos
+			rolling = false
+			continue
+		}
+		// slide the window by one byte
+		if e.pos+e.bs < e.hi {
+			a, s = roll(a, s, e.buf[e.pos], e.buf[e.pos+e.bs], n)
+		} else {
+			rolling = false
+		}
+		e.pos++
+		if e.pos-e.lit >= maxLiteral {
+			e.flushLit(e.pos)
+		}
+	}
+}
+
+// make sure there's a full window (unless EOF); compact the buffer when needed
+func (e *encoder) fill() error {
+	if e.eof || e.hi-e.pos >= e.bs {
+		return nil
+	}
+	if e.lit > 0 {
+		e.hi = copy(e.buf, e.buf[e.lit:e.hi])
+		e.pos -= e.lit
+		e.lit = 0
+	}
+	m, err := io.ReadAtLeast(e.src, e.buf[e.hi:], e.bs-(e.hi-e.pos))
+	e.hi += m
+	switch err {
+	case nil:
+	case io.EOF, io.ErrUnexpectedEOF:
+		e.eof = true
+	default:
+		return err
+	}
+	return nil
+}
+
+// the remaining (less than block size) bytes may still match the base's short last block
+func (e *encoder) tail() {
+	if e.last < 0 || e.pos == e.hi {
+		return
+	}
+	b := e.buf[e.pos:e.hi]
+	if int64(len(b)) != blockLen(e.last, int64(len(e.sig.Blocks)), e.sig.BlockSize, e.sig.Base.Size) {
+		return
+	}
+	blk := &e.sig.Blocks[e.last]
+	if a, s := weak(b); digest(a, s) != blk.Weak || strong(b) != blk.Strong {
+		return
+	}
+	e.flushLit(e.pos)
+	e.addCopy(e.last)
+	e.stats.Copied -= e.sig.BlockSize - int64(len(b))
+	e.pos = e.hi
+	e.lit = e.pos
+}
+
+func (e *encoder) match(wk uint32, win []byte) (int64, bool) {
+	cands, ok := e.idx[wk]
+	if !ok {
+		return 0, false
+	}
+	st := strong(win)
+	// prefer the block that continues the pending copy
+	next := e.cpy + e.ncpy
+	for _, j := range cands {
+		if j == next && e.ncpy > 0 && e.sig.Blocks[j].Strong == st {
+			return j, true
+		}
+	}
+	for _, j := range cands {
+		if e.sig.Blocks[j].Strong == st {
+			return j, true
+		}
+	}
+	return 0, false
+}
+
+func (e *encoder) addCopy(j int64) {
+	e.stats.Copied += e.sig.BlockSize
+	if e.ncpy > 0 && j == e.cpy+e.ncpy {
+		e.ncpy++
+		return
+	}
+	e.flushCopy()
+	e.cpy, e.ncpy = j, 1
+}
+
+func (e *encoder) flushCopy() {
+	if e.ncpy == 0 {
+		return
+	}
+	var b [2*binary.MaxVarintLen64 + 1]byte
+	b[0] = opCopy
+	l := 1 + binary.PutUvarint(b[1:], uint64(e.cpy))
+	l += binary.PutUvarint(b[l:], uint64(e.ncpy))
+	e.w.Write(b[:l])
+	e.ncpy = 0
+}
+
+// flush literal data buf[lit:end]
+func (e *encoder) flushLit(end int) {
+	if end <= e.lit {
+		return
+	}
+	e.flushCopy()
+	var b [binary.MaxVarintLen64 + 1]byte
+	b[0] = opData
+	l := 1 + binary.PutUvarint(b[1:], uint64(end-e.lit))
+	e.w.Write(b[:l])
+	e.w.Write(e.buf[e.lit:end])
+	e.stats.Literal += int64(end - e.lit)
+	e.lit = end
+}
@@ -0,0 +1,257 @@
+// Package delta: rsync-like delta encoding - signatures, rolling checksum, encode and apply
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package delta
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Delta sync in three steps:
+// 1. client requests the signature of the existing (base) object: rolling (weak) and strong checksums
+//    of all its fixed-size blocks;
+// 2. client scans the new content with a rolling window and encodes it as a sequence of
+//    "copy block(s)" and "literal data" ops (see Encode);
+// 3. target reconstructs the new content from the base object and the received ops (see Reader)
+//    and proceeds with a regular PUT - including checksum validation, versioning, and the rest of it.
+//
+// Signature wire format (all integers big-endian):
+// | sigMagic | block size (8) | Base | number of blocks (8) | { weak (4) | strong (16) } ... |
+
+const (
+	DefaultBlockSize = 64 * cos.KiB
+	MinBlockSize     = 512
+	MaxBlockSize     = 16 * cos.MiB
+
+	StrongSize = 16 // truncated sha256
+)
+
+const (
+	sigMagic   = "aisdsig1"
+	deltaMagic = "aisdlt01"
+	maxStrlen  = 1024
+)
+
+type (
+	// identifies the (version of the) base object that the signature was computed for
+	Base struct {
+		Version   string
+		CksumType string
+		CksumVal  string
+		Size      int64
+	}
+	Block struct {
+		Weak   uint32
+		Strong [StrongSize]byte
+	}
+	Sig struct {
+		Base      Base
+		Blocks    []Block
+		BlockSize int64
+	}
+)
+
+var errMagic = errors.New("delta: invalid magic")
+
+func ValidateBlockSize(bs int64) error {
+	if bs < MinBlockSize || bs > MaxBlockSize {
+		return fmt.Errorf("delta: invalid block size %d (expecting range [%s, %s])", bs,
+			cos.ToSizeIEC(MinBlockSize, 0), cos.ToSizeIEC(MaxBlockSize, 0))
+	}
+	return nil
+}
+
+func strong(b []byte) (s [StrongSize]byte) {
+	sum := sha256.Sum256(b)
+	copy(s[:], sum[:StrongSize])
+	return s
+}
+
+// rsync rolling checksum: a = sum(x[i]), b = sum((n-i) * x[i]), both mod 2^16
+func weak(b []byte) (a, s uint32) {
+	n := uint32(len(b))
+	for i, x := range b {
+		a += uint32(x)
+		s += (n - uint32(i)) * uint32(x)
+	}
+	return a & 0xffff, s & 0xffff
+}
+
+func roll(a, s uint32, out, in byte, n uint32) (uint32, uint32) {
+	a = (a - uint32(out) + uint32(in)) & 0xffff
+	s = (s - n*uint32(out) + a) & 0xffff
+	return a, s
+}
+
+func digest(a, s uint32) uint32 { return a | s<<16 }
+
+// NewSig reads the entire base object and computes its signature
+func NewSig(r io.Reader, base *Base, blockSize int64) (*Sig, error) {
+	if err := ValidateBlockSize(blockSize); err != nil {
+		return nil, err
+	}
+	var (
+		sig  = &Sig{Base: *base, BlockSize: blockSize}
+		buf  = make([]byte, blockSize)
+		size int64
+	)
+	sig.Blocks = make([]Block, 0, (base.Size+blockSize-1)/blockSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			a, s := weak(buf[:n])
+			sig.Blocks = append(sig.Blocks, Block{Weak: digest(a, s), Strong: strong(buf[:n])})
+			size += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if size != base.Size {
+		return nil, fmt.Errorf("delta: base size mismatch: read %d, expected %d", size, base.Size)
+	}
+	return sig, nil
+}
+
+func (sig *Sig) WriteTo(w io.Writer) (int64, error) {
+	var (
+		bw  = bufio.NewWriter(w)
+		b8  [8]byte
+		rec [4 + StrongSize]byte
+	)
+	// (bufio.Writer errors are sticky - checking once upon Flush)
+	bw.WriteString(sigMagic)
+	binary.BigEndian.PutUint64(b8[:], uint64(sig.BlockSize))
+	bw.Write(b8[:])
+	n := writeBase(bw, &sig.Base)
+	binary.BigEndian.PutUint64(b8[:], uint64(len(sig.Blocks)))
+	bw.Write(b8[:])
+	for i := range sig.Blocks {
+		binary.BigEndian.PutUint32(rec[:4], sig.Blocks[i].Weak)
+		copy(rec[4:], sig.Blocks[i].Strong[:])
+		bw.Write(rec[:])
+	}
+	n += int64(len(sigMagic)) + 16 + int64(len(sig.Blocks))*int64(len(rec))
+	return n, bw.Flush()
+}
+
+func ReadSig(r io.Reader) (*Sig, error) {
+	var (
+		br  = bufio.NewReader(r)
+		sig = &Sig{}
+		rec [4 + StrongSize]byte
+	)
+	if err := readMagic(br, sigMagic); err != nil {
+		return nil, err
+	}
+	bs, err := readU64(br)
+	if err != nil {
+		return nil, err
+	}
+	sig.BlockSize = int64(bs)
+	if err := ValidateBlockSize(sig.BlockSize); err != nil {
+		return nil, err
+	}
+	if err := readBase(br, &sig.Base); err != nil {
+		return nil, err
+	}
+	cnt, err := readU64(br)
+	if err != nil {
+		return nil, err
+	}
+	if expected := (sig.Base.Size + sig.BlockSize - 1) / sig.BlockSize; int64(cnt) != expected {
+		return nil, fmt.Errorf("delta: invalid signature: %d blocks, expected %d", cnt, expected)
+	}
+	sig.Blocks = make([]Block, cnt)
+	for i := range sig.Blocks {
+		if _, err := io.ReadFull(br, rec[:]); err != nil {
+			return nil, _eof(err)
+		}
+		sig.Blocks[i].Weak = binary.BigEndian.Uint32(rec[:4])
+		copy(sig.Blocks[i].Strong[:], rec[4:])
+	}
+	return sig, nil
+}
+
+// size of the i-th block (all blocks are full-size except, possibly, the last one)
+func blockLen(i, cnt, blockSize, size int64) int64 {
+	if i < cnt-1 {
+		return blockSize
+	}
+	return size - (cnt-1)*blockSize
+}
+
+//
+// wire helpers
+//
+
+func writeBase(bw *bufio.Writer, base *Base) (n int64) {
+	var b8 [8]byte
+	for _, s := range []string{base.Version, base.CksumType, base.CksumVal} {
+		binary.BigEndian.PutUint16(b8[:2], uint16(len(s)))
+		bw.Write(b8[:2])
+		bw.WriteString(s)
+		n += 2 + int64(len(s))
+	}
+	binary.BigEndian.PutUint64(b8[:], uint64(base.Size))
+	bw.Write(b8[:])
+	return n + 8
+}
+
+func readBase(br *bufio.Reader, base *Base) (err error) {
+	for _, ps := range []*string{&base.Version, &base.CksumType, &base.CksumVal} {
+		var b2 [2]byte
+		if _, err = io.ReadFull(br, b2[:]); err != nil {
+			return _eof(err)
+		}
+		l := int(binary.BigEndian.Uint16(b2[:]))
+		if l > maxStrlen {
+			return fmt.Errorf("delta: invalid base metadata length %d", l)
+		}
+		b := make([]byte, l)
+		if _, err = io.ReadFull(br, b); err != nil {
+			return _eof(err)
+		}
+		*ps = string(b)
+	}
+	size, err := readU64(br)
+	base.Size = int64(size)
+	return err
+}
+
+func readMagic(br *bufio.Reader, magic string) error {
+	var b [8]byte
+	if _, err := io.ReadFull(br, b[:]); err != nil {
+		return _eof(err)
+	}
+	if string(b[:]) != magic {
+		return errMagic
+	}
+	return nil
+}
+
+func readU64(br *bufio.Reader) (uint64, error) {
+	var b8 [8]byte
+	if _, err := io.ReadFull(br, b8[:]); err != nil {
+		return 0, _eof(err)
+	}
+	return binary.BigEndian.Uint64(b8[:]), nil
+}
+
+func _eof(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -58,6 +58,7 @@ type (
 		RemoteAddr string `json:"remote_addr"`
 		Caller     string `json:"caller"`
 		Node       string `json:"node"`
+		ReqID      string `json:"reqid,omitempty"` // cos.HdrReqID
 		trace      []byte
 		Status     int `json:"status"`
 	}
@@ -100,8 +101,13 @@ type (
 		cleanupWM      int64
 		usedPct        int32
 		oos            bool
+		inodes         bool // usedPct: inodes
 	}
 	ErrBucketAccessDenied struct{ errAccessDenied }
+	ErrBucketFrozen       struct {
+		bck       string
+		operation string
+	}
 	ErrObjectAccessDenied struct{ errAccessDenied }
 	errAccessDenied       struct {
 		entity      string
@@ -386,6 +392,16 @@ func NewBucketAccessDenied(bucket, oper string, aattrs apc.AccessAttrs) *ErrBuck
 	return &ErrBucketAccessDenied{errAccessDenied{bucket, oper, aattrs}}
 }
 
+// ErrBucketFrozen
+
+func NewErrBucketFrozen(bucket, oper string) *ErrBucketFrozen {
+	return &ErrBucketFrozen{bck: bucket, operation: oper}
+}
+
+func (e *ErrBucketFrozen) Error() string {
+	return fmt.Sprintf("bucket %s is frozen (read-only): %s denied", e.bck, e.operation)
+}
+
 func (e *ErrObjectAccessDenied) Error() string {
 	return "object " + e.String()
 }
@@ -396,7 +412,8 @@ func NewObjectAccessDenied(object, oper string, aattrs apc.AccessAttrs) *ErrObje
 
 // ErrCapExceeded
 
-func NewErrCapExceeded(totalBytesUsed, totalBytes uint64, highWM, cleanupWM int64, usedPct int32, oos bool) *ErrCapExceeded {
+// (inodes: usedPct is the percentage of used inodes rather than bytes)
+func NewErrCapExceeded(totalBytesUsed, totalBytes uint64, highWM, cleanupWM int64, usedPct int32, oos, inodes bool) *ErrCapExceeded {
 	return &ErrCapExceeded{
 		totalBytes:     totalBytes, // avail + used
 		totalBytesUsed: totalBytesUsed,
@@ -404,24 +421,29 @@ func NewErrCapExceeded(totalBytesUsed, totalBytes uint64, highWM, cleanupWM int6
 		cleanupWM:      cleanupWM,
 		usedPct:        usedPct,
 		oos:            oos,
+		inodes:         inodes,
 	}
 }
 
 func (e *ErrCapExceeded) Error() string {
 	suffix := fmt.Sprintf("total used %s out of %s", cos.ToSizeIEC(int64(e.totalBytesUsed), 2),
 		cos.ToSizeIEC(int64(e.totalBytes), 2))
+	what, free := "capacity", "space"
+	if e.inodes {
+		what, free = "inodes", "inodes"
+	}
 	if e.oos {
-		return fmt.Sprintf("out of space: used %d%% of total capacity on at least one of the mountpaths (%s)",
-			e.usedPct, suffix)
+		return fmt.Sprintf("out of %s: used %d%% of total %s on at least one of the mountpaths (%s)",
+			free, e.usedPct, what, suffix)
 	}
 	if e.highWM == 0 {
 		debug.Assert(e.cleanupWM > 0)
-		return fmt.Sprintf("low on free space: used capacity %d%% exceeded cleanup watermark(%d%%) (%s)",
-			e.usedPct, e.cleanupWM, suffix)
+		return fmt.Sprintf("low on free %s: used %s %d%% exceeded cleanup watermark(%d%%) (%s)",
+			free, what, e.usedPct, e.cleanupWM, suffix)
 	}
 	debug.Assert(e.highWM > 0)
-	return fmt.Sprintf("low on free space: used capacity %d%% exceeded high watermark(%d%%) (%s)",
-		e.usedPct, e.highWM, suffix)
+	return fmt.Sprintf("low on free %s: used %s %d%% exceeded high watermark(%d%%) (%s)",
+		free, what, e.usedPct, e.highWM, suffix)
 }
 
 func IsErrCapExceeded(err error) bool {
@@ -873,6 +895,7 @@ func (e *ErrHTTP) init(r *http.Request, err error, errCode int) {
 		e.Method, e.URLPath = r.Method, r.URL.Path
 		e.RemoteAddr = r.RemoteAddr
 		e.Caller = r.Header.Get(apc.HdrCallerName)
+		e.ReqID = r.Header.Get(cos.HdrReqID)
 	}
 	e.Node = thisNodeName
 }
@@ -913,6 +936,9 @@ func (e *ErrHTTP) StringEx() (s string) {
 	if e.Caller != "" {
 		s += " (called by " + e.Caller + ")"
 	}
+	if e.ReqID != "" {
+		s += " [reqid=" + e.ReqID + "]"
+	}
 	if len(e.trace) == 0 {
 		e._trace()
 	}
@@ -993,6 +1019,11 @@ func IsStatusNotFound(err error) (yes bool) {
 	return ok && herr.Status == http.StatusNotFound
 }
 
+func IsStatusPreconditionFailed(err error) (yes bool) {
+	herr, ok := err.(*ErrHTTP)
+	return ok && herr.Status == http.StatusPreconditionFailed
+}
+
 func IsStatusBadGateway(err error) (yes bool) {
 	herr, ok := err.(*ErrHTTP)
 	return ok && herr.Status == http.StatusBadGateway
@@ -28,6 +28,9 @@ const (
 	DontAllowPassingFQNtoETL  // do not allow passing fully-qualified name of a locally stored object to (local) ETL containers
 	IgnoreLimitedCoexistence  // run in presence of "limited coexistence" type conflicts (same as e.g. CopyBckMsg.Force but globally)
 	DisableFastColdGET        // use regular datapath to execute cold-GET operations
+	TrackObjectAccess         // track per-object access counts and last-access times (see api.GetBucketHeatmap)
+	S3ImportBucketACL         // when adding s3:// bucket to BMD, derive its access attributes from S3 bucket ACL and policy
+	AutoRedundancy            // ais bucket created without explicit props: select mirror or EC based on the number of targets
 )
 
 var All = []string{
@@ -41,6 +44,9 @@ var All = []string{
 	"Dont-Allow-Passing-FQN-to-ETL",
 	"Ignore-LimitedCoexistence-Conflicts",
 	"Disable-Fast-Cold-GET",
+	"Track-Object-Access",
+	"S3-Import-Bucket-ACL",
+	"Auto-Redundancy",
 }
 
 func (f Flags) IsSet(flag Flags) bool { return cos.BitFlags(f).IsSet(cos.BitFlags(flag)) }
@@ -18,6 +18,9 @@ const (
 	PlainGlobalConfig = "ais.json"
 	PlainLocalConfig  = "ais_local.json"
 
+	// plain-text node-local overrides, optional and user-managed (see ais/cfgwatch.go)
+	PlainOverrideConfig = "ais_override.json"
+
 	// versioned, replicated, and checksum-protected
 	GlobalConfig   = ".ais.conf"
 	OverrideConfig = ".ais.override_config"
@@ -33,6 +36,12 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// target: capacity history (ring buffer of per-mountpath capacity snapshots)
+	CapHistory = ".ais.caphist"
+
+	// target: per-bucket usage (GET/PUT bytes and counts) aggregated per calendar month
+	BckUsage = ".ais.bckusage"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
@@ -248,6 +248,10 @@ func copyProps(src, dst any, asType string) error {
 		if srcValField.IsNil() {
 			continue
 		}
+		if !dstValField.IsValid() {
+			// e.g., node-local override's FSP (LocalConfig) vs ClusterConfig - handled separately
+			continue
+		}
 		t, ok := dstVal.Type().FieldByName(fieldName)
 		debug.Assert(ok, fieldName)
 
@@ -48,6 +48,9 @@ type (
 		List(collection, pattern string) ([]string, error)
 		// Return subkeys with their values: map[key]value
 		GetAll(collection, pattern string) (map[string]string, error)
+		// Visit subkeys (and their values) in lexicographic order until the callback returns false:
+		// ascending - starting from the `from` key, inclusive; descending - starting from the last key
+		Iterate(collection, from string, desc bool, cb func(key, value string) bool) error
 	}
 )
 
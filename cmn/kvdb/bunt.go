@@ -152,6 +152,31 @@ func (bd *BuntDriver) DeleteCollection(collection string) error {
 	})
 }
 
+func (bd *BuntDriver) Iterate(collection, from string, desc bool, cb func(key, value string) bool) error {
+	var (
+		prefix = makePath(collection, "")
+		// (the smallest path that follows all the collection's subkeys)
+		bound = prefix[:len(prefix)-1] + string(prefix[len(prefix)-1]+1)
+	)
+	iter := func(path, val string) bool {
+		if path == bound {
+			return true
+		}
+		if !strings.HasPrefix(path, prefix) {
+			return false
+		}
+		_, key := ParsePath(path)
+		return key == "" || cb(key, val)
+	}
+	err := bd.driver.View(func(tx *buntdb.Tx) error {
+		if desc {
+			return tx.DescendLessOrEqual("", bound, iter)
+		}
+		return tx.AscendGreaterOrEqual("", makePath(collection, from), iter)
+	})
+	return buntToCommonErr(err, collection, "")
+}
+
 func (bd *BuntDriver) GetAll(collection, pattern string) (map[string]string, error) {
 	var (
 		values = make(map[string]string)
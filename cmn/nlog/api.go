@@ -36,6 +36,8 @@ func Errorf(format string, args ...any)   { log(sevErr, 0, format, args...) }
 
 func SetLogDirRole(dir, role string) { logDir, aisrole = dir, role }
 func SetTitle(s string)              { title = s }
+func SetNode(s string)               { node.Store(&s) }
+func SetJSON(v bool)                 { jsonFormat.Store(v) } // structured logging (see json.go)
 
 func InfoLogName() string { return sname() + ".INFO" }
 func ErrLogName() string  { return sname() + ".ERROR" }
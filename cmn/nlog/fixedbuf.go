@@ -38,6 +38,41 @@ func (fb *fixed) writeByte(c byte) {
 	}
 }
 
+// write quoted and escaped JSON string; long strings get truncated
+// so that the line can still be terminated (see closeJSON)
+func (fb *fixed) writeJSON(s string) {
+	const (
+		hex     = "0123456789abcdef"
+		reserve = 4 // closing quote, brace, and newline
+	)
+	fb.writeByte('"')
+	for i := 0; i < len(s); i++ {
+		if fb.avail() < reserve+6 {
+			break
+		}
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			fb.buf[fb.woff], fb.buf[fb.woff+1] = '\\', c
+			fb.woff += 2
+		case c == '\n':
+			fb.buf[fb.woff], fb.buf[fb.woff+1] = '\\', 'n'
+			fb.woff += 2
+		case c == '\t':
+			fb.buf[fb.woff], fb.buf[fb.woff+1] = '\\', 't'
+			fb.woff += 2
+		case c < 0x20:
+			fb.writeString(`\u00`)
+			fb.buf[fb.woff], fb.buf[fb.woff+1] = hex[c>>4], hex[c&0xf]
+			fb.woff += 2
+		default:
+			fb.buf[fb.woff] = c
+			fb.woff++
+		}
+	}
+	fb.writeByte('"')
+}
+
 func (fb *fixed) flush(file *os.File) (n int, err error) {
 	n, err = file.Write(fb.buf[:fb.woff])
 	if err != nil {
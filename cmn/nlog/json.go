@@ -0,0 +1,73 @@
+// Package nlog - aistore logger, provides buffering, timestamping, writing, and
+// flushing/syncing/rotating
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nlog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// structured (JSON) logging: one JSON object per line, e.g.:
+// {"level":"info","ts":"2024-05-07T01:08:49.290944Z","node":"t[irOcEVdK]","module":"cfgwatch","line":129,"msg":"..."}
+// - "node" is omitted until the node is initialized, "module" and "line" - for redacted modules (see redactFnames)
+// - timestamps are UTC
+
+const tsJSON = "2006-01-02T15:04:05.000000Z07:00"
+
+var sevJSON = []string{sevInfo: "info", sevWarn: "warning", sevErr: "error"}
+
+var (
+	jsonFormat atomic.Bool
+	node       atomic.Pointer[string]
+)
+
+func sprintfJSON(sev severity, depth int, format string, fb *fixed, args ...any) {
+	var msg string
+	if format == "" {
+		msg = fmt.Sprintln(args...)
+	} else {
+		msg = fmt.Sprintf(format, args...)
+	}
+	fn, ln, ok := caller(depth)
+	if _, redact := redactFnames[fn]; redact {
+		ok = false
+	}
+	openJSON(sevJSON[sev], time.Now(), fb)
+	if ok {
+		fb.writeString(`,"module":`)
+		fb.writeJSON(fn)
+		fb.writeString(`,"line":`)
+		fb.writeString(strconv.Itoa(ln))
+	}
+	closeJSON(msg, fb)
+}
+
+// log file header (see rotate)
+func headerJSON(msg string, now time.Time, fb *fixed) {
+	openJSON(sevJSON[sevInfo], now, fb)
+	closeJSON(msg, fb)
+}
+
+func openJSON(level string, now time.Time, fb *fixed) {
+	fb.writeString(`{"level":"`)
+	fb.writeString(level)
+	fb.writeString(`","ts":"`)
+	fb.writeString(now.UTC().Format(tsJSON))
+	fb.writeByte('"')
+	if s := node.Load(); s != nil {
+		fb.writeString(`,"node":`)
+		fb.writeJSON(*s)
+	}
+}
+
+func closeJSON(msg string, fb *fixed) {
+	fb.writeString(`,"msg":`)
+	fb.writeJSON(strings.TrimRight(msg, "\n"))
+	fb.writeString("}\n")
+}
@@ -228,11 +228,16 @@ func (nlog *nlog) rotate(now time.Time) (err error) {
 	nlog.erred.Store(false)
 	if title == "" {
 		line1 = "Started up at " + snow + ", " + s
-		_, err = nlog.file.WriteString(line1)
 	} else {
-		line1 = "Rotated at " + snow + ", " + s
-		nlog.file.WriteString(line1)
-		_, err = nlog.file.WriteString(title)
+		line1 = "Rotated at " + snow + ", " + s + title
+	}
+	if jsonFormat.Load() {
+		fb := alloc()
+		headerJSON(line1, now, fb)
+		_, err = nlog.file.Write(fb.buf[:fb.woff])
+		free(fb)
+	} else {
+		_, err = nlog.file.WriteString(line1)
 	}
 	return
 }
@@ -256,9 +261,9 @@ func logfname(tag string, t time.Time) (name, link string) {
 	return name, s + "." + tag
 }
 
-func formatHdr(s severity, depth int, fb *fixed) {
-	const char = "IWE"
-	_, fn, ln, ok := runtime.Caller(3 + depth)
+// returns caller's source file name without extension (aka module), and line
+func caller(depth int) (fn string, ln int, ok bool) {
+	_, fn, ln, ok = runtime.Caller(4 + depth)
 	if !ok {
 		return
 	}
@@ -269,6 +274,15 @@ func formatHdr(s severity, depth int, fb *fixed) {
 	if l := len(fn); l > 3 {
 		fn = fn[:l-3]
 	}
+	return
+}
+
+func formatHdr(s severity, depth int, fb *fixed) {
+	const char = "IWE"
+	fn, ln, ok := caller(depth)
+	if !ok {
+		return
+	}
 	fb.writeByte(char[s])
 	fb.writeByte(' ')
 	now := time.Now()
@@ -285,6 +299,10 @@ func formatHdr(s severity, depth int, fb *fixed) {
 }
 
 func sprintf(sev severity, depth int, format string, fb *fixed, args ...any) {
+	if jsonFormat.Load() {
+		sprintfJSON(sev, depth+1, format, fb, args...)
+		return
+	}
 	formatHdr(sev, depth+1, fb)
 	if format == "" {
 		fmt.Fprintln(fb, args...)
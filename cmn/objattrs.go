@@ -35,6 +35,29 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// bi-directional bucket replication: checksum of the content last known to be
+	// identical on both sides (see cmn.ReplConf)
+	ReplBaseObjMD = "repl_base"
+
+	// composite object: digest of the manifest that constitutes the object's content
+	// (see apc.CompositeManifest)
+	CompositeObjMD = "composite"
+
+	// marked stale by the out-of-band change detection (apc.ActCheckOOB);
+	// the value is the ID of the xaction that marked it
+	StaleObjMD = "stale"
+
+	// pinned object: not evicted by LRU and not validated against the remote backend
+	// (unless explicitly requested), see apc.ObjPropsToSet
+	PinnedObjMD = "pinned"
+
+	// provenance (see also SourceObjMD and OrigURLObjMD above):
+	// - kind and ID of the job (xaction) that created the object: download, dsort, ETL, copy, promote, etc.;
+	// - otherwise, the client that PUT the object: IP address and, if provided, User-Agent
+	ProvJobObjMD    = "prov_job"
+	ProvXactObjMD   = "prov_xid"
+	ProvClientObjMD = "prov_client"
+
 	// additional backend
 	LastModified = "LastModified"
 )
@@ -211,6 +234,35 @@ func (oa *ObjAttrs) FromHeader(hdr http.Header) (cksum *cos.Cksum) {
 	return
 }
 
+// user-defined metadata (PUT): apc.HdrObjMetaPrefix + key => custom key (lowercase) and value;
+// system-reserved keys (above) are rejected
+func (oa *ObjAttrs) UserMDFromHeader(hdr http.Header) error {
+	for name, vals := range hdr {
+		lname := strings.ToLower(name)
+		if !strings.HasPrefix(lname, apc.HdrObjMetaPrefix) {
+			continue
+		}
+		key := lname[len(apc.HdrObjMetaPrefix):]
+		if key == "" {
+			return fmt.Errorf("invalid user metadata header %q: missing key", name)
+		}
+		if IsSysObjMD(key) {
+			return fmt.Errorf("invalid user metadata key %q: reserved for system use", key)
+		}
+		oa.SetCustomKey(key, strings.Join(vals, ","))
+	}
+	return nil
+}
+
+func IsSysObjMD(key string) bool {
+	switch key {
+	case SourceObjMD, WebObjMD, VersionObjMD, CRC32CObjMD, MD5ObjMD, OrigURLObjMD, ReplBaseObjMD,
+		CompositeObjMD, StaleObjMD, PinnedObjMD, ProvJobObjMD, ProvXactObjMD, ProvClientObjMD:
+		return true
+	}
+	return strings.EqualFold(key, ETag) || strings.EqualFold(key, LastModified)
+}
+
 func (oa *ObjAttrs) FromLsoEntry(e *LsoEntry) {
 	oa.Size = e.Size
 	oa.Ver = e.Version
@@ -6,9 +6,11 @@
 package cmn
 
 import (
+	"math/bits"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 )
 
 // read-mostly and most often used timeouts: assign at startup to reduce the number of GCO.Get() calls
@@ -21,8 +23,12 @@ type readMostly struct {
 	}
 	features       feat.Flags
 	level, modules int
+	mlevels        [32]int8 // per-module levels (log.modules), indexed by module bit
+	mlset          bool     // any of the above set
 	testingEnv     bool
 	authEnabled    bool
+	capWeights     bool
+	partitions     int
 }
 
 var Rom readMostly
@@ -37,9 +43,22 @@ func (rom *readMostly) Set(cfg *ClusterConfig) {
 	rom.timeout.keepalive = cfg.Timeout.MaxKeepalive.D()
 	rom.features = cfg.Features
 	rom.authEnabled = cfg.Auth.Enabled
+	rom.capWeights = cfg.Rebalance.CapWeights
+	rom.partitions = cfg.Rebalance.Partitions
 
 	// pre-parse for FastV (below)
 	rom.level, rom.modules = cfg.Log.Level.Parse()
+	levels := cfg.Log.Modules.Levels()
+	for i := range rom.mlevels {
+		var l int8
+		if i < len(levels) {
+			l = int8(levels[i])
+		}
+		rom.mlevels[i] = l
+	}
+	rom.mlset = levels != nil
+
+	nlog.SetJSON(cfg.Log.Format == LogFormatJSON)
 }
 
 func (rom *readMostly) CplaneOperation() time.Duration { return rom.timeout.cplane }
@@ -47,7 +66,19 @@ func (rom *readMostly) MaxKeepalive() time.Duration    { return rom.timeout.keep
 func (rom *readMostly) Features() feat.Flags           { return rom.features }
 func (rom *readMostly) TestingEnv() bool               { return rom.testingEnv }
 func (rom *readMostly) AuthEnabled() bool              { return rom.authEnabled }
+func (rom *readMostly) CapWeights() bool               { return rom.capWeights }
+func (rom *readMostly) Partitions() int                { return rom.partitions }
 
+// - module listed in log.level (cos.LogLevel.Set): max verbosity
+// - otherwise, module's own level (log.modules.*) takes precedence over the global one
 func (rom *readMostly) FastV(verbosity, fl int) bool {
-	return rom.level >= verbosity || rom.modules&fl != 0
+	if rom.modules&fl != 0 {
+		return true
+	}
+	if rom.mlset {
+		if l := int(rom.mlevels[bits.TrailingZeros32(uint32(fl))&31]); l > 0 {
+			return l >= verbosity
+		}
+	}
+	return rom.level >= verbosity
 }
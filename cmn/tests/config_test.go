@@ -8,9 +8,11 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -72,3 +74,282 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestBucketProfiles(t *testing.T) {
+	var (
+		enabled  = true
+		slices   = 4
+		profiles = cmn.BucketProfilesConf{
+			"training-data": &cmn.BpropsToSet{EC: &cmn.ECConfToSet{Enabled: &enabled, DataSlices: &slices}},
+		}
+	)
+	tassert.CheckFatal(t, profiles.Validate())
+
+	props := profiles.Get("training-data")
+	tassert.Fatalf(t, props != nil && *props.EC.DataSlices == slices, "expected profile %+v", props)
+	*props.EC.DataSlices = 8
+	tassert.Errorf(t, *profiles["training-data"].EC.DataSlices == slices, "expected a copy of the profile")
+	tassert.Errorf(t, profiles.Get("nonexistent") == nil, "expected nil for nonexistent profile")
+
+	profiles["training-data"].Mirror = &cmn.MirrorConfToSet{Enabled: &enabled}
+	tassert.Errorf(t, profiles.Validate() != nil, "expected error: mirroring and ec both enabled")
+	delete(profiles, "training-data")
+
+	profiles["bad/name"] = &cmn.BpropsToSet{}
+	tassert.Errorf(t, profiles.Validate() != nil, "expected error: invalid profile name")
+}
+
+func TestProxyConfReadOnly(t *testing.T) {
+	c := cmn.ProxyConf{ReadOnly: true}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: read-only proxy must be non-electable")
+	c.NonElectable = true
+	tassert.CheckError(t, c.Validate())
+}
+
+func TestProxyConfMaxConns(t *testing.T) {
+	c := cmn.ProxyConf{MaxConns: 1000, MaxClientConns: 64}
+	tassert.CheckError(t, c.Validate())
+	c.MaxConns = 0 // unlimited
+	tassert.CheckError(t, c.Validate())
+	c.MaxConns = 32
+	tassert.Errorf(t, c.Validate() != nil, "expected error: max_client_conns exceeds max_conns")
+	c.MaxConns, c.MaxClientConns = -1, 0
+	tassert.Errorf(t, c.Validate() != nil, "expected error: negative max_conns")
+}
+
+func TestSLOConf(t *testing.T) {
+	slo := cmn.SLOConf{
+		"get-latency": &cmn.SLOMonitor{Metric: "get.ns", MaxAvgLatency: cos.Duration(50 * time.Millisecond)},
+		"get-errors":  &cmn.SLOMonitor{Metric: "get.n", MaxErrPct: 0.1},
+	}
+	tassert.CheckFatal(t, slo.Validate())
+	tassert.Errorf(t, slo["get-latency"].Threshold() == "50ms", "unexpected %q", slo["get-latency"].Threshold())
+	tassert.Errorf(t, slo["get-errors"].Threshold() == "0.1%", "unexpected %q", slo["get-errors"].Threshold())
+	tassert.Errorf(t, slo["get-latency"].Stat() == "avg", "unexpected %q", slo["get-latency"].Stat())
+	tassert.Errorf(t, slo.String() == "get-errors, get-latency", "unexpected %q", slo.String())
+
+	for _, m := range []*cmn.SLOMonitor{
+		{Metric: "get.ns"},
+		{Metric: "get.ns", MaxErrPct: 1},
+		{Metric: "get.n", MaxAvgLatency: cos.Duration(time.Second)},
+		{Metric: "get.n", MaxErrPct: 101},
+		{Metric: "err.get.n", MaxErrPct: 1},
+		{Metric: "get.size", MaxErrPct: 1},
+	} {
+		invalid := cmn.SLOConf{"invalid": m}
+		tassert.Errorf(t, invalid.Validate() != nil, "expected error: %+v", m)
+	}
+	invalid := cmn.SLOConf{"bad/name": &cmn.SLOMonitor{Metric: "get.n", MaxErrPct: 1}}
+	tassert.Errorf(t, invalid.Validate() != nil, "expected error: invalid monitor name")
+}
+
+func TestLsoConf(t *testing.T) {
+	var c cmn.LsoConf
+	tassert.CheckFatal(t, c.Validate()) // (older config: defaults)
+	tassert.Errorf(t, c.MaxPageSize == cmn.DefaultLsoMaxPageSize && c.MaxPageSizeHigh == cmn.DefaultLsoMaxPageSizeHigh &&
+		c.MaxPageSizeExtreme == cmn.DefaultLsoMaxPageSizeExtreme, "unexpected defaults %+v", c)
+
+	c = cmn.LsoConf{MaxPageSize: 2000}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.MaxPageSizeHigh == 2000 && c.MaxPageSizeExtreme == cmn.DefaultLsoMaxPageSizeExtreme, "unexpected %+v", c)
+
+	c = cmn.LsoConf{MaxPageSize: cmn.MaxLsoPageSize + 1}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: max page size out of range")
+	c = cmn.LsoConf{MaxPageSize: 1000, MaxPageSizeHigh: 2000}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: high > max")
+	c = cmn.LsoConf{MaxPageSize: 1000, MaxPageSizeHigh: 500, MaxPageSizeExtreme: 800}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: extreme > high")
+}
+
+func TestColdGetConf(t *testing.T) {
+	var c cmn.ColdGetConf
+	tassert.CheckFatal(t, c.Validate()) // (older config: defaults)
+	tassert.Errorf(t, c.MaxActive == cmn.DefaultColdGetMaxActive && c.MaxQueued == cmn.DefaultColdGetMaxQueued &&
+		c.QueueTimeout.D() == cmn.DefaultColdGetQueueTimeout, "unexpected defaults %+v", c)
+
+	c = cmn.ColdGetConf{MaxActive: -1}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: negative max active")
+	c = cmn.ColdGetConf{MaxQueued: cmn.ColdGetNoQueue}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.MaxQueued == cmn.ColdGetNoQueue, "expected no-queueing to be preserved, got %d", c.MaxQueued)
+	c = cmn.ColdGetConf{MaxQueued: -2}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: invalid max queued")
+}
+
+func TestConfigToSetNames(t *testing.T) {
+	var (
+		enabled  = false
+		highWM   = int64(93)
+		toUpdate = cmn.ConfigToSet{
+			LRU:     &cmn.LRUConfToSet{Enabled: &enabled},
+			Disk:    &cmn.DiskConfToSet{DiskUtilHighWM: &highWM},
+			Backend: &cmn.BackendConf{},
+		}
+	)
+	names := toUpdate.Names()
+	expected := []string{"backend", "disk.disk_util_high_wm", "lru.enabled"}
+	tassert.Fatalf(t, len(names) == len(expected), "expected %v, got %v", expected, names)
+	for i := range expected {
+		tassert.Errorf(t, names[i] == expected[i], "expected %q, got %q", expected[i], names[i])
+	}
+}
+
+func TestDiffClusterConfig(t *testing.T) {
+	var (
+		from cmn.ClusterConfig
+		to   cmn.ClusterConfig
+	)
+	from.LRU.Enabled = true
+	from.Disk.DiskUtilHighWM = 90
+	to = from
+	tassert.Fatalf(t, len(cmn.DiffClusterConfig(&from, &to)) == 0, "expected no changes")
+
+	to.LRU.Enabled = false
+	to.Disk.DiskUtilHighWM = 93
+	changes := cmn.DiffClusterConfig(&from, &to)
+	tassert.Fatalf(t, len(changes) == 2, "expected 2 changes, got %+v", changes)
+	tassert.Errorf(t, changes[0].Name == "disk.disk_util_high_wm" && changes[0].From == "90" && changes[0].To == "93",
+		"unexpected %+v", changes[0])
+	tassert.Errorf(t, changes[1].Name == "lru.enabled" && changes[1].From == "true" && changes[1].To == "false",
+		"unexpected %+v", changes[1])
+}
+
+func TestShedConf(t *testing.T) {
+	var c cmn.ShedConf
+	tassert.CheckFatal(t, c.Validate()) // (older config: defaults)
+	tassert.Errorf(t, c.Delay.D() == cmn.DefaultShedDelay && !c.Enabled, "unexpected defaults %+v", c)
+
+	c = cmn.ShedConf{Delay: cos.Duration(cmn.MaxShedDelay + time.Second)}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: delay out of range")
+	c = cmn.ShedConf{MaxHeap: -1}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: negative max heap")
+}
+
+func TestWatchdogConf(t *testing.T) {
+	var c cmn.WatchdogConf
+	tassert.CheckFatal(t, c.Validate()) // (older config: defaults)
+	tassert.Errorf(t, c.Interval.D() == cmn.DefaultWatchdogInterval && c.MaxFDsPct == cmn.DefaultWatchdogFDsPct && !c.Enabled,
+		"unexpected defaults %+v", c)
+
+	c = cmn.WatchdogConf{Interval: cos.Duration(time.Millisecond)}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: interval out of range")
+	c = cmn.WatchdogConf{MaxFDsPct: 101}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: max_fds_pct out of range")
+	c = cmn.WatchdogConf{MaxGoroutines: -1}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: negative max_goroutines")
+}
+
+func TestMetricsConf(t *testing.T) {
+	var c cmn.MetricsConf
+	tassert.CheckFatal(t, c.Validate()) // (older config: StatsD)
+	tassert.Errorf(t, c.Has(cmn.MetricsStatsD) && !c.Has(cmn.MetricsProm), "unexpected default %+v", c)
+
+	c = cmn.MetricsConf{Sinks: "statsd, prometheus"}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.Has(cmn.MetricsStatsD) && c.Has(cmn.MetricsProm), "expected both sinks: %+v", c)
+
+	c = cmn.MetricsConf{Sinks: "prometheus,prometheus"}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: duplicate sink")
+	c = cmn.MetricsConf{Sinks: "graphite"}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: unknown sink")
+}
+
+func TestDiskConfBgIO(t *testing.T) {
+	valid := func() cmn.DiskConf {
+		return cmn.DiskConf{
+			DiskUtilLowWM: 20, DiskUtilHighWM: 80, DiskUtilMaxWM: 95,
+			IostatTimeLong: cos.Duration(2 * time.Second), IostatTimeShort: cos.Duration(100 * time.Millisecond),
+		}
+	}
+	c := valid()
+	tassert.CheckFatal(t, c.Validate()) // (older config: defaults)
+	tassert.Errorf(t, c.BgIOWeight == cmn.DefaultBgIOWeight && c.BgCgroup == "", "unexpected defaults %+v", c)
+
+	c = valid()
+	c.BgCgroup, c.BgIOWeight = "ais-bg", 1000
+	tassert.CheckFatal(t, c.Validate())
+	c.BgIOWeight = 5
+	tassert.Errorf(t, c.Validate() != nil, "expected error: bg_io_weight out of range")
+	c = valid()
+	c.BgCgroup = "../ais-bg"
+	tassert.Errorf(t, c.Validate() != nil, "expected error: bg_cgroup is a path")
+}
+
+func TestNetConfConnPool(t *testing.T) {
+	c := cmn.NetConf{L4: cmn.L4Conf{Proto: "tcp"}}
+	tassert.CheckFatal(t, c.Validate()) // (older config: zero values => defaults)
+
+	c.HTTP = cmn.HTTPConf{IdleConnsPerHost: 64, MaxIdleConns: 4096, MaxConnsPerHost: 128, IdleConnTimeout: cos.Duration(time.Minute)}
+	tassert.CheckFatal(t, c.Validate())
+	c.HTTP.MaxConnsPerHost = 32
+	tassert.Errorf(t, c.Validate() != nil, "expected error: idle_conns_per_host > max_conns_per_host")
+	c.HTTP = cmn.HTTPConf{MaxIdleConns: -1}
+	tassert.Errorf(t, c.Validate() != nil, "expected error: negative max_idle_conns")
+}
+
+func TestLogConfFormat(t *testing.T) {
+	c := cmn.LogConf{Level: "3", MaxSize: 4 * cos.MiB, MaxTotal: 64 * cos.MiB}
+	tassert.CheckFatal(t, c.Validate()) // (older config: defaults)
+	tassert.Errorf(t, c.Format == cmn.LogFormatText, "unexpected default %q", c.Format)
+
+	c.Format = cmn.LogFormatJSON
+	tassert.CheckFatal(t, c.Validate())
+	c.Format = "xml"
+	tassert.Errorf(t, c.Validate() != nil, "expected error: invalid log.format")
+}
+
+func TestLogModules(t *testing.T) {
+	// field order and names must match cos.Smodules
+	var (
+		i   int
+		cfg cmn.ClusterConfig
+	)
+	err := cmn.IterFields(&cfg.Log.Modules, func(tag string, _ cmn.IterField) (error, bool) {
+		tassert.Errorf(t, i < len(cos.Smodules) && tag == cos.Smodules[i], "log.modules.%s vs cos.Smodules[%d]", tag, i)
+		i++
+		return nil, false
+	}, cmn.IterOpts{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, i == len(cos.Smodules), "expected %d modules, got %d", len(cos.Smodules), i)
+
+	c := cmn.LogModulesConf{Reb: "debug", EC: "5", S3: "1"}
+	tassert.CheckFatal(t, c.Validate())
+	c.Xs = "6"
+	tassert.Errorf(t, c.Validate() != nil, "expected error: level out of range")
+	c.Xs = "verbose"
+	tassert.Errorf(t, c.Validate() != nil, "expected error: unknown level name")
+
+	// global 3; reb raised, s3 lowered; xs listed in log.level (max verbosity)
+	cfg.Log.Level.Set(3, []string{"xs"})
+	cfg.Log.Modules = cmn.LogModulesConf{Reb: "debug", S3: "2"}
+	cmn.Rom.Set(&cfg)
+	defer cmn.Rom.Set(&cmn.ClusterConfig{Log: cmn.LogConf{Level: "3"}})
+	tassert.Errorf(t, cmn.Rom.FastV(4, cos.SmoduleReb) && !cmn.Rom.FastV(5, cos.SmoduleReb), "reb: expected level 4")
+	tassert.Errorf(t, !cmn.Rom.FastV(3, cos.SmoduleS3) && cmn.Rom.FastV(2, cos.SmoduleS3), "s3: expected level 2")
+	tassert.Errorf(t, cmn.Rom.FastV(3, cos.SmoduleEC) && !cmn.Rom.FastV(4, cos.SmoduleEC), "ec: expected global level 3")
+	tassert.Errorf(t, cmn.Rom.FastV(5, cos.SmoduleXs), "xs: expected max verbosity")
+}
+
+func TestDefaultClusterConfig(t *testing.T) {
+	const url = "http://localhost:8080"
+	cc, err := cmn.DefaultClusterConfig(url, apc.AWS)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cc.Proxy.PrimaryURL == url && cc.Proxy.OriginalURL == url, "unexpected proxy config %+v", cc.Proxy)
+	_, ok := cc.Backend.Conf[apc.AWS]
+	tassert.Errorf(t, ok && len(cc.Backend.Conf) == 1, "expected %q backend, got %+v", apc.AWS, cc.Backend.Conf)
+
+	dir := t.TempDir()
+	for _, role := range []string{apc.Proxy, apc.Target} {
+		config := &cmn.Config{
+			ClusterConfig: *cc,
+			LocalConfig: cmn.LocalConfig{
+				ConfigDir: dir,
+				LogDir:    dir,
+				HostNet:   cmn.LocalNetConfig{Port: 8080},
+				FSP:       cmn.FSPConf{Paths: cos.NewStrSet(filepath.Join(dir, "mp"))},
+			},
+		}
+		config.SetRole(role)
+		tassert.CheckError(t, config.Validate())
+	}
+}
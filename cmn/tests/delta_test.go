@@ -0,0 +1,81 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/delta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func deltaRoundtrip(t *testing.T, base, data []byte, blockSize int64) delta.Stats {
+	sig, err := delta.NewSig(bytes.NewReader(base), &delta.Base{Version: "1", Size: int64(len(base))}, blockSize)
+	tassert.CheckFatal(t, err)
+
+	// signature wire roundtrip
+	sbuf := &bytes.Buffer{}
+	_, err = sig.WriteTo(sbuf)
+	tassert.CheckFatal(t, err)
+	sig, err = delta.ReadSig(sbuf)
+	tassert.CheckFatal(t, err)
+
+	dbuf := &bytes.Buffer{}
+	stats, err := delta.Encode(dbuf, bytes.NewReader(data), sig, int64(len(data)))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, stats.Copied+stats.Literal == int64(len(data)), "stats %+v vs size %d", stats, len(data))
+
+	dr, err := delta.NewReader(dbuf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, dr.Hdr.Base == sig.Base, "base %+v vs %+v", dr.Hdr.Base, sig.Base)
+	tassert.Errorf(t, dr.Hdr.Size == int64(len(data)), "size %d vs %d", dr.Hdr.Size, len(data))
+	dr.SetBase(bytes.NewReader(base))
+	out, err := io.ReadAll(dr)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(out, data), "reconstructed content differs (%d vs %d bytes)", len(out), len(data))
+	return stats
+}
+
+func TestDeltaRoundtrip(t *testing.T) {
+	const bs = 4 * cos.KiB
+	base := make([]byte, 1*cos.MiB+1234)
+	_, _ = cryptorand.Read(base)
+
+	// identical
+	stats := deltaRoundtrip(t, base, base, bs)
+	tassert.Errorf(t, stats.Literal == 0, "identical content: expected no literal data, got %d", stats.Literal)
+
+	// modified in the middle, with bytes inserted and removed
+	data := append([]byte(nil), base[:100*cos.KiB]...)
+	data = append(data, []byte("inserted")...)
+	data = append(data, base[100*cos.KiB+17:700*cos.KiB]...)
+	data = append(data, make([]byte, 3*bs)...)
+	data = append(data, base[700*cos.KiB:]...)
+	stats = deltaRoundtrip(t, base, data, bs)
+	tassert.Errorf(t, stats.Literal < 8*bs, "expected mostly copied content, got %+v", stats)
+
+	// appended, truncated, empty, and unrelated
+	deltaRoundtrip(t, base, append(append([]byte(nil), base...), base[:5000]...), bs)
+	deltaRoundtrip(t, base, base[:len(base)/2], bs)
+	deltaRoundtrip(t, base, nil, bs)
+	deltaRoundtrip(t, nil, base, bs)
+
+	unrelated := make([]byte, 3*cos.MiB)
+	_, _ = cryptorand.Read(unrelated)
+	stats = deltaRoundtrip(t, base, unrelated, bs)
+	tassert.Errorf(t, stats.Copied == 0, "unrelated content: expected nothing copied, got %+v", stats)
+}
+
+func TestDeltaInvalid(t *testing.T) {
+	_, err := delta.NewSig(bytes.NewReader(nil), &delta.Base{}, 100)
+	tassert.Errorf(t, err != nil, "expected invalid block size error")
+
+	_, err = delta.NewReader(bytes.NewReader([]byte("not-a-delta-stream")))
+	tassert.Errorf(t, err != nil, "expected invalid magic error")
+}
@@ -5,10 +5,14 @@
 package tests_test
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
 )
 
 func TestMatchRESTItems(t *testing.T) {
@@ -84,3 +88,46 @@ func TestMatchRESTItems(t *testing.T) {
 		}
 	}
 }
+
+func TestConnStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	var (
+		cs     cmn.ConnStats
+		client = cmn.NewClient(cmn.TransportArgs{ConnStats: &cs, IdleConnsPerHost: 4})
+	)
+	for i := 0; i < 10; i++ {
+		resp, err := client.Get(srv.URL)
+		tassert.CheckFatal(t, err)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	// sequential requests reuse the same (keep-alive) connection
+	tassert.Errorf(t, cs.Dials.Load() == 1 && cs.Open.Load() == 1, "expected (1 dial, 1 open), got (%d, %d)",
+		cs.Dials.Load(), cs.Open.Load())
+
+	client.CloseIdleConnections()
+	tassert.Errorf(t, cs.Open.Load() == 0, "expected no open connections, got %d", cs.Open.Load())
+}
+
+func TestUserMDFromHeader(t *testing.T) {
+	hdr := http.Header{}
+	hdr.Set("Ais-Meta-Color", "red")
+	hdr.Set("ais-meta-Owner", "alice")
+	hdr.Set("Ais-Checksum-Type", "xxhash")
+	oa := &cmn.ObjAttrs{}
+	tassert.CheckFatal(t, oa.UserMDFromHeader(hdr))
+	tassert.Errorf(t, len(oa.CustomMD) == 2, "expected 2 custom keys, got %v", oa.CustomMD)
+	tassert.Errorf(t, oa.CustomMD["color"] == "red", "expected color=red, got %v", oa.CustomMD)
+	tassert.Errorf(t, oa.CustomMD["owner"] == "alice", "expected owner=alice, got %v", oa.CustomMD)
+
+	for _, key := range []string{cmn.SourceObjMD, cmn.ETag, cmn.PinnedObjMD} {
+		hdr := http.Header{}
+		hdr.Set("Ais-Meta-"+key, "x")
+		err := (&cmn.ObjAttrs{}).UserMDFromHeader(hdr)
+		tassert.Errorf(t, err != nil, "expected error for system-reserved key %q", key)
+	}
+}
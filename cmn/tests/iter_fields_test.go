@@ -77,9 +77,11 @@ var _ = Describe("IterFields", func() {
 					"ec.bundle_multiplier": 0,
 					"ec.disk_only":         false,
 
-					"versioning.enabled":           false,
-					"versioning.validate_warm_get": false,
-					"versioning.synchronize":       false,
+					"versioning.enabled":                    false,
+					"versioning.validate_warm_get":          false,
+					"versioning.validate_warm_get_interval": cos.Duration(0),
+					"versioning.synchronize":                false,
+					"versioning.retain":                     0,
 
 					"checksum.type":              cos.ChecksumXXHash,
 					"checksum.validate_warm_get": false,
@@ -87,19 +89,45 @@ var _ = Describe("IterFields", func() {
 					"checksum.validate_obj_move": false,
 					"checksum.enable_read_range": false,
 
-					"lru.enabled":           false,
-					"lru.dont_evict_time":   cos.Duration(0),
-					"lru.capacity_upd_time": cos.Duration(0),
+					"lru.enabled":            false,
+					"lru.dont_evict_time":    cos.Duration(0),
+					"lru.capacity_upd_time":  cos.Duration(0),
+					"lru.version_evict_time": cos.Duration(0),
 
 					"extra.aws.cloud_region": "us-central",
 					"extra.aws.endpoint":     "",
 					"extra.aws.profile":      "",
 
-					"access":  apc.AccessAttrs(0),
-					"created": int64(0),
+					"access":          apc.AccessAttrs(0),
+					"frozen":          false,
+					"created":         int64(0),
+					"auto_redundancy": "",
 
 					"write_policy.data": apc.WritePolicy(""),
 					"write_policy.md":   apc.WritePolicy(""),
+
+					"dedup.chunking":   "",
+					"dedup.chunk_size": cos.SizeIEC(0),
+					"dedup.enabled":    false,
+
+					"packing.max_obj_size":   cos.SizeIEC(0),
+					"packing.container_size": cos.SizeIEC(0),
+					"packing.compact_pct":    0,
+					"packing.enabled":        false,
+
+					"replication.dst":           "",
+					"replication.conflict":      "",
+					"replication.queue_size":    0,
+					"replication.bidirectional": false,
+					"replication.enabled":       false,
+
+					"rate_limit.get_rps": 0,
+					"rate_limit.get_bps": cos.SizeIEC(0),
+					"rate_limit.put_rps": 0,
+					"rate_limit.put_bps": cos.SizeIEC(0),
+					"rate_limit.enabled": false,
+
+					"etl.id": "",
 				},
 			),
 			Entry("list BpropsToSet fields",
@@ -133,9 +161,11 @@ var _ = Describe("IterFields", func() {
 					"ec.bundle_multiplier": (*int)(nil),
 					"ec.disk_only":         (*bool)(nil),
 
-					"versioning.enabled":           (*bool)(nil),
-					"versioning.validate_warm_get": (*bool)(nil),
-					"versioning.synchronize":       (*bool)(nil),
+					"versioning.enabled":                    (*bool)(nil),
+					"versioning.validate_warm_get":          (*bool)(nil),
+					"versioning.validate_warm_get_interval": (*cos.Duration)(nil),
+					"versioning.synchronize":                (*bool)(nil),
+					"versioning.retain":                     (*int)(nil),
 
 					"checksum.type":              apc.String(cos.ChecksumXXHash),
 					"checksum.validate_warm_get": (*bool)(nil),
@@ -143,15 +173,40 @@ var _ = Describe("IterFields", func() {
 					"checksum.validate_obj_move": (*bool)(nil),
 					"checksum.enable_read_range": (*bool)(nil),
 
-					"lru.enabled":           (*bool)(nil),
-					"lru.dont_evict_time":   (*cos.Duration)(nil),
-					"lru.capacity_upd_time": (*cos.Duration)(nil),
+					"lru.enabled":            (*bool)(nil),
+					"lru.dont_evict_time":    (*cos.Duration)(nil),
+					"lru.capacity_upd_time":  (*cos.Duration)(nil),
+					"lru.version_evict_time": (*cos.Duration)(nil),
 
 					"access": apc.AccAttrs(1024),
+					"frozen": (*bool)(nil),
 
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   apc.WPolicy(apc.WriteDelayed),
 
+					"dedup.chunking":   (*string)(nil),
+					"dedup.chunk_size": (*cos.SizeIEC)(nil),
+					"dedup.enabled":    (*bool)(nil),
+
+					"packing.max_obj_size":   (*cos.SizeIEC)(nil),
+					"packing.container_size": (*cos.SizeIEC)(nil),
+					"packing.compact_pct":    (*int)(nil),
+					"packing.enabled":        (*bool)(nil),
+
+					"replication.dst":           (*string)(nil),
+					"replication.conflict":      (*string)(nil),
+					"replication.queue_size":    (*int)(nil),
+					"replication.bidirectional": (*bool)(nil),
+					"replication.enabled":       (*bool)(nil),
+
+					"rate_limit.get_rps": (*int)(nil),
+					"rate_limit.get_bps": (*cos.SizeIEC)(nil),
+					"rate_limit.put_rps": (*int)(nil),
+					"rate_limit.put_bps": (*cos.SizeIEC)(nil),
+					"rate_limit.enabled": (*bool)(nil),
+
+					"etl.id": (*string)(nil),
+
 					"extra.hdfs.ref_directory": (*string)(nil),
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
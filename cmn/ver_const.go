@@ -47,5 +47,8 @@ const (
 
 	MetaverMetasync = 1 // metasync over network formatting version (jsp)
 
+	MetaverCapHist  = 1 // target's capacity history (jsp)
+	MetaverBckUsage = 1 // target's per-bucket usage (jsp)
+
 	MetaverJSP = jsp.Metaver // `jsp` own encoding version
 )
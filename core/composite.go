@@ -0,0 +1,58 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/OneOfOne/xxhash"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Composite objects (see apc.CompositeManifest):
+// the content is a JSON manifest, and cmn.CompositeObjMD (custom metadata) holds its digest.
+// Custom metadata travels with the object (copy, rebalance, mirroring, EC), while
+// any subsequent write that changes the content invalidates the digest - and with it,
+// the "composite" status of the object.
+
+func CompositeDigest(manifest []byte) string {
+	return strconv.FormatUint(xxhash.Checksum64S(manifest, cos.MLCG32), 16)
+}
+
+// cheap check (not reading the content)
+func (lom *LOM) IsComposite() bool {
+	digest, ok := lom.GetCustomKey(cmn.CompositeObjMD)
+	return ok && digest != "" && lom.SizeBytes() <= apc.MaxCompositeManifestSize
+}
+
+// LoadComposite reads the manifest and validates it against the stored digest;
+// returns (nil, nil) if the object is not (or no longer) composite.
+// Must be called under rlock.
+func (lom *LOM) LoadComposite() (*apc.CompositeManifest, error) {
+	if !lom.IsComposite() {
+		return nil, nil
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(io.LimitReader(fh, apc.MaxCompositeManifestSize))
+	cos.Close(fh)
+	if err != nil {
+		return nil, err
+	}
+	if digest, _ := lom.GetCustomKey(cmn.CompositeObjMD); digest != CompositeDigest(b) {
+		return nil, nil // overwritten
+	}
+	manifest := &apc.CompositeManifest{}
+	if err := jsoniter.Unmarshal(b, manifest); err != nil {
+		return nil, cmn.NewErrFailedTo(T, "unmarshal composite manifest", lom.Cname(), err)
+	}
+	return manifest, nil
+}
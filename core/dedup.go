@@ -0,0 +1,490 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Object deduplication (bucket-configurable, see cmn.DedupConf):
+// - new content gets split into chunks: fixed-size or content-defined (CDC);
+// - each chunk is stored once per bucket and mountpath - under its SHA-256 digest (fs.DedupType);
+// - the object itself (lom.FQN) becomes a manifest listing its chunks in order;
+// - on-disk metadata (lmeta) records the fact while keeping the object's size and checksum intact;
+// - reading is done via lom.NewHandle();
+// - chunks that are no longer referenced get removed by space cleanup (space/cleanup.go).
+//
+// Manifest layout:
+// | -- magic (8) -- | -- chunk size (8) | chunk digest (32) -- | ... |
+
+const (
+	dedupMagic   = "aisdedup"
+	dedupRecSize = cos.SizeofI64 + sha256.Size
+)
+
+type (
+	dedupChunk struct {
+		digest string // hex
+		off    int64
+		size   int64
+	}
+	// reads deduplicated content
+	dedupReader struct {
+		mi     *fs.Mountpath // manifest's mountpath
+		bck    *cmn.Bck
+		fqn    string // manifest
+		chunks []dedupChunk
+		fh     *os.File // currently open chunk
+		size   int64
+		off    int64
+		idx    int        // index of the `fh` chunk
+		mu     sync.Mutex // ReadAt may be called concurrently (e.g., EC slices via cos.SectionHandle)
+	}
+	// splits content into fixed-size or content-defined chunks
+	chunker struct {
+		r       io.Reader
+		buf     []byte
+		n, off  int
+		minSize int
+		mask    uint64
+		cdc     bool
+		eof     bool
+	}
+)
+
+// interface guard
+var _ cos.LomReader = (*dedupReader)(nil)
+
+// CDC: gear table for the rolling hash
+// (must remain deterministic across nodes and versions - hence, splitmix64 with a fixed seed)
+var gear [256]uint64
+
+func init() {
+	x := uint64(0x616973_64656475)
+	for i := range gear {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+func (lom *LOM) IsDedup() bool { return lom.md.dedup }
+
+// NewHandle opens object's content for reading, whether deduplicated, packed, or neither
+// (compare with cos.NewFileHandle)
+func (lom *LOM) NewHandle() (cos.LomReader, error) {
+	if lom.md.packed {
+		return lom.openPacked()
+	}
+	if lom.md.dedup {
+		r, err := newDedupReader(lom.mi, lom.Bucket(), lom.FQN)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	return fh, nil
+}
+
+func dedupChunkFQN(mi *fs.Mountpath, bck *cmn.Bck, digest string) string {
+	return mi.MakePathFQN(bck, fs.DedupType, digest[:2]+"/"+digest)
+}
+
+// Dedup splits the (fully written) workfile into content-addressed chunks and replaces
+// its content with the corresponding manifest. The caller then proceeds to finalize
+// the workfile as usual (rename => lom.FQN, persist).
+// Returns the size of the newly stored chunks (zero when the content is fully duplicated).
+func (lom *LOM) Dedup(workFQN string, conf *cmn.DedupConf) (stored int64, err error) {
+	var (
+		fh, mfh *os.File
+		mfqn    = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDedup)
+		now     = time.Now()
+	)
+	if fh, err = os.Open(workFQN); err != nil {
+		return 0, err
+	}
+	if mfh, err = lom.CreateFile(mfqn); err != nil {
+		cos.Close(fh)
+		return 0, err
+	}
+	var (
+		rec [dedupRecSize]byte
+		c   = newChunker(fh, conf)
+		bw  = bufio.NewWriter(mfh)
+	)
+	if _, err = bw.WriteString(dedupMagic); err != nil {
+		goto fin
+	}
+	for {
+		var (
+			b []byte
+			n int64
+		)
+		if b, err = c.next(); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			break
+		}
+		digest := sha256.Sum256(b)
+		if n, err = lom.putChunk(hex.EncodeToString(digest[:]), b, now); err != nil {
+			break
+		}
+		stored += n
+		binary.BigEndian.PutUint64(rec[:], uint64(len(b)))
+		copy(rec[cos.SizeofI64:], digest[:])
+		if _, err = bw.Write(rec[:]); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+fin:
+	cos.Close(fh)
+	if err == nil {
+		err = cos.FlushClose(mfh)
+	} else {
+		cos.Close(mfh)
+	}
+	if err == nil {
+		err = cos.Rename(mfqn, workFQN)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(mfqn); errRm != nil {
+			nlog.Errorln("nested err:", errRm)
+		}
+		return 0, cmn.NewErrFailedTo(T, "dedup", lom, err)
+	}
+	lom.md.dedup = true
+	return stored, nil
+}
+
+// store chunk unless already stored, in which case update its mtime
+// to keep it from being removed as unreferenced (see space cleanup)
+func (lom *LOM) putChunk(digest string, b []byte, now time.Time) (int64, error) {
+	fqn := dedupChunkFQN(lom.mi, lom.Bucket(), digest)
+	err := os.Chtimes(fqn, now, now)
+	if err == nil {
+		return 0, nil
+	}
+	if !os.IsNotExist(err) {
+		return 0, err
+	}
+	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDedup)
+	wfh, err := lom.CreateFile(wfqn)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = wfh.Write(b); err == nil {
+		err = cos.FlushClose(wfh)
+	} else {
+		cos.Close(wfh)
+	}
+	if err == nil {
+		err = cos.Rename(wfqn, fqn)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(wfqn); errRm != nil {
+			nlog.Errorln("nested err:", errRm)
+		}
+		return 0, err
+	}
+	return int64(len(b)), nil
+}
+
+// DedupRefs adds to `refs` the digests of all chunks referenced by the object
+func (lom *LOM) DedupRefs(refs cos.StrSet) error {
+	if !lom.md.dedup {
+		return nil
+	}
+	chunks, _, err := readManifest(lom.FQN)
+	if err != nil {
+		return err
+	}
+	for i := range chunks {
+		refs.Set(chunks[i].digest)
+	}
+	return nil
+}
+
+// copyChunks makes sure that all chunks referenced by the manifest are stored on the
+// destination mountpath - a prerequisite to copying (or moving) the manifest itself
+func copyChunks(mi, dst *fs.Mountpath, bck *cmn.Bck, mfqn string, buf []byte) error {
+	chunks, _, err := readManifest(mfqn)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i := range chunks {
+		var (
+			digest = chunks[i].digest
+			dstFQN = dedupChunkFQN(dst, bck, digest)
+		)
+		err := os.Chtimes(dstFQN, now, now)
+		if err == nil {
+			continue
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+		workFQN := dst.MakePathFQN(bck, fs.WorkfileType, fs.WorkfileDedup+"."+digest+"."+cos.GenTie())
+		_, _, err = cos.CopyFile(findChunk(mi, bck, digest), workFQN, buf, cos.ChecksumNone)
+		if err == nil {
+			err = cos.Rename(workFQN, dstFQN)
+		}
+		if err != nil {
+			if errRm := cos.RemoveFile(workFQN); errRm != nil {
+				nlog.Errorln("nested err:", errRm)
+			}
+			return fmt.Errorf("%s: failed to copy chunk %s to %s: %w", mfqn, digest, dst, err)
+		}
+	}
+	return nil
+}
+
+// chunks are stored on the manifest's mountpath
+// (and, in rare cases when the manifest has been relocated as is, on some other one)
+func findChunk(mi *fs.Mountpath, bck *cmn.Bck, digest string) string {
+	fqn := dedupChunkFQN(mi, bck, digest)
+	if cos.Stat(fqn) == nil {
+		return fqn
+	}
+	for _, other := range fs.GetAvail() {
+		if other.Path == mi.Path {
+			continue
+		}
+		if ofqn := dedupChunkFQN(other, bck, digest); cos.Stat(ofqn) == nil {
+			return ofqn
+		}
+	}
+	return fqn
+}
+
+// DedupDigest returns the chunk's digest given its FQN (see space cleanup)
+func DedupDigest(fqn string) string { return filepath.Base(fqn) }
+
+func readManifest(fqn string) (chunks []dedupChunk, size int64, _ error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(b) < len(dedupMagic) || string(b[:len(dedupMagic)]) != dedupMagic ||
+		(len(b)-len(dedupMagic))%dedupRecSize != 0 {
+		return nil, 0, fmt.Errorf("invalid dedup manifest %q (size %d)", fqn, len(b))
+	}
+	b = b[len(dedupMagic):]
+	chunks = make([]dedupChunk, 0, len(b)/dedupRecSize)
+	for off := 0; off < len(b); off += dedupRecSize {
+		csize := int64(binary.BigEndian.Uint64(b[off:]))
+		chunks = append(chunks, dedupChunk{
+			digest: hex.EncodeToString(b[off+cos.SizeofI64 : off+dedupRecSize]),
+			off:    size,
+			size:   csize,
+		})
+		size += csize
+	}
+	return chunks, size, nil
+}
+
+/////////////////
+// dedupReader //
+/////////////////
+
+func newDedupReader(mi *fs.Mountpath, bck *cmn.Bck, fqn string) (*dedupReader, error) {
+	chunks, size, err := readManifest(fqn)
+	if err != nil {
+		return nil, err
+	}
+	return &dedupReader{mi: mi, bck: bck, fqn: fqn, chunks: chunks, size: size, idx: -1}, nil
+}
+
+func (r *dedupReader) Open() (cos.ReadOpenCloser, error) { return newDedupReader(r.mi, r.bck, r.fqn) }
+
+func (r *dedupReader) Read(b []byte) (n int, err error) {
+	n, err = r.ReadAt(b, r.off)
+	r.off += int64(n)
+	return n, err
+}
+
+func (r *dedupReader) ReadAt(b []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("dedup-reader: negative offset")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n < len(b) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		i := sort.Search(len(r.chunks), func(i int) bool { return r.chunks[i].off+r.chunks[i].size > off })
+		if err = r.open(i); err != nil {
+			return n, err
+		}
+		var (
+			k int
+			c = &r.chunks[i]
+			m = min(int64(len(b)-n), c.off+c.size-off)
+		)
+		k, err = r.fh.ReadAt(b[n:n+int(m)], off-c.off)
+		n += k
+		off += int64(k)
+		if err != nil {
+			if err == io.EOF && int64(k) == m {
+				continue
+			}
+			if err == io.EOF {
+				err = fmt.Errorf("%s: chunk %s is truncated: %w", r.fqn, c.digest, io.ErrUnexpectedEOF)
+			}
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (r *dedupReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("dedup-reader: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("dedup-reader: negative position")
+	}
+	r.off = offset
+	return offset, nil
+}
+
+func (r *dedupReader) Close() error {
+	r.mu.Lock()
+	err := r.close()
+	r.mu.Unlock()
+	return err
+}
+
+func (r *dedupReader) close() (err error) {
+	if r.fh != nil {
+		err = r.fh.Close()
+		r.fh, r.idx = nil, -1
+	}
+	return err
+}
+
+// chunks are stored on the manifest's mountpath
+// (and, in rare cases when the manifest has been relocated as is, on some other one)
+func (r *dedupReader) open(i int) (err error) {
+	if i == r.idx {
+		return nil
+	}
+	r.close()
+	digest := r.chunks[i].digest
+	r.fh, err = os.Open(dedupChunkFQN(r.mi, r.bck, digest))
+	if err != nil && os.IsNotExist(err) {
+		for _, mi := range fs.GetAvail() {
+			if mi.Path == r.mi.Path {
+				continue
+			}
+			if r.fh, err = os.Open(dedupChunkFQN(mi, r.bck, digest)); err == nil || !os.IsNotExist(err) {
+				break
+			}
+		}
+	}
+	if err != nil {
+		r.fh = nil
+		return fmt.Errorf("%s: failed to open chunk %s: %w", r.fqn, digest, err)
+	}
+	r.idx = i
+	return nil
+}
+
+/////////////
+// chunker //
+/////////////
+
+func newChunker(r io.Reader, conf *cmn.DedupConf) *chunker {
+	size := int(conf.ChunkSize)
+	if conf.Chunking != apc.DedupCDC {
+		return &chunker{r: r, buf: make([]byte, size)}
+	}
+	// CDC: average chunk size `size` (power of two approx.), clamped to [size/4, size*4]
+	return &chunker{
+		r:       r,
+		buf:     make([]byte, size*4),
+		minSize: size / 4,
+		mask:    1<<(bits.Len(uint(size))-1) - 1,
+		cdc:     true,
+	}
+}
+
+// returns the next chunk that remains valid until the subsequent call
+func (c *chunker) next() ([]byte, error) {
+	if c.off > 0 {
+		c.n = copy(c.buf, c.buf[c.off:c.n])
+		c.off = 0
+	}
+	if !c.eof && c.n < len(c.buf) {
+		m, err := io.ReadFull(c.r, c.buf[c.n:])
+		c.n += m
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			c.eof = true
+		default:
+			return nil, err
+		}
+	}
+	if c.n == 0 {
+		return nil, io.EOF
+	}
+	cut := c.n
+	if c.cdc {
+		cut = c.cut(c.buf[:c.n])
+	}
+	c.off = cut
+	return c.buf[:cut], nil
+}
+
+// gear-based content-defined boundary (cutpoint)
+func (c *chunker) cut(b []byte) int {
+	if len(b) <= c.minSize {
+		return len(b)
+	}
+	var h uint64
+	for i := c.minSize; i < len(b); i++ {
+		h = (h << 1) + gear[b[i]]
+		if h&c.mask == 0 {
+			return i + 1
+		}
+	}
+	return len(b)
+}
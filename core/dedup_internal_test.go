@@ -0,0 +1,163 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func chunkAll(t *testing.T, data []byte, conf *cmn.DedupConf) (chunks [][]byte) {
+	c := newChunker(bytes.NewReader(data), conf)
+	for {
+		b, err := c.next()
+		if err == io.EOF {
+			return chunks
+		}
+		tassert.CheckFatal(t, err)
+		chunks = append(chunks, append([]byte(nil), b...))
+	}
+}
+
+func TestDedupChunker(t *testing.T) {
+	data := make([]byte, 3*cos.MiB+123)
+	_, _ = cryptorand.Read(data)
+
+	for _, chunking := range apc.SupportedDedupChunking {
+		t.Run(chunking, func(t *testing.T) {
+			conf := &cmn.DedupConf{Chunking: chunking, ChunkSize: 64 * cos.KiB}
+			chunks := chunkAll(t, data, conf)
+			tassert.Fatalf(t, bytes.Equal(bytes.Join(chunks, nil), data), "reassembled content differs")
+			for i, b := range chunks {
+				size := cos.SizeIEC(len(b))
+				last := i == len(chunks)-1
+				switch {
+				case chunking == apc.DedupFixed:
+					tassert.Errorf(t, size == conf.ChunkSize || last, "chunk %d: unexpected size %d", i, size)
+				default:
+					tassert.Errorf(t, size <= 4*conf.ChunkSize, "chunk %d: size %d exceeds max", i, size)
+					tassert.Errorf(t, size > conf.ChunkSize/4 || last, "chunk %d: size %d below min", i, size)
+				}
+			}
+		})
+	}
+}
+
+// content-defined boundaries must survive insertion at the head of the stream
+func TestDedupChunkerShift(t *testing.T) {
+	data := make([]byte, 4*cos.MiB)
+	_, _ = cryptorand.Read(data)
+	shifted := append([]byte("inserted-prefix"), data...)
+
+	conf := &cmn.DedupConf{Chunking: apc.DedupCDC, ChunkSize: 32 * cos.KiB}
+	digests := cos.NewStrSet()
+	orig := chunkAll(t, data, conf)
+	for _, b := range orig {
+		sum := sha256.Sum256(b)
+		digests.Add(hex.EncodeToString(sum[:]))
+	}
+	var shared int
+	for _, b := range chunkAll(t, shifted, conf) {
+		sum := sha256.Sum256(b)
+		if digests.Contains(hex.EncodeToString(sum[:])) {
+			shared++
+		}
+	}
+	tassert.Errorf(t, shared >= len(orig)*3/4, "expected most chunks to be shared: %d out of %d", shared, len(orig))
+}
+
+// EC reads slices of the same object in parallel (cos.SectionHandle => ReadAt)
+// stores chunks on the given mountpath and returns the manifest's fqn
+func writeDedup(t *testing.T, mi *fs.Mountpath, bck *cmn.Bck, data []byte) string {
+	var (
+		mb  = []byte(dedupMagic)
+		rec [dedupRecSize]byte
+	)
+	for _, b := range chunkAll(t, data, &cmn.DedupConf{Chunking: apc.DedupCDC, ChunkSize: 16 * cos.KiB}) {
+		digest := sha256.Sum256(b)
+		fqn := dedupChunkFQN(mi, bck, hex.EncodeToString(digest[:]))
+		tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(fqn), cos.PermRWXRX))
+		tassert.CheckFatal(t, os.WriteFile(fqn, b, cos.PermRWR))
+		binary.BigEndian.PutUint64(rec[:], uint64(len(b)))
+		copy(rec[cos.SizeofI64:], digest[:])
+		mb = append(mb, rec[:]...)
+	}
+	mfqn := filepath.Join(mi.Path, "manifest")
+	tassert.CheckFatal(t, os.WriteFile(mfqn, mb, cos.PermRWR))
+	return mfqn
+}
+
+func TestDedupReaderConcurrent(t *testing.T) {
+	var (
+		mi   = &fs.Mountpath{Path: t.TempDir()}
+		bck  = &cmn.Bck{Name: "dedup", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		data = make([]byte, cos.MiB+777)
+	)
+	_, _ = cryptorand.Read(data)
+	mfqn := writeDedup(t, mi, bck, data)
+
+	r, err := newDedupReader(mi, bck, mfqn)
+	tassert.CheckFatal(t, err)
+	defer r.Close()
+
+	const numSections = 8
+	var (
+		wg      sync.WaitGroup
+		secSize = int64(len(data)+numSections-1) / numSections
+		out     = make([][]byte, numSections)
+	)
+	for i := 0; i < numSections; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sec := cos.NewSectionHandle(r, int64(i)*secSize, secSize, 0)
+			out[i], _ = io.ReadAll(sec)
+		}(i)
+	}
+	wg.Wait()
+	tassert.Fatalf(t, bytes.Equal(bytes.Join(out, nil), data), "concurrently read content differs")
+}
+
+func TestDedupCopyChunks(t *testing.T) {
+	var (
+		mi   = &fs.Mountpath{Path: t.TempDir()}
+		dst  = &fs.Mountpath{Path: t.TempDir()}
+		bck  = &cmn.Bck{Name: "dedup", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		data = make([]byte, cos.MiB/2+333)
+		buf  = make([]byte, 32*cos.KiB)
+	)
+	_, _ = cryptorand.Read(data)
+	mfqn := writeDedup(t, mi, bck, data)
+
+	// twice: the second time around all chunks are already there
+	for i := 0; i < 2; i++ {
+		tassert.CheckFatal(t, copyChunks(mi, dst, bck, mfqn, buf))
+	}
+	// the source chunks are gone - the copy must be self-sufficient
+	tassert.CheckFatal(t, os.RemoveAll(mi.MakePathCT(bck, fs.DedupType)))
+	dfqn := filepath.Join(dst.Path, "manifest")
+	tassert.CheckFatal(t, os.Rename(mfqn, dfqn))
+
+	r, err := newDedupReader(dst, bck, dfqn)
+	tassert.CheckFatal(t, err)
+	b, err := io.ReadAll(r)
+	r.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, data), "content of the copied object differs")
+}
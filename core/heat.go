@@ -0,0 +1,136 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"math/bits"
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Per-object access statistics (enabled via feat.TrackObjectAccess):
+// - each target counts GET accesses and records last-access times of the objects it stores;
+// - tracked objects are kept per bucket, in memory, and their number is bounded (heatMaxObjs);
+// - when exceeded, the coldest quarter gets evicted (still accounted for in the totals);
+// - the resulting heatmap (top-N hottest objects and access histogram) is aggregated
+//   by the proxy - see api.GetBucketHeatmap.
+
+const (
+	heatMaxObjs  = 64 * 1024 // max number of tracked objects per bucket (and target)
+	heatEvictCnt = heatMaxObjs / 4
+)
+
+type (
+	heatObj struct {
+		cnt   int64
+		atime int64
+	}
+	heatBck struct {
+		objs    map[string]*heatObj // by object name
+		total   int64
+		evicted int64
+		mu      sync.Mutex
+	}
+	heatmap struct {
+		bcks map[string]*heatBck // by bucket uname
+		mu   sync.RWMutex
+	}
+)
+
+var heat = heatmap{bcks: make(map[string]*heatBck, 16)}
+
+func TrackAccess(lom *LOM, atime int64) {
+	hb := heat.get(lom.Bucket().MakeUname(""))
+	hb.mu.Lock()
+	hb.total++
+	if ho, ok := hb.objs[lom.ObjName]; ok {
+		ho.cnt++
+		ho.atime = atime
+	} else {
+		if len(hb.objs) >= heatMaxObjs {
+			hb.evict()
+		}
+		hb.objs[lom.ObjName] = &heatObj{cnt: 1, atime: atime}
+	}
+	hb.mu.Unlock()
+}
+
+// returns nil when the bucket is not being tracked
+func GetHeatmap(bck *cmn.Bck, topN int) *apc.Heatmap {
+	heat.mu.RLock()
+	hb, ok := heat.bcks[bck.MakeUname("")]
+	heat.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	hb.mu.Lock()
+	h := &apc.Heatmap{
+		Top:     make([]apc.HeatmapEntry, 0, len(hb.objs)),
+		Total:   hb.total,
+		Tracked: int64(len(hb.objs)),
+		Evicted: hb.evicted,
+	}
+	for name, ho := range hb.objs {
+		i := bits.Len64(uint64(ho.cnt)) - 1
+		for len(h.Histogram) <= i {
+			h.Histogram = append(h.Histogram, 0)
+		}
+		h.Histogram[i]++
+		h.Top = append(h.Top, apc.HeatmapEntry{Name: name, Count: ho.cnt, Atime: ho.atime})
+	}
+	hb.mu.Unlock()
+
+	h.SortTop(topN)
+	return h
+}
+
+// stop tracking (e.g., destroyed) buckets
+func UntrackBck(bcks ...*meta.Bck) {
+	heat.mu.Lock()
+	for _, b := range bcks {
+		delete(heat.bcks, b.MakeUname(""))
+	}
+	heat.mu.Unlock()
+}
+
+func (hm *heatmap) get(buname string) (hb *heatBck) {
+	hm.mu.RLock()
+	hb, ok := hm.bcks[buname]
+	hm.mu.RUnlock()
+	if ok {
+		return hb
+	}
+	hm.mu.Lock()
+	if hb, ok = hm.bcks[buname]; !ok {
+		hb = &heatBck{objs: make(map[string]*heatObj, 64)}
+		hm.bcks[buname] = hb
+	}
+	hm.mu.Unlock()
+	return hb
+}
+
+// evict the coldest (least accessed and, secondly, least recently accessed) objects
+// (is called under lock)
+func (hb *heatBck) evict() {
+	names := make([]string, 0, len(hb.objs))
+	for name := range hb.objs {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		oi, oj := hb.objs[names[i]], hb.objs[names[j]]
+		if oi.cnt != oj.cnt {
+			return oi.cnt < oj.cnt
+		}
+		return oi.atime < oj.atime
+	})
+	for _, name := range names[:heatEvictCnt] {
+		delete(hb.objs, name)
+	}
+	hb.evicted += heatEvictCnt
+}
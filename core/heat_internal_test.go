@@ -0,0 +1,58 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHeatmap(t *testing.T) {
+	bck := &cmn.Bck{Name: "heat", Provider: apc.AIS}
+	hb := heat.get(bck.MakeUname(""))
+	for i := 1; i <= 10; i++ {
+		hb.objs["obj"+strconv.Itoa(i)] = &heatObj{cnt: int64(i), atime: int64(i)}
+		hb.total += int64(i)
+	}
+	h := GetHeatmap(bck, 3)
+	tassert.Fatalf(t, h != nil, "expecting heatmap")
+	tassert.Errorf(t, h.Total == 55 && h.Tracked == 10, "wrong totals: %+v", h)
+	tassert.Fatalf(t, len(h.Top) == 3, "expecting top 3, got %d", len(h.Top))
+	tassert.Errorf(t, h.Top[0].Name == "obj10" && h.Top[2].Name == "obj8", "wrong order: %+v", h.Top)
+
+	// counts 1, 2-3, 4-7, 8-10
+	expected := []int64{1, 2, 4, 3}
+	tassert.Fatalf(t, len(h.Histogram) == len(expected), "wrong histogram %v", h.Histogram)
+	for i := range expected {
+		tassert.Errorf(t, h.Histogram[i] == expected[i], "histogram[%d]: %d vs %d", i, h.Histogram[i], expected[i])
+	}
+
+	// merge with itself
+	other := GetHeatmap(bck, 3)
+	h.Merge(other, 4)
+	tassert.Errorf(t, h.Total == 110 && len(h.Top) == 4 && h.Histogram[2] == 8, "wrong merge: %+v", h)
+
+	UntrackBck((*meta.Bck)(bck))
+	tassert.Errorf(t, GetHeatmap(bck, 3) == nil, "expecting untracked bucket")
+}
+
+func TestHeatmapEvict(t *testing.T) {
+	hb := &heatBck{objs: make(map[string]*heatObj, heatMaxObjs)}
+	for i := 0; i < heatMaxObjs; i++ {
+		hb.objs["obj"+strconv.Itoa(i)] = &heatObj{cnt: int64(i + 1), atime: int64(i)}
+	}
+	hb.evict()
+	tassert.Errorf(t, len(hb.objs) == heatMaxObjs-heatEvictCnt, "expecting %d, got %d", heatMaxObjs-heatEvictCnt, len(hb.objs))
+	tassert.Errorf(t, hb.evicted == heatEvictCnt, "expecting %d evicted, got %d", heatEvictCnt, hb.evicted)
+	_, ok := hb.objs["obj0"]
+	tassert.Errorf(t, !ok, "expecting the coldest object to be evicted")
+	_, ok = hb.objs["obj"+strconv.Itoa(heatMaxObjs-1)]
+	tassert.Errorf(t, ok, "expecting the hottest object to remain")
+}
@@ -226,6 +226,19 @@ func (lom *LOM) Copy(mi *fs.Mountpath, buf []byte) (err error) {
 		copyFQN = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
 		workFQN = mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
 	)
+	if lom.md.packed {
+		// packed objects have no copies - can only move to their hrw location
+		if copyFQN != lom.HrwFQN {
+			return fmt.Errorf("%s: cannot mirror packed object", lom)
+		}
+		return lom.copyPacked(mi)
+	}
+	if lom.md.dedup {
+		// the manifest is of no use without its chunks (that are stored per mountpath)
+		if err = copyChunks(lom.mi, mi, lom.Bucket(), lom.FQN, buf); err != nil {
+			return err
+		}
+	}
 	// check if the copy destination exists and then skip copying if it's also identical
 	if errExists := cos.Stat(copyFQN); errExists == nil {
 		cplom := AllocLOM(lom.ObjName)
@@ -276,6 +289,33 @@ func (lom *LOM) Copy2FQN(dstFQN string, buf []byte) (dst *LOM, err error) {
 	return
 }
 
+func (lom *LOM) copyDedup(workFQN string, buf []byte, cksumType string) (cksum *cos.CksumHash, err error) {
+	var (
+		r   cos.LomReader
+		wfh *os.File
+	)
+	if r, err = lom.NewHandle(); err != nil {
+		return nil, err
+	}
+	if wfh, err = cos.CreateFile(workFQN); err != nil {
+		cos.Close(r)
+		return nil, err
+	}
+	_, cksum, err = cos.CopyAndChecksum(wfh, r, buf, cksumType)
+	cos.Close(r)
+	if err == nil {
+		err = cos.FlushClose(wfh)
+	} else {
+		cos.Close(wfh)
+	}
+	if err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			nlog.Errorln("nested err:", errRemove)
+		}
+	}
+	return cksum, err
+}
+
 func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 	var (
 		dstCksum  *cos.CksumHash
@@ -298,12 +338,27 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 	}
 
 	workFQN := fs.CSM.Gen(dst, fs.WorkfileType, fs.WorkfileCopy)
-	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, cksumType)
+	if lom.md.dedup || lom.md.packed {
+		// reassemble (or unpack): destination may (and likely will) reside on a different
+		// mountpath and/or in a bucket that does not deduplicate (pack)
+		dstCksum, err = lom.copyDedup(workFQN, buf, cksumType)
+		dst.md.dedup, dst.md.packed = false, false
+	} else {
+		_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, cksumType)
+	}
 	if err != nil {
 		return
 	}
+	if dedup := &dst.Bprops().Dedup; dedup.Enabled {
+		if _, err = dst.Dedup(workFQN, dedup); err != nil {
+			if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+				nlog.Errorln("nested err:", errRemove)
+			}
+			return
+		}
+	}
 
-	if err = cos.Rename(workFQN, dstFQN); err != nil {
+	if err = dst.RenameFrom(workFQN); err != nil {
 		if errRemove := cos.RemoveFile(workFQN); errRemove != nil && !os.IsNotExist(errRemove) {
 			nlog.Errorln("nested err:", errRemove)
 		}
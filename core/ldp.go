@@ -42,7 +42,7 @@ func (r *deferROC) Close() (err error) {
 
 // is called under rlock; unlocks on fail
 func (lom *LOM) NewDeferROC() (cos.ReadOpenCloser, error) {
-	fh, err := cos.NewFileHandle(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err == nil {
 		return &deferROC{fh, lom.LIF()}, nil
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 )
 
 // (compare with cos.CreateFile)
@@ -56,11 +57,17 @@ func (lom *LOM) Remove(force ...bool) (err error) {
 	if os.IsNotExist(err) {
 		err = nil
 	}
+	if errP := lom.unpack(); errP != nil {
+		err = errP
+	}
 	for copyFQN := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !os.IsNotExist(erc) {
 			err = erc
 		}
 	}
+	if lom.bck.Props != nil && lom.bck.IsAIS() && lom.VersionConf().Retain > 0 {
+		lom.RemovePrev()
+	}
 	lom.md.bckID = 0
 	return err
 }
@@ -71,8 +78,24 @@ func (lom *LOM) RenameFrom(workfqn string) error {
 	if err := cos.Stat(bdir); err != nil {
 		return fmt.Errorf("%s(bdir: %s): %w", lom, bdir, err)
 	}
+	if bprops := lom.Bprops(); bprops != nil && bprops.Packing.Enabled && !lom.md.dedup {
+		conf := &bprops.Packing
+		finfo, err := os.Stat(workfqn)
+		if err != nil {
+			return cmn.NewErrFailedTo(T, "finalize", lom, err)
+		}
+		if finfo.Size() <= int64(conf.MaxObjSize) {
+			if err := lom.pack(workfqn, finfo.Size(), conf); err != nil {
+				return cmn.NewErrFailedTo(T, "pack", lom, err)
+			}
+			return nil
+		}
+	}
 	if err := cos.Rename(workfqn, lom.FQN); err != nil {
 		return cmn.NewErrFailedTo(T, "finalize", lom, err)
 	}
+	if err := lom.unpack(); err != nil {
+		nlog.Errorln("failed to remove packed version of", lom.Cname(), "err:", err)
+	}
 	return nil
 }
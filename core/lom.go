@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/ios"
@@ -52,6 +53,9 @@ type (
 		cmn.ObjAttrs
 		atimefs uint64 // NOTE: high bit is reserved for `dirty`
 		bckID   uint64
+		vtime   int64 // last time validated against remote (mono time; in-memory only - not persisted)
+		dedup   bool  // see dedup.go
+		packed  bool  // see pack.go (in-memory only - not persisted)
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -103,6 +107,7 @@ func Tinit(t Target, tstats cos.StatsUpdater, runHK bool) {
 	}
 	if runHK {
 		regLomCacheWithHK()
+		regPackWithHK()
 	}
 }
 
@@ -151,10 +156,25 @@ func (lom *LOM) ValidateWarmGet(qparam string /*apc.QparamLatestVer*/) bool {
 	}
 }
 
+// whether the object was validated against its remote counterpart within a given interval
+// (see cmn.VersionConf.ValidateWarmGetIval)
+func (lom *LOM) ValidatedWithin(ival time.Duration) bool {
+	return ival > 0 && lom.md.vtime != 0 && mono.Since(lom.md.vtime) < ival
+}
+
+// update cached metadata as well (benign race)
+func (lom *LOM) SetVtime(now int64) {
+	lom.md.vtime = now
+	if _, lmd := lom.fromCache(); lmd != nil && lmd.uname == lom.md.uname {
+		lmd.vtime = now
+	}
+}
+
 func (lom *LOM) Uname() string  { return lom.md.uname }
 func (lom *LOM) Digest() uint64 { return lom.digest }
 
-func (lom *LOM) SetSize(size int64)    { lom.md.Size = size }
+// NOTE: new content - resets dedup (if any)
+func (lom *LOM) SetSize(size int64)    { lom.md.Size, lom.md.dedup = size, false }
 func (lom *LOM) SetVersion(ver string) { lom.md.Ver = ver }
 
 func (lom *LOM) Checksum() *cos.Cksum          { return lom.md.Cksum }
@@ -171,6 +191,12 @@ func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
 
 func (lom *LOM) GetCustomKey(key string) (string, bool) { return lom.md.GetCustomKey(key) }
 func (lom *LOM) SetCustomKey(key, value string)         { lom.md.SetCustomKey(key, value) }
+func (lom *LOM) DelCustomKeys(keys ...string)           { lom.md.DelCustomKeys(keys...) }
+
+func (lom *LOM) IsPinned() bool {
+	_, ok := lom.md.GetCustomKey(cmn.PinnedObjMD)
+	return ok
+}
 
 // lom <= transport.ObjHdr (NOTE: caller must call freeLOM)
 func AllocLomFromHdr(hdr *transport.ObjHdr) (lom *LOM, err error) {
@@ -371,11 +397,11 @@ func (lom *LOM) ComputeSetCksum() (*cos.Cksum, error) {
 }
 
 func (lom *LOM) ComputeCksum(cksumType string) (cksum *cos.CksumHash, err error) {
-	var file *os.File
+	var file cos.LomReader
 	if cksumType == cos.ChecksumNone {
 		return
 	}
-	if file, err = os.Open(lom.FQN); err != nil {
+	if file, err = lom.NewHandle(); err != nil {
 		return
 	}
 	// No need to allocate `buf` as `io.Discard` has efficient `io.ReaderFrom` implementation.
@@ -537,11 +563,14 @@ func (lom *LOM) fromCache() (lcache *sync.Map, lmd *lmeta) {
 }
 
 func (lom *LOM) FromFS() error {
+	lom.md.packed = false
 	finfo, atimefs, err := ios.FinfoAtime(lom.FQN)
 	if err != nil {
 		if !os.IsNotExist(err) {
 			err = os.NewSyscallError("stat", err)
 			T.FSHC(err, lom.FQN)
+		} else if found, errP := lom.fromPack(); found {
+			return errP
 		}
 		return err
 	}
@@ -559,7 +588,7 @@ func (lom *LOM) FromFS() error {
 		return err
 	}
 	// fstat & atime
-	if lom.md.Size != finfo.Size() { // corruption or tampering
+	if lom.md.Size != finfo.Size() && !lom.md.dedup { // corruption or tampering
 		return cmn.NewErrLmetaCorrupted(lom.whingeSize(finfo.Size()))
 	}
 	lom.md.Atime = atimefs
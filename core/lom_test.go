@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
@@ -33,6 +34,7 @@ var _ = Describe("LOM", func() {
 		bucketLocalA = "LOM_TEST_Local_A"
 		bucketLocalB = "LOM_TEST_Local_B"
 		bucketLocalC = "LOM_TEST_Local_C"
+		bucketPacked = "LOM_TEST_Packed"
 
 		bucketCloudA = "LOM_TEST_Cloud_A"
 		bucketCloudB = "LOM_TEST_Cloud_B"
@@ -72,6 +74,7 @@ var _ = Describe("LOM", func() {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.VersionType, &fs.VersionContentResolver{}, true)
 
 	bmd := mock.NewBaseBownerMock(
 		meta.NewBck(
@@ -92,6 +95,14 @@ var _ = Describe("LOM", func() {
 			},
 		),
 		meta.NewBck(sameBucketName, apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 4}),
+		meta.NewBck(
+			bucketPacked, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{
+				Cksum:   cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Packing: cmn.PackConf{Enabled: true, MaxObjSize: cos.KiB, ContainerSize: cos.MiB, CompactPct: 50},
+				BID:     8,
+			},
+		),
 		meta.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 5}),
 		meta.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 6}),
 		meta.NewBck(sameBucketName, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 7}),
@@ -562,6 +573,83 @@ var _ = Describe("LOM", func() {
 			})
 		})
 
+		Describe("PrevVersions", func() {
+			testObject := "foldr/test-obj-ver.ext"
+			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
+
+			It("should retain and load previous versions", func() {
+				lom := filePut(localFQN, 16)
+				for i := 0; i < 3; i++ {
+					verFQN, err := lom.RetainPrev()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(verFQN).NotTo(BeEmpty())
+					createTestFile(localFQN, 16+i)
+					lom.SetSize(int64(16 + i))
+					Expect(lom.IncVersion()).NotTo(HaveOccurred())
+					Expect(persist(lom)).NotTo(HaveOccurred())
+					Expect(lom.TrimPrev(2)).NotTo(HaveOccurred())
+				}
+				Expect(lom.Version()).To(Equal("4"))
+
+				vers, err := lom.PrevVersions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vers).To(Equal([]string{"3", "2"}))
+
+				prev, err := lom.LoadPrev("3")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(prev.Version()).To(Equal("3"))
+				Expect(prev.SizeBytes()).To(BeEquivalentTo(17))
+				core.FreeLOM(prev)
+
+				_, err = lom.LoadPrev("1")
+				Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+
+				lom.RemovePrev()
+				vers, err = lom.PrevVersions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vers).To(BeEmpty())
+			})
+		})
+
+		Describe("ValidatedWithin", func() {
+			testObject := "foldr/test-obj-vtime.ext"
+			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
+
+			It("should honor validation interval", func() {
+				lom := filePut(localFQN, 0)
+				Expect(lom.Load(true, false)).NotTo(HaveOccurred())
+
+				// never validated
+				Expect(lom.ValidatedWithin(time.Hour)).To(BeFalse())
+
+				lom.SetVtime(mono.NanoTime())
+				Expect(lom.ValidatedWithin(time.Hour)).To(BeTrue())
+				// zero interval: always validate
+				Expect(lom.ValidatedWithin(0)).To(BeFalse())
+
+				lom.SetVtime(mono.NanoTime() - int64(2*time.Hour))
+				Expect(lom.ValidatedWithin(time.Hour)).To(BeFalse())
+				Expect(lom.ValidatedWithin(3 * time.Hour)).To(BeTrue())
+			})
+
+			It("should update cached metadata", func() {
+				lom := filePut(localFQN, 0)
+				Expect(lom.Load(true, false)).NotTo(HaveOccurred())
+				lom.SetVtime(mono.NanoTime())
+
+				// new LOM, same object: loaded from cache
+				lom2 := NewBasicLom(localFQN)
+				Expect(lom2.Load(true, false)).NotTo(HaveOccurred())
+				Expect(lom2.ValidatedWithin(time.Hour)).To(BeTrue())
+
+				// not persisted
+				lom2.Uncache()
+				lom3 := NewBasicLom(localFQN)
+				Expect(lom3.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom3.ValidatedWithin(time.Hour)).To(BeFalse())
+			})
+		})
+
 		Describe("CustomMD", func() {
 			testObject := "foldr/test-obj.ext"
 			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
@@ -994,6 +1082,78 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("packed objects", func() {
+		It("should pack small objects and load, read, and remove them", func() {
+			var (
+				objName = "packed/obj"
+				bck     = cmn.Bck{Name: bucketPacked, Provider: apc.AIS, Ns: cmn.NsGlobal}
+			)
+			put := func(size int) (lom *core.LOM, data []byte) {
+				lom = &core.LOM{ObjName: objName}
+				Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+				workFQN := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+				createTestFile(workFQN, size)
+				data, err := os.ReadFile(workFQN)
+				Expect(err).NotTo(HaveOccurred())
+				lom.SetSize(int64(size))
+				lom.SetAtimeUnix(time.Now().UnixNano())
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.RenameFrom(workFQN)).NotTo(HaveOccurred())
+				Expect(lom.PersistMain()).NotTo(HaveOccurred())
+				lom.Uncache()
+				return lom, data
+			}
+			load := func() *core.LOM {
+				lom := &core.LOM{ObjName: objName}
+				Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				return lom
+			}
+			read := func(lom *core.LOM) []byte {
+				r, err := lom.NewHandle()
+				Expect(err).NotTo(HaveOccurred())
+				defer r.Close()
+				b, err := io.ReadAll(r)
+				Expect(err).NotTo(HaveOccurred())
+				return b
+			}
+
+			// small: packed
+			lom, data := put(100)
+			Expect(lom.IsPacked()).To(BeTrue())
+			Expect(lom.FQN).NotTo(BeAnExistingFile())
+			lom = load()
+			Expect(lom.IsPacked()).To(BeTrue())
+			Expect(lom.SizeBytes()).To(BeEquivalentTo(100))
+			Expect(read(lom)).To(Equal(data))
+			Expect(lom.ValidateContentChecksum()).NotTo(HaveOccurred())
+
+			// large: regular file that replaces the packed version
+			lom, data = put(2 * cos.KiB)
+			Expect(lom.IsPacked()).To(BeFalse())
+			Expect(lom.FQN).To(BeAnExistingFile())
+			lom = load()
+			Expect(lom.IsPacked()).To(BeFalse())
+			Expect(read(lom)).To(Equal(data))
+
+			// small again
+			_, data = put(10)
+			Expect(lom.FQN).NotTo(BeAnExistingFile())
+			lom = load()
+			Expect(lom.IsPacked()).To(BeTrue())
+			Expect(read(lom)).To(Equal(data))
+
+			lom.Lock(true)
+			Expect(lom.Remove()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			lom = &core.LOM{ObjName: objName}
+			Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+			err := lom.Load(false, false)
+			Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+		})
+	})
+
 	Describe("local and cloud bucket with the same name", func() {
 		It("should have different fqn", func() {
 			testObject := "foldr/test-obj.ext"
@@ -58,6 +58,7 @@ const (
 	lomObjSize
 	lomObjCopies
 	lomCustomMD
+	lomObjDedup // object content is a manifest of deduplicated chunks (see dedup.go)
 )
 
 // packing format separators
@@ -166,7 +167,7 @@ func (lom *LOM) PersistMain() (err error) {
 	}
 	// write-immediate (default)
 	buf := lom.marshal()
-	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	if err = lom.setXattr(buf); err != nil {
 		lom.Uncache()
 		T.FSHC(err, lom.FQN)
 	} else {
@@ -194,7 +195,7 @@ func (lom *LOM) Persist() (err error) {
 	}
 
 	buf := lom.marshal()
-	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	if err = lom.setXattr(buf); err != nil {
 		lom.Uncache()
 		T.FSHC(err, lom.FQN)
 	} else {
@@ -238,13 +239,16 @@ func (lom *LOM) flushCold(md *lmeta, atime time.Time) {
 		return
 	}
 	buf := lom.marshal()
-	if err := fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	if err := lom.setXattr(buf); err != nil {
 		T.FSHC(err, lom.FQN)
 	}
 	g.smm.Free(buf)
 }
 
 func (lom *LOM) flushAtime(atime time.Time) error {
+	if lom.md.packed {
+		return lom.packSetMD(nil, atime.UnixNano())
+	}
 	finfo, err := os.Stat(lom.FQN)
 	if err != nil {
 		return err
@@ -253,6 +257,13 @@ func (lom *LOM) flushAtime(atime time.Time) error {
 	return os.Chtimes(lom.FQN, atime, mtime)
 }
 
+func (lom *LOM) setXattr(buf []byte) error {
+	if lom.md.packed {
+		return lom.packSetMD(buf, lom.md.Atime)
+	}
+	return fs.SetXattr(lom.FQN, XattrLOM, buf)
+}
+
 func (lom *LOM) marshal() (buf []byte) {
 	lmsize := g.maxLmeta.Load()
 	buf = lom.md.marshal(lmsize)
@@ -302,6 +313,7 @@ func (md *lmeta) unmarshal(buf []byte) error {
 		haveCksumType, haveCksumValue     bool
 		last                              bool
 	)
+	md.dedup = false
 	if len(buf) < prefLen {
 		return fmt.Errorf("%s: too short (%d)", invalid, len(buf))
 	}
@@ -390,6 +402,8 @@ func (md *lmeta) unmarshal(buf []byte) error {
 				custom[entries[i]] = entries[i+1]
 			}
 			md.SetCustomMD(custom)
+		case lomObjDedup:
+			md.dedup = true
 		default:
 			return errors.New(invalid + " #6")
 		}
@@ -430,6 +444,10 @@ func (md *lmeta) marshal(mdSize int64) (buf []byte) {
 		buf = _marshRecord(buf, lomCustomMD, "", false)
 		buf = _marshCustomMD(buf, custom)
 	}
+	if md.dedup {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomObjDedup, "", false)
+	}
 
 	// checksum, prepend, and return
 	buf[0] = cmn.MetaverLOM
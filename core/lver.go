@@ -0,0 +1,165 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Previous object versions (ais:// buckets with versioning.retain > 0):
+// - upon overwrite, the existing object gets hard-linked as fs.VersionType content
+//   named "<object-name>.<version>" - on the same mountpath and with its metadata (xattrs) intact;
+// - at most versioning.retain versions are kept (older ones get removed right away);
+// - GET with apc.QparamObjVersion reads a given version;
+// - versions are removed together with the object and, otherwise, by LRU (lru.version_evict_time)
+//   and space cleanup;
+// - not supported: dedup-ed, packed, and composite objects (no previous versions kept).
+// Previous versions are not replicated (mirrored, erasure coded) and do not migrate
+// (rebalance, resilver) with their objects.
+
+func (lom *LOM) versionFQN(ver string) string { return fs.CSM.Gen(lom, fs.VersionType, ver) }
+
+// RetainPrev hard-links the current (about to be overwritten) object as its previous version
+// and returns the resulting FQN, or empty string if there's nothing to retain.
+// Must be called under wlock, prior to overwriting; upon success the caller then either
+// calls TrimPrev (object overwritten) or removes the returned FQN (failed to overwrite).
+func (lom *LOM) RetainPrev() (string, error) {
+	prev := lom.CloneMD(lom.FQN)
+	defer FreeLOM(prev)
+	prev.md = lmeta{}
+	if err := prev.LoadMetaFromFS(); err != nil {
+		if cos.IsNotExist(err, 0) || cmn.IsErrLmetaNotFound(err) {
+			return "", nil // (new object)
+		}
+		return "", err
+	}
+	prev.md.bckID = lom.Bprops().BID
+	ver := prev.md.Ver
+	if ver == "" || prev.md.dedup || prev.IsComposite() {
+		return "", nil
+	}
+	verFQN := lom.versionFQN(ver)
+	if err := os.Link(lom.FQN, verFQN); err != nil {
+		switch {
+		case os.IsExist(err): // e.g., versioning disabled and re-enabled
+			if err = cos.RemoveFile(verFQN); err == nil {
+				err = os.Link(lom.FQN, verFQN)
+			}
+		case os.IsNotExist(err):
+			if err = cos.CreateDir(filepath.Dir(verFQN)); err == nil {
+				err = os.Link(lom.FQN, verFQN)
+			}
+		}
+		if err != nil {
+			return "", cmn.NewErrFailedTo(T, "retain previous version of", lom.Cname(), err)
+		}
+	}
+	return verFQN, nil
+}
+
+// TrimPrev keeps `retain` most recent previous versions and removes the rest.
+// Must be called under wlock, after the object has been overwritten.
+func (lom *LOM) TrimPrev(retain int) error {
+	vers, err := lom.PrevVersions()
+	if err != nil {
+		return err
+	}
+	for i := retain; i < len(vers); i++ {
+		if err := cos.RemoveFile(lom.versionFQN(vers[i])); err != nil && !os.IsNotExist(err) {
+			nlog.Warningln("failed to remove", lom.Cname(), "version", vers[i], "err:", err)
+		}
+	}
+	return nil
+}
+
+// PrevVersions returns previous versions of the object, most recent first.
+func (lom *LOM) PrevVersions() ([]string, error) {
+	var (
+		dir, base = filepath.Split(lom.versionFQN("0"))
+		prefix    = strings.TrimSuffix(base, "0")
+	)
+	dentries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	var (
+		vers []string
+		nums []int64
+	)
+	for _, de := range dentries {
+		name := de.Name()
+		if de.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		ver := name[len(prefix):]
+		n, err := strconv.ParseInt(ver, 10, 64) // (ais versions are numeric; excludes "<object-name>.x.<version>")
+		if err != nil {
+			continue
+		}
+		vers = append(vers, ver)
+		nums = append(nums, n)
+	}
+	sort.Sort(&byVer{vers, nums})
+	return vers, nil
+}
+
+// LoadPrev loads metadata of the given previous version; the caller must free the returned LOM.
+// Must be called under rlock.
+func (lom *LOM) LoadPrev(ver string) (*LOM, error) {
+	if _, err := strconv.ParseInt(ver, 10, 64); err != nil {
+		return nil, cos.NewErrNotFound(T, lom.Cname()+" version "+strconv.Quote(ver))
+	}
+	prev := lom.CloneMD(lom.versionFQN(ver))
+	prev.md = lmeta{}
+	if err := prev.LoadMetaFromFS(); err != nil {
+		FreeLOM(prev)
+		if cos.IsNotExist(err, 0) {
+			return nil, cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+		}
+		return nil, err
+	}
+	prev.md.copies = nil
+	prev.md.bckID = lom.Bprops().BID
+	return prev, nil
+}
+
+// RemovePrev removes all previous versions of the object.
+func (lom *LOM) RemovePrev() {
+	vers, err := lom.PrevVersions()
+	if err != nil {
+		nlog.Warningln("failed to list", lom.Cname(), "versions:", err)
+		return
+	}
+	for _, ver := range vers {
+		if err := cos.RemoveFile(lom.versionFQN(ver)); err != nil && !os.IsNotExist(err) {
+			nlog.Warningln("failed to remove", lom.Cname(), "version", ver, "err:", err)
+		}
+	}
+}
+
+// most recent first
+type byVer struct {
+	vers []string
+	nums []int64
+}
+
+func (b *byVer) Len() int           { return len(b.vers) }
+func (b *byVer) Less(i, j int) bool { return b.nums[i] > b.nums[j] }
+func (b *byVer) Swap(i, j int) {
+	b.vers[i], b.vers[j] = b.vers[j], b.vers[i]
+	b.nums[i], b.nums[j] = b.nums[j], b.nums[i]
+}
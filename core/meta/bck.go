@@ -224,6 +224,7 @@ func (b *Bck) VersionConf() cmn.VersionConf {
 	if backend := b.Backend(); backend != nil && backend.Props != nil {
 		conf := backend.Props.Versioning
 		conf.ValidateWarmGet = b.Props.Versioning.ValidateWarmGet
+		conf.ValidateWarmGetIval = b.Props.Versioning.ValidateWarmGetIval
 		return conf
 	}
 	return b.Props.Versioning
@@ -236,6 +237,9 @@ func (b *Bck) VersionConf() cmn.VersionConf {
 func (b *Bck) Allow(bit apc.AccessAttrs) error { return b.checkAccess(bit) }
 
 func (b *Bck) checkAccess(bit apc.AccessAttrs) (err error) {
+	if b.Props.Frozen && bit&apc.AccessFrozen != 0 {
+		return cmn.NewErrBucketFrozen(b.String(), (bit & apc.AccessFrozen).Describe(true))
+	}
 	if b.Props.Access.Has(bit) {
 		return
 	}
@@ -117,4 +117,19 @@ var _ = Describe("Bck", func() {
 			),
 		)
 	})
+
+	Describe("Allow", func() {
+		It("should deny modifications of a frozen bucket regardless of access", func() {
+			bck := meta.NewBck("a", apc.AIS, cmn.NsGlobal)
+			bck.Props = &cmn.Bprops{Access: apc.AccessAll, Frozen: true}
+			Expect(bck.Allow(apc.AceGET)).To(Succeed())
+			Expect(bck.Allow(apc.AccessRO)).To(Succeed())
+			Expect(bck.Allow(apc.AcePUT)).To(HaveOccurred())
+			Expect(bck.Allow(apc.AceObjDELETE)).To(HaveOccurred())
+			Expect(bck.Allow(apc.AccessRW)).To(HaveOccurred())
+
+			bck.Props.Frozen = false
+			Expect(bck.Allow(apc.AccessRW)).To(Succeed())
+		})
+	})
 })
@@ -6,6 +6,7 @@ package meta
 
 import (
 	"fmt"
+	"math"
 	"sync/atomic"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -18,6 +19,11 @@ import (
 // A variant of consistent hash based on rendezvous algorithm by Thaler and Ravishankar,
 // aka highest random weight (HRW)
 // See also: fs/hrw.go
+//
+// Capacity-weighted HRW (config.Rebalance.CapWeights) selects targets in proportion to their
+// respective total capacities (Snode.Capacity) - see `hrwScore` below.
+//
+// Partition-map placement (config.Rebalance.Partitions) - see `placement` and `PartTable` below.
 
 var robin atomic.Uint64 // round
 
@@ -44,12 +50,16 @@ func (smap *Smap) HrwMultiHome(uname string) (si *Snode, netName string, err err
 }
 
 func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
-	var max uint64
+	var (
+		max      uint64
+		weighted = smap.weighted()
+	)
+	digest = placement(digest)
 	for _, tsi := range smap.Tmap {
 		if tsi.InMaintOrDecomm() { // always skipping targets 'in maintenance mode'
 			continue
 		}
-		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
+		cs := hrwScore(tsi, digest, weighted)
 		if cs >= max {
 			max = cs
 			si = tsi
@@ -63,9 +73,13 @@ func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 
 // NOTE: including targets 'in maintenance mode', if any
 func (smap *Smap) HrwHash2Tall(digest uint64) (si *Snode, err error) {
-	var max uint64
+	var (
+		max      uint64
+		weighted = smap.weighted()
+	)
+	digest = placement(digest)
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
+		cs := hrwScore(tsi, digest, weighted)
 		if cs >= max {
 			max = cs
 			si = tsi
@@ -145,6 +159,86 @@ func (smap *Smap) HrwTargetTask(uuid string) (si *Snode, err error) {
 	return si, err
 }
 
+// Weighted variant (aka weighted rendezvous hashing): score = w / -ln(u), where u is the
+// target's hash normalized to (0, 1), and w is the target's weight (capacity).
+// Notes:
+//   - positive float64 scores are ordered the same way as their respective IEEE 754 bits,
+//     which is why the latter can be compared (and sorted) as uint64 - same as unweighted;
+//   - with equal weights, the selection is exactly the same as the unweighted one
+//     (given that -1/ln(u) is monotonic in u).
+func hrwScore(tsi *Snode, digest uint64, weighted bool) uint64 {
+	cs := xoshiro256.Hash(tsi.Digest() ^ digest)
+	if !weighted {
+		return cs
+	}
+	u := (float64(cs>>11) + 0.5) / (1 << 53)
+	return math.Float64bits(float64(tsi.Capacity) / -math.Log(u))
+}
+
+// capacity weights are enabled and all active targets have reported their capacities
+// (otherwise, e.g. when upgrading, falling back to unweighted HRW)
+func (smap *Smap) weighted() bool {
+	if !cmn.Rom.CapWeights() {
+		return false
+	}
+	for _, tsi := range smap.Tmap {
+		if tsi.Capacity == 0 && !tsi.InMaintOrDecomm() {
+			return false
+		}
+	}
+	return true
+}
+
+// HrwScores returns all active targets sorted by their respective HRW scores for a given object
+// in descending order - the first target being the one that HrwName2T selects.
+// Used to explain object placement (see CLI 'ais advanced why').
+func (smap *Smap) HrwScores(uname string) (Nodes, []uint64) {
+	var (
+		digest   = placement(xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32))
+		hlist    = newHrwList(len(smap.Tmap))
+		weighted = smap.weighted()
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		hlist.add(hrwScore(tsi, digest, weighted), tsi)
+	}
+	return hlist.sis, hlist.hs
+}
+
+// Partition-map placement: objects hash into a fixed number of virtual partitions, and
+// it is partitions (rather than objects) that get HRW-assigned to targets. When a target
+// joins (or leaves), only the partitions that it wins (or loses) move - which also makes
+// it possible to compute the entire placement upfront (see PartTable).
+func placement(digest uint64) uint64 {
+	if n := cmn.Rom.Partitions(); n > 0 {
+		return partDigest(digest % uint64(n))
+	}
+	return digest
+}
+
+func partDigest(part uint64) uint64 { return xoshiro256.Hash(part + cos.MLCG32) }
+
+// PartTable returns partition => target table, whereby a given object
+// belongs to the partition `digest % len(table)`;
+// returns nil if partition-map placement is disabled (or there are no active targets).
+func (smap *Smap) PartTable() Nodes {
+	n := cmn.Rom.Partitions()
+	if n == 0 {
+		return nil
+	}
+	table := make(Nodes, n)
+	for i := range table {
+		tsi, err := smap.HrwHash2T(uint64(i)) // (i % n == i)
+		if err != nil {
+			return nil
+		}
+		table[i] = tsi
+	}
+	return table
+}
+
 /////////////
 // hrwList //
 /////////////
@@ -167,15 +261,16 @@ func (smap *Smap) HrwTargetList(uname string, count int) (sis Nodes, err error)
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, cnt, smap)
 		return
 	}
-	digest := xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
-	hlist := newHrwList(count)
-
+	var (
+		digest   = placement(xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32))
+		hlist    = newHrwList(count)
+		weighted = smap.weighted()
+	)
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
 		if tsi.InMaintOrDecomm() {
 			continue
 		}
-		hlist.add(cs, tsi)
+		hlist.add(hrwScore(tsi, digest, weighted), tsi)
 	}
 	sis = hlist.get()
 	if count != cnt && len(sis) < count {
@@ -0,0 +1,142 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HRW", func() {
+	const numObjs = 30000
+
+	newSmap := func(capacities ...uint64) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, len(capacities))}
+		for i, c := range capacities {
+			tsi := &meta.Snode{Capacity: c}
+			tsi.Init("t"+strconv.Itoa(i), apc.Target)
+			smap.Tmap[tsi.ID()] = tsi
+		}
+		return smap
+	}
+	setPlacement := func(capWeights bool, partitions int) {
+		var config cmn.ClusterConfig
+		config.Rebalance.CapWeights = capWeights
+		config.Rebalance.Partitions = partitions
+		cmn.Rom.Set(&config)
+	}
+	setCapWeights := func(enabled bool) { setPlacement(enabled, 0) }
+	place := func(smap *meta.Smap) map[string]string {
+		placement := make(map[string]string, numObjs)
+		for i := 0; i < numObjs; i++ {
+			uname := "bucket/obj-" + strconv.Itoa(i)
+			tsi, err := smap.HrwName2T(uname)
+			Expect(err).NotTo(HaveOccurred())
+			placement[uname] = tsi.ID()
+		}
+		return placement
+	}
+
+	AfterEach(func() {
+		setPlacement(false, 0)
+	})
+
+	It("should place objects in proportion to target capacities", func() {
+		setCapWeights(true)
+		smap := newSmap(1000, 2000, 1000)
+		counts := make(map[string]int, 3)
+		for _, tid := range place(smap) {
+			counts[tid]++
+		}
+		Expect(counts["t1"]).To(BeNumerically("~", numObjs/2, numObjs/20))
+		Expect(counts["t0"]).To(BeNumerically("~", numObjs/4, numObjs/20))
+		Expect(counts["t2"]).To(BeNumerically("~", numObjs/4, numObjs/20))
+	})
+
+	It("should not change placement when all capacities are equal", func() {
+		smap := newSmap(1000, 1000, 1000, 1000)
+		unweighted := place(smap)
+		setCapWeights(true)
+		Expect(place(smap)).To(Equal(unweighted))
+	})
+
+	It("should fall back to unweighted when capacity is unknown", func() {
+		smap := newSmap(1000, 4000, 0)
+		unweighted := place(smap)
+		setCapWeights(true)
+		Expect(place(smap)).To(Equal(unweighted))
+	})
+
+	It("should order weighted target lists", func() {
+		setCapWeights(true)
+		smap := newSmap(1000, 2000, 3000)
+		for i := 0; i < 100; i++ {
+			uname := "bucket/obj-" + strconv.Itoa(i)
+			sis, err := smap.HrwTargetList(uname, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(3))
+			tsi, err := smap.HrwName2T(uname)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0].ID()).To(Equal(tsi.ID()))
+		}
+	})
+
+	It("should sort targets by HRW scores", func() {
+		setCapWeights(true)
+		smap := newSmap(1000, 2000, 3000, 4000)
+		for i := 0; i < 100; i++ {
+			uname := "bucket/obj-" + strconv.Itoa(i)
+			sis, scores := smap.HrwScores(uname)
+			Expect(sis).To(HaveLen(4))
+			for j := 1; j < len(scores); j++ {
+				Expect(scores[j-1]).To(BeNumerically(">=", scores[j]))
+			}
+			tsi, err := smap.HrwName2T(uname)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0].ID()).To(Equal(tsi.ID()))
+		}
+	})
+
+	Describe("partition map", func() {
+		const numParts = 1024
+
+		It("should place objects via partition table", func() {
+			setPlacement(false, numParts)
+			smap := newSmap(1, 1, 1, 1)
+			table := smap.PartTable()
+			Expect(table).To(HaveLen(numParts))
+			for i := 0; i < 1000; i++ {
+				digest := uint64(i) * 0x9e3779b97f4a7c15
+				tsi, err := smap.HrwHash2T(digest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(table[digest%numParts].ID()).To(Equal(tsi.ID()))
+			}
+		})
+
+		It("should move only the partitions won by a new target", func() {
+			setPlacement(false, numParts)
+			before := newSmap(1, 1, 1, 1).PartTable()
+			after := newSmap(1, 1, 1, 1, 1).PartTable()
+			var moved int
+			for i := range after {
+				if after[i].ID() == before[i].ID() {
+					continue
+				}
+				Expect(after[i].ID()).To(Equal("t4"))
+				moved++
+			}
+			Expect(moved).To(BeNumerically("~", numParts/5, numParts/20))
+		})
+
+		It("should return nil table when disabled", func() {
+			Expect(newSmap(1, 1).PartTable()).To(BeNil())
+		})
+	})
+})
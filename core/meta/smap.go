@@ -71,7 +71,8 @@ type (
 		DaeType    string     `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string     `json:"daemon_id"`
 		name       string
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Flags      cos.BitFlags `json:"flags"`                  // enum { SnodeNonElectable, SnodeIC, ... }
+		Capacity   uint64       `json:"capacity_mib,omitempty"` // target's total capacity (MiB) - HRW weight
 		idDigest   uint64
 	}
 
@@ -106,6 +106,41 @@ func (bd *DBDriver) DeleteCollection(collection string) error {
 	return nil
 }
 
+func (bd *DBDriver) Iterate(collection, from string, desc bool, cb func(key, value string) bool) error {
+	var (
+		keys   []string
+		values = make(map[string]string)
+	)
+	bd.mtx.RLock()
+	prefix := bd.makePath(collection, "")
+	for k, v := range bd.values {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		if _, key := kvdb.ParsePath(k); key != "" && (desc || key >= from) {
+			keys = append(keys, key)
+			values[key] = v
+		}
+	}
+	bd.mtx.RUnlock()
+
+	sort.Strings(keys)
+	if desc {
+		for i := len(keys) - 1; i >= 0; i-- {
+			if !cb(keys[i], values[keys[i]]) {
+				break
+			}
+		}
+		return nil
+	}
+	for _, key := range keys {
+		if !cb(key, values[key]) {
+			break
+		}
+	}
+	return nil
+}
+
 func (bd *DBDriver) GetAll(collection, pattern string) (map[string]string, error) {
 	var (
 		values = make(map[string]string)
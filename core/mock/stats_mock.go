@@ -30,5 +30,6 @@ func (*StatsTracker) AddMany(...cos.NamedVal64)  {}
 func (*StatsTracker) RegMetrics(*meta.Snode)     {}
 func (*StatsTracker) GetMetricNames() cos.StrKVs { return nil }
 func (*StatsTracker) GetStats() *stats.Node      { return nil }
+func (*StatsTracker) GetSLO() []stats.SLOStatus  { return nil }
 func (*StatsTracker) ResetStats(bool)            {}
 func (*StatsTracker) IsPrometheus() bool         { return false }
@@ -5,6 +5,7 @@
 package core
 
 import (
+	"errors"
 	"strings"
 	"time"
 )
@@ -46,15 +47,28 @@ type (
 
 	// intra-cluster notification message
 	NotifMsg struct {
-		UUID     string `json:"uuid"`    // xaction UUID
-		NodeID   string `json:"node_id"` // notifier node ID
-		Kind     string `json:"kind"`    // xaction `Kind`
-		ErrMsg   string `json:"err"`     // error.Error()
-		Data     []byte `json:"message"` // (e.g. usage: custom progress stats)
-		AbortedX bool   `json:"aborted"` // true if aborted (see related: Snap.AbortedX)
+		UUID     string `json:"uuid"`            // xaction UUID
+		NodeID   string `json:"node_id"`         // notifier node ID
+		Kind     string `json:"kind"`            // xaction `Kind`
+		ErrMsg   string `json:"err"`             // error.Error()
+		Data     []byte `json:"message"`         // (e.g. usage: custom progress stats)
+		AbortedX bool   `json:"aborted"`         // true if aborted (see related: Snap.AbortedX)
+		ReqID    string `json:"reqid,omitempty"` // ID of the client request that started the xaction, if known
 	}
 )
 
+// error reported by the notifier, if any (with request ID, when known)
+func (msg *NotifMsg) Err() error {
+	switch {
+	case msg.ErrMsg == "":
+		return nil
+	case msg.ReqID == "":
+		return errors.New(msg.ErrMsg)
+	default:
+		return errors.New(msg.ErrMsg + " [reqid=" + msg.ReqID + "]")
+	}
+}
+
 func (msg *NotifMsg) String() (s string) {
 	var sb strings.Builder
 	sb.WriteString("nmsg-")
@@ -68,5 +82,10 @@ func (msg *NotifMsg) String() (s string) {
 		sb.WriteString(", err: ")
 		sb.WriteString(msg.ErrMsg)
 	}
+	if msg.ReqID != "" {
+		sb.WriteString(" [reqid=")
+		sb.WriteString(msg.ReqID)
+		sb.WriteByte(']')
+	}
 	return sb.String()
 }
@@ -0,0 +1,177 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Packed ("slab") objects (bucket-configurable, see cmn.PackConf and fs/pack.go):
+// - packing happens when the object gets finalized (lom.RenameFrom) - iff its size
+//   does not exceed the configured maximum;
+// - packed object has no file and no xattr of its own - its metadata is kept in the
+//   store's journal and gets loaded by lom.FromFS() when there's no regular file;
+// - reading is done via lom.NewHandle();
+// - packed objects do not have copies: copying to another mountpath (resilvering) moves;
+// - compaction runs periodically in the background (see packHK below).
+
+const packCompactIval = 10 * time.Minute
+
+var packCompacting atomic.Bool
+
+func (lom *LOM) IsPacked() bool { return lom.md.packed }
+
+// returns true if found (in which case the error, if any, is a load error)
+func (lom *LOM) fromPack() (bool, error) {
+	ps, err := fs.PackGet(lom.mi, lom.Bucket(), false)
+	if ps == nil {
+		if err != nil {
+			nlog.Errorln(err)
+		}
+		return false, nil
+	}
+	e, ok := ps.Get(lom.ObjName)
+	if !ok {
+		return false, nil
+	}
+	if err := lom.md.unmarshal(e.MD); err != nil {
+		return true, cmn.NewErrLmetaCorrupted(err)
+	}
+	if lom.md.Size != e.Size {
+		return true, cmn.NewErrLmetaCorrupted(lom.whingeSize(e.Size))
+	}
+	lom.md.packed = true
+	lom.md.Atime = e.Atime
+	lom.md.atimefs = uint64(e.Atime)
+	return true, nil
+}
+
+func (lom *LOM) packSetMD(md []byte, atime int64) error {
+	ps, err := fs.PackGet(lom.mi, lom.Bucket(), false)
+	if ps == nil {
+		if err == nil {
+			err = &os.PathError{Op: "pack-set-md", Path: lom.FQN, Err: os.ErrNotExist}
+		}
+		return err
+	}
+	return ps.SetMD(lom.ObjName, md, atime)
+}
+
+func (lom *LOM) openPacked() (cos.LomReader, error) {
+	ps, err := fs.PackGet(lom.mi, lom.Bucket(), false)
+	if ps == nil {
+		if err == nil {
+			err = &os.PathError{Op: "pack-open", Path: lom.FQN, Err: os.ErrNotExist}
+		}
+		return nil, err
+	}
+	return ps.Open(lom.ObjName)
+}
+
+// the workfile is small enough: append it to the store and remove the
+// previous (unpacked) version, if any
+func (lom *LOM) pack(workfqn string, size int64, conf *cmn.PackConf) error {
+	ps, err := fs.PackGet(lom.mi, lom.Bucket(), true)
+	if err != nil {
+		return err
+	}
+	fh, err := os.Open(workfqn)
+	if err != nil {
+		return err
+	}
+	lom.md.Size, lom.md.packed = size, true
+	buf := lom.marshal()
+	err = ps.Put(lom.ObjName, fh, size, buf, lom.md.Atime, conf)
+	g.smm.Free(buf)
+	cos.Close(fh)
+	if err != nil {
+		lom.md.packed = false
+		return err
+	}
+	if err := cos.RemoveFile(workfqn); err != nil {
+		nlog.Errorln("failed to remove packed workfile:", err)
+	}
+	if err := cos.RemoveFile(lom.FQN); err != nil {
+		nlog.Errorln("failed to remove unpacked version:", err)
+	}
+	return nil
+}
+
+// CopyContent copies packed or deduplicated object's content into a (work) file
+func (lom *LOM) CopyContent(workfqn string, buf []byte, cksumType string) (*cos.CksumHash, error) {
+	return lom.copyDedup(workfqn, buf, cksumType)
+}
+
+// remove packed version, if any
+func (lom *LOM) unpack() error {
+	lom.md.packed = false
+	ps, err := fs.PackGet(lom.mi, lom.Bucket(), false)
+	if ps == nil {
+		return err
+	}
+	_, err = ps.Del(lom.ObjName)
+	return err
+}
+
+// move packed object to another mountpath
+func (lom *LOM) copyPacked(mi *fs.Mountpath) error {
+	ps, err := fs.PackGet(mi, lom.Bucket(), true)
+	if err != nil {
+		return err
+	}
+	r, err := lom.NewHandle()
+	if err != nil {
+		return err
+	}
+	var (
+		conf = lom.Bprops().Packing
+		buf  = lom.marshal()
+	)
+	if !conf.Enabled {
+		conf.ContainerSize, conf.CompactPct = cmn.DefaultPackContainerSize, cmn.DefaultPackCompactPct
+	}
+	err = ps.Put(lom.ObjName, r, lom.md.Size, buf, lom.md.Atime, &conf)
+	g.smm.Free(buf)
+	cos.Close(r)
+	if err != nil {
+		return err
+	}
+	return lom.unpack()
+}
+
+//
+// background compaction
+//
+
+func regPackWithHK() {
+	hk.Reg("pack-compact"+hk.NameSuffix, packHK, packCompactIval)
+}
+
+func packHK() time.Duration {
+	if !packCompacting.CAS(false, true) {
+		return packCompactIval
+	}
+	go func() {
+		fs.PackRange(func(ps *fs.PackStore) {
+			n, err := ps.Compact(0 /*as configured*/)
+			switch {
+			case err != nil:
+				nlog.Errorln(ps.String(), "compaction failed:", err)
+			case n > 0:
+				nlog.Infoln(ps.String(), "compacted", n, "container(s)")
+			}
+		})
+		packCompacting.Store(false)
+	}()
+	return packCompactIval
+}
@@ -0,0 +1,68 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Uniform random sample of the object names stored by a given target:
+// a single pass through the bucket (no listing) with reservoir sampling (Algorithm R).
+// The proxy then merges per-target samples - see api.SampleObjects.
+
+type reservoir struct {
+	names []string
+	size  int
+	seen  int64
+}
+
+func (r *reservoir) add(name string) {
+	r.seen++
+	if len(r.names) < r.size {
+		r.names = append(r.names, name)
+		return
+	}
+	if j := rand.Int63n(r.seen); j < int64(r.size) {
+		r.names[j] = name
+	}
+}
+
+// shuffle to make sure that any prefix of the result is itself a uniform sample
+func (r *reservoir) result() *apc.Sample {
+	rand.Shuffle(len(r.names), func(i, j int) { r.names[i], r.names[j] = r.names[j], r.names[i] })
+	return &apc.Sample{Names: r.names, Total: r.seen}
+}
+
+func SampleBck(bck *meta.Bck, msg *apc.SampleMsg) (*apc.Sample, error) {
+	var (
+		r    = &reservoir{size: msg.Size, names: make([]string, 0, min(msg.Size, 1024))}
+		opts = &fs.WalkBckOpts{
+			WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Sorted: true},
+		}
+	)
+	opts.WalkOpts.Bck.Copy(bck.Bucket())
+	opts.Callback = func(fqn string, _ fs.DirEntry) error {
+		lom := &LOM{}
+		if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+			return nil // (not an object)
+		}
+		if !lom.IsHRW() {
+			return nil // skip copies and misplaced objects
+		}
+		if msg.Prefix == "" || strings.HasPrefix(lom.ObjName, msg.Prefix) {
+			r.add(lom.ObjName)
+		}
+		return nil
+	}
+	if err := fs.WalkBck(opts); err != nil {
+		return nil, err
+	}
+	return r.result(), nil
+}
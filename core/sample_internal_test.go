@@ -0,0 +1,46 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReservoir(t *testing.T) {
+	const (
+		size   = 10
+		total  = 100
+		rounds = 10000
+	)
+	// fewer than size
+	r := &reservoir{size: size}
+	for i := 0; i < size/2; i++ {
+		r.add(strconv.Itoa(i))
+	}
+	s := r.result()
+	tassert.Errorf(t, len(s.Names) == size/2 && s.Total == size/2, "wrong sample: %+v", s)
+
+	// uniform: each name must be selected with probability size/total
+	hits := make(map[string]int, total)
+	for k := 0; k < rounds; k++ {
+		r := &reservoir{size: size}
+		for i := 0; i < total; i++ {
+			r.add(strconv.Itoa(i))
+		}
+		s := r.result()
+		tassert.Fatalf(t, len(s.Names) == size && s.Total == total, "wrong sample: %d, %d", len(s.Names), s.Total)
+		for _, name := range s.Names {
+			hits[name]++
+		}
+	}
+	expected := rounds * size / total // 1000
+	for i := 0; i < total; i++ {
+		n := hits[strconv.Itoa(i)]
+		tassert.Errorf(t, n > expected*3/4 && n < expected*5/4, "name %d selected %d times (expected ~%d)", i, n, expected)
+	}
+}
@@ -72,6 +72,7 @@ type (
 		DeleteObject(lom *LOM, evict bool) (errCode int, err error)
 		GetCold(ctx context.Context, lom *LOM, owt cmn.OWT) (errCode int, err error)
 		CopyObject(lom *LOM, dm DM, coi *CopyParams) (int64, error)
+		RenameObject(lom *LOM, objNameTo string) error
 		Promote(params *PromoteParams) (errCode int, err error)
 		HeadObjT2T(lom *LOM, si *meta.Snode) bool
 
@@ -104,6 +105,7 @@ type (
 		Config          *cmn.Config // during xaction
 		Xact            Xact        // responsible xaction
 		apc.PromoteArgs             // all of the above
+		Linked          bool        // (out) hard-linked rather than copied, see apc.PromoteArgs.HardLink
 	}
 	CopyParams struct {
 		DP        DP // transform via: ext/etl/dp.go or core/ldp.go
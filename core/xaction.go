@@ -5,11 +5,13 @@
 package core
 
 import (
+	"runtime"
 	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/sys"
 )
 
 type QuiRes int
@@ -58,6 +60,8 @@ type (
 		Finish()
 		Abort(error) bool
 		AddNotif(n Notif)
+		SetReqID(string)
+		ReqID() string
 
 		// common stats
 		Objs() int64
@@ -66,6 +70,15 @@ type (
 		InObjsAdd(int, int64)  // receive
 		InBytes() int64
 		OutBytes() int64
+
+		ResAcct
+	}
+
+	// resource usage accounting (network: see OutObjsAdd and InObjsAdd above)
+	ResAcct interface {
+		CPUAdd(time.Duration)
+		DiskReadAdd(int64)
+		DiskWriteAdd(int64)
 	}
 )
 
@@ -77,6 +90,11 @@ type (
 		OutBytes int64 `json:"out-bytes,string"` //
 		InObjs   int64 `json:"in-objs,string"`   // receive
 		InBytes  int64 `json:"in-bytes,string"`
+
+		// resource usage (see ResAcct)
+		CPU       int64 `json:"cpu-ns,string"`     // CPU time consumed by the xaction's workers
+		DiskRead  int64 `json:"disk-read,string"`  // bytes read from local drives
+		DiskWrite int64 `json:"disk-write,string"` // bytes written to local drives
 	}
 	Snap struct {
 		// xaction-specific stats counters
@@ -119,3 +137,29 @@ func (snp *Snap) IsIdle() bool    { return snp.IdleX }
 func (snp *Snap) Started() bool   { return !snp.StartTime.IsZero() }
 func (snp *Snap) Running() bool   { return snp.Started() && !snp.IsAborted() && snp.EndTime.IsZero() }
 func (snp *Snap) Finished() bool  { return snp.Started() && !snp.EndTime.IsZero() }
+
+/////////////
+// ResAcct //
+/////////////
+
+// CPU accounting: usage
+//
+//	begin := core.CPUBegin()
+//	... (process one object - synchronously) ...
+//	core.CPUEnd(xctn, begin)
+//
+// - the calling goroutine is locked to its OS thread in between, and the consumed
+//   user + system time of the thread is attributed to the xaction;
+// - work delegated to other goroutines is not accounted for (by design).
+
+func CPUBegin() time.Duration {
+	runtime.LockOSThread()
+	return sys.ThreadCPU()
+}
+
+func CPUEnd(acct ResAcct, begin time.Duration) {
+	if d := sys.ThreadCPU() - begin; d > 0 {
+		acct.CPUAdd(d)
+	}
+	runtime.UnlockOSThread()
+}
@@ -7,6 +7,7 @@ for backend in ${AIS_BACKEND_PROVIDERS}; do
     aws)   backend_desc+=('"aws":   {}') ;;
     azure) backend_desc+=('"azure": {}') ;;
     gcp)   backend_desc+=('"gcp":   {}') ;;
+    oci)   backend_desc+=('"oci":   {}') ;;
     hdfs)  backend_desc+=('"hdfs":  {"user": "root", "addresses": ["localhost:8020", "localhost:9000"], "use_datanode_hostname": true}') ;;
   esac
 done
@@ -33,7 +34,8 @@ cat > $AIS_CONF_FILE <<EOL
 		"max_size":  "4mb",
 		"max_total": "128mb",
 		"flush_time": "40s",
-		"stats_time": "60s"
+		"stats_time": "60s",
+		"format":     "${AIS_LOG_FORMAT:-text}"
 	},
 	"periodic": {
 		"stats_time":        "10s",
@@ -46,7 +48,10 @@ cat > $AIS_CONF_FILE <<EOL
 		"max_host_busy":        "20s",
 		"startup_time":         "1m",
 		"join_startup_time":    "3m",
-		"send_file_time":       "5m"
+		"send_file_time":       "5m",
+		"object_get_time":      "0s",
+		"object_put_time":      "0s",
+		"object_head_time":     "0s"
 	},
 	"client": {
 		"client_timeout":      "10s",
@@ -54,10 +59,14 @@ cat > $AIS_CONF_FILE <<EOL
 		"list_timeout":        "3m"
 	},
 	"proxy": {
-		"primary_url":   "${AIS_PRIMARY_URL}",
-		"original_url":  "${AIS_PRIMARY_URL}",
-		"discovery_url": "${AIS_DISCOVERY_URL}",
-		"non_electable": ${AIS_NON_ELECTABLE:-false}
+		"primary_url":      "${AIS_PRIMARY_URL}",
+		"original_url":     "${AIS_PRIMARY_URL}",
+		"discovery_url":    "${AIS_DISCOVERY_URL}",
+		"non_electable":    ${AIS_NON_ELECTABLE:-false},
+		"webui":            ${AIS_WEBUI:-false},
+		"read_only":        false,
+		"max_conns":        0,
+		"max_client_conns": 0
 	},
 	"space": {
 		"cleanupwm":         65,
@@ -67,6 +76,7 @@ cat > $AIS_CONF_FILE <<EOL
 	},
 	"lru": {
 		"dont_evict_time":   "120m",
+		"version_evict_time": "0s",
 		"capacity_upd_time": "10m",
 		"enabled":           true
 	},
@@ -75,13 +85,17 @@ cat > $AIS_CONF_FILE <<EOL
 	    "iostat_time_short": "${AIS_IOSTAT_TIME_SHORT:-100ms}",
 	    "disk_util_low_wm":  20,
 	    "disk_util_high_wm": 80,
-	    "disk_util_max_wm":  95
+	    "disk_util_max_wm":  95,
+	    "bg_cgroup":         "",
+	    "bg_io_weight":      50
 	},
 	"rebalance": {
 		"dest_retry_time":	"2m",
 		"compression":     	"${AIS_REBALANCE_COMPRESSION:-never}",
 		"bundle_multiplier":	${AIS_REBALANCE_BUNDLE_MULTIPLIER:-2},
-		"enabled":         	true
+		"enabled":         	true,
+		"capacity_weights":	false,
+		"partitions":		0
 	},
 	"resilver": {
 		"enabled": true
@@ -111,7 +125,8 @@ cat > $AIS_CONF_FILE <<EOL
 	},
 	"versioning": {
 		"enabled":           true,
-		"validate_warm_get": false
+		"validate_warm_get": false,
+		"retain":            0
 	},
 	"net": {
 		"l4": {
@@ -127,6 +142,11 @@ cat > $AIS_CONF_FILE <<EOL
 			"client_auth_tls":   ${AIS_CLIENT_AUTH_TLS:-0},
 			"write_buffer_size": ${HTTP_WRITE_BUFFER_SIZE:-0},
 			"read_buffer_size":  ${HTTP_READ_BUFFER_SIZE:-0},
+			"idle_conns_per_host": ${HTTP_IDLE_CONNS_PER_HOST:-0},
+			"max_idle_conns":      ${HTTP_MAX_IDLE_CONNS:-0},
+			"max_conns_per_host":  ${HTTP_MAX_CONNS_PER_HOST:-0},
+			"idle_conn_timeout":   "${HTTP_IDLE_CONN_TIMEOUT:-0s}",
+			"http2":               ${HTTP_HTTP2:-false},
 			"chunked_transfer":  ${AIS_HTTP_CHUNKED_TRANSFER:-true},
 			"skip_verify":       ${AIS_SKIP_VERIFY_CRT:-false}
 		}
@@ -171,6 +191,31 @@ cat > $AIS_CONF_FILE <<EOL
 		"compression":		"never",
 		"bundle_multiplier":	2
 	},
+	"list_objects": {
+		"max_page_size":		50000,
+		"max_page_size_high":		5000,
+		"max_page_size_extreme":	1000
+	},
+	"cold_get": {
+		"max_active":		256,
+		"max_queued":		1024,
+		"queue_timeout":	"10s"
+	},
+	"shed": {
+		"max_heap":	"0",
+		"delay":	"500ms",
+		"enabled":	true
+	},
+	"watchdog": {
+		"interval":		"1m",
+		"max_goroutines":	0,
+		"max_sockets":		0,
+		"max_fds_pct":		80,
+		"enabled":		true
+	},
+	"metrics": {
+		"sinks":	"${AIS_METRICS_SINKS:-statsd}"
+	},
 	"write_policy": {
 		"data": "${WRITE_POLICY_DATA:-}",
 		"md": "${WRITE_POLICY_MD:-}"
@@ -354,6 +354,8 @@ func freeObject(r any) {
 		if handle != nil {
 			cos.Close(handle)
 		}
+	case cos.LomReader: // e.g., deduplicated content (see lom.NewHandle)
+		cos.Close(handle)
 	default:
 		debug.FailTypeCast(r)
 	}
@@ -177,8 +177,8 @@ func (c *getJogger) copyMissingReplicas(ctx *restoreCtx, reader cos.ReadOpenClos
 	switch r := reader.(type) {
 	case *memsys.SGL:
 		srcReader = memsys.NewReader(r)
-	case *cos.FileHandle:
-		srcReader, err = cos.NewFileHandle(ctx.lom.FQN)
+	case cos.LomReader:
+		srcReader, err = ctx.lom.NewHandle()
 	default:
 		debug.FailTypeCast(reader)
 		err = fmt.Errorf("unsupported reader type: %T", reader)
@@ -322,7 +322,7 @@ func (c *getJogger) restoreReplicatedFromDisk(ctx *restoreCtx) error {
 		return fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
 	}
 
-	reader, err := cos.NewFileHandle(ctx.lom.FQN)
+	reader, err := ctx.lom.NewHandle()
 	if err != nil {
 		return err
 	}
@@ -136,13 +136,13 @@ func newReplicaResponse(attrs *cmn.ObjAttrs, bck *meta.Bck, objName string) (rea
 		nlog.Warningln(err)
 		return nil, err
 	}
-	reader, err = cos.NewFileHandle(lom.FQN)
-	if err != nil {
-		return nil, err
-	}
 	if lom.SizeBytes() == 0 {
 		return nil, nil
 	}
+	reader, err = lom.NewHandle()
+	if err != nil {
+		return nil, err
+	}
 	attrs.Size = lom.SizeBytes()
 	attrs.Ver = lom.Version()
 	attrs.Atime = lom.AtimeUnix()
@@ -26,12 +26,17 @@ const (
 	TypeRange   Type = "range"
 	TypeMulti   Type = "multi"
 	TypeBackend Type = "backend"
+
+	TypeManifest Type = "manifest" // list of links stored in the cluster (see ManifestBody)
 )
 
 const PrefixJobID = "dnl-"
 
 const DownloadProgressInterval = 10 * time.Second
 
+// minimum interval between continuous sync passes (see BackendBody.SyncInterval)
+const MinSyncInterval = 10 * time.Second
+
 type (
 	// NOTE: Changing this structure requires changes in `MarshalJSON` and `UnmarshalJSON` methods.
 	Body struct {
@@ -112,7 +117,9 @@ type (
 		Base
 		Prefix string `json:"prefix"`
 		Suffix string `json:"suffix"`
-		Sync   bool   `json:"synchronize"`
+		Sync   bool   `json:"synchronize"` // (also) delete locally stored objects that were removed from the remote
+		// when non-empty: mirror the remote prefix continuously, re-listing it every so often (until aborted)
+		SyncInterval string `json:"sync_interval,omitempty"`
 	}
 
 	SingleBody struct {
@@ -124,17 +131,26 @@ type (
 		Base
 		Template string `json:"template"`
 		Subdir   string `json:"subdir"`
+		// when non-empty: periodically re-check all links and download new and changed objects (until aborted)
+		SyncInterval string `json:"sync_interval,omitempty"`
 	}
 
 	MultiBody struct {
 		Base
 		ObjectsPayload any `json:"objects"`
 	}
+
+	// download links listed in the manifest object (newline-delimited links or CSV "url,objname")
+	ManifestBody struct {
+		Base
+		ManifestBck cmn.Bck `json:"manifest_bucket"`
+		ManifestObj string  `json:"manifest_object"`
+	}
 )
 
 func IsType(a string) bool {
 	b := Type(a)
-	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange
+	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange || b == TypeManifest
 }
 
 /////////
@@ -304,6 +320,20 @@ func (b *Base) Validate() error {
 	return nil
 }
 
+func validateSyncIval(ival string) error {
+	if ival == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(ival)
+	if err != nil {
+		return fmt.Errorf("failed to parse sync_interval field: %v", err)
+	}
+	if d < MinSyncInterval {
+		return fmt.Errorf("sync_interval %v is too short (minimum: %v)", d, MinSyncInterval)
+	}
+	return nil
+}
+
 ///////////////
 // SingleObj //
 ///////////////
@@ -393,7 +423,7 @@ func (b *RangeBody) Validate() error {
 	if b.Template == "" {
 		return errors.New("missing 'template' in the request body")
 	}
-	return nil
+	return validateSyncIval(b.SyncInterval)
 }
 
 func (b *RangeBody) Describe() string {
@@ -467,7 +497,12 @@ func (b *MultiBody) String() string {
 // BackendBody //
 /////////////////
 
-func (b *BackendBody) Validate() error { return b.Base.Validate() }
+func (b *BackendBody) Validate() error {
+	if err := b.Base.Validate(); err != nil {
+		return err
+	}
+	return validateSyncIval(b.SyncInterval)
+}
 
 func (b *BackendBody) Describe() string {
 	if b.Description != "" {
@@ -475,3 +510,31 @@ func (b *BackendBody) Describe() string {
 	}
 	return fmt.Sprintf("remote bucket prefetch -> %s", b.Bck)
 }
+
+//////////////////
+// ManifestBody //
+//////////////////
+
+func (b *ManifestBody) Validate() error {
+	if err := b.Base.Validate(); err != nil {
+		return err
+	}
+	if b.ManifestBck.Name == "" {
+		return errors.New("missing 'manifest_bucket.name'")
+	}
+	if b.ManifestObj == "" {
+		return errors.New("missing 'manifest_object' in the request body")
+	}
+	return nil
+}
+
+func (b *ManifestBody) Describe() string {
+	if b.Description != "" {
+		return b.Description
+	}
+	return fmt.Sprintf("%s -> %s", b.ManifestBck.Cname(b.ManifestObj), b.Bck)
+}
+
+func (b *ManifestBody) String() string {
+	return fmt.Sprintf("bucket: %q, manifest: %q", b.Bck, b.ManifestBck.Cname(b.ManifestObj))
+}
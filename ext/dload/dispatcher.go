@@ -193,13 +193,48 @@ func (d *dispatcher) finish(job jobif) {
 }
 
 // forward request to designated jogger
+// (continuous sync: repeat every job.SyncInterval() until aborted)
 func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 	defer d.finish(job)
 
-	if aborted := d.checkAborted(); aborted || d.checkAbortedJob(job) {
-		return !aborted
+	for {
+		if aborted := d.checkAborted(); aborted || d.checkAbortedJob(job) {
+			return !aborted
+		}
+		if ok = d.dispatchPass(job); !ok {
+			return false
+		}
+		ival := job.SyncInterval()
+		if ival == 0 || !d.nextPass(job, ival) {
+			return true
+		}
+		g.store.setAllDispatched(job.ID(), false)
+		job.rewind()
 	}
+}
+
+// wait for the current pass to complete and then for the sync interval to elapse;
+// keep the downloader from going idle in the meantime
+func (d *dispatcher) nextPass(job jobif, ival time.Duration) bool {
+	d.xdl.IncPending()
+	defer d.xdl.DecPending()
+
+	d.waitFor(job.ID())
+	if cmn.Rom.FastV(4, cos.SmoduleDload) {
+		nlog.Infoln(job.String(), "next sync in", ival)
+	}
+	timer := time.NewTimer(ival)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-d.jobAbortedCh(job.ID()).Listen():
+	case <-d.stopCh.Listen():
+	}
+	return false
+}
 
+func (d *dispatcher) dispatchPass(job jobif) (ok bool) {
 	diffResolver := NewDiffResolver(&defaultDiffResolverCtx{})
 	go diffResolver.Start()
 
@@ -58,6 +58,10 @@ type (
 		// Determines if it requires also syncing.
 		Sync() bool
 
+		// Non-zero: continuous sync - re-list (re-generate) and download new and changed
+		// objects every so often until aborted (see BackendBody and RangeBody).
+		SyncInterval() time.Duration
+
 		// Checks if object name matches the request.
 		checkObj(objName string) bool
 
@@ -65,6 +69,9 @@ type (
 		//  `ok` is set to `true` if there is batch to process, `false` otherwise
 		genNext() (objs []dlObj, ok bool, err error)
 
+		// rewind to the beginning (continuous sync only)
+		rewind()
+
 		// via tryAcquire and release
 		throttler() *throttler
 
@@ -79,6 +86,7 @@ type (
 		id          string
 		description string
 		timeout     time.Duration
+		syncIval    time.Duration
 		throt       throttler
 	}
 
@@ -157,6 +165,9 @@ func (j *baseDlJob) Timeout() time.Duration { return j.timeout }
 func (j *baseDlJob) Description() string    { return j.description }
 func (*baseDlJob) Sync() bool               { return false }
 
+func (j *baseDlJob) SyncInterval() time.Duration { return j.syncIval }
+func (*baseDlJob) rewind()                       { debug.Assert(false) }
+
 func (j *baseDlJob) String() (s string) {
 	s = fmt.Sprintf("dl-job[%s]-%s", j.ID(), j.Bck())
 	if j.Description() == "" {
@@ -280,11 +291,18 @@ func newRangeDlJob(id string, bck *meta.Bck, payload *RangeBody, xdl *Xact) (rj
 	}
 	rj.pt.InitIter()
 	rj.dir = payload.Subdir
+	rj.syncIval, _ = time.ParseDuration(payload.SyncInterval)
 	return
 }
 
 func (j *rangeDlJob) SrcBck() *cmn.Bck { return j.bck.Bucket() }
-func (j *rangeDlJob) Len() int         { return j.count }
+
+func (j *rangeDlJob) Len() int {
+	if j.syncIval > 0 {
+		return -1 // continuous sync: the total keeps growing
+	}
+	return j.count
+}
 
 func (j *rangeDlJob) genNext() ([]dlObj, bool, error) {
 	if j.done {
@@ -296,6 +314,11 @@ func (j *rangeDlJob) genNext() ([]dlObj, bool, error) {
 	return j.objs, true, nil
 }
 
+func (j *rangeDlJob) rewind() {
+	j.pt.InitIter()
+	j.done = false
+}
+
 func (j *rangeDlJob) String() (s string) {
 	return fmt.Sprintf("range-%s-%d-%s", &j.baseDlJob, j.count, j.dir)
 }
@@ -341,6 +364,7 @@ func newBackendDlJob(id string, bck *meta.Bck, payload *BackendBody, xdl *Xact)
 		bj.sync = payload.Sync
 		bj.prefix = payload.Prefix
 		bj.suffix = payload.Suffix
+		bj.syncIval, _ = time.ParseDuration(payload.SyncInterval)
 	}
 	return
 }
@@ -356,6 +380,11 @@ func (j *backendDlJob) checkObj(objName string) bool {
 	return strings.HasPrefix(objName, j.prefix) && strings.HasSuffix(objName, j.suffix)
 }
 
+func (j *backendDlJob) rewind() {
+	j.continuationToken = ""
+	j.done = false
+}
+
 func (j *backendDlJob) genNext() (objs []dlObj, ok bool, err error) {
 	if j.done {
 		return nil, false, nil
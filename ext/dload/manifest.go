@@ -0,0 +1,185 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// manifest download job: the list of links is itself an object (the "manifest") stored in the cluster.
+// Supported manifest formats (one entry per line):
+// - plain: "<link>" - object name is then the link's base;
+// - CSV:   "<link>,<object name>" (optional header "url,objname" is skipped).
+// Empty lines and lines starting with '#' are ignored.
+//
+// Each target reads the manifest in batches (HTTP range reads from the target that stores it)
+// and downloads only the objects that it owns - the entire list is never held in memory.
+
+const manifestHdr = "url,objname"
+
+var _ jobif = (*manifestDlJob)(nil)
+
+type manifestDlJob struct {
+	baseDlJob
+	mbck   *meta.Bck // manifest bucket
+	mobj   string    // manifest object
+	objs   []dlObj   // objects' metas which are ready to be downloaded
+	offset int64     // manifest: number of bytes already processed
+	done   bool      // true when the manifest is fully read
+}
+
+func newManifestDlJob(id string, bck *meta.Bck, payload *ManifestBody, xdl *Xact) (*manifestDlJob, error) {
+	mbck := meta.CloneBck(&payload.ManifestBck)
+	if err := mbck.Init(core.T.Bowner()); err != nil {
+		return nil, err
+	}
+	mj := &manifestDlJob{mbck: mbck, mobj: payload.ManifestObj}
+	mj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	return mj, nil
+}
+
+func (*manifestDlJob) Len() int { return -1 }
+
+func (j *manifestDlJob) String() string {
+	return fmt.Sprintf("manifest-%s-%s", &j.baseDlJob, j.mbck.Cname(j.mobj))
+}
+
+func (j *manifestDlJob) genNext() ([]dlObj, bool, error) {
+	if j.done {
+		return nil, false, nil
+	}
+	if err := j.getNextObjs(); err != nil {
+		return nil, false, err
+	}
+	return j.objs, true, nil
+}
+
+func (j *manifestDlJob) getNextObjs() error {
+	var (
+		smap = core.T.Sowner().Get()
+		sid  = core.T.SID()
+	)
+	j.objs = j.objs[:0]
+	body, err := j.open(smap)
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		j.done = true
+		return nil
+	}
+	defer cos.Close(body)
+
+	br := bufio.NewReader(body)
+	for len(j.objs) < downloadBatchSize {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("failed to read manifest %s: %v", j.mbck.Cname(j.mobj), err)
+		}
+		j.offset += int64(len(line))
+		if objName, link, ok, errP := ParseManifestLine(line); errP != nil {
+			return fmt.Errorf("manifest %s: %v", j.mbck.Cname(j.mobj), errP)
+		} else if ok {
+			obj, errM := makeDlObj(smap, sid, j.bck, objName, link)
+			switch {
+			case errM == nil:
+				j.objs = append(j.objs, obj)
+			case errM != errInvalidTarget:
+				return errM
+			}
+		}
+		if err == io.EOF {
+			j.done = true
+			break
+		}
+	}
+	return nil
+}
+
+// GET the remaining part of the manifest from the target that stores it;
+// returns nil reader when there's nothing left to read
+func (j *manifestDlJob) open(smap *meta.Smap) (io.ReadCloser, error) {
+	tsi, err := smap.HrwName2T(j.mbck.MakeUname(j.mobj))
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodGet, tsi.URL(cmn.NetIntraData)+apc.URLPathObjects.Join(j.mbck.Name, j.mobj), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = j.mbck.NewQuery().Encode()
+	req.Header.Set(apc.HdrCallerID, core.T.SID())
+	req.Header.Set(apc.HdrCallerName, core.T.String())
+	if j.offset > 0 {
+		req.Header.Set(cos.HdrRange, "bytes="+strconv.FormatInt(j.offset, 10)+"-")
+	}
+	resp, err := core.T.DataClient().Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		cos.Close(resp.Body)
+		return nil, nil
+	case http.StatusNotFound:
+		cos.Close(resp.Body)
+		return nil, cos.NewErrNotFound(core.T, "manifest "+j.mbck.Cname(j.mobj))
+	default:
+		cos.Close(resp.Body)
+		return nil, fmt.Errorf("failed to read manifest %s from %s: %s", j.mbck.Cname(j.mobj), tsi, resp.Status)
+	}
+}
+
+// ParseManifestLine parses a single manifest entry (see above);
+// returns ok == false for lines that must be skipped
+func ParseManifestLine(line string) (objName, link string, ok bool, err error) {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' || strings.EqualFold(line, manifestHdr) {
+		return "", "", false, nil
+	}
+	if !strings.Contains(line, ",") {
+		link = line
+	} else {
+		cr := csv.NewReader(strings.NewReader(line))
+		cr.TrimLeadingSpace = true
+		fields, errV := cr.Read()
+		if errV != nil {
+			return "", "", false, fmt.Errorf("invalid entry %q: %v", line, errV)
+		}
+		if len(fields) > 2 {
+			return "", "", false, fmt.Errorf("invalid entry %q: expecting %q", line, manifestHdr)
+		}
+		link = fields[0]
+		if len(fields) > 1 {
+			objName = strings.TrimSpace(fields[1])
+		}
+	}
+	if link == "" {
+		return "", "", false, fmt.Errorf("invalid entry %q: empty link", line)
+	}
+	if objName == "" {
+		objName = path.Base(link)
+		if objName == "." || objName == "/" {
+			return "", "", false, errors.New("failed to extract object name from the download link " + link)
+		}
+	}
+	return objName, link, true, nil
+}
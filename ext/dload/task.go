@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -90,6 +91,9 @@ func (task *singleTask) download(lom *core.LOM) {
 	task.ended.Store(time.Now())
 
 	if err != nil {
+		if IsErrSrcMismatch(err) {
+			g.tstats.IncErr(stats.ErrDownloadMismatchCount)
+		}
 		task.markFailed(err.Error())
 		return
 	}
@@ -140,14 +144,17 @@ func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Respo
 			resp.StatusCode)
 	}
 
-	r := task.wrapReader(resp.Body)
 	size := attrsFromLink(task.obj.link, resp, lom)
 	task.setTotalSize(size)
+	vr := &verifyReader{r: task.wrapReader(resp.Body), link: task.obj.link, size: size, expct: srcCksum(lom)}
+	if vr.expct != nil {
+		vr.cksum = cos.NewCksumHash(vr.expct.Ty())
+	}
 
 	params := core.AllocPutParams()
 	{
 		params.WorkTag = "dl"
-		params.Reader = r
+		params.Reader = vr
 		params.OWT = cmn.OwtPut
 		params.Atime = task.started.Load()
 		params.Size = size
@@ -155,6 +162,9 @@ func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Respo
 	}
 	erp := core.T.PutObject(lom, params)
 	core.FreePutParams(params)
+	if vr.err != nil {
+		return true, vr.err // (not storing corrupted content)
+	}
 	if erp != nil {
 		return true, erp
 	}
@@ -283,3 +293,51 @@ func (task *singleTask) String() (str string) {
 		task.jobID(), task.obj.objName, task.obj.link, task.obj.fromRemote, task.job.Bck(),
 	)
 }
+
+//////////////////
+// verifyReader //
+//////////////////
+
+// validates downloaded content against the source-provided size and checksum, if any;
+// upon mismatch, fails the PUT with ErrSrcMismatch (instead of returning io.EOF)
+type verifyReader struct {
+	r     io.ReadCloser
+	cksum *cos.CksumHash // nil when the source provides no checksum
+	expct *cos.Cksum
+	err   error
+	link  string
+	size  int64 // expected size; zero or negative when not known
+	n     int64
+}
+
+func (vr *verifyReader) Read(b []byte) (n int, err error) {
+	n, err = vr.r.Read(b)
+	if n > 0 {
+		vr.n += int64(n)
+		if vr.cksum != nil {
+			vr.cksum.H.Write(b[:n])
+		}
+	}
+	if err == io.EOF {
+		if vr.err = vr.verify(); vr.err != nil {
+			err = vr.err
+		}
+	}
+	return n, err
+}
+
+func (vr *verifyReader) Close() error { return vr.r.Close() }
+
+func (vr *verifyReader) verify() error {
+	if vr.size > 0 && vr.n != vr.size {
+		return &ErrSrcMismatch{link: vr.link, what: "size", expct: strconv.FormatInt(vr.size, 10), actual: strconv.FormatInt(vr.n, 10)}
+	}
+	if vr.cksum == nil {
+		return nil
+	}
+	vr.cksum.Finalize()
+	if !vr.cksum.Equal(vr.expct) {
+		return &ErrSrcMismatch{link: vr.link, what: vr.expct.Ty(), expct: vr.expct.Val(), actual: vr.cksum.Val()}
+	}
+	return nil
+}
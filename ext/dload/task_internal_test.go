@@ -0,0 +1,104 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+type testOAH struct {
+	cos.SimpleOAH
+	md cos.StrKVs
+}
+
+func (oah *testOAH) GetCustomKey(k string) (v string, ok bool) { v, ok = oah.md[k]; return }
+func (oah *testOAH) SetCustomKey(k, v string)                  { oah.md[k] = v }
+
+func TestVerifyReader(t *testing.T) {
+	const content = "the quick brown fox"
+	var (
+		sum  = md5.Sum([]byte(content))
+		good = hex.EncodeToString(sum[:])
+		bad  = strings.Repeat("0", len(good))
+	)
+	tests := []struct {
+		expct    *cos.Cksum
+		size     int64
+		mismatch bool
+	}{
+		{nil, 0, false},
+		{nil, int64(len(content)), false},
+		{nil, int64(len(content)) + 1, true},
+		{cos.NewCksum(cos.ChecksumMD5, good), int64(len(content)), false},
+		{cos.NewCksum(cos.ChecksumMD5, good), -1, false},
+		{cos.NewCksum(cos.ChecksumMD5, bad), int64(len(content)), true},
+	}
+	for i, test := range tests {
+		vr := &verifyReader{r: io.NopCloser(strings.NewReader(content)), link: "http://a.com/b", size: test.size, expct: test.expct}
+		if test.expct != nil {
+			vr.cksum = cos.NewCksumHash(test.expct.Ty())
+		}
+		b, err := io.ReadAll(vr)
+		if test.mismatch {
+			if !IsErrSrcMismatch(err) || !IsErrSrcMismatch(vr.err) {
+				t.Fatalf("%d: expected source mismatch, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil || string(b) != content {
+			t.Fatalf("%d: unexpected (%q, %v)", i, b, err)
+		}
+	}
+}
+
+func TestSrcCksum(t *testing.T) {
+	var (
+		sum  = md5.Sum([]byte("abc"))
+		hexs = hex.EncodeToString(sum[:])
+	)
+	tests := []struct {
+		md    cos.StrKVs
+		ty    string
+		value string
+	}{
+		{cos.StrKVs{}, "", ""},
+		{cos.StrKVs{cmn.MD5ObjMD: hexs}, cos.ChecksumMD5, hexs},
+		{cos.StrKVs{cmn.MD5ObjMD: `"` + hexs + `"`}, cos.ChecksumMD5, hexs},                             // S3 ETag
+		{cos.StrKVs{cmn.MD5ObjMD: `"` + hexs[:30] + "-2" + `"`}, "", ""},                                // S3 multipart ETag
+		{cos.StrKVs{cmn.MD5ObjMD: "9d-3", cmn.CRC32CObjMD: "0a0b0c0d"}, cos.ChecksumCRC32C, "0a0b0c0d"}, // GCS composite
+	}
+	for i, test := range tests {
+		cksum := srcCksum(&testOAH{md: test.md})
+		if test.ty == "" {
+			if cksum != nil {
+				t.Errorf("%d: expected no checksum, got %s", i, cksum)
+			}
+			continue
+		}
+		if cksum == nil || cksum.Ty() != test.ty || cksum.Val() != test.value {
+			t.Errorf("%d: expected %s[%s], got %s", i, test.ty, test.value, cksum)
+		}
+	}
+
+	// generic web link w/ Content-MD5
+	resp := &http.Response{Header: http.Header{}, ContentLength: 3}
+	resp.Header.Set(cos.HdrContentMD5, base64.StdEncoding.EncodeToString(sum[:]))
+	oah := &testOAH{md: cos.StrKVs{}}
+	if size := attrsFromLink("http://example.com/abc", resp, oah); size != 3 {
+		t.Fatalf("expected size 3, got %d", size)
+	}
+	if cksum := srcCksum(oah); cksum == nil || cksum.Val() != hexs {
+		t.Fatalf("expected md5 %s, got %s", hexs, cksum)
+	}
+}
@@ -6,7 +6,12 @@ package dload
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"hash/crc32"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,6 +32,23 @@ const headReqTimeout = 5 * time.Second
 
 var errInvalidTarget = errors.New("downloader: invalid target")
 
+// downloaded content does not match source-provided size or checksum
+type ErrSrcMismatch struct {
+	link   string
+	what   string // "size" or checksum type
+	expct  string
+	actual string
+}
+
+func (e *ErrSrcMismatch) Error() string {
+	return fmt.Sprintf("source mismatch: %s downloaded from %q: expected %s, got %s", e.what, e.link, e.expct, e.actual)
+}
+
+func IsErrSrcMismatch(err error) bool {
+	var e *ErrSrcMismatch
+	return errors.As(err, &e)
+}
+
 func clientForURL(u string) *http.Client {
 	if cos.IsHTTPS(u) {
 		return g.clientTLS
@@ -159,8 +181,18 @@ func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, er
 			return nil, err
 		}
 		return newSingleDlJob(id, bck, dp, xdl)
+	case TypeManifest:
+		dp := &ManifestBody{}
+		err := jsoniter.Unmarshal(dlb.RawMessage, dp)
+		if err != nil {
+			return nil, err
+		}
+		if err := dp.Validate(); err != nil {
+			return nil, err
+		}
+		return newManifestDlJob(id, bck, dp, xdl)
 	default:
-		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend)")
+		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend, manifest)")
 	}
 }
 
@@ -168,6 +200,7 @@ func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, er
 func attrsFromLink(link string, resp *http.Response, oah cos.OAH) (size int64) {
 	u, err := url.Parse(link)
 	debug.AssertNoErr(err)
+	oah.SetCustomKey(cmn.OrigURLObjMD, link) // provenance
 	switch {
 	case cos.IsGoogleStorageURL(u) || cos.IsGoogleAPIURL(u):
 		h := cmn.BackendHelpers.Google
@@ -202,15 +235,46 @@ func attrsFromLink(link string, resp *http.Response, oah cos.OAH) (size int64) {
 		if v, ok := h.EncodeVersion(resp.Header.Get(cos.AzVersionHeader)); ok {
 			oah.SetCustomKey(cmn.VersionObjMD, v)
 		}
-		if v, ok := h.EncodeCksum(resp.Header.Get(cos.AzCksumHeader)); ok {
+		if v, ok := decodeContentMD5(resp.Header.Get(cos.AzCksumHeader)); ok {
 			oah.SetCustomKey(cmn.MD5ObjMD, v)
 		}
 	default:
 		oah.SetCustomKey(cmn.SourceObjMD, cmn.WebObjMD)
+		if v, ok := decodeContentMD5(resp.Header.Get(cos.HdrContentMD5)); ok {
+			oah.SetCustomKey(cmn.MD5ObjMD, v)
+		}
 	}
 	return resp.ContentLength
 }
 
+// base64 => hex
+func decodeContentMD5(v string) (string, bool) {
+	if v == "" {
+		return "", false
+	}
+	b, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || len(b) != md5.Size {
+		return "", false
+	}
+	return hex.EncodeToString(b), true
+}
+
+// source-provided checksum (see attrsFromLink) to validate the downloaded content, if any:
+// - MD5, unless it's an S3 multipart ETag (that is not an MD5 of the content);
+// - otherwise, GCS CRC32C
+func srcCksum(oah cos.OAH) *cos.Cksum {
+	if v, ok := oah.GetCustomKey(cmn.MD5ObjMD); ok {
+		v = strings.Trim(v, "\"")
+		if len(v) == 2*md5.Size && !strings.Contains(v, cmn.AwsMultipartDelim) {
+			return cos.NewCksum(cos.ChecksumMD5, v)
+		}
+	}
+	if v, ok := oah.GetCustomKey(cmn.CRC32CObjMD); ok && len(v) == 2*crc32.Size {
+		return cos.NewCksum(cos.ChecksumCRC32C, v)
+	}
+	return nil
+}
+
 func parseGoogleCksumHeader(hdr []string) cos.StrKVs {
 	var (
 		h      = cmn.BackendHelpers.Google
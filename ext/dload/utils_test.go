@@ -44,6 +44,61 @@ func TestNormalizeObjName(t *testing.T) {
 	}
 }
 
+func TestParseManifestLine(t *testing.T) {
+	tests := []struct {
+		line    string
+		objName string
+		link    string
+		ok      bool
+		err     bool
+	}{
+		{"", "", "", false, false},
+		{"   \n", "", "", false, false},
+		{"# comment", "", "", false, false},
+		{"url,objname\n", "", "", false, false},
+		{"https://a.com/dir/file.tar\n", "file.tar", "https://a.com/dir/file.tar", true, false},
+		{"https://a.com/file.tar, dir/obj.tar", "dir/obj.tar", "https://a.com/file.tar", true, false},
+		{"https://a.com/file.tar,", "file.tar", "https://a.com/file.tar", true, false},
+		{`"https://a.com/x?a=1,2",obj`, "obj", "https://a.com/x?a=1,2", true, false},
+		{",obj", "", "", false, true},
+		{"https://a.com/file.tar,obj,extra", "", "", false, true},
+		{"/", "", "", false, true},
+	}
+	for _, test := range tests {
+		objName, link, ok, err := dload.ParseManifestLine(test.line)
+		if (err != nil) != test.err {
+			t.Fatalf("%q: expected error=%t, got %v", test.line, test.err, err)
+		}
+		if objName != test.objName || link != test.link || ok != test.ok {
+			t.Errorf("%q: expected (%q, %q, %t), got (%q, %q, %t)",
+				test.line, test.objName, test.link, test.ok, objName, link, ok)
+		}
+	}
+}
+
+func TestSyncIntervalValidate(t *testing.T) {
+	tests := []struct {
+		ival string
+		err  bool
+	}{
+		{"", false},
+		{"1h", false},
+		{"10s", false},
+		{"5s", true},
+		{"abc", true},
+	}
+	for _, test := range tests {
+		backend := &dload.BackendBody{Base: dload.Base{Bck: cmn.Bck{Name: "b"}}, SyncInterval: test.ival}
+		if err := backend.Validate(); (err != nil) != test.err {
+			t.Errorf("backend %q: expected error=%t, got %v", test.ival, test.err, err)
+		}
+		rng := &dload.RangeBody{Base: dload.Base{Bck: cmn.Bck{Name: "b"}}, Template: "a{0..9}", SyncInterval: test.ival}
+		if err := rng.Validate(); (err != nil) != test.err {
+			t.Errorf("range %q: expected error=%t, got %v", test.ival, test.err, err)
+		}
+	}
+}
+
 func TestCompareObject(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 	var (
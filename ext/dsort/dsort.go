@@ -13,7 +13,6 @@ import (
 	"math"
 	"net/http"
 	"net/url"
-	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -296,8 +295,9 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 				// NOTE: cannot have `PutObject` closing the original reader
 				// on error as it'll cause writer (below) to panic
 				params.Reader = io.NopCloser(r)
+				params.Xact = m.xctn // provenance
 
-				// TODO: params.Xact - in part, to count PUTs and bytes in a generic fashion
+				// TODO: count PUTs and bytes via params.Xact in a generic fashion
 				// (vs metrics.ShardCreationStats.updateThroughput - see below)
 
 				// TODO: add params.Size = (size resulting from shardRW.Create below)
@@ -321,6 +321,9 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 		shardRW = shard.RWs[m.Pars.OutputExtension]
 		debug.Assert(shardRW != nil, m.Pars.OutputExtension)
 	}
+	if !m.Pars.DryRun && m.Pars.OutputExtension == archive.ExtTarZst {
+		shardRW = shard.NewTarzstRW(m.Pars.ZstdLevel) // configurable compression level
+	}
 
 	_, err = shardRW.Create(s, w, m.dsorter)
 	w.CloseWithError(err)
@@ -370,7 +373,7 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 			goto exit
 		}
 
-		file, err := cos.NewFileHandle(lom.FQN)
+		file, err := lom.NewHandle()
 		if err != nil {
 			return err
 		}
@@ -955,7 +958,7 @@ func (es *extractShard) _do(lom *core.LOM) error {
 	}
 
 	lom.Lock(false)
-	fh, err := os.Open(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err != nil {
 		phaseInfo.adjuster.releaseSema(lom.Mountpath())
 		lom.Unlock(false)
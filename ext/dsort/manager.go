@@ -633,6 +633,7 @@ func (m *Manager) recvShard(hdr *transport.ObjHdr, objReader io.Reader, err erro
 	{
 		params.WorkTag = ct.WorkfileRecvShard
 		params.Reader = rc
+		params.Xact = m.xctn // provenance
 		params.Cksum = nil
 		params.Atime = started
 		params.Size = hdr.ObjAttrs.Size
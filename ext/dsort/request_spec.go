@@ -238,6 +238,9 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	if pars.DsorterMemThreshold == "" {
 		pars.DsorterMemThreshold = cfg.DsorterMemThreshold
 	}
+	if pars.ZstdLevel == 0 {
+		pars.ZstdLevel = cfg.ZstdLevel
+	}
 
 	return pars, nil
 }
@@ -28,6 +28,7 @@ var (
 		archive.ExtTgz:    &tgzRW{archive.ExtTgz},
 		archive.ExtTarGz:  &tgzRW{archive.ExtTarGz},
 		archive.ExtTarLz4: &tlz4RW{archive.ExtTarLz4},
+		archive.ExtTarZst: &tzstRW{ext: archive.ExtTarZst},
 		archive.ExtZip:    &zipRW{archive.ExtZip},
 	}
 )
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/feat"
@@ -61,6 +62,7 @@ type (
 		MsgType() string // Code or Spec
 		CommType() string
 		ArgType() string
+		CacheBck() *cmn.Bck // (optional) where to cache transformed objects
 		Validate() error
 		String() string
 	}
@@ -71,6 +73,9 @@ type (
 		CommTypeX string       `json:"communication"` // enum commTypes
 		ArgTypeX  string       `json:"argument"`      // enum argTypes
 		Timeout   cos.Duration `json:"timeout"`
+		// when specified, transformed objects are stored in (and subsequently served from) this ais:// bucket;
+		// see also: CacheTag
+		CacheBckX cmn.Bck `json:"cache_bck,omitempty"`
 	}
 	InitSpecMsg struct {
 		InitMsgBase
@@ -143,11 +148,12 @@ var (
 	_ InitMsg = (*InitSpecMsg)(nil)
 )
 
-func (m InitMsgBase) CommType() string { return m.CommTypeX }
-func (m InitMsgBase) ArgType() string  { return m.ArgTypeX }
-func (m InitMsgBase) Name() string     { return m.IDX }
-func (*InitCodeMsg) MsgType() string   { return Code }
-func (*InitSpecMsg) MsgType() string   { return Spec }
+func (m InitMsgBase) CommType() string   { return m.CommTypeX }
+func (m InitMsgBase) ArgType() string    { return m.ArgTypeX }
+func (m InitMsgBase) Name() string       { return m.IDX }
+func (m InitMsgBase) CacheBck() *cmn.Bck { return &m.CacheBckX }
+func (*InitCodeMsg) MsgType() string     { return Code }
+func (*InitSpecMsg) MsgType() string     { return Spec }
 
 func (m *InitCodeMsg) String() string {
 	return fmt.Sprintf("init-%s[%s-%s-%s-%s]", Code, m.IDX, m.CommTypeX, m.ArgTypeX, m.Runtime)
@@ -215,6 +221,18 @@ func (m *InitMsgBase) validate(detail string) error {
 		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
 	}
 
+	if !m.CacheBckX.IsEmpty() {
+		if m.CacheBckX.Provider == "" {
+			m.CacheBckX.Provider = apc.AIS
+		}
+		if !m.CacheBckX.IsAIS() {
+			return cmn.NewErrETL(errCtx, "cache bucket %s must be an ais:// bucket [%s]", m.CacheBckX.Cname(""), detail)
+		}
+		if err := m.CacheBckX.Validate(); err != nil {
+			return cmn.NewErrETL(errCtx, "invalid cache bucket: %v [%s]", err, detail)
+		}
+	}
+
 	// NOTE: default comm-type
 	if m.CommType() == "" {
 		cos.Infof("Warning: empty comm-type, defaulting to %q", Hpush)
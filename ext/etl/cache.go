@@ -0,0 +1,93 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/OneOfOne/xxhash"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Transformed objects are cached in the (user-specified) InitMsg.CacheBck() as follows:
+//   - cached object name: <etl-name>/<args-digest>/<provider>/[<namespace>/]<bucket>/<object-name>,
+//     where args-digest is computed over the transformer's query parameters (if any);
+//   - cached object's custom metadata (CacheTagObjMD): <transform-ID>;<source-version>,
+//     where transform-ID is a digest of the entire InitMsg (code, spec, runtime, etc.)
+//     and source version is the source object's version or, if not versioned, its checksum.
+//
+// Cached objects get served only if both the transform ID and the source version match;
+// otherwise, the object gets re-transformed and re-cached (i.e., overwritten).
+
+const CacheTagObjMD = "etl_cache_tag"
+
+const noArgs = "-"
+
+// name of the transformed (and cached) object;
+// returns "" when the transformation cannot be cached - currently, when query parameters
+// are passed to a reverse-proxied (Hrev) transformer (offline transformation doesn't forward them)
+func CacheObjName(msg InitMsg, src *core.LOM, rawQuery string) string {
+	args := noArgs
+	if q := cacheArgs(rawQuery); q != "" {
+		if msg.CommType() == Hrev {
+			return ""
+		}
+		args = strconv.FormatUint(xxhash.Checksum64S(cos.UnsafeB(q), cos.MLCG32), 16)
+	}
+	bck := src.Bucket()
+	s := msg.Name() + "/" + args + "/" + bck.Provider + "/"
+	if !bck.Ns.IsGlobal() {
+		s += url.PathEscape(bck.Ns.String()) + "/"
+	}
+	return s + bck.Name + "/" + src.ObjName
+}
+
+// transformer's own query parameters, if any (compare w/ pruneQuery)
+func cacheArgs(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+	vals, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	for _, filtered := range []string{apc.QparamETLName, apc.QparamProxyID, apc.QparamUnixTime,
+		apc.QparamProvider, apc.QparamNamespace, apc.QparamSilent, apc.QparamLatestVer} {
+		vals.Del(filtered)
+	}
+	return vals.Encode() // (sorted by key)
+}
+
+// returns "" when the source object has neither version nor checksum (and therefore cannot be cached)
+func CacheTag(msg InitMsg, src *core.LOM) string {
+	ver := src.Version()
+	if ver == "" {
+		cksum := src.Checksum()
+		if cksum.IsEmpty() {
+			return ""
+		}
+		ver = cksum.Type() + ":" + cksum.Value()
+	}
+	return TransformID(msg) + ";" + ver
+}
+
+// changes whenever ETL gets re-initialized with different code, spec, or parameters
+func TransformID(msg InitMsg) string {
+	b, err := jsoniter.Marshal(msg)
+	if err != nil {
+		return msg.Name()
+	}
+	return msg.Name() + "-" + strconv.FormatUint(xxhash.Checksum64S(b, cos.MLCG32), 16)
+}
+
+// is cached (transformed) object up to date
+func CacheHit(cached *core.LOM, tag string) bool {
+	v, ok := cached.GetCustomKey(CacheTagObjMD)
+	return ok && v == tag && tag != ""
+}
@@ -0,0 +1,36 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCacheArgs(t *testing.T) {
+	tests := map[string]string{
+		"":                                      "",
+		"provider=ais&etl_name=xyz":             "",
+		"provider=ais&pid=p1&utm=123&width=64":  "width=64",
+		"width=64&height=32&namespace=%23ns":    "height=32&width=64",
+		"height=32&width=64&etl_name=xyz&sln=1": "height=32&width=64",
+	}
+	for q, expected := range tests {
+		tassert.Errorf(t, cacheArgs(q) == expected, "%q: expected %q, got %q", q, expected, cacheArgs(q))
+	}
+}
+
+func TestTransformID(t *testing.T) {
+	msg := &InitCodeMsg{Runtime: "python3.11v2"}
+	msg.IDX = "md5"
+	msg.Code = []byte("def transform(b): return b")
+	id1 := TransformID(msg)
+	tassert.Errorf(t, id1 == TransformID(msg), "expecting the same transform ID")
+
+	msg.Code = []byte("def transform(b): return b[::-1]")
+	id2 := TransformID(msg)
+	tassert.Errorf(t, id1 != id2, "expecting a different transform ID when the code changes (%s)", id1)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -29,6 +30,7 @@ type (
 		ObjCount() int64
 		InBytes() int64
 		OutBytes() int64
+		Stats() *Stats
 	}
 
 	// Communicator is responsible for managing communications with local ETL container.
@@ -61,6 +63,7 @@ type (
 	baseComm struct {
 		listener meta.Slistener
 		boot     *etlBootstrapper
+		stats    commStats
 	}
 	pushComm struct {
 		baseComm
@@ -124,6 +127,11 @@ func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicato
 					req.Header.Set("User-Agent", "")
 				}
 			},
+			ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+				rp.stats.fail()
+				nlog.Errorln(Hrev, rp.String(), "err:", err)
+				w.WriteHeader(http.StatusBadGateway)
+			},
 		}
 		rp.rp = revProxy
 		return rp
@@ -156,9 +164,10 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 	}
 
 	var (
-		req    *http.Request
-		resp   *http.Response
-		cancel func()
+		req     *http.Request
+		resp    *http.Response
+		cancel  func()
+		started = mono.NanoTime()
 	)
 	if timeout != 0 {
 		var ctx context.Context
@@ -187,6 +196,7 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 			}
 			c.boot.xctn.InObjsAdd(1, 0)
 			c.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
+			c.stats.observe(started, size)
 		},
 	}), nil
 }
@@ -219,11 +229,12 @@ func (pc *pushComm) doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duratio
 
 func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, errCode int, err error) {
 	var (
-		body   io.ReadCloser
-		cancel func()
-		req    *http.Request
-		resp   *http.Response
-		u      string
+		body    io.ReadCloser
+		cancel  func()
+		req     *http.Request
+		resp    *http.Response
+		u       string
+		started = mono.NanoTime()
 	)
 	if err := pc.boot.xctn.AbortErr(); err != nil {
 		return nil, 0, err
@@ -241,7 +252,7 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		debug.Assertf(lom.Bck().Ns.IsGlobal(), lom.Bck().Cname("")+" - bucket with namespace")
 		u = pc.boot.uri + "/" + lom.Bck().Name + "/" + lom.ObjName
 
-		fh, err := cos.NewFileHandle(lom.FQN)
+		fh, err := lom.NewHandle()
 		if err != nil {
 			return nil, 0, err
 		}
@@ -299,6 +310,7 @@ finish:
 			}
 			pc.boot.xctn.InObjsAdd(1, 0)
 			pc.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
+			pc.stats.observe(started, size)
 		},
 	}
 	return cos.NewReaderWithArgs(args), 0, nil
@@ -309,6 +321,7 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck
 	r, err := pc.doRequest(bck, lom, 0 /*timeout*/)
 	core.FreeLOM(lom)
 	if err != nil {
+		pc.stats.fail()
 		return err
 	}
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
@@ -324,13 +337,18 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck
 
 	slab.Free(buf)
 	r.Close()
+	if err != nil {
+		pc.stats.fail()
+	}
 	return err
 }
 
 func (pc *pushComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	lom := core.AllocLOM(objName)
 	r, err = pc.doRequest(bck, lom, timeout)
-	if err == nil && cmn.Rom.FastV(5, cos.SmoduleETL) {
+	if err != nil {
+		pc.stats.fail()
+	} else if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpush, lom.Cname(), err)
 	}
 	core.FreeLOM(lom)
@@ -350,6 +368,7 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 	size, err := lomLoad(lom, bck)
 	if err != nil {
 		core.FreeLOM(lom)
+		rc.stats.fail()
 		return err
 	}
 	if size > 0 {
@@ -381,11 +400,15 @@ func (rc *redirectComm) OfflineTransform(bck *meta.Bck, objName string, timeout
 	size, errV := lomLoad(lom, bck)
 	if errV != nil {
 		core.FreeLOM(lom)
+		rc.stats.fail()
 		return nil, errV
 	}
 
 	etlURL := rc.redirectURL(lom)
 	r, err := rc.getWithTimeout(etlURL, size, timeout)
+	if err != nil {
+		rc.stats.fail()
+	}
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpull, lom.Cname(), err)
@@ -399,10 +422,12 @@ func (rc *redirectComm) OfflineTransform(bck *meta.Bck, objName string, timeout
 //////////////////
 
 func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) error {
+	started := mono.NanoTime()
 	lom := core.AllocLOM(objName)
 	size, err := lomLoad(lom, bck)
 	if err != nil {
 		core.FreeLOM(lom)
+		rp.stats.fail()
 		return err
 	}
 	if size > 0 {
@@ -414,6 +439,7 @@ func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 	r.URL.Path, _ = url.PathUnescape(path) // `Path` must be unescaped otherwise it will be escaped again.
 	r.URL.RawPath = path                   // `RawPath` should be escaped version of `Path`.
 	rp.rp.ServeHTTP(w, r)
+	rp.stats.observe(started, size)
 
 	return nil
 }
@@ -423,10 +449,14 @@ func (rp *revProxyComm) OfflineTransform(bck *meta.Bck, objName string, timeout
 	size, errV := lomLoad(lom, bck)
 	if errV != nil {
 		core.FreeLOM(lom)
+		rp.stats.fail()
 		return nil, errV
 	}
 	etlURL := cos.JoinPath(rp.boot.uri, transformerPath(bck, objName))
 	r, err := rp.getWithTimeout(etlURL, size, timeout)
+	if err != nil {
+		rp.stats.fail()
+	}
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hrev, lom.Cname(), err)
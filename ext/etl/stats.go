@@ -0,0 +1,165 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"math/bits"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Per-ETL runtime statistics: objects and bytes in/out, failures, and transformation latency.
+// Latency is tracked via log2 histogram: bucket i counts transformations that took
+// [2^i, 2^(i+1)) microseconds (bucket 0 also includes anything faster).
+//
+// Latency is measured from the start of the request to the ETL container until the (transformed)
+// response is fully read - with one exception: inline (GET) transformations via Hpull
+// redirect the requesting client to the container, and therefore cannot be timed.
+
+const NumLatBuckets = 32 // up to 2^32us (~71 minutes)
+
+type (
+	Stats struct {
+		TargetID string  `json:"target_id"`
+		ObjCount int64   `json:"obj_count"`
+		InBytes  int64   `json:"in_bytes"`
+		OutBytes int64   `json:"out_bytes"`
+		Errors   int64   `json:"errors"`
+		LatCount int64   `json:"lat_count"` // number of timed transformations
+		LatTotal int64   `json:"lat_total"` // total (cumulative) latency, ns
+		LatBytes int64   `json:"lat_bytes"` // size of the source objects (of the timed transformations)
+		LatHist  []int64 `json:"lat_hist"`  // NumLatBuckets counters
+	}
+	StatsByTarget []*Stats
+
+	// runtime (per communicator)
+	commStats struct {
+		errs  atomic.Int64
+		cnt   atomic.Int64
+		total atomic.Int64
+		bytes atomic.Int64
+		hist  [NumLatBuckets]atomic.Int64
+	}
+)
+
+///////////////
+// commStats //
+///////////////
+
+func (cs *commStats) observe(started, size int64) {
+	lat := mono.Since(started)
+	cs.cnt.Inc()
+	cs.total.Add(int64(lat))
+	if size > 0 {
+		cs.bytes.Add(size)
+	}
+	cs.hist[latBucket(lat)].Inc()
+}
+
+func (cs *commStats) fail() { cs.errs.Inc() }
+
+func latBucket(lat time.Duration) int {
+	us := uint64(lat / time.Microsecond)
+	if us < 2 {
+		return 0
+	}
+	return min(bits.Len64(us)-1, NumLatBuckets-1)
+}
+
+func (c *baseComm) Stats() *Stats {
+	s := &Stats{
+		TargetID: core.T.SID(),
+		ObjCount: c.ObjCount(),
+		InBytes:  c.InBytes(),
+		OutBytes: c.OutBytes(),
+		Errors:   c.stats.errs.Load(),
+		LatCount: c.stats.cnt.Load(),
+		LatTotal: c.stats.total.Load(),
+		LatBytes: c.stats.bytes.Load(),
+		LatHist:  make([]int64, NumLatBuckets),
+	}
+	for i := range c.stats.hist {
+		s.LatHist[i] = c.stats.hist[i].Load()
+	}
+	return s
+}
+
+///////////
+// Stats //
+///////////
+
+func (s *Stats) LatAvg() time.Duration {
+	if s.LatCount == 0 {
+		return 0
+	}
+	return time.Duration(s.LatTotal / s.LatCount)
+}
+
+// upper bound of the histogram bucket that contains the given percentile
+func (s *Stats) LatPercentile(pct float64) time.Duration {
+	if s.LatCount == 0 {
+		return 0
+	}
+	var (
+		target = int64(float64(s.LatCount)*pct/100 + 0.5)
+		cum    int64
+	)
+	target = max(target, 1)
+	for i, n := range s.LatHist {
+		cum += n
+		if cum >= target {
+			return time.Duration(int64(1)<<(i+1)) * time.Microsecond
+		}
+	}
+	return time.Duration(int64(1)<<len(s.LatHist)) * time.Microsecond
+}
+
+func (s *Stats) LatP99() time.Duration { return s.LatPercentile(99) }
+
+// average transformation throughput (source bytes per second of the timed transformations)
+func (s *Stats) Throughput() int64 {
+	if s.LatTotal == 0 {
+		return 0
+	}
+	return int64(float64(s.LatBytes) / time.Duration(s.LatTotal).Seconds())
+}
+
+// failures relative to the total number of attempted transformations
+func (s *Stats) ErrRate() float64 {
+	if total := s.ObjCount + s.Errors; total > 0 {
+		return float64(s.Errors) / float64(total)
+	}
+	return 0
+}
+
+func (s *Stats) add(other *Stats) {
+	s.ObjCount += other.ObjCount
+	s.InBytes += other.InBytes
+	s.OutBytes += other.OutBytes
+	s.Errors += other.Errors
+	s.LatCount += other.LatCount
+	s.LatTotal += other.LatTotal
+	s.LatBytes += other.LatBytes
+	if len(s.LatHist) < len(other.LatHist) {
+		hist := make([]int64, len(other.LatHist))
+		copy(hist, s.LatHist)
+		s.LatHist = hist
+	}
+	for i, n := range other.LatHist {
+		s.LatHist[i] += n
+	}
+}
+
+// cluster-wide totals (including cluster-wide latency percentiles)
+func (sbt StatsByTarget) Total() *Stats {
+	total := &Stats{LatHist: make([]int64, NumLatBuckets)}
+	for _, s := range sbt {
+		total.add(s)
+	}
+	return total
+}
@@ -0,0 +1,54 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLatBucket(t *testing.T) {
+	tests := []struct {
+		lat    time.Duration
+		bucket int
+	}{
+		{0, 0},
+		{time.Microsecond, 0},
+		{3 * time.Microsecond, 1},
+		{time.Millisecond, 9}, // 1000us => [512, 1024)
+		{time.Second, 19},     // 10^6us => [2^19, 2^20)
+		{100 * time.Hour, NumLatBuckets - 1},
+	}
+	for _, test := range tests {
+		b := latBucket(test.lat)
+		tassert.Errorf(t, b == test.bucket, "%v: expected bucket %d, got %d", test.lat, test.bucket, b)
+	}
+}
+
+func TestStatsTotal(t *testing.T) {
+	var (
+		s1 = &Stats{ObjCount: 99, Errors: 1, LatCount: 99, LatTotal: int64(99 * time.Millisecond), LatBytes: 99 << 20,
+			LatHist: make([]int64, NumLatBuckets)}
+		s2 = &Stats{ObjCount: 1, LatCount: 1, LatTotal: int64(time.Second), LatBytes: 1 << 20,
+			LatHist: make([]int64, NumLatBuckets)}
+	)
+	s1.LatHist[latBucket(time.Millisecond)] = 99
+	s2.LatHist[latBucket(time.Second)] = 1
+
+	total := StatsByTarget{s1, s2}.Total()
+	tassert.Errorf(t, total.ObjCount == 100 && total.Errors == 1, "wrong totals: %+v", total)
+	tassert.Errorf(t, total.ErrRate() > 0.0099 && total.ErrRate() < 0.0100, "wrong error rate %f", total.ErrRate())
+
+	// 99% of all transformations are within [512us, 1024us)
+	tassert.Errorf(t, total.LatP99() == 1024*time.Microsecond, "wrong p99 %v", total.LatP99())
+	tassert.Errorf(t, total.LatPercentile(100) == (1<<20)*time.Microsecond, "wrong p100 %v", total.LatPercentile(100))
+	tassert.Errorf(t, total.LatAvg() == 1099*time.Millisecond/100, "wrong average %v", total.LatAvg())
+	tassert.Errorf(t, total.Throughput() > 0, "expecting positive throughput")
+
+	// original (per-target) stats remain intact
+	tassert.Errorf(t, s1.ObjCount == 99 && s1.LatHist[latBucket(time.Second)] == 0, "modified: %+v", s1)
+}
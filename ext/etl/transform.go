@@ -379,6 +379,15 @@ func PodHealth(etlName string) (string, error) {
 	return client.Health(c.PodName())
 }
 
+// runtime statistics (see stats.go)
+func GetStats(etlName string) (*Stats, error) {
+	c, err := GetCommunicator(etlName)
+	if err != nil {
+		return nil, err
+	}
+	return c.Stats(), nil
+}
+
 func PodMetrics(etlName string) (*CPUMemUsed, error) {
 	c, err := GetCommunicator(etlName)
 	if err != nil {
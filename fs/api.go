@@ -9,6 +9,10 @@ type (
 		Used    uint64 `json:"used,string"`  // bytes
 		Avail   uint64 `json:"avail,string"` // ditto
 		PctUsed int32  `json:"pct_used"`     // %% used (redundant ok)
+		// inodes (zero when the filesystem does not have a fixed number of inodes)
+		Inodes     uint64 `json:"inodes,string,omitempty"`      // total
+		InodesFree uint64 `json:"inodes_free,string,omitempty"` // free
+		PctInodes  int32  `json:"pct_inodes,omitempty"`         // %% used
 	}
 	// Capacity, Disks, Filesystem (CDF)
 	// (not to be confused with Cumulative Distribution Function)
@@ -20,9 +24,10 @@ type (
 	// Target (cumulative) CDF
 	TargetCDF struct {
 		Mountpaths map[string]*CDF // mpath => [Capacity, Disks, FS (CDF)]
-		PctMax     int32           `json:"pct_max"` // max used (%)
-		PctAvg     int32           `json:"pct_avg"` // avg used (%)
-		PctMin     int32           `json:"pct_min"` // min used (%)
-		CsErr      string          `json:"cs_err"`  // OOS or high-wm error message
+		PctMax     int32           `json:"pct_max"`              // max used (%)
+		PctAvg     int32           `json:"pct_avg"`              // avg used (%)
+		PctMin     int32           `json:"pct_min"`              // min used (%)
+		PctInodes  int32           `json:"pct_inodes,omitempty"` // max inodes used (%)
+		CsErr      string          `json:"cs_err"`               // OOS or high-wm error message
 	}
 )
@@ -0,0 +1,96 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/sys"
+)
+
+// Background IO (see `disk.bg_cgroup` in the cluster config):
+// long-lived goroutines of background jobs (e.g., per-mountpath joggers) lock themselves
+// to an OS thread and move the latter into a separate blkio cgroup with a lower weight,
+// so that the (Linux) IO scheduler itself gives precedence to the foreground GET/PUT.
+//
+// usage (by the goroutine that is about to exit):
+//
+//	bg := fs.BgIOBegin()
+//	defer fs.BgIOEnd(bg)
+
+type bgio struct {
+	home   string // aisnode's own blkio cgroup
+	name   string // configured child cgroup (name)
+	failed string // ditto, failed to set up
+	weight int
+	mu     sync.Mutex
+}
+
+var bgIO bgio
+
+func BgIOBegin() bool {
+	c := &cmn.GCO.Get().Disk
+	if c.BgCgroup == "" {
+		return false
+	}
+	dir := bgIO.setup(c)
+	if dir == "" {
+		return false
+	}
+	runtime.LockOSThread()
+	if err := sys.CgroupMoveThread(dir); err != nil {
+		runtime.UnlockOSThread()
+		nlog.Warningln("failed to run background IO in", dir, "cgroup:", err)
+		return false
+	}
+	return true
+}
+
+func BgIOEnd(bg bool) {
+	if !bg {
+		return
+	}
+	if err := sys.CgroupMoveThread(bgIO.home); err != nil {
+		// NOTE: not unlocking - the thread terminates when the (locked) goroutine exits
+		nlog.Warningln("failed to move thread back to", bgIO.home, "cgroup:", err)
+		return
+	}
+	runtime.UnlockOSThread()
+}
+
+// returns the cgroup's directory or empty string if not available;
+// (re)applies weight upon config change
+func (b *bgio) setup(c *cmn.DiskConf) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c.BgCgroup == b.failed {
+		return ""
+	}
+	if c.BgCgroup == b.name && c.BgIOWeight == b.weight {
+		return filepath.Join(b.home, b.name)
+	}
+	if b.home == "" {
+		home, err := sys.BlkioCgroup()
+		if err != nil {
+			b.failed = c.BgCgroup
+			nlog.Errorln("disk.bg_cgroup", c.BgCgroup, "is not supported:", err, "- relying on cooperative throttling")
+			return ""
+		}
+		b.home = home
+	}
+	dir := filepath.Join(b.home, c.BgCgroup)
+	if err := sys.MkdirBlkio(dir, c.BgIOWeight); err != nil {
+		b.failed = c.BgCgroup
+		nlog.Errorln("failed to set up disk.bg_cgroup", dir, "weight", c.BgIOWeight, "err:", err)
+		return ""
+	}
+	b.name, b.weight, b.failed = c.BgCgroup, c.BgIOWeight, ""
+	nlog.Infoln("background IO: cgroup", dir, "weight", c.BgIOWeight)
+	return dir
+}
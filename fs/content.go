@@ -38,6 +38,9 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	DedupType    = "dd" // content-addressed chunks of deduplicated objects (see core/dedup.go)
+	PackType     = "pk" // container files and index of packed small objects (see pack.go)
+	VersionType  = "pv" // previous versions of objects in ais:// buckets (see core/lver.go)
 )
 
 type (
@@ -177,6 +180,9 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	DedupContentResolver    struct{}
+	PackContentResolver     struct{}
+	VersionContentResolver  struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -251,3 +257,47 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// dedup chunks are referenced by objects (manifests) stored on the same mountpath,
+// are never moved or evicted individually, and are garbage-collected by space cleanup
+func (*DedupContentResolver) PermToMove() bool    { return false }
+func (*DedupContentResolver) PermToEvict() bool   { return false }
+func (*DedupContentResolver) PermToProcess() bool { return false }
+
+func (*DedupContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*DedupContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
+
+// packed objects are accessed (and moved, if need be) via their respective LOMs -
+// never container by container (see pack.go)
+func (*PackContentResolver) PermToMove() bool    { return false }
+func (*PackContentResolver) PermToEvict() bool   { return false }
+func (*PackContentResolver) PermToProcess() bool { return false }
+
+func (*PackContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*PackContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
+
+// previous versions are stored next to (same mountpath as) their objects and are not moved;
+// LRU evicts them based on lru.version_evict_time
+func (*VersionContentResolver) PermToMove() bool    { return false }
+func (*VersionContentResolver) PermToEvict() bool   { return true }
+func (*VersionContentResolver) PermToProcess() bool { return false }
+
+// base.version
+func (*VersionContentResolver) GenUniqueFQN(base, ver string) string { return base + "." + ver }
+
+func (*VersionContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	i := strings.LastIndexByte(base, '.')
+	if i <= 0 || i == len(base)-1 {
+		return "", false, false
+	}
+	if _, err := strconv.ParseUint(base[i+1:], 10, 64); err != nil {
+		return "", false, false
+	}
+	return base[:i], false, true
+}
@@ -32,6 +32,8 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileDedup        = "dedup"          // deduplicate object: chunks and manifest
+	WorkfileMpt          = "mpt"            // parts of a multipart upload
 )
 
 type ParsedFQN struct {
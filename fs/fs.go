@@ -94,6 +94,7 @@ type (
 		PctAvg     int32  // average used (%)
 		PctMax     int32  // max used (%)
 		PctMin     int32  // max used (%)
+		PctInodes  int32  // max inodes used (%)
 	}
 )
 
@@ -333,6 +334,9 @@ func (mi *Mountpath) getCapacity(config *cmn.Config, refresh bool) (c Capacity,
 		c.Used = ratomic.LoadUint64(&mi.capacity.Used)
 		c.Avail = ratomic.LoadUint64(&mi.capacity.Avail)
 		c.PctUsed = ratomic.LoadInt32(&mi.capacity.PctUsed)
+		c.Inodes = ratomic.LoadUint64(&mi.capacity.Inodes)
+		c.InodesFree = ratomic.LoadUint64(&mi.capacity.InodesFree)
+		c.PctInodes = ratomic.LoadInt32(&mi.capacity.PctInodes)
 		return
 	}
 	statfs := &syscall.Statfs_t{}
@@ -353,6 +357,15 @@ func (mi *Mountpath) getCapacity(config *cmn.Config, refresh bool) (c Capacity,
 	c.Avail = a
 	ratomic.StoreInt32(&mi.capacity.PctUsed, int32(pct))
 	c.PctUsed = int32(pct)
+
+	// inodes (e.g., XFS and btrfs allocate them dynamically and may report zero)
+	if statfs.Files > 0 && statfs.Files >= statfs.Ffree {
+		c.Inodes, c.InodesFree = statfs.Files, statfs.Ffree
+		c.PctInodes = int32(math.Ceil(float64(statfs.Files-statfs.Ffree) * 100 / float64(statfs.Files)))
+	}
+	ratomic.StoreUint64(&mi.capacity.Inodes, c.Inodes)
+	ratomic.StoreUint64(&mi.capacity.InodesFree, c.InodesFree)
+	ratomic.StoreInt32(&mi.capacity.PctInodes, c.PctInodes)
 	return
 }
 
@@ -722,6 +735,7 @@ func Remove(mpath string, cb ...func()) (*Mountpath, error) {
 
 	config := cmn.GCO.Get()
 	availableCopy := _cloneOne(avail)
+	packForget(nil, mi)
 	mfs.ios.RemoveMpath(cleanMpath, config.TestingEnv())
 	delete(availableCopy, cleanMpath)
 	delete(mfs.fsIDs, mi.FsID)
@@ -807,6 +821,7 @@ func Disable(mpath string, cb ...func()) (disabledMpath *Mountpath, err error) {
 		availableCopy, disabledCopy := cloneMPI()
 		cos.ClearfAtomic(&mi.flags, FlagWaitingDD)
 		disabledCopy[cleanMpath] = mi
+		packForget(nil, mi)
 
 		config := cmn.GCO.Get()
 		mfs.ios.RemoveMpath(cleanMpath, config.TestingEnv())
@@ -879,6 +894,7 @@ func DestroyBucket(op string, bck *cmn.Bck, bid uint64) (err error) {
 		count = len(avail)
 		now   time.Time
 	)
+	packForget(bck, nil)
 	for _, mi := range avail {
 		// normally, unique bucket ID (aka BID) must be known
 		// - i.e., non-zero (and unique);
@@ -915,6 +931,8 @@ func DestroyBucket(op string, bck *cmn.Bck, bid uint64) (err error) {
 func RenameBucketDirs(bckFrom, bckTo *cmn.Bck) (err error) {
 	avail := GetAvail()
 	renamed := make([]*Mountpath, 0, len(avail))
+	packForget(bckFrom, nil)
+	packForget(bckTo, nil)
 	for _, mi := range avail {
 		fromPath := mi.makeDelPathBck(bckFrom)
 		toPath := mi.MakePathBck(bckTo)
@@ -1086,6 +1104,7 @@ func Cap() (cs CapStatus) {
 	cs.PctMin = ratomic.LoadInt32(&mfs.cs.PctMin)
 	cs.PctAvg = ratomic.LoadInt32(&mfs.cs.PctAvg)
 	cs.PctMax = ratomic.LoadInt32(&mfs.cs.PctMax)
+	cs.PctInodes = ratomic.LoadInt32(&mfs.cs.PctInodes)
 	return
 }
 
@@ -1113,6 +1132,7 @@ func CapRefresh(config *cmn.Config, tcdf *TargetCDF) (cs CapStatus, err, errCap
 		cs.PctMax = max(cs.PctMax, c.PctUsed)
 		cs.PctMin = min(cs.PctMin, c.PctUsed)
 		cs.PctAvg += c.PctUsed
+		cs.PctInodes = max(cs.PctInodes, c.PctInodes)
 		if tcdf == nil {
 			continue
 		}
@@ -1130,6 +1150,7 @@ func CapRefresh(config *cmn.Config, tcdf *TargetCDF) (cs CapStatus, err, errCap
 
 	if tcdf != nil {
 		tcdf.PctMax, tcdf.PctAvg, tcdf.PctMin = cs.PctMax, cs.PctAvg, cs.PctMin
+		tcdf.PctInodes = cs.PctInodes
 		if errCap != nil {
 			tcdf.CsErr = errCap.Error()
 		}
@@ -1143,6 +1164,7 @@ func CapRefresh(config *cmn.Config, tcdf *TargetCDF) (cs CapStatus, err, errCap
 	ratomic.StoreInt32(&mfs.cs.PctMin, cs.PctMin)
 	ratomic.StoreInt32(&mfs.cs.PctAvg, cs.PctAvg)
 	ratomic.StoreInt32(&mfs.cs.PctMax, cs.PctMax)
+	ratomic.StoreInt32(&mfs.cs.PctInodes, cs.PctInodes)
 	return
 }
 
@@ -1172,13 +1194,18 @@ func CapStatusGetWhat() (fsInfo apc.CapacityInfo) {
 // note: conditioning on max, not avg
 func (cs *CapStatus) Err() (err error) {
 	oos := cs.IsOOS()
-	if oos || int64(cs.PctMax) > cs.HighWM {
-		err = cmn.NewErrCapExceeded(cs.TotalUsed, cs.TotalAvail+cs.TotalUsed, cs.HighWM, 0 /*cleanup wm*/, cs.PctMax, oos)
+	if pct := cs.PctUsedMax(); oos || int64(pct) > cs.HighWM {
+		err = cmn.NewErrCapExceeded(cs.TotalUsed, cs.TotalAvail+cs.TotalUsed, cs.HighWM, 0 /*cleanup wm*/, pct, oos,
+			cs.PctInodes > cs.PctMax /*inodes*/)
 	}
 	return
 }
 
-func (cs *CapStatus) IsOOS() bool { return int64(cs.PctMax) > cs.OOS }
+// max used (%) across mountpaths: bytes or inodes, whichever is greater
+// (some workloads run out of inodes first)
+func (cs *CapStatus) PctUsedMax() int32 { return max(cs.PctMax, cs.PctInodes) }
+
+func (cs *CapStatus) IsOOS() bool { return int64(cs.PctUsedMax()) > cs.OOS }
 
 func (cs *CapStatus) IsNil() bool { return cs.TotalUsed == 0 && cs.TotalAvail == 0 }
 
@@ -1189,10 +1216,13 @@ func (cs *CapStatus) String() (s string) {
 	)
 	s = fmt.Sprintf("cap(used %s, avail %s [min=%d%%, avg=%d%%, max=%d%%]", totalUsed, totalAvail,
 		cs.PctMin, cs.PctAvg, cs.PctMax)
+	if cs.PctInodes > 0 {
+		s += fmt.Sprintf(", inodes %d%%", cs.PctInodes)
+	}
 	switch {
 	case cs.IsOOS():
 		s += ", OOS"
-	case int64(cs.PctMax) > cs.HighWM:
+	case int64(cs.PctUsedMax()) > cs.HighWM:
 		s += ", high-wm"
 	}
 	s += ")"
@@ -1202,7 +1232,7 @@ func (cs *CapStatus) String() (s string) {
 // next time to CapRefresh()
 func (cs *CapStatus) _next(config *cmn.Config) time.Duration {
 	var (
-		util = int64(cs.PctMax)
+		util = int64(cs.PctUsedMax())
 		umin = min(config.Space.HighWM-10, config.Space.LowWM)
 		umax = config.Space.OOS
 		tmax = config.LRU.CapacityUpdTime.D()
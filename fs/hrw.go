@@ -36,3 +36,13 @@ func Hrw(uname string) (mi *Mountpath, digest uint64, err error) {
 	}
 	return
 }
+
+// HrwScore returns mountpath's HRW score for a given object (compare with Hrw above).
+// Used to explain object placement (see CLI 'ais advanced why').
+func HrwScore(mpath, uname string) uint64 {
+	var (
+		digest     = xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+		pathDigest = xxhash.Checksum64S(cos.UnsafeB(mpath), cos.MLCG32)
+	)
+	return xoshiro256.Hash(pathDigest ^ digest)
+}
@@ -5,6 +5,7 @@
 package fs_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -335,3 +336,16 @@ func BenchmarkMakePathFQN(b *testing.B) {
 		cos.Assert(len(s) > 0)
 	}
 }
+
+func TestCapStatusInodes(t *testing.T) {
+	cs := fs.CapStatus{HighWM: 90, OOS: 95, PctMax: 10, PctAvg: 10, PctMin: 10}
+	tassert.Errorf(t, cs.Err() == nil, "unexpected %s", cs.String())
+
+	cs.PctInodes = 92
+	err := cs.Err()
+	tassert.Errorf(t, cmn.IsErrCapExceeded(err) && !cs.IsOOS(), "expected high-wm error, got %v (%s)", err, cs.String())
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "inodes"), "expected inodes in %v", err)
+
+	cs.PctInodes = 96
+	tassert.Errorf(t, cs.IsOOS() && cs.PctUsedMax() == 96, "expected OOS: %s", cs.String())
+}
@@ -50,6 +50,7 @@ type (
 		VisitObj              func(lom *core.LOM, buf []byte) error
 		VisitCT               func(ct *core.CT, buf []byte) error
 		Slab                  *memsys.Slab
+		Parent                core.ResAcct // if specified, CPU time of the visits is attributed to it
 		Bck                   cmn.Bck
 		Buckets               cmn.Bcks
 		Prefix                string
@@ -201,6 +202,8 @@ func newJogger(ctx context.Context, opts *JgroupOpts, mi *fs.Mountpath, config *
 }
 
 func (j *jogger) run() (err error) {
+	bg := fs.BgIOBegin()
+	defer fs.BgIOEnd(bg)
 	if j.opts.Slab != nil {
 		if j.opts.Parallel <= 1 {
 			j.bufs = [][]byte{j.opts.Slab.Alloc()}
@@ -407,10 +410,24 @@ func (j *jogger) visitObj(lom *core.LOM, buf []byte) (err error) {
 		return nil
 	}
 visit:
-	return j.opts.VisitObj(lom, buf)
+	if j.opts.Parent == nil {
+		return j.opts.VisitObj(lom, buf)
+	}
+	begin := core.CPUBegin()
+	err = j.opts.VisitObj(lom, buf)
+	core.CPUEnd(j.opts.Parent, begin)
+	return err
 }
 
-func (j *jogger) visitCT(ct *core.CT, buf []byte) error { return j.opts.VisitCT(ct, buf) }
+func (j *jogger) visitCT(ct *core.CT, buf []byte) error {
+	if j.opts.Parent == nil {
+		return j.opts.VisitCT(ct, buf)
+	}
+	begin := core.CPUBegin()
+	err := j.opts.VisitCT(ct, buf)
+	core.CPUEnd(j.opts.Parent, begin)
+	return err
+}
 
 func (j *jogger) getBuf(position int) []byte {
 	if j.bufs == nil {
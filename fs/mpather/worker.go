@@ -21,6 +21,7 @@ type (
 	WorkerGroupOpts struct {
 		Callback  func(lom *core.LOM, buf []byte)
 		Slab      *memsys.Slab
+		Parent    core.ResAcct // if specified, CPU time of the callbacks is attributed to it
 		QueueSize int
 	}
 
@@ -94,6 +95,8 @@ func newWorker(opts *WorkerGroupOpts, mi *fs.Mountpath) (w *worker) {
 }
 
 func (w *worker) work() error {
+	bg := fs.BgIOBegin()
+	defer fs.BgIOEnd(bg)
 	var buf []byte
 	if w.opts.Slab != nil {
 		buf = w.opts.Slab.Alloc()
@@ -107,7 +110,7 @@ func (w *worker) work() error {
 				break
 			}
 			if err = lom.Load(false /*cache it*/, false); err == nil {
-				w.opts.Callback(lom, buf)
+				w.callback(lom, buf)
 			} else {
 				core.FreeLOM(lom)
 			}
@@ -141,3 +144,13 @@ func drainWorkCh(workCh chan core.LIF) (n int) {
 		}
 	}
 }
+
+func (w *worker) callback(lom *core.LOM, buf []byte) {
+	if w.opts.Parent == nil {
+		w.opts.Callback(lom, buf)
+		return
+	}
+	begin := core.CPUBegin()
+	w.opts.Callback(lom, buf)
+	core.CPUEnd(w.opts.Parent, begin)
+}
@@ -0,0 +1,695 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Small-object packing aka "slab objects" (bucket-configurable, see cmn.PackConf):
+// - objects of up to `packing.max_obj_size` get appended to container files
+//   instead of being stored one file (and one inode) per object;
+// - each (mountpath, bucket) has its own store under the PackType content directory;
+// - the store consists of numbered container files and a single append-only journal
+//   that maps object names to their (container, offset, size) and carries object metadata;
+// - the journal gets replayed upon first access; torn tail (if any) is truncated;
+// - overwritten and deleted objects leave garbage in their respective containers -
+//   containers with enough garbage get compacted in the background (see core/pack.go);
+// - regular file (under the same name) takes precedence (see core.LOM.FromFS).
+//
+// Journal record:
+// | -- payload size (4) -- | -- crc32c (4) -- | type (1) | name len (2) | name | ... payload ... |
+
+const (
+	packIndex    = "index"
+	packIndexTmp = "index.tmp"
+	packCprefix  = "c-" // container: prefix + 8-digit hex ID
+
+	packHdrSize = 8
+	packMaxRec  = 4 * cos.MiB // sanity
+)
+
+// journal record types
+const (
+	packRecPut byte = iota + 1 // location, atime, and metadata
+	packRecMD                  // atime and (optionally) metadata
+	packRecDel
+)
+
+type (
+	PackEntry struct {
+		MD    []byte // object metadata (opaque, see core/lom_xattr.go)
+		Atime int64
+		Off   int64
+		Size  int64
+		Cid   uint32 // container
+	}
+	PackStore struct {
+		entries map[string]*PackEntry
+		total   map[uint32]int64 // container => size
+		live    map[uint32]int64 // container => bytes referenced by entries
+		jfh     *os.File         // journal
+		cfh     *os.File         // current container (appending)
+		dir     string
+		jsize   int64
+		nrecs   int64
+		csize   int64 // max container size (as per bucket props)
+		pct     int   // compaction threshold (ditto)
+		cid     uint32
+		mu      sync.RWMutex
+	}
+	packReader struct {
+		*io.SectionReader
+		fh   *os.File
+		fqn  string
+		off  int64
+		size int64
+	}
+	packDE bool // DirEntry of a packed object (false) or its virtual parent directory (true)
+)
+
+// interface guard
+var (
+	_ cos.LomReader = (*packReader)(nil)
+	_ DirEntry      = packDE(false)
+)
+
+var (
+	packs = struct {
+		m  map[string]*PackStore // nil value: known to be absent
+		mu sync.RWMutex
+	}{m: make(map[string]*PackStore, 4)}
+
+	crcTab = crc32.MakeTable(crc32.Castagnoli)
+
+	errPackName = errors.New("object name too long to pack")
+)
+
+func (de packDE) IsDir() bool { return bool(de) }
+
+// PackGet returns packed-objects store of a given bucket on a given mountpath;
+// returns nil (and no error) if the store does not exist and `create` is false
+func PackGet(mi *Mountpath, bck *cmn.Bck, create bool) (*PackStore, error) {
+	return packGet(mi.MakePathCT(bck, PackType), create)
+}
+
+func packGet(dir string, create bool) (ps *PackStore, err error) {
+	var known bool
+	packs.mu.RLock()
+	ps, known = packs.m[dir]
+	packs.mu.RUnlock()
+	if ps != nil || (known && !create) {
+		return ps, nil
+	}
+
+	packs.mu.Lock()
+	ps, known = packs.m[dir]
+	if ps == nil && (!known || create) {
+		if ps, err = openPack(dir, create); err == nil {
+			packs.m[dir] = ps
+		}
+	}
+	packs.mu.Unlock()
+	return ps, err
+}
+
+// PackRange visits all currently open stores
+func PackRange(cb func(ps *PackStore)) {
+	packs.mu.RLock()
+	all := make([]*PackStore, 0, len(packs.m))
+	for _, ps := range packs.m {
+		if ps != nil {
+			all = append(all, ps)
+		}
+	}
+	packs.mu.RUnlock()
+	for _, ps := range all {
+		cb(ps)
+	}
+}
+
+// forget (and close) the stores that are about to be (re)moved;
+// the caller passes either a bucket or a mountpath
+func packForget(bck *cmn.Bck, mi *Mountpath) {
+	var dirs []string
+	if bck != nil {
+		for _, mi := range GetAvail() {
+			dirs = append(dirs, mi.MakePathCT(bck, PackType))
+		}
+	}
+	packs.mu.Lock()
+	for dir, ps := range packs.m {
+		switch {
+		case mi != nil:
+			if !strings.HasPrefix(dir, mi.Path+string(filepath.Separator)) {
+				continue
+			}
+		case !cos.StringInSlice(dir, dirs):
+			continue
+		}
+		if ps != nil {
+			ps.close()
+		}
+		delete(packs.m, dir)
+	}
+	packs.mu.Unlock()
+}
+
+func openPack(dir string, create bool) (*PackStore, error) {
+	jfqn := filepath.Join(dir, packIndex)
+	jfh, err := os.OpenFile(jfqn, os.O_RDWR, 0)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		if !create {
+			return nil, nil
+		}
+		if err = cos.CreateDir(dir); err != nil {
+			return nil, err
+		}
+		if jfh, err = os.OpenFile(jfqn, os.O_CREATE|os.O_RDWR, cos.PermRWR); err != nil {
+			return nil, err
+		}
+	}
+	ps := &PackStore{
+		dir:     dir,
+		jfh:     jfh,
+		entries: make(map[string]*PackEntry, 64),
+		total:   make(map[uint32]int64, 4),
+		live:    make(map[uint32]int64, 4),
+		csize:   int64(cmn.DefaultPackContainerSize),
+		pct:     cmn.DefaultPackCompactPct,
+	}
+	if err = ps.replay(); err == nil {
+		err = ps.scan()
+	}
+	if err != nil {
+		cos.Close(jfh)
+		return nil, fmt.Errorf("failed to load packed objects %q: %w", dir, err)
+	}
+	return ps, nil
+}
+
+///////////////
+// PackStore //
+///////////////
+
+func (ps *PackStore) String() string { return "pack[" + ps.dir + "]" }
+
+func (ps *PackStore) cfqn(cid uint32) string {
+	return filepath.Join(ps.dir, fmt.Sprintf("%s%08x", packCprefix, cid))
+}
+
+func (ps *PackStore) Len() int {
+	ps.mu.RLock()
+	l := len(ps.entries)
+	ps.mu.RUnlock()
+	return l
+}
+
+// Names returns sorted names of all packed objects
+func (ps *PackStore) Names() []string {
+	ps.mu.RLock()
+	names := make([]string, 0, len(ps.entries))
+	for name := range ps.entries {
+		names = append(names, name)
+	}
+	ps.mu.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+func (ps *PackStore) Get(name string) (e PackEntry, ok bool) {
+	ps.mu.RLock()
+	if pe, exists := ps.entries[name]; exists {
+		e, ok = *pe, true
+	}
+	ps.mu.RUnlock()
+	return
+}
+
+// Put appends object's content to the current container and records
+// its location and metadata (the latter is copied)
+func (ps *PackStore) Put(name string, r io.Reader, size int64, md []byte, atime int64, conf *cmn.PackConf) error {
+	if len(name) > math.MaxUint16 {
+		return errPackName
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.csize, ps.pct = int64(conf.ContainerSize), conf.CompactPct
+
+	if err := ps.cur(size); err != nil {
+		return err
+	}
+	var (
+		off    = ps.total[ps.cid]
+		n, err = io.Copy(io.NewOffsetWriter(ps.cfh, off), io.LimitReader(r, size))
+	)
+	ps.total[ps.cid] += n // (partially written content is garbage)
+	if err == nil && n != size {
+		err = fmt.Errorf("%s: short read %q (%d != %d)", ps, name, n, size)
+	}
+	if err != nil {
+		return err
+	}
+	e := &PackEntry{MD: bytes.Clone(md), Atime: atime, Off: off, Size: size, Cid: ps.cid}
+	if err := ps.log(packRecPut, name, e); err != nil {
+		return err
+	}
+	ps.set(name, e)
+	return nil
+}
+
+// SetMD updates atime and, unless nil, metadata
+func (ps *PackStore) SetMD(name string, md []byte, atime int64) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	e, ok := ps.entries[name]
+	if !ok {
+		return &os.PathError{Op: "pack-set-md", Path: name, Err: os.ErrNotExist}
+	}
+	ne := *e
+	ne.Atime = atime
+	if md != nil {
+		ne.MD = bytes.Clone(md)
+	}
+	if err := ps.log(packRecMD, name, &ne); err != nil {
+		return err
+	}
+	*e = ne
+	return nil
+}
+
+// returns false if not present
+func (ps *PackStore) Del(name string) (bool, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	e, ok := ps.entries[name]
+	if !ok {
+		return false, nil
+	}
+	if err := ps.log(packRecDel, name, nil); err != nil {
+		return true, err
+	}
+	ps.live[e.Cid] -= e.Size
+	delete(ps.entries, name)
+	return true, nil
+}
+
+// Open returns packed object's reader that remains valid
+// regardless of subsequent overwrites, deletions, and compactions
+func (ps *PackStore) Open(name string) (cos.LomReader, error) {
+	ps.mu.RLock()
+	e, ok := ps.entries[name]
+	if !ok {
+		ps.mu.RUnlock()
+		return nil, &os.PathError{Op: "pack-open", Path: name, Err: os.ErrNotExist}
+	}
+	pr := &packReader{fqn: ps.cfqn(e.Cid), off: e.Off, size: e.Size}
+	fh, err := os.Open(pr.fqn)
+	ps.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	pr.fh, pr.SectionReader = fh, io.NewSectionReader(fh, pr.off, pr.size)
+	return pr, nil
+}
+
+// Compact moves live content out of the containers that have at least `pct`
+// percent of garbage, and removes the latter; rewrites the journal if need be.
+// Returns the number of removed containers.
+func (ps *PackStore) Compact(pct int) (n int, err error) {
+	ps.mu.RLock()
+	if pct <= 0 {
+		pct = ps.pct
+	}
+	victims := make([]uint32, 0, 4)
+	for cid, total := range ps.total {
+		if cid == ps.cid {
+			continue
+		}
+		if (total-ps.live[cid])*100 >= total*int64(pct) {
+			victims = append(victims, cid)
+		}
+	}
+	ps.mu.RUnlock()
+
+	// one container at a time (to keep readers going)
+	for _, cid := range victims {
+		if err = ps.compact(cid); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	ps.mu.Lock()
+	if ps.nrecs > 2*int64(len(ps.entries))+1024 {
+		err = ps.rewrite()
+	}
+	ps.mu.Unlock()
+	return n, err
+}
+
+func (ps *PackStore) compact(cid uint32) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if _, ok := ps.total[cid]; !ok {
+		return nil
+	}
+	if ps.live[cid] > 0 {
+		fh, err := os.Open(ps.cfqn(cid))
+		if err != nil {
+			return err
+		}
+		err = ps.move(fh, cid)
+		cos.Close(fh)
+		if err != nil {
+			return err
+		}
+	}
+	if err := cos.RemoveFile(ps.cfqn(cid)); err != nil {
+		return err
+	}
+	delete(ps.total, cid)
+	delete(ps.live, cid)
+	return nil
+}
+
+// (under lock)
+func (ps *PackStore) move(fh *os.File, cid uint32) error {
+	for name, e := range ps.entries {
+		if e.Cid != cid {
+			continue
+		}
+		if err := ps.cur(e.Size); err != nil {
+			return err
+		}
+		off := ps.total[ps.cid]
+		n, err := io.Copy(io.NewOffsetWriter(ps.cfh, off), io.NewSectionReader(fh, e.Off, e.Size))
+		ps.total[ps.cid] += n
+		if err == nil && n != e.Size {
+			err = fmt.Errorf("%s: container %x truncated (%q, %d != %d)", ps, cid, name, n, e.Size)
+		}
+		if err != nil {
+			return err
+		}
+		ne := *e
+		ne.Cid, ne.Off = ps.cid, off
+		if err := ps.log(packRecPut, name, &ne); err != nil {
+			return err
+		}
+		ps.set(name, &ne)
+	}
+	return nil
+}
+
+// (under lock)
+func (ps *PackStore) set(name string, e *PackEntry) {
+	if prev, ok := ps.entries[name]; ok {
+		ps.live[prev.Cid] -= prev.Size
+	}
+	ps.live[e.Cid] += e.Size
+	ps.entries[name] = e
+}
+
+// make sure the current container has room for `size` more bytes (under lock)
+func (ps *PackStore) cur(size int64) (err error) {
+	if ps.cid > 0 && (ps.total[ps.cid] == 0 || ps.total[ps.cid]+size <= ps.csize) {
+		if ps.cfh == nil {
+			ps.cfh, err = os.OpenFile(ps.cfqn(ps.cid), os.O_CREATE|os.O_WRONLY, cos.PermRWR)
+		}
+		return err
+	}
+	if ps.cfh != nil {
+		cos.Close(ps.cfh)
+		ps.cfh = nil
+	}
+	cid := ps.cid + 1
+	if ps.cfh, err = os.OpenFile(ps.cfqn(cid), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR); err != nil {
+		return err
+	}
+	ps.cid, ps.total[cid] = cid, 0
+	return nil
+}
+
+// append journal record (under lock)
+func (ps *PackStore) log(ty byte, name string, e *PackEntry) error {
+	rec := packRec(ty, name, e)
+	if _, err := ps.jfh.WriteAt(rec, ps.jsize); err != nil {
+		return err
+	}
+	ps.jsize += int64(len(rec))
+	ps.nrecs++
+	return nil
+}
+
+// rewrite the journal to contain only the current entries (under lock)
+func (ps *PackStore) rewrite() error {
+	var (
+		tmp   = filepath.Join(ps.dir, packIndexTmp)
+		jfqn  = filepath.Join(ps.dir, packIndex)
+		jsize int64
+	)
+	fh, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(fh)
+	for name, e := range ps.entries {
+		rec := packRec(packRecPut, name, e)
+		if _, err = bw.Write(rec); err != nil {
+			break
+		}
+		jsize += int64(len(rec))
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = cos.FlushClose(fh)
+	} else {
+		cos.Close(fh)
+	}
+	if err == nil {
+		err = os.Rename(tmp, jfqn)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(tmp); errRm != nil {
+			nlog.Errorln("nested err:", errRm)
+		}
+		return err
+	}
+	jfh, err := os.OpenFile(jfqn, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	cos.Close(ps.jfh)
+	ps.jfh, ps.jsize, ps.nrecs = jfh, jsize, int64(len(ps.entries))
+	return nil
+}
+
+func (ps *PackStore) close() {
+	ps.mu.Lock()
+	if ps.cfh != nil {
+		cos.Close(ps.cfh)
+		ps.cfh = nil
+	}
+	cos.Close(ps.jfh)
+	ps.mu.Unlock()
+}
+
+//
+// journal replay
+//
+
+func (ps *PackStore) replay() error {
+	var (
+		hdr [packHdrSize]byte
+		br  = bufio.NewReader(ps.jfh)
+		buf []byte
+	)
+	for {
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return ps.torn(err)
+		}
+		size := binary.BigEndian.Uint32(hdr[:])
+		if size > packMaxRec {
+			return ps.torn(fmt.Errorf("invalid record size %d", size))
+		}
+		if cap(buf) < int(size) {
+			buf = make([]byte, size)
+		}
+		buf = buf[:size]
+		if _, err := io.ReadFull(br, buf); err != nil {
+			return ps.torn(err)
+		}
+		if crc32.Checksum(buf, crcTab) != binary.BigEndian.Uint32(hdr[4:]) {
+			return ps.torn(errors.New("checksum mismatch"))
+		}
+		if err := ps.apply(buf); err != nil {
+			return ps.torn(err)
+		}
+		ps.jsize += packHdrSize + int64(size)
+		ps.nrecs++
+	}
+}
+
+// truncate torn (or otherwise invalid) tail
+func (ps *PackStore) torn(err error) error {
+	nlog.Warningf("%s: truncating journal at offset %d: %v", ps, ps.jsize, err)
+	return ps.jfh.Truncate(ps.jsize)
+}
+
+func (ps *PackStore) apply(rec []byte) error {
+	if len(rec) < 3 {
+		return errors.New("record too short")
+	}
+	var (
+		ty = rec[0]
+		l  = int(binary.BigEndian.Uint16(rec[1:]))
+	)
+	if len(rec) < 3+l {
+		return errors.New("invalid name length")
+	}
+	name, rec := string(rec[3:3+l]), rec[3+l:]
+	switch ty {
+	case packRecPut:
+		if len(rec) < 28 {
+			return errors.New("invalid put record")
+		}
+		e := &PackEntry{
+			Cid:   binary.BigEndian.Uint32(rec),
+			Off:   int64(binary.BigEndian.Uint64(rec[4:])),
+			Size:  int64(binary.BigEndian.Uint64(rec[12:])),
+			Atime: int64(binary.BigEndian.Uint64(rec[20:])),
+			MD:    bytes.Clone(rec[28:]),
+		}
+		ps.set(name, e)
+	case packRecMD:
+		if len(rec) < 8 {
+			return errors.New("invalid md record")
+		}
+		if e, ok := ps.entries[name]; ok {
+			e.Atime = int64(binary.BigEndian.Uint64(rec))
+			if len(rec) > 8 {
+				e.MD = bytes.Clone(rec[8:])
+			}
+		}
+	case packRecDel:
+		if e, ok := ps.entries[name]; ok {
+			ps.live[e.Cid] -= e.Size
+			delete(ps.entries, name)
+		}
+	default:
+		return fmt.Errorf("unknown record type %d", ty)
+	}
+	return nil
+}
+
+// container sizes; the most recent container becomes current
+func (ps *PackStore) scan() error {
+	des, err := os.ReadDir(ps.dir)
+	if err != nil {
+		return err
+	}
+	for _, de := range des {
+		name := de.Name()
+		if !strings.HasPrefix(name, packCprefix) {
+			continue
+		}
+		cid, err := strconv.ParseUint(name[len(packCprefix):], 16, 32)
+		if err != nil {
+			continue
+		}
+		finfo, err := de.Info()
+		if err != nil {
+			return err
+		}
+		ps.total[uint32(cid)] = finfo.Size()
+		ps.cid = max(ps.cid, uint32(cid))
+	}
+	// sanity: entries must reference existing containers
+	for name, e := range ps.entries {
+		if total, ok := ps.total[e.Cid]; !ok || e.Off+e.Size > total {
+			nlog.Errorf("%s: %q references missing or truncated container %x - dropping", ps, name, e.Cid)
+			ps.live[e.Cid] -= e.Size
+			delete(ps.entries, name)
+		}
+	}
+	for cid := range ps.live {
+		if _, ok := ps.total[cid]; !ok {
+			delete(ps.live, cid)
+		}
+	}
+	return nil
+}
+
+func packRec(ty byte, name string, e *PackEntry) []byte {
+	size := 3 + len(name)
+	switch ty {
+	case packRecPut:
+		size += 28 + len(e.MD)
+	case packRecMD:
+		size += 8 + len(e.MD)
+	}
+	rec := make([]byte, packHdrSize+size)
+	b := rec[packHdrSize:]
+	b[0] = ty
+	binary.BigEndian.PutUint16(b[1:], uint16(len(name)))
+	copy(b[3:], name)
+	p := b[3+len(name):]
+	switch ty {
+	case packRecPut:
+		binary.BigEndian.PutUint32(p, e.Cid)
+		binary.BigEndian.PutUint64(p[4:], uint64(e.Off))
+		binary.BigEndian.PutUint64(p[12:], uint64(e.Size))
+		binary.BigEndian.PutUint64(p[20:], uint64(e.Atime))
+		copy(p[28:], e.MD)
+	case packRecMD:
+		binary.BigEndian.PutUint64(p, uint64(e.Atime))
+		copy(p[8:], e.MD)
+	}
+	binary.BigEndian.PutUint32(rec, uint32(size))
+	binary.BigEndian.PutUint32(rec[4:], crc32.Checksum(b, crcTab))
+	return rec
+}
+
+////////////////
+// packReader //
+////////////////
+
+func (pr *packReader) Close() error { return pr.fh.Close() }
+
+// (the container may have been compacted away in the meantime - hence, dup)
+func (pr *packReader) Open() (cos.ReadOpenCloser, error) {
+	fd, err := syscall.Dup(int(pr.fh.Fd()))
+	if err != nil {
+		return nil, os.NewSyscallError("dup", err)
+	}
+	fh := os.NewFile(uintptr(fd), pr.fqn)
+	return &packReader{SectionReader: io.NewSectionReader(fh, pr.off, pr.size), fh: fh, fqn: pr.fqn, off: pr.off, size: pr.size}, nil
+}
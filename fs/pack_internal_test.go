@@ -0,0 +1,155 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func packContent(i, size int) []byte {
+	return bytes.Repeat([]byte{byte('a' + i%26)}, size)
+}
+
+func packRead(t *testing.T, ps *PackStore, name string) []byte {
+	r, err := ps.Open(name)
+	tassert.CheckFatal(t, err)
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	tassert.CheckFatal(t, err)
+	return b
+}
+
+func packPut(t *testing.T, ps *PackStore, conf *cmn.PackConf, name string, data []byte) {
+	err := ps.Put(name, bytes.NewReader(data), int64(len(data)), []byte("md-"+name), 1, conf)
+	tassert.CheckFatal(t, err)
+}
+
+func TestPackStore(t *testing.T) {
+	var (
+		dir  = filepath.Join(t.TempDir(), "%"+PackType)
+		conf = &cmn.PackConf{ContainerSize: 64 * cos.KiB, CompactPct: 50}
+	)
+	ps, err := openPack(dir, true)
+	tassert.CheckFatal(t, err)
+
+	const num = 100
+	for i := 0; i < num; i++ {
+		packPut(t, ps, conf, fmt.Sprintf("a/%03d", i), packContent(i, 1000+i))
+	}
+	tassert.Errorf(t, len(ps.total) > 1, "expecting multiple containers, got %d", len(ps.total))
+
+	// overwrite and delete
+	packPut(t, ps, conf, "a/007", []byte("overwritten"))
+	found, err := ps.Del("a/008")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, found, "a/008 not found")
+	tassert.CheckFatal(t, ps.SetMD("a/009", []byte("updated"), 2))
+
+	check := func(ps *PackStore) {
+		tassert.Fatalf(t, ps.Len() == num-1, "expecting %d entries, got %d", num-1, ps.Len())
+		for i := 0; i < num; i++ {
+			name := fmt.Sprintf("a/%03d", i)
+			e, ok := ps.Get(name)
+			switch i {
+			case 7:
+				tassert.Errorf(t, string(packRead(t, ps, name)) == "overwritten", "%s: wrong content", name)
+			case 8:
+				tassert.Errorf(t, !ok, "%s: expecting deleted", name)
+			case 9:
+				tassert.Errorf(t, string(e.MD) == "updated" && e.Atime == 2, "%s: wrong md %q", name, e.MD)
+			default:
+				tassert.Errorf(t, ok && string(e.MD) == "md-"+name, "%s: wrong md %q", name, e.MD)
+				tassert.Errorf(t, bytes.Equal(packRead(t, ps, name), packContent(i, 1000+i)), "%s: wrong content", name)
+			}
+		}
+	}
+	check(ps)
+
+	// replay the journal
+	ps.close()
+	ps, err = openPack(dir, false)
+	tassert.CheckFatal(t, err)
+	check(ps)
+
+	// torn tail gets truncated
+	jfqn := filepath.Join(dir, packIndex)
+	finfo, err := os.Stat(jfqn)
+	tassert.CheckFatal(t, err)
+	packPut(t, ps, conf, "b", []byte("torn"))
+	ps.close()
+	tassert.CheckFatal(t, os.Truncate(jfqn, finfo.Size()+5))
+	ps, err = openPack(dir, false)
+	tassert.CheckFatal(t, err)
+	_, ok := ps.Get("b")
+	tassert.Errorf(t, !ok, "torn record must be ignored")
+	tassert.Errorf(t, ps.jsize == finfo.Size(), "expecting truncated journal (%d vs %d)", ps.jsize, finfo.Size())
+	check(ps)
+	ps.close()
+}
+
+func TestPackStoreCompact(t *testing.T) {
+	var (
+		dir  = filepath.Join(t.TempDir(), "%"+PackType)
+		conf = &cmn.PackConf{ContainerSize: 16 * cos.KiB, CompactPct: 50}
+	)
+	ps, err := openPack(dir, true)
+	tassert.CheckFatal(t, err)
+
+	const num = 64
+	for i := 0; i < num; i++ {
+		packPut(t, ps, conf, fmt.Sprintf("%03d", i), packContent(i, 1024))
+	}
+	numc := len(ps.total)
+
+	// keep every 4th
+	for i := 0; i < num; i++ {
+		if i%4 != 0 {
+			_, err := ps.Del(fmt.Sprintf("%03d", i))
+			tassert.CheckFatal(t, err)
+		}
+	}
+	// open reader must survive compaction
+	r, err := ps.Open("000")
+	tassert.CheckFatal(t, err)
+
+	n, err := ps.Compact(0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n > 0 && len(ps.total) < numc, "expecting fewer containers (%d, %d => %d)", n, numc, len(ps.total))
+
+	b, err := io.ReadAll(r)
+	tassert.CheckFatal(t, err)
+	r.Close()
+	tassert.Errorf(t, bytes.Equal(b, packContent(0, 1024)), "reader: wrong content after compaction")
+
+	check := func(ps *PackStore) {
+		tassert.Fatalf(t, ps.Len() == num/4, "expecting %d entries, got %d", num/4, ps.Len())
+		for i := 0; i < num; i += 4 {
+			name := fmt.Sprintf("%03d", i)
+			tassert.Errorf(t, bytes.Equal(packRead(t, ps, name), packContent(i, 1024)), "%s: wrong content", name)
+		}
+	}
+	check(ps)
+
+	// rewrite the journal and replay
+	ps.mu.Lock()
+	err = ps.rewrite()
+	ps.mu.Unlock()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ps.nrecs == num/4, "expecting %d records, got %d", num/4, ps.nrecs)
+	ps.close()
+	ps, err = openPack(dir, false)
+	tassert.CheckFatal(t, err)
+	check(ps)
+	ps.close()
+}
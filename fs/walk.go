@@ -43,6 +43,7 @@ type (
 		Dir      string
 		CTs      []string
 		Sorted   bool
+		noPack   bool // packed objects are delivered by the caller (see WalkBck)
 	}
 
 	errCallbackWrapper struct {
@@ -132,6 +133,9 @@ func Walk(opts *WalkOpts) error {
 	}
 	for _, fqn := range fqns {
 		err1 := godirwalk.Walk(fqn, gOpts)
+		if (err1 == nil || os.IsNotExist(err1)) && !opts.noPack {
+			err1 = walkPacked(fqn, opts.Callback)
+		}
 		if err1 == nil || os.IsNotExist(err1) {
 			continue
 		}
@@ -157,6 +161,24 @@ func Walk(opts *WalkOpts) error {
 	return err
 }
 
+// packed objects don't have their own files - deliver them to the callback
+// after walking the bucket's objects directory (see pack.go)
+func walkPacked(ctdir string, cb walkFunc) error {
+	if filepath.Base(ctdir) != string(prefCT)+ObjectType {
+		return nil
+	}
+	ps, err := packGet(filepath.Join(filepath.Dir(ctdir), string(prefCT)+PackType), false)
+	if ps == nil {
+		return err
+	}
+	for _, name := range ps.Names() {
+		if err := cb(filepath.Join(ctdir, name), packDE(false)); err != nil && err != filepath.SkipDir {
+			return err
+		}
+	}
+	return nil
+}
+
 func allMpathCTpaths(opts *WalkOpts) (fqns []string, err error) {
 	children, erc := mpathChildren(opts)
 	if erc != nil {
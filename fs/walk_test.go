@@ -5,11 +5,13 @@
 package fs_test
 
 import (
+	"fmt"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -193,3 +195,95 @@ func TestWalkBckSkipDir(t *testing.T) {
 	}
 	tassert.Fatalf(t, expectedTotal == len(fqns), "expected %d objects, got %d", expectedTotal, len(fqns))
 }
+
+func TestWalkBckPacked(t *testing.T) {
+	var (
+		bck  = cmn.Bck{Name: "packed", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		conf = &cmn.PackConf{ContainerSize: cos.MiB, CompactPct: 50}
+	)
+	fs.TestNew(mock.NewIOS())
+	fs.TestDisableValidation()
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+
+	var expected []string
+	for i := 0; i < 3; i++ {
+		mpath := t.TempDir()
+		mi, err := fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+
+		// regular files
+		for _, name := range []string{"b/x", "d"} {
+			name = fmt.Sprintf("%s-%d", name, i)
+			fqn := mi.MakePathFQN(&bck, fs.ObjectType, name)
+			tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fqn)))
+			tassert.CheckFatal(t, os.WriteFile(fqn, []byte(name), cos.PermRWR))
+			expected = append(expected, name)
+		}
+		// packed
+		ps, err := fs.PackGet(mi, &bck, true)
+		tassert.CheckFatal(t, err)
+		for _, name := range []string{"a", "b/y", "c/z"} {
+			name = fmt.Sprintf("%s-%d", name, i)
+			tassert.CheckFatal(t, ps.Put(name, strings.NewReader(name), int64(len(name)), nil, 0, conf))
+			expected = append(expected, name)
+		}
+	}
+	defer func() {
+		avail, _ := fs.Get()
+		for mpath := range avail {
+			fs.Remove(mpath)
+		}
+	}()
+
+	walk := func(validate func(string, fs.DirEntry) error) (objs []string) {
+		err := fs.WalkBck(&fs.WalkBckOpts{
+			WalkOpts: fs.WalkOpts{
+				Bck: bck,
+				CTs: []string{fs.ObjectType},
+				Callback: func(fqn string, de fs.DirEntry) error {
+					parsedFQN, err := fs.ParseFQN(fqn)
+					tassert.CheckError(t, err)
+					if de.IsDir() {
+						objs = append(objs, parsedFQN.ObjName+"/")
+					} else {
+						objs = append(objs, parsedFQN.ObjName)
+					}
+					return nil
+				},
+				Sorted: true,
+			},
+			ValidateCallback: validate,
+		})
+		tassert.CheckFatal(t, err)
+		return objs
+	}
+
+	// recursive
+	objs := walk(nil)
+	sort.Strings(expected)
+	tassert.Fatalf(t, sort.StringsAreSorted(objs), "expected the output to be sorted: %v", objs)
+	tassert.Fatalf(t, reflect.DeepEqual(objs, expected), "found %v, expected %v", objs, expected)
+
+	// skip "c" altogether; deliver "b" without its content
+	objs = walk(func(fqn string, de fs.DirEntry) error {
+		if !de.IsDir() {
+			return nil
+		}
+		parsedFQN, err := fs.ParseFQN(fqn)
+		if err != nil {
+			return nil
+		}
+		switch parsedFQN.ObjName {
+		case "b":
+			return fs.SkipDirContent
+		case "c":
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	for _, name := range objs {
+		tassert.Errorf(t, !strings.HasPrefix(name, "c") && (name == "b/" || !strings.HasPrefix(name, "b/")), "unexpected %q", name)
+	}
+	tassert.Errorf(t, cos.StringInSlice("b/", objs), "expecting virtual directory \"b/\" in %v", objs)
+	tassert.Errorf(t, cos.StringInSlice("a-0", objs) && cos.StringInSlice("d-2", objs), "missing objects in %v", objs)
+}
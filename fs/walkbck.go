@@ -7,8 +7,12 @@ package fs
 import (
 	"container/heap"
 	"context"
+	"errors"
+	"path/filepath"
+	"strings"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"golang.org/x/sync/errgroup"
 )
@@ -17,10 +21,16 @@ import (
 // for those (very few) clients that don't have their own custom implementation
 
 type WalkBckOpts struct {
-	ValidateCallback walkFunc // should return filepath.SkipDir to skip directory without an error
+	ValidateCallback walkFunc // should return filepath.SkipDir to skip directory without an error (see also SkipDirContent)
 	WalkOpts
 }
 
+// ValidateCallback may return SkipDirContent to skip directory's content while still delivering
+// the directory itself - in the same sorted order - to the Callback (used by non-recursive list-objects)
+var SkipDirContent = errors.New("skip directory content")
+
+const walkBckTag = "fs-walk-bck-mpath"
+
 // internals
 type (
 	joggerBck struct {
@@ -28,6 +38,8 @@ type (
 		mi       *Mountpath
 		validate walkFunc
 		ctx      context.Context
+		vdirs    map[string]error // packed objects: validated (virtual) directories
+		packed   []string         // sorted names of packed objects yet to be delivered
 		opts     WalkOpts
 	}
 	wbe struct { // walk bck entry
@@ -63,6 +75,7 @@ func WalkBck(opts *WalkBckOpts) error {
 		}
 		jg.opts.Callback = jg.cb
 		jg.opts.Mi = mi
+		jg.opts.noPack = true
 		joggers[idx] = jg
 		idx++
 	}
@@ -100,21 +113,34 @@ func WalkBck(opts *WalkBckOpts) error {
 ///////////////
 
 func (jg *joggerBck) walk() (err error) {
-	err = Walk(&jg.opts)
+	if cos.StringInSlice(ObjectType, jg.opts.CTs) {
+		var ps *PackStore
+		if ps, err = PackGet(jg.mi, &jg.opts.Bck, false); ps != nil {
+			jg.packed = ps.Names()
+		}
+	}
+	if err == nil {
+		err = Walk(&jg.opts)
+	}
+	if err == nil {
+		err = jg.pushPacked("")
+	}
 	close(jg.workCh)
 	return
 }
 
 func (jg *joggerBck) cb(fqn string, de DirEntry) error {
-	const tag = "fs-walk-bck-mpath"
 	select {
 	case <-jg.ctx.Done():
-		return cmn.NewErrAborted(jg.mi.String(), tag, nil)
+		return cmn.NewErrAborted(jg.mi.String(), walkBckTag, nil)
 	default:
 		break
 	}
 	if jg.validate != nil {
 		if err := jg.validate(fqn, de); err != nil {
+			if err == SkipDirContent && de.IsDir() {
+				return jg.push(fqn, de, filepath.SkipDir)
+			}
 			// If err != filepath.SkipDir, Walk will propagate the error
 			// to group.Go. Then context will be canceled, which terminates
 			// all other go routines running.
@@ -124,12 +150,85 @@ func (jg *joggerBck) cb(fqn string, de DirEntry) error {
 	if de.IsDir() {
 		return nil
 	}
+	return jg.push(fqn, de, nil)
+}
+
+func (jg *joggerBck) push(fqn string, de DirEntry, rerr error) error {
+	// merge packed objects, if any, to keep the output sorted
+	if len(jg.packed) > 0 {
+		if parsed, err := ParseFQN(fqn); err == nil {
+			if err := jg.pushPacked(parsed.ObjName); err != nil {
+				return err
+			}
+		}
+	}
+	return jg._push(fqn, de, rerr)
+}
+
+func (jg *joggerBck) _push(fqn string, de DirEntry, rerr error) error {
 	select {
 	case <-jg.ctx.Done():
-		return cmn.NewErrAborted(jg.mi.String(), tag, nil)
+		return cmn.NewErrAborted(jg.mi.String(), walkBckTag, nil)
 	case jg.workCh <- &wbe{de, fqn}:
-		return nil
+		return rerr
+	}
+}
+
+// deliver packed objects that sort before `upto` (all of them when `upto` is empty)
+func (jg *joggerBck) pushPacked(upto string) error {
+	for len(jg.packed) > 0 {
+		name := jg.packed[0]
+		if upto != "" && name >= upto {
+			break
+		}
+		jg.packed = jg.packed[1:]
+		if err := jg.pushPackedOne(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (jg *joggerBck) pushPackedOne(name string) error {
+	fqn := jg.mi.MakePathFQN(&jg.opts.Bck, ObjectType, name)
+	if jg.validate == nil {
+		return jg._push(fqn, packDE(false), nil)
+	}
+	// validate virtual parent directories, top to bottom
+	// (with the same semantics as the regular walk - see `cb` above)
+	if jg.vdirs == nil {
+		jg.vdirs = make(map[string]error, 16)
+	}
+	for i := strings.IndexByte(name, '/'); i > 0; {
+		dir := name[:i]
+		err, ok := jg.vdirs[dir]
+		if !ok {
+			err = jg.validate(jg.mi.MakePathFQN(&jg.opts.Bck, ObjectType, dir), packDE(true))
+			jg.vdirs[dir] = err
+			if err == SkipDirContent {
+				return jg._push(jg.mi.MakePathFQN(&jg.opts.Bck, ObjectType, dir), packDE(true), nil)
+			}
+		}
+		switch err {
+		case nil:
+		case filepath.SkipDir, SkipDirContent:
+			return nil
+		default:
+			return err
+		}
+		j := strings.IndexByte(name[i+1:], '/')
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+	if err := jg.validate(fqn, packDE(false)); err != nil {
+		if err == filepath.SkipDir {
+			return nil
+		}
+		return err
 	}
+	return jg._push(fqn, packDE(false), nil)
 }
 
 /////////////
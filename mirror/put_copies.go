@@ -89,6 +89,7 @@ func (p *putFactory) Start() error {
 	r.workers = mpather.NewWorkerGroup(&mpather.WorkerGroupOpts{
 		Callback:  r.do,
 		Slab:      slab,
+		Parent:    r,
 		QueueSize: mirror.Burst,
 	})
 	p.xctn = r
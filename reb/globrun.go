@@ -102,9 +102,10 @@ type (
 	}
 	rebJogger struct {
 		joggerBase
-		smap *meta.Smap
-		opts fs.WalkOpts
-		ver  int64
+		smap  *meta.Smap
+		parts meta.Nodes // partition => target (partition-map placement only)
+		opts  fs.WalkOpts
+		ver   int64
 	}
 	rebArgs struct {
 		smap   *meta.Smap
@@ -523,12 +524,24 @@ func (reb *Reb) runNoEC(rargs *rebArgs) error {
 		nlog.Errorln(logHdr, "rx-ready num-fail", errCnt) // unlikely
 	}
 
-	wg := &sync.WaitGroup{}
-	ver := rargs.smap.Version
+	var (
+		wg    = &sync.WaitGroup{}
+		ver   = rargs.smap.Version
+		parts = rargs.smap.PartTable() // (compute placement once)
+	)
+	if parts != nil {
+		var cnt int
+		for _, tsi := range parts {
+			if tsi.ID() == core.T.SID() {
+				cnt++
+			}
+		}
+		nlog.Infoln(reb.logHdr(rargs.id, rargs.smap), "partition map: owning", cnt, "of", len(parts), "partitions")
+	}
 	for _, mi := range rargs.apaths {
 		rl := &rebJogger{
 			joggerBase: joggerBase{m: reb, xreb: reb.xctn(), wg: wg},
-			smap:       rargs.smap, ver: ver,
+			smap:       rargs.smap, parts: parts, ver: ver,
 		}
 		wg.Add(1)
 		go rl.jog(mi)
@@ -742,6 +755,8 @@ func (rj *rebJogger) jog(mi *fs.Mountpath) {
 	// the jogger is running in separate goroutine, so use defer to be
 	// sure that `Done` is called even if the jogger crashes to avoid hang up
 	defer rj.wg.Done()
+	bg := fs.BgIOBegin()
+	defer fs.BgIOEnd(bg)
 	{
 		rj.opts.Mi = mi
 		rj.opts.CTs = []string{fs.ObjectType}
@@ -771,6 +786,7 @@ func (rj *rebJogger) objSentCallback(hdr *transport.ObjHdr, _ io.ReadCloser, arg
 	rj.m.inQueue.Dec()
 	if err == nil {
 		rj.xreb.OutObjsAdd(1, hdr.ObjAttrs.Size) // NOTE: double-counts retransmissions
+		rj.xreb.DiskReadAdd(hdr.ObjAttrs.Size)
 		return
 	}
 	// log err
@@ -794,7 +810,9 @@ func (rj *rebJogger) visitObj(fqn string, de fs.DirEntry) error {
 		return nil
 	}
 	lom := core.AllocLOM(fqn)
+	begin := core.CPUBegin()
 	err := rj._lwalk(lom, fqn)
+	core.CPUEnd(rj.xreb, begin)
 	if err != nil {
 		core.FreeLOM(lom)
 		if err == cmn.ErrSkip {
@@ -815,7 +833,7 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 	if lom.Bck().Props.EC.Enabled {
 		return filepath.SkipDir
 	}
-	tsi, err := rj.smap.HrwHash2T(lom.Digest())
+	tsi, err := rj.hrw(lom)
 	if err != nil {
 		return err
 	}
@@ -846,6 +864,16 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 	return nil
 }
 
+// (same as smap.HrwHash2T, with partition table lookup when available)
+// NOTE: partitions do not scope the walk - objects are not stored by partition,
+// and the joggers still visit all of them (objects that stay are skipped w/o loading)
+func (rj *rebJogger) hrw(lom *core.LOM) (*meta.Snode, error) {
+	if n := uint64(len(rj.parts)); n > 0 {
+		return rj.parts[lom.Digest()%n], nil
+	}
+	return rj.smap.HrwHash2T(lom.Digest())
+}
+
 // takes rlock and keeps it _iff_ successful
 func _getReader(lom *core.LOM) (roc cos.ReadOpenCloser, err error) {
 	lom.Lock(false)
@@ -53,6 +53,12 @@ type (
 			b fs.CapStatus // capacity after removing 'deleted'
 			c fs.CapStatus // upon finishing
 		}
+		// chunks referenced by deduplicated objects across all mountpaths (see core/dedup.go)
+		dedup struct {
+			refs map[string]cos.StrSet // bucket uname => referenced chunks
+			skip map[string]bool       // bucket uname => not removing unreferenced chunks
+			mu   sync.Mutex
+		}
 		jcnt atomic.Int32
 	}
 	// clnJ represents a single cleanup context and a single /jogger/
@@ -64,6 +70,11 @@ type (
 			loms []*core.LOM
 			ec   []*core.CT // EC slices and replicas without corresponding metafiles (CT FQN -> Meta FQN)
 		}
+		dedup struct {
+			refs cos.StrSet // chunks referenced by deduplicated objects (see core/dedup.go)
+			skip bool       // not removing unreferenced chunks (e.g., when failed to load some object)
+			bcks []cmn.Bck  // traversed buckets (that may contain dedup chunks)
+		}
 		bck cmn.Bck
 		now int64
 		// init-time
@@ -126,6 +137,7 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 		joggers        = make(map[string]*clnJ, num)
 		parent         = &clnP{joggers: joggers, ini: *ini}
 	)
+	parent.dedup.refs, parent.dedup.skip = make(map[string]cos.StrSet), make(map[string]bool)
 	defer func() {
 		if ini.WG != nil {
 			ini.WG.Done()
@@ -164,6 +176,14 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 	}
 	parent.wg.Wait()
 
+	// unreferenced dedup chunks - only after all mountpaths have been traversed
+	// (an object may reference chunks stored on a different mountpath)
+	for _, j := range joggers {
+		parent.wg.Add(1)
+		go j.rmChunks()
+	}
+	parent.wg.Wait()
+
 	for _, j := range joggers {
 		j.stop()
 	}
@@ -348,18 +368,66 @@ func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.VersionType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
 	j.now = time.Now().UnixNano()
+	j.dedup.refs, j.dedup.skip = make(cos.StrSet), false
 	if err = fs.Walk(opts); err != nil {
 		return
 	}
+	j.p.addRefs(&j.bck, j.dedup.refs, j.dedup.skip)
+	j.dedup.refs = nil
+	j.dedup.bcks = append(j.dedup.bcks, j.bck)
 	size, err = j.rmLeftovers()
 	return
 }
 
+func (p *clnP) addRefs(bck *cmn.Bck, refs cos.StrSet, skip bool) {
+	uname := bck.MakeUname("")
+	p.dedup.mu.Lock()
+	if skip {
+		p.dedup.skip[uname] = true
+	}
+	if all, ok := p.dedup.refs[uname]; ok {
+		for digest := range refs {
+			all.Set(digest)
+		}
+	} else {
+		p.dedup.refs[uname] = refs
+	}
+	p.dedup.mu.Unlock()
+}
+
+// remove this mountpath's dedup chunks that are not referenced by any object
+// on any mountpath (the references are read-only at this point)
+func (j *clnJ) rmChunks() {
+	var size int64
+	for i := range j.dedup.bcks {
+		uname := j.dedup.bcks[i].MakeUname("")
+		if j.p.dedup.skip[uname] {
+			continue
+		}
+		j.bck, j.dedup.refs = j.dedup.bcks[i], j.p.dedup.refs[uname]
+		opts := &fs.WalkOpts{Mi: j.mi, Bck: j.bck, CTs: []string{fs.DedupType}, Callback: j.walkDedup}
+		err := fs.Walk(opts)
+		if err == nil {
+			var sz int64
+			sz, err = j.rmLeftovers()
+			size += sz
+		}
+		if err != nil {
+			nlog.Errorln(j.String()+":", "failed to remove unreferenced dedup chunks:", err)
+			break
+		}
+	}
+	if size != 0 {
+		nlog.Infof("%s: removed unreferenced dedup chunks, size %s", j, cos.ToSizeIEC(size, 1))
+	}
+	j.p.wg.Done()
+}
+
 func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 	switch parsedFQN.ContentType {
 	case fs.WorkfileType:
@@ -411,6 +479,22 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 			return
 		}
 		j.oldWork = append(j.oldWork, fqn)
+	case fs.VersionType:
+		// previous object versions (see core/lver.go):
+		// remove when no longer retained or when the object itself is not stored on this mountpath
+		ct, err := core.NewCTFromFQN(fqn, core.T.Bowner())
+		if err != nil || ct.Bck().Props.Versioning.Retain == 0 {
+			j.oldWork = append(j.oldWork, fqn)
+			return
+		}
+		objName, _, ok := fs.CSM.Resolver(fs.VersionType).ParseUniqueFQN(ct.ObjectName())
+		if !ok {
+			j.oldWork = append(j.oldWork, fqn)
+			return
+		}
+		if cos.Stat(ct.Mountpath().MakePathFQN(ct.Bucket(), fs.ObjectType, objName)) != nil {
+			j.oldWork = append(j.oldWork, fqn)
+		}
 	default:
 		debug.Assertf(false, "Unsupported content type: %s", parsedFQN.ContentType)
 	}
@@ -424,6 +508,9 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	}
 	// handle load err
 	if errLoad := lom.Load(false /*cache it*/, false /*locked*/); errLoad != nil {
+		if !cos.IsNotExist(errLoad, 0) {
+			j.dedup.skip = true // (may be referencing dedup chunks)
+		}
 		_, atime, err := ios.FinfoAtime(lom.FQN)
 		if err != nil {
 			if !os.IsNotExist(err) {
@@ -454,6 +541,10 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		}
 		return
 	}
+	if err := lom.DedupRefs(j.dedup.refs); err != nil {
+		j.dedup.skip = true
+		j.ini.Xaction.AddErr(err, 4, cos.SmoduleSpace)
+	}
 	// too early
 	if lom.AtimeUnix()+int64(j.config.LRU.DontEvictTime) > j.now {
 		if cmn.Rom.FastV(5, cos.SmoduleSpace) {
@@ -525,6 +616,28 @@ func (j *clnJ) walk(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// dedup chunk that is not referenced by any object is removed
+// unless it's been recently written or touched (by in-flight PUT)
+func (j *clnJ) walkDedup(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	if err := j.yieldTerm(); err != nil {
+		return err
+	}
+	if _, ok := j.dedup.refs[core.DedupDigest(fqn)]; ok {
+		return nil
+	}
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return nil
+	}
+	if finfo.ModTime().UnixNano()+int64(j.config.LRU.DontEvictTime) < j.now {
+		j.oldWork = append(j.oldWork, fqn)
+	}
+	return nil
+}
+
 // TODO: remove disfunctional files as soon as possible without adding them to slices.
 func (j *clnJ) rmLeftovers() (size int64, err error) {
 	var (
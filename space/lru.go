@@ -9,6 +9,7 @@ package space
 import (
 	"container/heap"
 	"fmt"
+	"os"
 	"sort"
 	"sync"
 	"time"
@@ -87,6 +88,9 @@ type (
 		heap      *minHeap
 		bck       cmn.Bck
 		now       int64
+		ver       struct {
+			size, cnt, capCheck int64 // previous object versions evicted (see lru.version_evict_time)
+		}
 		// init-time
 		p       *lruP
 		ini     *IniLRU
@@ -297,14 +301,66 @@ func (j *lruJ) jogBck() (size int64, err error) {
 		Sorted:   false,
 	}
 	j.now = time.Now().UnixNano()
+
+	// previous versions go first (see core/lver.go)
+	if j.config.LRU.VersionEvictTime > 0 && j.bck.IsAIS() {
+		if size, err = j.evictVersions(); err != nil || j.totalSize < cos.KiB {
+			return
+		}
+	}
 	if err = fs.Walk(opts); err != nil {
 		return
 	}
 	// 3. evict
-	size, err = j.evict()
+	n, err := j.evict()
+	size += n
 	return
 }
 
+// remove previous object versions that are older than lru.version_evict_time
+func (j *lruJ) evictVersions() (size int64, err error) {
+	opts := &fs.WalkOpts{
+		Mi:       j.mi,
+		Bck:      j.bck,
+		CTs:      []string{fs.VersionType},
+		Callback: j.walkVer,
+		Sorted:   false,
+	}
+	j.ver.size, j.ver.cnt, j.ver.capCheck = 0, 0, 0
+	err = fs.Walk(opts)
+	size = j.ver.size
+	j.ini.StatsT.Add(stats.LruEvictSize, j.ver.size)
+	j.ini.StatsT.Add(stats.LruEvictCount, j.ver.cnt)
+	j.ini.Xaction.ObjsAdd(int(j.ver.cnt), j.ver.size)
+	return
+}
+
+func (j *lruJ) walkVer(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	finfo, err := os.Lstat(fqn)
+	if err != nil {
+		return nil
+	}
+	if finfo.ModTime().UnixNano()+int64(j.config.LRU.VersionEvictTime) > j.now {
+		return nil
+	}
+	if err := os.Remove(fqn); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorf("%s: failed to evict %q: %v", j, fqn, err)
+		}
+		return nil
+	}
+	j.ver.size += finfo.Size()
+	j.ver.cnt++
+	if cmn.Rom.FastV(5, cos.SmoduleSpace) {
+		nlog.Infof("%s: evicted previous version %q, size=%d", j, fqn, finfo.Size())
+	}
+	j.ver.capCheck, err = j.postRemove(j.ver.capCheck, finfo.Size())
+	return err
+}
+
 func (j *lruJ) visitLOM(parsedFQN *fs.ParsedFQN) {
 	if !j.allowDelObj {
 		return
@@ -328,6 +384,9 @@ func (j *lruJ) _visit(lom *core.LOM) (pushed bool) {
 	if lom.HasCopies() && lom.IsCopy() {
 		return
 	}
+	if lom.IsPinned() {
+		return
+	}
 	// do nothing if the heap's curSize >= totalSize and
 	// the file is more recent then the the heap's newest.
 	if j.curSize >= j.totalSize && lom.AtimeUnix() > j.newest {
@@ -5,10 +5,14 @@
 package space_test
 
 import (
+	"bytes"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -283,6 +287,81 @@ var _ = Describe("space evict/cleanup tests", func() {
 				Expect(len(files)).To(Equal(0))
 			})
 		})
+
+		Describe("cleanup dedup chunks", func() {
+			const anotherPath = basePath + "-another"
+			var ini *space.IniCln
+			BeforeEach(func() {
+				ini = newInitStoreCln()
+				cos.CreateDir(anotherPath)
+				fs.TestNew(mock.NewIOS())
+				fs.TestDisableValidation()
+				for _, mpath := range []string{basePath, anotherPath} {
+					_, err := fs.Add(mpath, "daeID")
+					Expect(err).NotTo(HaveOccurred())
+				}
+				fs.CSM.Reg(fs.DedupType, &fs.DedupContentResolver{}, true)
+			})
+			AfterEach(func() {
+				os.RemoveAll(anotherPath)
+			})
+			It("should keep chunks referenced from other mountpaths", func() {
+				var (
+					bck     = cmn.Bck{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					lom     = &core.LOM{ObjName: "dedup-obj"}
+					content = make([]byte, 100*cos.KiB)
+				)
+				Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+				_, _ = rand.Read(content)
+				workFQN := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+				_, err := cos.SaveReader(workFQN, bytes.NewReader(content), nil, cos.ChecksumNone, int64(len(content)))
+				Expect(err).NotTo(HaveOccurred())
+				lom.SetSize(int64(len(content)))
+				_, err = lom.Dedup(workFQN, &cmn.DedupConf{Chunking: apc.DedupFixed, ChunkSize: 64 * cos.KiB})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cos.Rename(workFQN, lom.FQN)).NotTo(HaveOccurred())
+				lom.SetAtimeUnix(time.Now().UnixNano())
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+
+				// relocate the chunks to the other mountpath and add an unreferenced one
+				var (
+					src   = lom.Mountpath().MakePathCT(&bck, fs.DedupType)
+					other = fs.GetAvail()[basePath]
+				)
+				if other.Path == lom.Mountpath().Path {
+					other = fs.GetAvail()[anotherPath]
+				}
+				dst := other.MakePathCT(&bck, fs.DedupType)
+				cos.CreateDir(path.Dir(dst))
+				Expect(os.Rename(src, dst)).NotTo(HaveOccurred())
+				unref := path.Join(dst, "ff", "ff"+strings.Repeat("0", 62))
+				cos.CreateDir(path.Dir(unref))
+				Expect(os.WriteFile(unref, []byte("unreferenced"), cos.PermRWR)).NotTo(HaveOccurred())
+				chunks := make([]string, 0, 2)
+				Expect(filepath.Walk(dst, func(p string, fi os.FileInfo, _ error) error {
+					if !fi.IsDir() && p != unref {
+						chunks = append(chunks, p)
+					}
+					return nil
+				})).NotTo(HaveOccurred())
+				Expect(chunks).To(HaveLen(2))
+
+				space.RunCleanup(ini)
+
+				Expect(unref).NotTo(BeAnExistingFile())
+				for _, chunk := range chunks {
+					Expect(chunk).To(BeAnExistingFile())
+				}
+				lom.Uncache()
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				r, err := lom.NewHandle()
+				Expect(err).NotTo(HaveOccurred())
+				b, err := io.ReadAll(r)
+				r.Close()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b).To(Equal(content))
+			})
+		})
 	})
 })
 
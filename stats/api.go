@@ -42,6 +42,7 @@ type (
 		GetStats() *Node
 		ResetStats(errorsOnly bool)
 		GetMetricNames() cos.StrKVs // (name, kind) pairs
+		GetSLO() []SLOStatus        // SLO monitors' state (see cmn.SLOConf)
 
 		RegMetrics(node *meta.Snode) // + init Prometheus, if configured
 	}
@@ -0,0 +1,239 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Bucket usage: per-bucket bytes served (GET), bytes ingested (PUT), and respective
+// request counts aggregated per calendar month (UTC) and persisted (in the node's
+// config directory) across restarts. Intended for chargeback/showback in shared clusters.
+//
+// The datapath only increments (atomic) in-memory counters; the stats runner periodically
+// folds them into the current period and (rate-limited) persists the result.
+// On a crash, up to `bckUsageSaveInterval` worth of accounting can be lost.
+
+const (
+	BckUsagePeriodFmt = "2006-01" // calendar month, e.g. "2024-06"
+
+	bckUsageMaxPeriods   = 24 // months
+	bckUsageSaveInterval = 5 * time.Minute
+)
+
+type (
+	BckUsage struct {
+		GetCount int64 `json:"get.n,string"`
+		GetBytes int64 `json:"get.size,string"`
+		PutCount int64 `json:"put.n,string"`
+		PutBytes int64 `json:"put.size,string"`
+	}
+	// period (BckUsagePeriodFmt) => bucket (cname) => usage
+	BckUsagePeriods map[string]map[string]*BckUsage
+
+	bckUsageKey struct {
+		ns       cmn.Ns
+		name     string
+		provider string
+	}
+	bckUsageCtr struct {
+		getCount atomic.Int64
+		getBytes atomic.Int64
+		putCount atomic.Int64
+		putBytes atomic.Int64
+	}
+	bckUsage struct {
+		Periods BckUsagePeriods `json:"periods"`
+		live    map[bckUsageKey]*bckUsageCtr
+		fpath   string
+		saved   int64 // mono time of the last save
+		lmu     sync.RWMutex
+		mu      sync.Mutex
+		dirty   bool
+	}
+)
+
+// interface guard
+var _ jsp.Opts = (*bckUsage)(nil)
+
+func (*bckUsage) JspOpts() jsp.Options { return jsp.CksumSign(cmn.MetaverBckUsage) }
+
+func (u *BckUsage) add(other *BckUsage) {
+	u.GetCount += other.GetCount
+	u.GetBytes += other.GetBytes
+	u.PutCount += other.PutCount
+	u.PutBytes += other.PutBytes
+}
+
+// merge (e.g., cluster-wide totals from per-target results)
+func (p BckUsagePeriods) Merge(other BckUsagePeriods) {
+	for period, bcks := range other {
+		dst, ok := p[period]
+		if !ok {
+			dst = make(map[string]*BckUsage, len(bcks))
+			p[period] = dst
+		}
+		for cname, u := range bcks {
+			if d, ok := dst[cname]; ok {
+				d.add(u)
+			} else {
+				c := *u
+				dst[cname] = &c
+			}
+		}
+	}
+}
+
+//////////////
+// bckUsage //
+//////////////
+
+func (bu *bckUsage) init(configDir string) {
+	bu.live = make(map[bckUsageKey]*bckUsageCtr, 16)
+	bu.fpath = filepath.Join(configDir, fname.BckUsage)
+	bu.saved = mono.NanoTime()
+	if _, err := jsp.LoadMeta(bu.fpath, bu); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			nlog.Errorln("failed to load bucket usage:", err, "- starting anew")
+		}
+		bu.Periods = nil
+	}
+	if bu.Periods == nil {
+		bu.Periods = make(BckUsagePeriods, 4)
+	}
+}
+
+func (bu *bckUsage) ctr(bck *cmn.Bck) *bckUsageCtr {
+	key := bckUsageKey{ns: bck.Ns, name: bck.Name, provider: bck.Provider}
+	bu.lmu.RLock()
+	c, ok := bu.live[key]
+	bu.lmu.RUnlock()
+	if ok {
+		return c
+	}
+	bu.lmu.Lock()
+	if c, ok = bu.live[key]; !ok {
+		c = &bckUsageCtr{}
+		bu.live[key] = c
+	}
+	bu.lmu.Unlock()
+	return c
+}
+
+func (bu *bckUsage) addGet(bck *cmn.Bck, size int64) {
+	c := bu.ctr(bck)
+	c.getCount.Inc()
+	c.getBytes.Add(size)
+}
+
+func (bu *bckUsage) addPut(bck *cmn.Bck, size int64) {
+	c := bu.ctr(bck)
+	c.putCount.Inc()
+	c.putBytes.Add(size)
+}
+
+// fold live counters into the current period; caller must hold `bu.mu`
+func (bu *bckUsage) _fold(now time.Time) {
+	var (
+		period = now.UTC().Format(BckUsagePeriodFmt)
+		bcks   map[string]*BckUsage
+	)
+	bu.lmu.RLock()
+	for key, c := range bu.live {
+		delta := BckUsage{
+			GetCount: c.getCount.Swap(0),
+			GetBytes: c.getBytes.Swap(0),
+			PutCount: c.putCount.Swap(0),
+			PutBytes: c.putBytes.Swap(0),
+		}
+		if delta == (BckUsage{}) {
+			continue
+		}
+		if bcks == nil {
+			if bcks = bu.Periods[period]; bcks == nil {
+				bcks = make(map[string]*BckUsage, len(bu.live))
+				bu.Periods[period] = bcks
+				bu._trim()
+			}
+		}
+		cname := (&cmn.Bck{Name: key.name, Provider: key.provider, Ns: key.ns}).Cname("")
+		if u, ok := bcks[cname]; ok {
+			u.add(&delta)
+		} else {
+			bcks[cname] = &delta
+		}
+		bu.dirty = true
+	}
+	bu.lmu.RUnlock()
+}
+
+// keep at most `bckUsageMaxPeriods` most recent periods
+func (bu *bckUsage) _trim() {
+	if len(bu.Periods) <= bckUsageMaxPeriods {
+		return
+	}
+	periods := make([]string, 0, len(bu.Periods))
+	for period := range bu.Periods {
+		periods = append(periods, period)
+	}
+	sort.Strings(periods)
+	for _, period := range periods[:len(periods)-bckUsageMaxPeriods] {
+		delete(bu.Periods, period)
+	}
+}
+
+// is called by the stats runner periodically and upon termination (force)
+func (bu *bckUsage) flush(force bool) {
+	var err error
+	if bu.fpath == "" {
+		return // not initialized
+	}
+	bu.mu.Lock()
+	bu._fold(time.Now())
+	if bu.dirty && (force || mono.Since(bu.saved) >= bckUsageSaveInterval) {
+		if err = jsp.SaveMeta(bu.fpath, bu, nil); err == nil {
+			bu.dirty = false
+		}
+		bu.saved = mono.NanoTime()
+	}
+	bu.mu.Unlock()
+	if err != nil {
+		nlog.Errorln("failed to persist bucket usage:", err)
+	}
+}
+
+// returns a copy of the specified period (empty string - all periods), including
+// the not-yet-folded counts
+func (bu *bckUsage) get(period string) BckUsagePeriods {
+	bu.mu.Lock()
+	bu._fold(time.Now())
+	out := make(BckUsagePeriods, len(bu.Periods))
+	for p, bcks := range bu.Periods {
+		if period != "" && p != period {
+			continue
+		}
+		dst := make(map[string]*BckUsage, len(bcks))
+		for cname, u := range bcks {
+			c := *u
+			dst[cname] = &c
+		}
+		out[p] = dst
+	}
+	bu.mu.Unlock()
+	return out
+}
@@ -0,0 +1,98 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func TestBckUsage(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		bu     = &bckUsage{}
+		bck1   = cmn.Bck{Name: "b1", Provider: apc.AIS}
+		bck2   = cmn.Bck{Name: "b2", Provider: apc.AWS}
+		period = time.Now().UTC().Format(BckUsagePeriodFmt)
+		wg     sync.WaitGroup
+	)
+	bu.init(dir)
+	if len(bu.get("")) != 0 {
+		t.Fatal("expected no usage")
+	}
+
+	// concurrent datapath
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				bu.addGet(&bck1, 10)
+				bu.addPut(&bck2, 1000)
+			}
+		}()
+	}
+	wg.Wait()
+	bu.addPut(&bck1, 5)
+
+	usage := bu.get(period)
+	u1, u2 := usage[period][bck1.Cname("")], usage[period][bck2.Cname("")]
+	if u1 == nil || u2 == nil {
+		t.Fatalf("missing bucket(s) in %+v", usage)
+	}
+	if *u1 != (BckUsage{GetCount: 800, GetBytes: 8000, PutCount: 1, PutBytes: 5}) {
+		t.Fatalf("%s: unexpected %+v", bck1.Cname(""), *u1)
+	}
+	if *u2 != (BckUsage{PutCount: 800, PutBytes: 800 * 1000}) {
+		t.Fatalf("%s: unexpected %+v", bck2.Cname(""), *u2)
+	}
+	if len(bu.get("1999-01")) != 0 {
+		t.Fatal("expected no usage for an unrelated period")
+	}
+
+	// persistence: forced flush and reload
+	bu.addGet(&bck2, 7)
+	bu.flush(true)
+	bu2 := &bckUsage{}
+	bu2.init(dir)
+	if u := bu2.get("")[period][bck2.Cname("")]; u == nil || u.GetCount != 1 || u.GetBytes != 7 || u.PutCount != 800 {
+		t.Fatalf("reloaded usage differs: %+v", u)
+	}
+
+	// merge (cluster-wide totals)
+	total := make(BckUsagePeriods)
+	total.Merge(bu.get(""))
+	total.Merge(bu2.get(""))
+	if u := total[period][bck1.Cname("")]; u.GetCount != 1600 {
+		t.Fatalf("expected merged GET count 1600, got %d", u.GetCount)
+	}
+	if u1.GetCount != 800 {
+		t.Fatal("merge must not modify its sources")
+	}
+}
+
+func TestBckUsageTrim(t *testing.T) {
+	bu := &bckUsage{Periods: make(BckUsagePeriods)}
+	for i := 0; i < bckUsageMaxPeriods+6; i++ {
+		p := fmt.Sprintf("%04d-%02d", 2000+i/12, i%12+1)
+		bu.Periods[p] = map[string]*BckUsage{"ais://b": {GetCount: 1}}
+	}
+	bu._trim()
+	if len(bu.Periods) != bckUsageMaxPeriods {
+		t.Fatalf("expected %d periods, got %d", bckUsageMaxPeriods, len(bu.Periods))
+	}
+	if _, ok := bu.Periods["2000-06"]; ok {
+		t.Fatal("expected the oldest periods to be removed")
+	}
+	if _, ok := bu.Periods["2002-06"]; !ok {
+		t.Fatal("expected the most recent period to be kept")
+	}
+}
@@ -0,0 +1,106 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Capacity history: periodic per-mountpath capacity snapshots kept in a fixed-size
+// ring buffer and persisted (in the node's config directory) across restarts.
+// Used by `ais show storage --history` to plot usage trends.
+
+const (
+	capHistInterval = time.Hour
+	capHistMaxSnaps = 24 * 31 // approx. one month at the interval above
+)
+
+type (
+	CapSnap struct {
+		Mountpaths map[string]fs.Capacity `json:"mpaths"`
+		Time       int64                  `json:"time,string"` // unix nano
+	}
+	// ring buffer: `Next` points to the oldest snapshot (zero until full)
+	capHist struct {
+		Snaps []CapSnap `json:"snaps"`
+		Next  int       `json:"next"`
+		fpath string
+		mu    sync.RWMutex
+	}
+)
+
+// interface guard
+var _ jsp.Opts = (*capHist)(nil)
+
+func (*capHist) JspOpts() jsp.Options { return jsp.CksumSign(cmn.MetaverCapHist) }
+
+func (h *capHist) init(configDir string) {
+	h.fpath = filepath.Join(configDir, fname.CapHistory)
+	h.Snaps = make([]CapSnap, 0, 64)
+	if _, err := jsp.LoadMeta(h.fpath, h); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			nlog.Errorln("failed to load capacity history:", err, "- starting anew")
+		}
+		h.Snaps, h.Next = h.Snaps[:0], 0
+		return
+	}
+	if l := len(h.Snaps); l > capHistMaxSnaps || h.Next < 0 || h.Next >= max(l, 1) || (l < capHistMaxSnaps && h.Next != 0) {
+		nlog.Errorln("invalid capacity history", h.fpath, "- starting anew")
+		h.Snaps, h.Next = h.Snaps[:0], 0
+	}
+}
+
+// is called by the stats runner upon (periodic) capacity refresh
+func (h *capHist) add(now int64, tcdf *fs.TargetCDF) {
+	h.mu.Lock()
+	if l := len(h.Snaps); l > 0 {
+		last := h.Snaps[(h.Next+l-1)%l]
+		if time.Duration(now-last.Time) < capHistInterval {
+			h.mu.Unlock()
+			return
+		}
+	}
+	snap := CapSnap{Time: now, Mountpaths: make(map[string]fs.Capacity, len(tcdf.Mountpaths))}
+	for mpath, cdf := range tcdf.Mountpaths {
+		snap.Mountpaths[mpath] = cdf.Capacity
+	}
+	if len(h.Snaps) < capHistMaxSnaps {
+		h.Snaps = append(h.Snaps, snap)
+	} else {
+		h.Snaps[h.Next] = snap
+		h.Next = (h.Next + 1) % capHistMaxSnaps
+	}
+	err := jsp.SaveMeta(h.fpath, h, nil)
+	h.mu.Unlock()
+	if err != nil {
+		nlog.Errorln("failed to persist capacity history:", err)
+	}
+}
+
+// returns snapshots taken at or after `since` (unix nano), oldest first
+func (h *capHist) get(since int64) []CapSnap {
+	h.mu.RLock()
+	l := len(h.Snaps)
+	out := make([]CapSnap, 0, l)
+	for i := 0; i < l; i++ {
+		snap := &h.Snaps[(h.Next+i)%l]
+		if snap.Time >= since {
+			out = append(out, *snap)
+		}
+	}
+	h.mu.RUnlock()
+	return out
+}
@@ -0,0 +1,64 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/fs"
+)
+
+func TestCapHistRing(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		h    = &capHist{}
+		tcdf = &fs.TargetCDF{Mountpaths: map[string]*fs.CDF{"/mp1": {}}}
+		ival = int64(capHistInterval)
+		base = time.Now().UnixNano()
+	)
+	h.init(dir)
+	if len(h.get(0)) != 0 {
+		t.Fatal("expected empty history")
+	}
+
+	// wrap around (and skip the ones that come too early)
+	total := capHistMaxSnaps + 10
+	for i := 0; i < total; i++ {
+		tcdf.Mountpaths["/mp1"].Capacity.PctUsed = int32(i % 100)
+		h.add(base+int64(i)*ival, tcdf)
+		h.add(base+int64(i)*ival+1, tcdf) // too soon - ignored
+	}
+	snaps := h.get(0)
+	if len(snaps) != capHistMaxSnaps {
+		t.Fatalf("expected %d snapshots, got %d", capHistMaxSnaps, len(snaps))
+	}
+	for i := 1; i < len(snaps); i++ {
+		if snaps[i].Time-snaps[i-1].Time != ival {
+			t.Fatalf("snapshots out of order at %d", i)
+		}
+	}
+	if oldest := base + int64(total-capHistMaxSnaps)*ival; snaps[0].Time != oldest {
+		t.Fatalf("expected the oldest at %d, got %d", oldest, snaps[0].Time)
+	}
+
+	// since
+	last := base + int64(total-1)*ival
+	if n := len(h.get(last - 2*ival)); n != 3 {
+		t.Fatalf("expected 3 most recent snapshots, got %d", n)
+	}
+
+	// persistence: reload
+	h2 := &capHist{}
+	h2.init(dir)
+	snaps2 := h2.get(0)
+	if len(snaps2) != len(snaps) || snaps2[0].Time != snaps[0].Time || snaps2[len(snaps2)-1].Time != last {
+		t.Fatalf("reloaded history differs: %d vs %d snapshots", len(snaps2), len(snaps))
+	}
+	if pct := snaps2[len(snaps2)-1].Mountpaths["/mp1"].PctUsed; pct != int32((total-1)%100) {
+		t.Fatalf("expected %d%%, got %d%%", (total-1)%100, pct)
+	}
+}
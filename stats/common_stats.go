@@ -66,15 +66,29 @@ const (
 	ListCount   = "lst.n"    // list-objects
 
 	// statically defined err counts (NOTE: update regCommon when adding/updating)
-	ErrHTTPWriteCount = errPrefix + "http.write.n"
-	ErrDownloadCount  = errPrefix + "dl.n"
-	ErrPutMirrorCount = errPrefix + "put.mirror.n"
+	ErrHTTPWriteCount        = errPrefix + "http.write.n"
+	ErrDownloadCount         = errPrefix + "dl.n"
+	ErrDownloadMismatchCount = errPrefix + "dl.mismatch.n" // size or checksum vs source-provided
+	ErrPutMirrorCount        = errPrefix + "put.mirror.n"
 
 	// KindLatency
 	GetLatency       = "get.ns"
 	ListLatency      = "lst.ns"
 	KeepAliveLatency = "kalive.ns"
 
+	// KindGauge (the value is set rather than added - see coreStats.update)
+	// sampled by the watchdog (see cmn.WatchdogConf)
+	GoroutinesGauge = "proc.goroutines"
+	OpenFDsGauge    = "proc.fds"
+	SocketsGauge    = "proc.sockets"
+
+	// intra-cluster clients' connection pools (see cmn.ConnStats):
+	// currently open connections (gauges) and established connections (counters)
+	CtrlConnsGauge = "http.ctrl.conns"
+	CtrlDialsCount = "http.ctrl.dials.n"
+	DataConnsGauge = "http.data.conns"
+	DataDialsCount = "http.data.dials.n"
+
 	// KindSpecial
 	Uptime = "up.ns.time"
 )
@@ -122,10 +136,11 @@ type (
 	coreStats struct {
 		Tracker   map[string]*statsValue
 		promDesc  promDesc
-		statsdC   *statsd.Client
+		statsdC   *statsd.Client // StatsD sink (nil when not configured)
 		sgl       *memsys.SGL
 		statsTime time.Duration
 		cmu       sync.RWMutex // ctracker vs Prometheus Collect()
+		prom      bool         // Prometheus sink (see runner.Collect)
 	}
 
 	// Prunner and Trunner
@@ -135,6 +150,7 @@ type (
 		ticker    *time.Ticker
 		core      *coreStats
 		ctracker  copyTracker // to avoid making it at runtime
+		slo       sloMonitors // see cmn.SLOConf
 		sorted    []string    // sorted names
 		name      string      // this stats-runner's name
 		prev      string      // prev ctracker.write
@@ -188,8 +204,9 @@ func (s *coreStats) init(size int) {
 	s.sgl = memsys.PageMM().NewSGL(memsys.PageSize)
 }
 
-// NOTE: nil StatsD client means that we provide metrics to Prometheus (see below)
-func (s *coreStats) isPrometheus() bool { return s.statsdC == nil }
+// metrics sinks (see cmn.MetricsConf): either one, or both
+func (s *coreStats) isPrometheus() bool { return s.prom }
+func (s *coreStats) isStatsD() bool     { return s.statsdC != nil }
 
 // vs Collect()
 func (s *coreStats) promRLock() {
@@ -216,16 +233,29 @@ func (s *coreStats) promUnlock() {
 	}
 }
 
-// init MetricClient client: StatsD (default) or Prometheus
-func (s *coreStats) initMetricClient(node *meta.Snode, parent *runner) {
-	// Either Prometheus
-	if prom := os.Getenv("AIS_PROMETHEUS"); prom != "" {
+// init metrics sinks: StatsD (default) and/or Prometheus, as per cmn.MetricsConf;
+// (legacy) AIS_PROMETHEUS environment takes precedence and selects Prometheus only
+func (s *coreStats) initMetricClient(node *meta.Snode, parent *runner, config *cmn.Config, more ...prometheus.Collector) {
+	useStatsD := config.Metrics.Has(cmn.MetricsStatsD)
+	if os.Getenv("AIS_PROMETHEUS") != "" {
+		s.prom, useStatsD = true, false
+	} else {
+		s.prom = config.Metrics.Has(cmn.MetricsProm)
+	}
+
+	// Prometheus
+	if s.prom {
 		nlog.Infoln("Using Prometheus")
 		prometheus.MustRegister(parent) // as prometheus.Collector
+		for _, c := range more {
+			prometheus.MustRegister(c)
+		}
+	}
+	if !useStatsD {
 		return
 	}
 
-	// or StatsD
+	// StatsD
 	var (
 		port  = 8125  // StatsD default port, see https://github.com/etsy/stats
 		probe = false // test-probe StatsD server at init time
@@ -328,12 +358,14 @@ func (s *coreStats) update(nv cos.NamedVal64) {
 	case KindThroughput:
 		ratomic.AddInt64(&v.Value, nv.Value)
 		ratomic.AddInt64(&v.cumulative, nv.Value)
+	case KindGauge:
+		ratomic.StoreInt64(&v.Value, nv.Value)
 	case KindCounter, KindSize:
 		ratomic.AddInt64(&v.Value, nv.Value)
 		// - non-empty suffix forces an immediate Tx with no aggregation (see below);
 		// - suffix is an arbitrary string that can be defined at runtime;
 		// - e.g. usage: per-mountpath error counters.
-		if !s.isPrometheus() && nv.NameSuffix != "" {
+		if s.isStatsD() && nv.NameSuffix != "" {
 			s.statsdC.Send(v.label.comm+"."+nv.NameSuffix,
 				1, metric{Type: statsd.Counter, Name: "count", Value: nv.Value})
 		}
@@ -342,7 +374,7 @@ func (s *coreStats) update(nv cos.NamedVal64) {
 	}
 }
 
-// log + StatsD (Prometheus is done separately via `Collect`)
+// log + StatsD, if configured (Prometheus is done separately via `Collect`)
 func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 	idle := true
 	intl := max(int64(s.statsTime.Seconds()), 1)
@@ -360,7 +392,7 @@ func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 			out[name] = copyValue{lat}
 			// NOTE: ns => ms, and not reporting zeros
 			millis := cos.DivRound(lat, int64(time.Millisecond))
-			if !s.isPrometheus() && millis > 0 {
+			if s.isStatsD() && millis > 0 {
 				s.statsdC.AppMetric(metric{Type: statsd.Timer, Name: v.label.stsd, Value: float64(millis)}, s.sgl)
 			}
 		case KindThroughput:
@@ -372,14 +404,14 @@ func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 				}
 			}
 			out[name] = copyValue{throughput}
-			if !s.isPrometheus() && throughput > 0 {
+			if s.isStatsD() && throughput > 0 {
 				fv := roundMBs(throughput)
 				s.statsdC.AppMetric(metric{Type: statsd.Gauge, Name: v.label.stsd, Value: fv}, s.sgl)
 			}
 		case KindComputedThroughput:
 			if throughput := ratomic.SwapInt64(&v.Value, 0); throughput > 0 {
 				out[name] = copyValue{throughput}
-				if !s.isPrometheus() {
+				if s.isStatsD() {
 					fv := roundMBs(throughput)
 					s.statsdC.AppMetric(metric{Type: statsd.Gauge, Name: v.label.stsd, Value: fv}, s.sgl)
 				}
@@ -399,7 +431,7 @@ func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 				}
 			}
 			// StatsD iff changed
-			if !s.isPrometheus() && changed {
+			if s.isStatsD() && changed {
 				if v.kind == KindCounter {
 					s.statsdC.AppMetric(metric{Type: statsd.Counter, Name: v.label.stsd, Value: val}, s.sgl)
 				} else {
@@ -415,7 +447,7 @@ func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 		case KindGauge:
 			val := ratomic.LoadInt64(&v.Value)
 			out[name] = copyValue{val}
-			if !s.isPrometheus() {
+			if s.isStatsD() {
 				s.statsdC.AppMetric(metric{Type: statsd.Gauge, Name: v.label.stsd, Value: float64(val)}, s.sgl)
 			}
 			if isDiskUtilMetric(name) && val > diskLowUtil[0] {
@@ -425,7 +457,7 @@ func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 			out[name] = copyValue{ratomic.LoadInt64(&v.Value)}
 		}
 	}
-	if !s.isPrometheus() {
+	if s.isStatsD() {
 		s.statsdC.SendSGL(s.sgl)
 	}
 	return idle
@@ -584,6 +616,8 @@ func (r *runner) GetStats() *Node {
 	return &Node{Tracker: ctracker}
 }
 
+func (r *runner) GetSLO() []SLOStatus { return r.slo.get() }
+
 func (r *runner) ResetStats(errorsOnly bool) {
 	r.core.reset(errorsOnly)
 }
@@ -617,6 +651,7 @@ func (r *runner) regCommon(node *meta.Snode) {
 	// more error counters
 	r.reg(node, ErrHTTPWriteCount, KindCounter)
 	r.reg(node, ErrDownloadCount, KindCounter)
+	r.reg(node, ErrDownloadMismatchCount, KindCounter)
 	r.reg(node, ErrPutMirrorCount, KindCounter)
 
 	// latency
@@ -624,6 +659,15 @@ func (r *runner) regCommon(node *meta.Snode) {
 	r.reg(node, ListLatency, KindLatency)
 	r.reg(node, KeepAliveLatency, KindLatency)
 
+	// watchdog
+	r.reg(node, GoroutinesGauge, KindGauge)
+	r.reg(node, OpenFDsGauge, KindGauge)
+	r.reg(node, SocketsGauge, KindGauge)
+	r.reg(node, CtrlConnsGauge, KindGauge)
+	r.reg(node, CtrlDialsCount, KindCounter)
+	r.reg(node, DataConnsGauge, KindGauge)
+	r.reg(node, DataDialsCount, KindCounter)
+
 	// special uptime
 	r.reg(node, Uptime, KindSpecial)
 }
@@ -889,7 +933,7 @@ func (r *runner) StartedUp() bool { return r.startedUp.Load() }
 func (r *runner) Stop(err error) {
 	nlog.Infof("Stopping %s, err: %v", r.Name(), err)
 	r.stopCh <- struct{}{}
-	if !r.IsPrometheus() {
+	if r.core.isStatsD() {
 		r.core.statsdC.Close()
 	}
 	close(r.stopCh)
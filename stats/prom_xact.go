@@ -0,0 +1,69 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus only: progress of the currently running xactions (target),
+// e.g. ais_target_xact_objs{kind="copy-bck",xid="...",bucket="ais://abc",node_id="..."}
+
+var xactPromLabels = []string{"kind", "xid", "bucket"}
+
+type xactCollector struct {
+	objs     *prometheus.Desc
+	bytes    *prometheus.Desc
+	outBytes *prometheus.Desc
+	inBytes  *prometheus.Desc
+}
+
+// interface guard
+var _ prometheus.Collector = (*xactCollector)(nil)
+
+func newXactCollector(node *meta.Snode) *xactCollector {
+	var (
+		id     = strings.ReplaceAll(node.ID(), ".", "_")
+		labels = prometheus.Labels{"node_id": id}
+		fqn    = func(name string) string { return prometheus.BuildFQName("ais", node.Type(), "xact_"+name) }
+	)
+	return &xactCollector{
+		objs:     prometheus.NewDesc(fqn("objs"), "running xaction: number of locally processed objects", xactPromLabels, labels),
+		bytes:    prometheus.NewDesc(fqn("bytes"), "running xaction: locally processed size (bytes)", xactPromLabels, labels),
+		outBytes: prometheus.NewDesc(fqn("out_bytes"), "running xaction: transmitted size (bytes)", xactPromLabels, labels),
+		inBytes:  prometheus.NewDesc(fqn("in_bytes"), "running xaction: received size (bytes)", xactPromLabels, labels),
+	}
+}
+
+func (c *xactCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.objs
+	ch <- c.bytes
+	ch <- c.outBytes
+	ch <- c.inBytes
+}
+
+func (c *xactCollector) Collect(ch chan<- prometheus.Metric) {
+	snaps, err := xreg.GetSnap(xreg.Flt{OnlyRunning: apc.Bool(true)})
+	if err != nil {
+		return
+	}
+	for _, snap := range snaps {
+		var bname string
+		if !snap.Bck.IsEmpty() {
+			bname = snap.Bck.Cname("")
+		}
+		vals := []string{snap.Kind, snap.ID, bname}
+		ch <- prometheus.MustNewConstMetric(c.objs, prometheus.GaugeValue, float64(snap.Stats.Objs), vals...)
+		ch <- prometheus.MustNewConstMetric(c.bytes, prometheus.GaugeValue, float64(snap.Stats.Bytes), vals...)
+		ch <- prometheus.MustNewConstMetric(c.outBytes, prometheus.GaugeValue, float64(snap.Stats.OutBytes), vals...)
+		ch <- prometheus.MustNewConstMetric(c.inBytes, prometheus.GaugeValue, float64(snap.Stats.InBytes), vals...)
+	}
+}
@@ -18,7 +18,14 @@ import (
 
 const numProxyStats = 24 // approx. initial
 
-// NOTE: currently, proxy's stats == common and hardcoded
+// NOTE: currently, proxy's stats == common and hardcoded, plus the following
+
+// client connections to the proxy's public endpoint (see cmn.ProxyConf.MaxConns)
+const (
+	ClientConnsGauge    = "http.client.conns"
+	ClientConnsRejected = "http.client.conns.rejected.n" // 503 with Retry-After
+	ClientConnsDrained  = "http.client.conns.drained.n"  // served and closed
+)
 
 type Prunner struct {
 	runner
@@ -33,7 +40,7 @@ var _ cos.Runner = (*Prunner)(nil)
 
 func (r *Prunner) Run() error { return r._run(r /*as statsLogger*/) }
 
-// have only common metrics - init only the Prometheus part if enabled
+// have only common (and client connection) metrics - init only the Prometheus part if enabled
 func (r *Prunner) RegMetrics(node *meta.Snode) {
 	r.core.initProm(node)
 }
@@ -45,16 +52,21 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	r.core.init(numProxyStats)
 
 	r.regCommon(p.Snode()) // common metrics
+	r.reg(p.Snode(), ClientConnsGauge, KindGauge)
+	r.reg(p.Snode(), ClientConnsRejected, KindCounter)
+	r.reg(p.Snode(), ClientConnsDrained, KindCounter)
 
-	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
+	config := cmn.GCO.Get()
+	r.core.statsTime = config.Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
 
 	r.runner.name = "proxystats"
 	r.runner.daemon = p
+	r.runner.slo.node = p.String()
 
 	r.runner.stopCh = make(chan struct{}, 4)
 
-	r.core.initMetricClient(p.Snode(), &r.runner)
+	r.core.initMetricClient(p.Snode(), &r.runner, config)
 
 	r.sorted = make([]string, 0, numProxyStats)
 	return &r.runner.startedUp
@@ -64,13 +76,15 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 // statsLogger interface impl
 //
 
-func (r *Prunner) log(now int64, uptime time.Duration, _ *cmn.Config) {
+func (r *Prunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 	s := r.core
 	s.updateUptime(uptime)
 	s.promLock()
 	idle := s.copyT(r.ctracker)
 	s.promUnlock()
 
+	r.slo.eval(config.SLO, r.ctracker)
+
 	if now >= r.next || !idle {
 		s.sgl.Reset() // sharing w/ CoreStats.copyT
 		r.ctracker.write(s.sgl, r.sorted, false /*target*/, idle)
@@ -0,0 +1,134 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// SLO monitors (see cmn.SLOConf): every `periodic.stats_time` interval the stats runner
+// evaluates configured thresholds against the interval's values:
+// - latency metrics (e.g. "get.ns"): average latency over the interval ("max_avg_latency")
+//   (note: the stats track no percentiles, and the monitors do not evaluate any);
+// - count metrics (e.g. "get.n"): percentage of errors ("err.get.n") over all requests in the interval.
+// Intervals with no requests are idle and do not change the monitor's state.
+// Transitions (ok => breached and back) are logged as warnings - there's no cluster event bus
+// to publish them (see also apc.WhatSLO), e.g.:
+// "slo: node=t[xyz] monitor=get-latency metric=get.ns stat=avg value=72ms threshold=50ms status=breached"
+
+type (
+	// REST API (apc.WhatSLO): one per configured monitor
+	SLOStatus struct {
+		Name       string `json:"name"`
+		Metric     string `json:"metric"`
+		Stat       string `json:"stat"`                // evaluated statistic: "avg" (latency) or "err_pct"
+		Threshold  string `json:"threshold"`           // e.g. "50ms" or "0.1%"
+		Current    string `json:"current"`             // the most recent non-idle value ("" if none yet)
+		Breached   bool   `json:"breached"`            // as of the most recent non-idle interval
+		Breaches   int64  `json:"breaches,string"`     // number of ok => breached transitions since node startup
+		LastBreach int64  `json:"last_breach,string"`  // unix nano
+		Updated    int64  `json:"updated_time,string"` // ditto, the most recent non-idle interval
+	}
+	sloState struct {
+		SLOStatus
+		prev struct{ n, errs int64 } // cumulative counts as of the previous interval
+	}
+	sloMonitors struct {
+		states map[string]*sloState
+		node   string // (for logging)
+		mu     sync.RWMutex
+	}
+)
+
+func errPctStr(pct float64) string { return strconv.FormatFloat(pct, 'f', 3, 64) + "%" }
+
+// is called by the stats runner (serially) upon copying the interval's stats
+func (slo *sloMonitors) eval(conf cmn.SLOConf, ctracker copyTracker) {
+	if len(conf) == 0 && len(slo.states) == 0 {
+		return
+	}
+	slo.mu.Lock()
+	if slo.states == nil {
+		slo.states = make(map[string]*sloState, len(conf))
+	}
+	for name := range slo.states {
+		if _, ok := conf[name]; !ok {
+			delete(slo.states, name) // removed from config
+		}
+	}
+	now := time.Now().UnixNano()
+	for name, m := range conf {
+		st, ok := slo.states[name]
+		if !ok || st.Metric != m.Metric {
+			st = &sloState{}
+			st.Name, st.Metric, st.Stat = name, m.Metric, m.Stat()
+			slo.states[name] = st
+			if m.MaxErrPct > 0 {
+				// baseline: evaluate from now on
+				st.prev.n, st.prev.errs = ctracker[m.Metric].Value, ctracker[errPrefix+m.Metric].Value
+				st.Threshold = m.Threshold()
+				continue
+			}
+		}
+		st.Threshold = m.Threshold()
+
+		var breached bool
+		if m.MaxAvgLatency > 0 {
+			lat := ctracker[m.Metric].Value
+			if lat == 0 {
+				continue // idle
+			}
+			breached = lat > int64(m.MaxAvgLatency)
+			st.Current = time.Duration(lat).Round(time.Microsecond).String()
+		} else {
+			n, errs := ctracker[m.Metric].Value, ctracker[errPrefix+m.Metric].Value
+			dn, derrs := n-st.prev.n, errs-st.prev.errs
+			st.prev.n, st.prev.errs = n, errs
+			if dn < 0 || derrs < 0 { // stats reset
+				continue
+			}
+			total := dn + derrs
+			if total == 0 {
+				continue // idle
+			}
+			pct := float64(derrs) * 100 / float64(total)
+			breached = pct > m.MaxErrPct
+			st.Current = errPctStr(pct)
+		}
+		st.Updated = now
+		if breached == st.Breached {
+			continue
+		}
+		st.Breached = breached
+		status := "ok"
+		if breached {
+			st.Breaches++
+			st.LastBreach = now
+			status = "breached"
+		}
+		nlog.Warningln("slo: node="+slo.node, "monitor="+name, "metric="+m.Metric, "stat="+st.Stat, "value="+st.Current,
+			"threshold="+st.Threshold, "status="+status)
+	}
+	slo.mu.Unlock()
+}
+
+// sorted by name
+func (slo *sloMonitors) get() []SLOStatus {
+	slo.mu.RLock()
+	out := make([]SLOStatus, 0, len(slo.states))
+	for _, st := range slo.states {
+		out = append(out, st.SLOStatus)
+	}
+	slo.mu.RUnlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,83 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestSLOLatency(t *testing.T) {
+	var (
+		slo  = &sloMonitors{node: "t[test]"}
+		conf = cmn.SLOConf{"get-latency": {Metric: GetLatency, MaxAvgLatency: cos.Duration(50 * time.Millisecond)}}
+		ct   = copyTracker{}
+	)
+	for i, lat := range []time.Duration{10 * time.Millisecond, 70 * time.Millisecond, 0 /*idle*/, 80 * time.Millisecond, time.Millisecond} {
+		ct[GetLatency] = copyValue{int64(lat)}
+		slo.eval(conf, ct)
+		st := slo.get()
+		if len(st) != 1 {
+			t.Fatalf("expected one monitor, got %+v", st)
+		}
+		var (
+			breached = i == 1 || i == 2 || i == 3
+			breaches = int64(0)
+		)
+		if i > 0 {
+			breaches = 1
+		}
+		if st[0].Breached != breached || st[0].Breaches != breaches {
+			t.Errorf("step %d (%v): expected breached=%t (%d), got %+v", i, lat, breached, breaches, st[0])
+		}
+		if st[0].Stat != "avg" {
+			t.Errorf("step %d: expected average latency, got %q", i, st[0].Stat)
+		}
+	}
+
+	// removed from config
+	slo.eval(cmn.SLOConf{}, ct)
+	if st := slo.get(); len(st) != 0 {
+		t.Errorf("expected no monitors, got %+v", st)
+	}
+}
+
+func TestSLOErrRate(t *testing.T) {
+	var (
+		slo  = &sloMonitors{node: "t[test]"}
+		conf = cmn.SLOConf{"get-errors": {Metric: GetCount, MaxErrPct: 1}}
+		ct   = copyTracker{GetCount: {100}, errPrefix + GetCount: {50}}
+	)
+	slo.eval(conf, ct) // baseline (not counting errors prior to the monitor's creation)
+	if st := slo.get(); st[0].Breached || st[0].Current != "" {
+		t.Fatalf("expected no evaluation, got %+v", st[0])
+	}
+	tests := []struct {
+		n, errs  int64
+		breached bool
+		current  string
+	}{
+		{1000, 51, false, "0.111%"},   // 1 of 901
+		{1000, 51, false, "0.111%"},   // idle
+		{1090, 61, true, "10.000%"},   // 10 of 100
+		{2090, 62, false, "0.100%"},   // 1 of 1001
+		{2090, 100, true, "100.000%"}, // errors only
+	}
+	for i, test := range tests {
+		ct[GetCount], ct[errPrefix+GetCount] = copyValue{test.n}, copyValue{test.errs}
+		slo.eval(conf, ct)
+		st := slo.get()[0]
+		if st.Breached != test.breached || st.Current != test.current {
+			t.Errorf("step %d: expected breached=%t, current=%s, got %+v", i, test.breached, test.current, st)
+		}
+	}
+	if st := slo.get()[0]; st.Breaches != 2 || st.LastBreach == 0 {
+		t.Errorf("expected 2 breaches, got %+v", st)
+	}
+}
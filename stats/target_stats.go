@@ -42,6 +42,15 @@ const (
 	GetColdCount = "get.cold.n"
 	GetColdSize  = "get.cold.size"
 
+	// cold GET admission control (see cmn.ColdGetConf)
+	GetColdQueuedCount = "get.cold.queued.n"
+
+	// memory-pressure aware shedding of low-priority work (see cmn.ShedConf)
+	ShedDelayedCount = "shed.delayed.n"
+	ShedListCount    = "shed.lst.n"
+	ShedGetColdCount = "shed.get.cold.n"
+	ShedScrubCount   = "shed.scrub.n"
+
 	LruEvictCount = "lru.evict.n"
 	LruEvictSize  = "lru.evict.size"
 
@@ -63,6 +72,11 @@ const (
 	ErrMetadataCount = "err.md.n"
 	ErrIOCount       = "err.io.n"
 
+	ErrGetColdRejectedCount = "err.get.cold.rejected.n"
+
+	// per-bucket rate limiting (see cmn.RateLimitConf)
+	ErrRateLimitCount = "err.ratelim.n"
+
 	// target restarted (effectively, boolean)
 	RestartCount = "restart.n"
 
@@ -111,6 +125,8 @@ type (
 	Trunner struct {
 		t         core.NodeMemCap
 		TargetCDF fs.TargetCDF `json:"cdf"`
+		caphist   capHist
+		bckusage  bckUsage
 		disk      ios.AllDiskStats
 		xln       string
 		runner    // the base (compare w/ Prunner)
@@ -155,10 +171,11 @@ func (r *Trunner) Init(t core.Target) *atomic.Bool {
 
 	r.runner.name = "targetstats"
 	r.runner.daemon = t
+	r.runner.slo.node = t.String()
 
 	r.runner.stopCh = make(chan struct{}, 4)
 
-	r.core.initMetricClient(t.Snode(), &r.runner)
+	r.core.initMetricClient(t.Snode(), &r.runner, config, newXactCollector(t.Snode()))
 
 	r.sorted = make([]string, 0, numTargetStats)
 
@@ -182,9 +199,26 @@ func (r *Trunner) InitCDF() error {
 	if errCap != nil {
 		nlog.Errorln(r.t.String()+":", errCap)
 	}
+	r.caphist.init(cmn.GCO.Get().ConfigDir)
+	r.bckusage.init(cmn.GCO.Get().ConfigDir)
 	return nil
 }
 
+func (r *Trunner) Stop(err error) {
+	r.bckusage.flush(true)
+	r.runner.Stop(err)
+}
+
+// capacity snapshots taken at or after `since` (unix nano)
+func (r *Trunner) CapHistory(since int64) []CapSnap { return r.caphist.get(since) }
+
+// per-bucket usage (chargeback): GET (bytes served) and PUT (bytes ingested)
+func (r *Trunner) AddBckGet(bck *cmn.Bck, size int64) { r.bckusage.addGet(bck, size) }
+func (r *Trunner) AddBckPut(bck *cmn.Bck, size int64) { r.bckusage.addPut(bck, size) }
+
+// per-bucket usage for a given period (BckUsagePeriodFmt; empty - all persisted periods)
+func (r *Trunner) BckUsage(period string) BckUsagePeriods { return r.bckusage.get(period) }
+
 func diskMetricName(disk, metric string) string {
 	return fmt.Sprintf("%s.%s.%s", diskMetricLabel, disk, metric)
 }
@@ -216,6 +250,14 @@ func extractPromDiskMetricName(name string) (diskName, metricName string) {
 func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(node, GetColdCount, KindCounter)
 	r.reg(node, GetColdSize, KindSize)
+	r.reg(node, GetColdQueuedCount, KindCounter)
+	r.reg(node, ErrGetColdRejectedCount, KindCounter)
+	r.reg(node, ErrRateLimitCount, KindCounter)
+
+	r.reg(node, ShedDelayedCount, KindCounter)
+	r.reg(node, ShedListCount, KindCounter)
+	r.reg(node, ShedGetColdCount, KindCounter)
+	r.reg(node, ShedScrubCount, KindCounter)
 
 	r.reg(node, LruEvictCount, KindCounter)
 	r.reg(node, LruEvictSize, KindSize)
@@ -321,6 +363,8 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 	idle := s.copyT(r.ctracker, config.Disk.DiskUtilLowWM)
 	s.promUnlock()
 
+	r.slo.eval(config.SLO, r.ctracker)
+
 	if now >= r.next || !idle {
 		s.sgl.Reset() // sharing w/ CoreStats.copyT
 		r.ctracker.write(s.sgl, r.sorted, true /*target*/, idle)
@@ -341,14 +385,22 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 		nlog.Errorln(err)
 	} else if errCap != nil {
 		r.t.OOS(&cs)
-	} else if updated && cs.PctMax > int32(config.Space.CleanupWM) {
+	} else if pct := cs.PctUsedMax(); updated && pct > int32(config.Space.CleanupWM) {
 		debug.Assert(!cs.IsOOS(), cs.String())
-		errCap = cmn.NewErrCapExceeded(cs.TotalUsed, cs.TotalAvail+cs.TotalUsed, 0, config.Space.CleanupWM, cs.PctMax, false)
+		errCap = cmn.NewErrCapExceeded(cs.TotalUsed, cs.TotalAvail+cs.TotalUsed, 0, config.Space.CleanupWM, pct, false,
+			cs.PctInodes > cs.PctMax /*inodes*/)
 		r.t.OOS(&cs)
 	}
+	if updated {
+		r.caphist.add(time.Now().UnixNano(), &r.TargetCDF) // wall clock (persistent)
+	}
+	r.bckusage.flush(false)
 	if (updated && now >= r.next) || errCap != nil {
 		for mpath, fsCapacity := range r.TargetCDF.Mountpaths {
 			s := fmt.Sprintf("%s: used %d%%", mpath, fsCapacity.Capacity.PctUsed)
+			if fsCapacity.Capacity.Inodes > 0 {
+				s += fmt.Sprintf(", inodes %d%%", fsCapacity.Capacity.PctInodes)
+			}
 			r.lines = append(r.lines, s)
 			if config.TestingEnv() {
 				// skipping likely identical
@@ -15,6 +15,10 @@ const (
 	hostProcessStatCPUPath = proc + "%d/stat"
 	// Memory usage by a process
 	hostProcessStatMemPath = proc + "%d/statm"
+	// open file descriptors of a process
+	hostProcessFDPath = proc + "%d/fd"
+	// cgroups of the current process
+	selfCgroupPath = proc + "self/cgroup"
 
 	// container stats
 
@@ -31,4 +35,16 @@ const (
 	contCPULimit = contCPUPath + "cpu.cfs_quota_us"
 	// length of a period (quota/period ~= max number of CPU available for cgroup)
 	contCPUPeriod = contCPUPath + "cpu.cfs_period_us"
+
+	// blkio (cgroup v1): proportional disk bandwidth
+	contBlkioPath = "/sys/fs/cgroup/blkio/"
+
+	// cgroup v2 (unified hierarchy)
+	contV2Path       = "/sys/fs/cgroup/"
+	contV2CPUMax     = contV2Path + "cpu.max" // "$MAX $PERIOD", where $MAX may be "max" (no limit)
+	contV2MemMax     = contV2Path + "memory.max"
+	contV2MemCurrent = contV2Path + "memory.current"
+	contV2MemStat    = contV2Path + "memory.stat"
+
+	cgroupNoLimit = "max"
 )
@@ -0,0 +1,13 @@
+// Package sys provides methods to read system information
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package sys
+
+import "errors"
+
+var errNoCgroups = errors.New("cgroups are not supported")
+
+func BlkioCgroup() (string, error)  { return "", errNoCgroups }
+func MkdirBlkio(string, int) error  { return errNoCgroups }
+func CgroupMoveThread(string) error { return errNoCgroups }
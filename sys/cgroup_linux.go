@@ -0,0 +1,66 @@
+// Package sys provides methods to read system information
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package sys
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"golang.org/x/sys/unix"
+)
+
+// NOTE: cgroup v1 only - in v2 the `io` controller is a domain controller that cannot
+// distinguish threads of the same process
+
+// BlkioCgroup returns the absolute path of the blkio cgroup the current process belongs to
+func BlkioCgroup() (dir string, err error) {
+	err = cos.ReadLines(selfCgroupPath, func(line string) error {
+		// e.g. "4:blkio:/user.slice"
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			return nil
+		}
+		for _, ctrl := range strings.Split(fields[1], ",") {
+			if ctrl == "blkio" {
+				dir = filepath.Join(contBlkioPath, fields[2])
+				return io.EOF
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if dir == "" {
+		return "", errors.New("blkio cgroup (v1) not found")
+	}
+	return dir, nil
+}
+
+// MkdirBlkio creates (if need be) blkio cgroup and sets its proportional weight
+func MkdirBlkio(dir string, weight int) error {
+	if err := os.Mkdir(dir, 0o755); err != nil && !os.IsExist(err) {
+		return err
+	}
+	w := []byte(strconv.Itoa(weight))
+	err := os.WriteFile(filepath.Join(dir, "blkio.weight"), w, 0)
+	if err != nil && os.IsNotExist(err) {
+		// BFQ I/O scheduler
+		err = os.WriteFile(filepath.Join(dir, "blkio.bfq.weight"), w, 0)
+	}
+	return err
+}
+
+// CgroupMoveThread moves the calling OS thread into a given (v1) cgroup;
+// the caller must be locked to its thread (runtime.LockOSThread)
+func CgroupMoveThread(dir string) error {
+	tid := []byte(strconv.Itoa(unix.Gettid()))
+	return os.WriteFile(filepath.Join(dir, "tasks"), tid, 0)
+}
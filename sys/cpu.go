@@ -19,6 +19,7 @@ type LoadAvg struct {
 
 var (
 	contCPUs      int
+	contMemLimit  uint64
 	containerized bool
 )
 
@@ -30,13 +31,18 @@ func init() {
 		} else {
 			nlog.Errorln(err)
 		}
+		contMemLimit = containerMemLimit()
 	}
 }
 
 func Containerized() bool { return containerized }
 func NumCPU() int         { return contCPUs }
 
+// cgroup memory limit; zero when not containerized or not limited
+func MemLimit() uint64 { return contMemLimit }
+
 // SetMaxProcs sets GOMAXPROCS = NumCPU unless already overridden via Go environment
+// (NumCPU, in turn, accounts for cgroup CPU quota, if any)
 func SetMaxProcs() {
 	if val, exists := os.LookupEnv(maxProcsEnvVar); exists {
 		nlog.Warningf("GOMAXPROCS is set via Go environment %q: %q", maxProcsEnvVar, val)
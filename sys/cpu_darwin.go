@@ -6,6 +6,7 @@ package sys
 
 import (
 	"errors"
+	"time"
 
 	"github.com/lufia/iostat"
 )
@@ -31,3 +32,6 @@ func LoadAverage() (avg LoadAvg, err error) {
 		Fifteen: loadAvg.Load15,
 	}, nil
 }
+
+// TODO: not implemented (no RUSAGE_THREAD)
+func ThreadCPU() time.Duration { return 0 }
@@ -6,13 +6,16 @@ package sys
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"golang.org/x/sys/unix"
 )
 
 // isContainerized returns true if the application is running
@@ -31,9 +34,26 @@ func isContainerized() (yes bool) {
 	if err != nil {
 		nlog.Errorf("Failed to read system info: %v", err)
 	}
+	if !yes {
+		// cgroup v2 with its own namespace: "/proc/1/cgroup" is simply "0::/"
+		yes = limitedV2()
+	}
 	return
 }
 
+// whether cgroup v2 limits CPU or memory
+func limitedV2() bool {
+	if line, err := cos.ReadOneLine(contV2CPUMax); err == nil {
+		if fields := strings.Fields(line); len(fields) > 0 && fields[0] != cgroupNoLimit {
+			return true
+		}
+	}
+	if line, err := cos.ReadOneLine(contV2MemMax); err == nil && strings.TrimSpace(line) != cgroupNoLimit {
+		return true
+	}
+	return false
+}
+
 // Returns an approximate number of CPUs allocated for the container.
 // By default, container runs without limits and its cfs_quota_us is
 // negative (-1). When a container starts with limited CPU usage its quota
@@ -44,6 +64,9 @@ func containerNumCPU() (int, error) {
 
 	quotaInt, err := cos.ReadOneInt64(contCPULimit)
 	if err != nil {
+		if nv2, errV2 := containerNumCPUv2(); errV2 == nil {
+			return nv2, nil
+		}
 		return 0, err
 	}
 	// negative quota means 'unlimited' - all hardware CPUs are used
@@ -64,6 +87,31 @@ func containerNumCPU() (int, error) {
 	return int(max(approx, 1)), nil
 }
 
+// same as above for cgroup v2 (see contV2CPUMax)
+func containerNumCPUv2() (int, error) {
+	line, err := cos.ReadOneLine(contV2CPUMax)
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(line)
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("failed to parse %s: %q", contV2CPUMax, line)
+	}
+	if fields[0] == cgroupNoLimit {
+		return runtime.NumCPU(), nil
+	}
+	quota, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	period, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil || period == 0 {
+		return 0, fmt.Errorf("failed to parse %s: %q", contV2CPUMax, line)
+	}
+	approx := (quota + period - 1) / period
+	return int(max(approx, 1)), nil
+}
+
 // LoadAverage returns the system load average
 func LoadAverage() (avg LoadAvg, err error) {
 	avg = LoadAvg{}
@@ -84,3 +132,12 @@ func LoadAverage() (avg LoadAvg, err error) {
 
 	return avg, err
 }
+
+// user + system CPU time consumed by the calling OS thread
+func ThreadCPU() time.Duration {
+	var ru unix.Rusage
+	if err := unix.Getrusage(unix.RUSAGE_THREAD, &ru); err != nil {
+		return 0
+	}
+	return time.Duration(ru.Utime.Nano() + ru.Stime.Nano())
+}
@@ -7,8 +7,16 @@ package sys
 import (
 	"fmt"
 	"math"
+	"os"
+	"runtime/debug"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+const (
+	memLimitEnvVar = "GOMEMLIMIT"
+	memLimitPct    = 90 // Go runtime soft memory limit, as a percentage of the cgroup limit
 )
 
 // Memory stats for the host OS or for container, depending on where the app is running.
@@ -45,3 +53,24 @@ func (mem *MemStat) String() string {
 	)
 	return fmt.Sprintf("used %s, free %s, buffcache %s, actfree %s", used, free, buffcache, actfree)
 }
+
+// SetMemLimit sets Go runtime soft memory limit (GOMEMLIMIT) to a fraction of the cgroup
+// memory limit, if any - unless already set via Go environment.
+// Returns the resulting limit (math.MaxInt64 means no limit).
+func SetMemLimit() int64 {
+	if val, exists := os.LookupEnv(memLimitEnvVar); exists {
+		nlog.Warningf("GOMEMLIMIT is set via Go environment %q: %q", memLimitEnvVar, val)
+		return debug.SetMemoryLimit(-1)
+	}
+	if contMemLimit == 0 {
+		return debug.SetMemoryLimit(-1)
+	}
+	limit := int64(contMemLimit / 100 * memLimitPct)
+	nlog.Infof("Setting GOMEMLIMIT to %s (%d%% of the container limit %s)", cos.ToSizeIEC(limit, 1), memLimitPct,
+		cos.ToSizeIEC(int64(contMemLimit), 1))
+	debug.SetMemoryLimit(limit)
+	return limit
+}
+
+// current Go runtime soft memory limit (see SetMemLimit)
+func GoMemLimit() int64 { return debug.SetMemoryLimit(-1) }
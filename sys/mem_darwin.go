@@ -100,3 +100,5 @@ func (mem *MemStat) host() error {
 }
 
 func (*MemStat) container() error { return errors.New("Darwin: cannot get container memory stats") }
+
+func containerMemLimit() uint64 { return 0 }
@@ -63,13 +63,13 @@ func (mem *MemStat) host() (err error) {
 	return
 }
 
-// parse `/sys/fs/cgroup/memory/memory.stat` lines
+// parse `/sys/fs/cgroup/memory/memory.stat` (v1) or `/sys/fs/cgroup/memory.stat` (v2) lines
 func (mem *MemStat) cgroupParse(line string) error {
 	fields := strings.Fields(line)
 	if len(fields) < 2 {
 		return nil
 	}
-	if fields[0] != "total_cache" {
+	if fields[0] != "total_cache" && fields[0] != "file" {
 		return nil
 	}
 	val, err := strconv.ParseUint(fields[1], 10, 64)
@@ -80,24 +80,50 @@ func (mem *MemStat) cgroupParse(line string) error {
 	return nil
 }
 
-// Returns host stats if memory is not limited via cgroups "memory.limit_in_bytes".
+// returns cgroup memory limit (zero when not limited) and the corresponding
+// usage and stats paths - cgroup v1 or v2, whichever is present
+func cgroupMem() (memLimit uint64, usedPath, statPath string) {
+	var err error
+	if memLimit, err = cos.ReadOneUint64(contMemLimitPath); err == nil {
+		// It is safe to assume that the value greater than MaxInt64/2 indicates "no limit"
+		// https://unix.stackexchange.com/questions/420906/what-is-the-value-for-the-cgroups-limit-in-bytes-if-the-memory-is-not-restricte
+		if memLimit > math.MaxInt64/2 {
+			return 0, "", ""
+		}
+		return memLimit, contMemUsedPath, contMemStatPath
+	}
+	line, err := cos.ReadOneLine(contV2MemMax)
+	if err != nil {
+		return 0, "", ""
+	}
+	if line = strings.TrimSpace(line); line == cgroupNoLimit {
+		return 0, "", ""
+	}
+	if memLimit, err = strconv.ParseUint(line, 10, 64); err != nil {
+		return 0, "", ""
+	}
+	return memLimit, contV2MemCurrent, contV2MemStat
+}
+
+func containerMemLimit() uint64 {
+	memLimit, _, _ := cgroupMem()
+	return memLimit
+}
+
+// Returns host stats if memory is not limited via cgroups
+// ("memory.limit_in_bytes" or, in v2, "memory.max").
 func (mem *MemStat) container() error {
 	if err := mem.host(); err != nil {
 		return err
 	}
-	memLimit, err := cos.ReadOneUint64(contMemLimitPath)
-	if err != nil {
-		return nil
-	}
-	// It is safe to assume that the value greater than MaxInt64/2 indicates "no limit"
-	// https://unix.stackexchange.com/questions/420906/what-is-the-value-for-the-cgroups-limit-in-bytes-if-the-memory-is-not-restricte
-	if memLimit > math.MaxInt64/2 {
+	memLimit, usedPath, statPath := cgroupMem()
+	if memLimit == 0 {
 		return nil
 	}
 
 	// this one is an approximate value that includes buff/cache
 	// (ie., kernel buffers and page caches that can be reclaimed)
-	memUsed, err := cos.ReadOneUint64(contMemUsedPath)
+	memUsed, err := cos.ReadOneUint64(usedPath)
 	if err != nil {
 		return nil
 	}
@@ -106,7 +132,7 @@ func (mem *MemStat) container() error {
 	mem.Free = mem.Total - mem.Used
 
 	// calculate memory used for buffcache
-	err = cos.ReadLines(contMemStatPath, mem.cgroupParse)
+	err = cos.ReadLines(statPath, mem.cgroupParse)
 	if err != nil {
 		debug.AssertNoErr(err)
 		// NOTE: returning host memory
@@ -23,6 +23,13 @@ type (
 		CPU ProcCPUStats
 		Mem ProcMemStats
 	}
+
+	// open file descriptors (including sockets) vs the process (soft) limit
+	ProcFDStats struct {
+		Open    int
+		Sockets int
+		Limit   uint64
+	}
 )
 
 func ProcessStats(pid int) (ProcStats, error) {
@@ -42,3 +49,5 @@ func ProcessStats(pid int) (ProcStats, error) {
 
 	return stats, nil
 }
+
+func ProcessFDs(pid int) (ProcFDStats, error) { return procFDs(pid) }
@@ -13,3 +13,8 @@ func procMem(_ int) (ProcMemStats, error) {
 func procCPU(_ int) (ProcCPUStats, error) {
 	return ProcCPUStats{}, nil
 }
+
+// TODO: not implemented
+func procFDs(_ int) (ProcFDStats, error) {
+	return ProcFDStats{}, nil
+}
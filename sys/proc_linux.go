@@ -6,8 +6,11 @@ package sys
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
@@ -69,3 +72,26 @@ func procCPU(pid int) (ProcCPUStats, error) {
 
 	return cpu, nil
 }
+
+// NOTE: the limit is this process's (soft) RLIMIT_NOFILE
+func procFDs(pid int) (ProcFDStats, error) {
+	fds := ProcFDStats{}
+
+	dir := fmt.Sprintf(hostProcessFDPath, pid)
+	dentries, err := os.ReadDir(dir)
+	if err != nil {
+		return fds, err
+	}
+	fds.Open = len(dentries)
+	for _, dent := range dentries {
+		// (the descriptor may have been closed in the meantime)
+		if link, err := os.Readlink(filepath.Join(dir, dent.Name())); err == nil && strings.HasPrefix(link, "socket:") {
+			fds.Sockets++
+		}
+	}
+	var rlim syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlim); err == nil {
+		fds.Limit = rlim.Cur
+	}
+	return fds, nil
+}
@@ -8,6 +8,7 @@ package sys_test
 // Use t.Logf or t.Errorf instead of tlog.Logf
 import (
 	"math"
+	"net"
 	"os"
 	"runtime"
 	"testing"
@@ -114,3 +115,37 @@ func TestProc(t *testing.T) {
 	tassert.Errorf(t, newStats.CPU.Percent > 0.0, "Process must use some CPU. Usage: %g", stats.CPU.Percent)
 	t.Logf("Process CPU usage: %6.2f%%", newStats.CPU.Percent)
 }
+
+func TestProcFDs(t *testing.T) {
+	checkSkipOS(t, "darwin")
+
+	pid := os.Getpid()
+	before, err := sys.ProcessFDs(pid)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, before.Open > 0 && before.Limit > 0, "unexpected %+v", before)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	tassert.CheckFatal(t, err)
+	defer ln.Close()
+	after, err := sys.ProcessFDs(pid)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, after.Open > before.Open && after.Sockets > before.Sockets,
+		"expected the listener to show up: %+v vs %+v", before, after)
+}
+
+func TestThreadCPU(t *testing.T) {
+	checkSkipOS(t, "darwin")
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var (
+		begin = sys.ThreadCPU()
+		x     = 1.0
+	)
+	for i := 0; i < 20_000_000; i++ {
+		x = math.Sqrt(x + float64(i))
+	}
+	spent := sys.ThreadCPU() - begin
+	tassert.Errorf(t, spent > 0 && x > 0, "expected non-zero thread CPU time, got %v", spent)
+	tassert.Errorf(t, spent < time.Minute, "unexpected thread CPU time %v", spent)
+}
@@ -151,6 +151,9 @@ func PrepareObjects(t *testing.T, desc ObjectsDesc) *ObjectsOut {
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.ECSliceType, &fs.ECSliceContentResolver{}, true)
 	fs.CSM.Reg(fs.ECMetaType, &fs.ECMetaContentResolver{}, true)
+	fs.CSM.Reg(fs.DedupType, &fs.DedupContentResolver{}, true)
+	fs.CSM.Reg(fs.PackType, &fs.PackContentResolver{}, true)
+	fs.CSM.Reg(fs.VersionType, &fs.VersionContentResolver{}, true)
 
 	dir := t.TempDir()
 
@@ -119,7 +119,7 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 	}
 	debug.Assert(s.usePDU() == extra.UsePDU())
 
-	chsize := burst(extra.Config)      // num objects the caller can post without blocking
+	chsize := Burst(extra.Config)      // num objects the caller can post without blocking
 	s.workCh = make(chan *Obj, chsize) // Send Qeueue (SQ)
 	s.cmplCh = make(chan cmpl, chsize) // Send Completion Queue (SCQ)
 
@@ -15,11 +15,14 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/sys"
 )
 
 // transport defaults
 const (
 	dfltBurstNum     = 128 // burst size (see: config.Transport.Burst)
+	dfltBurstMin     = 32  // ditto, when auto-tuned for a small number of CPUs (see Burst)
+	burstPerCPU      = 16
 	dfltTick         = time.Second
 	dfltTickIdle     = dfltTick << 8   // (when there are no streams to _collect_)
 	dfltIdleTeardown = 4 * time.Second // (see config.Transport.IdleTeardown)
@@ -64,9 +67,11 @@ func Init(tstats cos.StatsUpdater, config *cmn.Config) *StreamCollector {
 	return sc
 }
 
-func burst(config *cmn.Config) (burst int) {
+// Burst returns the number of objects the caller can post without blocking:
+// configured, or environment-overridden, or else auto-tuned for the (cgroup-limited) number of CPUs
+func Burst(config *cmn.Config) (burst int) {
 	if burst = config.Transport.Burst; burst == 0 {
-		burst = dfltBurstNum
+		burst = min(max(sys.NumCPU()*burstPerCPU, dfltBurstMin), dfltBurstNum)
 	}
 	if a := os.Getenv("AIS_STREAM_BURST_NUM"); a != "" {
 		if burst64, err := strconv.ParseInt(a, 10, 0); err != nil {
@@ -77,6 +77,23 @@ type (
 
 	// primarily: `api.QueryXactionSnaps`
 	MultiSnap map[string][]*core.Snap // by target ID (tid)
+
+	// pushed over WebSocket at a given interval (see `api.WatchXaction`)
+	WatchMsg struct {
+		Snaps MultiSnap `json:"snaps"`         // selected xactions, by target ID
+		Err   string    `json:"err,omitempty"` // (terminates the watch)
+		Time  int64     `json:"time"`          // unix nano
+		Fin   bool      `json:"fin,omitempty"` // all selected xactions have finished (the last message)
+	}
+)
+
+// xaction watch: interval between stats updates
+const (
+	DfltWatchIval = time.Second
+	MinWatchIval  = 100 * time.Millisecond
+	MaxWatchIval  = time.Minute
+
+	MaxWatchIDs = 64
 )
 
 type (
@@ -141,6 +158,10 @@ var Table = map[string]Descriptor{
 	apc.ActECRespond: {Scope: ScopeB, Startable: false, Idles: true},
 	apc.ActPutCopies: {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
 
+	// on-demand async replication to remote ais cluster (triggered by PUT and DELETE);
+	// when started explicitly - resync (reconcile) the destination
+	apc.ActReplicate: {Scope: ScopeB, Startable: true, Idles: true, ExtendedStats: true},
+
 	//
 	// on-demand multi-object (consider setting ConflictRebRes = true)
 	//
@@ -208,6 +229,26 @@ var Table = map[string]Descriptor{
 		Startable:   true,
 		RefreshCap:  true,
 	},
+	apc.ActInitShard: {
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      false,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+	},
+	apc.ActCheckOOB: {
+		Scope:      ScopeB,
+		Access:     apc.AccessRO,
+		Startable:  false,
+		RefreshCap: true,
+	},
+	apc.ActRenamePrefix: {
+		Scope:          ScopeB,
+		Access:         apc.AceObjMOVE,
+		Startable:      false,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+	},
 
 	// entire bucket (storage svcs)
 	apc.ActECEncode: {
@@ -507,6 +548,31 @@ func (xs MultiSnap) _get(xid string) (aborted, running, notstarted bool) {
 	return
 }
 
+// (all targets) given xaction or, if `xid` is empty, all xactions in the snapshot:
+// - found: present on at least one target;
+// - finished: found and not running on any target;
+// - aborted: the first aborted (target's) snap, if any
+func (xs MultiSnap) IsFinished(xid string) (found, finished bool, aborted *core.Snap) {
+	var running bool
+	for _, snaps := range xs {
+		for _, xsnap := range snaps {
+			if xid != "" && xid != xsnap.ID {
+				continue
+			}
+			found = true
+			switch {
+			case xsnap.IsAborted():
+				if aborted == nil {
+					aborted = xsnap
+				}
+			case !xsnap.Finished():
+				running = true
+			}
+		}
+	}
+	return found, found && !running, aborted
+}
+
 func (xs MultiSnap) ObjCounts(xid string) (locObjs, outObjs, inObjs int64) {
 	if xid == "" {
 		uuids := xs.GetUUIDs()
@@ -33,6 +33,7 @@ type (
 		_nam   string
 		sutime atomic.Int64
 		eutime atomic.Int64
+		reqID  ratomic.Pointer[string] // (see SetReqID)
 		abort  struct {
 			ch   chan error
 			err  ratomic.Pointer[error]
@@ -45,6 +46,9 @@ type (
 			outbytes atomic.Int64
 			inobjs   atomic.Int64 // receive
 			inbytes  atomic.Int64
+			cpu      atomic.Int64 // resource usage (core.ResAcct)
+			dread    atomic.Int64
+			dwrite   atomic.Int64
 		}
 		err cos.Errs
 	}
@@ -300,6 +304,17 @@ func (xctn *Base) onFinished(err error, aborted bool) {
 	IncFinished() // in re: HK cleanup long-time finished
 }
 
+// ID of the client request that started this xaction, if known (see cos.HdrReqID);
+// set upon (txn) commit and included in notifications and logs
+func (xctn *Base) SetReqID(id string) { xctn.reqID.Store(&id) }
+
+func (xctn *Base) ReqID() string {
+	if p := xctn.reqID.Load(); p != nil {
+		return *p
+	}
+	return ""
+}
+
 func (xctn *Base) AddNotif(n core.Notif) {
 	xctn.notif = n.(*NotifXact)
 	debug.Assert(xctn.notif.Xact != nil && xctn.notif.F != nil)     // always fin-notif and points to self
@@ -333,14 +348,18 @@ func (xctn *Base) Finish() {
 	}
 	xctn.onFinished(err, aborted)
 	// log
+	s := xctn.String()
+	if id := xctn.ReqID(); id != "" {
+		s += " [reqid=" + id + "]"
+	}
 	switch {
 	case xctn.Kind() == apc.ActList:
 	case err == nil:
-		nlog.Infoln(xctn.String(), "finished")
+		nlog.Infoln(s, "finished")
 	case aborted:
-		nlog.Warningln(xctn.String(), "aborted:", err.Error(), info)
+		nlog.Warningln(s, "aborted:", err.Error(), info)
 	default:
-		nlog.Infoln("Warning:", xctn.String(), "finished w/err:", err.Error())
+		nlog.Infoln("Warning:", s, "finished w/err:", err.Error())
 	}
 }
 
@@ -377,6 +396,11 @@ func (xctn *Base) InObjsAdd(cnt int, size int64) {
 	xctn.stats.inbytes.Add(size)
 }
 
+// base stats: resource usage (see core.ResAcct, core.CPUBegin)
+func (xctn *Base) CPUAdd(d time.Duration) { xctn.stats.cpu.Add(int64(d)) }
+func (xctn *Base) DiskReadAdd(n int64)    { xctn.stats.dread.Add(n) }
+func (xctn *Base) DiskWriteAdd(n int64)   { xctn.stats.dwrite.Add(n) }
+
 // provided for external use to fill-in xaction-specific `SnapExt` part
 func (xctn *Base) ToSnap(snap *core.Snap) {
 	snap.ID = xctn.ID()
@@ -403,6 +427,9 @@ func (xctn *Base) ToStats(stats *core.Stats) {
 	stats.OutBytes = xctn.OutBytes() //
 	stats.InObjs = xctn.InObjs()     // receive
 	stats.InBytes = xctn.InBytes()
+	stats.CPU = xctn.stats.cpu.Load() // resource usage
+	stats.DiskRead = xctn.stats.dread.Load()
+	stats.DiskWrite = xctn.stats.dwrite.Load()
 }
 
 // RebID helpers
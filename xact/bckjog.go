@@ -18,6 +18,9 @@ type BckJog struct {
 
 func (r *BckJog) Init(id, kind string, bck *meta.Bck, opts *mpather.JgroupOpts, config *cmn.Config) {
 	r.InitBase(id, kind, bck)
+	if opts.Parent == nil {
+		opts.Parent = &r.Base
+	}
 	r.joggers = mpather.NewJoggerGroup(opts, config, "")
 	r.Config = config
 }
@@ -80,5 +80,6 @@ func (nx *NotifXact) ToNotifMsg(aborted bool) core.NotifMsg {
 		Kind:     nx.Xact.Kind(),
 		Data:     cos.MustMarshal(nx.Xact.Snap()),
 		AbortedX: aborted,
+		ReqID:    nx.Xact.ReqID(),
 	}
 }
@@ -114,6 +114,10 @@ func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
 
+func RenewReplicate(bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActReplicate, bck, Args{})
+}
+
 func RenewTCB(uuid, kind string, custom *TCBArgs) RenewRes {
 	return RenewBucketXact(
 		kind,
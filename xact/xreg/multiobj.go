@@ -21,6 +21,18 @@ func RenewPrefetch(uuid string, bck *meta.Bck, msg *apc.PrefetchMsg) RenewRes {
 	return RenewBucketXact(apc.ActPrefetchObjects, bck, Args{UUID: uuid, Custom: msg})
 }
 
+func RenewInitShard(uuid string, bck *meta.Bck, msg *apc.InitShardMsg) RenewRes {
+	return RenewBucketXact(apc.ActInitShard, bck, Args{UUID: uuid, Custom: msg})
+}
+
+func RenewCheckOOB(uuid string, bck *meta.Bck, msg *apc.CheckOOBMsg) RenewRes {
+	return RenewBucketXact(apc.ActCheckOOB, bck, Args{UUID: uuid, Custom: msg})
+}
+
+func RenewRenamePrefix(uuid string, bck *meta.Bck, msg *apc.RenamePrefixMsg) RenewRes {
+	return RenewBucketXact(apc.ActRenamePrefix, bck, Args{UUID: uuid, Custom: msg})
+}
+
 // kind: (apc.ActCopyObjects | apc.ActETLObjects)
 func RenewTCObjs(kind string, custom *TCObjsArgs) RenewRes {
 	return RenewBucketXact(kind, custom.BckFrom, Args{Custom: custom}, custom.BckFrom, custom.BckTo)
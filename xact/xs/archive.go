@@ -145,10 +145,10 @@ func (r *XactArch) Begin(msg *cmn.ArchiveBckMsg, archlom *core.LOM) (err error)
 	// fcreate at BEGIN time
 	if core.T.SID() == wi.tsi.ID() {
 		var (
-			s           string
-			lmfh        *os.File
-			finfo, errX = os.Stat(wi.archlom.FQN)
-			exists      = errX == nil
+			s       string
+			lmfh    cos.LomReader
+			_, errX = os.Stat(wi.archlom.FQN)
+			exists  = errX == nil
 		)
 		if exists && wi.msg.AppendIfExists {
 			s = " append"
@@ -169,7 +169,7 @@ func (r *XactArch) Begin(msg *cmn.ArchiveBckMsg, archlom *core.LOM) (err error)
 
 		// append case (above)
 		if lmfh != nil {
-			err = wi.writer.Copy(lmfh, finfo.Size())
+			err = wi.writer.Copy(lmfh, wi.archlom.SizeBytes())
 			if err != nil {
 				wi.writer.Fini()
 				wi.cleanup()
@@ -429,16 +429,20 @@ func (r *XactArch) Snap() (snap *core.Snap) {
 // archwi //
 ////////////
 
-func (wi *archwi) beginAppend() (lmfh *os.File, err error) {
+func (wi *archwi) beginAppend() (lmfh cos.LomReader, err error) {
 	msg := wi.msg
-	if msg.Mime == archive.ExtTar {
+	if err = wi.archlom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return
+	}
+	// (fast append is not applicable to deduplicated content)
+	if msg.Mime == archive.ExtTar && !wi.archlom.IsDedup() {
 		if err = wi.openTarForAppend(); err == nil || err != archive.ErrTarIsEmpty {
 			return
 		}
 	}
 	// msg.Mime has been already validated (see ais/* for apc.ActArchive)
 	// prep to copy `lmfh` --> `wi.fh` with subsequent APPEND-ing
-	lmfh, err = os.Open(wi.archlom.FQN)
+	lmfh, err = wi.archlom.NewHandle()
 	if err != nil {
 		return
 	}
@@ -503,7 +507,7 @@ func (wi *archwi) do(lom *core.LOM, lrit *lriterator) {
 		}
 	}
 
-	fh, err := cos.NewFileHandle(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err != nil {
 		wi.r.AddErr(err, 5, cos.SmoduleXs)
 		return
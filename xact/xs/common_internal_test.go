@@ -0,0 +1,124 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// common helpers: internal (package xs) unit tests
+
+const testOtherTarget = "other-tid" // see testInit
+
+type testSowner struct {
+	smap *meta.Smap
+}
+
+func (o *testSowner) Get() *meta.Smap             { return o.smap }
+func (*testSowner) Listeners() meta.SmapListeners { return nil }
+
+// one local mountpath; two targets: the mock and testOtherTarget
+// (the caller then sets core.T)
+func testInit(t *testing.T, bcks ...*meta.Bck) *mock.TargetMock {
+	mpath := t.TempDir()
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	if hk.DefaultHK == nil {
+		hk.TestInit()
+	}
+
+	tmock := mock.NewTarget(mock.NewBaseBownerMock(bcks...))
+	smap := &meta.Smap{Tmap: meta.NodeMap{}}
+	for _, tid := range []string{tmock.SID(), testOtherTarget} {
+		smap.Tmap[tid] = &meta.Snode{DaeID: tid, DaeType: apc.Target}
+	}
+	smap.InitDigests()
+	tmock.SO = &testSowner{smap: smap}
+	return tmock
+}
+
+func testBck(name, provider string) *meta.Bck {
+	return meta.NewBck(name, provider, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+}
+
+// store object locally (as if PUT), return its checksum
+func testPutObj(t *testing.T, bck *cmn.Bck, objName, content, ver string, atime int64) *cos.Cksum {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(lom.FQN), cos.PermRWXRX))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, []byte(content), cos.PermRWR))
+
+	cksum := cos.NewCksumHash(cos.ChecksumXXHash)
+	cksum.H.Write([]byte(content))
+	cksum.Finalize()
+	lom.SetSize(int64(len(content)))
+	lom.SetCksum(cksum.Clone())
+	lom.SetVersion(ver)
+	lom.SetAtimeUnix(atime)
+	tassert.CheckFatal(t, lom.Persist())
+	lom.Uncache()
+	return cksum.Clone()
+}
+
+// whether the object belongs to this (mock) target
+func testOwned(t *testing.T, bck *cmn.Bck, objName string) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	_, local, err := lom.HrwTarget(core.T.Sowner().Get())
+	tassert.CheckFatal(t, err)
+	return local
+}
+
+// generate (fmt.Sprintf(format, seqno)) names of the objects that belong to this (mock) target;
+// optional suffixes to generate groups of related objects (e.g., samples) that all belong
+func testOwnedNames(t *testing.T, bck *cmn.Bck, format string, num int, suffixes ...string) (names []string) {
+	if len(suffixes) == 0 {
+		suffixes = []string{""}
+	}
+outer:
+	for i := 0; len(names) < num; i++ {
+		name := fmt.Sprintf(format, i)
+		for _, sfx := range suffixes {
+			if !testOwned(t, bck, name+sfx) {
+				continue outer
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// create (via its factory), start, and run xaction to completion
+func testRun(t *testing.T, factory xreg.Renewable, bck *meta.Bck, custom any) core.Xact {
+	p := factory.New(xreg.Args{UUID: cos.GenUUID(), Custom: custom}, bck)
+	tassert.CheckFatal(t, p.Start())
+	xctn := p.Get()
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	xctn.Run(wg)
+	return xctn
+}
@@ -6,11 +6,13 @@
 package xs
 
 import (
+	"fmt"
 	"path/filepath"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -22,7 +24,10 @@ import (
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
-// promotes (i.e., copies) locally accessible (within the cluster) directory => bucket
+// promotes (i.e., copies, moves, or hard-links) locally accessible (within the cluster) directory => bucket
+
+// max number of per-file errors in the (per-target) report, see apc.PromoteStats
+const prmMaxRecent = 32
 
 type (
 	proFactory struct {
@@ -31,9 +36,16 @@ type (
 		args *apc.PromoteArgs
 	}
 	XactDirPromote struct {
-		p    *proFactory
-		smap *meta.Smap
+		p       *proFactory
+		smap    *meta.Smap
+		recent  []string
+		recentM sync.Mutex
 		xact.BckJog
+		stats struct {
+			linked  atomic.Int64
+			skipped atomic.Int64
+			failed  atomic.Int64
+		}
 		confirmedFshare bool // set separately in the commit phase prior to Run
 	}
 )
@@ -105,6 +117,16 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 
 	// promote
 	args := r.p.args
+	if len(args.Include) > 0 || len(args.Exclude) > 0 {
+		relname, err := filepath.Rel(args.SrcFQN, fqn)
+		if err != nil {
+			return err
+		}
+		if !args.Selected(relname) {
+			r.stats.skipped.Inc()
+			return nil
+		}
+	}
 	objName, err := PrmObjName(fqn, args.SrcFQN, args.ObjName)
 	if err != nil {
 		return err
@@ -128,18 +150,35 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 			ObjName:      objName,
 			OverwriteDst: args.OverwriteDst,
 			DeleteSrc:    args.DeleteSrc,
+			HardLink:     args.HardLink,
 		},
 	}
-	// TODO: continue-on-error (unify w/ x-archive)
 	errCode, err := core.T.Promote(&params)
 	if cos.IsNotExist(err, errCode) {
 		err = nil
 	}
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
-		nlog.Infof("%s: %s => %s (over=%t, del=%t, share=%t): %v", r.Base.Name(), fqn, bck.Cname(objName),
-			args.OverwriteDst, args.DeleteSrc, r.confirmedFshare, err)
+		nlog.Infof("%s: %s => %s (over=%t, del=%t, link=%t, share=%t): %v", r.Base.Name(), fqn, bck.Cname(objName),
+			args.OverwriteDst, args.DeleteSrc, params.Linked, r.confirmedFshare, err)
 	}
-	return err
+	if err == nil {
+		if params.Linked {
+			r.stats.linked.Inc()
+		}
+		return nil
+	}
+	err = fmt.Errorf("failed to promote %q: %w", fqn, err)
+	if !args.ContinueOnError {
+		return err
+	}
+	r.stats.failed.Inc()
+	r.recentM.Lock()
+	if len(r.recent) < prmMaxRecent {
+		r.recent = append(r.recent, err.Error())
+	}
+	r.recentM.Unlock()
+	r.AddErr(err, 4, cos.SmoduleXs)
+	return nil
 }
 
 func (r *XactDirPromote) Snap() (snap *core.Snap) {
@@ -147,6 +186,15 @@ func (r *XactDirPromote) Snap() (snap *core.Snap) {
 	r.ToSnap(snap)
 
 	snap.IdleX = r.IsIdle()
+	ext := &apc.PromoteStats{
+		Linked:  r.stats.linked.Load(),
+		Skipped: r.stats.skipped.Load(),
+		Failed:  r.stats.failed.Load(),
+	}
+	r.recentM.Lock()
+	ext.Recent = append([]string(nil), r.recent...)
+	r.recentM.Unlock()
+	snap.Ext = ext
 	return
 }
 
@@ -25,6 +25,10 @@ func Xreg(xeleOnly bool) {
 	xreg.RegBckXact(&evdFactory{kind: apc.ActEvictObjects})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActDeleteObjects})
 	xreg.RegBckXact(&prfFactory{})
+	xreg.RegBckXact(&replFactory{})
+	xreg.RegBckXact(&ishardFactory{})
+	xreg.RegBckXact(&oobFactory{})
+	xreg.RegBckXact(&rnpFactory{})
 
 	xreg.RegNonBckXact(&nsummFactory{})
 
@@ -0,0 +1,389 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Initial sharding (apc.ActInitShard): each target packs the loose objects that it stores
+// into shards of a given (target) size:
+// - a single pass through the bucket groups objects into samples (see apc.InitShardMsg);
+// - samples are ordered (by name or hash) and packed, in that order, never splitting
+//   a sample between shards;
+// - each shard gets written locally and then promoted into the cluster (i.e., stored by
+//   its HRW target); same for the per-target manifest that maps shards to samples.
+
+type (
+	ishardFactory struct {
+		xreg.RenewBase
+		xctn *XactInitShard
+		msg  *apc.InitShardMsg
+	}
+	XactInitShard struct {
+		msg      *apc.InitShardMsg
+		config   *cmn.Config
+		mime     string
+		manifest apc.InitShardManifest
+		xact.Base
+	}
+	ishardSample struct {
+		key   string
+		names []string
+		size  int64
+		hash  uint64
+	}
+	ishardWork struct {
+		r       *XactInitShard
+		writer  archive.Writer
+		lom     *core.LOM // shard
+		wfh     *os.File
+		fqn     string // workfile
+		samples []*ishardSample
+		cksum   cos.CksumHashSize
+		size    int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactInitShard)(nil)
+	_ xreg.Renewable = (*ishardFactory)(nil)
+)
+
+///////////////////
+// ishardFactory //
+///////////////////
+
+func (*ishardFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.InitShardMsg)
+	return &ishardFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+}
+
+func (p *ishardFactory) Start() error {
+	mime, err := archive.Mime("", p.msg.Template)
+	if err != nil {
+		return err
+	}
+	r := &XactInitShard{msg: p.msg, config: cmn.GCO.Get(), mime: mime}
+	r.manifest.Shards = make(map[string][]string, 16)
+	r.manifest.TID = core.T.SID()
+	r.InitBase(p.Args.UUID, p.Kind(), p.Bck)
+	p.xctn = r
+	return nil
+}
+
+func (*ishardFactory) Kind() string     { return apc.ActInitShard }
+func (p *ishardFactory) Get() core.Xact { return p.xctn }
+
+func (*ishardFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+///////////////////
+// XactInitShard //
+///////////////////
+
+func (r *XactInitShard) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(r.Name(), "started:", r.msg.Template, r.msg.Order, cos.ToSizeIEC(r.msg.ShardSize, 0))
+
+	samples, err := r.collect()
+	if err != nil {
+		r.AddErr(err)
+		r.Finish()
+		return
+	}
+	r.order(samples)
+
+	var (
+		seq int
+		wi  *ishardWork
+	)
+	for _, sample := range samples {
+		if r.IsAborted() {
+			break
+		}
+		if wi == nil {
+			if wi, err = r.newWork(seq); err != nil {
+				r.AddErr(err)
+				break
+			}
+			seq++
+		}
+		if err := wi.add(sample); err != nil {
+			r.AddErr(err, 5, cos.SmoduleXs)
+			continue
+		}
+		if wi.size >= r.msg.ShardSize {
+			wi.fini()
+			wi = nil
+		}
+	}
+	if wi != nil {
+		if len(wi.samples) > 0 && !r.IsAborted() {
+			wi.fini()
+		} else {
+			wi.cleanup()
+		}
+	}
+	if !r.IsAborted() && len(r.manifest.Shards) > 0 {
+		if err := r.storeManifest(); err != nil {
+			r.AddErr(err)
+		}
+	}
+	r.Finish()
+}
+
+// walk local objects and group them into samples
+func (r *XactInitShard) collect() ([]*ishardSample, error) {
+	var (
+		bck     = r.Bck()
+		skip    = r.msg.TmplPrefix() // shards and manifests (from this and prior runs)
+		samples = make(map[string]*ishardSample, 1024)
+		opts    = &fs.WalkBckOpts{
+			WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Sorted: true},
+		}
+	)
+	opts.WalkOpts.Bck.Copy(bck.Bucket())
+	opts.Callback = func(fqn string, _ fs.DirEntry) error {
+		if r.IsAborted() {
+			return r.AbortErr()
+		}
+		lom := &core.LOM{}
+		if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+			return nil // (not an object)
+		}
+		if !lom.IsHRW() || strings.HasPrefix(lom.ObjName, skip) || !strings.HasPrefix(lom.ObjName, r.msg.Prefix) {
+			return nil
+		}
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+			return nil // (e.g., deleted in the meantime)
+		}
+		key := sampleKey(lom.ObjName)
+		sample, ok := samples[key]
+		if !ok {
+			sample = &ishardSample{key: key}
+			samples[key] = sample
+		}
+		sample.names = append(sample.names, lom.ObjName)
+		sample.size += lom.SizeBytes()
+		return nil
+	}
+	if err := fs.WalkBck(opts); err != nil {
+		return nil, err
+	}
+	out := make([]*ishardSample, 0, len(samples))
+	for _, sample := range samples {
+		out = append(out, sample)
+	}
+	return out, nil
+}
+
+// sample name (key): object name up to (and not including) the first dot of the basename
+func sampleKey(objName string) string {
+	dir, base := path.Split(objName)
+	if i := strings.IndexByte(base, '.'); i > 0 {
+		base = base[:i]
+	}
+	return dir + base
+}
+
+func (r *XactInitShard) order(samples []*ishardSample) {
+	if r.msg.Order == apc.ShardOrderHash {
+		for _, sample := range samples {
+			sample.hash = xxhash.Checksum64S(cos.UnsafeB(sample.key), cos.MLCG32)
+		}
+		sort.Slice(samples, func(i, j int) bool { return samples[i].hash < samples[j].hash })
+		return
+	}
+	debug.Assert(r.msg.Order == apc.ShardOrderName, r.msg.Order)
+	sort.Slice(samples, func(i, j int) bool { return samples[i].key < samples[j].key })
+}
+
+func (r *XactInitShard) newWork(seq int) (*ishardWork, error) {
+	wi := &ishardWork{r: r, lom: core.AllocLOM(r.msg.ShardName(core.T.SID(), seq))}
+	if err := wi.lom.InitBck(r.Bck().Bucket()); err != nil {
+		core.FreeLOM(wi.lom)
+		return nil, err
+	}
+	wi.fqn = fs.CSM.Gen(wi.lom, fs.WorkfileType, fs.WorkfileCreateArch)
+	wfh, err := wi.lom.CreateFile(wi.fqn)
+	if err != nil {
+		core.FreeLOM(wi.lom)
+		return nil, err
+	}
+	wi.wfh = wfh
+	wi.cksum.Init(wi.lom.CksumType())
+	wi.writer = archive.NewWriter(r.mime, wi.wfh, &wi.cksum, &archive.Opts{})
+	return wi, nil
+}
+
+func (r *XactInitShard) storeManifest() error {
+	var (
+		objName = r.msg.ManifestName(core.T.SID())
+		lom     = core.AllocLOM(objName)
+	)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		return err
+	}
+	fqn := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+	wfh, err := lom.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	_, err = wfh.Write(cos.MustMarshal(&r.manifest))
+	cos.Close(wfh)
+	if err != nil {
+		if errRm := cos.RemoveFile(fqn); errRm != nil {
+			nlog.Errorln(r.Name(), "failed to remove", fqn, errRm)
+		}
+		return err
+	}
+	return r.promote(fqn, objName)
+}
+
+func (r *XactInitShard) promote(fqn, objName string) error {
+	params := core.PromoteParams{
+		Bck:    r.Bck(),
+		Config: r.config,
+		Xact:   r,
+		PromoteArgs: apc.PromoteArgs{
+			SrcFQN:         fqn,
+			ObjName:        objName,
+			OverwriteDst:   true,
+			DeleteSrc:      true,
+			SrcIsNotFshare: true,
+		},
+	}
+	if _, err := core.T.Promote(&params); err != nil {
+		if errRm := cos.RemoveFile(fqn); errRm != nil {
+			nlog.Errorln(r.Name(), "failed to remove", fqn, errRm)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *XactInitShard) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+////////////////
+// ishardWork //
+////////////////
+
+func (wi *ishardWork) add(sample *ishardSample) error {
+	for _, objName := range sample.names {
+		if err := wi.write(objName); err != nil {
+			return err
+		}
+	}
+	wi.samples = append(wi.samples, sample)
+	wi.size += sample.size
+	return nil
+}
+
+func (wi *ishardWork) write(objName string) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(wi.r.Bck().Bucket()); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		return err
+	}
+	err = wi.writer.Write(objName, lom, fh)
+	cos.Close(fh)
+	if err == nil {
+		wi.r.InObjsAdd(1, lom.SizeBytes())
+	}
+	return err
+}
+
+func (wi *ishardWork) fini() {
+	var (
+		r       = wi.r
+		objName = wi.lom.ObjName
+	)
+	wi.writer.Fini()
+	err := wi.wfh.Close()
+	wi.wfh = nil
+	if err == nil {
+		err = r.promote(wi.fqn, objName)
+	}
+	if err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		wi.cleanup()
+		return
+	}
+	keys := make([]string, 0, len(wi.samples))
+	for _, sample := range wi.samples {
+		keys = append(keys, sample.key)
+	}
+	r.manifest.Shards[objName] = keys
+	if cmn.Rom.FastV(4, cos.SmoduleXs) {
+		nlog.Infoln(r.Name(), "stored", objName, len(keys), "samples", cos.ToSizeIEC(wi.size, 1))
+	}
+
+	if r.msg.DeleteSrc {
+		for _, sample := range wi.samples {
+			for _, name := range sample.names {
+				wi.delete(name)
+			}
+		}
+	}
+	core.FreeLOM(wi.lom)
+}
+
+func (wi *ishardWork) delete(objName string) {
+	lom := core.AllocLOM(objName)
+	if err := lom.InitBck(wi.r.Bck().Bucket()); err == nil {
+		if errCode, err := core.T.DeleteObject(lom, false /*evict*/); err != nil && !cos.IsNotExist(err, errCode) {
+			wi.r.AddErr(err, 5, cos.SmoduleXs)
+		}
+	}
+	core.FreeLOM(lom)
+}
+
+func (wi *ishardWork) cleanup() {
+	if wi.wfh != nil {
+		wi.writer.Fini()
+		cos.Close(wi.wfh)
+	}
+	if err := cos.RemoveFile(wi.fqn); err != nil {
+		nlog.Errorln(wi.r.Name(), "failed to remove", wi.fqn, err)
+	}
+	core.FreeLOM(wi.lom)
+}
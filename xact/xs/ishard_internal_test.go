@@ -0,0 +1,211 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/OneOfOne/xxhash"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// promotes (i.e., "stores in the cluster") by keeping the promoted content in memory
+type ishardTarget struct {
+	*mock.TargetMock
+	promoted map[string][]byte // object name => content
+	deleted  cos.StrSet
+	mu       sync.Mutex
+}
+
+func (t *ishardTarget) Promote(params *core.PromoteParams) (int, error) {
+	b, err := os.ReadFile(params.SrcFQN)
+	if err != nil {
+		return 0, err
+	}
+	t.mu.Lock()
+	t.promoted[params.ObjName] = b
+	t.mu.Unlock()
+	return 0, os.Remove(params.SrcFQN)
+}
+
+func (t *ishardTarget) DeleteObject(lom *core.LOM, _ bool) (int, error) {
+	t.mu.Lock()
+	t.deleted.Add(lom.ObjName)
+	t.mu.Unlock()
+	return 0, os.Remove(lom.FQN)
+}
+
+func testIshardInit(t *testing.T) (*ishardTarget, *meta.Bck) {
+	bck := testBck("ishard", apc.AIS)
+	tgt := &ishardTarget{TargetMock: testInit(t, bck), promoted: make(map[string][]byte), deleted: cos.NewStrSet()}
+	core.T = tgt
+	return tgt, bck
+}
+
+// samples (".jpg" and ".cls" each) that are locally stored in their entirety
+func testIshardSamples(t *testing.T, bck *meta.Bck, prefix string, num, objSize int) []string {
+	content := strings.Repeat("x", objSize)
+	keys := testOwnedNames(t, bck.Bucket(), prefix+"s-%03d", num, ".jpg", ".cls")
+	for _, key := range keys {
+		testPutObj(t, bck.Bucket(), key+".jpg", content, "1", time.Now().UnixNano())
+		testPutObj(t, bck.Bucket(), key+".cls", content, "1", time.Now().UnixNano())
+	}
+	return keys
+}
+
+func testIshardRun(t *testing.T, bck *meta.Bck, msg *apc.InitShardMsg) *XactInitShard {
+	tassert.CheckFatal(t, msg.Validate())
+	r := testRun(t, &ishardFactory{}, bck, msg).(*XactInitShard)
+	tassert.CheckFatal(t, r.Err())
+	return r
+}
+
+// shard content: sample keys in the order of appearance
+func testIshardKeys(t *testing.T, b []byte) (keys []string) {
+	var (
+		tr   = tar.NewReader(bytes.NewReader(b))
+		seen = cos.NewStrSet()
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return keys
+		}
+		tassert.CheckFatal(t, err)
+		if key := sampleKey(hdr.Name); !seen.Contains(key) {
+			seen.Add(key)
+			keys = append(keys, key)
+		}
+	}
+}
+
+func TestInitShard(t *testing.T) {
+	const (
+		numSamples = 10
+		objSize    = 50
+		shardSize  = 250 // 3 samples (6 objects) per shard
+	)
+	for _, order := range apc.SupportedShardOrder {
+		t.Run(order, func(t *testing.T) {
+			tgt, bck := testIshardInit(t)
+			keys := testIshardSamples(t, bck, "data/", numSamples, objSize)
+			testIshardSamples(t, bck, "other/", 2, objSize) // not matching the prefix
+			msg := &apc.InitShardMsg{Prefix: "data/", Order: order, ShardSize: shardSize}
+			r := testIshardRun(t, bck, msg)
+
+			// manifest
+			b, ok := tgt.promoted[msg.ManifestName(core.T.SID())]
+			tassert.Fatalf(t, ok, "manifest not stored")
+			manifest := &apc.InitShardManifest{}
+			tassert.CheckFatal(t, jsoniter.Unmarshal(b, manifest))
+			tassert.Errorf(t, manifest.TID == core.T.SID(), "expected manifest TID %q, got %q", core.T.SID(), manifest.TID)
+			tassert.Fatalf(t, len(manifest.Shards) == 4, "expected 4 shards, got %d", len(manifest.Shards))
+
+			// shards: never splitting samples, packed in the requested order
+			var packed []string
+			for seq := 0; seq < len(manifest.Shards); seq++ {
+				name := msg.ShardName(core.T.SID(), seq)
+				b, ok := tgt.promoted[name]
+				tassert.Fatalf(t, ok, "shard %s not stored", name)
+				skeys := testIshardKeys(t, b)
+				tassert.Errorf(t, strings.Join(skeys, ",") == strings.Join(manifest.Shards[name], ","),
+					"%s: content %v vs manifest %v", name, skeys, manifest.Shards[name])
+				tr, n := tar.NewReader(bytes.NewReader(b)), 0
+				for _, err := tr.Next(); err == nil; _, err = tr.Next() {
+					n++
+				}
+				tassert.Errorf(t, n == 2*len(skeys), "%s: expected %d objects, got %d", name, 2*len(skeys), n)
+				packed = append(packed, skeys...)
+			}
+			expected := append([]string(nil), keys...)
+			if order == apc.ShardOrderHash {
+				hash := func(key string) uint64 { return xxhash.Checksum64S(cos.UnsafeB(key), cos.MLCG32) }
+				sort.Slice(expected, func(i, j int) bool { return hash(expected[i]) < hash(expected[j]) })
+			} else {
+				sort.Strings(expected)
+			}
+			tassert.Errorf(t, strings.Join(packed, ",") == strings.Join(expected, ","), "expected %v, got %v", expected, packed)
+			tassert.Errorf(t, len(tgt.deleted) == 0, "source objects must be kept")
+
+			snap := r.Snap()
+			tassert.Errorf(t, snap.Stats.InObjs == 2*numSamples, "expected %d objects in, got %d", 2*numSamples, snap.Stats.InObjs)
+		})
+	}
+}
+
+func TestInitShardDeleteSrc(t *testing.T) {
+	tgt, bck := testIshardInit(t)
+	keys := testIshardSamples(t, bck, "data/", 4, 50)
+	msg := &apc.InitShardMsg{Prefix: "data/", ShardSize: cos.MiB, DeleteSrc: true}
+	testIshardRun(t, bck, msg)
+
+	tassert.Errorf(t, len(tgt.promoted) == 2, "expected a single shard and a manifest, got %d", len(tgt.promoted))
+	tassert.Errorf(t, len(tgt.deleted) == 2*len(keys), "expected %d deleted, got %d", 2*len(keys), len(tgt.deleted))
+	for _, key := range keys {
+		tassert.Errorf(t, tgt.deleted.Contains(key+".jpg") && tgt.deleted.Contains(key+".cls"), "%s: expected deleted", key)
+	}
+}
+
+// a subsequent run skips the objects named per (shard) template
+func TestInitShardSkipShards(t *testing.T) {
+	tgt, bck := testIshardInit(t)
+	testIshardSamples(t, bck, "", 3, 50)
+	testIshardSamples(t, bck, "shards/", 2, 50) // (as in: shards from a prior run)
+	msg := &apc.InitShardMsg{ShardSize: cos.MiB}
+	testIshardRun(t, bck, msg)
+
+	b, ok := tgt.promoted[msg.ShardName(core.T.SID(), 0)]
+	tassert.Fatalf(t, ok, "shard not stored")
+	for _, key := range testIshardKeys(t, b) {
+		tassert.Errorf(t, !strings.HasPrefix(key, msg.TmplPrefix()), "%s: must not be packed", key)
+	}
+}
+
+func TestInitShardMsgValidate(t *testing.T) {
+	tests := []struct {
+		msg apc.InitShardMsg
+		ok  bool
+	}{
+		{apc.InitShardMsg{}, true},
+		{apc.InitShardMsg{Prefix: "data/", Template: "out/{tid}-{seq}.tgz", Order: apc.ShardOrderHash}, true},
+		{apc.InitShardMsg{Template: "out/{seq}.tar"}, false},      // no {tid}
+		{apc.InitShardMsg{Template: "{tid}-{seq}.tar"}, false},    // no prefix
+		{apc.InitShardMsg{Prefix: "shards/a/"}, false},            // source inside destination
+		{apc.InitShardMsg{Order: "size"}, false},                  // bad order
+		{apc.InitShardMsg{ShardSize: -1}, false},                  // bad size
+		{apc.InitShardMsg{Template: "out/{tid}-{seq}.bin"}, true}, // (format is checked upon start)
+	}
+	for i, test := range tests {
+		msg := test.msg
+		err := msg.Validate()
+		tassert.Errorf(t, (err == nil) == test.ok, "%d: %+v: expected ok=%t, got %v", i, test.msg, test.ok, err)
+	}
+	// defaults
+	msg := &apc.InitShardMsg{}
+	tassert.CheckFatal(t, msg.Validate())
+	tassert.Errorf(t, msg.Template == apc.DefaultInitShardTmpl && msg.Order == apc.ShardOrderName &&
+		msg.ShardSize == apc.DefaultInitShardSize, "unexpected defaults: %+v", msg)
+
+	// unknown archival format
+	_, bck := testIshardInit(t)
+	p := (&ishardFactory{}).New(xreg.Args{UUID: cos.GenUUID(), Custom: &apc.InitShardMsg{Template: "out/{tid}-{seq}.bin"}}, bck)
+	tassert.Errorf(t, p.Start() != nil, "expected unknown format to be rejected")
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
@@ -42,6 +43,7 @@ type (
 	lrxact interface {
 		IsAborted() bool
 		Finished() bool
+		core.ResAcct
 	}
 	// common multi-obj operation context and iterList()/iterRangeOrPref() logic
 	lriterator struct {
@@ -111,6 +113,8 @@ pref:
 }
 
 func (r *lriterator) run(wi lrwi, smap *meta.Smap) (err error) {
+	bg := fs.BgIOBegin()
+	defer fs.BgIOEnd(bg)
 	switch r.lrp {
 	case lrpList:
 		err = r._list(wi, smap)
@@ -236,6 +240,8 @@ func (r *lriterator) do(lom *core.LOM, wi lrwi, smap *meta.Smap) error {
 		}
 	}
 	// NOTE: lom is alloc-ed prior to the call and freed upon return
+	begin := core.CPUBegin()
 	wi.do(lom, r)
+	core.CPUEnd(r.parent, begin)
 	return nil
 }
@@ -54,6 +54,7 @@ type (
 			pageCh       chan *cmn.LsoEntry // channel to accumulate listed object entries
 			stopCh       *cos.StopCh        // to abort bucket walk
 			wi           *walkInfo          // walking context and state
+			lastDir      string             // non-recursive: last listed virtual directory
 			wg           sync.WaitGroup     // wait until this walk finishes
 			done         bool               // done walking (indication)
 			wor          bool               // wantOnlyRemote
@@ -271,6 +272,7 @@ func (r *LsoXact) listRemote() bool { return r.p.Bck.IsRemote() && !r.msg.IsFlag
 func (r *LsoXact) initWalk() {
 	r.walk.pageCh = make(chan *cmn.LsoEntry, pageChSize)
 	r.walk.done = false
+	r.walk.lastDir = ""
 	r.walk.stopCh = cos.NewStopCh()
 	r.walk.wg.Add(1)
 
@@ -547,24 +549,45 @@ func (r *LsoXact) validateCb(fqn string, de fs.DirEntry) error {
 		return nil
 	}
 	relPath := ct.ObjectName()
-	if cmn.ObjHasPrefix(relPath, r.walk.wi.msg.Prefix) {
-		suffix := strings.TrimPrefix(relPath, r.walk.wi.msg.Prefix)
-		if strings.Contains(suffix, "/") {
-			// We are deeper than it is allowed by prefix, skip dir's content
-			return filepath.SkipDir
-		}
-		entry := &cmn.LsoEntry{Name: relPath, Flags: apc.EntryIsDir}
-		select {
-		case r.walk.pageCh <- entry:
-			/* do nothing */
-		case <-r.walk.stopCh.Listen():
-			return errStopped
-		}
+	if relPath == "" || !cmn.ObjHasPrefix(relPath, r.walk.wi.msg.Prefix) {
+		return nil // bucket's root or parent of the prefix (keep going)
+	}
+	suffix := strings.TrimPrefix(relPath, r.walk.wi.msg.Prefix)
+	if strings.Contains(suffix, "/") {
+		// deeper than allowed by the prefix
+		return filepath.SkipDir
+	}
+	// virtual directory at the requested level: skip its content but have
+	// fs.WalkBck deliver the directory itself (sorted, see `cb` below) -
+	// this is what makes non-recursive listing of deeply nested buckets fast
+	return fs.SkipDirContent
+}
+
+// non-recursive: virtual directory (the same directory may reside on multiple mountpaths)
+func (r *LsoXact) dirEntry(fqn string) *cmn.LsoEntry {
+	ct, err := core.NewCTFromFQN(fqn, nil)
+	if err != nil {
+		return nil
 	}
-	return nil
+	name := ct.ObjectName()
+	if name == r.walk.lastDir || name <= r.walk.wi.msg.StartAfter {
+		return nil
+	}
+	r.walk.lastDir = name
+	return &cmn.LsoEntry{Name: name, Flags: apc.EntryIsDir}
 }
 
 func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		if entry := r.dirEntry(fqn); entry != nil {
+			select {
+			case r.walk.pageCh <- entry:
+			case <-r.walk.stopCh.Listen():
+				return errStopped
+			}
+		}
+		return nil
+	}
 	entry, err := r.walk.wi.callback(fqn, de)
 	if err != nil || entry == nil {
 		return err
@@ -573,14 +596,6 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	if entry.Name <= msg.StartAfter {
 		return nil
 	}
-	if r.walk.wi.msg.IsFlagSet(apc.LsNoRecursion) {
-		// Check if the object is nested deeper than requested.
-		// Note that it'd be incorrect to return `SkipDir` in this case.
-		relName := strings.TrimPrefix(entry.Name, r.walk.wi.msg.Prefix)
-		if strings.Contains(relName, "/") {
-			return nil
-		}
-	}
 
 	select {
 	case r.walk.pageCh <- entry:
@@ -595,7 +610,7 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 
 	// ls arch
 	// looking only at the file extension - not reading ("detecting") file magic (TODO: add lsmsg flag)
-	archList, err := archive.List(fqn)
+	archList, err := r.lsarch(fqn)
 	if err != nil {
 		if archive.IsErrUnknownFileExt(err) {
 			// skip and keep going
@@ -674,3 +689,25 @@ func (r *LsoXact) _recv(hdr *transport.ObjHdr, objReader io.Reader, buf []byte)
 	}
 	return
 }
+
+// (deduplicated archives are read via lom handle - see core/dedup.go)
+func (r *LsoXact) lsarch(fqn string) ([]*archive.Entry, error) {
+	if _, err := archive.Mime("", fqn); err != nil {
+		return nil, err
+	}
+	lom := core.AllocLOM("")
+	defer core.FreeLOM(lom)
+	if err := lom.InitFQN(fqn, r.Bck().Bucket()); err != nil {
+		return nil, err
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil || !lom.IsDedup() {
+		return archive.List(fqn)
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		return nil, err
+	}
+	lst, err := archive.ListReader(fh, fqn, lom.SizeBytes())
+	cos.Close(fh)
+	return lst, err
+}
@@ -0,0 +1,50 @@
+// Package xs contains most of the supported eXtended actions (xactions) with some
+// exceptions that include certain storage services (mirror, EC) and extensions (downloader, lru).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// "Head of bucket" (apc.LsHead): the first msg.PageSize objects found in the local mountpaths -
+// one mountpath at a time, in no particular order, and with early exit. Executes synchronously
+// (no xaction, no continuation); the proxy then simply concatenates per-target results.
+func LsoHead(bck *meta.Bck, msg *apc.LsoMsg) (*cmn.LsoResult, error) {
+	var (
+		wi   = newWalkInfo(msg, noopCb)
+		n    = int(msg.PageSize)
+		lst  = &cmn.LsoResult{UUID: msg.UUID, Entries: make(cmn.LsoEntries, 0, min(n, 1024))}
+		stop = cmn.NewErrAborted(bck.Cname(""), "list-objects head", errStopped)
+	)
+	cb := func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return wi.processDir(fqn)
+		}
+		entry, err := wi.callback(fqn, de)
+		if err != nil || entry == nil {
+			return err
+		}
+		lst.Entries = append(lst.Entries, entry)
+		if len(lst.Entries) >= n {
+			return stop
+		}
+		return nil
+	}
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{Mi: mi, CTs: []string{fs.ObjectType}, Callback: cb}
+		opts.Bck.Copy(bck.Bucket())
+		if err := fs.Walk(opts); err != nil && err != stop {
+			return nil, err
+		}
+		if len(lst.Entries) >= n {
+			break
+		}
+	}
+	return lst, nil
+}
@@ -0,0 +1,49 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLsoHead(t *testing.T) {
+	bck := testBck("head", apc.AIS)
+	core.T = testInit(t, bck)
+
+	names := testOwnedNames(t, bck.Bucket(), "dir/obj-%03d", 10)
+	for _, name := range names {
+		testPutObj(t, bck.Bucket(), name, "content of "+name, "", time.Now().UnixNano())
+	}
+	all := cos.NewStrSet(names...)
+
+	tests := []struct {
+		msg      apc.LsoMsg
+		expected int
+	}{
+		{apc.LsoMsg{PageSize: 3}, 3},
+		{apc.LsoMsg{PageSize: 1, Props: apc.GetPropsName, Flags: apc.LsNameOnly}, 1},
+		{apc.LsoMsg{PageSize: 100}, len(names)},
+		{apc.LsoMsg{PageSize: 100, Prefix: "dir/obj-"}, len(names)},
+		{apc.LsoMsg{PageSize: 100, Prefix: "other/"}, 0},
+	}
+	for _, test := range tests {
+		msg := test.msg
+		msg.UUID = cos.GenUUID()
+		lst, err := LsoHead(bck, &msg)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, len(lst.Entries) == test.expected, "%+v: expected %d entries, got %d",
+			test.msg, test.expected, len(lst.Entries))
+		for _, e := range lst.Entries {
+			tassert.Errorf(t, all.Contains(e.Name), "%+v: unexpected entry %q", test.msg, e.Name)
+		}
+	}
+}
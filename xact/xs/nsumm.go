@@ -7,12 +7,17 @@ package xs
 
 import (
 	"fmt"
+	"maps"
 	"math"
+	"mime"
+	"path"
+	"strings"
 	"sync"
 	ratomic "sync/atomic"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -35,13 +40,24 @@ type (
 		p             *nsummFactory
 		oneRes        cmn.BsummResult
 		mapRes        map[uint64]*cmn.BsummResult
+		samples       map[*cmn.BsummResult]*nsummSample // when sampling (see apc.BsummCtrlMsg.SampleSize)
+		errSampled    error                             // stops per-bucket traversal when the sample is complete
 		buckets       []*meta.Bck
 		_nam, _str    string
+		pbase         string     // (when breaking down by prefix) msg.Prefix up to and including its last '/'
+		mu            sync.Mutex // protects all BsummResult.ByPrefix and BsummResult.Groups, and all samples
 		totalDiskSize uint64
 		xact.BckJog
 		single     bool
 		listRemote bool
 	}
+	// sizes of the visited objects and copies, to extrapolate from
+	nsummSample struct {
+		n     uint64  // number of visited objects and copies
+		sum   uint64  // their total size
+		sumSq float64 // sum of squares of the sizes
+		full  bool    // reached the sample size while there was still more to visit
+	}
 )
 
 // interface guard
@@ -79,8 +95,18 @@ func newSumm(p *nsummFactory) (r *XactNsumm, err error) {
 	r = &XactNsumm{p: p}
 
 	r.totalDiskSize = fs.GetDiskSize()
+	if p.msg.PrefixDepth > 0 || p.msg.GroupBy == apc.BsummGroupPrefix {
+		if i := strings.LastIndexByte(p.msg.Prefix, '/'); i >= 0 {
+			r.pbase = p.msg.Prefix[:i+1]
+		}
+	}
 
-	listRemote := p.Bck.IsCloud() && !p.msg.ObjCached
+	if p.msg.SampleSize > 0 {
+		r.samples = make(map[*cmn.BsummResult]*nsummSample, 4)
+	}
+
+	// NOTE: sampling (estimating) applies to present objects only
+	listRemote := p.Bck.IsCloud() && !p.msg.ObjCached && p.msg.SampleSize == 0
 	if listRemote {
 		var (
 			smap = core.T.Sowner().Get()
@@ -120,7 +146,8 @@ func newSumm(p *nsummFactory) (r *XactNsumm, err error) {
 			if nmps == 0 {
 				return r, cmn.ErrNoMountpaths
 			}
-			opts.PerBucket = nb*nmps <= sys.NumCPU()
+			// (also, when sampling: to stop walking any given bucket once its sample is complete)
+			opts.PerBucket = nb*nmps <= sys.NumCPU() || p.msg.SampleSize > 0
 			goto ini
 		}
 	}
@@ -134,6 +161,9 @@ ini:
 	s := fmt.Sprintf("-msg-%+v", r.p.msg)
 	r._nam = r.Base.Name() + s
 	r._str = r.Base.String() + s
+	if r.samples != nil {
+		r.errSampled = cmn.NewErrAborted(r._nam, "sampled", errStopped)
+	}
 	return r, nil
 }
 
@@ -220,6 +250,15 @@ func (r *XactNsumm) initRes(res *cmn.BsummResult, bck *meta.Bck) {
 	res.TotalSize.Disks = r.totalDiskSize
 	res.ObjSize.Min = math.MaxInt64
 	res.TotalSize.OnDisk = fs.OnDiskSize(bck.Bucket(), r.p.msg.Prefix)
+	if r.p.msg.PrefixDepth > 0 {
+		res.ByPrefix = make(map[string]apc.BsummGroup, 16)
+	}
+	if r.p.msg.GroupBy != "" {
+		res.Groups = make(map[string]apc.BsummGroup, 16)
+	}
+	if r.samples != nil {
+		r.samples[res] = &nsummSample{}
+	}
 }
 
 func (r *XactNsumm) String() string { return r._str }
@@ -266,6 +305,26 @@ func (r *XactNsumm) cloneRes(dst, src *cmn.BsummResult) {
 	}
 	dst.ObjSize.Max = ratomic.LoadInt64(&src.ObjSize.Max)
 
+	if r.p.msg.PrefixDepth > 0 {
+		r.mu.Lock()
+		dst.ByPrefix = maps.Clone(src.ByPrefix)
+		dst.PrefixesTrunc = src.PrefixesTrunc
+		r.mu.Unlock()
+	}
+	if r.p.msg.GroupBy != "" {
+		r.mu.Lock()
+		dst.Groups = maps.Clone(src.Groups)
+		dst.GroupsTrunc = src.GroupsTrunc
+		r.mu.Unlock()
+	}
+
+	if r.samples != nil {
+		r.mu.Lock()
+		sample := *r.samples[src]
+		r.mu.Unlock()
+		dst.Estimate = sample.estimate(dst, r.Finished())
+	}
+
 	// compute the current (maybe, running-and-changing) average and used %%
 	if dst.ObjCount.Present > 0 {
 		dst.ObjSize.Avg = int64(cos.DivRoundU64(dst.TotalSize.PresentObjs, dst.ObjCount.Present))
@@ -284,10 +343,13 @@ func (r *XactNsumm) visitObj(lom *core.LOM, _ []byte) error {
 		debug.Assert(ok, r.Name(), lom.Cname()) // j.opts.Buckets above
 		res = s
 	}
+	size := lom.SizeBytes()
+	if r.samples != nil && !r.sample(res, size) {
+		return r.errSampled // this bucket, this mountpath: stop walking
+	}
 	if !lom.IsCopy() {
 		ratomic.AddUint64(&res.ObjCount.Present, 1)
 	}
-	size := lom.SizeBytes()
 	if cmin := ratomic.LoadInt64(&res.ObjSize.Min); cmin > size {
 		ratomic.CompareAndSwapInt64(&res.ObjSize.Min, cmin, size)
 	}
@@ -295,12 +357,99 @@ func (r *XactNsumm) visitObj(lom *core.LOM, _ []byte) error {
 		ratomic.CompareAndSwapInt64(&res.ObjSize.Max, cmax, size)
 	}
 	ratomic.AddUint64(&res.TotalSize.PresentObjs, uint64(size))
+	if r.p.msg.PrefixDepth > 0 {
+		r.byPrefix(res, lom.ObjName, size, lom.IsCopy())
+	}
+	if r.p.msg.GroupBy != "" {
+		r.groupBy(res, r.groupKey(lom), size, lom.IsCopy())
+	}
 
 	// generic stats (same as base.LomAdd())
 	r.ObjsAdd(1, size)
 	return nil
 }
 
+// add the object to each of its parent (virtual) directories, up to msg.PrefixDepth;
+// objects at the top level (relative to msg.Prefix) are accounted for only in the bucket totals;
+// same as the latter, copies add their sizes but are not counted
+func (r *XactNsumm) byPrefix(res *cmn.BsummResult, objName string, size int64, isCopy bool) {
+	debug.Assert(strings.HasPrefix(objName, r.pbase), objName, " vs ", r.pbase)
+	rel := objName[len(r.pbase):]
+	r.mu.Lock()
+	for i, depth := 0, 0; depth < r.p.msg.PrefixDepth; depth++ {
+		j := strings.IndexByte(rel[i:], '/')
+		if j < 0 {
+			break
+		}
+		i += j + 1
+		prefix := r.pbase + rel[:i]
+		v, ok := res.ByPrefix[prefix]
+		if !ok && len(res.ByPrefix) >= apc.MaxBsummGroups {
+			res.PrefixesTrunc = true
+			break
+		}
+		if !isCopy {
+			v.Count++
+		}
+		v.Size += uint64(size)
+		res.ByPrefix[prefix] = v
+	}
+	r.mu.Unlock()
+}
+
+//
+// sampling
+//
+
+func (r *XactNsumm) sample(res *cmn.BsummResult, size int64) bool {
+	r.mu.Lock()
+	s := r.samples[res]
+	if s.n >= uint64(r.p.msg.SampleSize) {
+		s.full = true
+		r.mu.Unlock()
+		return false
+	}
+	s.n++
+	s.sum += uint64(size)
+	s.sumSq += float64(size) * float64(size)
+	r.mu.Unlock()
+	return true
+}
+
+// when the sample is complete: replace the (sampled) count and size of present objects with estimates
+func (s *nsummSample) estimate(dst *cmn.BsummResult, finished bool) *apc.BsummEstimate {
+	e := &apc.BsummEstimate{Sampled: s.n, CountLow: dst.ObjCount.Present, CountHigh: dst.ObjCount.Present}
+	if !s.full {
+		e.Exact = finished
+		return e
+	}
+	dst.ObjCount.Present, e.CountLow, e.CountHigh = estimateCount(dst.TotalSize.OnDisk, s, dst.ObjCount.Present)
+	dst.TotalSize.PresentObjs = max(dst.TotalSize.OnDisk, dst.TotalSize.PresentObjs)
+	return e
+}
+
+// extrapolate the number of objects from the size on disk and the mean size of the sampled
+// objects and copies (`nobj` is the number of sampled objects, copies not counted);
+// the confidence interval is derived from the standard error of the mean
+func estimateCount(onDisk uint64, s *nsummSample, nobj uint64) (est, low, high uint64) {
+	const z95 = 1.96
+	if s.n == 0 || s.sum == 0 || onDisk <= s.sum {
+		return nobj, nobj, nobj
+	}
+	var (
+		n     = float64(s.n)
+		mean  = float64(s.sum) / n
+		vari  = max(s.sumSq/n-mean*mean, 0) * n / max(n-1, 1) // (unbiased)
+		se    = math.Sqrt(vari / n)
+		ratio = float64(nobj) / n // excluding copies
+		total = float64(onDisk)
+	)
+	est = max(uint64(math.Round(total/mean*ratio)), nobj)
+	low = max(uint64(total/(mean+z95*se)*ratio), nobj)
+	high = max(uint64(math.Ceil(total/max(mean-z95*se, 1)*ratio)), est)
+	return est, min(low, est), high
+}
+
 //
 // listRemote
 //
@@ -326,3 +475,61 @@ func (r *XactNsumm) runCloudBck(bck *meta.Bck, res *cmn.BsummResult) {
 		}
 	}
 }
+
+// group-by key (see apc.BsummGroup* enum)
+func (r *XactNsumm) groupKey(lom *core.LOM) string {
+	switch r.p.msg.GroupBy {
+	case apc.BsummGroupExt:
+		return groupExt(lom.ObjName)
+	case apc.BsummGroupCtype:
+		ctype, ok := lom.GetCustomKey(cos.HdrContentType)
+		if !ok || ctype == "" {
+			ctype = mime.TypeByExtension(path.Ext(lom.ObjName))
+		}
+		if i := strings.IndexByte(ctype, ';'); i >= 0 {
+			ctype = strings.TrimSpace(ctype[:i])
+		}
+		if ctype == "" {
+			return apc.BsummGroupUnknown
+		}
+		return ctype
+	default:
+		debug.Assert(r.p.msg.GroupBy == apc.BsummGroupPrefix, r.p.msg.GroupBy)
+		rel := lom.ObjName[len(r.pbase):]
+		if i := strings.IndexByte(rel, '/'); i >= 0 {
+			return r.pbase + rel[:i+1]
+		}
+		return r.pbase // objects that have no (virtual) directory below the prefix
+	}
+}
+
+// lowercase extension, with multi-part archive extensions (e.g. ".tar.gz") taking precedence
+func groupExt(objName string) string {
+	name := strings.ToLower(path.Base(objName))
+	for _, ext := range archive.FileExtensions {
+		if strings.HasSuffix(name, ext) && len(name) > len(ext) {
+			return ext
+		}
+	}
+	ext := path.Ext(name)
+	if ext == "" || ext == name {
+		return apc.BsummGroupNone
+	}
+	return ext
+}
+
+func (r *XactNsumm) groupBy(res *cmn.BsummResult, key string, size int64, isCopy bool) {
+	r.mu.Lock()
+	v, ok := res.Groups[key]
+	if !ok && len(res.Groups) >= apc.MaxBsummGroups {
+		res.GroupsTrunc = true
+		r.mu.Unlock()
+		return
+	}
+	if !isCopy {
+		v.Count++
+	}
+	v.Size += uint64(size)
+	res.Groups[key] = v
+	r.mu.Unlock()
+}
@@ -0,0 +1,108 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestNsummByPrefix(t *testing.T) {
+	r := &XactNsumm{p: &nsummFactory{msg: &apc.BsummCtrlMsg{Prefix: "data/im", PrefixDepth: 2}}, pbase: "data/"}
+	res := &cmn.BsummResult{}
+	res.ByPrefix = make(map[string]apc.BsummGroup)
+
+	r.byPrefix(res, "data/img.tar", 1, false)         // top level: totals only
+	r.byPrefix(res, "data/images/a.jpg", 10, false)   // depth 1
+	r.byPrefix(res, "data/images/x/b.jpg", 20, false) // depth 2
+	r.byPrefix(res, "data/images/x/y/c.jpg", 40, false)
+	r.byPrefix(res, "data/images/x/y/c.jpg", 40, true) // copy: size only
+
+	expected := map[string]apc.BsummGroup{
+		"data/images/":   {Count: 3, Size: 110},
+		"data/images/x/": {Count: 2, Size: 100},
+	}
+	tassert.Fatalf(t, len(res.ByPrefix) == len(expected), "expected %v, got %v", expected, res.ByPrefix)
+	for p, v := range expected {
+		tassert.Errorf(t, res.ByPrefix[p] == v, "%q: expected %+v, got %+v", p, v, res.ByPrefix[p])
+	}
+
+	// aggregation across targets must not modify the original (per-target) results
+	var all cmn.AllBsummResults
+	all = all.Aggregate(&cmn.BsummResult{BsummResult: res.BsummResult})
+	all = all.Aggregate(&cmn.BsummResult{BsummResult: res.BsummResult})
+	tassert.Fatalf(t, len(all) == 1, "expected a single (aggregated) result, got %d", len(all))
+	tassert.Errorf(t, all[0].ByPrefix["data/images/"].Count == 6, "expected 6, got %d", all[0].ByPrefix["data/images/"].Count)
+	tassert.Errorf(t, res.ByPrefix["data/images/"].Count == 3, "original modified: %+v", res.ByPrefix)
+}
+
+func TestNsummGroupExt(t *testing.T) {
+	tests := map[string]string{
+		"a/b/img.JPG":        ".jpg",
+		"shard-000.tar.gz":   ".tar.gz",
+		"shard-001.tgz":      ".tgz",
+		"x/README":           apc.BsummGroupNone,
+		"x.y/README":         apc.BsummGroupNone,
+		".hidden":            apc.BsummGroupNone,
+		"x/.tar.gz":          ".gz",
+		"data/train.parquet": ".parquet",
+	}
+	for name, ext := range tests {
+		tassert.Errorf(t, groupExt(name) == ext, "%q: expected %q, got %q", name, ext, groupExt(name))
+	}
+}
+
+func TestNsummGroupBy(t *testing.T) {
+	r := &XactNsumm{p: &nsummFactory{msg: &apc.BsummCtrlMsg{GroupBy: apc.BsummGroupExt}}}
+	res := &cmn.BsummResult{}
+	res.Groups = make(map[string]apc.BsummGroup)
+
+	r.groupBy(res, ".jpg", 10, false)
+	r.groupBy(res, ".jpg", 20, false)
+	r.groupBy(res, ".jpg", 20, true) // copy: size only
+	r.groupBy(res, ".tar", 100, false)
+
+	tassert.Errorf(t, res.Groups[".jpg"] == apc.BsummGroup{Count: 2, Size: 50}, "got %+v", res.Groups[".jpg"])
+	tassert.Errorf(t, res.Groups[".tar"] == apc.BsummGroup{Count: 1, Size: 100}, "got %+v", res.Groups[".tar"])
+
+	var all cmn.AllBsummResults
+	all = all.Aggregate(&cmn.BsummResult{BsummResult: res.BsummResult})
+	all = all.Aggregate(&cmn.BsummResult{BsummResult: res.BsummResult})
+	tassert.Errorf(t, all[0].Groups[".tar"].Size == 200, "expected 200, got %d", all[0].Groups[".tar"].Size)
+	tassert.Errorf(t, res.Groups[".tar"].Size == 100, "original modified: %+v", res.Groups)
+	tassert.Errorf(t, !all[0].GroupsTrunc, "not expecting truncated groups")
+}
+
+func TestNsummEstimate(t *testing.T) {
+	// 100 sampled objects, 1KiB and 3KiB (half and half), no copies
+	s := &nsummSample{n: 100, sum: 50*1024 + 50*3*1024, sumSq: 50*1024*1024 + 50*9*1024*1024}
+	est, low, high := estimateCount(100_000*2*1024, s, 100)
+	tassert.Errorf(t, est == 100_000, "expected 100000, got %d", est)
+	tassert.Errorf(t, low < est && est < high, "expected %d < %d < %d", low, est, high)
+	tassert.Errorf(t, low > 80_000 && high < 125_000, "bounds too wide: [%d, %d]", low, high)
+
+	// same sizes but every other sampled file is a copy
+	est, _, _ = estimateCount(100_000*2*1024, s, 50)
+	tassert.Errorf(t, est == 50_000, "expected 50000, got %d", est)
+
+	// nothing to extrapolate from
+	est, low, high = estimateCount(0, s, 100)
+	tassert.Errorf(t, est == 100 && low == 100 && high == 100, "expected 100 (exact), got %d [%d, %d]", est, low, high)
+
+	// aggregation across targets sums up estimates and bounds
+	var all cmn.AllBsummResults
+	for _, e := range []*apc.BsummEstimate{{Sampled: 10, CountLow: 90, CountHigh: 110}, {Sampled: 10, CountLow: 5, CountHigh: 5, Exact: true}} {
+		res := &cmn.BsummResult{}
+		res.Estimate = e
+		all = all.Aggregate(res)
+	}
+	tassert.Fatalf(t, len(all) == 1 && all[0].Estimate != nil, "expected a single estimated result")
+	e := all[0].Estimate
+	tassert.Errorf(t, e.Sampled == 20 && e.CountLow == 95 && e.CountHigh == 115 && !e.Exact, "got %+v", e)
+}
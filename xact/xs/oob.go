@@ -0,0 +1,299 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Out-of-band change detection (apc.ActCheckOOB): each target
+// - walks the (remote) bucket's objects that it stores locally;
+// - lists the remote backend, page by page, and compares each locally stored object with its
+//   remote counterpart (see lom.Equal);
+// - objects that are no longer listed remotely are considered deleted out-of-band;
+// - depending on apc.CheckOOBMsg.Action, stale objects get reported, marked (cmn.StaleObjMD),
+//   or evicted (pinned objects, see cmn.PinnedObjMD, are reported but never marked or evicted).
+// Otherwise, staleness gets discovered only upon access (and only with 'versioning.validate_warm_get').
+
+const oobMaxRecent = 32 // max number of stale object names in the (per-target) report, see apc.CheckOOBStats
+
+type (
+	oobFactory struct {
+		xreg.RenewBase
+		xctn *XactCheckOOB
+		msg  *apc.CheckOOBMsg
+	}
+	XactCheckOOB struct {
+		msg     *apc.CheckOOBMsg
+		local   map[string]struct{} // names of the locally stored objects yet to be found remotely
+		stats   oobCounters
+		recentM sync.Mutex
+		recent  []string
+		xact.Base
+	}
+	oobCounters struct {
+		scanned atomic.Int64
+		changed atomic.Int64
+		deleted atomic.Int64
+		marked  atomic.Int64
+		evicted atomic.Int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactCheckOOB)(nil)
+	_ xreg.Renewable = (*oobFactory)(nil)
+)
+
+////////////////
+// oobFactory //
+////////////////
+
+func (*oobFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.CheckOOBMsg)
+	return &oobFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+}
+
+func (p *oobFactory) Start() error {
+	if !p.Bck.IsRemote() {
+		return cmn.NewErrUnsupp("check out-of-band changes in", p.Bck.Cname(""))
+	}
+	r := &XactCheckOOB{msg: p.msg, local: make(map[string]struct{}, 1024)}
+	r.InitBase(p.Args.UUID, p.Kind(), p.Bck)
+	p.xctn = r
+	return nil
+}
+
+func (*oobFactory) Kind() string     { return apc.ActCheckOOB }
+func (p *oobFactory) Get() core.Xact { return p.xctn }
+
+func (*oobFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+//////////////////
+// XactCheckOOB //
+//////////////////
+
+func (r *XactCheckOOB) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(r.Name(), "started:", r.Bck().Cname(r.msg.Prefix), r.msg.Action)
+
+	if err := r.walk(); err != nil {
+		r.AddErr(err)
+		r.Finish()
+		return
+	}
+	r.stats.scanned.Store(int64(len(r.local)))
+	if len(r.local) == 0 {
+		r.Finish() // nothing cached
+		return
+	}
+	if err := r.list(); err != nil {
+		r.AddErr(err)
+		r.Finish()
+		return
+	}
+	// whatever remains was not listed remotely
+	for objName := range r.local {
+		if r.IsAborted() {
+			break
+		}
+		r.stats.deleted.Inc()
+		r.stale(objName, true /*deleted*/)
+	}
+	r.Finish()
+}
+
+// collect the names of the (HRW) objects this target stores
+func (r *XactCheckOOB) walk() error {
+	var (
+		bck  = r.Bck()
+		opts = &fs.WalkBckOpts{
+			WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Sorted: true},
+		}
+	)
+	opts.WalkOpts.Bck.Copy(bck.Bucket())
+	opts.Callback = func(fqn string, _ fs.DirEntry) error {
+		if r.IsAborted() {
+			return r.AbortErr()
+		}
+		lom := &core.LOM{}
+		if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+			return nil // (not an object)
+		}
+		if lom.IsHRW() && cmn.ObjHasPrefix(lom.ObjName, r.msg.Prefix) {
+			r.local[lom.ObjName] = struct{}{}
+		}
+		return nil
+	}
+	return fs.WalkBck(opts)
+}
+
+// list remote and compare
+func (r *XactCheckOOB) list() error {
+	var (
+		bck = r.Bck()
+		msg = &apc.LsoMsg{Prefix: r.msg.Prefix}
+	)
+	msg.AddProps(apc.GetPropsDefaultCloud...)
+	msg.SetFlag(apc.LsWantOnlyRemoteProps)
+	for {
+		if r.IsAborted() {
+			return nil
+		}
+		lst := &cmn.LsoResult{Entries: allocLsoEntries()}
+		errCode, err := core.T.Backend(bck).ListObjects(bck, msg, lst)
+		if err != nil {
+			freeLsoEntries(lst.Entries)
+			if errCode == http.StatusNotFound && !cos.IsNotExist(err, 0) {
+				err = cos.NewErrNotFound(nil, err.Error())
+			}
+			return err
+		}
+		for _, e := range lst.Entries {
+			if _, ok := r.local[e.Name]; !ok {
+				continue
+			}
+			delete(r.local, e.Name)
+			if r.changed(e) {
+				r.stats.changed.Inc()
+				r.stale(e.Name, false /*deleted*/)
+			}
+		}
+		freeLsoEntries(lst.Entries)
+		// last page listed
+		if lst.ContinuationToken == "" {
+			return nil
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+// compare with the remote (listed) attributes (compare with `setWanted` and `checkRemoteMD`)
+func (r *XactCheckOOB) changed(e *cmn.LsoEntry) bool {
+	lom := core.AllocLOM(e.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return false
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			r.AddErr(err, 5, cos.SmoduleXs)
+		}
+		return false // (e.g., evicted in the meantime)
+	}
+	r.ObjsAdd(1, lom.SizeBytes())
+	if e.Size > 0 && lom.SizeBytes() != e.Size {
+		return true
+	}
+	md := cmn.S2CustomMD(e.Custom, e.Version)
+	if len(md) == 0 {
+		return false // nothing to compare
+	}
+	var oa cmn.ObjAttrs
+	oa.CustomMD = md
+	oa.Size = e.Size
+	return !lom.Equal(&oa)
+}
+
+func (r *XactCheckOOB) stale(objName string, deleted bool) {
+	r.recentM.Lock()
+	if len(r.recent) < oobMaxRecent {
+		r.recent = append(r.recent, objName)
+	}
+	r.recentM.Unlock()
+	if cmn.Rom.FastV(4, cos.SmoduleXs) {
+		nlog.Infoln(r.Name(), "stale:", objName, "deleted:", deleted)
+	}
+
+	switch {
+	case r.msg.Action == apc.OOBReport:
+	case r.msg.Action == apc.OOBEvict || deleted: // (nothing to re-fetch)
+		r.evict(objName)
+	default:
+		r.mark(objName)
+	}
+}
+
+func (r *XactCheckOOB) evict(objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err == nil && lom.IsPinned() {
+		return
+	}
+	errCode, err := core.T.DeleteObject(lom, true /*evict*/)
+	switch {
+	case err == nil:
+		r.stats.evicted.Inc()
+	case !cos.IsNotExist(err, errCode):
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+}
+
+func (r *XactCheckOOB) mark(objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			r.AddErr(err, 5, cos.SmoduleXs)
+		}
+		return
+	}
+	if lom.IsPinned() {
+		return
+	}
+	lom.SetCustomKey(cmn.StaleObjMD, r.ID())
+	if err := lom.Persist(); err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return
+	}
+	r.stats.marked.Inc()
+}
+
+func (r *XactCheckOOB) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	ext := &apc.CheckOOBStats{
+		Action:  r.msg.Action,
+		Scanned: r.stats.scanned.Load(),
+		Changed: r.stats.changed.Load(),
+		Deleted: r.stats.deleted.Load(),
+		Marked:  r.stats.marked.Load(),
+		Evicted: r.stats.evicted.Load(),
+	}
+	r.recentM.Lock()
+	ext.Recent = append([]string(nil), r.recent...)
+	r.recentM.Unlock()
+	snap.Ext = ext
+	return
+}
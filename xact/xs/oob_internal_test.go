@@ -0,0 +1,189 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+const oobPageSize = 2 // to list remote bucket in multiple pages
+
+type (
+	// remote bucket: lists (name, size, version, ETag) in pages
+	oobBackend struct {
+		core.BackendProvider
+		objs []cmn.LsoEntry
+	}
+	oobTarget struct {
+		*mock.TargetMock
+		be *oobBackend
+	}
+	oobObj struct {
+		name, content string
+		pinned        bool
+	}
+)
+
+func (t *oobTarget) Backend(*meta.Bck) core.BackendProvider { return t.be }
+
+// evict
+func (*oobTarget) DeleteObject(lom *core.LOM, _ bool) (int, error) {
+	return 0, os.Remove(lom.FQN)
+}
+
+func (be *oobBackend) ListObjects(_ *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	var start int
+	if msg.ContinuationToken != "" {
+		start, _ = strconv.Atoi(msg.ContinuationToken)
+	}
+	end := min(start+oobPageSize, len(be.objs))
+	for i := start; i < end; i++ {
+		e := be.objs[i]
+		lst.Entries = append(lst.Entries, &e)
+	}
+	if end < len(be.objs) {
+		lst.ContinuationToken = strconv.Itoa(end)
+	}
+	return 0, nil
+}
+
+// locally stored (cached) objects and their remote counterparts (some of them changed, some deleted)
+func testOOBInit(t *testing.T) (*meta.Bck, *oobBackend) {
+	var (
+		bck = testBck("oob", apc.AWS)
+		be  = &oobBackend{}
+	)
+	core.T = &oobTarget{TargetMock: testInit(t, bck), be: be}
+
+	cached := []oobObj{
+		{name: "dir/same", content: "same"},
+		{name: "dir/changed-version", content: "changed-version"},
+		{name: "dir/changed-size", content: "changed-size"},
+		{name: "dir/deleted", content: "deleted"},
+		{name: "dir/deleted-pinned", content: "deleted-pinned", pinned: true},
+		{name: "other/deleted", content: "outside of the prefix"},
+	}
+	for _, o := range cached {
+		testPutObj(t, bck.Bucket(), o.name, o.content, "", time.Now().UnixNano())
+		lom := core.AllocLOM(o.name)
+		tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+		tassert.CheckFatal(t, lom.Load(false, false))
+		lom.SetCustomKey(cmn.VersionObjMD, "v1")
+		lom.SetCustomKey(cmn.ETag, "etag-"+o.content)
+		if o.pinned {
+			lom.SetCustomKey(cmn.PinnedObjMD, "")
+		}
+		tassert.CheckFatal(t, lom.Persist())
+		lom.Uncache()
+		core.FreeLOM(lom)
+	}
+
+	remote := func(name, content, ver string) cmn.LsoEntry {
+		return cmn.LsoEntry{Name: name, Size: int64(len(content)), Version: ver,
+			Custom: cmn.CustomMD2S(cos.StrKVs{cmn.ETag: "etag-" + content})}
+	}
+	be.objs = []cmn.LsoEntry{
+		remote("dir/changed-size", "changed-size+appended", "v1"),
+		remote("dir/changed-version", "changed-version", "v2"),
+		remote("dir/not-cached", "not-cached", "v1"),
+		remote("dir/same", "same", "v1"),
+	}
+	return bck, be
+}
+
+func testOOBRun(t *testing.T, bck *meta.Bck, action string) *apc.CheckOOBStats {
+	msg := &apc.CheckOOBMsg{Prefix: "dir/", Action: action}
+	tassert.CheckFatal(t, msg.Validate())
+	r := testRun(t, &oobFactory{}, bck, msg).(*XactCheckOOB)
+	tassert.CheckFatal(t, r.Err())
+	return r.Snap().Ext.(*apc.CheckOOBStats)
+}
+
+func testOOBExists(t *testing.T, bck *meta.Bck, objName string) (exists, stale bool) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	if err := lom.Load(false, false); err != nil {
+		tassert.Fatalf(t, cos.IsNotExist(err, 0), "%s: %v", objName, err)
+		return false, false
+	}
+	_, stale = lom.GetCustomKey(cmn.StaleObjMD)
+	return true, stale
+}
+
+func TestCheckOOB(t *testing.T) {
+	var (
+		stale = []string{"dir/changed-size", "dir/changed-version", "dir/deleted", "dir/deleted-pinned"}
+		// exists, marked stale - per action
+		expected = map[string]map[string][2]bool{
+			apc.OOBReport: {
+				"dir/same": {true, false}, "dir/changed-size": {true, false}, "dir/changed-version": {true, false},
+				"dir/deleted": {true, false}, "dir/deleted-pinned": {true, false}, "other/deleted": {true, false},
+			},
+			apc.OOBMark: {
+				"dir/same": {true, false}, "dir/changed-size": {true, true}, "dir/changed-version": {true, true},
+				"dir/deleted": {false, false}, "dir/deleted-pinned": {true, false}, "other/deleted": {true, false},
+			},
+			apc.OOBEvict: {
+				"dir/same": {true, false}, "dir/changed-size": {false, false}, "dir/changed-version": {false, false},
+				"dir/deleted": {false, false}, "dir/deleted-pinned": {true, false}, "other/deleted": {true, false},
+			},
+		}
+	)
+	for _, action := range apc.SupportedOOBActions {
+		t.Run(action, func(t *testing.T) {
+			bck, _ := testOOBInit(t)
+			stats := testOOBRun(t, bck, action)
+
+			tassert.Errorf(t, stats.Scanned == 5, "expected 5 scanned, got %d", stats.Scanned)
+			tassert.Errorf(t, stats.Changed == 2 && stats.Deleted == 2, "expected 2 changed and 2 deleted, got %+v", stats)
+			recent := append([]string(nil), stats.Recent...)
+			sort.Strings(recent)
+			tassert.Errorf(t, len(recent) == len(stale), "expected stale %v, got %v", stale, recent)
+			for i := 0; i < min(len(recent), len(stale)); i++ {
+				tassert.Errorf(t, recent[i] == stale[i], "expected stale %v, got %v", stale, recent)
+			}
+			switch action {
+			case apc.OOBReport:
+				tassert.Errorf(t, stats.Marked == 0 && stats.Evicted == 0, "report only: %+v", stats)
+			case apc.OOBMark:
+				tassert.Errorf(t, stats.Marked == 2 && stats.Evicted == 1, "expected 2 marked and 1 evicted: %+v", stats)
+			case apc.OOBEvict:
+				tassert.Errorf(t, stats.Marked == 0 && stats.Evicted == 3, "expected 3 evicted: %+v", stats)
+			}
+			for name, exp := range expected[action] {
+				exists, marked := testOOBExists(t, bck, name)
+				tassert.Errorf(t, exists == exp[0] && marked == exp[1], "%s: expected (exists, stale) = %v, got (%t, %t)",
+					name, exp, exists, marked)
+			}
+		})
+	}
+}
+
+func TestCheckOOBStart(t *testing.T) {
+	bck := meta.NewBck("oob", apc.AIS, cmn.NsGlobal)
+	p := (&oobFactory{}).New(xreg.Args{UUID: cos.GenUUID(), Custom: &apc.CheckOOBMsg{}}, bck)
+	tassert.Errorf(t, p.Start() != nil, "expected ais bucket to be rejected")
+
+	msg := &apc.CheckOOBMsg{}
+	tassert.CheckFatal(t, msg.Validate())
+	tassert.Errorf(t, msg.Action == apc.OOBReport, "expected default action %q, got %q", apc.OOBReport, msg.Action)
+	msg.Action = "delete"
+	tassert.Errorf(t, msg.Validate() != nil, "expected invalid action error")
+}
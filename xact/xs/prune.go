@@ -33,6 +33,7 @@ type prune struct {
 	bckFrom, bckTo *meta.Bck
 	smap           *meta.Smap
 	prefix         string
+	pt             *cos.ParsedTemplate // when not nil, prune only the matching names
 	// run
 	joggers *mpather.Jgroup
 	filter  *prob.Filter
@@ -93,6 +94,9 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 		_, local, err := dst.HrwTarget(rp.smap)
 		debug.Assertf(local, "local %t, err: %v", local, err)
 	})
+	if rp.pt != nil && !rp.pt.Match(dst.ObjName) {
+		return nil
+	}
 	// construct src lom
 	var src *core.LOM
 	if rp.same {
@@ -0,0 +1,582 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Asynchronous replication of a bucket to a bucket in attached remote ais cluster (see cmn.ReplConf):
+// - PUTs and DELETEs of the locally stored objects get queued (bounded) and applied, in order,
+//   by a single worker;
+// - when the remote is unreachable (or the queue overflows) pending changes are dropped and
+//   the xaction transitions to "resync" state;
+// - upon reconnect, resync (reconcile) replicates all local objects that differ from
+//   their remote counterparts and removes remote objects that do not exist locally
+//   (any longer);
+// - resync can also be started explicitly, via api.StartXaction(apc.ActReplicate).
+//
+// Bi-directional mode (active-active, with each of the two buckets replicating to the other):
+// - before overwriting, the remote counterpart is checked; identical content is never
+//   (re)sent, which also prevents changes from bouncing back and forth;
+// - the checksum of the content last known to be identical on both sides is stored
+//   in the object's custom metadata (cmn.ReplBaseObjMD); when both sides have changed
+//   since, it is a conflict resolved according to the configured policy
+//   (apc.ReplConflictNewer or apc.ReplConflictReject);
+// - resync does not remove remote objects: the ones that do not exist locally are
+//   expected to be replicated by the remote side;
+// - recent conflicts are reported via ReplStats (see Snap.Ext).
+
+const (
+	replRetryInterval = 10 * time.Second
+	replMaxConflicts  = 64 // max number of recent conflicts to report
+)
+
+// conflict resolution outcome
+const (
+	ReplOverwritten = "overwritten" // local version won
+	ReplKeptRemote  = "kept-remote" // remote version won
+	ReplRejected    = "rejected"    // both kept as is (apc.ReplConflictReject)
+)
+
+type (
+	replFactory struct {
+		xreg.RenewBase
+		xctn *XactRepl
+	}
+	replOp struct {
+		name   string
+		queued int64 // mono.NanoTime
+		del    bool
+	}
+	XactRepl struct {
+		xact.DemandBase
+		dst       *meta.Bck
+		workCh    chan replOp
+		resyncCh  chan struct{}
+		conflict  string // conflict resolution policy (bidirectional only)
+		conflicts struct {
+			recent []ReplConflict // most recent first
+			mu     sync.Mutex
+		}
+		resync    atomic.Bool  // waiting to reconcile
+		lag       atomic.Int64 // time in queue of the most recently replicated change
+		dropped   atomic.Int64 // changes dropped while (or because of) being out of sync
+		resyncs   atomic.Int64 // number of times transitioned to "resync" state
+		nconflict atomic.Int64 // total number of detected conflicts
+		bidir     bool
+	}
+	ReplConflict struct {
+		ObjName    string `json:"name"`
+		Resolution string `json:"resolution"` // one of the Repl* outcomes (above)
+		Local      string `json:"local"`      // local checksum and version
+		Remote     string `json:"remote"`     // ditto remote
+		Time       int64  `json:"time"`       // when detected (nanoseconds since UNIX epoch)
+	}
+	// ext. stats (see Snap.Ext)
+	ReplStats struct {
+		Dst       cmn.Bck        `json:"dst"`
+		Recent    []ReplConflict `json:"recent_conflicts,omitempty"`
+		Pending   int            `json:"pending"`
+		Lag       time.Duration  `json:"lag"`
+		Dropped   int64          `json:"dropped"`
+		Resyncs   int64          `json:"resyncs"`
+		Conflicts int64          `json:"conflicts"`
+		Resync    bool           `json:"resync"`
+		Bidir     bool           `json:"bidirectional"`
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactRepl)(nil)
+	_ xreg.Renewable = (*replFactory)(nil)
+)
+
+/////////////////
+// replFactory //
+/////////////////
+
+func (*replFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &replFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *replFactory) Start() error {
+	conf := &p.Bck.Props.Replication
+	if !conf.Enabled {
+		return fmt.Errorf("%s: replication disabled, nothing to do", p.Bck)
+	}
+	dstBck, err := conf.DstBck()
+	if err != nil {
+		return err
+	}
+	dst := meta.CloneBck(&dstBck)
+	if err := dst.InitFast(core.T.Bowner()); err != nil {
+		return err
+	}
+	r := &XactRepl{
+		dst:      dst,
+		workCh:   make(chan replOp, conf.QueueSize),
+		resyncCh: make(chan struct{}, 1),
+		conflict: conf.Conflict,
+		bidir:    conf.Bidir,
+	}
+	div := uint64(xact.IdleDefault)
+	beid, _, _ := xreg.GenBEID(div, p.Kind()+"|"+p.Bck.MakeUname(""))
+	if beid == "" {
+		beid = cos.GenUUID()
+	}
+	r.DemandBase.Init(beid, p.Kind(), p.Bck, xact.IdleDefault)
+	p.xctn = r
+
+	go r.Run(nil)
+	return nil
+}
+
+func (*replFactory) Kind() string     { return apc.ActReplicate }
+func (p *replFactory) Get() core.Xact { return p.xctn }
+
+func (p *replFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
+	debug.Assertf(false, "%s vs %s", p.Str(p.Kind()), xprev) // xreg.usePrev() must've returned true
+	return xreg.WprUse, nil
+}
+
+//////////////
+// XactRepl //
+//////////////
+
+func (r *XactRepl) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "=>", r.dst.Cname(""))
+	ticker := time.NewTicker(replRetryInterval)
+loop:
+	for {
+		select {
+		case op := <-r.workCh:
+			r.apply(&op)
+			r.DecPending()
+		case <-r.resyncCh:
+			r.reconcile()
+		case <-ticker.C:
+			if r.resync.Load() {
+				r.reconcile()
+			}
+		case <-r.IdleTimer():
+			break loop
+		case <-r.ChanAbort():
+			break loop
+		}
+	}
+	ticker.Stop()
+	r.DemandBase.Stop()
+
+	// drain
+	var n int
+	for {
+		select {
+		case <-r.workCh:
+			n++
+			continue
+		default:
+		}
+		break
+	}
+	if r.resync.Load() {
+		n++ // (see setResync)
+	}
+	if n > 0 {
+		r.SubPending(n)
+	}
+	r.Finish()
+}
+
+// main method: queue a change (PUT or DELETE) of a locally stored object
+func (r *XactRepl) Repl(objName string, del bool) {
+	r.IncPending()
+	select {
+	case r.workCh <- replOp{name: objName, queued: mono.NanoTime(), del: del}:
+	default:
+		r.DecPending()
+		r.dropped.Inc()
+		r.setResync("queue is full")
+	}
+}
+
+// explicit request to reconcile (e.g., via api.StartXaction)
+func (r *XactRepl) Resync() {
+	r.setResync("user request")
+	select {
+	case r.resyncCh <- struct{}{}:
+	default:
+	}
+}
+
+func (r *XactRepl) setResync(reason string) {
+	if r.resync.CAS(false, true) {
+		r.IncPending() // keep it running until reconciled
+		r.resyncs.Inc()
+		nlog.Warningln(r.Name(), "=>", r.dst.Cname(""), "out of sync:", reason)
+	}
+}
+
+func (r *XactRepl) apply(op *replOp) {
+	if r.resync.Load() {
+		r.dropped.Inc() // will be taken care of by the resync
+		return
+	}
+	var err error
+	if op.del {
+		err = r.del(op.name)
+	} else {
+		err = r.put(op.name, nil)
+	}
+	if err == nil {
+		r.lag.Store(mono.Since(op.queued).Nanoseconds())
+		return
+	}
+	r.AddErr(err, 5, cos.SmoduleXs)
+	r.setResync(err.Error())
+}
+
+// lom is nil when called by the worker; otherwise, it is loaded and rlocked (resync)
+func (r *XactRepl) put(objName string, lom *core.LOM) error {
+	if lom == nil {
+		lom = core.AllocLOM(objName)
+		defer core.FreeLOM(lom)
+		if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+			return err
+		}
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			if cos.IsNotExist(err, 0) {
+				return nil // deleted in the meantime (the deletion, if any, is queued)
+			}
+			return err
+		}
+	}
+	dst := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(r.dst.Bucket()); err != nil {
+		return err
+	}
+	if r.bidir {
+		send, err := r.resolve(lom, dst)
+		if err != nil || !send {
+			return err
+		}
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		return err
+	}
+	dst.CopyAttrs(lom.ObjAttrs(), false /*skip cksum*/)
+	if _, err := core.T.Backend(r.dst).PutObj(fh, dst); err != nil {
+		return err
+	}
+	r.ObjsAdd(1, lom.SizeBytes())
+	if r.bidir {
+		r.setBase(lom)
+	}
+	return nil
+}
+
+//
+// bidirectional
+//
+
+// compare with the remote counterpart and decide whether to send (overwrite) it
+func (r *XactRepl) resolve(lom, dst *core.LOM) (send bool, _ error) {
+	oa, errCode, err := core.T.Backend(r.dst).HeadObj(context.Background(), dst)
+	if err != nil {
+		if cos.IsNotExist(err, errCode) {
+			return true, nil
+		}
+		return false, err
+	}
+	if sameContent(lom, oa) {
+		r.setBase(lom) // (e.g., the change that has just arrived from the remote side)
+		return false, nil
+	}
+	base, _ := lom.GetCustomKey(cmn.ReplBaseObjMD)
+	switch {
+	case base != "" && oa.Cksum.Value() == base:
+		return true, nil // remote hasn't changed since the last sync
+	case base != "" && lom.Checksum().Value() == base:
+		return false, nil // local hasn't changed - the remote change is yet to arrive
+	}
+
+	// both have changed (or never synced)
+	var resolution string
+	switch {
+	case r.conflict == apc.ReplConflictReject:
+		resolution = ReplRejected
+	case localIsNewer(lom, oa):
+		resolution, send = ReplOverwritten, true
+	default:
+		resolution = ReplKeptRemote
+	}
+	r.addConflict(lom, oa, resolution)
+	return send, nil
+}
+
+// last writer wins: higher (ais) version, more recent write, and finally
+// (to make sure that both sides reach the same decision) greater checksum
+func localIsNewer(lom *core.LOM, oa *cmn.ObjAttrs) bool {
+	lv, errl := strconv.ParseInt(lom.Version(), 10, 64)
+	rv, errr := strconv.ParseInt(oa.Ver, 10, 64)
+	if errl == nil && errr == nil && lv != rv {
+		return lv > rv
+	}
+	if la, ra := lom.AtimeUnix(), oa.Atime; la != ra {
+		return la > ra
+	}
+	return lom.Checksum().Value() > oa.Cksum.Value()
+}
+
+func sameContent(lom *core.LOM, oa *cmn.ObjAttrs) bool {
+	if oa.Size != lom.SizeBytes() {
+		return false
+	}
+	a, b := oa.Cksum, lom.Checksum()
+	return a == nil || b == nil || a.Ty() != b.Ty() || a.Equal(b)
+}
+
+// store the checksum of the content that is now identical on both sides
+// (lom is rlocked by the caller)
+func (r *XactRepl) setBase(lom *core.LOM) {
+	cksum := lom.Checksum()
+	if cksum == nil {
+		return
+	}
+	if base, _ := lom.GetCustomKey(cmn.ReplBaseObjMD); base == cksum.Val() {
+		return
+	}
+	if lom.UpgradeLock() {
+		return // upgraded (and possibly modified) by another goroutine - next time
+	}
+	lom.SetCustomKey(cmn.ReplBaseObjMD, cksum.Val())
+	if err := lom.Persist(); err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	lom.DowngradeLock()
+}
+
+func (r *XactRepl) addConflict(lom *core.LOM, oa *cmn.ObjAttrs, resolution string) {
+	r.nconflict.Inc()
+	c := ReplConflict{
+		ObjName:    lom.ObjName,
+		Resolution: resolution,
+		Local:      lom.Checksum().String() + ", v" + lom.Version(),
+		Remote:     oa.Cksum.String() + ", v" + oa.Ver,
+		Time:       time.Now().UnixNano(),
+	}
+	nlog.Warningln(r.Name(), "conflict:", c.ObjName, "[", c.Local, "vs", c.Remote, "] =>", c.Resolution)
+
+	r.conflicts.mu.Lock()
+	l := len(r.conflicts.recent)
+	if l < replMaxConflicts {
+		r.conflicts.recent = append(r.conflicts.recent, ReplConflict{})
+	} else {
+		l--
+	}
+	copy(r.conflicts.recent[1:], r.conflicts.recent[:l])
+	r.conflicts.recent[0] = c
+	r.conflicts.mu.Unlock()
+}
+
+func (r *XactRepl) del(objName string) error {
+	dst := core.AllocLOM(objName)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(r.dst.Bucket()); err != nil {
+		return err
+	}
+	if errCode, err := core.T.Backend(r.dst).DeleteObj(dst); err != nil && !cos.IsNotExist(err, errCode) {
+		return err
+	}
+	r.ObjsAdd(1, 0)
+	return nil
+}
+
+//
+// resync
+//
+
+func (r *XactRepl) reconcile() {
+	// remote must be reachable
+	if _, _, err := core.T.Backend(r.dst).HeadBucket(context.Background(), r.dst); err != nil {
+		if cmn.Rom.FastV(4, cos.SmoduleXs) {
+			nlog.Infoln(r.Name(), "=>", r.dst.Cname(""), "still unreachable:", err)
+		}
+		return
+	}
+	nlog.Infoln(r.Name(), "=>", r.dst.Cname(""), "reconciling...")
+	started := mono.NanoTime()
+
+	// (replicated changes that are still in the queue are going to be dropped, see apply())
+	if err := r.reconcileLocal(); err != nil {
+		r.AddErr(err)
+		return
+	}
+	if !r.bidir {
+		if err := r.reconcileRemote(); err != nil {
+			r.AddErr(err)
+			return
+		}
+	}
+	if r.resync.CAS(true, false) {
+		r.DecPending()
+	}
+	nlog.Infoln(r.Name(), "=>", r.dst.Cname(""), "reconciled in", mono.Since(started))
+}
+
+// local => remote: (re)replicate objects that are missing or differ
+func (r *XactRepl) reconcileLocal() error {
+	var (
+		errs []error
+		mu   sync.Mutex // (one jogger per mountpath)
+		opts = &mpather.JgroupOpts{
+			CTs: []string{fs.ObjectType},
+			VisitObj: func(lom *core.LOM, _ []byte) error {
+				if r.IsAborted() {
+					return r.AbortErr()
+				}
+				err := r._reconcileObj(lom)
+				if err == nil {
+					return nil
+				}
+				mu.Lock()
+				errs = append(errs, err)
+				if len(errs) > 8 {
+					err = fmt.Errorf("%s: too many errors: %v", r, errors.Join(errs...))
+				} else {
+					err = nil
+				}
+				mu.Unlock()
+				return err
+			},
+			DoLoad:   mpather.Load,
+			Parallel: 1,
+		}
+	)
+	opts.Bck.Copy(r.Bck().Bucket())
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+	<-jg.ListenFinished()
+	if err := jg.Stop(); err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+func (r *XactRepl) _reconcileObj(lom *core.LOM) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	dst := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(r.dst.Bucket()); err != nil {
+		return err
+	}
+	if r.bidir {
+		return r.put(lom.ObjName, lom) // (compares with the remote counterpart)
+	}
+	oa, errCode, err := core.T.Backend(r.dst).HeadObj(context.Background(), dst)
+	if err == nil && sameContent(lom, oa) {
+		return nil // same
+	}
+	if err != nil && !cos.IsNotExist(err, errCode) {
+		return err
+	}
+	return r.put(lom.ObjName, lom)
+}
+
+// remote => local: remove remote objects that do not exist locally
+// (each target removes only those objects that it would own)
+func (r *XactRepl) reconcileRemote() error {
+	var (
+		backend = core.T.Backend(r.dst)
+		smap    = core.T.Sowner().Get()
+		msg     = &apc.LsoMsg{}
+	)
+	msg.SetFlag(apc.LsNameOnly)
+	for {
+		lst := &cmn.LsoResult{}
+		if _, err := backend.ListObjects(r.dst, msg, lst); err != nil {
+			return err
+		}
+		for _, en := range lst.Entries {
+			if r.IsAborted() {
+				return r.AbortErr()
+			}
+			if err := r._reconcileRemote(en.Name, smap); err != nil {
+				return err
+			}
+		}
+		if lst.ContinuationToken == "" {
+			return nil
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+func (r *XactRepl) _reconcileRemote(objName string, smap *meta.Smap) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		return err
+	}
+	if _, local, err := lom.HrwTarget(smap); err != nil || !local {
+		return err
+	}
+	err := lom.Load(false /*cache it*/, false /*locked*/)
+	if err == nil || !cos.IsNotExist(err, 0) {
+		return nil
+	}
+	return r.del(objName)
+}
+
+func (r *XactRepl) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	snap.DstBck = r.dst.Clone()
+	ext := &ReplStats{
+		Dst:       r.dst.Clone(),
+		Pending:   len(r.workCh),
+		Lag:       time.Duration(r.lag.Load()),
+		Dropped:   r.dropped.Load(),
+		Resyncs:   r.resyncs.Load(),
+		Conflicts: r.nconflict.Load(),
+		Resync:    r.resync.Load(),
+		Bidir:     r.bidir,
+	}
+	if r.bidir {
+		r.conflicts.mu.Lock()
+		ext.Recent = append([]ReplConflict(nil), r.conflicts.recent...)
+		r.conflicts.mu.Unlock()
+	}
+	snap.Ext = ext
+	return
+}
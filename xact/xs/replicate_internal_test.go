@@ -0,0 +1,404 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type (
+	// remote (destination) bucket: in-memory, name => attributes
+	replBackend struct {
+		core.BackendProvider // (methods that are not used by XactRepl are not implemented)
+		objs                 map[string]cmn.ObjAttrs
+		puts, dels           int
+		down                 bool
+		mu                   sync.Mutex
+	}
+	replTarget struct {
+		*mock.TargetMock
+		be *replBackend
+	}
+)
+
+func (t *replTarget) Backend(*meta.Bck) core.BackendProvider { return t.be }
+
+var errReplDown = errors.New("remote cluster is unreachable")
+
+func (be *replBackend) HeadBucket(context.Context, *meta.Bck) (cos.StrKVs, int, error) {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	if be.down {
+		return nil, http.StatusServiceUnavailable, errReplDown
+	}
+	return cos.StrKVs{}, 0, nil
+}
+
+func (be *replBackend) HeadObj(_ context.Context, lom *core.LOM) (*cmn.ObjAttrs, int, error) {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	if be.down {
+		return nil, http.StatusServiceUnavailable, errReplDown
+	}
+	oa, ok := be.objs[lom.ObjName]
+	if !ok {
+		return nil, http.StatusNotFound, cos.NewErrNotFound(nil, lom.Cname())
+	}
+	return &oa, 0, nil
+}
+
+func (be *replBackend) PutObj(r io.ReadCloser, lom *core.LOM) (int, error) {
+	b, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return 0, err
+	}
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	if be.down {
+		return http.StatusServiceUnavailable, errReplDown
+	}
+	oa := *lom.ObjAttrs()
+	oa.Size = int64(len(b))
+	be.objs[lom.ObjName] = oa
+	be.puts++
+	return 0, nil
+}
+
+func (be *replBackend) DeleteObj(lom *core.LOM) (int, error) {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	if be.down {
+		return http.StatusServiceUnavailable, errReplDown
+	}
+	if _, ok := be.objs[lom.ObjName]; !ok {
+		return http.StatusNotFound, cos.NewErrNotFound(nil, lom.Cname())
+	}
+	delete(be.objs, lom.ObjName)
+	be.dels++
+	return 0, nil
+}
+
+func (be *replBackend) ListObjects(_ *meta.Bck, _ *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	for name := range be.objs {
+		lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: name})
+	}
+	return 0, nil
+}
+
+// source and destination buckets
+func testReplInit(t *testing.T, conf cmn.ReplConf) (*XactRepl, *replBackend) {
+	var (
+		src = testBck("repl-src", apc.AIS)
+		dst = testBck("repl-dst", apc.AIS)
+		be  = &replBackend{objs: make(map[string]cmn.ObjAttrs)}
+	)
+	src.Props.Replication = conf
+	core.T = &replTarget{TargetMock: testInit(t, src, dst), be: be}
+
+	r := &XactRepl{
+		dst:      dst,
+		workCh:   make(chan replOp, conf.QueueSize),
+		resyncCh: make(chan struct{}, 1),
+		conflict: conf.Conflict,
+		bidir:    conf.Bidir,
+	}
+	r.DemandBase.Init(cos.GenUUID(), apc.ActReplicate, src, time.Minute)
+	return r, be
+}
+
+func testReplObj(t *testing.T, r *XactRepl, objName, content, ver string, atime int64) *cos.Cksum {
+	return testPutObj(t, r.Bck().Bucket(), objName, content, ver, atime)
+}
+
+func TestReplPutDelete(t *testing.T) {
+	r, be := testReplInit(t, cmn.ReplConf{Enabled: true, QueueSize: 8})
+	cksum := testReplObj(t, r, "obj", "content", "1", time.Now().UnixNano())
+
+	tassert.CheckFatal(t, r.put("obj", nil))
+	oa, ok := be.objs["obj"]
+	tassert.Fatalf(t, ok, "expected replicated object")
+	tassert.Errorf(t, oa.Cksum.Equal(cksum) && oa.Size == int64(len("content")), "unexpected remote attrs: %+v", oa)
+
+	// local object that's gone by the time its change gets replicated
+	tassert.CheckFatal(t, r.put("deleted-in-the-meantime", nil))
+	tassert.Errorf(t, be.puts == 1, "expected 1 put, got %d", be.puts)
+
+	tassert.CheckFatal(t, r.del("obj"))
+	_, ok = be.objs["obj"]
+	tassert.Errorf(t, !ok, "expected remote object deleted")
+	tassert.CheckFatal(t, r.del("obj")) // not found is fine
+}
+
+func TestReplQueueOverflow(t *testing.T) {
+	const queueSize = 2
+	r, be := testReplInit(t, cmn.ReplConf{Enabled: true, QueueSize: queueSize})
+	testReplObj(t, r, "obj", "content", "1", time.Now().UnixNano())
+
+	for i := 0; i < queueSize+1; i++ {
+		r.Repl("obj", false)
+	}
+	tassert.Errorf(t, r.dropped.Load() == 1, "expected 1 dropped, got %d", r.dropped.Load())
+	tassert.Fatalf(t, r.resync.Load(), "expected resync state")
+	tassert.Errorf(t, r.resyncs.Load() == 1, "expected 1 resync, got %d", r.resyncs.Load())
+
+	// queued changes are dropped while out of sync (to be taken care of by the resync)
+	for i := 0; i < queueSize; i++ {
+		op := <-r.workCh
+		r.apply(&op)
+	}
+	tassert.Errorf(t, be.puts == 0, "expected no puts while out of sync, got %d", be.puts)
+	tassert.Errorf(t, r.dropped.Load() == queueSize+1, "expected %d dropped, got %d", queueSize+1, r.dropped.Load())
+}
+
+func TestReplApplyFailure(t *testing.T) {
+	r, be := testReplInit(t, cmn.ReplConf{Enabled: true, QueueSize: 8})
+	testReplObj(t, r, "obj", "content", "1", time.Now().UnixNano())
+
+	be.down = true
+	r.apply(&replOp{name: "obj"})
+	tassert.Fatalf(t, r.resync.Load(), "expected resync state upon failure to replicate")
+
+	// still unreachable: remains out of sync
+	r.reconcile()
+	tassert.Fatalf(t, r.resync.Load(), "expected resync state while remote is unreachable")
+
+	be.down = false
+	r.reconcile()
+	tassert.Fatalf(t, !r.resync.Load(), "expected reconciled")
+	_, ok := be.objs["obj"]
+	tassert.Errorf(t, ok, "expected object replicated by resync")
+}
+
+func TestReplReconcile(t *testing.T) {
+	r, be := testReplInit(t, cmn.ReplConf{Enabled: true, QueueSize: 8})
+	now := time.Now().UnixNano()
+	cksum := testReplObj(t, r, "same", "same", "1", now)
+	testReplObj(t, r, "changed", "new content", "2", now)
+	testReplObj(t, r, "missing", "missing", "1", now)
+
+	be.objs["same"] = cmn.ObjAttrs{Cksum: cksum, Size: int64(len("same"))}
+	be.objs["changed"] = cmn.ObjAttrs{Cksum: cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef"), Size: 3}
+
+	// remote-only objects: removed only by their respective owners (HRW)
+	var owned, notOwned []string
+	for i := 0; len(owned) < 2 || len(notOwned) < 2; i++ {
+		name := "remote-only-" + strconv.Itoa(i)
+		be.objs[name] = cmn.ObjAttrs{Size: 1}
+		if testOwned(t, r.Bck().Bucket(), name) {
+			owned = append(owned, name)
+		} else {
+			notOwned = append(notOwned, name)
+		}
+	}
+
+	r.setResync("test")
+	r.reconcile()
+	tassert.Fatalf(t, !r.resync.Load(), "expected reconciled")
+
+	tassert.Errorf(t, be.puts == 2, "expected 2 puts (changed and missing), got %d", be.puts)
+	for _, name := range []string{"same", "changed", "missing"} {
+		_, ok := be.objs[name]
+		tassert.Errorf(t, ok, "expected %q at destination", name)
+	}
+	for _, name := range owned {
+		_, ok := be.objs[name]
+		tassert.Errorf(t, !ok, "expected %q removed from destination", name)
+	}
+	for _, name := range notOwned {
+		_, ok := be.objs[name]
+		tassert.Errorf(t, ok, "%q is owned by %s and must be kept", name, testOtherTarget)
+	}
+}
+
+//
+// bidirectional: conflict resolution
+//
+
+// load and rlock (as in: XactRepl.put)
+func testReplLoad(t *testing.T, r *XactRepl, objName string) (lom, dst *core.LOM) {
+	lom = core.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(r.Bck().Bucket()))
+	lom.Lock(false)
+	tassert.CheckFatal(t, lom.Load(false /*cache it*/, true /*locked*/))
+	dst = core.AllocLOM(objName)
+	tassert.CheckFatal(t, dst.InitBck(r.dst.Bucket()))
+	return lom, dst
+}
+
+func testReplFree(lom, dst *core.LOM) {
+	lom.Unlock(false)
+	core.FreeLOM(lom)
+	core.FreeLOM(dst)
+}
+
+func TestReplResolve(t *testing.T) {
+	var (
+		now     = time.Now().UnixNano()
+		other   = cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef")
+		setBase = func(t *testing.T, r *XactRepl, objName string, base *cos.Cksum) {
+			lom := core.AllocLOM(objName)
+			defer core.FreeLOM(lom)
+			tassert.CheckFatal(t, lom.InitBck(r.Bck().Bucket()))
+			tassert.CheckFatal(t, lom.Load(false, false))
+			lom.SetCustomKey(cmn.ReplBaseObjMD, base.Val())
+			tassert.CheckFatal(t, lom.Persist())
+			lom.Uncache()
+		}
+	)
+	tests := []struct {
+		name       string
+		remote     func(local *cos.Cksum) *cmn.ObjAttrs // nil: does not exist
+		base       func(local *cos.Cksum) *cos.Cksum    // nil: never synced
+		conflict   string
+		send       bool
+		resolution string // "" when no conflict
+	}{
+		{
+			name:   "remote-missing",
+			remote: func(*cos.Cksum) *cmn.ObjAttrs { return nil },
+			send:   true,
+		},
+		{
+			name:   "same-content",
+			remote: func(local *cos.Cksum) *cmn.ObjAttrs { return &cmn.ObjAttrs{Cksum: local, Size: 7, Ver: "9"} },
+		},
+		{
+			name:   "remote-unchanged",
+			remote: func(*cos.Cksum) *cmn.ObjAttrs { return &cmn.ObjAttrs{Cksum: other, Size: 3, Ver: "9"} },
+			base:   func(*cos.Cksum) *cos.Cksum { return other },
+			send:   true,
+		},
+		{
+			name:   "local-unchanged",
+			remote: func(*cos.Cksum) *cmn.ObjAttrs { return &cmn.ObjAttrs{Cksum: other, Size: 3, Ver: "1"} },
+			base:   func(local *cos.Cksum) *cos.Cksum { return local },
+		},
+		{
+			name:       "both-changed/local-newer",
+			remote:     func(*cos.Cksum) *cmn.ObjAttrs { return &cmn.ObjAttrs{Cksum: other, Size: 3, Ver: "1"} },
+			conflict:   apc.ReplConflictNewer,
+			send:       true,
+			resolution: ReplOverwritten,
+		},
+		{
+			name:       "both-changed/remote-newer",
+			remote:     func(*cos.Cksum) *cmn.ObjAttrs { return &cmn.ObjAttrs{Cksum: other, Size: 3, Ver: "3"} },
+			conflict:   apc.ReplConflictNewer,
+			resolution: ReplKeptRemote,
+		},
+		{
+			name:       "both-changed/reject",
+			remote:     func(*cos.Cksum) *cmn.ObjAttrs { return &cmn.ObjAttrs{Cksum: other, Size: 3, Ver: "1"} },
+			base:       func(*cos.Cksum) *cos.Cksum { return cos.NewCksum(cos.ChecksumXXHash, "fedcba9876543210") },
+			conflict:   apc.ReplConflictReject,
+			resolution: ReplRejected,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conf := cmn.ReplConf{Enabled: true, Bidir: true, Conflict: test.conflict, QueueSize: 8}
+			if conf.Conflict == "" {
+				conf.Conflict = apc.ReplConflictNewer
+			}
+			r, be := testReplInit(t, conf)
+			local := testReplObj(t, r, "obj", "content", "2", now)
+			if oa := test.remote(local); oa != nil {
+				be.objs["obj"] = *oa
+			}
+			if test.base != nil {
+				setBase(t, r, "obj", test.base(local))
+			}
+
+			lom, dst := testReplLoad(t, r, "obj")
+			send, err := r.resolve(lom, dst)
+			testReplFree(lom, dst)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, send == test.send, "expected send=%t, got %t", test.send, send)
+
+			var recent []ReplConflict
+			if snap := r.Snap(); snap.Ext != nil {
+				recent = snap.Ext.(*ReplStats).Recent
+			}
+			if test.resolution == "" {
+				tassert.Errorf(t, len(recent) == 0, "expected no conflicts, got %+v", recent)
+			} else {
+				tassert.Fatalf(t, len(recent) == 1, "expected exactly one conflict, got %+v", recent)
+				tassert.Errorf(t, recent[0].Resolution == test.resolution && recent[0].ObjName == "obj",
+					"expected %q, got %+v", test.resolution, recent[0])
+			}
+		})
+	}
+}
+
+// content found identical on both sides becomes the new base (see setBase)
+func TestReplResolveSetsBase(t *testing.T) {
+	r, be := testReplInit(t, cmn.ReplConf{Enabled: true, Bidir: true, Conflict: apc.ReplConflictNewer, QueueSize: 8})
+	local := testReplObj(t, r, "obj", "content", "1", time.Now().UnixNano())
+	be.objs["obj"] = cmn.ObjAttrs{Cksum: local, Size: int64(len("content"))}
+
+	tassert.CheckFatal(t, r.put("obj", nil))
+	tassert.Errorf(t, be.puts == 0, "identical content must not be (re)sent")
+
+	lom, dst := testReplLoad(t, r, "obj")
+	base, _ := lom.GetCustomKey(cmn.ReplBaseObjMD)
+	testReplFree(lom, dst)
+	tassert.Errorf(t, base == local.Val(), "expected base %q, got %q", local.Val(), base)
+}
+
+// both sides must reach the same decision
+func TestReplLocalIsNewer(t *testing.T) {
+	r, _ := testReplInit(t, cmn.ReplConf{Enabled: true, Bidir: true, QueueSize: 8})
+	const atime = 1_000_000
+	tests := []struct {
+		name  string
+		ver   string
+		atime int64
+		newer bool
+	}{
+		{"remote-lower-version", "1", atime + 1, true},
+		{"remote-higher-version", "3", atime - 1, false},
+		{"same-version/remote-earlier-write", "2", atime - 1, true},
+		{"same-version/remote-later-write", "2", atime + 1, false},
+	}
+	for _, test := range tests {
+		testReplObj(t, r, "obj", "content", "2", atime)
+		lom, dst := testReplLoad(t, r, "obj")
+		lom.SetAtimeUnix(atime)
+		oa := &cmn.ObjAttrs{Cksum: cos.NewCksum(cos.ChecksumXXHash, "0"), Ver: test.ver, Atime: test.atime}
+		newer := localIsNewer(lom, oa)
+		testReplFree(lom, dst)
+		tassert.Errorf(t, newer == test.newer, "%s: expected %t, got %t", test.name, test.newer, newer)
+	}
+
+	// full tie (same version and time): the greater checksum wins - on both sides
+	cksum := testReplObj(t, r, "obj", "content", "2", atime)
+	lom, dst := testReplLoad(t, r, "obj")
+	lom.SetAtimeUnix(atime)
+	for _, val := range []string{"0", "ffffffffffffffff"} {
+		oa := &cmn.ObjAttrs{Cksum: cos.NewCksum(cos.ChecksumXXHash, val), Ver: "2", Atime: atime}
+		expected := cksum.Val() > val
+		tassert.Errorf(t, localIsNewer(lom, oa) == expected, "tie vs %q: expected %t", val, expected)
+	}
+	testReplFree(lom, dst)
+}
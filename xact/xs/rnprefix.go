@@ -0,0 +1,119 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Rename virtual directory (apc.ActRenamePrefix): each target walks the objects it stores
+// under the source prefix and renames them, one at a time (see core.T.RenameObject),
+// as the new prefix + (the rest of the name). Renamed objects that, per HRW, belong to
+// other targets get migrated. Not atomic: in case of failures (or abort) the source
+// and destination "directories" may both contain some of the objects.
+
+type (
+	rnpFactory struct {
+		xreg.RenewBase
+		xctn *XactRenamePrefix
+		msg  *apc.RenamePrefixMsg
+	}
+	XactRenamePrefix struct {
+		msg *apc.RenamePrefixMsg
+		lriterator
+		xact.Base
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactRenamePrefix)(nil)
+	_ xreg.Renewable = (*rnpFactory)(nil)
+	_ lrwi           = (*XactRenamePrefix)(nil)
+)
+
+////////////////
+// rnpFactory //
+////////////////
+
+func (*rnpFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.RenamePrefixMsg)
+	return &rnpFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+}
+
+func (p *rnpFactory) Start() error {
+	if !p.Bck.IsAIS() {
+		return cmn.NewErrUnsupp("rename virtual directory in", p.Bck.Cname(""))
+	}
+	if err := p.msg.Validate(); err != nil {
+		return err
+	}
+	r := &XactRenamePrefix{msg: p.msg}
+	// (not using lriterator.init - prefix is a prefix even when it looks like a template)
+	r.lriterator = lriterator{parent: r, msg: &apc.ListRange{}, bck: p.Bck, prefix: p.msg.Prefix, lrp: lrpPrefix}
+	r.InitBase(p.Args.UUID, p.Kind(), p.Bck)
+	p.xctn = r
+	return nil
+}
+
+func (*rnpFactory) Kind() string     { return apc.ActRenamePrefix }
+func (p *rnpFactory) Get() core.Xact { return p.xctn }
+
+func (*rnpFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+//////////////////////
+// XactRenamePrefix //
+//////////////////////
+
+func (r *XactRenamePrefix) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(r.Name(), "started:", r.Bck().Cname(r.msg.Prefix), "=>", r.msg.NewPrefix)
+	if err := r.lriterator.run(r, core.T.Sowner().Get()); err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	r.Finish()
+}
+
+func (r *XactRenamePrefix) do(lom *core.LOM, _ *lriterator) {
+	objNameTo := r.msg.NewPrefix + strings.TrimPrefix(lom.ObjName, r.msg.Prefix)
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			r.AddErr(err, 5, cos.SmoduleXs)
+		}
+		return
+	}
+	size := lom.SizeBytes()
+	if err := core.T.RenameObject(lom, objNameTo); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			r.AddErr(err, 5, cos.SmoduleXs)
+		}
+		return
+	}
+	r.ObjsAdd(1, size)
+	if cmn.Rom.FastV(5, cos.SmoduleXs) {
+		nlog.Infoln(r.Name()+":", lom.Cname(), "=>", objNameTo)
+	}
+}
+
+func (r *XactRenamePrefix) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
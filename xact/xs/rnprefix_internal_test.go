@@ -0,0 +1,173 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// renames locally or "migrates" (per HRW) to testOtherTarget (compare with ais/target objMv)
+type rnpTarget struct {
+	*mock.TargetMock
+	fail     cos.StrSet        // object names to fail
+	renamed  map[string]string // from => to (local)
+	migrated map[string]string // from => to (testOtherTarget)
+	mu       sync.Mutex
+}
+
+func (t *rnpTarget) RenameObject(lom *core.LOM, objNameTo string) error {
+	if t.fail.Contains(lom.ObjName) {
+		return errors.New("failed to rename " + lom.Cname())
+	}
+	dst := core.AllocLOM(objNameTo)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(lom.Bucket()); err != nil {
+		return err
+	}
+	_, local, err := dst.HrwTarget(t.Sowner().Get())
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if local {
+		if err := os.MkdirAll(filepath.Dir(dst.FQN), cos.PermRWXRX); err != nil {
+			return err
+		}
+		t.renamed[lom.ObjName] = objNameTo
+		return os.Rename(lom.FQN, dst.FQN)
+	}
+	t.migrated[lom.ObjName] = objNameTo
+	return os.Remove(lom.FQN)
+}
+
+func testRnpInit(t *testing.T) (*rnpTarget, *meta.Bck) {
+	bck := testBck("rnp", apc.AIS)
+	tgt := &rnpTarget{
+		TargetMock: testInit(t, bck),
+		fail:       cos.NewStrSet(),
+		renamed:    make(map[string]string),
+		migrated:   make(map[string]string),
+	}
+	core.T = tgt
+	return tgt, bck
+}
+
+// each target only stores (and therefore renames) objects that it owns
+func testRnpObjs(t *testing.T, bck *meta.Bck, prefix string, num int) []string {
+	names := testOwnedNames(t, bck.Bucket(), prefix+"obj-%d", num)
+	for _, name := range names {
+		testPutObj(t, bck.Bucket(), name, name, "1", time.Now().UnixNano())
+	}
+	return names
+}
+
+func testRnpRun(t *testing.T, bck *meta.Bck, msg *apc.RenamePrefixMsg) *XactRenamePrefix {
+	return testRun(t, &rnpFactory{}, bck, msg).(*XactRenamePrefix)
+}
+
+func TestRenamePrefix(t *testing.T) {
+	const num = 20
+	tgt, bck := testRnpInit(t)
+	names := testRnpObjs(t, bck, "dir1/", num)
+	others := testRnpObjs(t, bck, "dir10/", 3) // shares the string prefix "dir1" but not "dir1/"
+
+	r := testRnpRun(t, bck, &apc.RenamePrefixMsg{Prefix: "dir1/", NewPrefix: "dir2/"})
+	tassert.CheckFatal(t, r.Err())
+
+	tassert.Errorf(t, len(tgt.renamed)+len(tgt.migrated) == num, "expected %d renamed, got %d local and %d migrated",
+		num, len(tgt.renamed), len(tgt.migrated))
+	// with two targets, some of the new names must belong to the other one
+	tassert.Errorf(t, len(tgt.renamed) > 0 && len(tgt.migrated) > 0,
+		"expected objects to both stay and move between targets: %d local, %d migrated", len(tgt.renamed), len(tgt.migrated))
+	for _, name := range names {
+		to, ok := tgt.renamed[name]
+		if !ok {
+			to, ok = tgt.migrated[name]
+		}
+		tassert.Errorf(t, ok && to == "dir2/"+name[len("dir1/"):], "%s: unexpected new name %q", name, to)
+	}
+	for from, to := range tgt.migrated {
+		tassert.Errorf(t, !testOwned(t, bck.Bucket(), to), "%s => %s: expected owned by %s", from, to, testOtherTarget)
+	}
+	for _, name := range others {
+		_, renamed := tgt.renamed[name]
+		_, migrated := tgt.migrated[name]
+		tassert.Errorf(t, !renamed && !migrated, "%s must not be renamed", name)
+	}
+	snap := r.Snap()
+	tassert.Errorf(t, snap.Stats.Objs == num, "expected %d objects in stats, got %d", num, snap.Stats.Objs)
+}
+
+// not atomic: failed objects remain under the source prefix while the rest gets renamed
+func TestRenamePrefixPartialFailure(t *testing.T) {
+	const num = 10
+	tgt, bck := testRnpInit(t)
+	names := testRnpObjs(t, bck, "src/", num)
+	tgt.fail.Add(names[1], names[num-1])
+
+	r := testRnpRun(t, bck, &apc.RenamePrefixMsg{Prefix: "src/", NewPrefix: "dst/"})
+	tassert.Fatalf(t, r.Err() != nil, "expected error")
+
+	tassert.Errorf(t, len(tgt.renamed)+len(tgt.migrated) == num-2, "expected %d renamed, got %d",
+		num-2, len(tgt.renamed)+len(tgt.migrated))
+	for _, name := range []string{names[1], names[num-1]} {
+		lom := core.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+		_, err := os.Stat(lom.FQN)
+		core.FreeLOM(lom)
+		tassert.Errorf(t, err == nil, "%s: expected to remain in place: %v", name, err)
+	}
+	snap := r.Snap()
+	tassert.Errorf(t, snap.Stats.Objs == num-2, "expected %d objects in stats, got %d", num-2, snap.Stats.Objs)
+}
+
+func TestRenamePrefixMsgValidate(t *testing.T) {
+	tests := []struct {
+		prefix, newPrefix string
+		ok                bool
+	}{
+		{"dir1/", "dir2/", true},
+		{"dir1/", "dir10/", true},
+		{"a/b/", "a/c/", true},
+		{"", "dir2/", false},
+		{"dir1/", "", false},
+		{"dir1/", "dir1/", false},
+		{"a/", "a/b/", false}, // destination inside source
+		{"a/b/", "a/", false}, // source inside destination
+		{"dir", "dir1/", false},
+	}
+	for _, test := range tests {
+		msg := &apc.RenamePrefixMsg{Prefix: test.prefix, NewPrefix: test.newPrefix}
+		err := msg.Validate()
+		tassert.Errorf(t, (err == nil) == test.ok, "%q => %q: expected ok=%t, got %v", test.prefix, test.newPrefix, test.ok, err)
+	}
+}
+
+// (the same validation upon xaction start - on each target)
+func TestRenamePrefixStart(t *testing.T) {
+	_, bck := testRnpInit(t)
+	p := (&rnpFactory{}).New(xreg.Args{UUID: cos.GenUUID(), Custom: &apc.RenamePrefixMsg{Prefix: "a/", NewPrefix: "a/b/"}}, bck)
+	tassert.Errorf(t, p.Start() != nil, "expected nested prefixes to be rejected")
+
+	remote := meta.NewBck("rnp", apc.AWS, cmn.NsGlobal)
+	p = (&rnpFactory{}).New(xreg.Args{UUID: cos.GenUUID(), Custom: &apc.RenamePrefixMsg{Prefix: "a/", NewPrefix: "b/"}}, remote)
+	tassert.Errorf(t, p.Start() != nil, "expected remote bucket to be rejected")
+}
@@ -8,6 +8,7 @@ package xs
 import (
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -44,6 +45,7 @@ type (
 		rxlast atomic.Int64 // finishing
 		xact.BckJog
 		prune    prune
+		pt       *cos.ParsedTemplate // source template, if any (msg.Template)
 		nam, str string
 		wg       sync.WaitGroup // starting up
 		refc     atomic.Int32   // finishing
@@ -168,10 +170,20 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 	if p.kind == apc.ActETLBck {
 		parallel = etlBucketParallelCnt // TODO: optimize with respect to disk bw and transforming computation
 	}
+	prefix := p.args.Msg.Prefix
+	if p.args.Msg.Template != "" {
+		pt, err := cos.NewParsedTemplate(p.args.Msg.Template)
+		debug.AssertNoErr(err) // validated (P)
+		r.pt = &pt
+		// narrow down the walk
+		if len(pt.Prefix) > len(prefix) && strings.HasPrefix(pt.Prefix, prefix) {
+			prefix = pt.Prefix
+		}
+	}
 	mpopts := &mpather.JgroupOpts{
 		CTs:      []string{fs.ObjectType},
 		VisitObj: r.do,
-		Prefix:   p.args.Msg.Prefix,
+		Prefix:   prefix,
 		Slab:     slab,
 		Parallel: parallel,
 		DoLoad:   mpather.Load,
@@ -187,7 +199,8 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 			r.prune.smap = smap
 			r.prune.bckFrom = p.args.BckFrom
 			r.prune.bckTo = p.args.BckTo
-			r.prune.prefix = p.args.Msg.Prefix
+			r.prune.prefix = prefix
+			r.prune.pt = r.pt
 		}
 		r.prune.init(config)
 	}
@@ -257,6 +270,9 @@ func (r *XactTCB) qcb(tot time.Duration) core.QuiRes {
 }
 
 func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
+	if r.pt != nil && !r.pt.Match(lom.ObjName) {
+		return nil
+	}
 	var (
 		args   = r.p.args // TCBArgs
 		toName = args.Msg.ToName(lom.ObjName)
@@ -351,16 +367,22 @@ func (r *XactTCB) Args() *xreg.TCBArgs { return r.p.args }
 func (r *XactTCB) _str() (s string) {
 	msg := &r.p.args.Msg.CopyBckMsg
 	if msg.Prefix != "" {
-		s = ", prefix " + r.p.args.Msg.Prefix
+		s += ", prefix " + msg.Prefix
+	}
+	if msg.Template != "" {
+		s += ", template " + msg.Template
 	}
 	if msg.Prepend != "" {
-		s = ", prepend " + r.p.args.Msg.Prepend
+		s += ", prepend " + msg.Prepend
+	}
+	if msg.Rename != "" {
+		s += ", rename " + msg.Rename
 	}
 	if msg.LatestVer {
-		s = ", latest-ver"
+		s += ", latest-ver"
 	}
 	if msg.Sync {
-		s = ", synchronize"
+		s += ", synchronize"
 	}
 	return s
 }
@@ -29,6 +29,7 @@ type (
 		msg          *apc.LsoMsg
 		lomVisitedCb lomVisitedCb
 		markerDir    string
+		mdFilter     apc.LsoMDFilter // (validated by the proxy)
 		wanted       cos.BitFlags
 	}
 )
@@ -45,6 +46,7 @@ func newWalkInfo(msg *apc.LsoMsg, lomVisitedCb lomVisitedCb) (wi *walkInfo) {
 		msg:          msg,
 		wanted:       wanted(msg),
 	}
+	wi.mdFilter, _ = msg.MDFilter()
 	if msg.ContinuationToken != "" { // marker is always a filename
 		wi.markerDir = filepath.Dir(msg.ContinuationToken)
 		if wi.markerDir == "." {
@@ -86,6 +88,11 @@ func (wi *walkInfo) match(lom *core.LOM) bool {
 	if !cmn.ObjHasPrefix(lom.ObjName, wi.msg.Prefix) {
 		return false
 	}
+	// non-recursive: objects nested deeper than requested (note that
+	// it'd be incorrect to return `SkipDir` in this case)
+	if wi.msg.IsFlagSet(apc.LsNoRecursion) && strings.Contains(lom.ObjName[len(wi.msg.Prefix):], "/") {
+		return false
+	}
 	return wi.msg.ContinuationToken == "" || !cmn.TokenGreaterEQ(wi.msg.ContinuationToken, lom.ObjName)
 }
 
@@ -153,7 +160,7 @@ func (wi *walkInfo) cb(lom *core.LOM, fqn string) (*cmn.LsoEntry, error) {
 	}
 
 	// shortcut #1: name-only optimizes-out loading md (NOTE: won't show misplaced and copies)
-	if wi.msg.IsFlagSet(apc.LsNameOnly) {
+	if wi.msg.IsFlagSet(apc.LsNameOnly) && wi.mdFilter == nil {
 		if !isOK(status) {
 			return nil, nil
 		}
@@ -166,6 +173,9 @@ func (wi *walkInfo) cb(lom *core.LOM, fqn string) (*cmn.LsoEntry, error) {
 		}
 		return nil, err
 	}
+	if wi.mdFilter != nil && !wi.mdFilter.Match(lom.GetCustomMD()) {
+		return nil, nil
+	}
 	if local && lom.IsCopy() {
 		// still may change below
 		status = apc.LocIsCopy